@@ -14,16 +14,21 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/htlcswitch"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/subscribe"
 )
 
+// byteOrder is the byte order used for all integers serialized by the
+// retribution store.
+var byteOrder = binary.BigEndian
+
 var (
 	// retributionBucket stores retribution state on disk between detecting
 	// a contract breach, broadcasting a justice transaction that sweeps the
@@ -38,11 +43,88 @@ var (
 	// before broadcasting the sweep txn.
 	justiceTxnBucket = []byte("justice-txn")
 
-	// errBrarShuttingDown is an error returned if the breacharbiter has
-	// been signalled to exit.
-	errBrarShuttingDown = errors.New("breacharbiter shutting down")
+	// justiceTxidsBucket holds a sub-bucket for each breached contract,
+	// which indexes the txids of all justice transactions we've attempted
+	// to broadcast for it. As the justice transactions are rebroadcast
+	// with a rising fee rate, and are split up if the single sweep is
+	// rejected, this allows us to recognize any of them after a restart.
+	justiceTxidsBucket = []byte("justice-txids")
 )
 
+const (
+	// justiceTxConfTarget is the confirmation target used to estimate the
+	// fee rate of the justice transactions. We'd like to sweep the breached
+	// funds back into our wallet ASAP.
+	justiceTxConfTarget = 2
+
+	// justiceTxFeeBumpPercent is the minimum percentage by which the fee
+	// rate of the justice transactions is increased on each rebroadcast.
+	justiceTxFeeBumpPercent = 25
+)
+
+// BreachDetectedEvent is sent to the breach event subscribers once a breach
+// has been handed off to, and persisted by, the breach arbiter.
+type BreachDetectedEvent struct {
+	// ChanPoint is the channel point of the breached channel.
+	ChanPoint wire.OutPoint
+
+	// BreachTxID is the txid of the revoked commitment transaction.
+	BreachTxID chainhash.Hash
+}
+
+// JusticeTxBroadcastEvent is sent to the breach event subscribers each time a
+// justice transaction has been broadcast.
+type JusticeTxBroadcastEvent struct {
+	// ChanPoint is the channel point of the breached channel.
+	ChanPoint wire.OutPoint
+
+	// JusticeTxID is the txid of the justice transaction.
+	JusticeTxID chainhash.Hash
+
+	// NumInputs is the number of breached outputs the transaction sweeps.
+	NumInputs int
+
+	// FeeRate is the fee rate of the justice transaction.
+	FeeRate lnwallet.SatPerKWeight
+}
+
+// BreachedOutputSpentEvent is sent to the breach event subscribers when one
+// of the breached outputs has been spent.
+type BreachedOutputSpentEvent struct {
+	// ChanPoint is the channel point of the breached channel.
+	ChanPoint wire.OutPoint
+
+	// OutPoint is the breached output that was spent.
+	OutPoint wire.OutPoint
+
+	// SpendTxID is the txid of the spending transaction.
+	SpendTxID chainhash.Hash
+
+	// Claimed is true if the output was swept by one of our justice
+	// transactions.
+	Claimed bool
+
+	// SecondLevel is true if the cheating party took an HTLC output to
+	// the second level, in which case we'll go on to sweep the output of
+	// the second-level transaction.
+	SecondLevel bool
+}
+
+// BreachResolvedEvent is sent to the breach event subscribers once all
+// breached outputs of a channel have been spent, and the channel is fully
+// closed.
+type BreachResolvedEvent struct {
+	// ChanPoint is the channel point of the breached channel.
+	ChanPoint wire.OutPoint
+
+	// RevokedFunds is the amount of funds revoked from the counter party
+	// that we've claimed.
+	RevokedFunds btcutil.Amount
+
+	// TotalFunds is the total amount of funds we've claimed.
+	TotalFunds btcutil.Amount
+}
+
 // ContractBreachEvent is an event the breachArbiter will receive in case a
 // contract breach is observed on-chain. It contains the necessary information
 // to handle the breach, and a ProcessACK channel we will use to ACK the event
@@ -107,6 +189,11 @@ type BreachConfig struct {
 	// breached channels. This is used in conjunction with DB to recover
 	// from crashes, restarts, or other failures.
 	Store RetributionStore
+
+	// MaxFeeRate is the highest fee rate the justice transactions are
+	// bumped to while they're waiting to confirm. A value of zero means
+	// the fee rate isn't capped.
+	MaxFeeRate lnwallet.SatPerKWeight
 }
 
 // breachArbiter is a special subsystem which is responsible for watching and
@@ -123,6 +210,9 @@ type breachArbiter struct {
 
 	cfg *BreachConfig
 
+	// ntfnServer dispatches breach events to subscribers.
+	ntfnServer *subscribe.Server
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 	sync.Mutex
@@ -132,8 +222,9 @@ type breachArbiter struct {
 // its dependent objects.
 func newBreachArbiter(cfg *BreachConfig) *breachArbiter {
 	return &breachArbiter{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:        cfg,
+		ntfnServer: subscribe.NewServer(),
+		quit:       make(chan struct{}),
 	}
 }
 
@@ -146,6 +237,10 @@ func (b *breachArbiter) Start() error {
 
 	brarLog.Tracef("Starting breach arbiter")
 
+	if err := b.ntfnServer.Start(); err != nil {
+		return err
+	}
+
 	// Load all retributions currently persisted in the retribution store.
 	breachRetInfos := make(map[wire.OutPoint]retributionInfo)
 	if err := b.cfg.Store.ForAll(func(ret *retributionInfo) error {
@@ -235,7 +330,20 @@ func (b *breachArbiter) Stop() error {
 	close(b.quit)
 	b.wg.Wait()
 
-	return nil
+	return b.ntfnServer.Stop()
+}
+
+// SubscribeBreachEvents returns a subscribe.Client that will receive updates
+// on the progress of all breach retributions handled by the breach arbiter.
+func (b *breachArbiter) SubscribeBreachEvents() (*subscribe.Client, error) {
+	return b.ntfnServer.Subscribe()
+}
+
+// notifyBreachEvent sends the passed event to all breach event subscribers.
+func (b *breachArbiter) notifyBreachEvent(event interface{}) {
+	if err := b.ntfnServer.SendUpdate(event); err != nil {
+		brarLog.Warnf("Unable to send breach event: %v", err)
+	}
 }
 
 // IsBreached queries the breach arbiter's retribution store to see if it is
@@ -312,188 +420,240 @@ func convertToSecondLevelRevoke(bo *breachedOutput, breachInfo *retributionInfo,
 		bo.outpoint)
 }
 
-// waitForSpendEvent waits for any of the breached outputs to get spent, and
-// mutates the breachInfo to be able to sweep it. This method should be used
-// when we fail to publish the justice tx because of a double spend, indicating
-// that the counter party has taken one of the breached outputs to the second
-// level. The spendNtfns map is a cache used to store registered spend
-// subscriptions, in case we must call this method multiple times.
-func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
-	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) error {
+// breachSpend wraps the spend of one of the breached outputs of a retribution.
+type breachSpend struct {
+	outpoint wire.OutPoint
+	detail   *chainntnfs.SpendDetail
+}
 
-	inputs := breachInfo.breachedOutputs
+// watchSpend registers for a spend notification of the passed breached
+// output, and delivers the spend on the spends channel once it's detected.
+func (b *breachArbiter) watchSpend(breachInfo *retributionInfo,
+	bo *breachedOutput, spends chan<- breachSpend,
+	exit <-chan struct{}) error {
 
-	// spend is used to wrap the index of the output that gets spent
-	// together with the spend details.
-	type spend struct {
-		index  int
-		detail *chainntnfs.SpendDetail
+	brarLog.Infof("Watching for spend of %v(%v) for ChannelPoint(%v)",
+		bo.witnessType, bo.outpoint, breachInfo.chanPoint)
+
+	spendNtfn, err := b.cfg.Notifier.RegisterSpendNtfn(
+		&bo.outpoint, bo.signDesc.Output.PkScript,
+		breachInfo.breachHeight,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to check for spentness of "+
+			"outpoint=%v: %v", bo.outpoint, err)
 	}
 
-	// We create a channel the first goroutine that gets a spend event can
-	// signal. We make it buffered in case multiple spend events come in at
-	// the same time.
-	anySpend := make(chan struct{}, len(inputs))
+	outpoint := bo.outpoint
 
-	// The allSpends channel will be used to pass spend events from all the
-	// goroutines that detects a spend before they are signalled to exit.
-	allSpends := make(chan spend, len(inputs))
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		defer spendNtfn.Cancel()
 
-	// exit will be used to signal the goroutines that they can exit.
-	exit := make(chan struct{})
-	var wg sync.WaitGroup
+		select {
+		case detail, ok := <-spendNtfn.Spend:
+			if !ok {
+				return
+			}
 
-	// We'll now launch a goroutine for each of the HTLC outputs, that will
-	// signal the moment they detect a spend event.
-	for i := range inputs {
-		breachedOutput := &inputs[i]
+			select {
+			case spends <- breachSpend{outpoint, detail}:
+			case <-exit:
+			case <-b.quit:
+			}
+
+		case <-exit:
+		case <-b.quit:
+		}
+	}()
 
-		brarLog.Infof("Checking spend from %v(%v) for ChannelPoint(%v)",
-			breachedOutput.witnessType, breachedOutput.outpoint,
-			breachInfo.chanPoint)
+	return nil
+}
 
-		// If we have already registered for a notification for this
-		// output, we'll reuse it.
-		spendNtfn, ok := spendNtfns[breachedOutput.outpoint]
-		if !ok {
-			var err error
-			spendNtfn, err = b.cfg.Notifier.RegisterSpendNtfn(
-				&breachedOutput.outpoint,
-				breachedOutput.signDesc.Output.PkScript,
-				breachInfo.breachHeight,
-			)
-			if err != nil {
-				brarLog.Errorf("Unable to check for spentness "+
-					"of outpoint=%v: %v",
-					breachedOutput.outpoint, err)
-
-				// Registration may have failed if we've been
-				// instructed to shutdown. If so, return here
-				// to avoid entering an infinite loop.
-				select {
-				case <-b.quit:
-					return errBrarShuttingDown
-				default:
-					continue
-				}
-			}
-			spendNtfns[breachedOutput.outpoint] = spendNtfn
+// nextJusticeFeeRate returns the fee rate to use for the next broadcast of the
+// justice transactions. The fee rate follows the estimate of the fee
+// estimator, but is always increased by at least justiceTxFeeBumpPercent
+// compared to the previous broadcast, so that the new transactions are able
+// to replace the old ones. The fee rate never exceeds maxFeeRate, so the fee
+// of the justice transactions can't grow past the value of the breached
+// outputs while they're waiting to confirm.
+func nextJusticeFeeRate(estimate, prevFeeRate,
+	maxFeeRate lnwallet.SatPerKWeight) lnwallet.SatPerKWeight {
+
+	feeRate := estimate
+	minFeeRate := prevFeeRate + prevFeeRate*justiceTxFeeBumpPercent/100
+	if feeRate < minFeeRate {
+		feeRate = minFeeRate
+	}
+
+	if maxFeeRate != 0 && feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+
+	return feeRate
+}
+
+// publishJusticeTxs broadcasts the passed justice transactions. The single
+// transaction sweeping all breached outputs is preferred, as it's the
+// cheapest. If it's rejected, for example because the cheating party already
+// took one of the HTLC outputs to the second level, the commitment and HTLC
+// outputs are swept separately, so a conflicting HTLC spend can't hold up the
+// sweep of the commitment outputs. The txids of all transactions we attempt
+// to broadcast are added to justiceTxids.
+func (b *breachArbiter) publishJusticeTxs(breachInfo *retributionInfo,
+	txs *justiceTxVariants, feeRate lnwallet.SatPerKWeight,
+	justiceTxids map[chainhash.Hash]struct{}) {
+
+	publish := func(tx *wire.MsgTx) bool {
+		brarLog.Debugf("Broadcasting justice tx: %v",
+			newLogClosure(func() string {
+				return spew.Sdump(tx)
+			}))
+
+		// Persist the transaction before making an attempt to
+		// broadcast, so that we'll recognize it as our own sweep after
+		// a restart.
+		txid := tx.TxHash()
+		err := b.cfg.Store.Finalize(&breachInfo.chanPoint, tx)
+		if err != nil {
+			brarLog.Errorf("Unable to finalize justice tx %v for "+
+				"ChannelPoint(%v): %v", txid,
+				breachInfo.chanPoint, err)
+			return false
 		}
+		justiceTxids[txid] = struct{}{}
 
-		// Launch a goroutine waiting for a spend event.
-		b.wg.Add(1)
-		wg.Add(1)
-		go func(index int, spendEv *chainntnfs.SpendEvent) {
-			defer b.wg.Done()
-			defer wg.Done()
+		err = b.cfg.PublishTransaction(tx)
+		if err != nil {
+			brarLog.Warnf("Unable to broadcast justice tx %v for "+
+				"ChannelPoint(%v): %v", txid,
+				breachInfo.chanPoint, err)
+			return false
+		}
 
-			select {
-			// The output has been taken to the second level!
-			case sp, ok := <-spendEv.Spend:
-				if !ok {
-					return
-				}
+		b.notifyBreachEvent(JusticeTxBroadcastEvent{
+			ChanPoint:   breachInfo.chanPoint,
+			JusticeTxID: txid,
+			NumInputs:   len(tx.TxIn),
+			FeeRate:     feeRate,
+		})
 
-				brarLog.Infof("Detected spend on %s(%v) by "+
-					"txid(%v) for ChannelPoint(%v)",
-					inputs[index].witnessType,
-					inputs[index].outpoint,
-					sp.SpenderTxHash,
-					breachInfo.chanPoint)
-
-				// First we send the spend event on the
-				// allSpends channel, such that it can be
-				// handled after all go routines have exited.
-				allSpends <- spend{index, sp}
-
-				// Finally we'll signal the anySpend channel
-				// that a spend was detected, such that the
-				// other goroutines can be shut down.
-				anySpend <- struct{}{}
-			case <-exit:
-				return
-			case <-b.quit:
-				return
-			}
-		}(i, spendNtfn)
+		return true
 	}
 
-	// We'll wait for any of the outputs to be spent, or that we are
-	// signalled to exit.
-	select {
-	// A goroutine have signalled that a spend occurred.
-	case <-anySpend:
-		// Signal for the remaining goroutines to exit.
-		close(exit)
-		wg.Wait()
-
-		// At this point all goroutines that can send on the allSpends
-		// channel have exited. We can therefore safely close the
-		// channel before ranging over its content.
-		close(allSpends)
-
-		doneOutputs := make(map[int]struct{})
-		for s := range allSpends {
-			breachedOutput := &inputs[s.index]
-			delete(spendNtfns, breachedOutput.outpoint)
-
-			switch breachedOutput.witnessType {
-			case input.HtlcAcceptedRevoke:
-				fallthrough
-			case input.HtlcOfferedRevoke:
-				brarLog.Infof("Spend on second-level"+
-					"%s(%v) for ChannelPoint(%v) "+
-					"transitions to second-level output",
-					breachedOutput.witnessType,
-					breachedOutput.outpoint,
-					breachInfo.chanPoint)
-
-				// In this case we'll morph our initial revoke
-				// spend to instead point to the second level
-				// output, and update the sign descriptor in the
-				// process.
-				convertToSecondLevelRevoke(
-					breachedOutput, breachInfo, s.detail,
-				)
+	if txs.spendAll != nil {
+		if publish(txs.spendAll) {
+			return
+		}
 
-				continue
-			}
+		// If there's nothing to split, there's no point in trying to
+		// broadcast the same set of inputs again.
+		if txs.spendCommitOuts == nil || txs.spendHTLCs == nil {
+			return
+		}
+	}
+
+	for _, tx := range []*wire.MsgTx{txs.spendCommitOuts, txs.spendHTLCs} {
+		if tx == nil {
+			continue
+		}
+
+		publish(tx)
+	}
+}
 
-			brarLog.Infof("Spend on %s(%v) for ChannelPoint(%v) "+
-				"transitions output to terminal state, "+
-				"removing input from justice transaction",
-				breachedOutput.witnessType,
-				breachedOutput.outpoint, breachInfo.chanPoint)
+// handleBreachSpend updates the retribution in response to the spend of one of
+// its breached outputs. Outputs swept by one of our justice transactions, or
+// spent by the cheating party in a way we can't claim, are removed. HTLC
+// outputs taken to the second level by the cheating party are converted into
+// the second-level output, which we're still able to sweep. The returned
+// output is the converted second-level output, if any, which should be
+// watched for a spend.
+func (b *breachArbiter) handleBreachSpend(breachInfo *retributionInfo,
+	spend breachSpend, justiceTxids map[chainhash.Hash]struct{},
+	totalFunds, revokedFunds *btcutil.Amount) *breachedOutput {
 
-			doneOutputs[s.index] = struct{}{}
+	inputs := breachInfo.breachedOutputs
+
+	index := -1
+	for i := range inputs {
+		if inputs[i].outpoint == spend.outpoint {
+			index = i
+			break
 		}
+	}
+	if index == -1 {
+		return nil
+	}
+	bo := &inputs[index]
 
-		// Filter the inputs for which we can no longer proceed.
-		var nextIndex int
-		for i := range inputs {
-			if _, ok := doneOutputs[i]; ok {
-				continue
-			}
+	spendTxID := spend.detail.SpendingTx.TxHash()
+	_, claimed := justiceTxids[spendTxID]
 
-			inputs[nextIndex] = inputs[i]
-			nextIndex++
+	brarLog.Infof("Detected spend on %v(%v) by txid(%v) for "+
+		"ChannelPoint(%v), claimed=%v", bo.witnessType, bo.outpoint,
+		spendTxID, breachInfo.chanPoint, claimed)
+
+	event := BreachedOutputSpentEvent{
+		ChanPoint: breachInfo.chanPoint,
+		OutPoint:  bo.outpoint,
+		SpendTxID: spendTxID,
+		Claimed:   claimed,
+	}
+
+	switch {
+	// The output was swept by one of our justice transactions, so we'll
+	// account for the funds we've claimed.
+	case claimed:
+		*totalFunds += bo.Amount()
+
+		// If the output being revoked is the remote commitment output
+		// or an HTLC output of the counter party, its amount
+		// contributes to the value of funds being revoked from the
+		// counter party.
+		switch bo.WitnessType() {
+		case input.CommitmentRevoke, input.HtlcOfferedRevoke,
+			input.HtlcSecondLevelRevoke:
+
+			*revokedFunds += bo.Amount()
 		}
 
-		// Update our remaining set of outputs before continuing with
-		// another attempt at publication.
-		breachInfo.breachedOutputs = inputs[:nextIndex]
+	// The cheating party took an HTLC output to the second level. In this
+	// case we'll morph our initial revoke spend to instead point to the
+	// second level output, and update the sign descriptor in the process.
+	case bo.witnessType == input.HtlcAcceptedRevoke ||
+		bo.witnessType == input.HtlcOfferedRevoke:
 
-	case <-b.quit:
-		return errBrarShuttingDown
+		convertToSecondLevelRevoke(bo, breachInfo, spend.detail)
+
+		event.SecondLevel = true
+		b.notifyBreachEvent(event)
+
+		return bo
+
+	default:
+		brarLog.Infof("Spend on %s(%v) for ChannelPoint(%v) "+
+			"transitions output to terminal state, removing input "+
+			"from justice transaction", bo.witnessType,
+			bo.outpoint, breachInfo.chanPoint)
 	}
 
+	b.notifyBreachEvent(event)
+
+	// The output has reached its terminal state, so we can remove it from
+	// the set of outputs to sweep.
+	breachInfo.breachedOutputs = append(inputs[:index], inputs[index+1:]...)
+
 	return nil
 }
 
 // exactRetribution is a goroutine which is executed once a contract breach has
 // been detected by a breachObserver. This function is responsible for
 // punishing a counterparty for violating the channel contract by sweeping ALL
-// the lingering funds within the channel into the daemon's wallet.
+// the lingering funds within the channel into the daemon's wallet. The justice
+// transactions are rebroadcast with a rising fee rate on every new block,
+// until each of the breached outputs has been spent.
 //
 // NOTE: This MUST be run as a goroutine.
 func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
@@ -502,17 +662,14 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	defer b.wg.Done()
 
 	// TODO(roasbeef): state needs to be checkpointed here
-	var breachConfHeight uint32
 	select {
-	case breachConf, ok := <-confChan.Confirmed:
+	case _, ok := <-confChan.Confirmed:
 		// If the second value is !ok, then the channel has been closed
 		// signifying a daemon shutdown, so we exit.
 		if !ok {
 			return
 		}
 
-		breachConfHeight = breachConf.BlockHeight
-
 		// Otherwise, if this is a real confirmation notification, then
 		// we fall through to complete our duty.
 	case <-b.quit:
@@ -522,156 +679,158 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	brarLog.Debugf("Breach transaction %v has been confirmed, sweeping "+
 		"revoked funds", breachInfo.commitHash)
 
-	// We may have to wait for some of the HTLC outputs to be spent to the
-	// second level before broadcasting the justice tx. We'll store the
-	// SpendEvents between each attempt to not re-register uneccessarily.
-	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
+	// We'll rebroadcast the justice transactions with a higher fee rate
+	// on every new block, until all breached outputs have been spent.
+	blockEpochs, err := b.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		brarLog.Errorf("Unable to register for block epochs: %v", err)
+		return
+	}
+	defer blockEpochs.Cancel()
 
-	finalTx, err := b.cfg.Store.GetFinalizedTxn(&breachInfo.chanPoint)
+	// All outputs are swept to the same script, so that rebroadcasts only
+	// differ in their fee.
+	pkScript, err := b.cfg.GenSweepScript()
+	if err != nil {
+		brarLog.Errorf("Unable to generate sweep script: %v", err)
+		return
+	}
+
+	// justiceTxids holds the txids of all justice transactions we've
+	// attempted to broadcast, which allows us to tell our own sweeps apart
+	// from spends of the cheating party. If we broadcast a justice
+	// transaction before a restart, we'll recognize it as well.
+	justiceTxids, err := b.cfg.Store.JusticeTxids(&breachInfo.chanPoint)
 	if err != nil {
-		brarLog.Errorf("Unable to get finalized txn for"+
+		brarLog.Errorf("Unable to get justice txids for "+
 			"chanid=%v: %v", &breachInfo.chanPoint, err)
 		return
 	}
 
-	// If this retribution has not been finalized before, we will first
-	// construct a sweep transaction and write it to disk. This will allow
-	// the breach arbiter to re-register for notifications for the justice
-	// txid.
-justiceTxBroadcast:
-	if finalTx == nil {
-		// With the breach transaction confirmed, we now create the
-		// justice tx which will claim ALL the funds within the
-		// channel.
-		finalTx, err = b.createJusticeTx(breachInfo)
-		if err != nil {
-			brarLog.Errorf("Unable to create justice tx: %v", err)
-			return
-		}
+	// All spends of the breached outputs are delivered on the spends
+	// channel. The exit channel signals the goroutines watching for
+	// spends that we're no longer interested.
+	spends := make(chan breachSpend)
+	exit := make(chan struct{})
+	defer close(exit)
 
-		// Persist our finalized justice transaction before making an
-		// attempt to broadcast.
-		err := b.cfg.Store.Finalize(&breachInfo.chanPoint, finalTx)
+	for i := range breachInfo.breachedOutputs {
+		err := b.watchSpend(
+			breachInfo, &breachInfo.breachedOutputs[i], spends,
+			exit,
+		)
 		if err != nil {
-			brarLog.Errorf("Unable to finalize justice tx for "+
-				"chanid=%v: %v", &breachInfo.chanPoint, err)
+			brarLog.Errorf("Unable to watch breached output: %v",
+				err)
 			return
 		}
 	}
 
-	brarLog.Debugf("Broadcasting justice tx: %v", newLogClosure(func() string {
-		return spew.Sdump(finalTx)
-	}))
+	var (
+		feeRate                  lnwallet.SatPerKWeight
+		totalFunds, revokedFunds btcutil.Amount
+		bumpFee                  = true
+	)
+	for len(breachInfo.breachedOutputs) > 0 {
+		// If this is our first broadcast or a new block came in, we'll
+		// query the fee estimator for a new fee rate.
+		// If the estimator fails, we'll stick to our previous fee rate,
+		// and try again once the next block comes in.
+		if bumpFee {
+			estimate, err := b.cfg.Estimator.EstimateFeePerKW(
+				justiceTxConfTarget,
+			)
+			if err != nil {
+				brarLog.Errorf("Unable to estimate fee for "+
+					"ChannelPoint(%v), retrying on the "+
+					"next block: %v", breachInfo.chanPoint,
+					err)
+			} else {
+				feeRate = nextJusticeFeeRate(
+					estimate, feeRate, b.cfg.MaxFeeRate,
+				)
+			}
+			bumpFee = false
+		}
 
-	// We'll now attempt to broadcast the transaction which finalized the
-	// channel's retribution against the cheating counter party.
-	err = b.cfg.PublishTransaction(finalTx)
-	if err != nil {
-		brarLog.Errorf("Unable to broadcast justice tx: %v", err)
-
-		if err == lnwallet.ErrDoubleSpend {
-			// Broadcasting the transaction failed because of a
-			// conflict either in the mempool or in chain. We'll
-			// now create spend subscriptions for all HTLC outputs
-			// on the commitment transaction that could possibly
-			// have been spent, and wait for any of them to
-			// trigger.
-			brarLog.Infof("Waiting for a spend event before " +
-				"attempting to craft new justice tx.")
-			finalTx = nil
-
-			err := b.waitForSpendEvent(breachInfo, spendNtfns)
+		// Without any fee rate to go by, there's nothing we can
+		// broadcast yet.
+		var justiceTxs *justiceTxVariants
+		if feeRate != 0 {
+			justiceTxs, err = b.createJusticeTx(
+				breachInfo.breachedOutputs, feeRate, pkScript,
+			)
 			if err != nil {
-				if err != errBrarShuttingDown {
-					brarLog.Errorf("error waiting for "+
-						"spend event: %v", err)
-				}
+				brarLog.Errorf("Unable to create justice "+
+					"tx: %v", err)
 				return
 			}
+		}
 
-			if len(breachInfo.breachedOutputs) == 0 {
-				brarLog.Debugf("No more outputs to sweep for "+
-					"breach, marking ChannelPoint(%v) "+
-					"fully resolved", breachInfo.chanPoint)
+		// If the fee exceeds the value of the breached outputs, none
+		// of the justice transactions can be created. Our fee rate is
+		// capped, so they may still become viable if the estimate
+		// drops, which is why we'll keep waiting for new blocks.
+		if justiceTxs != nil {
+			b.publishJusticeTxs(
+				breachInfo, justiceTxs, feeRate, justiceTxids,
+			)
+		}
 
-				err = b.cleanupBreach(&breachInfo.chanPoint)
-				if err != nil {
-					brarLog.Errorf("Failed to cleanup "+
-						"breached ChannelPoint(%v): %v",
-						breachInfo.chanPoint, err)
-				}
+		select {
+		case spend := <-spends:
+			secondLevel := b.handleBreachSpend(
+				breachInfo, spend, justiceTxids, &totalFunds,
+				&revokedFunds,
+			)
+			if secondLevel == nil {
+				continue
+			}
+
+			err := b.watchSpend(breachInfo, secondLevel, spends, exit)
+			if err != nil {
+				brarLog.Errorf("Unable to watch second-level "+
+					"output: %v", err)
 				return
 			}
 
-			brarLog.Infof("Attempting another justice tx "+
-				"with %d inputs",
-				len(breachInfo.breachedOutputs))
+		case _, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
 
-			goto justiceTxBroadcast
-		}
-	}
+			brarLog.Debugf("Justice tx for ChannelPoint(%v) not yet "+
+				"confirmed, rebroadcasting with a higher fee",
+				breachInfo.chanPoint)
 
-	// As a conclusionary step, we register for a notification to be
-	// dispatched once the justice tx is confirmed. After confirmation we
-	// notify the caller that initiated the retribution workflow that the
-	// deed has been done.
-	justiceTXID := finalTx.TxHash()
-	justiceScript := finalTx.TxOut[0].PkScript
-	confChan, err = b.cfg.Notifier.RegisterConfirmationsNtfn(
-		&justiceTXID, justiceScript, 1, breachConfHeight,
-	)
-	if err != nil {
-		brarLog.Errorf("Unable to register for conf for txid(%v): %v",
-			justiceTXID, err)
-		return
-	}
+			bumpFee = true
 
-	select {
-	case _, ok := <-confChan.Confirmed:
-		if !ok {
+		case <-b.quit:
 			return
 		}
+	}
 
-		// Compute both the total value of funds being swept and the
-		// amount of funds that were revoked from the counter party.
-		var totalFunds, revokedFunds btcutil.Amount
-		for _, inp := range breachInfo.breachedOutputs {
-			totalFunds += inp.Amount()
-
-			// If the output being revoked is the remote commitment
-			// output or an offered HTLC output, it's amount
-			// contributes to the value of funds being revoked from
-			// the counter party.
-			switch inp.WitnessType() {
-			case input.CommitmentRevoke:
-				revokedFunds += inp.Amount()
-			case input.HtlcOfferedRevoke:
-				revokedFunds += inp.Amount()
-			default:
-			}
-		}
-
-		brarLog.Infof("Justice for ChannelPoint(%v) has "+
-			"been served, %v revoked funds (%v total) "+
-			"have been claimed", breachInfo.chanPoint,
-			revokedFunds, totalFunds)
+	brarLog.Infof("Justice for ChannelPoint(%v) has been served, %v "+
+		"revoked funds (%v total) have been claimed",
+		breachInfo.chanPoint, revokedFunds, totalFunds)
 
-		err = b.cleanupBreach(&breachInfo.chanPoint)
-		if err != nil {
-			brarLog.Errorf("Failed to cleanup breached "+
-				"ChannelPoint(%v): %v", breachInfo.chanPoint,
-				err)
-		}
+	err = b.cleanupBreach(&breachInfo.chanPoint)
+	if err != nil {
+		brarLog.Errorf("Failed to cleanup breached ChannelPoint(%v): %v",
+			breachInfo.chanPoint, err)
+		return
+	}
 
-		// TODO(roasbeef): add peer to blacklist?
+	b.notifyBreachEvent(BreachResolvedEvent{
+		ChanPoint:    breachInfo.chanPoint,
+		RevokedFunds: revokedFunds,
+		TotalFunds:   totalFunds,
+	})
 
-		// TODO(roasbeef): close other active channels with offending
-		// peer
+	// TODO(roasbeef): add peer to blacklist?
 
-		return
-	case <-b.quit:
-		return
-	}
+	// TODO(roasbeef): close other active channels with offending
+	// peer
 }
 
 // cleanupBreach marks the given channel point as fully resolved and removes the
@@ -807,6 +966,11 @@ func (b *breachArbiter) handleBreachHandoff(breachEvent *ContractBreachEvent) {
 	brarLog.Warnf("A channel has been breached with txid: %v. Waiting "+
 		"for confirmation, then justice will be served!", breachTXID)
 
+	b.notifyBreachEvent(BreachDetectedEvent{
+		ChanPoint:  chanPoint,
+		BreachTxID: *breachTXID,
+	})
+
 	// With the retribution state persisted, channel close persisted, and
 	// notification registered, we launch a new goroutine which will
 	// finalize the channel retribution after the breach transaction has
@@ -906,6 +1070,13 @@ func (bo *breachedOutput) HeightHint() uint32 {
 	return bo.confHeight
 }
 
+// DeadlineHeight returns the absolute block height before which the output
+// must be swept. Breached outputs are swept by the justice transaction, which
+// doesn't carry a deadline of its own.
+func (bo *breachedOutput) DeadlineHeight() (uint32, bool) {
+	return 0, false
+}
+
 // Add compile-time constraint ensuring breachedOutput implements the Input
 // interface.
 var _ input.Input = (*breachedOutput)(nil)
@@ -1011,41 +1182,94 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 	}
 }
 
-// createJusticeTx creates a transaction which exacts "justice" by sweeping ALL
+// justiceTxVariants is a struct of the possible justice transactions that can
+// be used to sweep the breached outputs of a commitment. Besides the single
+// transaction sweeping all outputs, the outputs are split by their urgency:
+// the revoked commitment output can only be swept by the cheating party
+// after its csv delay, while the HTLC outputs may be taken to the second
+// level at any time. A variant is nil if there are no outputs to sweep in it,
+// or if the outputs aren't worth sweeping at the current fee rate.
+type justiceTxVariants struct {
+	spendAll        *wire.MsgTx
+	spendCommitOuts *wire.MsgTx
+	spendHTLCs      *wire.MsgTx
+}
+
+// createJusticeTx creates the transactions which exact "justice" by sweeping
 // the funds within the channel which we are now entitled to due to a breach of
-// the channel's contract by the counterparty. This function returns a *fully*
-// signed transaction with the witness for each input fully in place.
-func (b *breachArbiter) createJusticeTx(
-	r *retributionInfo) (*wire.MsgTx, error) {
+// the channel's contract by the counterparty. All transactions are *fully*
+// signed with the witness for each input fully in place.
+func (b *breachArbiter) createJusticeTx(breachedOutputs []breachedOutput,
+	feeRate lnwallet.SatPerKWeight,
+	pkScript []byte) (*justiceTxVariants, error) {
+
+	var allInputs, commitInputs, htlcInputs []input.Input
+	for i := range breachedOutputs {
+		// Grab locally scoped reference to breached output.
+		inp := &breachedOutputs[i]
+
+		switch inp.WitnessType() {
+		case input.CommitmentNoDelay, input.CommitmentRevoke:
+			commitInputs = append(commitInputs, inp)
+
+		case input.HtlcOfferedRevoke, input.HtlcAcceptedRevoke,
+			input.HtlcSecondLevelRevoke:
+
+			htlcInputs = append(htlcInputs, inp)
+
+		// If the witness type is unrecognized, we will omit it from
+		// the transactions.
+		default:
+			brarLog.Warnf("breached output in retribution info "+
+				"contains unexpected witness type: %v",
+				inp.WitnessType())
+			continue
+		}
+
+		allInputs = append(allInputs, inp)
+	}
 
-	// We will assemble the breached outputs into a slice of spendable
-	// outputs, while simultaneously computing the estimated weight of the
-	// transaction.
 	var (
-		spendableOutputs []input.Input
-		weightEstimate   input.TxWeightEstimator
+		txs justiceTxVariants
+		err error
 	)
+	txs.spendAll, err = b.createSweepTx(feeRate, pkScript, allInputs...)
+	if err != nil {
+		return nil, err
+	}
+	txs.spendCommitOuts, err = b.createSweepTx(
+		feeRate, pkScript, commitInputs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	txs.spendHTLCs, err = b.createSweepTx(feeRate, pkScript, htlcInputs...)
+	if err != nil {
+		return nil, err
+	}
 
-	// Allocate enough space to potentially hold each of the breached
-	// outputs in the retribution info.
-	spendableOutputs = make([]input.Input, 0, len(r.breachedOutputs))
+	return &txs, nil
+}
+
+// createSweepTx creates a transaction sweeping the passed inputs at the given
+// fee rate. If there are no inputs, or the swept amount would be dust after
+// paying the fee, no transaction is returned.
+func (b *breachArbiter) createSweepTx(feeRate lnwallet.SatPerKWeight,
+	pkScript []byte, inputs ...input.Input) (*wire.MsgTx, error) {
+
+	if len(inputs) == 0 {
+		return nil, nil
+	}
 
 	// The justice transaction we construct will be a segwit transaction
 	// that pays to a p2wkh output. Components such as the version,
 	// nLockTime, and output are already included in the TxWeightEstimator.
+	var weightEstimate input.TxWeightEstimator
 	weightEstimate.AddP2WKHOutput()
 
-	// Next, we iterate over the breached outputs contained in the
-	// retribution info.  For each, we switch over the witness type such
-	// that we contribute the appropriate weight for each input and witness,
-	// finally adding to our list of spendable outputs.
-	for i := range r.breachedOutputs {
-		// Grab locally scoped reference to breached output.
-		inp := &r.breachedOutputs[i]
-
-		// First, select the appropriate estimated witness weight for
-		// the give witness type of this breached output. If the witness
-		// type is unrecognized, we will omit it from the transaction.
+	// Next, we iterate over the inputs, and contribute the appropriate
+	// weight for each input and witness based on its witness type.
+	for _, inp := range inputs {
 		var witnessWeight int
 		switch inp.WitnessType() {
 		case input.CommitmentNoDelay:
@@ -1062,53 +1286,38 @@ func (b *breachArbiter) createJusticeTx(
 
 		case input.HtlcSecondLevelRevoke:
 			witnessWeight = input.ToLocalPenaltyWitnessSize
-
-		default:
-			brarLog.Warnf("breached output in retribution info "+
-				"contains unexpected witness type: %v",
-				inp.WitnessType())
-			continue
 		}
 		weightEstimate.AddWitnessInput(witnessWeight)
-
-		// Finally, append this input to our list of spendable outputs.
-		spendableOutputs = append(spendableOutputs, inp)
 	}
 
 	txWeight := int64(weightEstimate.Weight())
-	return b.sweepSpendableOutputsTxn(txWeight, spendableOutputs...)
+	return b.sweepSpendableOutputsTxn(txWeight, feeRate, pkScript, inputs...)
 }
 
 // sweepSpendableOutputsTxn creates a signed transaction from a sequence of
 // spendable outputs by sweeping the funds into a single p2wkh output.
 func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
+	feeRate lnwallet.SatPerKWeight, pkScript []byte,
 	inputs ...input.Input) (*wire.MsgTx, error) {
 
-	// First, we obtain a new public key script from the wallet which we'll
-	// sweep the funds to.
-	// TODO(roasbeef): possibly create many outputs to minimize change in
-	// the future?
-	pkScript, err := b.cfg.GenSweepScript()
-	if err != nil {
-		return nil, err
-	}
-
 	// Compute the total amount contained in the inputs.
 	var totalAmt btcutil.Amount
 	for _, input := range inputs {
 		totalAmt += btcutil.Amount(input.SignDesc().Output.Value)
 	}
 
-	// We'll actually attempt to target inclusion within the next two
-	// blocks as we'd like to sweep these funds back into our wallet ASAP.
-	feePerKw, err := b.cfg.Estimator.EstimateFeePerKW(2)
-	if err != nil {
-		return nil, err
-	}
-	txFee := feePerKw.FeeForWeight(txWeight)
-
 	// TODO(roasbeef): already start to siphon their funds into fees
-	sweepAmt := int64(totalAmt - txFee)
+	txFee := feeRate.FeeForWeight(txWeight)
+	sweepAmt := totalAmt - txFee
+
+	// If the fee eats up the swept funds, there's no point in sweeping
+	// the inputs at this fee rate.
+	if sweepAmt < lnwallet.DefaultDustLimit() {
+		brarLog.Debugf("Not sweeping %v inputs worth %v at fee rate "+
+			"%v, output would be dust", len(inputs), totalAmt,
+			feeRate)
+		return nil, nil
+	}
 
 	// With the fee calculated, we can now create the transaction using the
 	// information gathered above and the provided retribution information.
@@ -1117,7 +1326,7 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 	// We begin by adding the output to which our funds will be deposited.
 	txn.AddTxOut(&wire.TxOut{
 		PkScript: pkScript,
-		Value:    sweepAmt,
+		Value:    int64(sweepAmt),
 	})
 
 	// Next, we add all of the spendable outputs as inputs to the
@@ -1195,6 +1404,7 @@ type RetributionStore interface {
 	IsBreached(chanPoint *wire.OutPoint) (bool, error)
 
 	// Finalize persists the finalized justice transaction for a particular
+	// channel, and records its txid among the justice txids of the
 	// channel.
 	Finalize(chanPoint *wire.OutPoint, finalTx *wire.MsgTx) error
 
@@ -1203,6 +1413,11 @@ type RetributionStore interface {
 	// Finalize has not yet been called for this channel point.
 	GetFinalizedTxn(chanPoint *wire.OutPoint) (*wire.MsgTx, error)
 
+	// JusticeTxids returns the txids of all justice transactions that have
+	// been finalized for a particular channel.
+	JusticeTxids(chanPoint *wire.OutPoint) (map[chainhash.Hash]struct{},
+		error)
+
 	// Remove deletes the retributionInfo from disk, if any exists, under
 	// the given key. An error should be re raised if the removal fails.
 	Remove(key *wire.OutPoint) error
@@ -1231,7 +1446,7 @@ func newRetributionStore(db *channeldb.DB) *retributionStore {
 // Add adds a retribution state to the retributionStore, which is then persisted
 // to disk.
 func (rs *retributionStore) Add(ret *retributionInfo) error {
-	return rs.db.Update(func(tx *bbolt.Tx) error {
+	return rs.db.Update(func(tx kvdb.Tx) error {
 		// If this is our first contract breach, the retributionBucket
 		// won't exist, in which case, we just create a new bucket.
 		retBucket, err := tx.CreateBucketIfNotExists(retributionBucket)
@@ -1258,7 +1473,7 @@ func (rs *retributionStore) Add(ret *retributionInfo) error {
 // startup and re-register for confirmation notifications.
 func (rs *retributionStore) Finalize(chanPoint *wire.OutPoint,
 	finalTx *wire.MsgTx) error {
-	return rs.db.Update(func(tx *bbolt.Tx) error {
+	return rs.db.Update(func(tx kvdb.Tx) error {
 		justiceBkt, err := tx.CreateBucketIfNotExists(justiceTxnBucket)
 		if err != nil {
 			return err
@@ -1274,7 +1489,27 @@ func (rs *retributionStore) Finalize(chanPoint *wire.OutPoint,
 			return err
 		}
 
-		return justiceBkt.Put(chanBuf.Bytes(), txBuf.Bytes())
+		err = justiceBkt.Put(chanBuf.Bytes(), txBuf.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// Also index the txid of the transaction, so that we'll
+		// remember it even once it's been replaced by a transaction
+		// with a higher fee rate.
+		txidsBkt, err := tx.CreateBucketIfNotExists(justiceTxidsBucket)
+		if err != nil {
+			return err
+		}
+		chanTxidsBkt, err := txidsBkt.CreateBucketIfNotExists(
+			chanBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		txid := finalTx.TxHash()
+		return chanTxidsBkt.Put(txid[:], []byte{})
 	})
 }
 
@@ -1285,7 +1520,7 @@ func (rs *retributionStore) GetFinalizedTxn(
 	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
 
 	var finalTxBytes []byte
-	if err := rs.db.View(func(tx *bbolt.Tx) error {
+	if err := rs.db.View(func(tx kvdb.Tx) error {
 		justiceBkt := tx.Bucket(justiceTxnBucket)
 		if justiceBkt == nil {
 			return nil
@@ -1313,13 +1548,68 @@ func (rs *retributionStore) GetFinalizedTxn(
 	return finalTx, err
 }
 
+// JusticeTxids returns the txids of all justice transactions that have been
+// finalized for the provided channel point.
+func (rs *retributionStore) JusticeTxids(
+	chanPoint *wire.OutPoint) (map[chainhash.Hash]struct{}, error) {
+
+	justiceTxids := make(map[chainhash.Hash]struct{})
+	err := rs.db.View(func(tx kvdb.Tx) error {
+		var chanBuf bytes.Buffer
+		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
+			return err
+		}
+
+		// Justice transactions finalized by prior versions are only
+		// found in the justice txn bucket, so we'll include the txid
+		// of the last finalized transaction.
+		justiceBkt := tx.Bucket(justiceTxnBucket)
+		if justiceBkt != nil {
+			finalTxBytes := justiceBkt.Get(chanBuf.Bytes())
+			if finalTxBytes != nil {
+				finalTx := &wire.MsgTx{}
+				err := finalTx.Deserialize(
+					bytes.NewReader(finalTxBytes),
+				)
+				if err != nil {
+					return err
+				}
+
+				justiceTxids[finalTx.TxHash()] = struct{}{}
+			}
+		}
+
+		txidsBkt := tx.Bucket(justiceTxidsBucket)
+		if txidsBkt == nil {
+			return nil
+		}
+		chanTxidsBkt := txidsBkt.Bucket(chanBuf.Bytes())
+		if chanTxidsBkt == nil {
+			return nil
+		}
+
+		return chanTxidsBkt.ForEach(func(k, _ []byte) error {
+			var txid chainhash.Hash
+			copy(txid[:], k)
+			justiceTxids[txid] = struct{}{}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return justiceTxids, nil
+}
+
 // IsBreached queries the retribution store to discern if this channel was
 // previously breached. This is used when connecting to a peer to determine if
 // it is safe to add a link to the htlcswitch, as we should never add a channel
 // that has already been breached.
 func (rs *retributionStore) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
 	var found bool
-	err := rs.db.View(func(tx *bbolt.Tx) error {
+	err := rs.db.View(func(tx kvdb.Tx) error {
 		retBucket := tx.Bucket(retributionBucket)
 		if retBucket == nil {
 			return nil
@@ -1344,7 +1634,7 @@ func (rs *retributionStore) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
 // Remove removes a retribution state and finalized justice transaction by
 // channel point  from the retribution store.
 func (rs *retributionStore) Remove(chanPoint *wire.OutPoint) error {
-	return rs.db.Update(func(tx *bbolt.Tx) error {
+	return rs.db.Update(func(tx kvdb.Tx) error {
 		retBucket := tx.Bucket(retributionBucket)
 
 		// We return an error if the bucket is not already created,
@@ -1376,14 +1666,25 @@ func (rs *retributionStore) Remove(chanPoint *wire.OutPoint) error {
 			return nil
 		}
 
-		return justiceBkt.Delete(chanBytes)
+		if err := justiceBkt.Delete(chanBytes); err != nil {
+			return err
+		}
+
+		// Finally, remove the txids of all justice transactions we've
+		// finalized for this channel.
+		txidsBkt := tx.Bucket(justiceTxidsBucket)
+		if txidsBkt == nil || txidsBkt.Bucket(chanBytes) == nil {
+			return nil
+		}
+
+		return txidsBkt.DeleteBucket(chanBytes)
 	})
 }
 
 // ForAll iterates through all stored retributions and executes the passed
 // callback function on each retribution.
 func (rs *retributionStore) ForAll(cb func(*retributionInfo) error) error {
-	return rs.db.View(func(tx *bbolt.Tx) error {
+	return rs.db.View(func(tx kvdb.Tx) error {
 		// If the bucket does not exist, then there are no pending
 		// retributions.
 		retBucket := tx.Bucket(retributionBucket)
@@ -1551,3 +1852,37 @@ func (bo *breachedOutput) Decode(r io.Reader) error {
 
 	return nil
 }
+
+// TODO(bvu): copied from channeldb, remove repetition
+func writeOutpoint(w io.Writer, o *wire.OutPoint) error {
+	// TODO(roasbeef): make all scratch buffers on the stack
+	scratch := make([]byte, 4)
+
+	// TODO(roasbeef): write raw 32 bytes instead of wasting the extra
+	// byte.
+	if err := wire.WriteVarBytes(w, 0, o.Hash[:]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch, o.Index)
+	_, err := w.Write(scratch)
+	return err
+}
+
+// TODO(bvu): copied from channeldb, remove repetition
+func readOutpoint(r io.Reader, o *wire.OutPoint) error {
+	scratch := make([]byte, 4)
+
+	txid, err := wire.ReadVarBytes(r, 0, 32, "prevout")
+	if err != nil {
+		return err
+	}
+	copy(o.Hash[:], txid)
+
+	if _, err := r.Read(scratch); err != nil {
+		return err
+	}
+	o.Index = byteOrder.Uint32(scratch)
+
+	return nil
+}
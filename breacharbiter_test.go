@@ -381,6 +381,15 @@ func (frs *failingRetributionStore) GetFinalizedTxn(
 	return frs.rs.GetFinalizedTxn(chanPoint)
 }
 
+func (frs *failingRetributionStore) JusticeTxids(
+	chanPoint *wire.OutPoint) (map[chainhash.Hash]struct{}, error) {
+
+	frs.mu.Lock()
+	defer frs.mu.Unlock()
+
+	return frs.rs.JusticeTxids(chanPoint)
+}
+
 func (frs *failingRetributionStore) Remove(key *wire.OutPoint) error {
 	frs.mu.Lock()
 	defer frs.mu.Unlock()
@@ -491,9 +500,10 @@ func copyRetInfo(retInfo *retributionInfo) *retributionInfo {
 // by an in-memory map. Access to the internal state is provided by a mutex.
 // TODO(cfromknecht) extend to support and test controlled failures.
 type mockRetributionStore struct {
-	mu       sync.Mutex
-	state    map[wire.OutPoint]*retributionInfo
-	finalTxs map[wire.OutPoint]*wire.MsgTx
+	mu         sync.Mutex
+	state      map[wire.OutPoint]*retributionInfo
+	finalTxs   map[wire.OutPoint]*wire.MsgTx
+	finalTxids map[wire.OutPoint]map[chainhash.Hash]struct{}
 }
 
 func newMockRetributionStore() *mockRetributionStore {
@@ -501,6 +511,9 @@ func newMockRetributionStore() *mockRetributionStore {
 		mu:       sync.Mutex{},
 		state:    make(map[wire.OutPoint]*retributionInfo),
 		finalTxs: make(map[wire.OutPoint]*wire.MsgTx),
+		finalTxids: make(
+			map[wire.OutPoint]map[chainhash.Hash]struct{},
+		),
 	}
 }
 
@@ -525,6 +538,10 @@ func (rs *mockRetributionStore) Finalize(chanPoint *wire.OutPoint,
 
 	rs.mu.Lock()
 	rs.finalTxs[*chanPoint] = finalTx
+	if rs.finalTxids[*chanPoint] == nil {
+		rs.finalTxids[*chanPoint] = make(map[chainhash.Hash]struct{})
+	}
+	rs.finalTxids[*chanPoint][finalTx.TxHash()] = struct{}{}
 	rs.mu.Unlock()
 
 	return nil
@@ -540,10 +557,25 @@ func (rs *mockRetributionStore) GetFinalizedTxn(
 	return finalTx, nil
 }
 
+func (rs *mockRetributionStore) JusticeTxids(
+	chanPoint *wire.OutPoint) (map[chainhash.Hash]struct{}, error) {
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	justiceTxids := make(map[chainhash.Hash]struct{})
+	for txid := range rs.finalTxids[*chanPoint] {
+		justiceTxids[txid] = struct{}{}
+	}
+
+	return justiceTxids, nil
+}
+
 func (rs *mockRetributionStore) Remove(key *wire.OutPoint) error {
 	rs.mu.Lock()
 	delete(rs.state, *key)
 	delete(rs.finalTxs, *key)
+	delete(rs.finalTxids, *key)
 	rs.mu.Unlock()
 
 	return nil
@@ -586,6 +618,10 @@ var retributionStoreTestSuite = []struct {
 		"RemoveEmpty",
 		testRetributionStoreRemoveEmpty,
 	},
+	{
+		"JusticeTxids",
+		testRetributionStoreJusticeTxids,
+	},
 }
 
 // TestMockRetributionStore instantiates a mockRetributionStore and tests its
@@ -762,6 +798,64 @@ func testRetributionStoreOverwrite(frs FailingRetributionStore, t *testing.T) {
 	}
 }
 
+// testRetributionStoreJusticeTxids ensures that the txids of all finalized
+// justice transactions are remembered across restarts, and are removed along
+// with the retribution.
+func testRetributionStoreJusticeTxids(frs FailingRetributionStore,
+	t *testing.T) {
+
+	retInfo := retributions[0]
+	if err := frs.Add(&retInfo); err != nil {
+		t.Fatalf("unable to add retribution to store: %v", err)
+	}
+
+	// Finalize two justice transactions that only differ in their fee,
+	// as is the case when the justice transaction is rebroadcast.
+	finalTxs := make([]*wire.MsgTx, 2)
+	for i := range finalTxs {
+		finalTx := wire.NewMsgTx(2)
+		finalTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: retInfo.breachedOutputs[0].outpoint,
+		})
+		finalTx.AddTxOut(&wire.TxOut{Value: int64(1000 - i)})
+
+		err := frs.Finalize(&retInfo.chanPoint, finalTx)
+		if err != nil {
+			t.Fatalf("unable to finalize justice tx: %v", err)
+		}
+		finalTxs[i] = finalTx
+	}
+
+	frs.Restart()
+
+	justiceTxids, err := frs.JusticeTxids(&retInfo.chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch justice txids: %v", err)
+	}
+	if len(justiceTxids) != len(finalTxs) {
+		t.Fatalf("expected %v justice txids, found %v",
+			len(finalTxs), len(justiceTxids))
+	}
+	for _, finalTx := range finalTxs {
+		if _, ok := justiceTxids[finalTx.TxHash()]; !ok {
+			t.Fatalf("justice txid %v not found", finalTx.TxHash())
+		}
+	}
+
+	if err := frs.Remove(&retInfo.chanPoint); err != nil {
+		t.Fatalf("unable to remove retribution: %v", err)
+	}
+
+	justiceTxids, err = frs.JusticeTxids(&retInfo.chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch justice txids: %v", err)
+	}
+	if len(justiceTxids) != 0 {
+		t.Fatalf("expected no justice txids, found %v",
+			len(justiceTxids))
+	}
+}
+
 // testRetributionStoreAdds adds all of the test retributions to the database,
 // ensuring that the total number of elements increases by exactly 1 after each
 // operation.  If the `failing` flag is provide, the test will restart the
@@ -1157,9 +1251,6 @@ func TestBreachHandoffFail(t *testing.T) {
 	assertArbiterBreach(t, brar, chanPoint)
 }
 
-type publAssertion func(*testing.T, map[wire.OutPoint]*wire.MsgTx,
-	chan *wire.MsgTx)
-
 type breachTest struct {
 	name string
 
@@ -1167,18 +1258,6 @@ type breachTest struct {
 	// if by a remote party or watchtower. The outpoint of the second level
 	// htlc is in effect "readded" to the set of inputs.
 	spend2ndLevel bool
-
-	// sendFinalConf informs the test to send a confirmation for the justice
-	// transaction before asserting the arbiter is cleaned up.
-	sendFinalConf bool
-
-	// whenNonZeroInputs is called after spending an input but there are
-	// further inputs to spend in the test.
-	whenNonZeroInputs publAssertion
-
-	// whenZeroInputs is called after spending an input but there are no
-	// further inputs to spend in the test.
-	whenZeroInputs publAssertion
 }
 
 var (
@@ -1207,102 +1286,16 @@ var breachTests = []breachTest{
 	{
 		name:          "all spends",
 		spend2ndLevel: true,
-		whenNonZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			var tx *wire.MsgTx
-			select {
-			case tx = <-publTx:
-			case <-time.After(5 * time.Second):
-				t.Fatalf("tx was not published")
-			}
-
-			// The justice transaction should have thee same number
-			// of inputs as we are tracking in the test.
-			if len(tx.TxIn) != len(inputs) {
-				t.Fatalf("expected justice txn to have %d "+
-					"inputs, found %d", len(inputs),
-					len(tx.TxIn))
-			}
-
-			// Ensure that each input exists on the justice
-			// transaction.
-			for in := range inputs {
-				findInputIndex(t, in, tx)
-			}
-
-		},
-		whenZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			// Sanity check to ensure the brar doesn't try to
-			// broadcast another sweep, since all outputs have been
-			// spent externally.
-			select {
-			case <-publTx:
-				t.Fatalf("tx published unexpectedly")
-			case <-time.After(50 * time.Millisecond):
-			}
-		},
 	},
 	{
 		name:          "commit spends, second level sweep",
 		spend2ndLevel: false,
-		sendFinalConf: true,
-		whenNonZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			select {
-			case <-publTx:
-			case <-time.After(5 * time.Second):
-				t.Fatalf("tx was not published")
-			}
-		},
-		whenZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			// Now a transaction attempting to spend from the second
-			// level tx should be published instead. Let this
-			// publish succeed by setting the publishing error to
-			// nil.
-			var tx *wire.MsgTx
-			select {
-			case tx = <-publTx:
-			case <-time.After(5 * time.Second):
-				t.Fatalf("tx was not published")
-			}
-
-			// The commitment outputs should be gone, and there
-			// should only be a single htlc spend.
-			if len(tx.TxIn) != 1 {
-				t.Fatalf("expect 1 htlc output, found %d "+
-					"outputs", len(tx.TxIn))
-			}
-
-			// The remaining TxIn  previously attempting to spend
-			// the HTLC outpoint should now be spending from the
-			// second level tx.
-			//
-			// NOTE: Commitment outputs and htlc sweeps are spent
-			// with a different transactions (and thus txids),
-			// ensuring we aren't mistaking this for a different
-			// output type.
-			onlyInput := tx.TxIn[0].PreviousOutPoint.Hash
-			if onlyInput != htlc2ndLevlTx.TxHash() {
-				t.Fatalf("tx not attempting to spend second "+
-					"level tx, %v", tx.TxIn[0])
-			}
-		},
 	},
 }
 
 // TestBreachSpends checks the behavior of the breach arbiter in response to
 // spend events on a channels outputs by asserting that it properly removes or
-// modifies the inputs from the justice txn.
+// modifies the inputs from the justice txns.
 func TestBreachSpends(t *testing.T) {
 	for _, test := range breachTests {
 		tc := test
@@ -1319,9 +1312,7 @@ func testBreachSpends(t *testing.T, test breachTest) {
 	defer cleanUpArb()
 
 	var (
-		height       = bobClose.ChanSnapshot.CommitHeight
 		forceCloseTx = bobClose.CloseTx
-		chanPoint    = alice.ChanPoint
 		publTx       = make(chan *wire.MsgTx)
 		publErr      error
 		publMtx      sync.Mutex
@@ -1337,6 +1328,269 @@ func testBreachSpends(t *testing.T, test breachTest) {
 		return publErr
 	}
 
+	retribution := notifyBreach(t, brar, alice, bobClose, contractBreaches)
+
+	// Notify that the breaching transaction is confirmed, to trigger the
+	// retribution logic.
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+
+	localOutpoint := retribution.LocalOutpoint
+	remoteOutpoint := retribution.RemoteOutpoint
+	htlcOutpoint := retribution.HtlcRetributions[0].OutPoint
+
+	// The breach arbiter tracks the outputs that remain to be swept, and
+	// whether they are HTLC outputs.
+	remaining := map[wire.OutPoint]bool{
+		localOutpoint:  false,
+		remoteOutpoint: false,
+		htlcOutpoint:   true,
+	}
+
+	// The breach arbiter should attempt to sweep all outputs on the
+	// breached commitment. As the publication fails, it should then try to
+	// sweep the commitment and HTLC outputs separately.
+	txs := assertJusticeTxs(t, publTx, remaining, true)
+
+	// All outputs should initially spend from the force closed txn.
+	forceTxID := forceCloseTx.TxHash()
+	for _, tx := range txs {
+		for _, txIn := range tx.TxIn {
+			if txIn.PreviousOutPoint.Hash != forceTxID {
+				t.Fatalf("og justice tx not spending " +
+					"commitment")
+			}
+		}
+	}
+
+	// Construct a map from outpoint on the force close to the transaction
+	// we want it to be spent by. As the test progresses, this map will be
+	// updated to contain only the set of commitment or second level
+	// outpoints that remain to be spent.
+	inputs := map[wire.OutPoint]*wire.MsgTx{
+		htlcOutpoint:   htlc2ndLevlTx,
+		localOutpoint:  commitSpendTx,
+		remoteOutpoint: commitSpendTx,
+	}
+
+	// Until no more inputs to spend remain, deliver the spend events and
+	// assert the justice transactions that are published in response.
+	for len(inputs) > 0 {
+		var (
+			op      wire.OutPoint
+			spendTx *wire.MsgTx
+		)
+
+		// Pick an outpoint at random from the set of inputs.
+		for op, spendTx = range inputs {
+			delete(inputs, op)
+			break
+		}
+
+		// Deliver the spend notification for the chosen transaction.
+		notifier.Spend(&op, 2, spendTx)
+		delete(remaining, op)
+
+		// When the second layer transfer is detected, the breach
+		// arbiter should continue with the output of the second layer
+		// tx. Add it back to the inputs so that we can spend it again
+		// if the test requests this behavior.
+		spendTxID := spendTx.TxHash()
+		if spendTxID == htlc2ndLevlTx.TxHash() {
+			// Create the second level outpoint that will be spent,
+			// the index is always zero for these 1-in-1-out txns.
+			spendOp := wire.OutPoint{Hash: spendTxID}
+			remaining[spendOp] = true
+
+			if test.spend2ndLevel {
+				inputs[spendOp] = htlcSpendTx
+			}
+		}
+
+		// If the test has no further inputs to spend, let any
+		// publication made by the breach arbiter succeed.
+		if len(inputs) == 0 {
+			publMtx.Lock()
+			publErr = nil
+			publMtx.Unlock()
+		}
+
+		// As long as outputs remain, the breach arbiter should publish
+		// new justice transactions sweeping them.
+		if len(remaining) > 0 {
+			txs = assertJusticeTxs(t, publTx, remaining, len(inputs) > 0)
+			continue
+		}
+
+		// Sanity check to ensure the brar doesn't try to broadcast
+		// another sweep, since all outputs have been spent.
+		select {
+		case <-publTx:
+			t.Fatalf("tx published unexpectedly")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// If the second level output wasn't spent externally, the breach
+	// arbiter's last justice transaction is sweeping it. Confirm the sweep.
+	for op := range remaining {
+		if len(txs) != 1 {
+			t.Fatalf("expected a single justice tx, got %v", len(txs))
+		}
+
+		notifier.Spend(&op, 3, txs[0])
+	}
+
+	// Assert that the channel is fully resolved.
+	assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
+}
+
+// TestBreachJusticeTxFeeBump asserts that the breach arbiter rebroadcasts the
+// justice transaction with a higher fee on every new block, and that the
+// progress of the retribution is sent to breach event subscribers.
+func TestBreachJusticeTxFeeBump(t *testing.T) {
+	brar, alice, _, bobClose, contractBreaches,
+		cleanUpChans, cleanUpArb := initBreachedState(t)
+	defer cleanUpChans()
+	defer cleanUpArb()
+
+	publTx := make(chan *wire.MsgTx)
+	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+		publTx <- tx
+		return nil
+	}
+
+	sub, err := brar.SubscribeBreachEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe to breach events: %v", err)
+	}
+	defer sub.Cancel()
+
+	retribution := notifyBreach(t, brar, alice, bobClose, contractBreaches)
+
+	remaining := map[wire.OutPoint]bool{
+		retribution.LocalOutpoint:                false,
+		retribution.RemoteOutpoint:               false,
+		retribution.HtlcRetributions[0].OutPoint: true,
+	}
+
+	assertEvent := func() interface{} {
+		t.Helper()
+
+		select {
+		case event := <-sub.Updates():
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatalf("breach event not received")
+		}
+
+		return nil
+	}
+
+	if _, ok := assertEvent().(BreachDetectedEvent); !ok {
+		t.Fatalf("expected breach detected event")
+	}
+
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+
+	// As the publication succeeds, a single justice transaction sweeping
+	// all outputs is broadcast. With every new block, it should be
+	// replaced by a justice transaction paying a higher fee.
+	var (
+		prevValue   int64
+		prevFeeRate lnwallet.SatPerKWeight
+	)
+	for i := 0; i < 3; i++ {
+		if i > 0 {
+			select {
+			case notifier.epochChan <- &chainntnfs.BlockEpoch{}:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block epoch not consumed")
+			}
+		}
+
+		txs := assertJusticeTxs(t, publTx, remaining, false)
+		value := txs[0].TxOut[0].Value
+
+		event, ok := assertEvent().(JusticeTxBroadcastEvent)
+		if !ok {
+			t.Fatalf("expected justice tx broadcast event")
+		}
+		if event.JusticeTxID != txs[0].TxHash() {
+			t.Fatalf("unexpected justice txid in event")
+		}
+
+		if i > 0 {
+			if value >= prevValue {
+				t.Fatalf("expected swept value to decrease, "+
+					"got %v after %v", value, prevValue)
+			}
+			if event.FeeRate <= prevFeeRate {
+				t.Fatalf("expected fee rate to increase, "+
+					"got %v after %v", event.FeeRate,
+					prevFeeRate)
+			}
+		}
+
+		prevValue = value
+		prevFeeRate = event.FeeRate
+	}
+}
+
+// TestNextJusticeFeeRate asserts that the fee rate of the justice
+// transactions follows the estimate, while increasing on every rebroadcast
+// up to the configured maximum.
+func TestNextJusticeFeeRate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		estimate lnwallet.SatPerKWeight
+		prev     lnwallet.SatPerKWeight
+		max      lnwallet.SatPerKWeight
+		expected lnwallet.SatPerKWeight
+	}{
+		// The first broadcast uses the estimate.
+		{estimate: 1000, prev: 0, expected: 1000},
+
+		// If the estimate didn't rise enough, the fee rate is bumped.
+		{estimate: 1000, prev: 1000, expected: 1250},
+		{estimate: 500, prev: 1000, expected: 1250},
+
+		// If the estimate rose more than the bump, it's used instead.
+		{estimate: 2000, prev: 1000, expected: 2000},
+
+		// Neither the estimate nor the bump exceed the maximum.
+		{estimate: 3000, prev: 0, max: 2000, expected: 2000},
+		{estimate: 1000, prev: 1800, max: 2000, expected: 2000},
+		{estimate: 1000, prev: 2000, max: 2000, expected: 2000},
+	}
+
+	for _, test := range testCases {
+		feeRate := nextJusticeFeeRate(
+			test.estimate, test.prev, test.max,
+		)
+		if feeRate != test.expected {
+			t.Fatalf("estimate=%v, prev=%v, max=%v: expected %v, "+
+				"got %v", test.estimate, test.prev, test.max,
+				test.expected, feeRate)
+		}
+	}
+}
+
+// notifyBreach hands off a breach of alice's channel to the breach arbiter,
+// and marks the channel as pending closed. The breach retribution is
+// returned.
+func notifyBreach(t *testing.T, brar *breachArbiter,
+	alice *lnwallet.LightningChannel,
+	bobClose *lnwallet.LocalForceCloseSummary,
+	contractBreaches chan *ContractBreachEvent) *lnwallet.BreachRetribution {
+
+	t.Helper()
+
+	height := bobClose.ChanSnapshot.CommitHeight
+	chanPoint := alice.ChanPoint
+
 	// Notify the breach arbiter about the breach.
 	retribution, err := lnwallet.NewBreachRetribution(
 		alice.State(), height, 1)
@@ -1387,90 +1641,60 @@ func testBreachSpends(t *testing.T, test breachTest) {
 	// the breach arbiter won't be able to fully close it.
 	assertPendingClosed(t, alice)
 
-	// Notify that the breaching transaction is confirmed, to trigger the
-	// retribution logic.
-	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
-	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+	return retribution
+}
 
-	// The breach arbiter should attempt to sweep all outputs on the
-	// breached commitment. We'll pretend that the HTLC output has been
-	// spent by the channel counter party's second level tx already.
-	var tx *wire.MsgTx
-	select {
-	case tx = <-publTx:
-	case <-time.After(5 * time.Second):
-		t.Fatalf("tx was not published")
-	}
+// assertJusticeTxs asserts that the breach arbiter publishes justice
+// transactions sweeping the remaining outputs, which map to whether they are
+// HTLC outputs. If the publication fails and both commitment and HTLC outputs
+// remain, the transaction sweeping all outputs should be followed by separate
+// transactions for the commitment and HTLC outputs. The published
+// transactions are returned.
+func assertJusticeTxs(t *testing.T, publTx chan *wire.MsgTx,
+	remaining map[wire.OutPoint]bool, publFails bool) []*wire.MsgTx {
 
-	// All outputs should initially spend from the force closed txn.
-	forceTxID := forceCloseTx.TxHash()
-	for _, txIn := range tx.TxIn {
-		if txIn.PreviousOutPoint.Hash != forceTxID {
-			t.Fatalf("og justice tx not spending commitment")
+	t.Helper()
+
+	commitOuts := make(map[wire.OutPoint]bool)
+	htlcOuts := make(map[wire.OutPoint]bool)
+	for op, isHtlc := range remaining {
+		if isHtlc {
+			htlcOuts[op] = true
+		} else {
+			commitOuts[op] = true
 		}
 	}
 
-	localOutpoint := retribution.LocalOutpoint
-	remoteOutpoint := retribution.RemoteOutpoint
-	htlcOutpoint := retribution.HtlcRetributions[0].OutPoint
-
-	// Construct a map from outpoint on the force close to the transaction
-	// we want it to be spent by. As the test progresses, this map will be
-	// updated to contain only the set of commitment or second level
-	// outpoints that remain to be spent.
-	inputs := map[wire.OutPoint]*wire.MsgTx{
-		htlcOutpoint:   htlc2ndLevlTx,
-		localOutpoint:  commitSpendTx,
-		remoteOutpoint: commitSpendTx,
+	expected := []map[wire.OutPoint]bool{remaining}
+	if publFails && len(commitOuts) > 0 && len(htlcOuts) > 0 {
+		expected = append(expected, commitOuts, htlcOuts)
 	}
 
-	// Until no more inputs to spend remain, deliver the spend events and
-	// process the assertions prescribed by the test case.
-	for len(inputs) > 0 {
-		var (
-			op      wire.OutPoint
-			spendTx *wire.MsgTx
-		)
-
-		// Pick an outpoint at random from the set of inputs.
-		for op, spendTx = range inputs {
-			delete(inputs, op)
-			break
+	var txs []*wire.MsgTx
+	for _, outs := range expected {
+		var tx *wire.MsgTx
+		select {
+		case tx = <-publTx:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("tx was not published")
 		}
 
-		// Deliver the spend notification for the chosen transaction.
-		notifier.Spend(&op, 2, spendTx)
-
-		// When the second layer transfer is detected, add back the
-		// outpoint of the second layer tx so that we can spend it
-		// again. Only do so if the test requests this behavior.
-		spendTxID := spendTx.TxHash()
-		if test.spend2ndLevel && spendTxID == htlc2ndLevlTx.TxHash() {
-			// Create the second level outpoint that will be spent,
-			// the index is always zero for these 1-in-1-out txns.
-			spendOp := wire.OutPoint{Hash: spendTxID}
-			inputs[spendOp] = htlcSpendTx
+		// The justice transaction should have the same number of
+		// inputs as we expect it to sweep.
+		if len(tx.TxIn) != len(outs) {
+			t.Fatalf("expected justice txn to have %d inputs, "+
+				"found %d", len(outs), len(tx.TxIn))
 		}
 
-		if len(inputs) > 0 {
-			test.whenNonZeroInputs(t, inputs, publTx)
-		} else {
-			// Reset the publishing error so that any publication,
-			// made by the breach arbiter, if any, will succeed.
-			publMtx.Lock()
-			publErr = nil
-			publMtx.Unlock()
-			test.whenZeroInputs(t, inputs, publTx)
+		// Ensure that each input exists on the justice transaction.
+		for op := range outs {
+			findInputIndex(t, op, tx)
 		}
-	}
 
-	// Deliver confirmation of sweep if the test expects it.
-	if test.sendFinalConf {
-		notifier.confChannel <- &chainntnfs.TxConfirmation{}
+		txs = append(txs, tx)
 	}
 
-	// Assert that the channel is fully resolved.
-	assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
+	return txs
 }
 
 // findInputIndex returns the index of the input that spends from the given
@@ -0,0 +1,141 @@
+package brontide
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/wakiyamap/lnd/pool"
+)
+
+const (
+	// benchMsgSize is the size of the messages written and read by the
+	// benchmarks, which is in the range of a typical gossip message.
+	benchMsgSize = 256
+
+	// benchBatchSize is the number of messages that are flushed at once
+	// by the batched benchmarks.
+	benchBatchSize = 64
+)
+
+// establishBenchConnection establishes a brontide connection for the
+// benchmarks, returning the local and remote side of the connection.
+func establishBenchConnection(b *testing.B) (*Conn, *Conn, func()) {
+	localConn, remoteConn, cleanUp, err := establishTestConnection()
+	if err != nil {
+		b.Fatalf("unable to establish test connection: %v", err)
+	}
+
+	return localConn.(*Conn), remoteConn.(*Conn), cleanUp
+}
+
+// benchmarkWriteMessage benchmarks writing messages to a brontide connection,
+// flushing them to the wire after every batchSize messages.
+func benchmarkWriteMessage(b *testing.B, batchSize int) {
+	localConn, remoteConn, cleanUp := establishBenchConnection(b)
+	defer cleanUp()
+
+	// Drain everything written on the other side of the connection, such
+	// that our writes never block.
+	go io.Copy(ioutil.Discard, remoteConn.conn)
+
+	msg := make([]byte, benchMsgSize)
+
+	b.SetBytes(benchMsgSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := localConn.WriteMessage(msg); err != nil {
+			b.Fatalf("unable to write message: %v", err)
+		}
+
+		if (i+1)%batchSize != 0 && i != b.N-1 {
+			continue
+		}
+		if _, err := localConn.Flush(); err != nil {
+			b.Fatalf("unable to flush messages: %v", err)
+		}
+	}
+}
+
+// BenchmarkWriteMessageFlushEach benchmarks writing messages to a brontide
+// connection, flushing each message with a separate write.
+func BenchmarkWriteMessageFlushEach(b *testing.B) {
+	benchmarkWriteMessage(b, 1)
+}
+
+// BenchmarkWriteMessageBatched benchmarks writing messages to a brontide
+// connection, flushing batches of messages with a single write.
+func BenchmarkWriteMessageBatched(b *testing.B) {
+	benchmarkWriteMessage(b, benchBatchSize)
+}
+
+// benchmarkReadMessage benchmarks reading messages from a brontide
+// connection, either reading the message bodies into freshly allocated
+// buffers, or into buffers taken from a read buffer pool.
+func benchmarkReadMessage(b *testing.B, pooled bool) {
+	localConn, remoteConn, cleanUp := establishBenchConnection(b)
+	defer cleanUp()
+
+	if pooled {
+		readBufPool := pool.NewReadBuffer(
+			pool.DefaultReadBufferGCInterval,
+			pool.DefaultReadBufferExpiryInterval,
+		)
+		localConn.SetBufferPools(nil, readBufPool)
+	}
+
+	// Write all messages to be read from a separate goroutine, batching
+	// the writes such that the writer doesn't limit the read throughput.
+	writeErrs := make(chan error, 1)
+	go func() {
+		msg := make([]byte, benchMsgSize)
+		for i := 0; i < b.N; i++ {
+			if err := remoteConn.WriteMessage(msg); err != nil {
+				writeErrs <- err
+				return
+			}
+
+			if (i+1)%benchBatchSize != 0 && i != b.N-1 {
+				continue
+			}
+			if _, err := remoteConn.Flush(); err != nil {
+				writeErrs <- err
+				return
+			}
+		}
+		writeErrs <- nil
+	}()
+
+	b.SetBytes(benchMsgSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		msg, err := localConn.ReadNextMessage()
+		if err != nil {
+			b.Fatalf("unable to read message: %v", err)
+		}
+		if len(msg) != benchMsgSize {
+			b.Fatalf("expected message of size %v, got %v",
+				benchMsgSize, len(msg))
+		}
+	}
+
+	if err := <-writeErrs; err != nil {
+		b.Fatalf("unable to write messages: %v", err)
+	}
+}
+
+// BenchmarkReadMessageUnpooled benchmarks reading messages into buffers that
+// are allocated for each message.
+func BenchmarkReadMessageUnpooled(b *testing.B) {
+	benchmarkReadMessage(b, false)
+}
+
+// BenchmarkReadMessagePooled benchmarks reading messages into buffers taken
+// from a read buffer pool.
+func BenchmarkReadMessagePooled(b *testing.B) {
+	benchmarkReadMessage(b, true)
+}
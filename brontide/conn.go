@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/pool"
 )
 
 // Conn is an implementation of net.Conn which enforces an authenticated key
@@ -25,6 +27,28 @@ type Conn struct {
 	readBuf bytes.Buffer
 }
 
+// newConn creates a new Conn that encrypts and decrypts all messages sent over
+// the passed connection using the brontide Machine.
+func newConn(conn net.Conn, noise *Machine) *Conn {
+	return &Conn{
+		conn:  conn,
+		noise: noise,
+	}
+}
+
+// SetBufferPools sets the pools the buffers used to write and read messages
+// are taken from. The buffers are returned to the pools after each flush or
+// read, such that idle connections don't hold on to any. If no pools are set,
+// the buffers are allocated as needed instead.
+//
+// NOTE: This MUST be called before any messages are written or read.
+func (c *Conn) SetBufferPools(writeBufPool *pool.WriteBuffer,
+	readBufPool *pool.ReadBuffer) {
+
+	c.noise.writeBufPool = writeBufPool
+	c.noise.readBufPool = readBufPool
+}
+
 // A compile-time assertion to ensure that Conn meets the net.Conn interface.
 var _ net.Conn = (*Conn)(nil)
 
@@ -32,7 +56,7 @@ var _ net.Conn = (*Conn)(nil)
 // remote peer located at address which has remotePub as its long-term static
 // public key. In the case of a handshake failure, the connection is closed and
 // a non-nil error is returned.
-func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
+func Dial(local keychain.SingleKeyECDH, netAddr *lnwire.NetAddress,
 	dialer func(string, string) (net.Conn, error)) (*Conn, error) {
 	ipAddr := netAddr.Address.String()
 	var conn net.Conn
@@ -42,10 +66,9 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 		return nil, err
 	}
 
-	b := &Conn{
-		conn:  conn,
-		noise: NewBrontideMachine(true, localPriv, netAddr.IdentityKey),
-	}
+	b := newConn(
+		conn, NewBrontideMachine(true, local, netAddr.IdentityKey),
+	)
 
 	// Initiate the handshake by sending the first act to the receiver.
 	actOne, err := b.noise.GenActOne()
@@ -173,34 +196,47 @@ func (c *Conn) Write(b []byte) (n int, err error) {
 		return c.noise.Flush(c.conn)
 	}
 
-	// If we need to split the message into fragments, then we'll write
-	// chunks which maximize usage of the available payload.
+	// If we need to split the message into fragments, then we'll buffer
+	// chunks which maximize usage of the available payload, and write them
+	// out together once no more chunks can be buffered.
 	chunkSize := math.MaxUint16
 
 	bytesToWrite := len(b)
+	bytesBuffered := 0
 	bytesWritten := 0
-	for bytesWritten < bytesToWrite {
+	for bytesBuffered < bytesToWrite {
 		// If we're on the last chunk, then truncate the chunk size as
 		// necessary to avoid an out-of-bounds array memory access.
-		if bytesWritten+chunkSize > len(b) {
-			chunkSize = len(b) - bytesWritten
+		if bytesBuffered+chunkSize > len(b) {
+			chunkSize = len(b) - bytesBuffered
 		}
 
-		// Slice off the next chunk to be written based on our running
+		// Slice off the next chunk to be buffered based on our running
 		// counter and next chunk size.
-		chunk := b[bytesWritten : bytesWritten+chunkSize]
-		if err := c.noise.WriteMessage(chunk); err != nil {
+		chunk := b[bytesBuffered : bytesBuffered+chunkSize]
+		err := c.noise.WriteMessage(chunk)
+		switch {
+		// If the chunks buffered so far must be flushed first, we'll
+		// write them out before buffering the chunk again.
+		case err == ErrMessageNotFlushed:
+			n, err := c.noise.Flush(c.conn)
+			bytesWritten += n
+			if err != nil {
+				return bytesWritten, err
+			}
+			continue
+
+		case err != nil:
 			return bytesWritten, err
 		}
 
-		n, err := c.noise.Flush(c.conn)
-		bytesWritten += n
-		if err != nil {
-			return bytesWritten, err
-		}
+		bytesBuffered += chunkSize
 	}
 
-	return bytesWritten, nil
+	n, err = c.noise.Flush(c.conn)
+	bytesWritten += n
+
+	return bytesWritten, err
 }
 
 // WriteMessage encrypts and buffers the next message p for the connection. The
@@ -5,14 +5,20 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/keychain"
 )
 
-// defaultHandshakes is the maximum number of handshakes that can be done in
-// parallel.
-const defaultHandshakes = 1000
+// DefaultMaxPendingHandshakes is the default maximum number of handshakes
+// that can be done in parallel.
+const DefaultMaxPendingHandshakes = 1000
+
+// ErrTooManyPendingHandshakes is returned when a connection is rejected
+// because its host has too many handshakes pending already.
+var ErrTooManyPendingHandshakes = errors.New("too many pending handshakes " +
+	"from host")
 
 // Listener is an implementation of a net.Conn which executes an authenticated
 // key exchange and message encryption protocol dubbed "Machine" after
@@ -20,22 +26,85 @@ const defaultHandshakes = 1000
 // details w.r.t the handshake and encryption scheme used within the
 // connection.
 type Listener struct {
-	localStatic *btcec.PrivateKey
+	localStatic keychain.SingleKeyECDH
 
 	tcp *net.TCPListener
 
 	handshakeSema chan struct{}
 	conns         chan maybeConn
 	quit          chan struct{}
+
+	// maxHandshakes is the maximum number of handshakes that can be done
+	// in parallel.
+	maxHandshakes int
+
+	// maxHandshakesPerHost is the maximum number of handshakes that can
+	// be done in parallel with a single host. Zero means no limit.
+	maxHandshakesPerHost int
+
+	// hostLimitExempt, if set, is consulted for each accepted connection
+	// to determine whether it's exempt from maxHandshakesPerHost.
+	hostLimitExempt func(net.Addr) bool
+
+	// connFilter, if set, is consulted for each accepted connection
+	// before its handshake is started. If it returns an error, the
+	// connection is rejected.
+	connFilter func(net.Addr) error
+
+	// pendingHandshakes tracks the number of handshakes in progress per
+	// host.
+	pendingHandshakes map[string]int
+	pendingMtx        sync.Mutex
+}
+
+// MaxPendingHandshakes is a functional option that sets the maximum number of
+// handshakes the Listener does in parallel.
+func MaxPendingHandshakes(n int) func(*Listener) {
+	return func(l *Listener) {
+		l.maxHandshakes = n
+	}
+}
+
+// MaxPendingHandshakesPerHost is a functional option that sets the maximum
+// number of handshakes the Listener does in parallel with a single host. Any
+// further connections from the host are rejected until one of its handshakes
+// completes. A value of zero means no limit.
+func MaxPendingHandshakesPerHost(n int) func(*Listener) {
+	return func(l *Listener) {
+		l.maxHandshakesPerHost = n
+	}
+}
+
+// HostLimitExempt is a functional option that sets a function which determines
+// whether a connection, identified by its remote address, is exempt from the
+// maximum number of handshakes per host. This allows connections that don't
+// originate from the host they appear to come from, such as connections relayed
+// by a local proxy, to not count against a single, shared limit.
+func HostLimitExempt(exempt func(net.Addr) bool) func(*Listener) {
+	return func(l *Listener) {
+		l.hostLimitExempt = exempt
+	}
+}
+
+// ConnFilter is a functional option that sets a filter which is consulted for
+// the remote address of each accepted connection before its handshake is
+// started. If the filter returns an error, the connection is rejected.
+func ConnFilter(filter func(net.Addr) error) func(*Listener) {
+	return func(l *Listener) {
+		l.connFilter = filter
+	}
 }
 
 // A compile-time assertion to ensure that Conn meets the net.Listener interface.
 var _ net.Listener = (*Listener)(nil)
 
 // NewListener returns a new net.Listener which enforces the Brontide scheme
-// during both initial connection establishment and data transfer.
-func NewListener(localStatic *btcec.PrivateKey, listenAddr string) (*Listener,
-	error) {
+// during both initial connection establishment and data transfer. The last
+// parameter is a set of variadic arguments for adding additional options to
+// the Listener.
+func NewListener(localStatic keychain.SingleKeyECDH, listenAddr string,
+	options ...func(*Listener)) (*Listener, error) {
+
 	addr, err := net.ResolveTCPAddr("tcp", listenAddr)
 	if err != nil {
 		return nil, err
@@ -47,14 +116,23 @@ func NewListener(localStatic *btcec.PrivateKey, listenAddr string) (*Listener,
 	}
 
 	brontideListener := &Listener{
-		localStatic:   localStatic,
-		tcp:           l,
-		handshakeSema: make(chan struct{}, defaultHandshakes),
-		conns:         make(chan maybeConn),
-		quit:          make(chan struct{}),
+		localStatic:       localStatic,
+		tcp:               l,
+		conns:             make(chan maybeConn),
+		quit:              make(chan struct{}),
+		maxHandshakes:     DefaultMaxPendingHandshakes,
+		pendingHandshakes: make(map[string]int),
+	}
+
+	// With the default options established, we'll now process all the
+	// options passed in as parameters.
+	for _, option := range options {
+		option(brontideListener)
 	}
 
-	for i := 0; i < defaultHandshakes; i++ {
+	maxHandshakes := brontideListener.maxHandshakes
+	brontideListener.handshakeSema = make(chan struct{}, maxHandshakes)
+	for i := 0; i < maxHandshakes; i++ {
 		brontideListener.handshakeSema <- struct{}{}
 	}
 
@@ -65,7 +143,8 @@ func NewListener(localStatic *btcec.PrivateKey, listenAddr string) (*Listener,
 
 // listen accepts connection from the underlying tcp conn, then performs
 // the brontinde handshake procedure asynchronously. A maximum of
-// defaultHandshakes will be active at any given time.
+// maxHandshakes will be active at any given time, and at most
+// maxHandshakesPerHost with a single host.
 //
 // NOTE: This method must be run as a goroutine.
 func (l *Listener) listen() {
@@ -83,7 +162,67 @@ func (l *Listener) listen() {
 			continue
 		}
 
-		go l.doHandshake(conn)
+		// Before spending any resources on the handshake, we'll make
+		// sure the connection passes the filter, and that its host
+		// doesn't have too many handshakes pending already.
+		host, err := l.admitConn(conn)
+		if err != nil {
+			remoteAddr := conn.RemoteAddr().String()
+			conn.Close()
+			l.rejectConn(rejectedConnErr(err, remoteAddr))
+			l.handshakeSema <- struct{}{}
+			continue
+		}
+
+		go l.doHandshake(conn, host)
+	}
+}
+
+// connHost returns the host a connection originates from, which is its IP
+// address for TCP connections.
+func connHost(conn net.Conn) string {
+	if tcpAddr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		return tcpAddr.IP.String()
+	}
+
+	return conn.RemoteAddr().String()
+}
+
+// admitConn checks whether a handshake should be started for the accepted
+// connection. If so, a pending handshake is registered for the connection's
+// host, which is returned and must be released once the handshake is done.
+func (l *Listener) admitConn(conn net.Conn) (string, error) {
+	if l.connFilter != nil {
+		if err := l.connFilter(conn.RemoteAddr()); err != nil {
+			return "", err
+		}
+	}
+
+	host := connHost(conn)
+	exempt := l.hostLimitExempt != nil &&
+		l.hostLimitExempt(conn.RemoteAddr())
+
+	l.pendingMtx.Lock()
+	defer l.pendingMtx.Unlock()
+
+	if l.maxHandshakesPerHost > 0 && !exempt &&
+		l.pendingHandshakes[host] >= l.maxHandshakesPerHost {
+
+		return "", ErrTooManyPendingHandshakes
+	}
+	l.pendingHandshakes[host]++
+
+	return host, nil
+}
+
+// releaseHost releases a pending handshake of the given host.
+func (l *Listener) releaseHost(host string) {
+	l.pendingMtx.Lock()
+	defer l.pendingMtx.Unlock()
+
+	l.pendingHandshakes[host]--
+	if l.pendingHandshakes[host] <= 0 {
+		delete(l.pendingHandshakes, host)
 	}
 }
 
@@ -97,8 +236,11 @@ func rejectedConnErr(err error, remoteAddr string) error {
 // doHandshake asynchronously performs the brontide handshake, so that it does
 // not block the main accept loop. This prevents peers that delay writing to the
 // connection from block other connection attempts.
-func (l *Listener) doHandshake(conn net.Conn) {
-	defer func() { l.handshakeSema <- struct{}{} }()
+func (l *Listener) doHandshake(conn net.Conn, host string) {
+	defer func() {
+		l.releaseHost(host)
+		l.handshakeSema <- struct{}{}
+	}()
 
 	select {
 	case <-l.quit:
@@ -108,10 +250,9 @@ func (l *Listener) doHandshake(conn net.Conn) {
 
 	remoteAddr := conn.RemoteAddr().String()
 
-	brontideConn := &Conn{
-		conn:  conn,
-		noise: NewBrontideMachine(false, l.localStatic, nil),
-	}
+	brontideConn := newConn(
+		conn, NewBrontideMachine(false, l.localStatic, nil),
+	)
 
 	// We'll ensure that we get ActOne from the remote peer in a timely
 	// manner. If they don't respond within 1s, then we'll kill the
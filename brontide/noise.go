@@ -14,6 +14,9 @@ import (
 	"golang.org/x/crypto/hkdf"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/buffer"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/pool"
 )
 
 const (
@@ -44,6 +47,12 @@ const (
 	// the remote party fails to deliver the proper payload within this
 	// time frame, then we'll fail the connection.
 	handshakeReadTimeout = time.Second * 5
+
+	// maxSendBufferSize is the maximum number of ciphertext bytes that can
+	// be buffered using WriteMessage before they must be flushed, which is
+	// the size of the buffers of the write buffer pool. A single message
+	// is always accepted if nothing is buffered, regardless of its size.
+	maxSendBufferSize = buffer.WriteSize
 )
 
 var (
@@ -53,7 +62,7 @@ var (
 		"the max allowed message length of (2^16)-1")
 
 	// ErrMessageNotFlushed signals that the connection cannot accept a new
-	// message because the prior message has not been fully flushed.
+	// message because the messages buffered so far must be flushed first.
 	ErrMessageNotFlushed = errors.New("prior message not flushed")
 
 	// lightningPrologue is the noise prologue that is used to initialize
@@ -289,7 +298,7 @@ type handshakeState struct {
 
 	initiator bool
 
-	localStatic    *btcec.PrivateKey
+	localStatic    keychain.SingleKeyECDH
 	localEphemeral *btcec.PrivateKey
 
 	remoteStatic    *btcec.PublicKey
@@ -300,11 +309,12 @@ type handshakeState struct {
 // with the prologue and protocol name. If this is the responder's handshake
 // state, then the remotePub can be nil.
 func newHandshakeState(initiator bool, prologue []byte,
-	localPub *btcec.PrivateKey, remotePub *btcec.PublicKey) handshakeState {
+	localKey keychain.SingleKeyECDH,
+	remotePub *btcec.PublicKey) handshakeState {
 
 	h := handshakeState{
 		initiator:    initiator,
-		localStatic:  localPub,
+		localStatic:  localKey,
 		remoteStatic: remotePub,
 	}
 
@@ -322,7 +332,7 @@ func newHandshakeState(initiator bool, prologue []byte,
 	if initiator {
 		h.mixHash(remotePub.SerializeCompressed())
 	} else {
-		h.mixHash(localPub.PubKey().SerializeCompressed())
+		h.mixHash(localKey.PubKey().SerializeCompressed())
 	}
 
 	return h
@@ -376,15 +386,41 @@ type Machine struct {
 	// (of the next ciphertext), followed by a 16 byte MAC.
 	nextCipherHeader [encHeaderSize]byte
 
-	// nextHeaderSend holds a reference to the remaining header bytes to
-	// write out for a pending message. This allows us to tolerate timeout
-	// errors that cause partial writes.
-	nextHeaderSend []byte
-
-	// nextHeaderBody holds a reference to the remaining body bytes to write
-	// out for a pending message. This allows us to tolerate timeout errors
-	// that cause partial writes.
-	nextBodySend []byte
+	// sendBuf holds the ciphertext of all messages buffered using
+	// WriteMessage, which are written to the wire in a single call to
+	// Flush. Its storage is released once all messages have been flushed.
+	sendBuf []byte
+
+	// writeBufPool, if set, is the pool the storage of sendBuf is taken
+	// from, which avoids keeping a buffer around for each connection.
+	writeBufPool *pool.WriteBuffer
+
+	// sendStorage is the buffer taken from writeBufPool that backs
+	// sendBuf. It is returned to the pool once all buffered messages have
+	// been flushed.
+	sendStorage *buffer.Write
+
+	// readBufPool, if set, is the pool the buffers that message bodies are
+	// read into by ReadMessage are taken from.
+	readBufPool *pool.ReadBuffer
+
+	// sendOffset is the number of bytes of sendBuf that have already been
+	// written out. This allows us to tolerate timeout errors that cause
+	// partial writes.
+	sendOffset int
+
+	// sendFrames holds the payload lengths of the messages in sendBuf,
+	// such that Flush is able to report the number of plaintext bytes
+	// written, excluding the headers and MACs.
+	sendFrames []int
+
+	// frameIndex is the index within sendFrames of the first message that
+	// hasn't been fully written out yet.
+	frameIndex int
+
+	// frameOffset is the number of bytes of the message at frameIndex
+	// that have already been written out.
+	frameOffset int
 }
 
 // NewBrontideMachine creates a new instance of the brontide state-machine. If
@@ -393,11 +429,11 @@ type Machine struct {
 // string "lightning" as the prologue. The last parameter is a set of variadic
 // arguments for adding additional options to the brontide Machine
 // initialization.
-func NewBrontideMachine(initiator bool, localPub *btcec.PrivateKey,
+func NewBrontideMachine(initiator bool, localKey keychain.SingleKeyECDH,
 	remotePub *btcec.PublicKey, options ...func(*Machine)) *Machine {
 
 	handshake := newHandshakeState(
-		initiator, lightningPrologue, localPub, remotePub,
+		initiator, lightningPrologue, localKey, remotePub,
 	)
 
 	m := &Machine{
@@ -509,8 +545,11 @@ func (b *Machine) RecvActOne(actOne [ActOneSize]byte) error {
 	b.mixHash(b.remoteEphemeral.SerializeCompressed())
 
 	// es
-	s := ecdh(b.remoteEphemeral, b.localStatic)
-	b.mixKey(s)
+	s, err := b.localStatic.ECDH(b.remoteEphemeral)
+	if err != nil {
+		return err
+	}
+	b.mixKey(s[:])
 
 	// If the initiator doesn't know our static key, then this operation
 	// will fail.
@@ -601,8 +640,11 @@ func (b *Machine) GenActThree() ([ActThreeSize]byte, error) {
 	ourPubkey := b.localStatic.PubKey().SerializeCompressed()
 	ciphertext := b.EncryptAndHash(ourPubkey)
 
-	s := ecdh(b.remoteEphemeral, b.localStatic)
-	b.mixKey(s)
+	s, err := b.localStatic.ECDH(b.remoteEphemeral)
+	if err != nil {
+		return actThree, err
+	}
+	b.mixKey(s[:])
 
 	authPayload := b.EncryptAndHash([]byte{})
 
@@ -704,6 +746,11 @@ func (b *Machine) split() {
 // message is prepended with an encrypt+auth'd length which must be used as the
 // AD to the AEAD construction when being decrypted by the other side.
 //
+// Several messages can be buffered before flushing them, which allows them to
+// be written to the wire in a single write. If the buffered messages would
+// exceed the maximum buffer size, ErrMessageNotFlushed is returned, and the
+// message must be written again after the buffered ones have been flushed.
+//
 // NOTE: This DOES NOT write the message to the wire, it should be followed by a
 // call to Flush to ensure the message is written.
 func (b *Machine) WriteMessage(p []byte) error {
@@ -714,10 +761,9 @@ func (b *Machine) WriteMessage(p []byte) error {
 		return ErrMaxMessageLengthExceeded
 	}
 
-	// If a prior message was written but it hasn't been fully flushed,
-	// return an error as we only support buffering of one message at a
-	// time.
-	if len(b.nextHeaderSend) > 0 || len(b.nextBodySend) > 0 {
+	frameSize := encHeaderSize + len(p) + macSize
+	pending := len(b.sendBuf) - b.sendOffset
+	if pending > 0 && pending+frameSize > maxSendBufferSize {
 		return ErrMessageNotFlushed
 	}
 
@@ -728,88 +774,118 @@ func (b *Machine) WriteMessage(p []byte) error {
 	var pktLen [2]byte
 	binary.BigEndian.PutUint16(pktLen[:], fullLength)
 
-	// First, generate the encrypted+MAC'd length prefix for the packet.
-	b.nextHeaderSend = b.sendCipher.Encrypt(nil, nil, pktLen[:])
+	// If nothing is buffered yet, we'll take the storage for the send
+	// buffer from the pool, unless the message doesn't fit into a pooled
+	// buffer.
+	if len(b.sendBuf) == 0 && b.writeBufPool != nil &&
+		frameSize <= buffer.WriteSize {
 
-	// Finally, generate the encrypted packet itself.
-	b.nextBodySend = b.sendCipher.Encrypt(nil, nil, p)
+		b.sendStorage = b.writeBufPool.Take()
+		b.sendBuf = b.sendStorage[:0]
+	}
+
+	// First, append the encrypted+MAC'd length prefix for the packet to
+	// the send buffer, directly followed by the encrypted packet itself.
+	b.sendBuf = b.sendCipher.Encrypt(nil, b.sendBuf, pktLen[:])
+	b.sendBuf = b.sendCipher.Encrypt(nil, b.sendBuf, p)
+	b.sendFrames = append(b.sendFrames, len(p))
 
 	return nil
 }
 
-// Flush attempts to write a message buffered using WriteMessage to the provided
-// io.Writer. If no buffered message exists, this will result in a NOP.
-// Otherwise, it will continue to write the remaining bytes, picking up where
-// the byte stream left off in the event of a partial write. The number of bytes
-// returned reflects the number of plaintext bytes in the payload, and does not
-// account for the overhead of the header or MACs.
+// Flush attempts to write all messages buffered using WriteMessage to the
+// provided io.Writer in a single write. If no buffered message exists, this
+// will result in a NOP. Otherwise, it will continue to write the remaining
+// bytes, picking up where the byte stream left off in the event of a partial
+// write. The number of bytes returned reflects the number of plaintext bytes in
+// the payloads, and does not account for the overhead of the headers or MACs.
 //
 // NOTE: It is safe to call this method again iff a timeout error is returned.
 func (b *Machine) Flush(w io.Writer) (int, error) {
-	// First, write out the pending header bytes, if any exist. Any header
-	// bytes written will not count towards the total amount flushed.
-	if len(b.nextHeaderSend) > 0 {
-		// Write any remaining header bytes and shift the slice to point
-		// to the next segment of unwritten bytes. If an error is
-		// encountered, we can continue to write the header from where
-		// we left off on a subsequent call to Flush.
-		n, err := w.Write(b.nextHeaderSend)
-		b.nextHeaderSend = b.nextHeaderSend[n:]
-		if err != nil {
-			return 0, err
-		}
+	if b.sendOffset == len(b.sendBuf) {
+		return 0, nil
 	}
 
-	// Next, write the pending body bytes, if any exist. Only the number of
-	// bytes written that correspond to the ciphertext will be included in
-	// the total bytes written, bytes written as part of the MAC will not be
-	// counted.
-	var nn int
-	if len(b.nextBodySend) > 0 {
-		// Write out all bytes excluding the mac and shift the body
-		// slice depending on the number of actual bytes written.
-		n, err := w.Write(b.nextBodySend)
-		b.nextBodySend = b.nextBodySend[n:]
-
-		// If we partially or fully wrote any of the body's MAC, we'll
-		// subtract that contribution from the total amount flushed to
-		// preserve the abstraction of returning the number of plaintext
-		// bytes written by the connection.
-		//
-		// There are three possible scenarios we must handle to ensure
-		// the returned value is correct. In the first case, the write
-		// straddles both payload and MAC bytes, and we must subtract
-		// the number of MAC bytes written from n. In the second, only
-		// payload bytes are written, thus we can return n unmodified.
-		// The final scenario pertains to the case where only MAC bytes
-		// are written, none of which count towards the total.
+	// Write any remaining bytes and advance the offset to point to the
+	// next segment of unwritten bytes. If an error is encountered, we can
+	// continue to write from where we left off on a subsequent call to
+	// Flush.
+	n, err := w.Write(b.sendBuf[b.sendOffset:])
+	b.sendOffset += n
+
+	// Only the number of bytes written that correspond to the plaintext
+	// payloads will be included in the total bytes written.
+	nn := b.advanceFrames(n)
+
+	// Once everything has been written, we'll reset the send buffer and
+	// release its storage until the next messages are buffered.
+	if b.sendOffset == len(b.sendBuf) {
+		b.resetSendBuf()
+	}
+
+	return nn, err
+}
+
+// advanceFrames advances the write progress of the buffered messages by n
+// bytes, and returns the number of those bytes that correspond to the
+// plaintext payloads of the messages. Bytes of the encrypted headers and of
+// the MACs aren't counted.
+func (b *Machine) advanceFrames(n int) int {
+	var payloadBytes int
+	for n > 0 && b.frameIndex < len(b.sendFrames) {
+		payloadLen := b.sendFrames[b.frameIndex]
+		frameLen := encHeaderSize + payloadLen + macSize
+
+		// Determine the range of the current message that was written,
+		// which either ends at the end of the write or of the message.
+		start := b.frameOffset
+		end := start + n
+		if end > frameLen {
+			end = frameLen
+		}
+		n -= end - start
+
+		// Only count the part of the range that overlaps with the
+		// payload, which sits between the header and the MAC.
 		//
-		//                 |-----------Payload------------|----MAC----|
-		// Straddle:       S---------------------------------E--------0
-		// Payload-only:   S------------------------E-----------------0
-		// MAC-only:                                        S-------E-0
-		start, end := n+len(b.nextBodySend), len(b.nextBodySend)
-		switch {
-
-		// Straddles payload and MAC bytes, subtract number of MAC bytes
-		// written from the actual number written.
-		case start > macSize && end <= macSize:
-			nn = n - (macSize - end)
-
-		// Only payload bytes are written, return n directly.
-		case start > macSize && end > macSize:
-			nn = n
-
-		// Only MAC bytes are written, return 0 bytes written.
-		default:
+		//   |----Header----|-----------Payload------------|----MAC----|
+		//   0        encHeaderSize      encHeaderSize+payloadLen
+		payloadStart, payloadEnd := start, end
+		if payloadStart < encHeaderSize {
+			payloadStart = encHeaderSize
+		}
+		if payloadEnd > encHeaderSize+payloadLen {
+			payloadEnd = encHeaderSize + payloadLen
+		}
+		if payloadEnd > payloadStart {
+			payloadBytes += payloadEnd - payloadStart
 		}
 
-		if err != nil {
-			return nn, err
+		if end < frameLen {
+			b.frameOffset = end
+			continue
 		}
+
+		b.frameIndex++
+		b.frameOffset = 0
 	}
 
-	return nn, nil
+	return payloadBytes
+}
+
+// resetSendBuf resets the send buffer after all buffered messages have been
+// written, returning its storage to the write buffer pool if it was taken from
+// it.
+func (b *Machine) resetSendBuf() {
+	if b.sendStorage != nil {
+		b.writeBufPool.Return(b.sendStorage)
+		b.sendStorage = nil
+	}
+	b.sendBuf = nil
+	b.sendOffset = 0
+	b.sendFrames = b.sendFrames[:0]
+	b.frameIndex = 0
+	b.frameOffset = 0
 }
 
 // ReadMessage attempts to read the next message from the passed io.Reader. In
@@ -820,8 +896,16 @@ func (b *Machine) ReadMessage(r io.Reader) ([]byte, error) {
 		return nil, err
 	}
 
-	buf := make([]byte, pktLen)
-	return b.ReadBody(r, buf)
+	// The body is only needed until it has been decrypted, so we'll read
+	// it into a buffer from the pool if one is set.
+	if b.readBufPool == nil {
+		return b.ReadBody(r, make([]byte, pktLen))
+	}
+
+	buf := b.readBufPool.Take()
+	defer b.readBufPool.Return(buf)
+
+	return b.ReadBody(r, buf[:pktLen])
 }
 
 // ReadHeader attempts to read the next message header from the passed
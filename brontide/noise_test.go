@@ -3,15 +3,21 @@ package brontide
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"io"
+	"io/ioutil"
 	"math"
 	"net"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"testing/iotest"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/pool"
 )
 
 type maybeNetConn struct {
@@ -19,19 +25,22 @@ type maybeNetConn struct {
 	err  error
 }
 
-func makeListener() (*Listener, *lnwire.NetAddress, error) {
+func makeListener(options ...func(*Listener)) (*Listener,
+	*lnwire.NetAddress, error) {
+
 	// First, generate the long-term private keys for the brontide listener.
 	localPriv, err := btcec.NewPrivateKey(btcec.S256())
 	if err != nil {
 		return nil, nil, err
 	}
+	localKeyECDH := &keychain.PrivKeyECDH{PrivKey: localPriv}
 
 	// Having a port of ":0" means a random port, and interface will be
 	// chosen for our listener.
 	addr := "localhost:0"
 
 	// Our listener will be local, and the connection remote.
-	listener, err := NewListener(localPriv, addr)
+	listener, err := NewListener(localKeyECDH, addr, options...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,13 +66,14 @@ func establishTestConnection() (net.Conn, net.Conn, func(), error) {
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	remoteKeyECDH := &keychain.PrivKeyECDH{PrivKey: remotePriv}
 
 	// Initiate a connection with a separate goroutine, and listen with our
 	// main one. If both errors are nil, then encryption+auth was
 	// successful.
 	remoteConnChan := make(chan maybeNetConn, 1)
 	go func() {
-		remoteConn, err := Dial(remotePriv, netAddr, net.Dial)
+		remoteConn, err := Dial(remoteKeyECDH, netAddr, net.Dial)
 		remoteConnChan <- maybeNetConn{remoteConn, err}
 	}()
 
@@ -190,9 +200,10 @@ func TestConcurrentHandshakes(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to generate private key: %v", err)
 	}
+	remoteKeyECDH := &keychain.PrivKeyECDH{PrivKey: remotePriv}
 
 	go func() {
-		remoteConn, err := Dial(remotePriv, netAddr, net.Dial)
+		remoteConn, err := Dial(remoteKeyECDH, netAddr, net.Dial)
 		connChan <- maybeNetConn{remoteConn, err}
 	}()
 
@@ -299,6 +310,112 @@ func TestWriteMessageChunking(t *testing.T) {
 	}
 }
 
+// TestListenerAdmission asserts that the listener rejects connections that
+// don't pass its filter, or whose host has too many pending handshakes, before
+// starting their handshake.
+func TestListenerAdmission(t *testing.T) {
+	t.Parallel()
+
+	errFiltered := errors.New("filtered")
+	var filterAll int32
+	listener, netAddr, err := makeListener(
+		MaxPendingHandshakesPerHost(1),
+		ConnFilter(func(net.Addr) error {
+			if atomic.LoadInt32(&filterAll) == 1 {
+				return errFiltered
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unable to create listener: %v", err)
+	}
+	defer listener.Close()
+
+	// The first connection doesn't send anything, so its handshake stays
+	// pending. A second connection from the same host must be rejected.
+	addr := netAddr.Address.String()
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatalf("unable to dial listener: %v", err)
+		}
+		defer conn.Close()
+	}
+
+	_, err = listener.Accept()
+	if err == nil || !strings.Contains(
+		err.Error(), ErrTooManyPendingHandshakes.Error(),
+	) {
+		t.Fatalf("expected too many pending handshakes, got: %v", err)
+	}
+
+	// Connections that don't pass the filter must be rejected as well.
+	atomic.StoreInt32(&filterAll, 1)
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("unable to dial listener: %v", err)
+	}
+	defer conn.Close()
+
+	_, err = listener.Accept()
+	if err == nil || !strings.Contains(err.Error(), errFiltered.Error()) {
+		t.Fatalf("expected filtered connection, got: %v", err)
+	}
+}
+
+// TestListenerAdmissionExempt asserts that connections exempt from the per
+// host limit complete their handshake, even if their host has too many
+// pending handshakes.
+func TestListenerAdmissionExempt(t *testing.T) {
+	t.Parallel()
+
+	listener, netAddr, err := makeListener(
+		MaxPendingHandshakesPerHost(1),
+		HostLimitExempt(func(addr net.Addr) bool {
+			return addr.(*net.TCPAddr).IP.IsLoopback()
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unable to create listener: %v", err)
+	}
+	defer listener.Close()
+
+	// The first connection doesn't send anything, so its handshake stays
+	// pending.
+	conn, err := net.Dial("tcp", netAddr.Address.String())
+	if err != nil {
+		t.Fatalf("unable to dial listener: %v", err)
+	}
+	defer conn.Close()
+
+	// As connections from the loopback address are exempt, a second one
+	// must still be accepted.
+	remotePriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	remoteKeyECDH := &keychain.PrivKeyECDH{PrivKey: remotePriv}
+
+	remoteConnChan := make(chan maybeNetConn, 1)
+	go func() {
+		remoteConn, err := Dial(remoteKeyECDH, netAddr, net.Dial)
+		remoteConnChan <- maybeNetConn{remoteConn, err}
+	}()
+
+	localConn, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("unable to accept connection: %v", err)
+	}
+	defer localConn.Close()
+
+	remote := <-remoteConnChan
+	if remote.err != nil {
+		t.Fatalf("unable to dial listener: %v", remote.err)
+	}
+	defer remote.conn.Close()
+}
+
 // TestBolt0008TestVectors ensures that our implementation of brontide exactly
 // matches the test vectors within the specification.
 func TestBolt0008TestVectors(t *testing.T) {
@@ -352,9 +469,11 @@ func TestBolt0008TestVectors(t *testing.T) {
 
 	// Finally, we'll create both brontide state machines, so we can begin
 	// our test.
-	initiator := NewBrontideMachine(true, initiatorPriv, responderPub,
+	initiatorKeyECDH := &keychain.PrivKeyECDH{PrivKey: initiatorPriv}
+	responderKeyECDH := &keychain.PrivKeyECDH{PrivKey: responderPriv}
+	initiator := NewBrontideMachine(true, initiatorKeyECDH, responderPub,
 		initiatorEphemeral)
-	responder := NewBrontideMachine(false, responderPriv, nil,
+	responder := NewBrontideMachine(false, responderKeyECDH, nil,
 		responderEphemeral)
 
 	// We'll start with the initiator generating the initial payload for
@@ -711,3 +830,168 @@ func assertFlush(t *testing.T, b *Machine, w io.Writer, n int64, expN int,
 		t.Fatalf("expected n: %d, got: %d", expN, nn)
 	}
 }
+
+// countingWriter wraps an io.Writer and counts the number of calls to Write.
+type countingWriter struct {
+	w      io.Writer
+	writes int
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.writes++
+	return c.w.Write(p)
+}
+
+// TestFlushBatched asserts that several messages buffered on a Machine are
+// written out in a single write, possibly resumed after a timeout, and that
+// they can be read back in order by the other side.
+func TestFlushBatched(t *testing.T) {
+	t.Parallel()
+
+	// The sender acts as responder and the receiver as initiator, such
+	// that the receiving keys of the latter match the sending keys of the
+	// former.
+	var sender, receiver Machine
+	receiver.initiator = true
+	sender.split()
+	receiver.split()
+
+	const numMsgs = 5
+	for i := 0; i < numMsgs; i++ {
+		msg := bytes.Repeat([]byte{byte(i)}, payloadSize)
+		if err := sender.WriteMessage(msg); err != nil {
+			t.Fatalf("unable to write message %d: %v", i, err)
+		}
+	}
+
+	// Flush the messages, timing out in the middle of the payload of the
+	// second message. Only the payload bytes written so far should be
+	// counted.
+	var (
+		wire    bytes.Buffer
+		w       = &countingWriter{w: &wire}
+		msgSize = encHeaderSize + payloadSize + macSize
+	)
+	assertFlush(
+		t, &sender, w, int64(msgSize+encHeaderSize+1), payloadSize+1,
+		iotest.ErrTimeout,
+	)
+	assertFlush(t, &sender, w, -1, (numMsgs-1)*payloadSize-1, nil)
+	if w.writes != 2 {
+		t.Fatalf("expected 2 writes, got %d", w.writes)
+	}
+
+	// With all messages written, another flush shouldn't result in a
+	// write.
+	assertFlush(t, &sender, w, -1, 0, nil)
+	if w.writes != 2 {
+		t.Fatalf("expected no additional write, got %d", w.writes-2)
+	}
+
+	for i := 0; i < numMsgs; i++ {
+		msg, err := receiver.ReadMessage(&wire)
+		if err != nil {
+			t.Fatalf("unable to read message %d: %v", i, err)
+		}
+
+		expMsg := bytes.Repeat([]byte{byte(i)}, payloadSize)
+		if !bytes.Equal(msg, expMsg) {
+			t.Fatalf("message %d mismatch: expected %x, got %x", i,
+				expMsg, msg)
+		}
+	}
+}
+
+// TestWriteMessageBufferFull asserts that a Machine refuses to buffer another
+// message once the maximum buffer size would be exceeded, but always accepts a
+// message if nothing is buffered.
+func TestWriteMessageBufferFull(t *testing.T) {
+	t.Parallel()
+
+	var b Machine
+	b.split()
+
+	largeMsg := make([]byte, math.MaxUint16)
+	if err := b.WriteMessage(largeMsg); err != nil {
+		t.Fatalf("unable to write message: %v", err)
+	}
+	if err := b.WriteMessage(make([]byte, 1)); err != ErrMessageNotFlushed {
+		t.Fatalf("expected ErrMessageNotFlushed, got: %v", err)
+	}
+
+	assertFlush(t, &b, ioutil.Discard, -1, math.MaxUint16, nil)
+
+	if err := b.WriteMessage(make([]byte, 1)); err != nil {
+		t.Fatalf("unable to write message after flush: %v", err)
+	}
+}
+
+// TestPooledBuffers asserts that a Machine takes the storage for its buffered
+// messages from the write buffer pool, only returning it once all of them have
+// been flushed, and that messages are read using the read buffer pool.
+func TestPooledBuffers(t *testing.T) {
+	t.Parallel()
+
+	var sender, receiver Machine
+	receiver.initiator = true
+	sender.split()
+	receiver.split()
+
+	sender.writeBufPool = pool.NewWriteBuffer(
+		pool.DefaultWriteBufferGCInterval,
+		pool.DefaultWriteBufferExpiryInterval,
+	)
+	receiver.readBufPool = pool.NewReadBuffer(
+		pool.DefaultReadBufferGCInterval,
+		pool.DefaultReadBufferExpiryInterval,
+	)
+
+	msg := bytes.Repeat([]byte{0x01}, payloadSize)
+	if err := sender.WriteMessage(msg); err != nil {
+		t.Fatalf("unable to write message: %v", err)
+	}
+	if sender.sendStorage == nil {
+		t.Fatalf("expected send buffer to be taken from the pool")
+	}
+
+	// A partial flush must keep the storage, as the remaining bytes are
+	// still to be written.
+	var wire bytes.Buffer
+	assertFlush(t, &sender, &wire, encHeaderSize, 0, iotest.ErrTimeout)
+	if sender.sendStorage == nil {
+		t.Fatalf("expected send buffer to be kept after partial flush")
+	}
+
+	assertFlush(t, &sender, &wire, -1, payloadSize, nil)
+	if sender.sendStorage != nil || sender.sendBuf != nil {
+		t.Fatalf("expected send buffer to be returned after flush")
+	}
+
+	// A message that doesn't fit into a pooled buffer is buffered in
+	// storage of its own.
+	largeMsg := make([]byte, math.MaxUint16)
+	if err := sender.WriteMessage(largeMsg); err != nil {
+		t.Fatalf("unable to write message: %v", err)
+	}
+	if sender.sendStorage != nil {
+		t.Fatalf("expected large message not to use pooled storage")
+	}
+	assertFlush(t, &sender, &wire, -1, math.MaxUint16, nil)
+
+	readMsg, err := receiver.ReadMessage(&wire)
+	if err != nil {
+		t.Fatalf("unable to read message: %v", err)
+	}
+	if !bytes.Equal(readMsg, msg) {
+		t.Fatalf("message mismatch: expected %x, got %x", msg, readMsg)
+	}
+
+	readMsg, err = receiver.ReadMessage(&wire)
+	if err != nil {
+		t.Fatalf("unable to read message: %v", err)
+	}
+	if len(readMsg) != math.MaxUint16 {
+		t.Fatalf("expected message of size %d, got %d",
+			math.MaxUint16, len(readMsg))
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"errors"
 
-	bolt "github.com/coreos/bbolt"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 )
 
 const (
@@ -104,7 +104,7 @@ func NewHeightHintCache(db *channeldb.DB) (*HeightHintCache, error) {
 // initBuckets ensures that the primary buckets used by the circuit are
 // initialized so that we can assume their existence after startup.
 func (c *HeightHintCache) initBuckets() error {
-	return c.db.Update(func(tx *bolt.Tx) error {
+	return c.db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(spendHintBucket)
 		if err != nil {
 			return err
@@ -126,7 +126,7 @@ func (c *HeightHintCache) CommitSpendHint(height uint32,
 	Log.Tracef("Updating spend hint to height %d for %v", height,
 		spendRequests)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
 		if spendHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -157,7 +157,7 @@ func (c *HeightHintCache) CommitSpendHint(height uint32,
 // cache for the outpoint.
 func (c *HeightHintCache) QuerySpendHint(spendRequest SpendRequest) (uint32, error) {
 	var hint uint32
-	err := c.db.View(func(tx *bolt.Tx) error {
+	err := c.db.View(func(tx kvdb.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
 		if spendHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -189,7 +189,7 @@ func (c *HeightHintCache) PurgeSpendHint(spendRequests ...SpendRequest) error {
 
 	Log.Tracef("Removing spend hints for %v", spendRequests)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
 		if spendHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -220,7 +220,7 @@ func (c *HeightHintCache) CommitConfirmHint(height uint32,
 	Log.Tracef("Updating confirm hints to height %d for %v", height,
 		confRequests)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
 		if confirmHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -251,7 +251,7 @@ func (c *HeightHintCache) CommitConfirmHint(height uint32,
 // the cache for the transaction hash.
 func (c *HeightHintCache) QueryConfirmHint(confRequest ConfRequest) (uint32, error) {
 	var hint uint32
-	err := c.db.View(func(tx *bolt.Tx) error {
+	err := c.db.View(func(tx kvdb.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
 		if confirmHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -284,7 +284,7 @@ func (c *HeightHintCache) PurgeConfirmHint(confRequests ...ConfRequest) error {
 
 	Log.Tracef("Removing confirm hints for %v", confRequests)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
 		if confirmHints == nil {
 			return ErrCorruptedHeightHintCache
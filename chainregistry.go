@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcutil"
@@ -29,6 +30,7 @@ import (
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwallet/btcwallet"
+	"github.com/wakiyamap/lnd/lnwallet/rpcwallet"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/routing/chainview"
 )
@@ -114,6 +116,12 @@ type chainControl struct {
 
 	msgSigner lnwallet.MessageSigner
 
+	// nodeKeyECDH is the ECDH abstraction of our node identity key.
+	nodeKeyECDH keychain.SingleKeyECDH
+
+	// nodeKeySigner signs messages with our node identity key.
+	nodeKeySigner keychain.SingleKeyMessageSigner
+
 	chainNotifier chainntnfs.ChainNotifier
 
 	chainView chainview.FilteredChainView
@@ -184,6 +192,24 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 
 	var err error
 
+	// If a remote signer is configured, then the wallet is created as a
+	// watch-only copy of the wallet of the remote signer, such that no
+	// private keys are ever held by this node.
+	var remoteSigner *rpcwallet.RPCKeyRing
+	if cfg.RemoteSigner.Enable {
+		ltndLog.Infof("Using remote signer at %v",
+			cfg.RemoteSigner.RPCHost)
+
+		remoteSigner, err = rpcwallet.New(cfg.RemoteSigner)
+		if err != nil {
+			fmt.Printf("unable to create remote signer: %v\n", err)
+			return nil, err
+		}
+
+		walletConfig.WatchOnlyWallet =
+			remoteSigner.ExportWatchOnlyWallet
+	}
+
 	// Initialize the height hint cache within the chain directory.
 	hintCache, err := chainntnfs.NewHeightHintCache(chanDB)
 	if err != nil {
@@ -469,9 +495,18 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		channelConstraints = defaultMonaChannelConstraints
 	}
 
-	keyRing := keychain.NewBtcWalletKeyRing(
+	var keyRing keychain.SecretKeyRing = keychain.NewBtcWalletKeyRing(
 		wc.InternalWallet(), activeNetParams.CoinType,
 	)
+
+	// If a remote signer is configured, then all operations that require
+	// private keys are delegated to it, while the wallet controller only
+	// tracks the chain as a watch-only wallet.
+	if remoteSigner != nil {
+		cc.msgSigner = remoteSigner
+		cc.signer = remoteSigner
+		keyRing = remoteSigner
+	}
 	cc.keyRing = keyRing
 
 	// Create, and start the lnwallet, which handles the core payment
@@ -501,6 +536,37 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 
 	cc.wallet = lnWallet
 
+	// Our node identity key is used for every transport handshake, every
+	// onion packet and every announcement. If a remote signer is
+	// configured, then we only know its public key, and all of these
+	// operations are carried out by the remote signer.
+	nodeKeyLoc := keychain.KeyLocator{
+		Family: keychain.KeyFamilyNodeKey,
+		Index:  0,
+	}
+	if remoteSigner != nil {
+		nodeKeyDesc, err := keyRing.DeriveKey(nodeKeyLoc)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive node key: %v",
+				err)
+		}
+
+		cc.nodeKeyECDH = keychain.NewPubKeyECDH(nodeKeyDesc, keyRing)
+		cc.nodeKeySigner = remoteSigner.KeySigner(nodeKeyDesc)
+	} else {
+		idPrivKey, err := keyRing.DerivePrivKey(keychain.KeyDescriptor{
+			KeyLocator: nodeKeyLoc,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive node key: %v",
+				err)
+		}
+		idPrivKey.Curve = btcec.S256()
+
+		cc.nodeKeyECDH = &keychain.PrivKeyECDH{PrivKey: idPrivKey}
+		cc.nodeKeySigner = keychain.NewPrivKeyMessageSigner(idPrivKey)
+	}
+
 	return cc, nil
 }
 
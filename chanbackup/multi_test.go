@@ -21,6 +21,14 @@ func TestMultiPackUnpack(t *testing.T) {
 
 		single := NewSingle(channel, []net.Addr{addr1, addr2})
 
+		// Every other channel has been spliced, such that singles
+		// with and without a spliced outpoint are mixed.
+		if i%2 == 0 {
+			splicedOutpoint := op
+			splicedOutpoint.Index = uint32(i)
+			single.SplicedOutpoint = &splicedOutpoint
+		}
+
 		originalSingles = append(originalSingles, single)
 		multi.StaticBackups = append(multi.StaticBackups, single)
 	}
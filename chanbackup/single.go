@@ -26,6 +26,13 @@ const (
 	// simply: version || SCB. Where SCB is the known format of the
 	// version.
 	DefaultSingleVersion = 0
+
+	// ECDHRevocationRootVersion is the version of the single channel
+	// backup for channels whose shachain root was derived through ECDH
+	// between the key of the ShaChainRootDesc locator and the local
+	// multi-sig key. The format is identical to DefaultSingleVersion, but
+	// the ShaChainRootDesc public key is always zero.
+	ECDHRevocationRootVersion = 1
 )
 
 // Single is a static description of an existing channel that can be used for
@@ -58,6 +65,11 @@ type Single struct {
 	// target blockchain as specified by the chain hash parameter.
 	FundingOutpoint wire.OutPoint
 
+	// SplicedOutpoint is the outpoint of the funding output created by
+	// the latest splice of the channel, which funds the channel in place
+	// of FundingOutpoint. It's nil if the channel was never spliced.
+	SplicedOutpoint *wire.OutPoint
+
 	// ShortChannelID encodes the exact location in the chain in which the
 	// channel was initially confirmed. This includes: the block height,
 	// transaction index, and the output within the target transaction.
@@ -72,7 +84,8 @@ type Single struct {
 	// authenticated connection for the stored identity public key.
 	Addresses []net.Addr
 
-	// Capacity is the size of the original channel.
+	// Capacity is the size of the channel, including any splices that
+	// have been locked in.
 	Capacity btcutil.Amount
 
 	// LocalChanCfg is our local channel configuration. It contains all the
@@ -97,7 +110,10 @@ type Single struct {
 	RemoteChanCfg channeldb.ChannelConfig
 
 	// ShaChainRootDesc describes how to derive the private key that was
-	// used as the shachain root for this channel.
+	// used as the shachain root for this channel. For the
+	// ECDHRevocationRootVersion, the public key isn't set and the root is
+	// derived through ECDH between the key of the locator and the local
+	// multi-sig key instead.
 	ShaChainRootDesc keychain.KeyDescriptor
 }
 
@@ -107,37 +123,60 @@ type Single struct {
 func NewSingle(channel *channeldb.OpenChannel,
 	nodeAddrs []net.Addr) Single {
 
-	// TODO(roasbeef): update after we start to store the KeyLoc for
-	// shachain root
-
-	// We'll need to obtain the shachain root which is derived directly
-	// from a private key in our keychain.
-	var b bytes.Buffer
-	channel.RevocationProducer.Encode(&b) // Can't return an error.
+	// If the shachain root was derived through ECDH, then we only need
+	// to store the locator of the key it was derived from.
+	version := SingleBackupVersion(ECDHRevocationRootVersion)
+	revKeyLoc := channel.RevocationKeyLocator
+	shaChainRootDesc := keychain.KeyDescriptor{
+		KeyLocator: revKeyLoc,
+	}
+	if revKeyLoc.Family != keychain.KeyFamilyRevocationRoot {
+		version = DefaultSingleVersion
 
-	// Once we have the root, we'll make a public key from it, such that
-	// the backups plaintext don't carry any private information. When we
-	// go to recover, we'll present this in order to derive the private
-	// key.
-	_, shaChainPoint := btcec.PrivKeyFromBytes(btcec.S256(), b.Bytes())
+		// Otherwise, the shachain root is derived directly from a
+		// private key in our keychain.
+		var b bytes.Buffer
+		channel.RevocationProducer.Encode(&b) // Can't return an error.
+
+		// Once we have the root, we'll make a public key from it, such
+		// that the backups plaintext don't carry any private
+		// information. When we go to recover, we'll present this in
+		// order to derive the private key.
+		_, shaChainPoint := btcec.PrivKeyFromBytes(
+			btcec.S256(), b.Bytes(),
+		)
 
-	return Single{
-		Version:         DefaultSingleVersion,
-		IsInitiator:     channel.IsInitiator,
-		ChainHash:       channel.ChainHash,
-		FundingOutpoint: channel.FundingOutpoint,
-		ShortChannelID:  channel.ShortChannelID,
-		RemoteNodePub:   channel.IdentityPub,
-		Addresses:       nodeAddrs,
-		Capacity:        channel.Capacity,
-		LocalChanCfg:    channel.LocalChanCfg,
-		RemoteChanCfg:   channel.RemoteChanCfg,
-		ShaChainRootDesc: keychain.KeyDescriptor{
+		shaChainRootDesc = keychain.KeyDescriptor{
 			PubKey: shaChainPoint,
 			KeyLocator: keychain.KeyLocator{
 				Family: keychain.KeyFamilyRevocationRoot,
 			},
-		},
+		}
+	}
+
+	// If the channel has been spliced, then it's funded by the output of
+	// its latest splice transaction, which is what must be watched on
+	// chain once the channel is restored. The capacity of the channel
+	// already reflects the splice.
+	var splicedOutpoint *wire.OutPoint
+	fundingOutpoint := channel.CurrentFundingOutpoint()
+	if fundingOutpoint != channel.FundingOutpoint {
+		splicedOutpoint = &fundingOutpoint
+	}
+
+	return Single{
+		Version:          version,
+		IsInitiator:      channel.IsInitiator,
+		ChainHash:        channel.ChainHash,
+		FundingOutpoint:  channel.FundingOutpoint,
+		SplicedOutpoint:  splicedOutpoint,
+		ShortChannelID:   channel.ShortChannelID,
+		RemoteNodePub:    channel.IdentityPub,
+		Addresses:        nodeAddrs,
+		Capacity:         channel.Capacity,
+		LocalChanCfg:     channel.LocalChanCfg,
+		RemoteChanCfg:    channel.RemoteChanCfg,
+		ShaChainRootDesc: shaChainRootDesc,
 	}
 }
 
@@ -148,6 +187,11 @@ func (s *Single) Serialize(w io.Writer) error {
 	// we're aware of.
 	switch s.Version {
 	case DefaultSingleVersion:
+	case ECDHRevocationRootVersion:
+		if s.ShaChainRootDesc.PubKey != nil {
+			return fmt.Errorf("shachain root public key must " +
+				"not be set for ECDH derived roots")
+		}
 	default:
 		return fmt.Errorf("unable to serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -210,6 +254,15 @@ func (s *Single) Serialize(w io.Writer) error {
 		return err
 	}
 
+	// The outpoint of the latest splice is only written for channels that
+	// have been spliced, after all other fields.
+	if s.SplicedOutpoint != nil {
+		err := lnwire.WriteElements(&singleBytes, *s.SplicedOutpoint)
+		if err != nil {
+			return err
+		}
+	}
+
 	return lnwire.WriteElements(
 		w,
 		byte(s.Version),
@@ -305,6 +358,7 @@ func (s *Single) Deserialize(r io.Reader) error {
 
 	switch s.Version {
 	case DefaultSingleVersion:
+	case ECDHRevocationRootVersion:
 	default:
 		return fmt.Errorf("unable to de-serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -315,6 +369,15 @@ func (s *Single) Deserialize(r io.Reader) error {
 		return err
 	}
 
+	// We'll only parse the SCB from the number of bytes indicated by its
+	// length, as its trailing fields are optional.
+	singleBytes := make([]byte, length)
+	if _, err := io.ReadFull(r, singleBytes); err != nil {
+		return err
+	}
+	singleReader := bytes.NewReader(singleBytes)
+	r = singleReader
+
 	err = lnwire.ReadElements(
 		r, &s.IsInitiator, s.ChainHash[:], &s.FundingOutpoint,
 		&s.ShortChannelID, &s.RemoteNodePub, &s.Addresses, &s.Capacity,
@@ -383,8 +446,13 @@ func (s *Single) Deserialize(r io.Reader) error {
 	}
 
 	// Since this field is optional, we'll check to see if the pubkey has
-	// been specified or not.
-	if !bytes.Equal(shaChainPub[:], zeroPub[:]) {
+	// been specified or not. Roots derived through ECDH never carry one.
+	hasShaChainPub := !bytes.Equal(shaChainPub[:], zeroPub[:])
+	if hasShaChainPub && s.Version == ECDHRevocationRootVersion {
+		return fmt.Errorf("unexpected shachain root public key for " +
+			"ECDH derived root")
+	}
+	if hasShaChainPub {
 		s.ShaChainRootDesc.PubKey, err = btcec.ParsePubKey(
 			shaChainPub[:], btcec.S256(),
 		)
@@ -399,7 +467,24 @@ func (s *Single) Deserialize(r io.Reader) error {
 	}
 	s.ShaChainRootDesc.KeyLocator.Family = keychain.KeyFamily(shaKeyFam)
 
-	return lnwire.ReadElements(r, &s.ShaChainRootDesc.KeyLocator.Index)
+	err = lnwire.ReadElements(r, &s.ShaChainRootDesc.KeyLocator.Index)
+	if err != nil {
+		return err
+	}
+
+	// If any bytes remain, then the channel has been spliced, and they
+	// carry the outpoint of its latest splice.
+	if singleReader.Len() == 0 {
+		return nil
+	}
+
+	var splicedOutpoint wire.OutPoint
+	if err := lnwire.ReadElements(r, &splicedOutpoint); err != nil {
+		return err
+	}
+	s.SplicedOutpoint = &splicedOutpoint
+
+	return nil
 }
 
 // UnpackFromReader is similar to Deserialize method, but it expects the passed
@@ -54,6 +54,10 @@ func assertSingleEqual(t *testing.T, a, b Single) {
 		t.Fatalf("chan point doesn't match: %v vs %v",
 			a.FundingOutpoint, b.FundingOutpoint)
 	}
+	if !reflect.DeepEqual(a.SplicedOutpoint, b.SplicedOutpoint) {
+		t.Fatalf("spliced outpoint doesn't match: %v vs %v",
+			a.SplicedOutpoint, b.SplicedOutpoint)
+	}
 	if a.ShortChannelID != b.ShortChannelID {
 		t.Fatalf("chan id doesn't match: %v vs %v",
 			a.ShortChannelID, b.ShortChannelID)
@@ -286,6 +290,69 @@ func TestSinglePackUnpack(t *testing.T) {
 	}
 }
 
+// TestSingleRevocationKeyLocator tests that a backup of a channel whose
+// shachain root was derived through ECDH uses the ECDHRevocationRootVersion,
+// only carries the locator of the root key, and that it survives a
+// pack/unpack round trip.
+func TestSingleRevocationKeyLocator(t *testing.T) {
+	t.Parallel()
+
+	channel, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen open channel: %v", err)
+	}
+	channel.RevocationKeyLocator = keychain.KeyLocator{
+		Family: keychain.KeyFamilyRevocationRoot,
+		Index:  7,
+	}
+
+	single := NewSingle(channel, nil)
+	if single.Version != ECDHRevocationRootVersion {
+		t.Fatalf("expected version %v, got %v",
+			ECDHRevocationRootVersion, single.Version)
+	}
+	if single.ShaChainRootDesc.PubKey != nil {
+		t.Fatalf("expected no shachain root pubkey")
+	}
+	if single.ShaChainRootDesc.KeyLocator != channel.RevocationKeyLocator {
+		t.Fatalf("expected shachain root locator %v, got %v",
+			channel.RevocationKeyLocator,
+			single.ShaChainRootDesc.KeyLocator)
+	}
+
+	keyRing := &mockKeyRing{}
+
+	var b bytes.Buffer
+	if err := single.PackToWriter(&b, keyRing); err != nil {
+		t.Fatalf("unable to pack single: %v", err)
+	}
+
+	var unpackedSingle Single
+	if err := unpackedSingle.UnpackFromReader(&b, keyRing); err != nil {
+		t.Fatalf("unable to unpack single: %v", err)
+	}
+
+	if unpackedSingle.Version != single.Version {
+		t.Fatalf("versions don't match: %v vs %v", single.Version,
+			unpackedSingle.Version)
+	}
+	if !reflect.DeepEqual(
+		single.ShaChainRootDesc, unpackedSingle.ShaChainRootDesc,
+	) {
+		t.Fatalf("sha chain root doesn't match: %v vs %v",
+			spew.Sdump(single.ShaChainRootDesc),
+			spew.Sdump(unpackedSingle.ShaChainRootDesc))
+	}
+
+	// A backup of this version must never carry a shachain root public
+	// key, so serializing one that does should fail.
+	single.ShaChainRootDesc.PubKey = single.RemoteNodePub
+	if err := single.Serialize(&b); err == nil {
+		t.Fatalf("expected serialization with shachain root pubkey " +
+			"to fail")
+	}
+}
+
 // TestPackedSinglesUnpack tests that we're able to properly unpack a series of
 // packed singles.
 func TestPackedSinglesUnpack(t *testing.T) {
@@ -1,6 +1,7 @@
 package lnd
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/btcsuite/btcd/txscript"
@@ -25,6 +26,12 @@ var (
 	// ErrInvalidState is returned when the closing state machine receives
 	// a message while it is in an unknown state.
 	ErrInvalidState = fmt.Errorf("invalid state")
+
+	// ErrUpfrontShutdownScriptMismatch is returned when a peer or end user
+	// provides a script to cooperatively close out to which does not match
+	// the upfront shutdown script previously set for that party.
+	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does " +
+		"not match upfront shutdown script")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -116,6 +123,12 @@ type channelCloser struct {
 	// offer when starting negotiation. This will be used as a baseline.
 	idealFeeSat btcutil.Amount
 
+	// maxFeeSat is the highest total fee that we're willing to agree to
+	// for the closing transaction. Our own proposals will never exceed
+	// this value, and we'll refuse to accept any remote offer above it. A
+	// value of zero indicates that no upper bound is enforced.
+	maxFeeSat btcutil.Amount
+
 	// lastFeeProposal is the last fee that we proposed to the remote
 	// party. We'll use this as a pivot point to rachet our next offer up,
 	// or down, or simply accept the remote party's prior offer.
@@ -147,10 +160,13 @@ type channelCloser struct {
 }
 
 // newChannelCloser creates a new instance of the channel closure given the
-// passed configuration, and delivery+fee preference. The final argument should
-// only be populated iff, we're the initiator of this closing request.
+// passed configuration, and delivery+fee preference. If maxFeePerKw is
+// non-zero, then the negotiated fee will never exceed the total fee implied by
+// this rate. The final argument should only be populated iff, we're the
+// initiator of this closing request.
 func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
-	idealFeePerKw lnwallet.SatPerKWeight, negotiationHeight uint32,
+	idealFeePerKw, maxFeePerKw lnwallet.SatPerKWeight,
+	negotiationHeight uint32,
 	closeReq *htlcswitch.ChanClose) *channelCloser {
 
 	// Given the target fee-per-kw, we'll compute what our ideal _total_
@@ -159,6 +175,21 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 	// TODO(roasbeef): should factor in minimal commit
 	idealFeeSat := cfg.channel.CalcFee(idealFeePerKw)
 
+	// If the caller specified a ceiling for the fee rate, then we'll also
+	// compute the maximum total fee we're willing to pay. Our ideal fee
+	// should never be above this value, so we'll clamp it down if needed.
+	var maxFeeSat btcutil.Amount
+	if maxFeePerKw != 0 {
+		maxFeeSat = cfg.channel.CalcFee(maxFeePerKw)
+		if idealFeeSat > maxFeeSat {
+			peerLog.Infof("Ideal starting fee of %v is greater "+
+				"than max fee of %v, clamping",
+				int64(idealFeeSat), int64(maxFeeSat))
+
+			idealFeeSat = maxFeeSat
+		}
+	}
+
 	// If this fee is greater than the fee currently present within the
 	// commitment transaction, then we'll clamp it down to be within the
 	// proper range.
@@ -185,6 +216,7 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		maxFeeSat:           maxFeeSat,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[btcutil.Amount]*lnwire.ClosingSigned),
 	}
@@ -262,6 +294,26 @@ func (c *channelCloser) CloseRequest() *htlcswitch.ChanClose {
 	return c.closeReq
 }
 
+// checkUpfrontShutdownScript ensures that the delivery address within the
+// remote party's Shutdown message matches the upfront shutdown script they
+// committed to when the channel was opened, if any.
+func (c *channelCloser) checkUpfrontShutdownScript(msg *lnwire.Shutdown) error {
+	upfrontScript := c.cfg.channel.State().RemoteShutdownScript
+	if len(upfrontScript) == 0 {
+		return nil
+	}
+
+	if !bytes.Equal(upfrontScript, msg.Address) {
+		peerLog.Warnf("ChannelPoint(%v): remote party's shutdown "+
+			"script %x doesn't match upfront shutdown script %x",
+			c.chanPoint, []byte(msg.Address), []byte(upfrontScript))
+
+		return ErrUpfrontShutdownScriptMismatch
+	}
+
+	return nil
+}
+
 // ProcessCloseMsg attempts to process the next message in the closing series.
 // This method will update the state accordingly and return two primary values:
 // the next set of messages to be sent, and a bool indicating if the fee
@@ -282,6 +334,13 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// If the remote party committed to an upfront shutdown script
+		// when the channel was opened, then the delivery address they
+		// sent must match it.
+		if err := c.checkUpfrontShutdownScript(shutDownMsg); err != nil {
+			return nil, false, err
+		}
+
 		// Next, we'll note the other party's preference for their
 		// delivery address. We'll use this when we craft the closure
 		// transaction.
@@ -332,6 +391,12 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// As above, if the remote party committed to an upfront
+		// shutdown script, then their delivery address must match it.
+		if err := c.checkUpfrontShutdownScript(shutDownMsg); err != nil {
+			return nil, false, err
+		}
+
 		// Now that we know this is a valid shutdown message, we'll
 		// record their preferred delivery closing script.
 		c.remoteDeliveryScript = shutDownMsg.Address
@@ -382,7 +447,7 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 			// fee rate, and the last proposed fee by both sides.
 			feeProposal := calcCompromiseFee(c.chanPoint,
 				c.idealFeeSat, c.lastFeeProposal,
-				remoteProposedFee,
+				remoteProposedFee, c.maxFeeSat,
 			)
 
 			// With our new fee proposal calculated, we'll craft a
@@ -548,15 +613,41 @@ func rachetFee(fee btcutil.Amount, up bool) btcutil.Amount {
 
 // calcCompromiseFee performs the current fee negotiation algorithm, taking
 // into consideration our ideal fee based on current fee environment, the fee
-// we last proposed (if any), and the fee proposed by the peer.
-func calcCompromiseFee(chanPoint wire.OutPoint,
-	ourIdealFee, lastSentFee, remoteFee btcutil.Amount) btcutil.Amount {
+// we last proposed (if any), and the fee proposed by the peer. If maxFee is
+// non-zero, then the returned fee will never exceed it.
+func calcCompromiseFee(chanPoint wire.OutPoint, ourIdealFee, lastSentFee,
+	remoteFee, maxFee btcutil.Amount) btcutil.Amount {
 
 	// TODO(roasbeef): take in number of rounds as well?
 
 	peerLog.Infof("ChannelPoint(%v): computing fee compromise, ideal=%v, "+
-		"last_sent=%v, remote_offer=%v", chanPoint, int64(ourIdealFee),
-		int64(lastSentFee), int64(remoteFee))
+		"last_sent=%v, remote_offer=%v, max=%v", chanPoint,
+		int64(ourIdealFee), int64(lastSentFee), int64(remoteFee),
+		int64(maxFee))
+
+	compromiseFee := compromiseFeeUnbounded(
+		chanPoint, ourIdealFee, lastSentFee, remoteFee,
+	)
+
+	// If the compromise we arrived at is above the ceiling set by the
+	// user, then we'll clamp it down. In the case that the remote party
+	// continues to insist on a fee above this value, then negotiation
+	// won't terminate until they accept our offer.
+	if maxFee != 0 && compromiseFee > maxFee {
+		peerLog.Infof("ChannelPoint(%v): compromise fee of %v is "+
+			"above max fee of %v, clamping", chanPoint,
+			int64(compromiseFee), int64(maxFee))
+
+		return maxFee
+	}
+
+	return compromiseFee
+}
+
+// compromiseFeeUnbounded computes the next fee to offer during negotiation
+// without taking any fee ceiling into account.
+func compromiseFeeUnbounded(chanPoint wire.OutPoint,
+	ourIdealFee, lastSentFee, remoteFee btcutil.Amount) btcutil.Amount {
 
 	// Otherwise, we'll need to attempt to make a fee compromise if this is
 	// the second round, and neither side has agreed on fees.
@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/wakiyamap/lnd/chanbackup"
+	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/channelnotifier"
 )
 
@@ -79,27 +80,21 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 				// the node address, then send to the
 				// sub-swapper.
 				case channelnotifier.OpenChannelEvent:
-					nodeAddrs, err := c.addrs.AddrsForNode(
-						event.Channel.IdentityPub,
-					)
-					if err != nil {
-						pub := event.Channel.IdentityPub
-						ltndLog.Errorf("unable to "+
-							"fetch addrs for %x: %v",
-							pub.SerializeCompressed(),
-							err)
-					}
+					chanEvent := c.newChanEvent(event.Channel)
 
-					channel := event.Channel
-					chanEvent := chanbackup.ChannelEvent{
-						NewChans: []chanbackup.ChannelWithAddrs{
-							{
-								OpenChannel: channel,
-								Addrs:       nodeAddrs,
-							},
-						},
+					select {
+					case chanUpdates <- chanEvent:
+					case <-quit:
+						return
 					}
 
+				// A splice of an existing channel has been
+				// locked in. As the sub-swapper replaces the
+				// backup of a channel it already knows of,
+				// we'll send it as a new channel.
+				case channelnotifier.SplicedChannelEvent:
+					chanEvent := c.newChanEvent(event.Channel)
+
 					select {
 					case chanUpdates <- chanEvent:
 					case <-quit:
@@ -140,6 +135,28 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 	}, nil
 }
 
+// newChanEvent creates a channel event for the sub-swapper carrying the passed
+// channel, along with the addresses of the channel peer.
+func (c *channelNotifier) newChanEvent(
+	channel *channeldb.OpenChannel) chanbackup.ChannelEvent {
+
+	nodeAddrs, err := c.addrs.AddrsForNode(channel.IdentityPub)
+	if err != nil {
+		pub := channel.IdentityPub
+		ltndLog.Errorf("unable to fetch addrs for %x: %v",
+			pub.SerializeCompressed(), err)
+	}
+
+	return chanbackup.ChannelEvent{
+		NewChans: []chanbackup.ChannelWithAddrs{
+			{
+				OpenChannel: channel,
+				Addrs:       nodeAddrs,
+			},
+		},
+	}
+}
+
 // A compile-time constraint to ensure channelNotifier implements
 // chanbackup.ChannelNotifier.
 var _ chanbackup.ChannelNotifier = (*channelNotifier)(nil)
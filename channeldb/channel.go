@@ -15,7 +15,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/shachain"
@@ -58,6 +58,53 @@ var (
 	// remote peer during a channel sync in case we have lost channel state.
 	dataLossCommitPointKey = []byte("data-loss-commit-point-key")
 
+	// localUpfrontShutdownKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores an optional
+	// upfront shutdown script that we committed to when opening the
+	// channel.
+	localUpfrontShutdownKey = []byte("local-upfront-shutdown-key")
+
+	// remoteUpfrontShutdownKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores an optional
+	// upfront shutdown script that the remote party committed to when
+	// opening the channel.
+	remoteUpfrontShutdownKey = []byte("remote-upfront-shutdown-key")
+
+	// confirmedScidKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the short channel ID
+	// of the confirmed funding transaction of a zero-conf channel, whose
+	// ShortChannelID is an alias.
+	confirmedScidKey = []byte("confirmed-scid-key")
+
+	// remoteAliasKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the alias short
+	// channel ID the remote party of a zero-conf channel recognizes for
+	// forwarding, which it sent us in its FundingLocked message.
+	remoteAliasKey = []byte("remote-alias-key")
+
+	// revocationKeyLocatorKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores the locator
+	// of the key the root of our revocation producer was derived from, for
+	// channels whose root was derived through ECDH.
+	revocationKeyLocatorKey = []byte("revocation-key-locator-key")
+
+	// splicedOutpointKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the outpoint of the
+	// funding output created by the latest confirmed splice of the
+	// channel.
+	splicedOutpointKey = []byte("spliced-outpoint-key")
+
+	// pendingSplicesKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the splices of the
+	// channel whose splice transaction hasn't confirmed yet.
+	pendingSplicesKey = []byte("pending-splices-key")
+
+	// confirmedSpliceKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the txid of the
+	// pending splice whose splice transaction has confirmed, but which
+	// hasn't been locked in yet.
+	confirmedSpliceKey = []byte("confirmed-splice-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -73,6 +120,14 @@ var (
 	// channel closure. This key should be accessed from within the
 	// sub-bucket of a target channel, identified by its channel point.
 	revocationLogBucket = []byte("revocation-log-key")
+
+	// spliceRevocationLogBucket is the sub-bucket of a target channel
+	// storing a revocation log for each pending splice, keyed by the txid
+	// of its splice transaction. Each log records the revoked commitments
+	// of the remote party spending the funding output created by the
+	// splice, and is merged into the revocation log of the channel once
+	// the splice is locked in.
+	spliceRevocationLogBucket = []byte("splice-revocation-log-key")
 )
 
 var (
@@ -88,6 +143,20 @@ var (
 	// particular channel cannot be found.
 	ErrNoRevocationsFound = fmt.Errorf("no revocations found")
 
+	// ErrSpliceNotFound is returned when attempting to lock in a splice
+	// that isn't pending for the channel.
+	ErrSpliceNotFound = fmt.Errorf("splice not found")
+
+	// ErrSpliceConfirmed is returned when attempting to abandon a splice
+	// whose splice transaction has confirmed.
+	ErrSpliceConfirmed = fmt.Errorf("splice transaction has confirmed")
+
+	// ErrChanSpliced is returned when a caller attempts to mutate the
+	// state of a channel, after a splice of the channel has been locked
+	// in through another instance of it.
+	ErrChanSpliced = fmt.Errorf("cannot mutate channel spliced by " +
+		"another instance")
+
 	// ErrNoPendingCommit is returned when there is not a pending
 	// commitment for a remote party. A new commitment is written to disk
 	// each time we write a new state in order to be properly fault
@@ -407,8 +476,35 @@ type OpenChannel struct {
 	// ShortChannelID encodes the exact location in the chain in which the
 	// channel was initially confirmed. This includes: the block height,
 	// transaction index, and the output within the target transaction.
+	//
+	// NOTE: For zero-conf channels, this is the alias of the channel as
+	// they are opened before the funding transaction confirms.
 	ShortChannelID lnwire.ShortChannelID
 
+	// confirmedShortChanID is the short channel ID of the confirmed
+	// funding transaction of a zero-conf channel. It is unset until the
+	// funding transaction confirms.
+	confirmedShortChanID lnwire.ShortChannelID
+
+	// remoteAlias is the alias short channel ID of a zero-conf channel
+	// chosen by the remote party. Our own alias is stored as the
+	// ShortChannelID. It is unset until the remote party's FundingLocked
+	// message has been received.
+	remoteAlias lnwire.ShortChannelID
+
+	// splicedOutpoint is the outpoint of the funding output created by the
+	// latest confirmed splice of the channel. It is nil for channels that
+	// were never spliced, whose funding output is still FundingOutpoint.
+	splicedOutpoint *wire.OutPoint
+
+	// pendingSplices are the splices of the channel that have been signed
+	// by both parties, but whose splice transaction hasn't confirmed yet.
+	pendingSplices []*PendingSplice
+
+	// confirmedSplice is the txid of the pending splice whose splice
+	// transaction has confirmed, if any.
+	confirmedSplice *chainhash.Hash
+
 	// IsPending indicates whether a channel's funding transaction has been
 	// confirmed.
 	IsPending bool
@@ -488,6 +584,12 @@ type OpenChannel struct {
 	// secret producer is shachain producer.
 	RevocationProducer shachain.Producer
 
+	// RevocationKeyLocator is the locator of the key the root of the
+	// RevocationProducer was derived from, through ECDH with our multi-sig
+	// key. It's only set for channels whose root was derived this way, in
+	// which case its family is keychain.KeyFamilyRevocationRoot.
+	RevocationKeyLocator keychain.KeyLocator
+
 	// RevocationStore is used to efficiently store the revocations for
 	// previous channels states sent to us by remote side. Current
 	// implementation of secret store is shachain store.
@@ -506,6 +608,19 @@ type OpenChannel struct {
 	// for which we are the initiator.
 	FundingTxn *wire.MsgTx
 
+	// LocalShutdownScript is set to a pre-set script if the channel was
+	// opened by the local node with option_upfront_shutdown_script set.
+	// If the option was not set, the field is empty. Any cooperative
+	// close of the channel must pay our settled funds to this script.
+	LocalShutdownScript lnwire.DeliveryAddress
+
+	// RemoteShutdownScript is set to a pre-set script if the channel was
+	// opened by the remote node with option_upfront_shutdown_script set.
+	// If the option was not set, the field is empty. We'll refuse any
+	// Shutdown message from the remote party that pays to a different
+	// script.
+	RemoteShutdownScript lnwire.DeliveryAddress
+
 	// TODO(roasbeef): eww
 	Db *DB
 
@@ -532,6 +647,64 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// ConfirmedShortChanID returns the short channel ID that encodes the location
+// of the confirmed funding transaction. For zero-conf channels whose funding
+// transaction hasn't confirmed yet, an empty short channel ID is returned.
+func (c *OpenChannel) ConfirmedShortChanID() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	if !c.ShortChannelID.IsAlias() {
+		return c.ShortChannelID
+	}
+
+	return c.confirmedShortChanID
+}
+
+// CurrentFundingOutpoint returns the outpoint of the output currently funding
+// the channel. This differs from FundingOutpoint, which keeps identifying the
+// channel, once the channel has been spliced.
+func (c *OpenChannel) CurrentFundingOutpoint() wire.OutPoint {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.splicedOutpoint != nil {
+		return *c.splicedOutpoint
+	}
+
+	return c.FundingOutpoint
+}
+
+// PendingSplices returns the splices of the channel whose splice transaction
+// hasn't confirmed yet.
+func (c *OpenChannel) PendingSplices() []*PendingSplice {
+	c.RLock()
+	defer c.RUnlock()
+
+	return append([]*PendingSplice(nil), c.pendingSplices...)
+}
+
+// ConfirmedSplice returns the txid of the pending splice whose splice
+// transaction has confirmed, but which hasn't been locked in yet. Nil is
+// returned if no splice transaction has confirmed.
+func (c *OpenChannel) ConfirmedSplice() *chainhash.Hash {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.confirmedSplice
+}
+
+// RemoteAlias returns the alias short channel ID the remote party chose for a
+// zero-conf channel. It should be used in route hints, and in the channel
+// updates sent to the remote party. An empty short channel ID is returned if
+// the remote party hasn't sent its alias yet.
+func (c *OpenChannel) RemoteAlias() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.remoteAlias
+}
+
 // ChanStatus returns the current ChannelStatus of this channel.
 func (c *OpenChannel) ChanStatus() ChannelStatus {
 	c.RLock()
@@ -578,8 +751,8 @@ func (c *OpenChannel) RefreshShortChanID() error {
 	c.Lock()
 	defer c.Unlock()
 
-	var sid lnwire.ShortChannelID
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	var sid, confirmedSid, remoteAlias lnwire.ShortChannelID
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -593,6 +766,8 @@ func (c *OpenChannel) RefreshShortChanID() error {
 		}
 
 		sid = channel.ShortChannelID
+		confirmedSid = channel.confirmedShortChanID
+		remoteAlias = channel.remoteAlias
 
 		return nil
 	})
@@ -601,6 +776,8 @@ func (c *OpenChannel) RefreshShortChanID() error {
 	}
 
 	c.ShortChannelID = sid
+	c.confirmedShortChanID = confirmedSid
+	c.remoteAlias = remoteAlias
 	c.Packager = NewChannelPackager(sid)
 
 	return nil
@@ -609,8 +786,8 @@ func (c *OpenChannel) RefreshShortChanID() error {
 // fetchChanBucket is a helper function that returns the bucket where a
 // channel's data resides in given: the public key for the node, the outpoint,
 // and the chainhash that the channel resides on.
-func fetchChanBucket(tx *bbolt.Tx, nodeKey *btcec.PublicKey,
-	outPoint *wire.OutPoint, chainHash chainhash.Hash) (*bbolt.Bucket, error) {
+func fetchChanBucket(tx kvdb.Tx, nodeKey *btcec.PublicKey,
+	outPoint *wire.OutPoint, chainHash chainhash.Hash) (kvdb.Bucket, error) {
 
 	// First fetch the top level bucket which stores all data related to
 	// current, active channels.
@@ -651,7 +828,7 @@ func fetchChanBucket(tx *bbolt.Tx, nodeKey *btcec.PublicKey,
 // fullSync is an internal version of the FullSync method which allows callers
 // to sync the contents of an OpenChannel while re-using an existing database
 // transaction.
-func (c *OpenChannel) fullSync(tx *bbolt.Tx) error {
+func (c *OpenChannel) fullSync(tx kvdb.Tx) error {
 	// First fetch the top level bucket which stores all data related to
 	// current, active channels.
 	openChanBucket, err := tx.CreateBucketIfNotExists(openChannelBucket)
@@ -684,7 +861,7 @@ func (c *OpenChannel) fullSync(tx *bbolt.Tx) error {
 		chanPointBuf.Bytes(),
 	)
 	switch {
-	case err == bbolt.ErrBucketExists:
+	case err == kvdb.ErrBucketExists:
 		// If this channel already exists, then in order to avoid
 		// overriding it, we'll return an error back up to the caller.
 		return ErrChanAlreadyExists
@@ -692,7 +869,21 @@ func (c *OpenChannel) fullSync(tx *bbolt.Tx) error {
 		return err
 	}
 
-	return putOpenChannel(chanBucket, c)
+	if err := putOpenChannel(chanBucket, c); err != nil {
+		return err
+	}
+
+	// A channel that has been spliced, such as one restored from a static
+	// backup, is funded by the output of its latest splice transaction.
+	if c.splicedOutpoint == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, c.splicedOutpoint); err != nil {
+		return err
+	}
+	return chanBucket.Put(splicedOutpointKey, b.Bytes())
 }
 
 // MarkAsOpen marks a channel as fully open given a locator that uniquely
@@ -701,7 +892,7 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	c.Lock()
 	defer c.Unlock()
 
-	if err := c.Db.Update(func(tx *bbolt.Tx) error {
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -729,6 +920,438 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// MarkConfirmed records the short channel ID of the confirmed funding
+// transaction of a zero-conf channel. The channel keeps using its alias as
+// ShortChannelID, as it may already be referenced by forwarded HTLCs and
+// issued invoices.
+func (c *OpenChannel) MarkConfirmed(confirmedLoc lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, confirmedLoc); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(confirmedScidKey, b.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	c.confirmedShortChanID = confirmedLoc
+
+	return nil
+}
+
+// PendingSplice describes a splice of a channel that has been signed by both
+// parties, but whose splice transaction hasn't confirmed yet. Until it does,
+// the current funding output and commitments of the channel remain valid.
+type PendingSplice struct {
+	// SpliceTx is the transaction spending the current funding output of
+	// the channel into the new one.
+	SpliceTx *wire.MsgTx
+
+	// FundingOutpoint is the outpoint of the new funding output created
+	// by SpliceTx.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the capacity of the channel once the splice confirms.
+	Capacity btcutil.Amount
+
+	// LocalCommitment is our commitment spending the new funding output,
+	// which replaces our current commitment once the splice confirms.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the remote party's commitment spending the new
+	// funding output, which replaces their current commitment once the
+	// splice confirms.
+	RemoteCommitment ChannelCommitment
+
+	// LocalDelta is the amount our balance is changed by the splice.
+	LocalDelta btcutil.Amount
+
+	// RemoteDelta is the amount the balance of the remote party is
+	// changed by the splice.
+	RemoteDelta btcutil.Amount
+
+	// FeePerKw is the fee rate of SpliceTx. Any replacement of the splice
+	// must pay a higher fee rate.
+	FeePerKw btcutil.Amount
+
+	// PrevOutputs are the outputs spent by the inputs of SpliceTx other
+	// than the funding output, in order. They're used to determine
+	// whether SpliceTx can still confirm.
+	PrevOutputs []*wire.TxOut
+}
+
+// AddPendingSplice records a splice of the channel that has been signed by
+// both parties. The splice only takes effect once LockInSplice is called for
+// its splice transaction.
+func (c *OpenChannel) AddPendingSplice(splice *PendingSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var pendingSplices []*PendingSplice
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := c.checkSplicedOutpoint(chanBucket); err != nil {
+			return err
+		}
+
+		pendingSplices, err = fetchPendingSplices(chanBucket)
+		if err != nil {
+			return err
+		}
+		pendingSplices = append(pendingSplices, splice)
+
+		return putPendingSplices(chanBucket, pendingSplices)
+	}); err != nil {
+		return err
+	}
+
+	c.pendingSplices = pendingSplices
+
+	return nil
+}
+
+// MarkSpliceConfirmed records that the splice transaction of the pending
+// splice with the given txid has confirmed. From then on, the splice can no
+// longer be abandoned, and it is locked in once both parties have seen it
+// confirm. The pending splices are read from disk, so splices added through
+// another instance of the channel are taken into account. If no such splice is
+// pending, ErrSpliceNotFound is returned.
+func (c *OpenChannel) MarkSpliceConfirmed(spliceTxid chainhash.Hash) (
+	*PendingSplice, error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	var splice *PendingSplice
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		pendingSplices, err := fetchPendingSplices(chanBucket)
+		if err != nil {
+			return err
+		}
+		splice = findPendingSplice(pendingSplices, spliceTxid)
+		if splice == nil {
+			return ErrSpliceNotFound
+		}
+
+		return chanBucket.Put(confirmedSpliceKey, spliceTxid[:])
+	}); err != nil {
+		return nil, err
+	}
+
+	c.confirmedSplice = &spliceTxid
+
+	return splice, nil
+}
+
+// LockInSplice promotes the pending splice with the given splice transaction
+// once that transaction has confirmed, and both parties have seen it do so.
+// The funding output, capacity and commitments of the splice replace those of
+// the channel, as does the revocation log of the splice. All other pending
+// splices, which are now double spent, are discarded. The pending splices are
+// read from disk, so splices added through another instance of the channel are
+// taken into account. Locking in a splice that has already been locked in only
+// refreshes the in-memory state of the channel. If no such splice is pending,
+// ErrSpliceNotFound is returned.
+func (c *OpenChannel) LockInSplice(spliceTxid chainhash.Hash) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var channel *OpenChannel
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err = fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		// If the splice has already been locked in, e.g. through
+		// another instance of the channel, there's nothing left to do.
+		if channel.splicedOutpoint != nil &&
+			channel.splicedOutpoint.Hash == spliceTxid {
+
+			return nil
+		}
+
+		splice := findPendingSplice(channel.pendingSplices, spliceTxid)
+		if splice == nil {
+			return ErrSpliceNotFound
+		}
+
+		channel.Capacity = splice.Capacity
+		channel.LocalCommitment = splice.LocalCommitment
+		channel.RemoteCommitment = splice.RemoteCommitment
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+
+		// A commitment we've extended to the remote party that they
+		// haven't revoked their prior commitment for yet is replaced
+		// by its variant spending the new funding output, so it's
+		// retransmitted as such.
+		if tipBytes := chanBucket.Get(commitDiffKey); tipBytes != nil {
+			diff, err := deserializeCommitDiff(
+				bytes.NewReader(tipBytes),
+			)
+			if err != nil {
+				return err
+			}
+			if err := diff.promoteSplice(spliceTxid); err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			if err := serializeCommitDiff(&b, diff); err != nil {
+				return err
+			}
+			err = chanBucket.Put(commitDiffKey, b.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		// The revoked commitments spending the new funding output are
+		// merged into the revocation log of the channel, replacing
+		// those spending the prior funding output at the same height.
+		if err := mergeSpliceRevocationLog(
+			chanBucket, spliceTxid,
+		); err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := writeOutpoint(&b, &splice.FundingOutpoint); err != nil {
+			return err
+		}
+		err = chanBucket.Put(splicedOutpointKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+		channel.splicedOutpoint = &splice.FundingOutpoint
+
+		if err := chanBucket.Delete(pendingSplicesKey); err != nil {
+			return err
+		}
+		return chanBucket.Delete(confirmedSpliceKey)
+	}); err != nil {
+		return err
+	}
+
+	c.splicedOutpoint = channel.splicedOutpoint
+	c.Capacity = channel.Capacity
+	c.LocalCommitment = channel.LocalCommitment
+	c.RemoteCommitment = channel.RemoteCommitment
+	c.pendingSplices = nil
+	c.confirmedSplice = nil
+
+	return nil
+}
+
+// AbandonSplice discards the pending splice with the given splice transaction,
+// which can no longer confirm, e.g. as one of its inputs has been double
+// spent. If the splice transaction has confirmed, ErrSpliceConfirmed is
+// returned.
+func (c *OpenChannel) AbandonSplice(spliceTxid chainhash.Hash) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var pendingSplices []*PendingSplice
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := c.checkSplicedOutpoint(chanBucket); err != nil {
+			return err
+		}
+
+		txidBytes := chanBucket.Get(confirmedSpliceKey)
+		if bytes.Equal(txidBytes, spliceTxid[:]) {
+			return ErrSpliceConfirmed
+		}
+
+		splices, err := fetchPendingSplices(chanBucket)
+		if err != nil {
+			return err
+		}
+		for _, splice := range splices {
+			if splice.SpliceTx.TxHash() == spliceTxid {
+				continue
+			}
+			pendingSplices = append(pendingSplices, splice)
+		}
+		if len(pendingSplices) == len(splices) {
+			return ErrSpliceNotFound
+		}
+
+		// The commitments spending the funding output of the splice
+		// are dropped from the pending commitment of the remote party,
+		// as is the revocation log of the splice.
+		if tipBytes := chanBucket.Get(commitDiffKey); tipBytes != nil {
+			diff, err := deserializeCommitDiff(
+				bytes.NewReader(tipBytes),
+			)
+			if err != nil {
+				return err
+			}
+			diff.removeSplice(spliceTxid)
+
+			var b bytes.Buffer
+			if err := serializeCommitDiff(&b, diff); err != nil {
+				return err
+			}
+			err = chanBucket.Put(commitDiffKey, b.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		spliceLogs := chanBucket.Bucket(spliceRevocationLogBucket)
+		if spliceLogs != nil && spliceLogs.Bucket(spliceTxid[:]) != nil {
+			err := spliceLogs.DeleteBucket(spliceTxid[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		return putPendingSplices(chanBucket, pendingSplices)
+	}); err != nil {
+		return err
+	}
+
+	c.pendingSplices = pendingSplices
+
+	return nil
+}
+
+// checkSplicedOutpoint ensures that no splice of the channel has been locked
+// in through another instance of the channel, whose promoted state would
+// otherwise be overwritten by the stale state of this instance.
+func (c *OpenChannel) checkSplicedOutpoint(chanBucket kvdb.Bucket) error {
+	splicedOutpoint, err := fetchSplicedOutpoint(chanBucket)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case splicedOutpoint == nil && c.splicedOutpoint == nil:
+		return nil
+
+	case splicedOutpoint != nil && c.splicedOutpoint != nil &&
+		*splicedOutpoint == *c.splicedOutpoint:
+
+		return nil
+	}
+
+	return ErrChanSpliced
+}
+
+// findPendingSplice returns the splice with the given splice transaction among
+// the passed pending splices, or nil if there is none.
+func findPendingSplice(pendingSplices []*PendingSplice,
+	spliceTxid chainhash.Hash) *PendingSplice {
+
+	for _, splice := range pendingSplices {
+		if splice.SpliceTx.TxHash() == spliceTxid {
+			return splice
+		}
+	}
+
+	return nil
+}
+
+// mergeSpliceRevocationLog merges the revocation log of the splice with the
+// given splice transaction into the revocation log of the channel, then
+// deletes the revocation logs of all pending splices.
+func mergeSpliceRevocationLog(chanBucket kvdb.Bucket,
+	spliceTxid chainhash.Hash) error {
+
+	spliceLogs := chanBucket.Bucket(spliceRevocationLogBucket)
+	if spliceLogs == nil {
+		return nil
+	}
+
+	if spliceLog := spliceLogs.Bucket(spliceTxid[:]); spliceLog != nil {
+		logBucket, err := chanBucket.CreateBucketIfNotExists(
+			revocationLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = spliceLog.ForEach(func(k, v []byte) error {
+			return logBucket.Put(k, v)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return chanBucket.DeleteBucket(spliceRevocationLogBucket)
+}
+
+// SetRemoteAlias records the alias short channel ID the remote party chose for
+// a zero-conf channel.
+func (c *OpenChannel) SetRemoteAlias(alias lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, alias); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(remoteAliasKey, b.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	c.remoteAlias = alias
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -737,7 +1360,7 @@ func (c *OpenChannel) MarkDataLoss(commitPoint *btcec.PublicKey) error {
 	defer c.Unlock()
 
 	var status ChannelStatus
-	if err := c.Db.Update(func(tx *bbolt.Tx) error {
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -781,7 +1404,7 @@ func (c *OpenChannel) MarkDataLoss(commitPoint *btcec.PublicKey) error {
 func (c *OpenChannel) DataLossCommitPoint() (*btcec.PublicKey, error) {
 	var commitPoint *btcec.PublicKey
 
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -826,7 +1449,7 @@ func (c *OpenChannel) MarkBorked() error {
 // active.
 //
 // NOTE: The primary mutex should already be held before this method is called.
-func (c *OpenChannel) isBorked(chanBucket *bbolt.Bucket) (bool, error) {
+func (c *OpenChannel) isBorked(chanBucket kvdb.Bucket) (bool, error) {
 	channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
 	if err != nil {
 		return false, err
@@ -846,7 +1469,7 @@ func (c *OpenChannel) MarkCommitmentBroadcasted() error {
 }
 
 func (c *OpenChannel) putChanStatus(status ChannelStatus) error {
-	if err := c.Db.Update(func(tx *bbolt.Tx) error {
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -875,7 +1498,7 @@ func (c *OpenChannel) putChanStatus(status ChannelStatus) error {
 }
 
 func (c *OpenChannel) clearChanStatus(status ChannelStatus) error {
-	if err := c.Db.Update(func(tx *bbolt.Tx) error {
+	if err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -905,7 +1528,7 @@ func (c *OpenChannel) clearChanStatus(status ChannelStatus) error {
 
 // putChannel serializes, and stores the current state of the channel in its
 // entirety.
-func putOpenChannel(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func putOpenChannel(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 	// First, we'll write out all the relatively static fields, that are
 	// decided upon initial channel creation.
 	if err := putChanInfo(chanBucket, channel); err != nil {
@@ -929,7 +1552,7 @@ func putOpenChannel(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 
 // fetchOpenChannel retrieves, and deserializes (including decrypting
 // sensitive) the complete channel currently active with the passed nodeID.
-func fetchOpenChannel(chanBucket *bbolt.Bucket,
+func fetchOpenChannel(chanBucket kvdb.Bucket,
 	chanPoint *wire.OutPoint) (*OpenChannel, error) {
 
 	channel := &OpenChannel{
@@ -976,14 +1599,14 @@ func (c *OpenChannel) SyncPending(addr net.Addr, pendingHeight uint32) error {
 
 	c.FundingBroadcastHeight = pendingHeight
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		return syncNewChannel(tx, c, []net.Addr{addr})
 	})
 }
 
 // syncNewChannel will write the passed channel to disk, and also create a
 // LinkNode (if needed) for the channel peer.
-func syncNewChannel(tx *bbolt.Tx, c *OpenChannel, addrs []net.Addr) error {
+func syncNewChannel(tx kvdb.Tx, c *OpenChannel, addrs []net.Addr) error {
 	// First, sync all the persistent channel state to disk.
 	if err := c.fullSync(tx); err != nil {
 		return err
@@ -1015,8 +1638,13 @@ func syncNewChannel(tx *bbolt.Tx, c *OpenChannel, addrs []net.Addr) error {
 // (remote or local). The commitment stat completely describes the balance
 // state at this point in the commitment chain. This method its to be called on
 // two occasions: when we revoke our prior commitment state, and when the
-// remote party revokes their prior commitment state.
-func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
+// remote party revokes their prior commitment state. If the channel has pending
+// splices, the variant of the new commitment spending the funding output of
+// each of them must be passed as well, keyed by the txid of its splice
+// transaction.
+func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment,
+	spliceCommitments map[chainhash.Hash]*ChannelCommitment) error {
+
 	c.Lock()
 	defer c.Unlock()
 
@@ -1027,7 +1655,8 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 		return ErrNoRestoredChannelMutation
 	}
 
-	err := c.Db.Update(func(tx *bbolt.Tx) error {
+	var pendingSplices []*PendingSplice
+	err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -1045,6 +1674,10 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 			return ErrChanBorked
 		}
 
+		if err := c.checkSplicedOutpoint(chanBucket); err != nil {
+			return err
+		}
+
 		if err = putChanInfo(chanBucket, c); err != nil {
 			return fmt.Errorf("unable to store chan info: %v", err)
 		}
@@ -1059,13 +1692,35 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 				"revocations: %v", err)
 		}
 
-		return nil
+		// Our commitments spending the funding outputs of the pending
+		// splices advance along with our current one.
+		if len(c.pendingSplices) == 0 {
+			return nil
+		}
+		pendingSplices = make([]*PendingSplice, len(c.pendingSplices))
+		for i, splice := range c.pendingSplices {
+			spliceTxid := splice.SpliceTx.TxHash()
+			commitment, ok := spliceCommitments[spliceTxid]
+			if !ok {
+				return fmt.Errorf("no commitment for splice "+
+					"%v", spliceTxid)
+			}
+
+			updatedSplice := *splice
+			updatedSplice.LocalCommitment = *commitment
+			pendingSplices[i] = &updatedSplice
+		}
+
+		return putPendingSplices(chanBucket, pendingSplices)
 	})
 	if err != nil {
 		return err
 	}
 
 	c.LocalCommitment = *newCommitment
+	if pendingSplices != nil {
+		c.pendingSplices = pendingSplices
+	}
 
 	return nil
 }
@@ -1334,6 +1989,63 @@ type CommitDiff struct {
 	// settles and fails from the forwarding packages of other channels,
 	// such that they will not be reforwarded internally after a restart.
 	SettleFailAcks []SettleFailRef
+
+	// SpliceDiffs holds the variant of the new commitment spending the
+	// funding output of each pending splice of the channel, along with
+	// the SpliceCommitSig message that should be sent before CommitSig.
+	SpliceDiffs []SpliceCommitDiff
+}
+
+// SpliceCommitDiff is the variant of a new commitment of the remote party
+// spending the funding output created by a pending splice.
+type SpliceCommitDiff struct {
+	// SpliceTxid is the txid of the splice transaction of the pending
+	// splice.
+	SpliceTxid chainhash.Hash
+
+	// Commitment is the variant of the new commitment spending the funding
+	// output created by the splice.
+	Commitment ChannelCommitment
+
+	// CommitSig is the exact SpliceCommitSig message that should be sent
+	// before the CommitSig of the CommitDiff when it is retransmitted.
+	CommitSig *lnwire.SpliceCommitSig
+}
+
+// promoteSplice replaces the commitment of the diff, as well as its CommitSig
+// message, by their variants spending the funding output created by the
+// splice with the given splice transaction, once that splice is locked in.
+func (d *CommitDiff) promoteSplice(spliceTxid chainhash.Hash) error {
+	for _, spliceDiff := range d.SpliceDiffs {
+		if spliceDiff.SpliceTxid != spliceTxid {
+			continue
+		}
+
+		d.Commitment = spliceDiff.Commitment
+		d.CommitSig = &lnwire.CommitSig{
+			ChanID:    spliceDiff.CommitSig.ChannelID,
+			CommitSig: spliceDiff.CommitSig.CommitSig,
+			HtlcSigs:  spliceDiff.CommitSig.HtlcSigs,
+		}
+		d.SpliceDiffs = nil
+
+		return nil
+	}
+
+	return fmt.Errorf("commit diff has no commitment for splice %v",
+		spliceTxid)
+}
+
+// removeSplice drops the commitment variant of the diff spending the funding
+// output created by the splice with the given splice transaction.
+func (d *CommitDiff) removeSplice(spliceTxid chainhash.Hash) {
+	var spliceDiffs []SpliceCommitDiff
+	for _, spliceDiff := range d.SpliceDiffs {
+		if spliceDiff.SpliceTxid != spliceTxid {
+			spliceDiffs = append(spliceDiffs, spliceDiff)
+		}
+	}
+	d.SpliceDiffs = spliceDiffs
 }
 
 func serializeCommitDiff(w io.Writer, diff *CommitDiff) error {
@@ -1381,6 +2093,31 @@ func serializeCommitDiff(w io.Writer, diff *CommitDiff) error {
 		}
 	}
 
+	// The commitment variants of pending splices are only written if
+	// there are any, so diffs of channels without pending splices keep
+	// their prior format.
+	if len(diff.SpliceDiffs) == 0 {
+		return nil
+	}
+
+	numSpliceDiffs := uint16(len(diff.SpliceDiffs))
+	if err := binary.Write(w, byteOrder, numSpliceDiffs); err != nil {
+		return err
+	}
+
+	for _, spliceDiff := range diff.SpliceDiffs {
+		if _, err := w.Write(spliceDiff.SpliceTxid[:]); err != nil {
+			return err
+		}
+		err := serializeChanCommit(w, &spliceDiff.Commitment)
+		if err != nil {
+			return err
+		}
+		if err := spliceDiff.CommitSig.Encode(w, 0); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1445,6 +2182,34 @@ func deserializeCommitDiff(r io.Reader) (*CommitDiff, error) {
 		}
 	}
 
+	// The commitment variants of pending splices are optional, so
+	// reaching the end of the diff here isn't an error.
+	var numSpliceDiffs uint16
+	err = binary.Read(r, byteOrder, &numSpliceDiffs)
+	switch {
+	case err == io.EOF:
+		return &d, nil
+	case err != nil:
+		return nil, err
+	}
+
+	d.SpliceDiffs = make([]SpliceCommitDiff, numSpliceDiffs)
+	for i := range d.SpliceDiffs {
+		spliceDiff := &d.SpliceDiffs[i]
+		_, err := io.ReadFull(r, spliceDiff.SpliceTxid[:])
+		if err != nil {
+			return nil, err
+		}
+		spliceDiff.Commitment, err = deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+		spliceDiff.CommitSig = &lnwire.SpliceCommitSig{}
+		if err := spliceDiff.CommitSig.Decode(r, 0); err != nil {
+			return nil, err
+		}
+	}
+
 	return &d, nil
 }
 
@@ -1465,7 +2230,7 @@ func (c *OpenChannel) AppendRemoteCommitChain(diff *CommitDiff) error {
 		return ErrNoRestoredChannelMutation
 	}
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		// First, we'll grab the writable bucket where this channel's
 		// data resides.
 		chanBucket, err := fetchChanBucket(
@@ -1485,6 +2250,10 @@ func (c *OpenChannel) AppendRemoteCommitChain(diff *CommitDiff) error {
 			return ErrChanBorked
 		}
 
+		if err := c.checkSplicedOutpoint(chanBucket); err != nil {
+			return err
+		}
+
 		// Any outgoing settles and fails necessarily have a
 		// corresponding adds in this channel's forwarding packages.
 		// Mark all of these as being fully processed in our forwarding
@@ -1525,7 +2294,7 @@ func (c *OpenChannel) AppendRemoteCommitChain(diff *CommitDiff) error {
 // these pointers, causing the tip and the tail to point to the same entry.
 func (c *OpenChannel) RemoteCommitChainTip() (*CommitDiff, error) {
 	var cd *CommitDiff
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -1571,7 +2340,7 @@ func (c *OpenChannel) InsertNextRevocation(revKey *btcec.PublicKey) error {
 
 	c.RemoteNextRevocation = revKey
 
-	err := c.Db.Update(func(tx *bbolt.Tx) error {
+	err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -1606,9 +2375,11 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg) error {
 		return ErrNoRestoredChannelMutation
 	}
 
-	var newRemoteCommit *ChannelCommitment
-
-	err := c.Db.Update(func(tx *bbolt.Tx) error {
+	var (
+		newRemoteCommit *ChannelCommitment
+		pendingSplices  []*PendingSplice
+	)
+	err := c.Db.Update(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -1626,6 +2397,10 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg) error {
 			return ErrChanBorked
 		}
 
+		if err := c.checkSplicedOutpoint(chanBucket); err != nil {
+			return err
+		}
+
 		// Persist the latest preimage state to disk as the remote peer
 		// has just added to our local preimage store, and given us a
 		// new pending revocation key.
@@ -1674,6 +2449,16 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg) error {
 			return err
 		}
 
+		// The commitments of the remote party spending the funding
+		// outputs of the pending splices have been revoked as well, so
+		// we'll add them to the revocation logs of their splices.
+		pendingSplices, err = advanceSpliceCommitChains(
+			chanBucket, c.pendingSplices, newCommit.SpliceDiffs,
+		)
+		if err != nil {
+			return err
+		}
+
 		// Lastly, we write the forwarding package to disk so that we
 		// can properly recover from failures and reforward HTLCs that
 		// have not received a corresponding settle/fail.
@@ -1693,10 +2478,72 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg) error {
 	// pointer of the new remote commitment, which was previously the tip
 	// of the commit chain.
 	c.RemoteCommitment = *newRemoteCommit
+	if pendingSplices != nil {
+		c.pendingSplices = pendingSplices
+	}
 
 	return nil
 }
 
+// advanceSpliceCommitChains appends the current commitment of the remote party
+// spending the funding output of each of the passed pending splices to the
+// revocation log of the splice, then replaces it by its new commitment found
+// within the passed splice diffs. The updated pending splices are written to
+// the bucket of the channel and returned.
+func advanceSpliceCommitChains(chanBucket kvdb.Bucket,
+	pendingSplices []*PendingSplice,
+	spliceDiffs []SpliceCommitDiff) ([]*PendingSplice, error) {
+
+	if len(pendingSplices) == 0 {
+		return nil, nil
+	}
+
+	spliceLogs, err := chanBucket.CreateBucketIfNotExists(
+		spliceRevocationLogBucket,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedSplices := make([]*PendingSplice, len(pendingSplices))
+	for i, splice := range pendingSplices {
+		spliceTxid := splice.SpliceTx.TxHash()
+
+		var newCommit *ChannelCommitment
+		for j := range spliceDiffs {
+			if spliceDiffs[j].SpliceTxid == spliceTxid {
+				newCommit = &spliceDiffs[j].Commitment
+				break
+			}
+		}
+		if newCommit == nil {
+			return nil, fmt.Errorf("no commitment for splice %v",
+				spliceTxid)
+		}
+
+		spliceLog, err := spliceLogs.CreateBucketIfNotExists(
+			spliceTxid[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = appendChannelLogEntry(spliceLog, &splice.RemoteCommitment)
+		if err != nil {
+			return nil, err
+		}
+
+		updatedSplice := *splice
+		updatedSplice.RemoteCommitment = *newCommit
+		updatedSplices[i] = &updatedSplice
+	}
+
+	if err := putPendingSplices(chanBucket, updatedSplices); err != nil {
+		return nil, err
+	}
+
+	return updatedSplices, nil
+}
+
 // NextLocalHtlcIndex returns the next unallocated local htlc index. To ensure
 // this always returns the next index that has been not been allocated, this
 // will first try to examine any pending commitments, before falling back to the
@@ -1728,7 +2575,7 @@ func (c *OpenChannel) LoadFwdPkgs() ([]*FwdPkg, error) {
 	defer c.RUnlock()
 
 	var fwdPkgs []*FwdPkg
-	if err := c.Db.View(func(tx *bbolt.Tx) error {
+	if err := c.Db.View(func(tx kvdb.Tx) error {
 		var err error
 		fwdPkgs, err = c.Packager.LoadFwdPkgs(tx)
 		return err
@@ -1746,7 +2593,7 @@ func (c *OpenChannel) AckAddHtlcs(addRefs ...AddRef) error {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		return c.Packager.AckAddHtlcs(tx, addRefs...)
 	})
 }
@@ -1759,7 +2606,7 @@ func (c *OpenChannel) AckSettleFails(settleFailRefs ...SettleFailRef) error {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		return c.Packager.AckSettleFails(tx, settleFailRefs...)
 	})
 }
@@ -1770,7 +2617,7 @@ func (c *OpenChannel) SetFwdFilter(height uint64, fwdFilter *PkgFilter) error {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		return c.Packager.SetFwdFilter(tx, height, fwdFilter)
 	})
 }
@@ -1783,7 +2630,7 @@ func (c *OpenChannel) RemoveFwdPkg(height uint64) error {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		return c.Packager.RemovePkg(tx, height)
 	})
 }
@@ -1803,7 +2650,7 @@ func (c *OpenChannel) RevocationLogTail() (*ChannelCommitment, error) {
 	}
 
 	var commit ChannelCommitment
-	if err := c.Db.View(func(tx *bbolt.Tx) error {
+	if err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -1850,7 +2697,7 @@ func (c *OpenChannel) CommitmentHeight() (uint64, error) {
 	defer c.RUnlock()
 
 	var height uint64
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		// Get the bucket dedicated to storing the metadata for open
 		// channels.
 		chanBucket, err := fetchChanBucket(
@@ -1885,7 +2732,7 @@ func (c *OpenChannel) FindPreviousState(updateNum uint64) (*ChannelCommitment, e
 	defer c.RUnlock()
 
 	var commit ChannelCommitment
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -2039,7 +2886,7 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	return c.Db.Update(func(tx kvdb.Tx) error {
 		openChanBucket := tx.Bucket(openChannelBucket)
 		if openChanBucket == nil {
 			return ErrNoChanDBExists
@@ -2183,7 +3030,7 @@ func (c *OpenChannel) Snapshot() *ChannelSnapshot {
 // latest fully committed state is returned. The first commitment returned is
 // the local commitment, and the second returned is the remote commitment.
 func (c *OpenChannel) LatestCommitments() (*ChannelCommitment, *ChannelCommitment, error) {
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -2205,7 +3052,7 @@ func (c *OpenChannel) LatestCommitments() (*ChannelCommitment, *ChannelCommitmen
 // acting on a possible contract breach to ensure, that the caller has the most
 // up to date information required to deliver justice.
 func (c *OpenChannel) RemoteRevocationStore() (shachain.Store, error) {
-	err := c.Db.View(func(tx *bbolt.Tx) error {
+	err := c.Db.View(func(tx kvdb.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -2222,7 +3069,7 @@ func (c *OpenChannel) RemoteRevocationStore() (shachain.Store, error) {
 	return c.RevocationStore, nil
 }
 
-func putChannelCloseSummary(tx *bbolt.Tx, chanID []byte,
+func putChannelCloseSummary(tx kvdb.Tx, chanID []byte,
 	summary *ChannelCloseSummary, lastChanState *OpenChannel) error {
 
 	closedChanBucket, err := tx.CreateBucketIfNotExists(closedChannelBucket)
@@ -2301,7 +3148,7 @@ func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
 	return nil
 }
 
-func fetchChannelCloseSummary(tx *bbolt.Tx,
+func fetchChannelCloseSummary(tx kvdb.Tx,
 	chanID []byte) (*ChannelCloseSummary, error) {
 
 	closedChanBucket, err := tx.CreateBucketIfNotExists(closedChannelBucket)
@@ -2408,7 +3255,7 @@ func writeChanConfig(b io.Writer, c *ChannelConfig) error {
 	)
 }
 
-func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func putChanInfo(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 	var w bytes.Buffer
 	if err := WriteElements(&w,
 		channel.ChanType, channel.ChainHash, channel.FundingOutpoint,
@@ -2437,7 +3284,73 @@ func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return chanBucket.Put(chanInfoKey, w.Bytes())
+	if err := chanBucket.Put(chanInfoKey, w.Bytes()); err != nil {
+		return err
+	}
+
+	// Finally, we'll write out the optional upfront shutdown scripts for
+	// both parties. These are stored under distinct keys so channels
+	// created before upfront shutdown scripts were supported can still be
+	// read without a migration.
+	err := putOptionalUpfrontShutdownScript(
+		chanBucket, localUpfrontShutdownKey,
+		channel.LocalShutdownScript,
+	)
+	if err != nil {
+		return err
+	}
+	err = putOptionalUpfrontShutdownScript(
+		chanBucket, remoteUpfrontShutdownKey,
+		channel.RemoteShutdownScript,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The locator of the revocation root key is stored under a distinct
+	// key as well, as it's only known for channels whose revocation root
+	// was derived through ECDH.
+	revKeyLoc := channel.RevocationKeyLocator
+	if revKeyLoc.Family != keychain.KeyFamilyRevocationRoot {
+		return nil
+	}
+
+	var b bytes.Buffer
+	err = WriteElements(&b, uint32(revKeyLoc.Family), revKeyLoc.Index)
+	if err != nil {
+		return err
+	}
+
+	return chanBucket.Put(revocationKeyLocatorKey, b.Bytes())
+}
+
+// putOptionalUpfrontShutdownScript adds a shutdown script under the key
+// provided if it has a non-zero length.
+func putOptionalUpfrontShutdownScript(chanBucket kvdb.Bucket, key []byte,
+	script lnwire.DeliveryAddress) error {
+
+	// If the script is empty, we don't need to add anything.
+	if len(script) == 0 {
+		return nil
+	}
+
+	return chanBucket.Put(key, script)
+}
+
+// getOptionalUpfrontShutdownScript reads the shutdown script stored under the
+// key provided if it is present. Upfront shutdown scripts are optional, so the
+// function returns a nil script if the key isn't found.
+func getOptionalUpfrontShutdownScript(chanBucket kvdb.Bucket,
+	key []byte) lnwire.DeliveryAddress {
+
+	script := chanBucket.Get(key)
+	if script == nil {
+		return nil
+	}
+
+	// As the returned slice is only valid for the lifetime of the
+	// transaction, we'll make a copy of it.
+	return append(lnwire.DeliveryAddress(nil), script...)
 }
 
 func serializeChanCommit(w io.Writer, c *ChannelCommitment) error {
@@ -2453,7 +3366,7 @@ func serializeChanCommit(w io.Writer, c *ChannelCommitment) error {
 	return SerializeHtlcs(w, c.Htlcs...)
 }
 
-func putChanCommitment(chanBucket *bbolt.Bucket, c *ChannelCommitment,
+func putChanCommitment(chanBucket kvdb.Bucket, c *ChannelCommitment,
 	local bool) error {
 
 	var commitKey []byte
@@ -2471,7 +3384,7 @@ func putChanCommitment(chanBucket *bbolt.Bucket, c *ChannelCommitment,
 	return chanBucket.Put(commitKey, b.Bytes())
 }
 
-func putChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func putChanCommitments(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 	// If this is a restored channel, then we don't have any commitments to
 	// write.
 	if channel.hasChanStatus(ChanStatusRestored) {
@@ -2490,7 +3403,7 @@ func putChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	)
 }
 
-func putChanRevocationState(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func putChanRevocationState(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 
 	var b bytes.Buffer
 	err := WriteElements(
@@ -2525,7 +3438,7 @@ func readChanConfig(b io.Reader, c *ChannelConfig) error {
 	)
 }
 
-func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func fetchChanInfo(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 	infoBytes := chanBucket.Get(chanInfoKey)
 	if infoBytes == nil {
 		return ErrNoChanInfoFound
@@ -2559,11 +3472,208 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
+	// Retrieve the optional upfront shutdown scripts, which will be nil
+	// if neither party committed to one when opening the channel.
+	channel.LocalShutdownScript = getOptionalUpfrontShutdownScript(
+		chanBucket, localUpfrontShutdownKey,
+	)
+	channel.RemoteShutdownScript = getOptionalUpfrontShutdownScript(
+		chanBucket, remoteUpfrontShutdownKey,
+	)
+
+	// Retrieve the optional locator of the revocation root key, which is
+	// only stored for channels whose revocation root was derived through
+	// ECDH.
+	locBytes := chanBucket.Get(revocationKeyLocatorKey)
+	if locBytes != nil {
+		var family uint32
+		err := ReadElements(
+			bytes.NewReader(locBytes), &family,
+			&channel.RevocationKeyLocator.Index,
+		)
+		if err != nil {
+			return err
+		}
+		channel.RevocationKeyLocator.Family = keychain.KeyFamily(family)
+	}
+
+	// Retrieve the optional confirmed short channel ID, which is only
+	// stored for zero-conf channels once their funding transaction
+	// confirmed.
+	if scidBytes := chanBucket.Get(confirmedScidKey); scidBytes != nil {
+		err := ReadElement(
+			bytes.NewReader(scidBytes), &channel.confirmedShortChanID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Retrieve the optional splice state, which is only stored for
+	// channels that have been spliced.
+	splicedOutpoint, err := fetchSplicedOutpoint(chanBucket)
+	if err != nil {
+		return err
+	}
+	channel.splicedOutpoint = splicedOutpoint
+	pendingSplices, err := fetchPendingSplices(chanBucket)
+	if err != nil {
+		return err
+	}
+	channel.pendingSplices = pendingSplices
+	if txidBytes := chanBucket.Get(confirmedSpliceKey); txidBytes != nil {
+		confirmedSplice, err := chainhash.NewHash(txidBytes)
+		if err != nil {
+			return err
+		}
+		channel.confirmedSplice = confirmedSplice
+	}
+
+	// Likewise, the remote alias is only stored for zero-conf channels,
+	// once the remote party sent it.
+	if aliasBytes := chanBucket.Get(remoteAliasKey); aliasBytes != nil {
+		err := ReadElement(
+			bytes.NewReader(aliasBytes), &channel.remoteAlias,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
 	return nil
 }
 
+// fetchSplicedOutpoint reads the outpoint of the funding output created by
+// the latest locked in splice of a channel, returning nil if the channel was
+// never spliced.
+func fetchSplicedOutpoint(chanBucket kvdb.Bucket) (*wire.OutPoint, error) {
+	opBytes := chanBucket.Get(splicedOutpointKey)
+	if opBytes == nil {
+		return nil, nil
+	}
+
+	var splicedOutpoint wire.OutPoint
+	err := readOutpoint(bytes.NewReader(opBytes), &splicedOutpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &splicedOutpoint, nil
+}
+
+// putPendingSplices writes the passed pending splices to the bucket of a
+// channel. If there are none, the key is removed altogether.
+func putPendingSplices(chanBucket kvdb.Bucket,
+	pendingSplices []*PendingSplice) error {
+
+	if len(pendingSplices) == 0 {
+		return chanBucket.Delete(pendingSplicesKey)
+	}
+
+	var b bytes.Buffer
+	if err := WriteElement(&b, uint16(len(pendingSplices))); err != nil {
+		return err
+	}
+	for _, splice := range pendingSplices {
+		err := WriteElements(&b,
+			splice.SpliceTx, splice.FundingOutpoint, splice.Capacity,
+		)
+		if err != nil {
+			return err
+		}
+		err = serializeChanCommit(&b, &splice.LocalCommitment)
+		if err != nil {
+			return err
+		}
+		err = serializeChanCommit(&b, &splice.RemoteCommitment)
+		if err != nil {
+			return err
+		}
+
+		err = WriteElements(&b,
+			splice.LocalDelta, splice.RemoteDelta, splice.FeePerKw,
+			uint16(len(splice.PrevOutputs)),
+		)
+		if err != nil {
+			return err
+		}
+		for _, prevOut := range splice.PrevOutputs {
+			err := WriteElements(&b,
+				btcutil.Amount(prevOut.Value), prevOut.PkScript,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return chanBucket.Put(pendingSplicesKey, b.Bytes())
+}
+
+// fetchPendingSplices reads the pending splices stored within the bucket of a
+// channel, returning nil if there are none.
+func fetchPendingSplices(chanBucket kvdb.Bucket) ([]*PendingSplice, error) {
+	splicesBytes := chanBucket.Get(pendingSplicesKey)
+	if splicesBytes == nil {
+		return nil, nil
+	}
+	r := bytes.NewReader(splicesBytes)
+
+	var numSplices uint16
+	if err := ReadElement(r, &numSplices); err != nil {
+		return nil, err
+	}
+
+	pendingSplices := make([]*PendingSplice, numSplices)
+	for i := range pendingSplices {
+		splice := &PendingSplice{}
+		err := ReadElements(r,
+			&splice.SpliceTx, &splice.FundingOutpoint,
+			&splice.Capacity,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		splice.LocalCommitment, err = deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+		splice.RemoteCommitment, err = deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var numPrevOutputs uint16
+		err = ReadElements(r,
+			&splice.LocalDelta, &splice.RemoteDelta,
+			&splice.FeePerKw, &numPrevOutputs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < int(numPrevOutputs); j++ {
+			var (
+				value    btcutil.Amount
+				pkScript []byte
+			)
+			if err := ReadElements(r, &value, &pkScript); err != nil {
+				return nil, err
+			}
+			splice.PrevOutputs = append(
+				splice.PrevOutputs,
+				wire.NewTxOut(int64(value), pkScript),
+			)
+		}
+
+		pendingSplices[i] = splice
+	}
+
+	return pendingSplices, nil
+}
+
 func deserializeChanCommit(r io.Reader) (ChannelCommitment, error) {
 	var c ChannelCommitment
 
@@ -2584,7 +3694,7 @@ func deserializeChanCommit(r io.Reader) (ChannelCommitment, error) {
 	return c, nil
 }
 
-func fetchChanCommitment(chanBucket *bbolt.Bucket, local bool) (ChannelCommitment, error) {
+func fetchChanCommitment(chanBucket kvdb.Bucket, local bool) (ChannelCommitment, error) {
 	var commitKey []byte
 	if local {
 		commitKey = append(chanCommitmentKey, byte(0x00))
@@ -2601,7 +3711,7 @@ func fetchChanCommitment(chanBucket *bbolt.Bucket, local bool) (ChannelCommitmen
 	return deserializeChanCommit(r)
 }
 
-func fetchChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func fetchChanCommitments(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 	var err error
 
 	// If this is a restored channel, then we don't have any commitments to
@@ -2622,7 +3732,7 @@ func fetchChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error
 	return nil
 }
 
-func fetchChanRevocationState(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+func fetchChanRevocationState(chanBucket kvdb.Bucket, channel *OpenChannel) error {
 	revBytes := chanBucket.Get(revocationStateKey)
 	if revBytes == nil {
 		return ErrNoRevocationsFound
@@ -2648,7 +3758,7 @@ func fetchChanRevocationState(chanBucket *bbolt.Bucket, channel *OpenChannel) er
 	return ReadElements(r, &channel.RemoteNextRevocation)
 }
 
-func deleteOpenChannel(chanBucket *bbolt.Bucket, chanPointBytes []byte) error {
+func deleteOpenChannel(chanBucket kvdb.Bucket, chanPointBytes []byte) error {
 
 	if err := chanBucket.Delete(chanInfoKey); err != nil {
 		return err
@@ -2667,6 +3777,33 @@ func deleteOpenChannel(chanBucket *bbolt.Bucket, chanPointBytes []byte) error {
 		return err
 	}
 
+	err = chanBucket.Delete(localUpfrontShutdownKey)
+	if err != nil {
+		return err
+	}
+	err = chanBucket.Delete(remoteUpfrontShutdownKey)
+	if err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(confirmedScidKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(remoteAliasKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(revocationKeyLocatorKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(splicedOutpointKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(pendingSplicesKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(confirmedSpliceKey); err != nil {
+		return err
+	}
+
 	if diff := chanBucket.Get(commitDiffKey); diff != nil {
 		return chanBucket.Delete(commitDiffKey)
 	}
@@ -2689,7 +3826,7 @@ func readLogKey(b []byte) uint64 {
 	return byteOrder.Uint64(b)
 }
 
-func appendChannelLogEntry(log *bbolt.Bucket,
+func appendChannelLogEntry(log kvdb.Bucket,
 	commit *ChannelCommitment) error {
 
 	var b bytes.Buffer
@@ -2701,7 +3838,7 @@ func appendChannelLogEntry(log *bbolt.Bucket,
 	return log.Put(logEntrykey[:], b.Bytes())
 }
 
-func fetchChannelLogEntry(log *bbolt.Bucket,
+func fetchChannelLogEntry(log kvdb.Bucket,
 	updateNum uint64) (ChannelCommitment, error) {
 
 	logEntrykey := makeLogKey(updateNum)
@@ -2714,7 +3851,7 @@ func fetchChannelLogEntry(log *bbolt.Bucket,
 	return deserializeChanCommit(commitReader)
 }
 
-func wipeChannelLogEntries(log *bbolt.Bucket) error {
+func wipeChannelLogEntries(log kvdb.Bucket) error {
 	// TODO(roasbeef): comment
 
 	logCursor := log.Cursor()
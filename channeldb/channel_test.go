@@ -241,6 +241,12 @@ func createTestChannelState(cdb *DB) (*OpenChannel, error) {
 		Db:                      cdb,
 		Packager:                NewChannelPackager(chanID),
 		FundingTxn:              testTx,
+		LocalShutdownScript:     bytes.Repeat([]byte{2}, 22),
+		RemoteShutdownScript:    bytes.Repeat([]byte{3}, 34),
+		RevocationKeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyRevocationRoot,
+			Index:  9,
+		},
 	}, nil
 }
 
@@ -435,7 +441,7 @@ func TestChannelStateTransition(t *testing.T) {
 	// First update the local node's broadcastable state and also add a
 	// CommitDiff remote node's as well in order to simulate a proper state
 	// transition.
-	if err := channel.UpdateCommitment(&commitment); err != nil {
+	if err := channel.UpdateCommitment(&commitment, nil); err != nil {
 		t.Fatalf("unable to update commitment: %v", err)
 	}
 
@@ -1026,3 +1032,480 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestZeroConfConfirmedShortChanID asserts that a zero-conf channel keeps its
+// alias as short channel ID once its funding transaction confirms, while the
+// confirmed short channel ID and the alias of the remote party are persisted
+// next to it.
+func TestZeroConfConfirmedShortChanID(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Open the channel using its alias, as done for zero-conf channels.
+	alias, err := lnwire.NewAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to create alias: %v", err)
+	}
+	if err := state.MarkAsOpen(alias); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	remoteAlias, err := lnwire.NewAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to create alias: %v", err)
+	}
+	if err := state.SetRemoteAlias(remoteAlias); err != nil {
+		t.Fatalf("unable to set remote alias: %v", err)
+	}
+
+	// As long as the funding transaction isn't confirmed, there should be
+	// no confirmed short channel ID.
+	if sid := state.ConfirmedShortChanID(); sid != (lnwire.ShortChannelID{}) {
+		t.Fatalf("expected no confirmed short_chan_id, got %v", sid)
+	}
+
+	confirmedLoc := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := state.MarkConfirmed(confirmedLoc); err != nil {
+		t.Fatalf("unable to mark channel confirmed: %v", err)
+	}
+
+	// Both the in-memory and the persisted channel should report the alias
+	// as short channel ID, and know the confirmed short channel ID as well
+	// as the remote alias.
+	channels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected one open channel, got %v", len(channels))
+	}
+
+	for _, channel := range []*OpenChannel{state, channels[0]} {
+		if channel.ShortChanID() != alias {
+			t.Fatalf("expected short_chan_id %v, got %v", alias,
+				channel.ShortChanID())
+		}
+		if channel.ConfirmedShortChanID() != confirmedLoc {
+			t.Fatalf("expected confirmed short_chan_id %v, got %v",
+				confirmedLoc, channel.ConfirmedShortChanID())
+		}
+		if channel.RemoteAlias() != remoteAlias {
+			t.Fatalf("expected remote alias %v, got %v",
+				remoteAlias, channel.RemoteAlias())
+		}
+	}
+}
+
+// newTestSplice creates a pending splice of the passed channel whose funding
+// output carries the given capacity.
+func newTestSplice(state *OpenChannel, capacity btcutil.Amount) *PendingSplice {
+	spliceTx := testTx.Copy()
+	spliceTx.TxOut[0].Value = int64(capacity)
+
+	localCommit := state.LocalCommitment
+	localCommit.LocalBalance = lnwire.NewMSatFromSatoshis(capacity)
+	remoteCommit := state.RemoteCommitment
+	remoteCommit.RemoteBalance = lnwire.NewMSatFromSatoshis(capacity)
+
+	return &PendingSplice{
+		SpliceTx: spliceTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash: spliceTx.TxHash(),
+		},
+		Capacity:         capacity,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+		LocalDelta:       capacity - state.Capacity,
+		FeePerKw:         253,
+		PrevOutputs: []*wire.TxOut{
+			wire.NewTxOut(int64(capacity), testTx.TxOut[0].PkScript),
+		},
+	}
+}
+
+// newTestSpliceDiff creates a commit diff extending a new commitment at the
+// given height to the remote party of the passed channel, along with its
+// variant spending the funding output of each of the passed splices.
+func newTestSpliceDiff(state *OpenChannel, height uint64,
+	splices []*PendingSplice) *CommitDiff {
+
+	chanID := lnwire.NewChanIDFromOutPoint(&state.FundingOutpoint)
+
+	commitment := state.RemoteCommitment
+	commitment.CommitHeight = height
+	diff := &CommitDiff{
+		Commitment: commitment,
+		CommitSig: &lnwire.CommitSig{
+			ChanID:    chanID,
+			CommitSig: wireSig,
+			HtlcSigs:  []lnwire.Sig{wireSig},
+		},
+		LogUpdates:        []LogUpdate{},
+		OpenedCircuitKeys: []CircuitKey{},
+		ClosedCircuitKeys: []CircuitKey{},
+	}
+	for _, splice := range splices {
+		spliceCommit := splice.RemoteCommitment
+		spliceCommit.CommitHeight = height
+
+		diff.SpliceDiffs = append(diff.SpliceDiffs, SpliceCommitDiff{
+			SpliceTxid: splice.SpliceTx.TxHash(),
+			Commitment: spliceCommit,
+			CommitSig: &lnwire.SpliceCommitSig{
+				ChannelID:  chanID,
+				SpliceTxid: splice.SpliceTx.TxHash(),
+				CommitSig:  wireSig,
+				HtlcSigs:   []lnwire.Sig{wireSig},
+			},
+		})
+	}
+
+	return diff
+}
+
+// TestLockInSplice tests that pending splices of a channel are persisted and
+// advance along with the commitments of the channel, and that locking in one
+// of them replaces the funding output, capacity, commitments, pending commit
+// diff and revocation log of the channel.
+func TestLockInSplice(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Before the channel is spliced, its funding output is the one it
+	// was opened with.
+	if state.CurrentFundingOutpoint() != state.FundingOutpoint {
+		t.Fatalf("expected funding outpoint %v, got %v",
+			state.FundingOutpoint, state.CurrentFundingOutpoint())
+	}
+
+	// We'll add two competing splices of the channel, each with their own
+	// splice transaction and commitments.
+	splices := []*PendingSplice{
+		newTestSplice(state, 20000), newTestSplice(state, 30000),
+	}
+	for _, splice := range splices {
+		if err := state.AddPendingSplice(splice); err != nil {
+			t.Fatalf("unable to add pending splice: %v", err)
+		}
+	}
+
+	fetchChannel := func() *OpenChannel {
+		channels, err := cdb.FetchOpenChannels(state.IdentityPub)
+		if err != nil {
+			t.Fatalf("unable to fetch open channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected one open channel, got %v",
+				len(channels))
+		}
+
+		return channels[0]
+	}
+
+	// Both splices should be found after a restart, while the channel is
+	// still funded by its original funding output.
+	channel := fetchChannel()
+	if !reflect.DeepEqual(channel.PendingSplices(), splices) {
+		t.Fatalf("pending splices don't match: expected %v, got %v",
+			spew.Sdump(splices), spew.Sdump(channel.PendingSplices()))
+	}
+	if channel.CurrentFundingOutpoint() != state.FundingOutpoint {
+		t.Fatalf("expected funding outpoint %v, got %v",
+			state.FundingOutpoint, channel.CurrentFundingOutpoint())
+	}
+
+	// Updating our commitment should fail unless a variant spending the
+	// funding output of each splice is provided.
+	newLocalCommit := state.LocalCommitment
+	newLocalCommit.CommitHeight = 1
+	err = state.UpdateCommitment(&newLocalCommit, nil)
+	if err == nil {
+		t.Fatalf("expected commitment update without splice " +
+			"commitments to fail")
+	}
+	spliceCommits := make(map[chainhash.Hash]*ChannelCommitment)
+	for _, splice := range splices {
+		spliceCommit := splice.LocalCommitment
+		spliceCommit.CommitHeight = 1
+		spliceCommits[splice.SpliceTx.TxHash()] = &spliceCommit
+	}
+	err = state.UpdateCommitment(&newLocalCommit, spliceCommits)
+	if err != nil {
+		t.Fatalf("unable to update commitment: %v", err)
+	}
+	for _, splice := range fetchChannel().PendingSplices() {
+		spliceCommit := spliceCommits[splice.SpliceTx.TxHash()]
+		if !reflect.DeepEqual(&splice.LocalCommitment, spliceCommit) {
+			t.Fatalf("splice commitment wasn't updated")
+		}
+	}
+
+	// Next, we'll extend a new commitment to the remote party, which they
+	// then revoke their prior commitment for. The revoked variants should
+	// be recorded, and replaced by the new ones.
+	diff := newTestSpliceDiff(state, 1, splices)
+	if err := state.AppendRemoteCommitChain(diff); err != nil {
+		t.Fatalf("unable to add to commit chain: %v", err)
+	}
+	diskDiff, err := state.RemoteCommitChainTip()
+	if err != nil {
+		t.Fatalf("unable to fetch commit diff: %v", err)
+	}
+	if !reflect.DeepEqual(diff, diskDiff) {
+		t.Fatalf("commit diffs don't match: expected %v, got %v",
+			spew.Sdump(diff), spew.Sdump(diskDiff))
+	}
+
+	revokedCommit := splices[1].RemoteCommitment
+	fwdPkg := NewFwdPkg(state.ShortChanID(), 0, nil, nil)
+	if err := state.AdvanceCommitChainTail(fwdPkg); err != nil {
+		t.Fatalf("unable to advance commit chain: %v", err)
+	}
+	for i, splice := range fetchChannel().PendingSplices() {
+		if !reflect.DeepEqual(
+			splice.RemoteCommitment, diff.SpliceDiffs[i].Commitment,
+		) {
+			t.Fatalf("splice commitment wasn't advanced")
+		}
+	}
+	lockedCommit := diff.SpliceDiffs[1].Commitment
+
+	// We'll then extend another commitment, which is left pending.
+	diff = newTestSpliceDiff(state, 2, splices)
+	if err := state.AppendRemoteCommitChain(diff); err != nil {
+		t.Fatalf("unable to add to commit chain: %v", err)
+	}
+
+	// Marking an unknown splice as confirmed should fail.
+	_, err = state.MarkSpliceConfirmed(chainhash.Hash{})
+	if err != ErrSpliceNotFound {
+		t.Fatalf("expected ErrSpliceNotFound, got %v", err)
+	}
+
+	// Once the second splice is marked as confirmed, it can no longer be
+	// abandoned.
+	splice := splices[1]
+	spliceTxid := splice.SpliceTx.TxHash()
+	if _, err := state.MarkSpliceConfirmed(spliceTxid); err != nil {
+		t.Fatalf("unable to mark splice confirmed: %v", err)
+	}
+	confirmedSplice := fetchChannel().ConfirmedSplice()
+	if confirmedSplice == nil || *confirmedSplice != spliceTxid {
+		t.Fatalf("expected confirmed splice %v, got %v", spliceTxid,
+			confirmedSplice)
+	}
+	if err := state.AbandonSplice(spliceTxid); err != ErrSpliceConfirmed {
+		t.Fatalf("expected ErrSpliceConfirmed, got %v", err)
+	}
+
+	// Locking in an unknown splice should fail.
+	err = state.LockInSplice(chainhash.Hash{})
+	if err != ErrSpliceNotFound {
+		t.Fatalf("expected ErrSpliceNotFound, got %v", err)
+	}
+
+	// Lock in the second splice. Afterwards, the channel should be funded
+	// by its funding output, both in memory and on disk, while still being
+	// identified by its original funding outpoint.
+	staleChannel := fetchChannel()
+	if err := state.LockInSplice(spliceTxid); err != nil {
+		t.Fatalf("unable to lock in splice: %v", err)
+	}
+
+	for _, channel := range []*OpenChannel{state, fetchChannel()} {
+		if channel.CurrentFundingOutpoint() != splice.FundingOutpoint {
+			t.Fatalf("expected funding outpoint %v, got %v",
+				splice.FundingOutpoint,
+				channel.CurrentFundingOutpoint())
+		}
+		if channel.FundingOutpoint == splice.FundingOutpoint {
+			t.Fatalf("channel point shouldn't change")
+		}
+		if channel.Capacity != splice.Capacity {
+			t.Fatalf("expected capacity %v, got %v",
+				splice.Capacity, channel.Capacity)
+		}
+		if !reflect.DeepEqual(
+			channel.LocalCommitment, *spliceCommits[spliceTxid],
+		) {
+			t.Fatalf("local commitment wasn't replaced")
+		}
+		if !reflect.DeepEqual(channel.RemoteCommitment, lockedCommit) {
+			t.Fatalf("remote commitment wasn't replaced")
+		}
+		if len(channel.PendingSplices()) != 0 {
+			t.Fatalf("expected no pending splices, got %v",
+				len(channel.PendingSplices()))
+		}
+		if channel.ConfirmedSplice() != nil {
+			t.Fatalf("expected no confirmed splice")
+		}
+	}
+
+	// The pending commit diff should have been replaced by its variant
+	// spending the new funding output.
+	diskDiff, err = state.RemoteCommitChainTip()
+	if err != nil {
+		t.Fatalf("unable to fetch commit diff: %v", err)
+	}
+	spliceDiff := diff.SpliceDiffs[1]
+	if !reflect.DeepEqual(diskDiff.Commitment, spliceDiff.Commitment) {
+		t.Fatalf("commit diff wasn't replaced")
+	}
+	if diskDiff.CommitSig.CommitSig != spliceDiff.CommitSig.CommitSig ||
+		len(diskDiff.SpliceDiffs) != 0 {
+
+		t.Fatalf("commit sig of commit diff wasn't replaced")
+	}
+
+	// The revocation log should now hold the revoked variant spending the
+	// new funding output.
+	prevCommit, err := state.FindPreviousState(0)
+	if err != nil {
+		t.Fatalf("unable to find previous state: %v", err)
+	}
+	if !reflect.DeepEqual(prevCommit, &revokedCommit) {
+		t.Fatalf("revocation log wasn't replaced: expected %v, got %v",
+			spew.Sdump(revokedCommit), spew.Sdump(prevCommit))
+	}
+
+	// A stale instance of the channel shouldn't be able to overwrite the
+	// promoted state, until the splice is locked in through it as well.
+	err = staleChannel.UpdateCommitment(&newLocalCommit, spliceCommits)
+	if err != ErrChanSpliced {
+		t.Fatalf("expected ErrChanSpliced, got %v", err)
+	}
+	if err := staleChannel.LockInSplice(spliceTxid); err != nil {
+		t.Fatalf("unable to lock in splice: %v", err)
+	}
+	if staleChannel.CurrentFundingOutpoint() != splice.FundingOutpoint {
+		t.Fatalf("expected funding outpoint %v, got %v",
+			splice.FundingOutpoint,
+			staleChannel.CurrentFundingOutpoint())
+	}
+	if staleChannel.Capacity != splice.Capacity {
+		t.Fatalf("expected capacity %v, got %v", splice.Capacity,
+			staleChannel.Capacity)
+	}
+}
+
+// TestAbandonSplice tests that abandoning a pending splice discards it along
+// with its commitments, while leaving other pending splices untouched.
+func TestAbandonSplice(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := state.FullSync(); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	splices := []*PendingSplice{
+		newTestSplice(state, 20000), newTestSplice(state, 30000),
+	}
+	for _, splice := range splices {
+		if err := state.AddPendingSplice(splice); err != nil {
+			t.Fatalf("unable to add pending splice: %v", err)
+		}
+	}
+
+	// We'll extend a new commitment to the remote party, so both splices
+	// have a revocation log, then another one that is left pending.
+	diff := newTestSpliceDiff(state, 1, splices)
+	if err := state.AppendRemoteCommitChain(diff); err != nil {
+		t.Fatalf("unable to add to commit chain: %v", err)
+	}
+	fwdPkg := NewFwdPkg(state.ShortChanID(), 0, nil, nil)
+	if err := state.AdvanceCommitChainTail(fwdPkg); err != nil {
+		t.Fatalf("unable to advance commit chain: %v", err)
+	}
+	diff = newTestSpliceDiff(state, 2, splices)
+	if err := state.AppendRemoteCommitChain(diff); err != nil {
+		t.Fatalf("unable to add to commit chain: %v", err)
+	}
+
+	// Abandon the first splice. Only the second one should remain, both
+	// within the channel and the pending commit diff.
+	spliceTxid := splices[0].SpliceTx.TxHash()
+	if err := state.AbandonSplice(spliceTxid); err != nil {
+		t.Fatalf("unable to abandon splice: %v", err)
+	}
+
+	channels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	for _, channel := range []*OpenChannel{state, channels[0]} {
+		pendingSplices := channel.PendingSplices()
+		if len(pendingSplices) != 1 ||
+			pendingSplices[0].SpliceTx.TxHash() ==
+				spliceTxid {
+
+			t.Fatalf("expected only second splice to remain, "+
+				"got %v", spew.Sdump(pendingSplices))
+		}
+	}
+
+	diskDiff, err := state.RemoteCommitChainTip()
+	if err != nil {
+		t.Fatalf("unable to fetch commit diff: %v", err)
+	}
+	if !reflect.DeepEqual(diskDiff.SpliceDiffs, diff.SpliceDiffs[1:]) {
+		t.Fatalf("splice diffs don't match: expected %v, got %v",
+			spew.Sdump(diff.SpliceDiffs[1:]),
+			spew.Sdump(diskDiff.SpliceDiffs))
+	}
+
+	// Abandoning it again should fail, as it's no longer pending.
+	if err := state.AbandonSplice(spliceTxid); err != ErrSpliceNotFound {
+		t.Fatalf("expected ErrSpliceNotFound, got %v", err)
+	}
+}
@@ -11,20 +11,19 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lnwire"
 )
 
 const (
-	dbName           = "channel.db"
-	dbFilePermission = 0600
+	dbName = "channel.db"
 )
 
 // migration is a function which takes a prior outdated version of the database
 // instances and mutates the key/bucket structure to arrive at a more
 // up-to-date version of the database.
-type migration func(tx *bbolt.Tx) error
+type migration func(tx kvdb.Tx) error
 
 type version struct {
 	number    uint32
@@ -96,6 +95,14 @@ var (
 			number:    8,
 			migration: migrateGossipMessageStoreKeys,
 		},
+		{
+			// The DB version that removes the utxo nursery's
+			// state, as time locked outputs are now swept by the
+			// contract resolvers. Outputs that are still
+			// incubating are handed over to the sweeper.
+			number:    9,
+			migration: migrateRemoveUtxoNursery,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
@@ -107,20 +114,35 @@ var (
 // information related to nodes, routing data, open/closed channels, fee
 // schedules, and reputation data.
 type DB struct {
-	*bbolt.DB
+	kvdb.Backend
 	dbPath string
 	graph  *ChannelGraph
 }
 
-// Open opens an existing channeldb. Any necessary schemas migrations due to
-// updates will take place as necessary.
+// Open opens an existing channeldb stored within a local bbolt database file
+// in the target directory, creating it if it doesn't yet exist. Any necessary
+// schemas migrations due to updates will take place as necessary.
 func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
-	path := filepath.Join(dbPath, dbName)
+	backend, err := kvdb.OpenBolt(filepath.Join(dbPath, dbName))
+	if err != nil {
+		return nil, err
+	}
 
-	if !fileExists(path) {
-		if err := createChannelDB(dbPath); err != nil {
-			return nil, err
-		}
+	return CreateWithBackend(backend, dbPath, modifiers...)
+}
+
+// CreateWithBackend opens the channeldb stored within the passed backend,
+// initializing all required top-level buckets if the backend is empty. Any
+// necessary schemas migrations due to updates will take place as necessary.
+// The dbPath is the local directory of the database, which holds the files
+// kept alongside it, such as the sphinx replay log, even if the backend is a
+// remote one. If an error is returned, then the backend is closed.
+func CreateWithBackend(backend kvdb.Backend, dbPath string,
+	modifiers ...OptionModifier) (*DB, error) {
+
+	if err := initChannelDB(backend); err != nil {
+		backend.Close()
+		return nil, err
 	}
 
 	opts := DefaultOptions()
@@ -128,14 +150,9 @@ func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 		modifier(&opts)
 	}
 
-	bdb, err := bbolt.Open(path, dbFilePermission, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	chanDB := &DB{
-		DB:     bdb,
-		dbPath: dbPath,
+		Backend: backend,
+		dbPath:  dbPath,
 	}
 	chanDB.graph = newChannelGraph(
 		chanDB, opts.RejectCacheSize, opts.ChannelCacheSize,
@@ -143,14 +160,14 @@ func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 
 	// Synchronize the version of database and apply migrations if needed.
 	if err := chanDB.syncVersions(dbVersions); err != nil {
-		bdb.Close()
+		backend.Close()
 		return nil, err
 	}
 
 	return chanDB, nil
 }
 
-// Path returns the file path to the channel database.
+// Path returns the local directory of the channel database.
 func (d *DB) Path() string {
 	return d.dbPath
 }
@@ -159,41 +176,41 @@ func (d *DB) Path() string {
 // database. The deletion is done in a single transaction, therefore this
 // operation is fully atomic.
 func (d *DB) Wipe() error {
-	return d.Update(func(tx *bbolt.Tx) error {
+	return d.Update(func(tx kvdb.Tx) error {
 		err := tx.DeleteBucket(openChannelBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
 		err = tx.DeleteBucket(closedChannelBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
 		err = tx.DeleteBucket(invoiceBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
 		err = tx.DeleteBucket(nodeInfoBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
 		err = tx.DeleteBucket(nodeBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 		err = tx.DeleteBucket(edgeBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 		err = tx.DeleteBucket(edgeIndexBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 		err = tx.DeleteBucket(graphMetaBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
@@ -201,24 +218,18 @@ func (d *DB) Wipe() error {
 	})
 }
 
-// createChannelDB creates and initializes a fresh version of channeldb. In
-// the case that the target path has not yet been created or doesn't yet exist,
-// then the path is created. Additionally, all required top-level buckets used
-// within the database are created.
-func createChannelDB(dbPath string) error {
-	if !fileExists(dbPath) {
-		if err := os.MkdirAll(dbPath, 0700); err != nil {
-			return err
+// initChannelDB initializes a fresh version of channeldb within the passed
+// backend by creating all required top-level buckets used within the
+// database. If the backend has already been initialized, then this is a
+// no-op.
+func initChannelDB(backend kvdb.Backend) error {
+	err := backend.Update(func(tx kvdb.Tx) error {
+		// If the meta bucket already exists, then the database has
+		// already been initialized.
+		if tx.Bucket(metaBucket) != nil {
+			return nil
 		}
-	}
-
-	path := filepath.Join(dbPath, dbName)
-	bdb, err := bbolt.Open(path, dbFilePermission, nil)
-	if err != nil {
-		return err
-	}
 
-	err = bdb.Update(func(tx *bbolt.Tx) error {
 		if _, err := tx.CreateBucket(openChannelBucket); err != nil {
 			return err
 		}
@@ -295,10 +306,10 @@ func createChannelDB(dbPath string) error {
 		return putMeta(meta, tx)
 	})
 	if err != nil {
-		return fmt.Errorf("unable to create new channeldb")
+		return fmt.Errorf("unable to create new channeldb: %v", err)
 	}
 
-	return bdb.Close()
+	return nil
 }
 
 // fileExists returns true if the file exists, and false otherwise.
@@ -318,7 +329,7 @@ func fileExists(path string) bool {
 // zero-length slice is returned.
 func (d *DB) FetchOpenChannels(nodeID *btcec.PublicKey) ([]*OpenChannel, error) {
 	var channels []*OpenChannel
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		var err error
 		channels, err = d.fetchOpenChannels(tx, nodeID)
 		return err
@@ -331,7 +342,7 @@ func (d *DB) FetchOpenChannels(nodeID *btcec.PublicKey) ([]*OpenChannel, error)
 // stored currently active/open channels associated with the target nodeID. In
 // the case that no active channels are known to have been created with this
 // node, then a zero-length slice is returned.
-func (d *DB) fetchOpenChannels(tx *bbolt.Tx,
+func (d *DB) fetchOpenChannels(tx kvdb.Tx,
 	nodeID *btcec.PublicKey) ([]*OpenChannel, error) {
 
 	// Get the bucket dedicated to storing the metadata for open channels.
@@ -384,7 +395,7 @@ func (d *DB) fetchOpenChannels(tx *bbolt.Tx,
 // fetchNodeChannels retrieves all active channels from the target chainBucket
 // which is under a node's dedicated channel bucket. This function is typically
 // used to fetch all the active channels related to a particular node.
-func (d *DB) fetchNodeChannels(chainBucket *bbolt.Bucket) ([]*OpenChannel, error) {
+func (d *DB) fetchNodeChannels(chainBucket kvdb.Bucket) ([]*OpenChannel, error) {
 
 	var channels []*OpenChannel
 
@@ -443,7 +454,7 @@ func (d *DB) FetchChannel(chanPoint wire.OutPoint) (*OpenChannel, error) {
 	// structure and skipping fully decoding each channel, we save a good
 	// bit of CPU as we don't need to do things like decompress public
 	// keys.
-	chanScan := func(tx *bbolt.Tx) error {
+	chanScan := func(tx kvdb.Tx) error {
 		// Get the bucket dedicated to storing the metadata for open
 		// channels.
 		openChanBucket := tx.Bucket(openChannelBucket)
@@ -587,7 +598,7 @@ func (d *DB) FetchWaitingCloseChannels() ([]*OpenChannel, error) {
 func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
 	var channels []*OpenChannel
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		// Get the bucket dedicated to storing the metadata for open
 		// channels.
 		openChanBucket := tx.Bucket(openChannelBucket)
@@ -674,7 +685,7 @@ func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
 func (d *DB) FetchClosedChannels(pendingOnly bool) ([]*ChannelCloseSummary, error) {
 	var chanSummaries []*ChannelCloseSummary
 
-	if err := d.View(func(tx *bbolt.Tx) error {
+	if err := d.View(func(tx kvdb.Tx) error {
 		closeBucket := tx.Bucket(closedChannelBucket)
 		if closeBucket == nil {
 			return ErrNoClosedChannels
@@ -712,7 +723,7 @@ var ErrClosedChannelNotFound = errors.New("unable to find closed channel summary
 // point of the channel in question.
 func (d *DB) FetchClosedChannel(chanID *wire.OutPoint) (*ChannelCloseSummary, error) {
 	var chanSummary *ChannelCloseSummary
-	if err := d.View(func(tx *bbolt.Tx) error {
+	if err := d.View(func(tx kvdb.Tx) error {
 		closeBucket := tx.Bucket(closedChannelBucket)
 		if closeBucket == nil {
 			return ErrClosedChannelNotFound
@@ -746,7 +757,7 @@ func (d *DB) FetchClosedChannelForID(cid lnwire.ChannelID) (
 	*ChannelCloseSummary, error) {
 
 	var chanSummary *ChannelCloseSummary
-	if err := d.View(func(tx *bbolt.Tx) error {
+	if err := d.View(func(tx kvdb.Tx) error {
 		closeBucket := tx.Bucket(closedChannelBucket)
 		if closeBucket == nil {
 			return ErrClosedChannelNotFound
@@ -794,7 +805,7 @@ func (d *DB) FetchClosedChannelForID(cid lnwire.ChannelID) (
 // the pending funds in a channel that has been forcibly closed have been
 // swept.
 func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
-	return d.Update(func(tx *bbolt.Tx) error {
+	return d.Update(func(tx kvdb.Tx) error {
 		var b bytes.Buffer
 		if err := writeOutpoint(&b, chanPoint); err != nil {
 			return err
@@ -847,7 +858,7 @@ func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
 // pruneLinkNode determines whether we should garbage collect a link node from
 // the database due to no longer having any open channels with it. If there are
 // any left, then this acts as a no-op.
-func (d *DB) pruneLinkNode(tx *bbolt.Tx, remotePub *btcec.PublicKey) error {
+func (d *DB) pruneLinkNode(tx kvdb.Tx, remotePub *btcec.PublicKey) error {
 	openChannels, err := d.fetchOpenChannels(tx, remotePub)
 	if err != nil {
 		return fmt.Errorf("unable to fetch open channels for peer %x: "+
@@ -867,7 +878,7 @@ func (d *DB) pruneLinkNode(tx *bbolt.Tx, remotePub *btcec.PublicKey) error {
 // PruneLinkNodes attempts to prune all link nodes found within the databse with
 // whom we no longer have any open channels with.
 func (d *DB) PruneLinkNodes() error {
-	return d.Update(func(tx *bbolt.Tx) error {
+	return d.Update(func(tx kvdb.Tx) error {
 		linkNodes, err := d.fetchAllLinkNodes(tx)
 		if err != nil {
 			return err
@@ -895,6 +906,10 @@ type ChannelShell struct {
 	// Chan is a shell of an OpenChannel, it contains only the items
 	// required to restore the channel on disk.
 	Chan *OpenChannel
+
+	// SplicedOutpoint is the outpoint of the funding output created by
+	// the latest splice of the channel, if it has been spliced.
+	SplicedOutpoint *wire.OutPoint
 }
 
 // RestoreChannelShells is a method that allows the caller to reconstruct the
@@ -911,7 +926,7 @@ func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
 	defer chanGraph.cacheMu.Unlock()
 
 	var chansRestored []uint64
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		for _, channelShell := range channelShells {
 			channel := channelShell.Chan
 
@@ -921,6 +936,13 @@ func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
 			// regular one.
 			channel.chanStatus |= ChanStatusRestored
 
+			// If the channel has been spliced, then it's funded
+			// by the output of its latest splice transaction.
+			if channelShell.SplicedOutpoint != nil {
+				op := *channelShell.SplicedOutpoint
+				channel.splicedOutpoint = &op
+			}
+
 			// First, we'll attempt to create a new open channel
 			// and link node for this channel. If the channel
 			// already exists, then in order to ensure this method
@@ -1022,7 +1044,7 @@ func (d *DB) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr, error) {
 		graphNode LightningNode
 	)
 
-	dbErr := d.View(func(tx *bbolt.Tx) error {
+	dbErr := d.View(func(tx kvdb.Tx) error {
 		var err error
 
 		linkNode, err = fetchLinkNode(tx, nodePub)
@@ -1111,7 +1133,7 @@ func (d *DB) syncVersions(versions []version) error {
 	migrations, migrationVersions := getMigrationsToApply(
 		versions, meta.DbVersionNumber,
 	)
-	return d.Update(func(tx *bbolt.Tx) error {
+	return d.Update(func(tx kvdb.Tx) error {
 		for i, migration := range migrations {
 			if migration == nil {
 				continue
@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/shachain"
@@ -47,6 +48,32 @@ func TestOpenWithCreate(t *testing.T) {
 	}
 }
 
+// TestCreateWithBackendPath asserts that a channeldb created from an already
+// opened backend reports the local directory it was created with.
+func TestCreateWithBackendPath(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	backend, err := kvdb.OpenBolt(filepath.Join(tempDirName, dbName))
+	if err != nil {
+		t.Fatalf("unable to open backend: %v", err)
+	}
+	cdb, err := CreateWithBackend(backend, tempDirName)
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	if cdb.Path() != tempDirName {
+		t.Fatalf("expected path %v, got %v", tempDirName, cdb.Path())
+	}
+}
+
 // TestWipe tests that the database wipe operation completes successfully
 // and that the buckets are deleted. It also checks that attempts to fetch
 // information while the buckets are not set return the correct errors.
@@ -360,6 +387,12 @@ func TestRestoreChannelShells(t *testing.T) {
 		t.Fatalf("unable to gen channel shell: %v", err)
 	}
 
+	// The channel has been spliced, so it's funded by the output of its
+	// latest splice transaction.
+	splicedOutpoint := channelShell.Chan.FundingOutpoint
+	splicedOutpoint.Hash[0] ^= 1
+	channelShell.SplicedOutpoint = &splicedOutpoint
+
 	graph := cdb.ChannelGraph()
 
 	// Before we can restore the channel, we'll need to make a source node
@@ -398,7 +431,7 @@ func TestRestoreChannelShells(t *testing.T) {
 	// Ensure that it isn't possible to modify the commitment state machine
 	// of this restored channel.
 	channel := nodeChans[0]
-	err = channel.UpdateCommitment(nil)
+	err = channel.UpdateCommitment(nil, nil)
 	if err != ErrNoRestoredChannelMutation {
 		t.Fatalf("able to mutate restored channel")
 	}
@@ -419,6 +452,10 @@ func TestRestoreChannelShells(t *testing.T) {
 			nodeChans[0].FundingOutpoint,
 			channelShell.Chan.FundingOutpoint)
 	}
+	if nodeChans[0].CurrentFundingOutpoint() != splicedOutpoint {
+		t.Fatalf("wrong current funding outpoint: expected %v, got %v",
+			splicedOutpoint, nodeChans[0].CurrentFundingOutpoint())
+	}
 	if !nodeChans[0].HasChanStatus(ChanStatusRestored) {
 		t.Fatalf("node has wrong status flags: %v",
 			nodeChans[0].chanStatus)
@@ -0,0 +1,573 @@
+package channeldb
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+// ExportVersion is the current version of the channeldb export format. This
+// MUST be bumped whenever the structure of an ExportRecord changes in a way
+// that older importers cannot understand.
+const ExportVersion uint32 = 1
+
+// The channeldb export format is a stream of newline delimited JSON objects,
+// each of which is an ExportRecord. The stream has the following structure:
+//
+//   * A single header record which carries the version of the export format,
+//     the schema version of the exported database, and the time the export
+//     was created.
+//
+//   * A bucket record for every bucket within the database, including nested
+//     buckets, in depth-first order. Each bucket record carries the full path
+//     of the bucket from the root of the database, along with the bucket's
+//     current sequence number.
+//
+//   * A key/value record for every non-bucket key within a bucket. Key/value
+//     records always follow the bucket record of their parent bucket.
+//
+//   * A single footer record which carries the total number of bucket and
+//     key/value records within the stream, along with the hex-encoded sha256
+//     of every line that preceded it (including the trailing newlines).
+//
+// All bucket names, keys and values are hex-encoded, as they're arbitrary
+// byte strings. Top-level bucket records additionally carry a human readable
+// name for the known buckets, purely for informational purposes.
+
+// ExportRecordType denotes the type of a record within a channeldb export.
+type ExportRecordType string
+
+const (
+	// ExportRecordHeader is the type of the first record within an export.
+	ExportRecordHeader ExportRecordType = "header"
+
+	// ExportRecordBucket is the type of a record that describes a bucket.
+	ExportRecordBucket ExportRecordType = "bucket"
+
+	// ExportRecordKeyValue is the type of a record that describes a single
+	// key/value pair within a bucket.
+	ExportRecordKeyValue ExportRecordType = "kv"
+
+	// ExportRecordFooter is the type of the final record within an export.
+	ExportRecordFooter ExportRecordType = "footer"
+)
+
+// ExportRecord is a single line within a channeldb export. Only the fields
+// relevant to the record's type are populated.
+type ExportRecord struct {
+	// Type is the type of this record.
+	Type ExportRecordType `json:"type"`
+
+	// FormatVersion is the version of the export format. This is only set
+	// within the header record.
+	FormatVersion uint32 `json:"format_version,omitempty"`
+
+	// DbVersion is the schema version of the exported database. This is
+	// only set within the header record.
+	DbVersion uint32 `json:"db_version,omitempty"`
+
+	// Timestamp is the unix timestamp at which the export was created.
+	// This is only set within the header record.
+	Timestamp int64 `json:"timestamp,omitempty"`
+
+	// Name is the human readable name of a known top-level bucket.
+	Name string `json:"name,omitempty"`
+
+	// Path is the hex-encoded path of bucket names from the root of the
+	// database to the bucket this record describes or resides within.
+	Path []string `json:"path,omitempty"`
+
+	// Sequence is the sequence number of a bucket.
+	Sequence uint64 `json:"sequence,omitempty"`
+
+	// Key is the hex-encoded key of a key/value record.
+	Key string `json:"key,omitempty"`
+
+	// Value is the hex-encoded value of a key/value record.
+	Value string `json:"value,omitempty"`
+
+	// NumRecords is the number of bucket and key/value records within the
+	// export. This is only set within the footer record.
+	NumRecords uint64 `json:"num_records,omitempty"`
+
+	// Checksum is the hex-encoded sha256 of all lines that precede the
+	// footer record. This is only set within the footer record.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// ExportSummary summarizes the contents of a channeldb export.
+type ExportSummary struct {
+	// DbVersion is the schema version of the exported database.
+	DbVersion uint32
+
+	// NumBuckets is the total number of buckets within the export.
+	NumBuckets uint64
+
+	// NumKeys is the total number of key/value pairs within the export.
+	NumKeys uint64
+
+	// BucketKeys maps the name of each top-level bucket to the number of
+	// key/value pairs found within it, including those within nested
+	// buckets. Unknown top-level buckets are named by their hex-encoded
+	// key.
+	BucketKeys map[string]uint64
+}
+
+var (
+	// ErrExportDBExists is returned when attempting to import an export
+	// into a directory that already contains a channel database.
+	ErrExportDBExists = errors.New("channel database already exists")
+
+	// ErrExportTruncated is returned when an export stream ends before
+	// its footer record.
+	ErrExportTruncated = errors.New("export is missing footer record")
+
+	// ErrExportChecksum is returned when the checksum within an export's
+	// footer doesn't match the records that precede it.
+	ErrExportChecksum = errors.New("export checksum mismatch")
+)
+
+// topLevelBucketNames maps the known top-level buckets of the database to the
+// human readable names used within an export.
+var topLevelBucketNames = map[string]string{
+	string(openChannelBucket):     "open_channels",
+	string(closedChannelBucket):   "closed_channels",
+	string(invoiceBucket):         "invoices",
+	string(paymentBucket):         "payments",
+	string(paymentStatusBucket):   "payment_statuses",
+	string(nodeInfoBucket):        "link_nodes",
+	string(nodeBucket):            "graph_nodes",
+	string(edgeBucket):            "graph_edges",
+	string(graphMetaBucket):       "graph_meta",
+	string(forwardingLogBucket):   "forwarding_log",
+	string(fwdPackagesKey):        "forwarding_packages",
+	string(metaBucket):            "metadata",
+	string(resolverReportsBucket): "resolver_reports",
+	string(rebalanceBucket):       "rebalances",
+}
+
+// topLevelBucketName returns the human readable name of the passed top-level
+// bucket.
+func topLevelBucketName(bucket []byte) string {
+	if name, ok := topLevelBucketNames[string(bucket)]; ok {
+		return name
+	}
+
+	return hex.EncodeToString(bucket)
+}
+
+// exportWriter writes export records to an io.Writer, while keeping track of
+// the running checksum and number of records.
+type exportWriter struct {
+	w          io.Writer
+	sum        hash.Hash
+	numRecords uint64
+}
+
+// writeRecord serializes the passed record as a single line, and writes it to
+// the underlying writer.
+func (e *exportWriter) writeRecord(record *ExportRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if _, err := e.w.Write(line); err != nil {
+		return err
+	}
+	e.sum.Write(line)
+
+	return nil
+}
+
+// Export writes every bucket and key/value pair within the database to the
+// passed io.Writer using the channeldb export format. The export is made from
+// a single consistent snapshot of the database.
+func (d *DB) Export(w io.Writer) (*ExportSummary, error) {
+	summary := &ExportSummary{
+		BucketKeys: make(map[string]uint64),
+	}
+
+	ew := &exportWriter{
+		w:   w,
+		sum: sha256.New(),
+	}
+
+	err := d.View(func(tx kvdb.Tx) error {
+		var meta Meta
+		if err := fetchMeta(&meta, tx); err != nil {
+			return err
+		}
+		summary.DbVersion = meta.DbVersionNumber
+
+		err := ew.writeRecord(&ExportRecord{
+			Type:          ExportRecordHeader,
+			FormatVersion: ExportVersion,
+			DbVersion:     meta.DbVersionNumber,
+			Timestamp:     time.Now().Unix(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.ForEach(func(name []byte, b kvdb.Bucket) error {
+			topName := topLevelBucketName(name)
+			summary.BucketKeys[topName] = 0
+
+			return exportBucket(
+				ew, summary, topName, [][]byte{name}, b,
+			)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ew.writeRecord(&ExportRecord{
+		Type:       ExportRecordFooter,
+		NumRecords: ew.numRecords,
+		Checksum:   hex.EncodeToString(ew.sum.Sum(nil)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// exportBucket writes a bucket record for the passed bucket, followed by all
+// of its key/value pairs, and then recurses into each nested bucket.
+func exportBucket(ew *exportWriter, summary *ExportSummary, topName string,
+	path [][]byte, b kvdb.Bucket) error {
+
+	bucketRecord := &ExportRecord{
+		Type:     ExportRecordBucket,
+		Path:     encodeExportPath(path),
+		Sequence: b.Sequence(),
+	}
+	if len(path) == 1 {
+		bucketRecord.Name = topName
+	}
+	if err := ew.writeRecord(bucketRecord); err != nil {
+		return err
+	}
+	ew.numRecords++
+	summary.NumBuckets++
+
+	// We'll write out all of the key/value pairs within this bucket
+	// first, and then recurse into the nested buckets. This ensures that
+	// the key/value records of a bucket are always grouped together
+	// directly after its bucket record.
+	var nestedBuckets [][]byte
+	err := b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nestedBuckets = append(
+				nestedBuckets, append([]byte(nil), k...),
+			)
+			return nil
+		}
+
+		err := ew.writeRecord(&ExportRecord{
+			Type:  ExportRecordKeyValue,
+			Path:  bucketRecord.Path,
+			Key:   hex.EncodeToString(k),
+			Value: hex.EncodeToString(v),
+		})
+		if err != nil {
+			return err
+		}
+		ew.numRecords++
+		summary.NumKeys++
+		summary.BucketKeys[topName]++
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, nestedName := range nestedBuckets {
+		nestedPath := make([][]byte, len(path), len(path)+1)
+		copy(nestedPath, path)
+		nestedPath = append(nestedPath, nestedName)
+
+		err := exportBucket(
+			ew, summary, topName, nestedPath, b.Bucket(nestedName),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeExportPath hex-encodes each bucket name within the passed path.
+func encodeExportPath(path [][]byte) []string {
+	encoded := make([]string, len(path))
+	for i, name := range path {
+		encoded[i] = hex.EncodeToString(name)
+	}
+
+	return encoded
+}
+
+// decodeExportPath decodes each hex-encoded bucket name within the passed
+// path.
+func decodeExportPath(path []string) ([][]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("empty bucket path")
+	}
+
+	decoded := make([][]byte, len(path))
+	for i, name := range path {
+		var err error
+		decoded[i], err = hex.DecodeString(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return decoded, nil
+}
+
+// Import creates a fresh channel database within dbPath, and populates it with
+// the contents of the passed channeldb export. The export is written within a
+// single database transaction, so either the entire export is imported, or
+// nothing at all. An error is returned if a channel database already exists
+// within dbPath.
+//
+// NOTE: The imported database may be of an older schema version than this
+// package expects. Any necessary migrations will be applied when the imported
+// database is next opened with Open.
+func Import(dbPath string, r io.Reader) (*ExportSummary, error) {
+	path := filepath.Join(dbPath, dbName)
+	if fileExists(path) {
+		return nil, ErrExportDBExists
+	}
+
+	backend, err := kvdb.OpenBolt(path)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := ImportToBackend(backend, r)
+	if err != nil {
+		backend.Close()
+		os.Remove(path)
+		return nil, err
+	}
+
+	if err := backend.Close(); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// ImportToBackend populates the passed backend with the contents of the passed
+// channeldb export. This allows an export of a local database to be imported
+// into a remote backend. The backend must be empty, otherwise
+// ErrExportDBExists is returned. As with Import, the export is written within
+// a single database transaction.
+func ImportToBackend(backend kvdb.Backend, r io.Reader) (*ExportSummary,
+	error) {
+
+	var summary *ExportSummary
+	err := backend.Update(func(tx kvdb.Tx) error {
+		// We'll ensure the backend is empty by checking if it has any
+		// top-level buckets.
+		err := tx.ForEach(func(_ []byte, _ kvdb.Bucket) error {
+			return ErrExportDBExists
+		})
+		if err != nil {
+			return err
+		}
+
+		summary, err = importRecords(tx, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// importRecords reads every record from the passed export stream and writes
+// the buckets and key/value pairs it describes using the passed transaction.
+// The header and footer of the stream are validated along the way.
+func importRecords(tx kvdb.Tx, r io.Reader) (*ExportSummary, error) {
+	summary := &ExportSummary{
+		BucketKeys: make(map[string]uint64),
+	}
+
+	var (
+		reader     = bufio.NewReader(r)
+		sum        = sha256.New()
+		numRecords uint64
+		sawHeader  bool
+		topName    string
+	)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			return nil, ErrExportTruncated
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		var record ExportRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, fmt.Errorf("unable to decode export "+
+				"record: %v", err)
+		}
+
+		// The very first record of the stream must be the header, and
+		// the header may not appear anywhere else.
+		if !sawHeader && record.Type != ExportRecordHeader {
+			return nil, errors.New("export is missing header record")
+		}
+
+		switch record.Type {
+		case ExportRecordHeader:
+			if sawHeader {
+				return nil, errors.New("duplicate export header")
+			}
+			sawHeader = true
+
+			if record.FormatVersion != ExportVersion {
+				return nil, fmt.Errorf("unsupported export "+
+					"version %v, expected %v",
+					record.FormatVersion, ExportVersion)
+			}
+
+			latestVersion := getLatestDBVersion(dbVersions)
+			if record.DbVersion > latestVersion {
+				return nil, ErrDBReversion
+			}
+			summary.DbVersion = record.DbVersion
+
+		case ExportRecordBucket:
+			path, err := decodeExportPath(record.Path)
+			if err != nil {
+				return nil, err
+			}
+
+			b, err := createExportBucket(tx, path)
+			if err != nil {
+				return nil, err
+			}
+			if err := b.SetSequence(record.Sequence); err != nil {
+				return nil, err
+			}
+
+			if len(path) == 1 {
+				topName = topLevelBucketName(path[0])
+				summary.BucketKeys[topName] = 0
+			}
+
+			numRecords++
+			summary.NumBuckets++
+
+		case ExportRecordKeyValue:
+			path, err := decodeExportPath(record.Path)
+			if err != nil {
+				return nil, err
+			}
+
+			b := fetchExportBucket(tx, path)
+			if b == nil {
+				return nil, fmt.Errorf("key/value record for "+
+					"unknown bucket %v", record.Path)
+			}
+
+			key, err := hex.DecodeString(record.Key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := hex.DecodeString(record.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := b.Put(key, value); err != nil {
+				return nil, err
+			}
+
+			numRecords++
+			summary.NumKeys++
+			summary.BucketKeys[topName]++
+
+		case ExportRecordFooter:
+			if record.NumRecords != numRecords {
+				return nil, fmt.Errorf("export footer "+
+					"expects %v records, found %v",
+					record.NumRecords, numRecords)
+			}
+
+			checksum := hex.EncodeToString(sum.Sum(nil))
+			if record.Checksum != checksum {
+				return nil, ErrExportChecksum
+			}
+
+			// Nothing may follow the footer.
+			_, err := reader.ReadByte()
+			if err != io.EOF {
+				return nil, errors.New("unexpected data " +
+					"after export footer")
+			}
+
+			return summary, nil
+
+		default:
+			return nil, fmt.Errorf("unknown export record type: %v",
+				record.Type)
+		}
+
+		sum.Write(line)
+	}
+}
+
+// createExportBucket creates the bucket at the passed path. All parent buckets
+// must have already been created.
+func createExportBucket(tx kvdb.Tx, path [][]byte) (kvdb.Bucket, error) {
+	if len(path) == 1 {
+		return tx.CreateBucket(path[0])
+	}
+
+	parent := fetchExportBucket(tx, path[:len(path)-1])
+	if parent == nil {
+		return nil, fmt.Errorf("parent of bucket %x not found",
+			bytes.Join(path, []byte("/")))
+	}
+
+	return parent.CreateBucket(path[len(path)-1])
+}
+
+// fetchExportBucket returns the bucket at the passed path, or nil if it
+// doesn't exist.
+func fetchExportBucket(tx kvdb.Tx, path [][]byte) kvdb.Bucket {
+	b := tx.Bucket(path[0])
+	for _, name := range path[1:] {
+		if b == nil {
+			return nil
+		}
+		b = b.Bucket(name)
+	}
+
+	return b
+}
@@ -0,0 +1,164 @@
+package channeldb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestExportImport asserts that a database exported to the channeldb export
+// format can be imported into a fresh database, and that the imported
+// database contains the same records and passes the integrity check.
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll start by populating the database with a pending channel and
+	// an invoice.
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	invoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := cdb.AddInvoice(invoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	// Next, we'll export the database.
+	var b bytes.Buffer
+	exportSummary, err := cdb.Export(&b)
+	if err != nil {
+		t.Fatalf("unable to export database: %v", err)
+	}
+	if exportSummary.DbVersion != getLatestDBVersion(dbVersions) {
+		t.Fatalf("expected db version %v, got %v",
+			getLatestDBVersion(dbVersions), exportSummary.DbVersion)
+	}
+	if exportSummary.BucketKeys["open_channels"] == 0 {
+		t.Fatalf("expected open channel records within export")
+	}
+	export := b.Bytes()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// A tampered export should fail the checksum and leave no database
+	// behind.
+	tampered := bytes.Replace(
+		export, []byte(`"type":"kv"`), []byte(`"type":"kv" `), 1,
+	)
+	_, err = Import(tempDirName, bytes.NewReader(tampered))
+	if err != ErrExportChecksum {
+		t.Fatalf("expected ErrExportChecksum, got %v", err)
+	}
+
+	// A truncated export should also be rejected.
+	truncated := export[:bytes.LastIndex(export[:len(export)-1], []byte("\n"))+1]
+	_, err = Import(tempDirName, bytes.NewReader(truncated))
+	if err != ErrExportTruncated {
+		t.Fatalf("expected ErrExportTruncated, got %v", err)
+	}
+
+	// The untouched export should import successfully, and match the
+	// summary of the export.
+	importSummary, err := Import(tempDirName, bytes.NewReader(export))
+	if err != nil {
+		t.Fatalf("unable to import database: %v", err)
+	}
+	if importSummary.NumKeys != exportSummary.NumKeys ||
+		importSummary.NumBuckets != exportSummary.NumBuckets {
+
+		t.Fatalf("import summary mismatch: expected %v keys and %v "+
+			"buckets, got %v keys and %v buckets",
+			exportSummary.NumKeys, exportSummary.NumBuckets,
+			importSummary.NumKeys, importSummary.NumBuckets)
+	}
+
+	// Importing into a directory with an existing database should fail.
+	_, err = Import(tempDirName, bytes.NewReader(export))
+	if err != ErrExportDBExists {
+		t.Fatalf("expected ErrExportDBExists, got %v", err)
+	}
+
+	importedDB, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open imported database: %v", err)
+	}
+	defer importedDB.Close()
+
+	pendingChannels, err := importedDB.FetchPendingChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channels: %v", err)
+	}
+	if len(pendingChannels) != 1 {
+		t.Fatalf("expected 1 pending channel, got %v",
+			len(pendingChannels))
+	}
+	if pendingChannels[0].FundingOutpoint != state.FundingOutpoint {
+		t.Fatalf("funding outpoint mismatch: expected %v, got %v",
+			state.FundingOutpoint, pendingChannels[0].FundingOutpoint)
+	}
+	if !bytes.Equal(pendingChannels[0].LocalShutdownScript,
+		state.LocalShutdownScript) {
+
+		t.Fatalf("local shutdown script mismatch")
+	}
+
+	if _, err := importedDB.LookupInvoice(paymentHash); err != nil {
+		t.Fatalf("unable to find imported invoice: %v", err)
+	}
+
+	// Re-exporting the imported database should yield the same records.
+	// We'll ignore the header and footer, as the header contains the
+	// time of the export.
+	var reexport bytes.Buffer
+	if _, err := importedDB.Export(&reexport); err != nil {
+		t.Fatalf("unable to export imported database: %v", err)
+	}
+	exportRecords := func(export []byte) string {
+		lines := strings.Split(string(export), "\n")
+		return strings.Join(lines[1:len(lines)-2], "\n")
+	}
+	if exportRecords(export) != exportRecords(reexport.Bytes()) {
+		t.Fatalf("re-exported database doesn't match original export")
+	}
+
+	// Finally, the imported database should pass the integrity check
+	// without any orphaned records.
+	report, err := importedDB.CheckIntegrity()
+	if err != nil {
+		t.Fatalf("integrity check failed: %v", err)
+	}
+	if report.NumChannels != 1 || report.NumInvoices != 1 {
+		t.Fatalf("expected 1 channel and 1 invoice, got %v and %v",
+			report.NumChannels, report.NumInvoices)
+	}
+	if report.HasOrphans() {
+		t.Fatalf("unexpected orphaned records: %v", spew.Sdump(report))
+	}
+}
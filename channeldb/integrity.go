@@ -0,0 +1,216 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/lnwire"
+)
+
+// IntegrityReport is the result of an integrity check of the database. It
+// details the number of records of each type that were successfully decoded,
+// along with any orphaned records that were found.
+type IntegrityReport struct {
+	// NumChannels is the number of pending, open and waiting close
+	// channels within the database.
+	NumChannels int
+
+	// NumClosedChannels is the number of channel close summaries within
+	// the database.
+	NumClosedChannels int
+
+	// NumInvoices is the number of invoices within the database.
+	NumInvoices int
+
+	// NumPayments is the number of outgoing payments within the database.
+	NumPayments int
+
+	// NumGraphNodes is the number of nodes within the channel graph.
+	NumGraphNodes int
+
+	// NumGraphEdges is the number of edges within the channel graph.
+	NumGraphEdges int
+
+	// NumForwardingEvents is the number of events within the forwarding
+	// log.
+	NumForwardingEvents int
+
+	// NumFwdPkgs is the number of forwarding packages within the
+	// database.
+	NumFwdPkgs int
+
+	// OrphanedFwdPkgs is the set of channels that have forwarding
+	// packages within the database, but are neither open nor closed.
+	OrphanedFwdPkgs []lnwire.ShortChannelID
+
+	// OrphanedLinkNodes is the set of link nodes with whom we don't have
+	// any channels.
+	OrphanedLinkNodes []*btcec.PublicKey
+
+	// ChannelsWithoutLinkNode is the set of channels whose counterparty
+	// doesn't have a link node.
+	ChannelsWithoutLinkNode []wire.OutPoint
+}
+
+// HasOrphans returns true if the integrity check found any orphaned records.
+func (r *IntegrityReport) HasOrphans() bool {
+	return len(r.OrphanedFwdPkgs) != 0 || len(r.OrphanedLinkNodes) != 0 ||
+		len(r.ChannelsWithoutLinkNode) != 0
+}
+
+// CheckIntegrity attempts to decode every channel, close summary, invoice,
+// payment, graph node and edge, forwarding event and forwarding package within
+// the database, returning an error if any of them are corrupt. Additionally,
+// records that don't have a corresponding channel or counterparty are
+// reported as orphaned.
+func (d *DB) CheckIntegrity() (*IntegrityReport, error) {
+	report := &IntegrityReport{}
+
+	channels, err := d.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+	report.NumChannels = len(channels)
+
+	closedChannels, err := d.FetchClosedChannels(false)
+	if err != nil && err != ErrNoClosedChannels {
+		return nil, err
+	}
+	report.NumClosedChannels = len(closedChannels)
+
+	invoices, err := d.FetchAllInvoices(false)
+	if err != nil && err != ErrNoInvoicesCreated {
+		return nil, err
+	}
+	report.NumInvoices = len(invoices)
+
+	payments, err := d.FetchAllPayments()
+	if err != nil && err != ErrNoPaymentsCreated {
+		return nil, err
+	}
+	report.NumPayments = len(payments)
+
+	graph := d.ChannelGraph()
+	err = graph.ForEachNode(nil, func(_ kvdb.Tx, _ *LightningNode) error {
+		report.NumGraphNodes++
+		return nil
+	})
+	if err != nil && err != ErrGraphNotFound {
+		return nil, err
+	}
+
+	err = graph.ForEachChannel(func(_ *ChannelEdgeInfo, _,
+		_ *ChannelEdgePolicy) error {
+
+		report.NumGraphEdges++
+		return nil
+	})
+	if err != nil && err != ErrGraphNotFound &&
+		err != ErrGraphNoEdgesFound {
+
+		return nil, err
+	}
+
+	linkNodes, err := d.FetchAllLinkNodes()
+	if err != nil && err != ErrLinkNodesNotFound {
+		return nil, err
+	}
+
+	// With all the records decoded, we'll now cross reference the
+	// channels against the link nodes. Every channel should have a link
+	// node for its counterparty, and every link node should have at least
+	// one channel.
+	for _, linkNode := range linkNodes {
+		var found bool
+		for _, channel := range channels {
+			if channel.IdentityPub.IsEqual(linkNode.IdentityPub) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report.OrphanedLinkNodes = append(
+				report.OrphanedLinkNodes, linkNode.IdentityPub,
+			)
+		}
+	}
+	for _, channel := range channels {
+		var found bool
+		for _, linkNode := range linkNodes {
+			if channel.IdentityPub.IsEqual(linkNode.IdentityPub) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report.ChannelsWithoutLinkNode = append(
+				report.ChannelsWithoutLinkNode,
+				channel.FundingOutpoint,
+			)
+		}
+	}
+
+	// Finally, we'll decode the forwarding log and all forwarding
+	// packages. Each set of forwarding packages should belong to either
+	// an open or closed channel.
+	knownChannels := make(map[lnwire.ShortChannelID]struct{})
+	for _, channel := range channels {
+		knownChannels[channel.ShortChanID()] = struct{}{}
+	}
+	for _, summary := range closedChannels {
+		knownChannels[summary.ShortChanID] = struct{}{}
+	}
+
+	err = d.View(func(tx kvdb.Tx) error {
+		logBucket := tx.Bucket(forwardingLogBucket)
+		if logBucket != nil {
+			err := logBucket.ForEach(func(_, v []byte) error {
+				var event ForwardingEvent
+				err := decodeForwardingEvent(
+					bytes.NewReader(v), &event,
+				)
+				if err != nil {
+					return err
+				}
+
+				report.NumForwardingEvents++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		fwdPkgBkt := tx.Bucket(fwdPackagesKey)
+		if fwdPkgBkt == nil {
+			return nil
+		}
+
+		return fwdPkgBkt.ForEach(func(k, _ []byte) error {
+			source := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(k),
+			)
+
+			fwdPkgs, err := loadChannelFwdPkgs(tx, source)
+			if err != nil {
+				return err
+			}
+			report.NumFwdPkgs += len(fwdPkgs)
+
+			if _, ok := knownChannels[source]; !ok {
+				report.OrphanedFwdPkgs = append(
+					report.OrphanedFwdPkgs, source,
+				)
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -9,7 +9,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lntypes"
 	"github.com/wakiyamap/lnd/lnwire"
 )
@@ -242,7 +242,7 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 	}
 
 	var invoiceAddIndex uint64
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -320,7 +320,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceAddIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -375,7 +375,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 // terms of the payment.
 func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	var invoice Invoice
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -415,7 +415,7 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	var invoices []Invoice
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoiceB := tx.Bucket(invoiceBucket)
 		if invoiceB == nil {
 			return ErrNoInvoicesCreated
@@ -506,7 +506,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 		InvoiceQuery: q,
 	}
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		// If the bucket wasn't found, then there aren't any invoices
 		// within the database yet, so we can simply exit.
 		invoices := tx.Bucket(invoiceBucket)
@@ -520,7 +520,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 		// keyForIndex is a helper closure that retrieves the invoice
 		// key for the given add index of an invoice.
-		keyForIndex := func(c *bbolt.Cursor, index uint64) []byte {
+		keyForIndex := func(c kvdb.Cursor, index uint64) []byte {
 			var keyIndex [8]byte
 			byteOrder.PutUint64(keyIndex[:], index)
 			_, invoiceKey := c.Seek(keyIndex[:])
@@ -529,7 +529,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 		// nextKey is a helper closure to determine what the next
 		// invoice key is when iterating over the invoice add index.
-		nextKey := func(c *bbolt.Cursor) ([]byte, []byte) {
+		nextKey := func(c kvdb.Cursor) ([]byte, []byte) {
 			if q.Reversed {
 				return c.Prev()
 			}
@@ -635,7 +635,7 @@ func (d *DB) AcceptOrSettleInvoice(paymentHash [32]byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
 	var settledInvoice *Invoice
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -675,7 +675,7 @@ func (d *DB) AcceptOrSettleInvoice(paymentHash [32]byte,
 func (d *DB) SettleHoldInvoice(preimage lntypes.Preimage) (*Invoice, error) {
 	var updatedInvoice *Invoice
 	hash := preimage.Hash()
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -714,7 +714,7 @@ func (d *DB) SettleHoldInvoice(preimage lntypes.Preimage) (*Invoice, error) {
 // payment hash.
 func (d *DB) CancelInvoice(paymentHash lntypes.Hash) (*Invoice, error) {
 	var canceledInvoice *Invoice
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -760,7 +760,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceSettleIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -802,7 +802,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	return settledInvoices, nil
 }
 
-func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
+func putInvoice(invoices, invoiceIndex, addIndex kvdb.Bucket,
 	i *Invoice, invoiceNum uint32, paymentHash lntypes.Hash) (
 	uint64, error) {
 
@@ -916,7 +916,7 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	return nil
 }
 
-func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
+func fetchInvoice(invoiceNum []byte, invoices kvdb.Bucket) (Invoice, error) {
 	invoiceBytes := invoices.Get(invoiceNum)
 	if invoiceBytes == nil {
 		return Invoice{}, ErrInvoiceNotFound
@@ -988,7 +988,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	return invoice, nil
 }
 
-func acceptOrSettleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
+func acceptOrSettleInvoice(invoices, settleIndex kvdb.Bucket, invoiceNum []byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
@@ -1031,7 +1031,7 @@ func acceptOrSettleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byt
 	return &invoice, nil
 }
 
-func setSettleFields(settleIndex *bbolt.Bucket, invoiceNum []byte,
+func setSettleFields(settleIndex kvdb.Bucket, invoiceNum []byte,
 	invoice *Invoice) error {
 
 	// Now that we know the invoice hasn't already been settled, we'll
@@ -1055,7 +1055,7 @@ func setSettleFields(settleIndex *bbolt.Bucket, invoiceNum []byte,
 	return nil
 }
 
-func settleHoldInvoice(invoices, settleIndex *bbolt.Bucket,
+func settleHoldInvoice(invoices, settleIndex kvdb.Bucket,
 	invoiceNum []byte, preimage lntypes.Preimage) (*Invoice,
 	error) {
 
@@ -1092,7 +1092,7 @@ func settleHoldInvoice(invoices, settleIndex *bbolt.Bucket,
 	return &invoice, nil
 }
 
-func cancelInvoice(invoices *bbolt.Bucket, invoiceNum []byte) (
+func cancelInvoice(invoices kvdb.Bucket, invoiceNum []byte) (
 	*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
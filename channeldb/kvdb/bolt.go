@@ -0,0 +1,359 @@
+package kvdb
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/bbolt"
+)
+
+const (
+	// boltFilePermission is the file permission that new bbolt database
+	// files are created with.
+	boltFilePermission = 0600
+)
+
+// boltErrors maps bbolt's errors to their backend agnostic equivalents.
+var boltErrors = map[error]error{
+	bbolt.ErrDatabaseNotOpen:    ErrDatabaseNotOpen,
+	bbolt.ErrBucketNotFound:     ErrBucketNotFound,
+	bbolt.ErrBucketExists:       ErrBucketExists,
+	bbolt.ErrBucketNameRequired: ErrBucketNameRequired,
+	bbolt.ErrKeyRequired:        ErrKeyRequired,
+	bbolt.ErrIncompatibleValue:  ErrIncompatibleValue,
+	bbolt.ErrTxNotWritable:      ErrTxNotWritable,
+	bbolt.ErrTxClosed:           ErrTxClosed,
+}
+
+// convertBoltErr converts any bbolt specific error into its backend agnostic
+// equivalent.
+func convertBoltErr(err error) error {
+	if kvErr, ok := boltErrors[err]; ok {
+		return kvErr
+	}
+
+	return err
+}
+
+// boltBackend is an implementation of the Backend interface backed by a local
+// bbolt database file.
+type boltBackend struct {
+	db *bbolt.DB
+}
+
+// A compile-time check to ensure boltBackend implements the Backend
+// interface.
+var _ Backend = (*boltBackend)(nil)
+
+// OpenBolt opens the bbolt database file at the given path, creating it along
+// with any missing parent directories if it doesn't yet exist.
+func OpenBolt(path string) (Backend, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	db, err := bbolt.Open(path, boltFilePermission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltBackend{db: db}, nil
+}
+
+// View executes the passed function within the context of a managed
+// read-only transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) View(fn func(tx Tx) error) error {
+	return convertBoltErr(b.db.View(func(tx *bbolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	}))
+}
+
+// Update executes the passed function within the context of a managed
+// read-write transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Update(fn func(tx Tx) error) error {
+	return convertBoltErr(b.db.Update(func(tx *bbolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	}))
+}
+
+// Batch executes the passed function within a read-write transaction that
+// may be shared with other concurrent calls to Batch.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Batch(fn func(tx Tx) error) error {
+	return convertBoltErr(b.db.Batch(func(tx *bbolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	}))
+}
+
+// Begin starts a new transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Begin(writable bool) (Tx, error) {
+	tx, err := b.db.Begin(writable)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return &boltTx{tx: tx}, nil
+}
+
+// Close closes the underlying database file.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+// boltTx wraps a bbolt transaction in order to implement the Tx interface.
+type boltTx struct {
+	tx *bbolt.Tx
+}
+
+// wrapBoltBucket wraps the passed bbolt bucket, taking care to return a nil
+// interface if the bucket itself is nil.
+func wrapBoltBucket(b *bbolt.Bucket) Bucket {
+	if b == nil {
+		return nil
+	}
+
+	return &boltBucket{bucket: b}
+}
+
+// Bucket returns the top-level bucket with the given name.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(t.tx.Bucket(name))
+}
+
+// CreateBucket creates a new top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) CreateBucket(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucket(name)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(b), nil
+}
+
+// CreateBucketIfNotExists creates a new top-level bucket if it doesn't already
+// exist.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(b), nil
+}
+
+// DeleteBucket deletes a top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) DeleteBucket(name []byte) error {
+	return convertBoltErr(t.tx.DeleteBucket(name))
+}
+
+// ForEach executes the passed function for each top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return convertBoltErr(t.tx.ForEach(
+		func(name []byte, b *bbolt.Bucket) error {
+			return fn(name, wrapBoltBucket(b))
+		},
+	))
+}
+
+// Writable returns true if this is a read-write transaction.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Writable() bool {
+	return t.tx.Writable()
+}
+
+// OnCommit registers a function to be executed once the transaction commits.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) OnCommit(fn func()) {
+	t.tx.OnCommit(fn)
+}
+
+// Commit commits the transaction.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Commit() error {
+	return convertBoltErr(t.tx.Commit())
+}
+
+// Rollback rolls back the transaction.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Rollback() error {
+	return convertBoltErr(t.tx.Rollback())
+}
+
+// boltBucket wraps a bbolt bucket in order to implement the Bucket interface.
+type boltBucket struct {
+	bucket *bbolt.Bucket
+}
+
+// Bucket returns the nested bucket with the given name.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(b.bucket.Bucket(name))
+}
+
+// CreateBucket creates a new nested bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) CreateBucket(name []byte) (Bucket, error) {
+	nested, err := b.bucket.CreateBucket(name)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(nested), nil
+}
+
+// CreateBucketIfNotExists creates a new nested bucket if it doesn't already
+// exist.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	nested, err := b.bucket.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(nested), nil
+}
+
+// DeleteBucket deletes a nested bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) DeleteBucket(name []byte) error {
+	return convertBoltErr(b.bucket.DeleteBucket(name))
+}
+
+// Get returns the value of the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Get(key []byte) []byte {
+	return b.bucket.Get(key)
+}
+
+// Put sets the value of the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Put(key, value []byte) error {
+	return convertBoltErr(b.bucket.Put(key, value))
+}
+
+// Delete removes the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Delete(key []byte) error {
+	return convertBoltErr(b.bucket.Delete(key))
+}
+
+// ForEach executes the passed function for each key/value pair.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return convertBoltErr(b.bucket.ForEach(fn))
+}
+
+// Cursor returns a new cursor over the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Cursor() Cursor {
+	return &boltCursor{cursor: b.bucket.Cursor()}
+}
+
+// NextSequence increments and returns the bucket's sequence number.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) NextSequence() (uint64, error) {
+	seq, err := b.bucket.NextSequence()
+	return seq, convertBoltErr(err)
+}
+
+// Sequence returns the bucket's sequence number.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Sequence() uint64 {
+	return b.bucket.Sequence()
+}
+
+// SetSequence sets the bucket's sequence number.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) SetSequence(v uint64) error {
+	return convertBoltErr(b.bucket.SetSequence(v))
+}
+
+// Tx returns the transaction the bucket was accessed within.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Tx() Tx {
+	return &boltTx{tx: b.bucket.Tx()}
+}
+
+// boltCursor wraps a bbolt cursor in order to implement the Cursor interface.
+type boltCursor struct {
+	cursor *bbolt.Cursor
+}
+
+// First moves the cursor to the first key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) First() ([]byte, []byte) {
+	return c.cursor.First()
+}
+
+// Last moves the cursor to the last key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Last() ([]byte, []byte) {
+	return c.cursor.Last()
+}
+
+// Next moves the cursor to the next key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Next() ([]byte, []byte) {
+	return c.cursor.Next()
+}
+
+// Prev moves the cursor to the previous key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Prev() ([]byte, []byte) {
+	return c.cursor.Prev()
+}
+
+// Seek moves the cursor to the given key, or the next key if it doesn't
+// exist.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Seek(seek []byte) ([]byte, []byte) {
+	return c.cursor.Seek(seek)
+}
+
+// Delete removes the key the cursor is positioned at.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Delete() error {
+	return convertBoltErr(c.cursor.Delete())
+}
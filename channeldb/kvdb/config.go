@@ -0,0 +1,52 @@
+package kvdb
+
+const (
+	// BoltBackendName is the name of the backend that stores all state
+	// within a local bbolt database file.
+	BoltBackendName = "bolt"
+
+	// EtcdBackendName is the name of the backend that stores all state
+	// within a remote etcd cluster.
+	EtcdBackendName = "etcd"
+)
+
+// LeaderFence identifies the leadership term of an instance that was elected
+// leader of a cluster. A backend guarded by a fence only commits read-write
+// transactions for as long as the term lasts, such that an instance that has
+// lost its leadership can't overwrite the state written by its successor.
+type LeaderFence struct {
+	// Key is the election key written by the leader. It's deleted once
+	// the leader resigns, or its lease expires.
+	Key string
+
+	// Rev is the revision the election key was created at, which
+	// distinguishes the term from any later term of the same instance.
+	Rev int64
+}
+
+// EtcdConfig holds the configuration needed to connect to a remote etcd
+// cluster.
+type EtcdConfig struct {
+	// Host is the host:port of the etcd cluster.
+	Host string `long:"host" description:"Etcd database host."`
+
+	// User is the user name used to authenticate with the cluster.
+	User string `long:"user" description:"Etcd database user."`
+
+	// Pass is the password used to authenticate with the cluster.
+	Pass string `long:"pass" description:"Password for the database user."`
+
+	// CertFile is the path to the TLS certificate used for the connection.
+	CertFile string `long:"cert_file" description:"Path to the TLS certificate for etcd RPC."`
+
+	// KeyFile is the path to the TLS private key used for the connection.
+	KeyFile string `long:"key_file" description:"Path to the TLS private key for etcd RPC."`
+
+	// InsecureSkipVerify disables verification of the server's TLS
+	// certificate.
+	InsecureSkipVerify bool `long:"insecure_skip_verify" description:"Whether we intend to skip TLS verification."`
+
+	// Prefix is prepended to every key written to the cluster, allowing
+	// several independent databases to share a single cluster.
+	Prefix string `long:"prefix" description:"The prefix that all keys are stored under within the etcd cluster."`
+}
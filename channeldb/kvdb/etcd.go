@@ -0,0 +1,216 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/namespace"
+	"github.com/coreos/etcd/pkg/transport"
+)
+
+const (
+	// etcdConnectionTimeout is the timeout used when establishing the
+	// initial connection to the etcd cluster.
+	etcdConnectionTimeout = 10 * time.Second
+
+	// etcdLongTimeout is the timeout applied to every individual request
+	// made to the etcd cluster.
+	etcdLongTimeout = 30 * time.Second
+)
+
+var (
+	// etcdLockKey is the key that every read-write transaction writes to
+	// upon commit. As a transaction is only committed if this key hasn't
+	// been modified since the transaction's snapshot was taken, writers
+	// are serialized across every process sharing the same cluster.
+	etcdLockKey = []byte("__lnd_kvdb_lock")
+)
+
+// etcdBackend is an implementation of the Backend interface backed by a
+// remote etcd cluster. Each transaction reads from a consistent snapshot of
+// the cluster taken when the transaction begins, and buffers all of its writes
+// in memory until it's committed, at which point they are applied atomically
+// within a single etcd transaction.
+//
+// NOTE: As a read-write transaction is applied as a single etcd transaction,
+// every etcd server of the cluster must be started with limits large enough
+// to hold the largest transaction lnd commits. The etcd defaults of 128
+// operations (--max-txn-ops) and 1.5 MiB (--max-request-bytes) are too low
+// for channel state, so at least --max-txn-ops=8192 and
+// --max-request-bytes=16777216 should be used, matching the limits of the
+// embedded etcd server. Splitting a transaction across several etcd transactions
+// would give up its atomicity, so a transaction exceeding the limits fails to
+// commit instead.
+type etcdBackend struct {
+	ctx    context.Context
+	cli    *clientv3.Client
+	kv     clientv3.KV
+	cancel func()
+
+	// prefix is the prefix that all keys of the backend are stored under.
+	// Reads are made through kv, which adds the prefix itself, while
+	// transactions are committed through cli directly, as the election
+	// key of the fence lives outside of the prefix.
+	prefix string
+
+	// fence, if set, guards every read-write transaction against the
+	// leadership term it identifies having ended.
+	fence *LeaderFence
+
+	// writeMtx ensures that only a single read-write transaction is active
+	// within this process at a time, mirroring bbolt's semantics.
+	writeMtx sync.Mutex
+}
+
+// A compile-time check to ensure etcdBackend implements the Backend interface.
+var _ Backend = (*etcdBackend)(nil)
+
+// NewEtcdClient creates a new client connected to the etcd cluster described
+// by the passed config.
+func NewEtcdClient(cfg *EtcdConfig) (*clientv3.Client, error) {
+	clientCfg := clientv3.Config{
+		Endpoints:   []string{cfg.Host},
+		DialTimeout: etcdConnectionTimeout,
+		Username:    cfg.User,
+		Password:    cfg.Pass,
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" || cfg.InsecureSkipVerify {
+		tlsInfo := transport.TLSInfo{
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+		}
+		tlsConfig, err := tlsInfo.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		clientCfg.TLS = tlsConfig
+	}
+
+	return clientv3.New(clientCfg)
+}
+
+// OpenEtcd connects to the etcd cluster described by the passed config. All
+// keys are stored under the config's prefix. If a fence is passed, read-write
+// transactions are only committed for as long as the leadership term it
+// identifies lasts, and fail with ErrLeaderFenced afterwards.
+func OpenEtcd(ctx context.Context, cfg *EtcdConfig,
+	fence *LeaderFence) (Backend, error) {
+
+	cli, err := NewEtcdClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &etcdBackend{
+		ctx:    ctx,
+		cli:    cli,
+		kv:     namespace.NewKV(cli.KV, cfg.Prefix),
+		cancel: cancel,
+		prefix: cfg.Prefix,
+		fence:  fence,
+	}, nil
+}
+
+// View executes the passed function within the context of a managed
+// read-only transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (e *etcdBackend) View(fn func(tx Tx) error) error {
+	tx, err := e.Begin(false)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	// As reads within a bucket can't return an error, any error
+	// encountered while reading from the cluster is surfaced here.
+	return tx.(*etcdTx).err
+}
+
+// Update executes the passed function within the context of a managed
+// read-write transaction. If the transaction conflicts with a transaction
+// committed by another process, ErrTxConflict is returned. The function isn't
+// executed again, as it may have side effects that can't be repeated, such as
+// consuming a reader.
+//
+// NOTE: This is part of the Backend interface.
+func (e *etcdBackend) Update(fn func(tx Tx) error) error {
+	tx, err := e.Begin(true)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Batch is identical to Update, as the etcd backend doesn't coalesce
+// concurrent transactions. However, as the passed function must be idempotent,
+// a transaction that conflicts with a transaction committed by another process
+// is retried against a fresh snapshot.
+//
+// NOTE: This is part of the Backend interface.
+func (e *etcdBackend) Batch(fn func(tx Tx) error) error {
+	for {
+		err := e.Update(fn)
+		if err == ErrTxConflict {
+			continue
+		}
+
+		return err
+	}
+}
+
+// Begin starts a new transaction reading from a snapshot of the current state
+// of the cluster.
+//
+// NOTE: This is part of the Backend interface.
+func (e *etcdBackend) Begin(writable bool) (Tx, error) {
+	select {
+	case <-e.ctx.Done():
+		return nil, ErrDatabaseNotOpen
+	default:
+	}
+
+	if writable {
+		e.writeMtx.Lock()
+	}
+
+	// We'll fetch the lock key in order to learn the current revision of
+	// the cluster, which all reads of the transaction will be made at.
+	ctx, cancel := context.WithTimeout(e.ctx, etcdLongTimeout)
+	resp, err := e.kv.Get(ctx, string(etcdLockKey))
+	cancel()
+	if err != nil {
+		if writable {
+			e.writeMtx.Unlock()
+		}
+		return nil, err
+	}
+
+	return newEtcdTx(e, resp.Header.Revision, writable), nil
+}
+
+// Close closes the connection to the etcd cluster.
+//
+// NOTE: This is part of the Backend interface.
+func (e *etcdBackend) Close() error {
+	e.cancel()
+	return e.cli.Close()
+}
@@ -0,0 +1,396 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+)
+
+// The etcd backend flattens the tree of buckets into etcd's single key space.
+// Every bucket is identified by a byte string derived from its path, with the
+// root bucket (holding all top-level buckets) having an empty identifier:
+//
+//   bucketID | 'k' | key  -> 'v' | value    (a key/value pair)
+//   bucketID | 'k' | name -> 'b'            (a nested bucket)
+//   bucketID | 's'        -> uint64         (the bucket's sequence)
+//
+// The identifier of a nested bucket is its parent's identifier followed by
+// 'c', the length of its name as a big endian uint32, and the name itself. As
+// the key/value pairs of a bucket all share the prefix bucketID | 'k', they
+// can be read with a single range request that excludes the contents of any
+// nested buckets, and the entire subtree of a bucket can be read with a single
+// range request over its identifier.
+const (
+	etcdEntryPrefix  = 'k'
+	etcdChildPrefix  = 'c'
+	etcdSeqPrefix    = 's'
+	etcdValueMarker  = 'v'
+	etcdBucketMarker = 'b'
+)
+
+// etcdEntryPrefixKey returns the prefix shared by all entries of the bucket
+// with the given identifier.
+func etcdEntryPrefixKey(bucketID []byte) []byte {
+	prefix := make([]byte, 0, len(bucketID)+1)
+	prefix = append(prefix, bucketID...)
+	return append(prefix, etcdEntryPrefix)
+}
+
+// etcdEntryKey returns the etcd key that the given key of the bucket with the
+// given identifier is stored at.
+func etcdEntryKey(bucketID, key []byte) []byte {
+	return append(etcdEntryPrefixKey(bucketID), key...)
+}
+
+// etcdChildID returns the identifier of the nested bucket with the given name.
+func etcdChildID(bucketID, name []byte) []byte {
+	id := make([]byte, 0, len(bucketID)+5+len(name))
+	id = append(id, bucketID...)
+	id = append(id, etcdChildPrefix)
+
+	var nameLen [4]byte
+	binary.BigEndian.PutUint32(nameLen[:], uint32(len(name)))
+	id = append(id, nameLen[:]...)
+
+	return append(id, name...)
+}
+
+// etcdSeqKey returns the etcd key that the sequence of the bucket with the
+// given identifier is stored at.
+func etcdSeqKey(bucketID []byte) []byte {
+	key := make([]byte, 0, len(bucketID)+1)
+	key = append(key, bucketID...)
+	return append(key, etcdSeqPrefix)
+}
+
+// isEtcdBucket returns true if the passed raw value marks a nested bucket.
+func isEtcdBucket(raw []byte) bool {
+	return len(raw) != 0 && raw[0] == etcdBucketMarker
+}
+
+// etcdBucket is an implementation of the Bucket interface for the etcd
+// backend.
+type etcdBucket struct {
+	tx *etcdTx
+	id []byte
+}
+
+// A compile-time check to ensure etcdBucket implements the Bucket interface.
+var _ Bucket = (*etcdBucket)(nil)
+
+// Bucket returns the nested bucket with the given name.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Bucket(name []byte) Bucket {
+	if !isEtcdBucket(b.tx.get(etcdEntryKey(b.id, name))) {
+		return nil
+	}
+
+	return &etcdBucket{tx: b.tx, id: etcdChildID(b.id, name)}
+}
+
+// CreateBucket creates a new nested bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) CreateBucket(name []byte) (Bucket, error) {
+	switch {
+	case !b.tx.writable:
+		return nil, ErrTxNotWritable
+	case len(name) == 0:
+		return nil, ErrBucketNameRequired
+	}
+
+	key := etcdEntryKey(b.id, name)
+	raw := b.tx.get(key)
+	switch {
+	case isEtcdBucket(raw):
+		return nil, ErrBucketExists
+	case raw != nil:
+		return nil, ErrIncompatibleValue
+	}
+
+	b.tx.put(key, []byte{etcdBucketMarker})
+
+	return &etcdBucket{tx: b.tx, id: etcdChildID(b.id, name)}, nil
+}
+
+// CreateBucketIfNotExists creates a new nested bucket if it doesn't already
+// exist.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bucket, err := b.CreateBucket(name)
+	if err == ErrBucketExists {
+		return b.Bucket(name), nil
+	}
+
+	return bucket, err
+}
+
+// DeleteBucket deletes a nested bucket along with its entire subtree.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) DeleteBucket(name []byte) error {
+	if !b.tx.writable {
+		return ErrTxNotWritable
+	}
+
+	key := etcdEntryKey(b.id, name)
+	raw := b.tx.get(key)
+	switch {
+	case raw == nil:
+		return ErrBucketNotFound
+	case !isEtcdBucket(raw):
+		return ErrIncompatibleValue
+	}
+
+	for _, kv := range b.tx.rangePrefix(etcdChildID(b.id, name)) {
+		b.tx.del(kv.key)
+	}
+	b.tx.del(key)
+
+	return nil
+}
+
+// Get returns the value of the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Get(key []byte) []byte {
+	raw := b.tx.get(etcdEntryKey(b.id, key))
+	if raw == nil || isEtcdBucket(raw) {
+		return nil
+	}
+
+	return raw[1:]
+}
+
+// Put sets the value of the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Put(key, value []byte) error {
+	switch {
+	case !b.tx.writable:
+		return ErrTxNotWritable
+	case len(key) == 0:
+		return ErrKeyRequired
+	}
+
+	entryKey := etcdEntryKey(b.id, key)
+	if isEtcdBucket(b.tx.get(entryKey)) {
+		return ErrIncompatibleValue
+	}
+
+	raw := make([]byte, 0, len(value)+1)
+	raw = append(raw, etcdValueMarker)
+	b.tx.put(entryKey, append(raw, value...))
+
+	return nil
+}
+
+// Delete removes the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Delete(key []byte) error {
+	if !b.tx.writable {
+		return ErrTxNotWritable
+	}
+
+	entryKey := etcdEntryKey(b.id, key)
+	raw := b.tx.get(entryKey)
+	switch {
+	case raw == nil:
+		return nil
+	case isEtcdBucket(raw):
+		return ErrIncompatibleValue
+	}
+
+	b.tx.del(entryKey)
+
+	return nil
+}
+
+// entries returns all key/value pairs of the bucket sorted by key, with nil
+// values for nested buckets.
+func (b *etcdBucket) entries() []etcdKV {
+	prefix := etcdEntryPrefixKey(b.id)
+
+	kvs := b.tx.rangePrefix(prefix)
+	for i := range kvs {
+		kvs[i].key = kvs[i].key[len(prefix):]
+		if isEtcdBucket(kvs[i].value) {
+			kvs[i].value = nil
+		} else {
+			kvs[i].value = kvs[i].value[1:]
+		}
+	}
+
+	return kvs
+}
+
+// ForEach executes the passed function for each key/value pair.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) ForEach(fn func(k, v []byte) error) error {
+	for _, kv := range b.entries() {
+		if err := fn(kv.key, kv.value); err != nil {
+			return err
+		}
+	}
+
+	return b.tx.err
+}
+
+// Cursor returns a new cursor over the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Cursor() Cursor {
+	return &etcdCursor{bucket: b}
+}
+
+// NextSequence increments and returns the bucket's sequence number.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) NextSequence() (uint64, error) {
+	seq := b.Sequence() + 1
+	if err := b.SetSequence(seq); err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// Sequence returns the bucket's sequence number.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Sequence() uint64 {
+	raw := b.tx.get(etcdSeqKey(b.id))
+	if len(raw) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(raw)
+}
+
+// SetSequence sets the bucket's sequence number.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) SetSequence(v uint64) error {
+	if !b.tx.writable {
+		return ErrTxNotWritable
+	}
+
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], v)
+	b.tx.put(etcdSeqKey(b.id), raw[:])
+
+	return nil
+}
+
+// Tx returns the transaction the bucket was accessed within.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *etcdBucket) Tx() Tx {
+	return b.tx
+}
+
+// etcdCursor is an implementation of the Cursor interface for the etcd
+// backend. The entries of the bucket are read each time the cursor is
+// repositioned with First, Last or Seek, and then iterated over in memory.
+type etcdCursor struct {
+	bucket *etcdBucket
+	kvs    []etcdKV
+	pos    int
+}
+
+// A compile-time check to ensure etcdCursor implements the Cursor interface.
+var _ Cursor = (*etcdCursor)(nil)
+
+// current returns the key/value pair the cursor is positioned at, or nil if
+// it has moved past either end of the bucket.
+func (c *etcdCursor) current() ([]byte, []byte) {
+	if c.pos < 0 || c.pos >= len(c.kvs) {
+		return nil, nil
+	}
+
+	return c.kvs[c.pos].key, c.kvs[c.pos].value
+}
+
+// First moves the cursor to the first key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *etcdCursor) First() ([]byte, []byte) {
+	c.kvs = c.bucket.entries()
+	c.pos = 0
+
+	return c.current()
+}
+
+// Last moves the cursor to the last key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *etcdCursor) Last() ([]byte, []byte) {
+	c.kvs = c.bucket.entries()
+	c.pos = len(c.kvs) - 1
+
+	return c.current()
+}
+
+// Next moves the cursor to the next key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *etcdCursor) Next() ([]byte, []byte) {
+	if c.pos < len(c.kvs) {
+		c.pos++
+	}
+
+	return c.current()
+}
+
+// Prev moves the cursor to the previous key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *etcdCursor) Prev() ([]byte, []byte) {
+	if c.pos >= 0 {
+		c.pos--
+	}
+
+	return c.current()
+}
+
+// Seek moves the cursor to the given key, or the next key if it doesn't
+// exist.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *etcdCursor) Seek(seek []byte) ([]byte, []byte) {
+	c.kvs = c.bucket.entries()
+	c.pos = sort.Search(len(c.kvs), func(i int) bool {
+		return bytes.Compare(c.kvs[i].key, seek) >= 0
+	})
+
+	return c.current()
+}
+
+// Delete removes the key the cursor is positioned at. A subsequent call to
+// Next moves the cursor to the key that followed the deleted key.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *etcdCursor) Delete() error {
+	key, value := c.current()
+	if key == nil {
+		return nil
+	}
+	if value == nil {
+		return ErrIncompatibleValue
+	}
+
+	if err := c.bucket.Delete(key); err != nil {
+		return err
+	}
+
+	c.kvs = append(c.kvs[:c.pos], c.kvs[c.pos+1:]...)
+	c.pos--
+
+	return nil
+}
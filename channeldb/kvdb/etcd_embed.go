@@ -0,0 +1,80 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/coreos/etcd/embed"
+)
+
+const (
+	// embeddedEtcdStartTimeout is the maximum amount of time we'll wait
+	// for an embedded etcd server to become ready.
+	embeddedEtcdStartTimeout = 10 * time.Second
+
+	// embeddedEtcdMaxTxnOps is the maximum number of operations we allow
+	// within a single transaction committed to an embedded etcd server.
+	embeddedEtcdMaxTxnOps = 8192
+
+	// embeddedEtcdMaxRequestBytes is the maximum size of a single request
+	// made to an embedded etcd server.
+	embeddedEtcdMaxRequestBytes = 16 * 1024 * 1024
+)
+
+// getFreeURL returns a URL of a free port on the loopback interface.
+func getFreeURL() (*url.URL, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close()
+
+	return &url.URL{Scheme: "http", Host: l.Addr().String()}, nil
+}
+
+// NewEmbeddedEtcdInstance starts an etcd server storing its state within the
+// passed directory, and returns the config needed to connect to it along with
+// a function that stops the server. This is mainly useful for testing.
+func NewEmbeddedEtcdInstance(path string) (*EtcdConfig, func(), error) {
+	clientURL, err := getFreeURL()
+	if err != nil {
+		return nil, nil, err
+	}
+	peerURL, err := getFreeURL()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := embed.NewConfig()
+	cfg.Dir = path
+	cfg.MaxTxnOps = embeddedEtcdMaxTxnOps
+	cfg.MaxRequestBytes = embeddedEtcdMaxRequestBytes
+	cfg.LCUrls = []url.URL{*clientURL}
+	cfg.ACUrls = []url.URL{*clientURL}
+	cfg.LPUrls = []url.URL{*peerURL}
+	cfg.APUrls = []url.URL{*peerURL}
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+	etcd, err := embed.StartEtcd(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(embeddedEtcdStartTimeout):
+		etcd.Close()
+		return nil, nil, fmt.Errorf("etcd failed to start after %v",
+			embeddedEtcdStartTimeout)
+	}
+
+	connConfig := &EtcdConfig{
+		Host: clientURL.Host,
+	}
+
+	return connConfig, etcd.Close, nil
+}
@@ -0,0 +1,130 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// makeEtcdBackend creates an etcd backend connected to a fresh embedded etcd
+// server.
+func makeEtcdBackend(t *testing.T) (Backend, func()) {
+	tempDir, err := ioutil.TempDir("", "etcd")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	cfg, stopEtcd, err := NewEmbeddedEtcdInstance(tempDir)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to start etcd: %v", err)
+	}
+
+	db, err := OpenEtcd(context.Background(), cfg, nil)
+	if err != nil {
+		stopEtcd()
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to open etcd backend: %v", err)
+	}
+
+	return db, func() {
+		db.Close()
+		stopEtcd()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// TestEtcdBackend runs the backend test suite against the etcd backend.
+func TestEtcdBackend(t *testing.T) {
+	runBackendTests(t, makeEtcdBackend)
+}
+
+// TestEtcdConflict asserts that a read-write transaction that conflicts with
+// a transaction committed by another process fails with ErrTxConflict without
+// being retried, while Batch retries it against a fresh snapshot.
+func TestEtcdConflict(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeEtcdBackend(t)
+	defer cleanUp()
+
+	// We'll open a second backend sharing the same cluster to play the
+	// role of another process.
+	other, err := OpenEtcd(
+		context.Background(), &EtcdConfig{
+			Host: db.(*etcdBackend).cli.Endpoints()[0],
+		}, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to open second backend: %v", err)
+	}
+	defer other.Close()
+
+	err = db.Update(func(tx Tx) error {
+		_, err := tx.CreateBucket([]byte("bucket"))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to create bucket: %v", err)
+	}
+
+	// incrementSeq returns a transaction function that increments the
+	// bucket's sequence. On its first execution, another process commits
+	// in between our read and our commit.
+	incrementSeq := func(attempts *int) func(tx Tx) error {
+		return func(tx Tx) error {
+			*attempts++
+
+			b := tx.Bucket([]byte("bucket"))
+			seq := b.Sequence()
+
+			if *attempts == 1 {
+				err := other.Update(func(tx Tx) error {
+					b := tx.Bucket([]byte("bucket"))
+					_, err := b.NextSequence()
+					return err
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return b.SetSequence(seq + 1)
+		}
+	}
+
+	// Update should fail with a conflict, leaving only the other
+	// process' write in place.
+	attempts := 0
+	err = db.Update(incrementSeq(&attempts))
+	if err != ErrTxConflict {
+		t.Fatalf("expected ErrTxConflict, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %v", attempts)
+	}
+
+	// Batch should retry the conflicting transaction, so both increments
+	// of the other process and our own are applied.
+	attempts = 0
+	err = db.Batch(incrementSeq(&attempts))
+	if err != nil {
+		t.Fatalf("unable to batch: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %v", attempts)
+	}
+
+	err = db.View(func(tx Tx) error {
+		if seq := tx.Bucket([]byte("bucket")).Sequence(); seq != 3 {
+			t.Fatalf("expected sequence 3, got %v", seq)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read sequence: %v", err)
+	}
+}
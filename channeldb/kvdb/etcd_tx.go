@@ -0,0 +1,334 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+// etcdKV is a single key/value pair read from the cluster.
+type etcdKV struct {
+	key   []byte
+	value []byte
+}
+
+// etcdTx is an implementation of the Tx interface for the etcd backend. All
+// reads are made against the revision of the cluster at the time the
+// transaction was started, overlaid with the transaction's own writes.
+type etcdTx struct {
+	backend  *etcdBackend
+	rev      int64
+	writable bool
+	closed   bool
+
+	// err is the first error encountered while reading from the cluster.
+	// It's returned once the transaction is committed, as reads within a
+	// bucket can't return an error themselves.
+	err error
+
+	// reads caches every individual key read from the cluster, a nil
+	// value denoting a key that doesn't exist.
+	reads map[string][]byte
+
+	// writes holds every key written within the transaction, a nil value
+	// denoting a key that has been deleted.
+	writes map[string][]byte
+
+	onCommit []func()
+
+	root *etcdBucket
+}
+
+// A compile-time check to ensure etcdTx implements the Tx interface.
+var _ Tx = (*etcdTx)(nil)
+
+// newEtcdTx creates a new transaction that reads at the given revision.
+func newEtcdTx(backend *etcdBackend, rev int64, writable bool) *etcdTx {
+	tx := &etcdTx{
+		backend:  backend,
+		rev:      rev,
+		writable: writable,
+		reads:    make(map[string][]byte),
+		writes:   make(map[string][]byte),
+	}
+	tx.root = &etcdBucket{tx: tx}
+
+	return tx
+}
+
+// get returns the raw value stored at the given key, or nil if it doesn't
+// exist.
+func (t *etcdTx) get(key []byte) []byte {
+	if value, ok := t.writes[string(key)]; ok {
+		return value
+	}
+	if value, ok := t.reads[string(key)]; ok {
+		return value
+	}
+
+	ctx, cancel := context.WithTimeout(t.backend.ctx, etcdLongTimeout)
+	defer cancel()
+
+	resp, err := t.backend.kv.Get(
+		ctx, string(key), clientv3.WithRev(t.rev),
+	)
+	if err != nil {
+		t.setErr(err)
+		return nil
+	}
+
+	var value []byte
+	if len(resp.Kvs) != 0 {
+		value = resp.Kvs[0].Value
+	}
+	t.reads[string(key)] = value
+
+	return value
+}
+
+// rangePrefix returns all key/value pairs whose key starts with the given
+// prefix, sorted by key.
+func (t *etcdTx) rangePrefix(prefix []byte) []etcdKV {
+	ctx, cancel := context.WithTimeout(t.backend.ctx, etcdLongTimeout)
+	defer cancel()
+
+	resp, err := t.backend.kv.Get(
+		ctx, string(prefix), clientv3.WithPrefix(),
+		clientv3.WithRev(t.rev),
+	)
+	if err != nil {
+		t.setErr(err)
+		return nil
+	}
+
+	// Overlay our own writes on top of the snapshot read from the
+	// cluster.
+	merged := make(map[string][]byte, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		merged[string(kv.Key)] = kv.Value
+	}
+	for key, value := range t.writes {
+		if !bytes.HasPrefix([]byte(key), prefix) {
+			continue
+		}
+
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+
+	kvs := make([]etcdKV, 0, len(merged))
+	for key, value := range merged {
+		kvs = append(kvs, etcdKV{key: []byte(key), value: value})
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return bytes.Compare(kvs[i].key, kvs[j].key) < 0
+	})
+
+	return kvs
+}
+
+// put buffers a write of the given key until the transaction is committed.
+func (t *etcdTx) put(key, value []byte) {
+	t.writes[string(key)] = value
+}
+
+// del buffers a deletion of the given key until the transaction is
+// committed.
+func (t *etcdTx) del(key []byte) {
+	t.writes[string(key)] = nil
+}
+
+// setErr records the passed error if no prior error has been recorded.
+func (t *etcdTx) setErr(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+// Bucket returns the top-level bucket with the given name.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) Bucket(name []byte) Bucket {
+	return t.root.Bucket(name)
+}
+
+// CreateBucket creates a new top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) CreateBucket(name []byte) (Bucket, error) {
+	return t.root.CreateBucket(name)
+}
+
+// CreateBucketIfNotExists creates a new top-level bucket if it doesn't already
+// exist.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	return t.root.CreateBucketIfNotExists(name)
+}
+
+// DeleteBucket deletes a top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) DeleteBucket(name []byte) error {
+	return t.root.DeleteBucket(name)
+}
+
+// ForEach executes the passed function for each top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.root.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+
+		return fn(k, t.root.Bucket(k))
+	})
+}
+
+// Writable returns true if this is a read-write transaction.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) Writable() bool {
+	return t.writable
+}
+
+// OnCommit registers a function to be executed once the transaction commits.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) OnCommit(fn func()) {
+	t.onCommit = append(t.onCommit, fn)
+}
+
+// Commit atomically applies all writes made within the transaction to the
+// cluster. ErrTxConflict is returned if another read-write transaction was
+// committed after this transaction's snapshot was taken, and ErrLeaderFenced
+// if the backend is fenced and the leadership term has ended.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) Commit() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	defer t.close()
+
+	if !t.writable {
+		return ErrTxNotWritable
+	}
+	if t.err != nil {
+		return t.err
+	}
+
+	// The transaction is committed through the client directly, so we
+	// add the prefix of the backend to our keys ourselves.
+	prefix := t.backend.prefix
+	lockKey := prefix + string(etcdLockKey)
+
+	ops := make([]clientv3.Op, 0, len(t.writes)+1)
+	for key, value := range t.writes {
+		if value == nil {
+			ops = append(ops, clientv3.OpDelete(prefix+key))
+			continue
+		}
+		ops = append(ops, clientv3.OpPut(prefix+key, string(value)))
+	}
+	ops = append(ops, clientv3.OpPut(lockKey, ""))
+
+	// The writes are only applied if no other transaction has touched the
+	// lock key since our snapshot was taken.
+	cmps := []clientv3.Cmp{
+		clientv3.Compare(
+			clientv3.ModRevision(lockKey), "<", t.rev+1,
+		),
+	}
+
+	// If we're fenced, the writes are also only applied if our election
+	// key still exists, as it's deleted once our lease expires. A new
+	// term of ours creates a new key, so its create revision must match
+	// as well.
+	var elseOps []clientv3.Op
+	fence := t.backend.fence
+	if fence != nil {
+		cmps = append(cmps, clientv3.Compare(
+			clientv3.CreateRevision(fence.Key), "=", fence.Rev,
+		))
+		elseOps = append(elseOps, clientv3.OpGet(fence.Key))
+	}
+
+	ctx, cancel := context.WithTimeout(t.backend.ctx, etcdLongTimeout)
+	defer cancel()
+
+	resp, err := t.backend.cli.Txn(ctx).If(cmps...).Then(
+		ops...,
+	).Else(elseOps...).Commit()
+	switch {
+	// The cluster's limits are too low to hold the transaction, which
+	// can only be fixed by raising them, see the etcdBackend docs.
+	case err == rpctypes.ErrTooManyOps || err == rpctypes.ErrRequestTooLarge:
+		return fmt.Errorf("unable to commit %v operations, the etcd "+
+			"server limits (--max-txn-ops, --max-request-bytes) "+
+			"must be raised: %v", len(ops), err)
+
+	case err != nil:
+		return err
+
+	case !resp.Succeeded && fence != nil &&
+		!fenceHeld(fence, resp.Responses[0].GetResponseRange()):
+
+		return ErrLeaderFenced
+
+	case !resp.Succeeded:
+		return ErrTxConflict
+	}
+
+	for _, fn := range t.onCommit {
+		fn()
+	}
+
+	return nil
+}
+
+// fenceHeld returns true if the passed response to a read of the fence's
+// election key shows that the leadership term of the fence is still ongoing.
+func fenceHeld(fence *LeaderFence, resp *etcdserverpb.RangeResponse) bool {
+	if resp == nil || len(resp.Kvs) == 0 {
+		return false
+	}
+
+	return resp.Kvs[0].CreateRevision == fence.Rev
+}
+
+// Rollback discards all writes made within the transaction.
+//
+// NOTE: This is part of the Tx interface.
+func (t *etcdTx) Rollback() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	t.close()
+
+	return nil
+}
+
+// close marks the transaction as closed, allowing the next read-write
+// transaction to begin.
+func (t *etcdTx) close() {
+	t.closed = true
+	t.writes = nil
+	t.reads = nil
+
+	if t.writable {
+		t.backend.writeMtx.Unlock()
+	}
+}
@@ -0,0 +1,204 @@
+package kvdb
+
+import "errors"
+
+var (
+	// ErrDatabaseNotOpen is returned when a transaction is started on a
+	// backend that has been closed.
+	ErrDatabaseNotOpen = errors.New("database not open")
+
+	// ErrBucketNotFound is returned when trying to access a bucket that
+	// has not been created yet.
+	ErrBucketNotFound = errors.New("bucket not found")
+
+	// ErrBucketExists is returned when creating a bucket that already
+	// exists.
+	ErrBucketExists = errors.New("bucket already exists")
+
+	// ErrBucketNameRequired is returned when creating a bucket with a
+	// blank name.
+	ErrBucketNameRequired = errors.New("bucket name required")
+
+	// ErrKeyRequired is returned when inserting a zero-length key.
+	ErrKeyRequired = errors.New("key required")
+
+	// ErrIncompatibleValue is returned when trying to create or delete a
+	// bucket on an existing non-bucket key, or when trying to put or
+	// delete a value on an existing bucket key.
+	ErrIncompatibleValue = errors.New("incompatible value")
+
+	// ErrTxNotWritable is returned when performing a write operation on a
+	// read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+
+	// ErrTxClosed is returned when committing or rolling back a
+	// transaction that has already been committed or rolled back.
+	ErrTxClosed = errors.New("tx closed")
+
+	// ErrTxConflict is returned when committing a read-write transaction
+	// that conflicts with a transaction committed by another process
+	// sharing the same database since the transaction was started. None
+	// of the transaction's writes are applied.
+	ErrTxConflict = errors.New("tx conflicts with a concurrent tx")
+
+	// ErrLeaderFenced is returned when committing a read-write
+	// transaction to a backend guarded by a LeaderFence after the
+	// leadership term the fence identifies has ended. None of the
+	// transaction's writes are applied.
+	ErrLeaderFenced = errors.New("leadership lost, tx not committed")
+)
+
+// Backend is a transactional key-value store that all of lnd's persistent
+// state is written to. Within a backend, values are stored within a tree of
+// named buckets. Within a bucket, keys are kept in byte-sorted order.
+//
+// The method set of a Backend, as well as that of the transactions, buckets
+// and cursors it hands out, mirrors bbolt's, such that code written against
+// bbolt can be ported to any backend by only changing types.
+type Backend interface {
+	// View executes the passed function within the context of a managed
+	// read-only transaction. Any error returned from the function is
+	// returned from View.
+	View(fn func(tx Tx) error) error
+
+	// Update executes the passed function within the context of a
+	// managed read-write transaction. If the function returns an error,
+	// the transaction is rolled back, otherwise it's committed. Backends
+	// shared by several processes return ErrTxConflict if another process
+	// committed a conflicting transaction in the meantime.
+	Update(fn func(tx Tx) error) error
+
+	// Batch is identical to Update, but allows a backend to coalesce
+	// concurrent calls into a single transaction. As a result, the
+	// passed function may be executed more than once, so it must be
+	// idempotent.
+	Batch(fn func(tx Tx) error) error
+
+	// Begin starts a new transaction. Only a single read-write
+	// transaction may be active at a time. The caller MUST either commit
+	// or roll back the returned transaction.
+	Begin(writable bool) (Tx, error)
+
+	// Close closes the backend, releasing all resources held by it.
+	Close() error
+}
+
+// Tx is a transaction within a Backend. Top-level buckets may only be
+// accessed through a transaction, and key/value pairs may only be stored
+// within a bucket.
+type Tx interface {
+	// Bucket returns the top-level bucket with the given name, or nil if
+	// it doesn't exist.
+	Bucket(name []byte) Bucket
+
+	// CreateBucket creates a new top-level bucket with the given name.
+	// ErrBucketExists is returned if the bucket already exists.
+	CreateBucket(name []byte) (Bucket, error)
+
+	// CreateBucketIfNotExists creates a new top-level bucket with the
+	// given name if it doesn't already exist, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+
+	// DeleteBucket deletes the top-level bucket with the given name,
+	// along with all of its contents. ErrBucketNotFound is returned if
+	// the bucket doesn't exist.
+	DeleteBucket(name []byte) error
+
+	// ForEach executes the passed function for each top-level bucket.
+	ForEach(fn func(name []byte, b Bucket) error) error
+
+	// Writable returns true if the transaction is a read-write
+	// transaction.
+	Writable() bool
+
+	// OnCommit registers a function that will be executed once the
+	// transaction has been successfully committed.
+	OnCommit(fn func())
+
+	// Commit writes all changes made within the transaction to the
+	// backend.
+	Commit() error
+
+	// Rollback discards all changes made within the transaction.
+	Rollback() error
+}
+
+// Bucket is a collection of key/value pairs and nested buckets.
+//
+// NOTE: Byte slices returned from a bucket or cursor are only valid for the
+// lifetime of the transaction they were read within.
+type Bucket interface {
+	// Bucket returns the nested bucket with the given name, or nil if it
+	// doesn't exist.
+	Bucket(name []byte) Bucket
+
+	// CreateBucket creates a new nested bucket with the given name.
+	// ErrBucketExists is returned if the bucket already exists.
+	CreateBucket(name []byte) (Bucket, error)
+
+	// CreateBucketIfNotExists creates a new nested bucket with the given
+	// name if it doesn't already exist, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+
+	// DeleteBucket deletes the nested bucket with the given name, along
+	// with all of its contents. ErrBucketNotFound is returned if the
+	// bucket doesn't exist.
+	DeleteBucket(name []byte) error
+
+	// Get returns the value of the given key, or nil if either the key
+	// doesn't exist, or it's a nested bucket.
+	Get(key []byte) []byte
+
+	// Put sets the value of the given key, overwriting any existing
+	// value.
+	Put(key, value []byte) error
+
+	// Delete removes the given key. Deleting a key that doesn't exist is
+	// not an error.
+	Delete(key []byte) error
+
+	// ForEach executes the passed function for each key/value pair within
+	// the bucket in key order. The value is nil for nested buckets. The
+	// bucket must not be modified by the passed function.
+	ForEach(fn func(k, v []byte) error) error
+
+	// Cursor returns a new cursor over the bucket.
+	Cursor() Cursor
+
+	// NextSequence increments the bucket's sequence number, and returns
+	// the new value.
+	NextSequence() (uint64, error)
+
+	// Sequence returns the bucket's current sequence number.
+	Sequence() uint64
+
+	// SetSequence sets the bucket's sequence number.
+	SetSequence(v uint64) error
+
+	// Tx returns the transaction the bucket was accessed within.
+	Tx() Tx
+}
+
+// Cursor iterates over the key/value pairs of a bucket in key order. All
+// positioning methods return a nil key once the cursor moves past either end
+// of the bucket. Values are nil for nested buckets.
+type Cursor interface {
+	// First moves the cursor to the first key within the bucket.
+	First() (key, value []byte)
+
+	// Last moves the cursor to the last key within the bucket.
+	Last() (key, value []byte)
+
+	// Next moves the cursor to the next key within the bucket.
+	Next() (key, value []byte)
+
+	// Prev moves the cursor to the previous key within the bucket.
+	Prev() (key, value []byte)
+
+	// Seek moves the cursor to the given key, or the next key if it
+	// doesn't exist.
+	Seek(seek []byte) (key, value []byte)
+
+	// Delete removes the key the cursor is currently positioned at.
+	Delete() error
+}
@@ -0,0 +1,484 @@
+package kvdb
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// backendFactory creates a fresh, empty backend along with a function that
+// tears it down.
+type backendFactory func(t *testing.T) (Backend, func())
+
+// makeBoltBackend creates a bbolt backend within a temporary directory.
+func makeBoltBackend(t *testing.T) (Backend, func()) {
+	tempDir, err := ioutil.TempDir("", "kvdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	db, err := OpenBolt(filepath.Join(tempDir, "test.db"))
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to open bolt backend: %v", err)
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+}
+
+var backendTests = []struct {
+	name string
+	test func(t *testing.T, db Backend)
+}{
+	{
+		name: "buckets",
+		test: testBuckets,
+	},
+	{
+		name: "key values",
+		test: testKeyValues,
+	},
+	{
+		name: "cursor",
+		test: testCursor,
+	},
+	{
+		name: "sequence",
+		test: testSequence,
+	},
+	{
+		name: "rollback",
+		test: testRollback,
+	},
+}
+
+// runBackendTests runs the full backend test suite against backends created
+// by the passed factory.
+func runBackendTests(t *testing.T, makeBackend backendFactory) {
+	for _, test := range backendTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			db, cleanUp := makeBackend(t)
+			defer cleanUp()
+
+			test.test(t, db)
+		})
+	}
+}
+
+// TestBoltBackend runs the backend test suite against the bbolt backend.
+func TestBoltBackend(t *testing.T) {
+	runBackendTests(t, makeBoltBackend)
+}
+
+// testBuckets asserts that top-level and nested buckets can be created,
+// enumerated and deleted along with their contents.
+func testBuckets(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		top, err := tx.CreateBucket([]byte("top"))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucket([]byte("top")); err != ErrBucketExists {
+			return fmt.Errorf("expected ErrBucketExists, got %v", err)
+		}
+
+		nested, err := top.CreateBucketIfNotExists([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		if err := nested.Put([]byte("key"), []byte("value")); err != nil {
+			return err
+		}
+
+		// A bucket whose name is a prefix of the nested bucket must not
+		// see any of its contents.
+		prefix, err := top.CreateBucket([]byte("nest"))
+		if err != nil {
+			return err
+		}
+		if prefix.Get([]byte("key")) != nil {
+			return fmt.Errorf("bucket contents leaked")
+		}
+
+		_, err = tx.CreateBucket([]byte("other"))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to create buckets: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		var names []string
+		err := tx.ForEach(func(name []byte, b Bucket) error {
+			if b == nil {
+				return fmt.Errorf("nil bucket %s", name)
+			}
+			names = append(names, string(name))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if fmt.Sprint(names) != "[other top]" {
+			return fmt.Errorf("unexpected top-level buckets: %v",
+				names)
+		}
+
+		top := tx.Bucket([]byte("top"))
+		if top == nil {
+			return fmt.Errorf("top bucket not found")
+		}
+		if tx.Bucket([]byte("missing")) != nil {
+			return fmt.Errorf("missing bucket found")
+		}
+
+		nested := top.Bucket([]byte("nested"))
+		if nested == nil {
+			return fmt.Errorf("nested bucket not found")
+		}
+		if !bytes.Equal(nested.Get([]byte("key")), []byte("value")) {
+			return fmt.Errorf("nested value mismatch")
+		}
+
+		// Nested buckets have nil values within their parent.
+		if top.Get([]byte("nested")) != nil {
+			return fmt.Errorf("expected nil value for nested bucket")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read buckets: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		top := tx.Bucket([]byte("top"))
+		if err := top.DeleteBucket([]byte("nested")); err != nil {
+			return err
+		}
+		err := top.DeleteBucket([]byte("nested"))
+		if err != ErrBucketNotFound {
+			return fmt.Errorf("expected ErrBucketNotFound, got %v",
+				err)
+		}
+
+		return tx.DeleteBucket([]byte("other"))
+	})
+	if err != nil {
+		t.Fatalf("unable to delete buckets: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		if tx.Bucket([]byte("other")) != nil {
+			return fmt.Errorf("deleted bucket found")
+		}
+
+		// Recreating a deleted bucket must yield an empty bucket.
+		nested, err := tx.Bucket([]byte("top")).CreateBucket(
+			[]byte("nested"),
+		)
+		if err != nil {
+			return err
+		}
+		if nested.Get([]byte("key")) != nil {
+			return fmt.Errorf("deleted value found")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to verify deletion: %v", err)
+	}
+}
+
+// testKeyValues asserts that values can be written, overwritten and deleted,
+// and that values and buckets can't be confused for one another.
+func testKeyValues(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+
+		if err := b.Put([]byte("a"), []byte("1")); err != nil {
+			return err
+		}
+		if err := b.Put([]byte("a"), []byte("2")); err != nil {
+			return err
+		}
+		if err := b.Put([]byte("empty"), []byte{}); err != nil {
+			return err
+		}
+		if err := b.Put(nil, []byte("1")); err != ErrKeyRequired {
+			return fmt.Errorf("expected ErrKeyRequired, got %v", err)
+		}
+
+		if _, err := b.CreateBucket([]byte("sub")); err != nil {
+			return err
+		}
+		err = b.Put([]byte("sub"), []byte("1"))
+		if err != ErrIncompatibleValue {
+			return fmt.Errorf("expected ErrIncompatibleValue, "+
+				"got %v", err)
+		}
+		_, err = b.CreateBucket([]byte("a"))
+		if err != ErrIncompatibleValue {
+			return fmt.Errorf("expected ErrIncompatibleValue, "+
+				"got %v", err)
+		}
+
+		// Writes must be visible within the same transaction.
+		if !bytes.Equal(b.Get([]byte("a")), []byte("2")) {
+			return fmt.Errorf("uncommitted write not visible")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to write values: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		b := tx.Bucket([]byte("bucket"))
+		if !bytes.Equal(b.Get([]byte("a")), []byte("2")) {
+			return fmt.Errorf("value mismatch")
+		}
+		if v := b.Get([]byte("empty")); len(v) != 0 {
+			return fmt.Errorf("expected empty value, got %x", v)
+		}
+
+		if err := b.Delete([]byte("a")); err != nil {
+			return err
+		}
+		if err := b.Delete([]byte("missing")); err != nil {
+			return err
+		}
+		if b.Get([]byte("a")) != nil {
+			return fmt.Errorf("deleted value found")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to delete values: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		b := tx.Bucket([]byte("bucket"))
+		if err := b.Put([]byte("b"), []byte("1")); err != ErrTxNotWritable {
+			return fmt.Errorf("expected ErrTxNotWritable, got %v",
+				err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("read-only transaction allowed a write: %v", err)
+	}
+}
+
+// testCursor asserts that cursors iterate over a bucket in key order in both
+// directions, and that the key a cursor is positioned at can be deleted.
+func testCursor(t *testing.T, db Backend) {
+	keys := []string{"a", "b", "c", "d", "e"}
+
+	err := db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+
+		// Insert the keys out of order to ensure they're sorted.
+		for i := len(keys) - 1; i >= 0; i-- {
+			err := b.Put([]byte(keys[i]), []byte(keys[i]))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to write values: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		c := tx.Bucket([]byte("bucket")).Cursor()
+
+		var forward []string
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			forward = append(forward, string(k))
+		}
+		if fmt.Sprint(forward) != fmt.Sprint(keys) {
+			return fmt.Errorf("unexpected forward order: %v",
+				forward)
+		}
+
+		var backward []string
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			backward = append(backward, string(k))
+		}
+		if fmt.Sprint(backward) != "[e d c b a]" {
+			return fmt.Errorf("unexpected backward order: %v",
+				backward)
+		}
+
+		if k, _ := c.Seek([]byte("bb")); string(k) != "c" {
+			return fmt.Errorf("expected seek to c, got %s", k)
+		}
+		if k, _ := c.Seek([]byte("z")); k != nil {
+			return fmt.Errorf("expected seek past end, got %s", k)
+		}
+
+		// Delete the key the cursor is positioned at.
+		if k, _ := c.Seek([]byte("c")); string(k) != "c" {
+			return fmt.Errorf("expected seek to c, got %s", k)
+		}
+		if err := c.Delete(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cursor iteration failed: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		var remaining []string
+		err := tx.Bucket([]byte("bucket")).ForEach(func(k, v []byte) error {
+			remaining = append(remaining, string(k))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if fmt.Sprint(remaining) != "[a b d e]" {
+			return fmt.Errorf("unexpected remaining keys: %v",
+				remaining)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to verify cursor deletion: %v", err)
+	}
+}
+
+// testSequence asserts that bucket sequences are persisted.
+func testSequence(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+		if b.Sequence() != 0 {
+			return fmt.Errorf("expected zero sequence")
+		}
+
+		for i := uint64(1); i <= 3; i++ {
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			if seq != i {
+				return fmt.Errorf("expected sequence %v, got %v",
+					i, seq)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to increment sequence: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		b := tx.Bucket([]byte("bucket"))
+		if b.Sequence() != 3 {
+			return fmt.Errorf("expected sequence 3, got %v",
+				b.Sequence())
+		}
+
+		return b.SetSequence(10)
+	})
+	if err != nil {
+		t.Fatalf("unable to set sequence: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		if seq := tx.Bucket([]byte("bucket")).Sequence(); seq != 10 {
+			return fmt.Errorf("expected sequence 10, got %v", seq)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read sequence: %v", err)
+	}
+}
+
+// testRollback asserts that a failed update is rolled back, and that commit
+// hooks only run once a transaction commits.
+func testRollback(t *testing.T, db Backend) {
+	errFail := fmt.Errorf("fail")
+
+	var committed bool
+	err := db.Update(func(tx Tx) error {
+		tx.OnCommit(func() {
+			committed = true
+		})
+
+		b, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+		if err := b.Put([]byte("key"), []byte("value")); err != nil {
+			return err
+		}
+
+		return errFail
+	})
+	if err != errFail {
+		t.Fatalf("expected errFail, got %v", err)
+	}
+	if committed {
+		t.Fatalf("commit hook executed for a failed update")
+	}
+
+	err = db.Update(func(tx Tx) error {
+		tx.OnCommit(func() {
+			committed = true
+		})
+
+		if tx.Bucket([]byte("bucket")) != nil {
+			return fmt.Errorf("rolled back bucket found")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to verify rollback: %v", err)
+	}
+	if !committed {
+		t.Fatalf("commit hook not executed")
+	}
+
+	tx, err := db.Begin(false)
+	if err != nil {
+		t.Fatalf("unable to begin transaction: %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("unable to roll back transaction: %v", err)
+	}
+	if err := tx.Rollback(); err != ErrTxClosed {
+		t.Fatalf("expected ErrTxClosed, got %v", err)
+	}
+}
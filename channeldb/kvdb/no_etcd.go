@@ -0,0 +1,21 @@
+// +build !kvdb_etcd
+
+package kvdb
+
+import (
+	"context"
+	"errors"
+)
+
+// errEtcdNotAvailable is returned when an etcd backend is requested from a
+// binary that was built without etcd support.
+var errEtcdNotAvailable = errors.New("etcd backend not available, " +
+	"rebuild with the kvdb_etcd build tag")
+
+// OpenEtcd would connect to the etcd cluster described by the passed config,
+// however etcd support was not compiled in, so an error is always returned.
+func OpenEtcd(ctx context.Context, cfg *EtcdConfig,
+	fence *LeaderFence) (Backend, error) {
+
+	return nil, errEtcdNotAvailable
+}
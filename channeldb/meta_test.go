@@ -5,8 +5,8 @@ import (
 	"io/ioutil"
 	"testing"
 
-	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 )
 
 // applyMigration is a helper test function that encapsulates the general steps
@@ -108,11 +108,11 @@ func TestOrderOfMigrations(t *testing.T) {
 	versions := []version{
 		{0, nil},
 		{1, nil},
-		{2, func(tx *bbolt.Tx) error {
+		{2, func(tx kvdb.Tx) error {
 			appliedMigration = 2
 			return nil
 		}},
-		{3, func(tx *bbolt.Tx) error {
+		{3, func(tx kvdb.Tx) error {
 			appliedMigration = 3
 			return nil
 		}},
@@ -184,7 +184,7 @@ func TestMigrationWithPanic(t *testing.T) {
 	beforeMigrationFunc := func(d *DB) {
 		// Insert data in database and in order then make sure that the
 		// key isn't changes in case of panic or fail.
-		d.Update(func(tx *bbolt.Tx) error {
+		d.Update(func(tx kvdb.Tx) error {
 			bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 			if err != nil {
 				return err
@@ -197,7 +197,7 @@ func TestMigrationWithPanic(t *testing.T) {
 
 	// Create migration function which changes the initially created data and
 	// throw the panic, in this case we pretending that something goes.
-	migrationWithPanic := func(tx *bbolt.Tx) error {
+	migrationWithPanic := func(tx kvdb.Tx) error {
 		bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 		if err != nil {
 			return err
@@ -218,7 +218,7 @@ func TestMigrationWithPanic(t *testing.T) {
 			t.Fatal("migration panicked but version is changed")
 		}
 
-		err = d.Update(func(tx *bbolt.Tx) error {
+		err = d.Update(func(tx kvdb.Tx) error {
 			bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 			if err != nil {
 				return err
@@ -255,7 +255,7 @@ func TestMigrationWithFatal(t *testing.T) {
 	afterMigration := []byte("aftermigration")
 
 	beforeMigrationFunc := func(d *DB) {
-		d.Update(func(tx *bbolt.Tx) error {
+		d.Update(func(tx kvdb.Tx) error {
 			bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 			if err != nil {
 				return err
@@ -269,7 +269,7 @@ func TestMigrationWithFatal(t *testing.T) {
 	// Create migration function which changes the initially created data and
 	// return the error, in this case we pretending that something goes
 	// wrong.
-	migrationWithFatal := func(tx *bbolt.Tx) error {
+	migrationWithFatal := func(tx kvdb.Tx) error {
 		bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 		if err != nil {
 			return err
@@ -290,7 +290,7 @@ func TestMigrationWithFatal(t *testing.T) {
 			t.Fatal("migration failed but version is changed")
 		}
 
-		err = d.Update(func(tx *bbolt.Tx) error {
+		err = d.Update(func(tx kvdb.Tx) error {
 			bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 			if err != nil {
 				return err
@@ -328,7 +328,7 @@ func TestMigrationWithoutErrors(t *testing.T) {
 
 	// Populate database with initial data.
 	beforeMigrationFunc := func(d *DB) {
-		d.Update(func(tx *bbolt.Tx) error {
+		d.Update(func(tx kvdb.Tx) error {
 			bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 			if err != nil {
 				return err
@@ -340,7 +340,7 @@ func TestMigrationWithoutErrors(t *testing.T) {
 	}
 
 	// Create migration function which changes the initially created data.
-	migrationWithoutErrors := func(tx *bbolt.Tx) error {
+	migrationWithoutErrors := func(tx kvdb.Tx) error {
 		bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 		if err != nil {
 			return err
@@ -362,7 +362,7 @@ func TestMigrationWithoutErrors(t *testing.T) {
 				"successfully applied migration")
 		}
 
-		err = d.Update(func(tx *bbolt.Tx) error {
+		err = d.Update(func(tx kvdb.Tx) error {
 			bucket, err := tx.CreateBucketIfNotExists(bucketPrefix)
 			if err != nil {
 				return err
@@ -406,7 +406,7 @@ func TestMigrationReversion(t *testing.T) {
 
 	// Update the database metadata to point to one more than the highest
 	// known version.
-	err = cdb.Update(func(tx *bbolt.Tx) error {
+	err = cdb.Update(func(tx kvdb.Tx) error {
 		newMeta := &Meta{
 			DbVersionNumber: getLatestDBVersion(dbVersions) + 1,
 		}
@@ -5,8 +5,11 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
+	"io"
 
-	"github.com/coreos/bbolt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lnwire"
 )
 
@@ -15,7 +18,7 @@ import (
 // (one for nodes and one for edges) to keep track of the last time a node or
 // edge was updated on the network. These new indexes allow us to implement the
 // new graph sync protocol added.
-func migrateNodeAndEdgeUpdateIndex(tx *bbolt.Tx) error {
+func migrateNodeAndEdgeUpdateIndex(tx kvdb.Tx) error {
 	// First, we'll populating the node portion of the new index. Before we
 	// can add new values to the index, we'll first create the new bucket
 	// where these items will be housed.
@@ -120,7 +123,7 @@ func migrateNodeAndEdgeUpdateIndex(tx *bbolt.Tx) error {
 // invoices an index in the add and/or the settle index. Additionally, all
 // existing invoices will have their bytes padded out in order to encode the
 // add+settle index as well as the amount paid.
-func migrateInvoiceTimeSeries(tx *bbolt.Tx) error {
+func migrateInvoiceTimeSeries(tx kvdb.Tx) error {
 	invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 	if err != nil {
 		return err
@@ -239,7 +242,7 @@ func migrateInvoiceTimeSeries(tx *bbolt.Tx) error {
 // migrateInvoiceTimeSeries migration. As at the time of writing, the
 // OutgoingPayment struct embeddeds an instance of the Invoice struct. As a
 // result, we also need to migrate the internal invoice to the new format.
-func migrateInvoiceTimeSeriesOutgoingPayments(tx *bbolt.Tx) error {
+func migrateInvoiceTimeSeriesOutgoingPayments(tx kvdb.Tx) error {
 	payBucket := tx.Bucket(paymentBucket)
 	if payBucket == nil {
 		return nil
@@ -308,7 +311,7 @@ func migrateInvoiceTimeSeriesOutgoingPayments(tx *bbolt.Tx) error {
 // bucket. It ensure that edges with unknown policies will also have an entry
 // in the bucket. After the migration, there will be two edge entries for
 // every channel, regardless of whether the policies are known.
-func migrateEdgePolicies(tx *bbolt.Tx) error {
+func migrateEdgePolicies(tx kvdb.Tx) error {
 	nodes := tx.Bucket(nodeBucket)
 	if nodes == nil {
 		return nil
@@ -380,7 +383,7 @@ func migrateEdgePolicies(tx *bbolt.Tx) error {
 // paymentStatusesMigration is a database migration intended for adding payment
 // statuses for each existing payment entity in bucket to be able control
 // transitions of statuses and prevent cases such as double payment
-func paymentStatusesMigration(tx *bbolt.Tx) error {
+func paymentStatusesMigration(tx kvdb.Tx) error {
 	// Get the bucket dedicated to storing statuses of payments,
 	// where a key is payment hash, value is payment status.
 	paymentStatuses, err := tx.CreateBucketIfNotExists(paymentStatusBucket)
@@ -467,7 +470,7 @@ func paymentStatusesMigration(tx *bbolt.Tx) error {
 // migration also fixes the case where the public keys within edge policies were
 // being serialized with an extra byte, causing an even greater error when
 // attempting to perform the offset calculation described earlier.
-func migratePruneEdgeUpdateIndex(tx *bbolt.Tx) error {
+func migratePruneEdgeUpdateIndex(tx kvdb.Tx) error {
 	// To begin the migration, we'll retrieve the update index bucket. If it
 	// does not exist, we have nothing left to do so we can simply exit.
 	edges := tx.Bucket(edgeBucket)
@@ -578,7 +581,7 @@ func migratePruneEdgeUpdateIndex(tx *bbolt.Tx) error {
 // migrateOptionalChannelCloseSummaryFields migrates the serialized format of
 // ChannelCloseSummary to a format where optional fields' presence is indicated
 // with boolean markers.
-func migrateOptionalChannelCloseSummaryFields(tx *bbolt.Tx) error {
+func migrateOptionalChannelCloseSummaryFields(tx kvdb.Tx) error {
 	closedChanBucket := tx.Bucket(closedChannelBucket)
 	if closedChanBucket == nil {
 		return nil
@@ -617,7 +620,7 @@ var messageStoreBucket = []byte("message-store")
 // migrateGossipMessageStoreKeys migrates the key format for gossip messages
 // found in the message store to a new one that takes into consideration the of
 // the message being stored.
-func migrateGossipMessageStoreKeys(tx *bbolt.Tx) error {
+func migrateGossipMessageStoreKeys(tx kvdb.Tx) error {
 	// We'll start by retrieving the bucket in which these messages are
 	// stored within. If there isn't one, there's nothing left for us to do
 	// so we can avoid the migration.
@@ -683,3 +686,240 @@ func migrateGossipMessageStoreKeys(tx *bbolt.Tx) error {
 
 	return nil
 }
+
+var (
+	// utxnChainPrefix is the prefix of the root-level bucket the utxo
+	// nursery stored its state within for each chain.
+	utxnChainPrefix = []byte("utxn")
+
+	// utxnChannelIndexKey is the nested bucket of the nursery's chain
+	// bucket that holds a bucket of outputs for each channel.
+	utxnChannelIndexKey = []byte("channel-index")
+
+	// utxnGradPrefix is the state prefix of the outputs within the
+	// nursery's channel index that have been fully swept.
+	utxnGradPrefix = []byte("grad")
+
+	// utxnHeightIndexKey is the nested bucket of the nursery's chain
+	// bucket that holds its height index.
+	utxnHeightIndexKey = []byte("height-index")
+
+	// utxnFinalizedKndrTxnKey is the key within a height bucket of the
+	// nursery under which its finalized kindergarten sweep txn is stored.
+	utxnFinalizedKndrTxnKey = []byte("finalized-kndr-txn")
+
+	// sweeperTxHashesBucket is the root-level bucket the sweeper stores
+	// the hashes of all its published sweep txns within.
+	sweeperTxHashesBucket = []byte("sweeper-tx-hashes")
+
+	// sweeperNurseryOutputsBucket is the root-level bucket the sweeper
+	// resumes the incubation of the outputs from that were still held by
+	// the nursery.
+	sweeperNurseryOutputsBucket = []byte("sweeper-nursery-outputs")
+)
+
+// migrateRemoveUtxoNursery removes all state of the utxo nursery. The nursery
+// incubated time locked outputs on behalf of the contract resolvers, which
+// now publish second-level transactions and sweep time locked outputs
+// themselves.
+//
+// The outputs the nursery was still incubating are handed over to the
+// sweeper, which resumes their incubation from the nursery's state, keyed by
+// the nursery's state prefix and the outpoint of the output. Outputs of
+// channels whose channel arbitrator still has state are left to its contract
+// resolvers though, which resume the resolution of these outputs themselves,
+// such that each output is only offered to the sweeper once. The nursery's
+// finalized sweep txns are carried over as well. If the sweeper hasn't
+// recorded their hashes yet, we'll do so here, such that the sweeper
+// recognizes them as its own once they confirm.
+func migrateRemoveUtxoNursery(tx kvdb.Tx) error {
+	// The nursery created a bucket for each chain it was used on, which
+	// is identified by the utxn prefix followed by the chain's hash. We'll
+	// first gather the names of these buckets, as we can't delete them
+	// while iterating.
+	var nurseryBuckets [][]byte
+	err := tx.ForEach(func(name []byte, _ kvdb.Bucket) error {
+		if len(name) != len(utxnChainPrefix)+chainhash.HashSize {
+			return nil
+		}
+		if !bytes.HasPrefix(name, utxnChainPrefix) {
+			return nil
+		}
+
+		bucketName := make([]byte, len(name))
+		copy(bucketName, name)
+		nurseryBuckets = append(nurseryBuckets, bucketName)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(nurseryBuckets) == 0 {
+		return nil
+	}
+
+	log.Info("Removing utxo nursery state")
+
+	// If the sweeper has already created its tx hashes bucket, it has
+	// already taken over the nursery's finalized sweep txns.
+	migrateTxHashes := tx.Bucket(sweeperTxHashesBucket) == nil
+	txHashes, err := tx.CreateBucketIfNotExists(sweeperTxHashesBucket)
+	if err != nil {
+		return err
+	}
+
+	nurseryOutputs, err := tx.CreateBucketIfNotExists(
+		sweeperNurseryOutputsBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, bucketName := range nurseryBuckets {
+		chainBucket := tx.Bucket(bucketName)
+
+		if migrateTxHashes {
+			err := migrateNurseryTxHashes(chainBucket, txHashes)
+			if err != nil {
+				return err
+			}
+		}
+
+		chainHash := bucketName[len(utxnChainPrefix):]
+		err := migrateIncubatingNurseryOutputs(
+			tx, chainHash, chainBucket, nurseryOutputs,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.DeleteBucket(bucketName); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Removal of utxo nursery state complete!")
+
+	return nil
+}
+
+// migrateIncubatingNurseryOutputs copies all outputs within the passed
+// nursery chain bucket that haven't graduated yet to the sweeper's nursery
+// outputs bucket, unless their channel arbitrator still has state.
+func migrateIncubatingNurseryOutputs(tx kvdb.Tx, chainHash []byte,
+	chainBucket, nurseryOutputs kvdb.Bucket) error {
+
+	chanIndex := chainBucket.Bucket(utxnChannelIndexKey)
+	if chanIndex == nil {
+		return nil
+	}
+
+	var numIncubating, numResolving int
+	err := chanIndex.ForEach(func(chanPoint, _ []byte) error {
+		chanBucket := chanIndex.Bucket(chanPoint)
+		if chanBucket == nil {
+			return nil
+		}
+
+		// The contract resolvers of the channel resume the resolution
+		// of its outputs themselves, so we'll leave the outputs to
+		// them.
+		resolving := hasArbitratorLog(tx, chainHash, chanPoint)
+
+		return chanBucket.ForEach(func(k, v []byte) error {
+			if v == nil || bytes.HasPrefix(k, utxnGradPrefix) {
+				return nil
+			}
+
+			if resolving {
+				numResolving++
+				return nil
+			}
+
+			// The nursery's bucket is deleted within this
+			// transaction, so we'll copy the output over.
+			key := make([]byte, len(k))
+			copy(key, k)
+			output := make([]byte, len(v))
+			copy(output, v)
+
+			numIncubating++
+			return nurseryOutputs.Put(key, output)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if numIncubating != 0 {
+		log.Infof("Handed %v incubating nursery outputs over to the "+
+			"sweeper", numIncubating)
+	}
+	if numResolving != 0 {
+		log.Infof("Left %v incubating nursery outputs to the contract "+
+			"resolvers", numResolving)
+	}
+
+	return nil
+}
+
+// hasArbitratorLog returns true if the channel arbitrator of the channel with
+// the passed key within the nursery's channel index still has state. The
+// arbitrator's state is scoped by the chain hash followed by the channel
+// point, and is only removed once all of its contracts have been resolved.
+func hasArbitratorLog(tx kvdb.Tx, chainHash, chanPointKey []byte) bool {
+	// The nursery serialized the txid of the channel point as variable
+	// length bytes, followed by its index.
+	r := bytes.NewReader(chanPointKey)
+	txid, err := wire.ReadVarBytes(r, 0, chainhash.HashSize, "chanpoint")
+	if err != nil || len(txid) != chainhash.HashSize {
+		return false
+	}
+	var index [4]byte
+	if _, err := io.ReadFull(r, index[:]); err != nil {
+		return false
+	}
+
+	scope := make([]byte, 0, len(chainHash)+len(txid)+len(index))
+	scope = append(scope, chainHash...)
+	scope = append(scope, txid...)
+	scope = append(scope, index[:]...)
+
+	return tx.Bucket(scope) != nil
+}
+
+// migrateNurseryTxHashes adds the hashes of all finalized sweep txns found
+// within the passed nursery chain bucket to the sweeper's tx hashes bucket.
+func migrateNurseryTxHashes(chainBucket, txHashes kvdb.Bucket) error {
+	heightIndex := chainBucket.Bucket(utxnHeightIndexKey)
+	if heightIndex == nil {
+		return nil
+	}
+
+	return heightIndex.ForEach(func(k, _ []byte) error {
+		heightBucket := heightIndex.Bucket(k)
+		if heightBucket == nil {
+			return nil
+		}
+
+		txBytes := heightBucket.Get(utxnFinalizedKndrTxnKey)
+		if txBytes == nil {
+			return nil
+		}
+
+		// Skip any tx that can't be deserialized, it can't be
+		// recognized by the sweeper anyway.
+		sweepTx := &wire.MsgTx{}
+		err := sweepTx.Deserialize(bytes.NewReader(txBytes))
+		if err != nil {
+			log.Warnf("Unable to deserialize nursery sweep tx: %v",
+				err)
+			return nil
+		}
+
+		hash := sweepTx.TxHash()
+		return txHashes.Put(hash[:], []byte{})
+	})
+}
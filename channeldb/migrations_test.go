@@ -6,12 +6,15 @@ import (
 	"encoding/binary"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lnwire"
 )
 
@@ -56,7 +59,7 @@ func TestPaymentStatusesMigration(t *testing.T) {
 		// locally-sourced payment should end up with an InFlight
 		// status, while the other should remain unchanged, which
 		// defaults to Grounded.
-		err = d.Update(func(tx *bbolt.Tx) error {
+		err = d.Update(func(tx kvdb.Tx) error {
 			circuits, err := tx.CreateBucketIfNotExists(
 				[]byte("circuit-adds"),
 			)
@@ -383,7 +386,7 @@ func TestMigrateOptionalChannelCloseSummaryFields(t *testing.T) {
 			// Get the old serialization format for this test's
 			// close summary, and it to the closed channel bucket.
 			old := test.oldSerialization(test.closeSummary)
-			err = d.Update(func(tx *bbolt.Tx) error {
+			err = d.Update(func(tx kvdb.Tx) error {
 				closedChanBucket, err := tx.CreateBucketIfNotExists(
 					closedChannelBucket,
 				)
@@ -419,7 +422,7 @@ func TestMigrateOptionalChannelCloseSummaryFields(t *testing.T) {
 			newSerialization := b.Bytes()
 
 			var dbSummary []byte
-			err = d.View(func(tx *bbolt.Tx) error {
+			err = d.View(func(tx kvdb.Tx) error {
 				closedChanBucket := tx.Bucket(closedChannelBucket)
 				if closedChanBucket == nil {
 					return errors.New("unable to find bucket")
@@ -497,7 +500,7 @@ func TestMigrateGossipMessageStoreKeys(t *testing.T) {
 			t.Fatalf("unable to serialize message: %v", err)
 		}
 
-		err := db.Update(func(tx *bbolt.Tx) error {
+		err := db.Update(func(tx kvdb.Tx) error {
 			messageStore, err := tx.CreateBucketIfNotExists(
 				messageStoreBucket,
 			)
@@ -526,7 +529,7 @@ func TestMigrateGossipMessageStoreKeys(t *testing.T) {
 		}
 
 		var rawMsg []byte
-		err = db.View(func(tx *bbolt.Tx) error {
+		err = db.View(func(tx kvdb.Tx) error {
 			messageStore := tx.Bucket(messageStoreBucket)
 			if messageStore == nil {
 				return errors.New("message store bucket not " +
@@ -564,3 +567,393 @@ func TestMigrateGossipMessageStoreKeys(t *testing.T) {
 		migrateGossipMessageStoreKeys, false,
 	)
 }
+
+// createNurseryBucket creates a nursery chain bucket holding a single output
+// stored under the passed state prefix, along with a finalized sweep tx if
+// one is passed.
+func createNurseryBucket(tx kvdb.Tx, bucketName, statePrefix []byte,
+	sweepTx []byte) error {
+
+	nursery, err := tx.CreateBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	channelIndex, err := nursery.CreateBucket(utxnChannelIndexKey)
+	if err != nil {
+		return err
+	}
+	chanBucket, err := channelIndex.CreateBucket([]byte("chan"))
+	if err != nil {
+		return err
+	}
+	outputKey := []byte(string(statePrefix) + "outpoint")
+	if err := chanBucket.Put(outputKey, []byte("output")); err != nil {
+		return err
+	}
+
+	heightIndex, err := nursery.CreateBucket(utxnHeightIndexKey)
+	if err != nil {
+		return err
+	}
+	var height [4]byte
+	byteOrder.PutUint32(height[:], 100)
+	heightBucket, err := heightIndex.CreateBucket(height[:])
+	if err != nil {
+		return err
+	}
+	if sweepTx == nil {
+		return nil
+	}
+
+	return heightBucket.Put(utxnFinalizedKndrTxnKey, sweepTx)
+}
+
+// TestMigrateRemoveUtxoNursery ensures that the migration removes the state of
+// the utxo nursery once all of its outputs have graduated, while handing its
+// sweep txns over to the sweeper and leaving other root-level buckets
+// untouched.
+func TestMigrateRemoveUtxoNursery(t *testing.T) {
+	t.Parallel()
+
+	var chainHash chainhash.Hash
+	copy(chainHash[:], bytes.Repeat([]byte{0x1}, chainhash.HashSize))
+	nurseryBucket := make([]byte, 0, len(utxnChainPrefix)+len(chainHash))
+	nurseryBucket = append(nurseryBucket, utxnChainPrefix...)
+	nurseryBucket = append(nurseryBucket, chainHash[:]...)
+
+	// Another root-level bucket that happens to share the utxn prefix,
+	// which must not be removed.
+	otherBucket := []byte("utxn-other")
+
+	// The nursery's finalized sweep tx, whose hash should be handed over
+	// to the sweeper.
+	sweepTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Index: 1}},
+		},
+		TxOut: []*wire.TxOut{
+			{Value: 1000},
+		},
+	}
+	sweepTxHash := sweepTx.TxHash()
+
+	// Before the migration, we'll create the nursery's bucket along with
+	// some of its nested state, its only output having graduated.
+	beforeMigration := func(db *DB) {
+		var b bytes.Buffer
+		if err := sweepTx.Serialize(&b); err != nil {
+			t.Fatalf("unable to serialize tx: %v", err)
+		}
+
+		err := db.Update(func(tx kvdb.Tx) error {
+			err := createNurseryBucket(
+				tx, nurseryBucket, utxnGradPrefix, b.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.CreateBucket(otherBucket)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// After the migration, the nursery's bucket should be gone.
+	afterMigration := func(db *DB) {
+		meta, err := db.FetchMeta(nil)
+		if err != nil {
+			t.Fatalf("unable to fetch db version: %v", err)
+		}
+		if meta.DbVersionNumber != 1 {
+			t.Fatalf("migration should have succeeded but didn't")
+		}
+
+		err = db.View(func(tx kvdb.Tx) error {
+			if tx.Bucket(nurseryBucket) != nil {
+				return errors.New("nursery bucket not removed")
+			}
+			if tx.Bucket(otherBucket) == nil {
+				return errors.New("unrelated bucket removed")
+			}
+
+			txHashes := tx.Bucket(sweeperTxHashesBucket)
+			if txHashes == nil {
+				return errors.New("sweeper tx hashes bucket " +
+					"not found")
+			}
+			if txHashes.Get(sweepTxHash[:]) == nil {
+				return errors.New("nursery sweep tx hash not " +
+					"migrated")
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applyMigration(
+		t, beforeMigration, afterMigration,
+		migrateRemoveUtxoNursery, false,
+	)
+}
+
+// TestMigrateRemoveUtxoNurseryIncubating ensures that the migration hands the
+// outputs the utxo nursery still incubates over to the sweeper, before
+// removing the nursery's state.
+func TestMigrateRemoveUtxoNurseryIncubating(t *testing.T) {
+	t.Parallel()
+
+	var chainHash chainhash.Hash
+	copy(chainHash[:], bytes.Repeat([]byte{0x1}, chainhash.HashSize))
+	nurseryBucket := make([]byte, 0, len(utxnChainPrefix)+len(chainHash))
+	nurseryBucket = append(nurseryBucket, utxnChainPrefix...)
+	nurseryBucket = append(nurseryBucket, chainHash[:]...)
+
+	// The outputs of the nursery, keyed by their state prefix followed by
+	// their outpoint. Only the graduated output has been swept already.
+	outputs := map[string]string{
+		"kndroutpoint": "output",
+		"criboutpoint": "crib output",
+		"psclout":      "preschool output",
+		"gradout":      "graduated output",
+	}
+
+	// Before the migration, we'll create the nursery's bucket with outputs
+	// in each of the nursery's states.
+	beforeMigration := func(db *DB) {
+		err := db.Update(func(tx kvdb.Tx) error {
+			err := createNurseryBucket(
+				tx, nurseryBucket, []byte("kndr"), nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			chanBucket := tx.Bucket(nurseryBucket).Bucket(
+				utxnChannelIndexKey,
+			).Bucket([]byte("chan"))
+			for k, v := range outputs {
+				err := chanBucket.Put([]byte(k), []byte(v))
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// After the migration, the nursery's bucket should be gone, and all of
+	// its incubating outputs should have been handed over to the sweeper.
+	afterMigration := func(db *DB) {
+		meta, err := db.FetchMeta(nil)
+		if err != nil {
+			t.Fatalf("unable to fetch db version: %v", err)
+		}
+		if meta.DbVersionNumber != 1 {
+			t.Fatalf("migration should have succeeded but didn't")
+		}
+
+		err = db.View(func(tx kvdb.Tx) error {
+			if tx.Bucket(nurseryBucket) != nil {
+				return errors.New("nursery bucket not removed")
+			}
+
+			nurseryOutputs := tx.Bucket(sweeperNurseryOutputsBucket)
+			if nurseryOutputs == nil {
+				return errors.New("sweeper nursery outputs " +
+					"bucket not found")
+			}
+
+			for k, v := range outputs {
+				output := nurseryOutputs.Get([]byte(k))
+
+				if strings.HasPrefix(k, "grad") {
+					if output != nil {
+						return fmt.Errorf("graduated "+
+							"output %v migrated", k)
+					}
+					continue
+				}
+
+				if !bytes.Equal(output, []byte(v)) {
+					return fmt.Errorf("expected output "+
+						"%v to be %v, got %v", k, v,
+						string(output))
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applyMigration(
+		t, beforeMigration, afterMigration,
+		migrateRemoveUtxoNursery, false,
+	)
+}
+
+// TestMigrateRemoveUtxoNurseryResolving ensures that the migration only hands
+// the incubating outputs of channels over to the sweeper, whose channel
+// arbitrator doesn't have any state anymore. The contract resolvers of the
+// other channels resume the resolution of their outputs themselves, so the
+// sweeper must not resume them as well.
+func TestMigrateRemoveUtxoNurseryResolving(t *testing.T) {
+	t.Parallel()
+
+	var chainHash chainhash.Hash
+	copy(chainHash[:], bytes.Repeat([]byte{0x1}, chainhash.HashSize))
+	nurseryBucket := make([]byte, 0, len(utxnChainPrefix)+len(chainHash))
+	nurseryBucket = append(nurseryBucket, utxnChainPrefix...)
+	nurseryBucket = append(nurseryBucket, chainHash[:]...)
+
+	// The channel point of the channel that is still being resolved by
+	// its channel arbitrator, and the one of the channel whose arbitrator
+	// has already finished.
+	resolvingChan := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+	finishedChan := wire.OutPoint{Hash: chainhash.Hash{3}, Index: 1}
+
+	// The nursery keys its channel index by the channel point, of which
+	// the txid is serialized as variable length bytes.
+	chanPointKey := func(chanPoint wire.OutPoint) []byte {
+		var b bytes.Buffer
+		err := wire.WriteVarBytes(&b, 0, chanPoint.Hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var index [4]byte
+		byteOrder.PutUint32(index[:], chanPoint.Index)
+		b.Write(index[:])
+
+		return b.Bytes()
+	}
+
+	// The arbitrator scopes its state by the chain hash followed by the
+	// channel point.
+	arbitratorScope := make([]byte, 0, 2*chainhash.HashSize+4)
+	arbitratorScope = append(arbitratorScope, chainHash[:]...)
+	arbitratorScope = append(arbitratorScope, resolvingChan.Hash[:]...)
+	arbitratorScope = append(arbitratorScope, 0, 0, 0, 1)
+
+	outputs := map[wire.OutPoint]map[string]string{
+		resolvingChan: {
+			"kndrresolving": "resolving output",
+			"cribresolving": "resolving crib output",
+		},
+		finishedChan: {
+			"kndrfinished": "finished output",
+			"cribfinished": "finished crib output",
+		},
+	}
+
+	// Before the migration, we'll create the nursery's bucket with the
+	// outputs of both channels, and the state of the arbitrator that is
+	// still resolving its channel.
+	beforeMigration := func(db *DB) {
+		err := db.Update(func(tx kvdb.Tx) error {
+			nursery, err := tx.CreateBucket(nurseryBucket)
+			if err != nil {
+				return err
+			}
+			channelIndex, err := nursery.CreateBucket(
+				utxnChannelIndexKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			for chanPoint, chanOutputs := range outputs {
+				chanBucket, err := channelIndex.CreateBucket(
+					chanPointKey(chanPoint),
+				)
+				if err != nil {
+					return err
+				}
+
+				for k, v := range chanOutputs {
+					err := chanBucket.Put(
+						[]byte(k), []byte(v),
+					)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			scope, err := tx.CreateBucket(arbitratorScope)
+			if err != nil {
+				return err
+			}
+			contracts, err := scope.CreateBucket(
+				[]byte("contractkey"),
+			)
+			if err != nil {
+				return err
+			}
+
+			return contracts.Put(
+				[]byte("resolver"), []byte("state"),
+			)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// After the migration, only the outputs of the channel whose
+	// arbitrator has finished should have been handed over to the
+	// sweeper, while the state of the other arbitrator is left intact.
+	afterMigration := func(db *DB) {
+		err := db.View(func(tx kvdb.Tx) error {
+			if tx.Bucket(nurseryBucket) != nil {
+				return errors.New("nursery bucket not removed")
+			}
+			if tx.Bucket(arbitratorScope) == nil {
+				return errors.New("arbitrator state removed")
+			}
+
+			nurseryOutputs := tx.Bucket(sweeperNurseryOutputsBucket)
+			if nurseryOutputs == nil {
+				return errors.New("sweeper nursery outputs " +
+					"bucket not found")
+			}
+
+			for k := range outputs[resolvingChan] {
+				if nurseryOutputs.Get([]byte(k)) != nil {
+					return fmt.Errorf("output %v of "+
+						"resolving channel migrated", k)
+				}
+			}
+			for k, v := range outputs[finishedChan] {
+				output := nurseryOutputs.Get([]byte(k))
+				if !bytes.Equal(output, []byte(v)) {
+					return fmt.Errorf("expected output "+
+						"%v to be %v, got %v", k, v,
+						string(output))
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applyMigration(
+		t, beforeMigration, afterMigration,
+		migrateRemoveUtxoNursery, false,
+	)
+}
@@ -6,7 +6,7 @@ import (
 	"errors"
 	"io"
 
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lnwire"
 )
 
@@ -118,7 +118,7 @@ func (db *DB) AddPayment(payment *OutgoingPayment) error {
 	}
 	paymentBytes := b.Bytes()
 
-	return db.Batch(func(tx *bbolt.Tx) error {
+	return db.Batch(func(tx kvdb.Tx) error {
 		payments, err := tx.CreateBucketIfNotExists(paymentBucket)
 		if err != nil {
 			return err
@@ -144,7 +144,7 @@ func (db *DB) AddPayment(payment *OutgoingPayment) error {
 func (db *DB) FetchAllPayments() ([]*OutgoingPayment, error) {
 	var payments []*OutgoingPayment
 
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(paymentBucket)
 		if bucket == nil {
 			return ErrNoPaymentsCreated
@@ -176,9 +176,9 @@ func (db *DB) FetchAllPayments() ([]*OutgoingPayment, error) {
 
 // DeleteAllPayments deletes all payments from DB.
 func (db *DB) DeleteAllPayments() error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.Tx) error {
 		err := tx.DeleteBucket(paymentBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
@@ -190,7 +190,7 @@ func (db *DB) DeleteAllPayments() error {
 // UpdatePaymentStatus sets the payment status for outgoing/finished payments in
 // local database.
 func (db *DB) UpdatePaymentStatus(paymentHash [32]byte, status PaymentStatus) error {
-	return db.Batch(func(tx *bbolt.Tx) error {
+	return db.Batch(func(tx kvdb.Tx) error {
 		return UpdatePaymentStatusTx(tx, paymentHash, status)
 	})
 }
@@ -199,7 +199,7 @@ func (db *DB) UpdatePaymentStatus(paymentHash [32]byte, status PaymentStatus) er
 // outgoing/finished payments in the local database. This method accepts a
 // boltdb transaction such that the operation can be composed into other
 // database transactions.
-func UpdatePaymentStatusTx(tx *bbolt.Tx,
+func UpdatePaymentStatusTx(tx kvdb.Tx,
 	paymentHash [32]byte, status PaymentStatus) error {
 
 	paymentStatuses, err := tx.CreateBucketIfNotExists(paymentStatusBucket)
@@ -210,11 +210,34 @@ func UpdatePaymentStatusTx(tx *bbolt.Tx,
 	return paymentStatuses.Put(paymentHash[:], status.Bytes())
 }
 
+// DeleteGroundedPaymentStatus removes the status of the payment with the
+// passed hash, if the payment is grounded. The status of payments that are in
+// flight or completed is left untouched, as it prevents paying the same hash
+// twice.
+func (db *DB) DeleteGroundedPaymentStatus(paymentHash [32]byte) error {
+	return db.Batch(func(tx kvdb.Tx) error {
+		bucket := tx.Bucket(paymentStatusBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		status, err := FetchPaymentStatusTx(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+		if status != StatusGrounded {
+			return nil
+		}
+
+		return bucket.Delete(paymentHash[:])
+	})
+}
+
 // FetchPaymentStatus returns the payment status for outgoing payment.
 // If status of the payment isn't found, it will default to "StatusGrounded".
 func (db *DB) FetchPaymentStatus(paymentHash [32]byte) (PaymentStatus, error) {
 	var paymentStatus = StatusGrounded
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.Tx) error {
 		var err error
 		paymentStatus, err = FetchPaymentStatusTx(tx, paymentHash)
 		return err
@@ -230,7 +253,7 @@ func (db *DB) FetchPaymentStatus(paymentHash [32]byte) (PaymentStatus, error) {
 // outgoing payment.  If status of the payment isn't found, it will default to
 // "StatusGrounded". It accepts the boltdb transactions such that this method
 // can be composed into other atomic operations.
-func FetchPaymentStatusTx(tx *bbolt.Tx, paymentHash [32]byte) (PaymentStatus, error) {
+func FetchPaymentStatusTx(tx kvdb.Tx, paymentHash [32]byte) (PaymentStatus, error) {
 	// The default status for all payments that aren't recorded in database.
 	var paymentStatus = StatusGrounded
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lnwire"
 )
 
@@ -250,4 +251,36 @@ func TestPaymentStatusWorkflow(t *testing.T) {
 			)
 		}
 	}
+
+	// Deleting the statuses should only remove the grounded payment, as
+	// the others prevent the same hash from being paid twice.
+	for _, testCase := range testCases {
+		err := db.DeleteGroundedPaymentStatus(testCase.paymentHash)
+		if err != nil {
+			t.Fatalf("unable to delete payment status: %v", err)
+		}
+	}
+
+	var numStatuses int
+	err = db.View(func(tx kvdb.Tx) error {
+		return tx.Bucket(paymentStatusBucket).ForEach(
+			func(k, v []byte) error {
+				var status PaymentStatus
+				status.FromBytes(v)
+				if status == StatusGrounded {
+					return fmt.Errorf("grounded payment "+
+						"%x not deleted", k)
+				}
+
+				numStatuses++
+				return nil
+			},
+		)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if numStatuses != 2 {
+		t.Fatalf("expected 2 payment statuses, got %v", numStatuses)
+	}
 }
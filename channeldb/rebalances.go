@@ -0,0 +1,163 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/lnwire"
+)
+
+var (
+	// rebalanceBucket is the top-level bucket that stores the outcome of
+	// circular payments that moved funds between our own channels. These
+	// are kept apart from regular payments, as they don't pay anyone but
+	// ourselves.
+	//
+	// Within the bucket, each rebalance is keyed by a monotonically
+	// increasing sequence number.
+	//
+	// maps: sequence number -> rebalance
+	rebalanceBucket = []byte("rebalances")
+)
+
+// Rebalance describes the outcome of a circular payment that was sent through
+// one of our channels and received back through another.
+type Rebalance struct {
+	// PaymentHash is the payment hash of the internal invoice that was
+	// paid by the rebalance.
+	PaymentHash [32]byte
+
+	// OutgoingChanID is the channel the payment left through.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// IncomingChanID is the channel the payment arrived back through. It
+	// is only known if the rebalance succeeded.
+	IncomingChanID lnwire.ShortChannelID
+
+	// LastHop is the compressed public key of the peer the payment was
+	// received back from.
+	LastHop [33]byte
+
+	// Amount is the amount that was moved between the channels.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the total fee that was paid to the intermediate nodes. It is
+	// only known if the rebalance succeeded.
+	Fee lnwire.MilliSatoshi
+
+	// Succeeded indicates whether the rebalance succeeded.
+	Succeeded bool
+
+	// FailureReason describes why the rebalance failed.
+	FailureReason string
+
+	// Timestamp is the time the rebalance completed.
+	Timestamp time.Time
+}
+
+// AddRebalance stores the outcome of a rebalance within the database. As the
+// rebalance isn't a regular payment, the status the switch recorded for its
+// payment hash is removed, unless the payment is still in flight. The invoice
+// of the rebalance, settled or canceled, already prevents paying the hash
+// twice.
+func (d *DB) AddRebalance(rebalance *Rebalance) error {
+	var b bytes.Buffer
+	if err := serializeRebalance(&b, rebalance); err != nil {
+		return err
+	}
+
+	return d.Batch(func(tx kvdb.Tx) error {
+		rebalances, err := tx.CreateBucketIfNotExists(rebalanceBucket)
+		if err != nil {
+			return err
+		}
+
+		status, err := FetchPaymentStatusTx(tx, rebalance.PaymentHash)
+		if err != nil {
+			return err
+		}
+		paymentStatuses := tx.Bucket(paymentStatusBucket)
+		if paymentStatuses != nil && status != StatusInFlight {
+			err := paymentStatuses.Delete(rebalance.PaymentHash[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		seqNum, err := rebalances.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		binary.BigEndian.PutUint64(key[:], seqNum)
+
+		return rebalances.Put(key[:], b.Bytes())
+	})
+}
+
+// FetchRebalances returns all rebalances stored within the database, in the
+// order in which they were added.
+func (d *DB) FetchRebalances() ([]*Rebalance, error) {
+	var rebalances []*Rebalance
+	err := d.View(func(tx kvdb.Tx) error {
+		bucket := tx.Bucket(rebalanceBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(_, v []byte) error {
+			rebalance, err := deserializeRebalance(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			rebalances = append(rebalances, rebalance)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rebalances, nil
+}
+
+// serializeRebalance writes the passed rebalance to w.
+func serializeRebalance(w io.Writer, rebalance *Rebalance) error {
+	return WriteElements(
+		w, rebalance.PaymentHash, rebalance.OutgoingChanID,
+		rebalance.IncomingChanID, rebalance.LastHop[:],
+		rebalance.Amount, rebalance.Fee, rebalance.Succeeded,
+		[]byte(rebalance.FailureReason),
+		uint64(rebalance.Timestamp.UnixNano()),
+	)
+}
+
+// deserializeRebalance reads a rebalance from r.
+func deserializeRebalance(r io.Reader) (*Rebalance, error) {
+	var (
+		rebalance           Rebalance
+		lastHop, failReason []byte
+		timestamp           uint64
+	)
+	err := ReadElements(
+		r, &rebalance.PaymentHash, &rebalance.OutgoingChanID,
+		&rebalance.IncomingChanID, &lastHop, &rebalance.Amount,
+		&rebalance.Fee, &rebalance.Succeeded, &failReason, &timestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	copy(rebalance.LastHop[:], lastHop)
+	rebalance.FailureReason = string(failReason)
+	rebalance.Timestamp = time.Unix(0, int64(timestamp))
+
+	return &rebalance, nil
+}
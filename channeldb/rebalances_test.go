@@ -0,0 +1,104 @@
+package channeldb
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/lnwire"
+)
+
+// TestRebalances tests that rebalances can be stored and are fetched in the
+// order in which they were added.
+func TestRebalances(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Before any rebalance is stored, we expect an empty result.
+	rebalances, err := db.FetchRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+	if len(rebalances) != 0 {
+		t.Fatalf("expected no rebalances, got %v", len(rebalances))
+	}
+
+	succeeded := &Rebalance{
+		PaymentHash:    [32]byte{1},
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(100),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(200),
+		LastHop:        [33]byte{2},
+		Amount:         500000,
+		Fee:            1200,
+		Succeeded:      true,
+		Timestamp:      time.Unix(0, 1234567890),
+	}
+	failed := &Rebalance{
+		PaymentHash:    [32]byte{3},
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(100),
+		LastHop:        [33]byte{4},
+		Amount:         700000,
+		FailureReason:  "unable to find a path",
+		Timestamp:      time.Unix(0, 1234567891),
+	}
+	timedOut := &Rebalance{
+		PaymentHash:    [32]byte{5},
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(100),
+		LastHop:        [33]byte{6},
+		Amount:         900000,
+		FailureReason:  "payment attempt not completed before timeout",
+		Timestamp:      time.Unix(0, 1234567892),
+	}
+
+	// The switch records the status of the payment hash of each rebalance
+	// as it would for a regular payment.
+	statuses := []PaymentStatus{
+		StatusCompleted, StatusGrounded, StatusInFlight,
+	}
+	expected := []*Rebalance{succeeded, failed, timedOut}
+	for i, rebalance := range expected {
+		err := db.UpdatePaymentStatus(rebalance.PaymentHash, statuses[i])
+		if err != nil {
+			t.Fatalf("unable to update payment status: %v", err)
+		}
+
+		if err := db.AddRebalance(rebalance); err != nil {
+			t.Fatalf("unable to add rebalance: %v", err)
+		}
+	}
+
+	// Only the status of the rebalance that is still in flight should be
+	// kept, as the others aren't regular payments.
+	err = db.View(func(tx kvdb.Tx) error {
+		paymentStatuses := tx.Bucket(paymentStatusBucket)
+		for i, rebalance := range expected {
+			kept := paymentStatuses.Get(rebalance.PaymentHash[:]) != nil
+			if kept != (statuses[i] == StatusInFlight) {
+				return fmt.Errorf("rebalance %v with status %v: "+
+					"status kept=%v", i, statuses[i], kept)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rebalances, err = db.FetchRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+	if !reflect.DeepEqual(rebalances, expected) {
+		t.Fatalf("rebalances mismatch: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(rebalances))
+	}
+}
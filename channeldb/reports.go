@@ -0,0 +1,258 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+var (
+	// resolverReportsBucket is the top-level bucket that stores the
+	// reports of the contract resolvers of force closed channels. The
+	// reports are kept after the channel has been fully closed, so that
+	// the resolution of its outputs can be inspected afterwards.
+	//
+	// maps: chainHash -> chanPoint -> outpoint -> resolver report
+	resolverReportsBucket = []byte("resolver-reports")
+)
+
+// ResolverType indicates the type of resolver that produced a report.
+type ResolverType uint8
+
+const (
+	// ResolverTypeCommit indicates that the report describes the output
+	// on the commitment transaction that pays to us.
+	ResolverTypeCommit ResolverType = 0
+
+	// ResolverTypeIncomingHtlc indicates that the report describes an
+	// incoming HTLC output.
+	ResolverTypeIncomingHtlc ResolverType = 1
+
+	// ResolverTypeOutgoingHtlc indicates that the report describes an
+	// outgoing HTLC output.
+	ResolverTypeOutgoingHtlc ResolverType = 2
+)
+
+// String returns a human readable version of the resolver type.
+func (r ResolverType) String() string {
+	switch r {
+	case ResolverTypeCommit:
+		return "Commit"
+	case ResolverTypeIncomingHtlc:
+		return "IncomingHtlc"
+	case ResolverTypeOutgoingHtlc:
+		return "OutgoingHtlc"
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverOutcome indicates the outcome of a resolver.
+type ResolverOutcome uint8
+
+const (
+	// ResolverOutcomeClaimed indicates that the output was swept back to
+	// our wallet.
+	ResolverOutcomeClaimed ResolverOutcome = 0
+
+	// ResolverOutcomeUnclaimed indicates that the output could not be
+	// claimed by us, but hasn't been claimed by the remote party either.
+	ResolverOutcomeUnclaimed ResolverOutcome = 1
+
+	// ResolverOutcomeAbandoned indicates that we gave up on the output,
+	// for example because the preimage of an incoming HTLC wasn't learned
+	// before it expired.
+	ResolverOutcomeAbandoned ResolverOutcome = 2
+
+	// ResolverOutcomeTimeout indicates that the output was claimed by the
+	// remote party.
+	ResolverOutcomeTimeout ResolverOutcome = 3
+
+	// ResolverOutcomeFirstStage indicates that the first stage of a two
+	// stage HTLC resolution confirmed, and the output of the second-level
+	// transaction still needs to be swept.
+	ResolverOutcomeFirstStage ResolverOutcome = 4
+)
+
+// String returns a human readable version of the resolver outcome.
+func (r ResolverOutcome) String() string {
+	switch r {
+	case ResolverOutcomeClaimed:
+		return "Claimed"
+	case ResolverOutcomeUnclaimed:
+		return "Unclaimed"
+	case ResolverOutcomeAbandoned:
+		return "Abandoned"
+	case ResolverOutcomeTimeout:
+		return "Timeout"
+	case ResolverOutcomeFirstStage:
+		return "FirstStage"
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverReport describes the final outcome of a single output of a force
+// closed channel, as resolved by one of its contract resolvers.
+type ResolverReport struct {
+	// OutPoint is the output that was resolved.
+	OutPoint wire.OutPoint
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// ResolverType is the type of resolver that resolved the output.
+	ResolverType ResolverType
+
+	// ResolverOutcome is the outcome of the resolution.
+	ResolverOutcome ResolverOutcome
+
+	// SpendTxID is the transaction that spent the output. It is nil if
+	// the output was never spent.
+	SpendTxID *chainhash.Hash
+
+	// WaitHeight is the block height the resolver had to wait for before
+	// it could act on the output, for example the expiry of an HTLC or
+	// the maturity of a csv-locked output. It is zero if the resolver
+	// didn't need to wait.
+	WaitHeight uint32
+}
+
+// PutResolverReport stores the report of a contract resolver of the passed
+// channel. If tx is nil, the report is written in a new transaction.
+func (d *DB) PutResolverReport(tx kvdb.Tx, chainHash chainhash.Hash,
+	chanPoint *wire.OutPoint, report *ResolverReport) error {
+
+	putReport := func(tx kvdb.Tx) error {
+		reportsBucket, err := tx.CreateBucketIfNotExists(
+			resolverReportsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		chainBucket, err := reportsBucket.CreateBucketIfNotExists(
+			chainHash[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		var chanPointBuf bytes.Buffer
+		if err := writeOutpoint(&chanPointBuf, chanPoint); err != nil {
+			return err
+		}
+
+		chanBucket, err := chainBucket.CreateBucketIfNotExists(
+			chanPointBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		var keyBuf, reportBuf bytes.Buffer
+		if err := writeOutpoint(&keyBuf, &report.OutPoint); err != nil {
+			return err
+		}
+		if err := serializeResolverReport(&reportBuf, report); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(keyBuf.Bytes(), reportBuf.Bytes())
+	}
+
+	if tx == nil {
+		return d.Batch(putReport)
+	}
+
+	return putReport(tx)
+}
+
+// FetchChannelReports returns all resolver reports that were stored for the
+// passed channel. If no reports exist, an empty slice is returned.
+func (d *DB) FetchChannelReports(chainHash chainhash.Hash,
+	chanPoint *wire.OutPoint) ([]*ResolverReport, error) {
+
+	var reports []*ResolverReport
+	err := d.View(func(tx kvdb.Tx) error {
+		reportsBucket := tx.Bucket(resolverReportsBucket)
+		if reportsBucket == nil {
+			return nil
+		}
+
+		chainBucket := reportsBucket.Bucket(chainHash[:])
+		if chainBucket == nil {
+			return nil
+		}
+
+		var chanPointBuf bytes.Buffer
+		if err := writeOutpoint(&chanPointBuf, chanPoint); err != nil {
+			return err
+		}
+
+		chanBucket := chainBucket.Bucket(chanPointBuf.Bytes())
+		if chanBucket == nil {
+			return nil
+		}
+
+		return chanBucket.ForEach(func(_, v []byte) error {
+			report, err := deserializeResolverReport(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			reports = append(reports, report)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// serializeResolverReport writes the passed report to w.
+func serializeResolverReport(w io.Writer, report *ResolverReport) error {
+	var spendTxID chainhash.Hash
+	if report.SpendTxID != nil {
+		spendTxID = *report.SpendTxID
+	}
+
+	return WriteElements(
+		w, report.OutPoint, report.Amount,
+		uint16(report.ResolverType), uint16(report.ResolverOutcome),
+		report.SpendTxID != nil, spendTxID, report.WaitHeight,
+	)
+}
+
+// deserializeResolverReport reads a report from r.
+func deserializeResolverReport(r io.Reader) (*ResolverReport, error) {
+	var (
+		report                ResolverReport
+		resolverType, outcome uint16
+		hasSpendTxID          bool
+		spendTxID             chainhash.Hash
+	)
+	err := ReadElements(
+		r, &report.OutPoint, &report.Amount, &resolverType, &outcome,
+		&hasSpendTxID, &spendTxID, &report.WaitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report.ResolverType = ResolverType(resolverType)
+	report.ResolverOutcome = ResolverOutcome(outcome)
+	if hasSpendTxID {
+		report.SpendTxID = &spendTxID
+	}
+
+	return &report, nil
+}
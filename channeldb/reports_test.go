@@ -0,0 +1,86 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+// TestResolverReports tests that resolver reports can be stored and fetched
+// per channel.
+func TestResolverReports(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	chainHash := chainhash.Hash{1}
+	chanPoint := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+	otherChanPoint := wire.OutPoint{Hash: chainhash.Hash{3}}
+
+	// Before any report is stored, we expect an empty result.
+	reports, err := db.FetchChannelReports(chainHash, &chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %v", len(reports))
+	}
+
+	spendTxID := chainhash.Hash{4}
+	claimed := &ResolverReport{
+		OutPoint:        wire.OutPoint{Hash: chainhash.Hash{5}},
+		Amount:          1000,
+		ResolverType:    ResolverTypeCommit,
+		ResolverOutcome: ResolverOutcomeClaimed,
+		SpendTxID:       &spendTxID,
+		WaitHeight:      144,
+	}
+	abandoned := &ResolverReport{
+		OutPoint:        wire.OutPoint{Hash: chainhash.Hash{5}, Index: 1},
+		Amount:          2000,
+		ResolverType:    ResolverTypeIncomingHtlc,
+		ResolverOutcome: ResolverOutcomeAbandoned,
+		WaitHeight:      200,
+	}
+
+	// Write the first report in its own transaction, and the second one
+	// as part of an existing transaction.
+	err = db.PutResolverReport(nil, chainHash, &chanPoint, claimed)
+	if err != nil {
+		t.Fatalf("unable to put report: %v", err)
+	}
+	err = db.Update(func(tx kvdb.Tx) error {
+		return db.PutResolverReport(tx, chainHash, &chanPoint, abandoned)
+	})
+	if err != nil {
+		t.Fatalf("unable to put report: %v", err)
+	}
+
+	reports, err = db.FetchChannelReports(chainHash, &chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+
+	expected := []*ResolverReport{claimed, abandoned}
+	if !reflect.DeepEqual(reports, expected) {
+		t.Fatalf("expected reports %v, got %v", spew.Sdump(expected),
+			spew.Sdump(reports))
+	}
+
+	// Reports of other channels must not be affected.
+	reports, err = db.FetchChannelReports(chainHash, &otherChanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %v", len(reports))
+	}
+}
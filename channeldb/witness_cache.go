@@ -3,7 +3,7 @@ package channeldb
 import (
 	"fmt"
 
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lntypes"
 )
 
@@ -106,7 +106,7 @@ func (w *WitnessCache) addWitnessEntries(wType WitnessType,
 		return nil
 	}
 
-	return w.db.Batch(func(tx *bbolt.Tx) error {
+	return w.db.Batch(func(tx kvdb.Tx) error {
 		witnessBucket, err := tx.CreateBucketIfNotExists(witnessBucketKey)
 		if err != nil {
 			return err
@@ -150,7 +150,7 @@ func (w *WitnessCache) LookupSha256Witness(hash lntypes.Hash) (lntypes.Preimage,
 // will be returned.
 func (w *WitnessCache) lookupWitness(wType WitnessType, witnessKey []byte) ([]byte, error) {
 	var witness []byte
-	err := w.db.View(func(tx *bbolt.Tx) error {
+	err := w.db.View(func(tx kvdb.Tx) error {
 		witnessBucket := tx.Bucket(witnessBucketKey)
 		if witnessBucket == nil {
 			return ErrNoWitnesses
@@ -189,7 +189,7 @@ func (w *WitnessCache) DeleteSha256Witness(hash lntypes.Hash) error {
 
 // deleteWitness attempts to delete a particular witness from the database.
 func (w *WitnessCache) deleteWitness(wType WitnessType, witnessKey []byte) error {
-	return w.db.Batch(func(tx *bbolt.Tx) error {
+	return w.db.Batch(func(tx kvdb.Tx) error {
 		witnessBucket, err := tx.CreateBucketIfNotExists(witnessBucketKey)
 		if err != nil {
 			return err
@@ -213,7 +213,7 @@ func (w *WitnessCache) deleteWitness(wType WitnessType, witnessKey []byte) error
 // DeleteWitnessClass attempts to delete an *entire* class of witnesses. After
 // this function return with a non-nil error,
 func (w *WitnessCache) DeleteWitnessClass(wType WitnessType) error {
-	return w.db.Batch(func(tx *bbolt.Tx) error {
+	return w.db.Batch(func(tx kvdb.Tx) error {
 		witnessBucket, err := tx.CreateBucketIfNotExists(witnessBucketKey)
 		if err != nil {
 			return err
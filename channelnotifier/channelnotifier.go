@@ -27,6 +27,13 @@ type OpenChannelEvent struct {
 	Channel *channeldb.OpenChannel
 }
 
+// SplicedChannelEvent represents a new event where a splice of an open channel
+// has been locked in, changing its funding output and capacity.
+type SplicedChannelEvent struct {
+	// Channel is the channel that has been spliced.
+	Channel *channeldb.OpenChannel
+}
+
 // ActiveChannelEvent represents a new event where a channel becomes active.
 type ActiveChannelEvent struct {
 	// ChannelPoint is the channelpoint for the newly active channel.
@@ -102,6 +109,23 @@ func (c *ChannelNotifier) NotifyOpenChannelEvent(chanPoint wire.OutPoint) {
 	}
 }
 
+// NotifySplicedChannelEvent notifies the channelEventNotifier goroutine that a
+// splice of a channel has been locked in.
+func (c *ChannelNotifier) NotifySplicedChannelEvent(chanPoint wire.OutPoint) {
+	// Fetch the relevant channel from the database.
+	channel, err := c.chanDB.FetchChannel(chanPoint)
+	if err != nil {
+		log.Warnf("Unable to fetch open channel from the db: %v", err)
+		return
+	}
+
+	// Send the splice event to all channel event subscribers.
+	event := SplicedChannelEvent{Channel: channel}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send spliced channel update: %v", err)
+	}
+}
+
 // NotifyClosedChannelEvent notifies the channelEventNotifier goroutine that a
 // channel has closed.
 func (c *ChannelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
@@ -34,24 +34,10 @@ type chanDBRestorer struct {
 func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 	*channeldb.ChannelShell, error) {
 
-	// First, we'll also need to obtain the private key for the shachain
-	// root from the encoded public key.
-	//
-	// TODO(roasbeef): now adds req for hardware signers to impl
-	// shachain...
-	privKey, err := c.secretKeys.DerivePrivKey(backup.ShaChainRootDesc)
-	if err != nil {
-		return nil, nil
-	}
-	revRoot, err := chainhash.NewHash(privKey.Serialize())
-	if err != nil {
-		return nil, err
-	}
-	shaChainProducer := shachain.NewRevocationProducer(*revRoot)
-
 	// Each of the keys in our local channel config only have their
 	// locators populate, so we'll re-derive the raw key now as we'll need
 	// it in order to carry out the DLP protocol.
+	var err error
 	backup.LocalChanCfg.MultiSigKey, err = c.secretKeys.DeriveKey(
 		backup.LocalChanCfg.MultiSigKey.KeyLocator,
 	)
@@ -83,8 +69,49 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 		return nil, fmt.Errorf("unable to derive htlc key: %v", err)
 	}
 
+	// With our multi-sig key derived, we can obtain the shachain root. For
+	// the default backup version, the root is a private key of our
+	// keychain. Otherwise, it was derived through ECDH between the key of
+	// the locator and our multi-sig key.
+	var (
+		revRoot   []byte
+		revKeyLoc keychain.KeyLocator
+	)
+	switch backup.Version {
+	case chanbackup.DefaultSingleVersion:
+		privKey, err := c.secretKeys.DerivePrivKey(
+			backup.ShaChainRootDesc,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive shachain "+
+				"root: %v", err)
+		}
+		revRoot = privKey.Serialize()
+
+	case chanbackup.ECDHRevocationRootVersion:
+		revKeyLoc = backup.ShaChainRootDesc.KeyLocator
+		revRoot, err = c.secretKeys.ScalarMult(
+			backup.ShaChainRootDesc,
+			backup.LocalChanCfg.MultiSigKey.PubKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive shachain "+
+				"root: %v", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown backup version: %v",
+			backup.Version)
+	}
+	revRootHash, err := chainhash.NewHash(revRoot)
+	if err != nil {
+		return nil, err
+	}
+	shaChainProducer := shachain.NewRevocationProducer(*revRootHash)
+
 	chanShell := channeldb.ChannelShell{
-		NodeAddrs: backup.Addresses,
+		NodeAddrs:       backup.Addresses,
+		SplicedOutpoint: backup.SplicedOutpoint,
 		Chan: &channeldb.OpenChannel{
 			ChainHash:               backup.ChainHash,
 			IsInitiator:             backup.IsInitiator,
@@ -98,6 +125,7 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 			RemoteCurrentRevocation: backup.RemoteNodePub,
 			RevocationStore:         shachain.NewRevocationStore(),
 			RevocationProducer:      shaChainProducer,
+			RevocationKeyLocator:    revKeyLoc,
 		},
 	}
 
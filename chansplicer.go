@@ -0,0 +1,886 @@
+package lnd
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/lnwallet/btcwallet"
+	"github.com/wakiyamap/lnd/lnwire"
+)
+
+var (
+	// ErrChanAlreadySplicing is returned when a splice of a channel is
+	// attempted while another splice negotiation is still active.
+	ErrChanAlreadySplicing = fmt.Errorf("channel splice already initiated")
+
+	// ErrSpliceStillValid is returned when attempting to abandon a pending
+	// splice whose transaction may still confirm.
+	ErrSpliceStillValid = fmt.Errorf("splice transaction may still " +
+		"confirm")
+
+	// ErrSplicePublicChannel is returned when attempting to splice an
+	// announced channel. As the rest of the network identifies announced
+	// channels by their funding output, they'd consider the channel
+	// closed once its funding output is spent by the splice transaction.
+	ErrSplicePublicChannel = fmt.Errorf("only private channels can be " +
+		"spliced")
+
+	// maxSpliceFeeRate is the highest fee rate we'll accept for a splice
+	// transaction, whether proposed by us or by the remote party.
+	maxSpliceFeeRate = lnwallet.SatPerKVByte(100 * 1000).FeePerKWeight()
+)
+
+// spliceState represents all the possible states the channel splicer state
+// machine can be in. Each message will either advance to the next state, or
+// fail the negotiation. Once the state machine reaches the spliceSigned
+// state, the splice transaction has been signed by both parties and the
+// negotiation is over. Likewise, once it reaches the spliceAbandoned state, a
+// pending splice has been abandoned by both parties.
+type spliceState uint8
+
+const (
+	// spliceIdle is the initial starting state. If a state machine
+	// receives a message while in this state, then it is the responder to
+	// a splice initiated by the remote party.
+	spliceIdle spliceState = iota
+
+	// spliceStfuSent is the state the initiator of a splice transitions to
+	// once it has sent Stfu, while awaiting the Stfu of the remote party.
+	spliceStfuSent
+
+	// spliceAwaitingInit is the state the responder of a splice
+	// transitions to once it has replied to the initiator's Stfu, while
+	// awaiting the proposed splice transaction.
+	spliceAwaitingInit
+
+	// spliceInitSent is the state the initiator of a splice transitions to
+	// once it has proposed the splice transaction, while awaiting the
+	// responder's signature for its new commitment.
+	spliceInitSent
+
+	// spliceAckSent is the state the responder of a splice transitions to
+	// once it has signed the initiator's new commitment, while awaiting
+	// the initiator's signatures.
+	spliceAckSent
+
+	// spliceSigned is the final state of the state machine. Both parties
+	// have signed the new commitments and the splice transaction, and the
+	// splice has been persisted as pending until its transaction
+	// confirms.
+	spliceSigned
+
+	// spliceAbandonSent is the state the initiator of a splice
+	// abandonment transitions to once it has sent SpliceAbandon, while
+	// awaiting the responder's SpliceAbandon.
+	spliceAbandonSent
+
+	// spliceAbandoned is the final state of the state machine when
+	// abandoning a pending splice, which has been discarded by both
+	// parties.
+	spliceAbandoned
+)
+
+// spliceRequest is a local request to splice funds into or out of a channel.
+type spliceRequest struct {
+	// chanPoint is the channel point of the channel to splice.
+	chanPoint wire.OutPoint
+
+	// amt is the amount to splice into the channel, or out of the channel
+	// if deliveryScript is set.
+	amt btcutil.Amount
+
+	// deliveryScript is the script the spliced out funds are paid to. It
+	// is only set for splice outs.
+	deliveryScript []byte
+
+	// feeRate is the fee rate to use for the splice transaction.
+	feeRate lnwallet.SatPerKWeight
+
+	// abandonTxid is the splice transaction of the pending splice to
+	// abandon. It is only set for requests to abandon a splice, which
+	// leave all other fields unset.
+	abandonTxid *chainhash.Hash
+
+	// txid receives the txid of the splice transaction once it has been
+	// signed by both parties, or abandoned by both parties.
+	txid chan *chainhash.Hash
+
+	// err receives any error encountered during the splice negotiation.
+	err chan error
+}
+
+// chanSpliceCfg holds all the items that a channelSplicer requires to carry
+// out its duties.
+type chanSpliceCfg struct {
+	// channel is the channel that should be spliced.
+	channel *lnwallet.LightningChannel
+
+	// unregisterChannel is a function closure that allows the
+	// channelSplicer to un-register a channel. Once this has been done,
+	// no further HTLC's should be routed through the channel until the
+	// splice has completed.
+	unregisterChannel func(lnwire.ChannelID)
+
+	// fundSplice performs coin selection for a splice in of the passed
+	// amount at the given fee rate.
+	fundSplice func(btcutil.Amount,
+		lnwallet.SatPerKWeight) (*lnwallet.ChannelContribution, error)
+
+	// releaseInputs releases the wallet inputs selected by fundSplice.
+	releaseInputs func([]*wire.TxIn)
+
+	// signInputs signs the wallet inputs of the splice transaction,
+	// returning their witnesses.
+	signInputs func(*wire.MsgTx) ([]wire.TxWitness, error)
+
+	// broadcastTx broadcasts the passed transaction to the network.
+	broadcastTx func(*wire.MsgTx) error
+
+	// fetchTx fetches the wallet transaction with the passed txid.
+	fetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+
+	// chainIO is used to determine whether a pending splice to be
+	// abandoned can still confirm.
+	chainIO lnwallet.BlockChainIO
+}
+
+// channelSplicer is a state machine that handles the negotiation of a splice
+// of a channel. This includes bringing the channel into a quiescent state,
+// agreeing on the splice transaction, signing the new commitments spending
+// the funding output it creates, and finally signing and broadcasting the
+// splice transaction itself.
+type channelSplicer struct {
+	// state is the current state of the state machine.
+	state spliceState
+
+	// cfg holds the configuration for this channelSplicer instance.
+	cfg chanSpliceCfg
+
+	// chanPoint is the full channel point of the target channel.
+	chanPoint wire.OutPoint
+
+	// cid is the full channel ID of the target channel.
+	cid lnwire.ChannelID
+
+	// spliceReq is the initial splice request. This will only be
+	// populated if we're the initiator of the splice.
+	spliceReq *spliceRequest
+
+	// walletInputs are the wallet inputs we contributed to the splice
+	// transaction as the initiator of a splice in.
+	walletInputs []*wire.TxIn
+
+	// splice is the pending splice being negotiated. It is populated once
+	// the splice transaction is known.
+	splice *channeldb.PendingSplice
+
+	// persisted is true once the splice has been persisted, after which
+	// it may confirm at any point.
+	persisted bool
+
+	// done is closed once the negotiation has either completed or failed.
+	done chan struct{}
+}
+
+// newChannelSplicer creates a new instance of the channel splicer given the
+// passed configuration. The final argument should only be populated iff,
+// we're the initiator of the splice.
+func newChannelSplicer(cfg chanSpliceCfg,
+	spliceReq *spliceRequest) *channelSplicer {
+
+	chanPoint := *cfg.channel.ChannelPoint()
+	return &channelSplicer{
+		state:     spliceIdle,
+		cfg:       cfg,
+		chanPoint: chanPoint,
+		cid:       lnwire.NewChanIDFromOutPoint(&chanPoint),
+		spliceReq: spliceReq,
+		done:      make(chan struct{}),
+	}
+}
+
+// SpliceRequest returns the original splice request that prompted the
+// creation of the state machine.
+//
+// NOTE: This will only return a non-nil pointer if we were the initiator of
+// the splice.
+func (s *channelSplicer) SpliceRequest() *spliceRequest {
+	return s.spliceReq
+}
+
+// PendingSplice returns the pending splice being negotiated, which is
+// populated once the splice transaction is known.
+func (s *channelSplicer) PendingSplice() *channeldb.PendingSplice {
+	return s.splice
+}
+
+// Persisted returns true if the splice has been persisted as pending. From
+// then on, the splice transaction may confirm at any point.
+func (s *channelSplicer) Persisted() bool {
+	return s.persisted
+}
+
+// Abandoned returns true if the negotiation abandoned a pending splice, rather
+// than creating a new one.
+func (s *channelSplicer) Abandoned() bool {
+	return s.state == spliceAbandoned
+}
+
+// Done returns a channel that's closed once the negotiation has either
+// completed or failed.
+func (s *channelSplicer) Done() <-chan struct{} {
+	return s.done
+}
+
+// Finish marks the negotiation as completed or failed, signaling any callers
+// waiting on Done.
+func (s *channelSplicer) Finish() {
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+}
+
+// quiesce un-registers the channel from the switch, and ensures the channel
+// has no HTLCs or unsettled updates that would prevent it from being spliced.
+func (s *channelSplicer) quiesce() error {
+	s.cfg.unregisterChannel(s.cid)
+
+	if !s.cfg.channel.IsQuiescent() {
+		return lnwallet.ErrSpliceNotQuiescent
+	}
+
+	return nil
+}
+
+// InitSplice is the first method that's to be called by the initiator of the
+// splice. This message returns the Stfu message to send to the remote party.
+// Upon completion, we enter the spliceStfuSent phase as we await a response.
+func (s *channelSplicer) InitSplice() (*lnwire.Stfu, error) {
+	if s.state != spliceIdle {
+		return nil, ErrChanAlreadySplicing
+	}
+
+	peerLog.Infof("ChannelPoint(%v): initiating splice", s.chanPoint)
+
+	if err := s.quiesce(); err != nil {
+		return nil, err
+	}
+
+	s.state = spliceStfuSent
+
+	return lnwire.NewStfu(s.cid, true), nil
+}
+
+// Abort releases any wallet inputs we contributed to the splice transaction.
+// It should be called if the negotiation fails before the splice has been
+// persisted.
+func (s *channelSplicer) Abort() {
+	if len(s.walletInputs) != 0 {
+		s.cfg.releaseInputs(s.walletInputs)
+		s.walletInputs = nil
+	}
+}
+
+// ProcessSpliceMsg attempts to process the next message in the splice
+// negotiation. This method will update the state accordingly and return two
+// primary values: the next set of messages to be sent, and a bool indicating
+// if the negotiation has completed. If the second value is true, then the
+// splice has been persisted and the channelSplicer can be garbage collected.
+func (s *channelSplicer) ProcessSpliceMsg(
+	msg lnwire.Message) ([]lnwire.Message, bool, error) {
+
+	switch s.state {
+
+	// If we're idle and receive a Stfu, the remote party is initiating a
+	// splice. We'll quiesce the channel ourselves and reply with our own
+	// Stfu.
+	case spliceIdle:
+		stfu, ok := msg.(*lnwire.Stfu)
+		if !ok || !stfu.Initiator {
+			return nil, false, ErrInvalidState
+		}
+
+		peerLog.Infof("ChannelPoint(%v): responding to splice",
+			s.chanPoint)
+
+		if err := s.quiesce(); err != nil {
+			return nil, false, err
+		}
+
+		s.state = spliceAwaitingInit
+
+		return []lnwire.Message{lnwire.NewStfu(s.cid, false)}, false, nil
+
+	// As the initiator, the remote party's Stfu signals that the channel
+	// is quiescent, so we can now propose the splice transaction.
+	case spliceStfuSent:
+		stfu, ok := msg.(*lnwire.Stfu)
+		if !ok || stfu.Initiator {
+			return nil, false, ErrInvalidState
+		}
+
+		// If we're abandoning a pending splice, we'll ensure it can't
+		// confirm anymore before asking the remote party to abandon it
+		// as well.
+		if abandonTxid := s.spliceReq.abandonTxid; abandonTxid != nil {
+			splice, err := s.validateSpliceAbandon(*abandonTxid)
+			if err != nil {
+				return nil, false, err
+			}
+			s.splice = splice
+			s.state = spliceAbandonSent
+
+			return []lnwire.Message{lnwire.NewSpliceAbandon(
+				s.cid, *abandonTxid,
+			)}, false, nil
+		}
+
+		spliceInit, err := s.initSpliceTx()
+		if err != nil {
+			return nil, false, err
+		}
+
+		s.state = spliceInitSent
+
+		return []lnwire.Message{spliceInit}, false, nil
+
+	// As the responder, we'll validate the proposed splice transaction
+	// and sign the initiator's new commitment. Alternatively, the
+	// initiator may ask us to abandon a pending splice, which we'll do
+	// once we've ensured it can't confirm anymore.
+	case spliceAwaitingInit:
+		if abandon, ok := msg.(*lnwire.SpliceAbandon); ok {
+			splice, err := s.validateSpliceAbandon(
+				abandon.SpliceTxid,
+			)
+			if err != nil {
+				return nil, false, err
+			}
+			s.splice = splice
+			if err := s.abandonSplice(); err != nil {
+				return nil, false, err
+			}
+
+			return []lnwire.Message{lnwire.NewSpliceAbandon(
+				s.cid, abandon.SpliceTxid,
+			)}, true, nil
+		}
+
+		spliceInit, ok := msg.(*lnwire.SpliceInit)
+		if !ok {
+			return nil, false, ErrInvalidState
+		}
+
+		peerLog.Debugf("ChannelPoint(%v): received splice tx: %v",
+			s.chanPoint, newLogClosure(func() string {
+				return spew.Sdump(spliceInit.SpliceTx)
+			}))
+
+		prevOutputs, err := s.validateSpliceInit(spliceInit)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// The responder never contributes to the splice, so only the
+		// remote party's balance may change.
+		splice, err := s.cfg.channel.NewSplice(
+			spliceInit.SpliceTx, 0, spliceInit.FundingContribution,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		splice.FeePerKw = btcutil.Amount(spliceInit.FundingFeePerKw)
+		splice.PrevOutputs = prevOutputs
+		commitSig, err := s.cfg.channel.SignSpliceCommitment(splice)
+		if err != nil {
+			return nil, false, err
+		}
+
+		s.splice = splice
+		s.state = spliceAckSent
+
+		return []lnwire.Message{&lnwire.SpliceAck{
+			ChannelID: s.cid,
+			CommitSig: commitSig,
+		}}, false, nil
+
+	// As the initiator, the responder's signature for our new commitment
+	// allows us to safely sign our new commitment for them, and the
+	// splice transaction itself.
+	case spliceInitSent:
+		spliceAck, ok := msg.(*lnwire.SpliceAck)
+		if !ok {
+			return nil, false, ErrInvalidState
+		}
+
+		channel := s.cfg.channel
+		err := channel.ReceiveSpliceCommitment(
+			s.splice, spliceAck.CommitSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		commitSig, err := channel.SignSpliceCommitment(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+
+		spliceTx := s.splice.SpliceTx
+		sharedInputSig, err := channel.SignSpliceInput(spliceTx)
+		if err != nil {
+			return nil, false, err
+		}
+		witnesses, err := s.cfg.signInputs(spliceTx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// Before handing out our signatures for the splice
+		// transaction, we'll persist the splice, as the remote party
+		// is able to broadcast it from then on.
+		err = channel.AddPendingSplice(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+		s.persisted = true
+		s.state = spliceSigned
+
+		return []lnwire.Message{&lnwire.SpliceSigned{
+			ChannelID:      s.cid,
+			CommitSig:      commitSig,
+			SharedInputSig: sharedInputSig,
+			Witnesses:      witnesses,
+		}}, true, nil
+
+	// As the responder, we'll verify the initiator's signature for our
+	// new commitment, persist the splice, and broadcast the fully signed
+	// splice transaction.
+	case spliceAckSent:
+		signedMsg, ok := msg.(*lnwire.SpliceSigned)
+		if !ok {
+			return nil, false, ErrInvalidState
+		}
+
+		channel := s.cfg.channel
+		err := channel.ReceiveSpliceCommitment(
+			s.splice, signedMsg.CommitSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// All inputs but the one spending the funding output belong
+		// to the initiator, so we'll expect a witness for each of
+		// them.
+		spliceTx := s.splice.SpliceTx.Copy()
+		fundingOutpoint := channel.State().CurrentFundingOutpoint()
+		if len(signedMsg.Witnesses) != len(spliceTx.TxIn)-1 {
+			return nil, false, fmt.Errorf("expected %v witnesses, "+
+				"got %v", len(spliceTx.TxIn)-1,
+				len(signedMsg.Witnesses))
+		}
+		witnesses := signedMsg.Witnesses
+		for _, txIn := range spliceTx.TxIn {
+			if txIn.PreviousOutPoint == fundingOutpoint {
+				continue
+			}
+			txIn.Witness = witnesses[0]
+			witnesses = witnesses[1:]
+		}
+
+		sharedInputSig, err := channel.SignSpliceInput(spliceTx)
+		if err != nil {
+			return nil, false, err
+		}
+		err = channel.CompleteSpliceInput(
+			spliceTx, sharedInputSig, signedMsg.SharedInputSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		err = channel.AddPendingSplice(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+		s.persisted = true
+		s.state = spliceSigned
+
+		peerLog.Infof("ChannelPoint(%v): broadcasting splice tx %v",
+			s.chanPoint, spliceTx.TxHash())
+
+		// The splice is persisted, so a failure to broadcast isn't
+		// fatal. The initiator may still broadcast it, and otherwise
+		// the channel can be force closed using the prior state.
+		if err := s.cfg.broadcastTx(spliceTx); err != nil {
+			peerLog.Errorf("Unable to broadcast splice tx: %v",
+				err)
+		}
+
+		return nil, true, nil
+
+	// As the initiator of a splice abandonment, the responder's
+	// SpliceAbandon signals that it has abandoned the splice, so we'll do
+	// the same.
+	case spliceAbandonSent:
+		abandon, ok := msg.(*lnwire.SpliceAbandon)
+		if !ok {
+			return nil, false, ErrInvalidState
+		}
+
+		spliceTxid := s.splice.SpliceTx.TxHash()
+		if abandon.SpliceTxid != spliceTxid {
+			return nil, false, fmt.Errorf("remote party abandoned "+
+				"splice %v, expected %v", abandon.SpliceTxid,
+				spliceTxid)
+		}
+
+		if err := s.abandonSplice(); err != nil {
+			return nil, false, err
+		}
+
+		return nil, true, nil
+
+	default:
+		return nil, false, ErrInvalidState
+	}
+}
+
+// abandonSplice discards the pending splice to be abandoned, after which the
+// final state is reached. Its inputs are released, as any of them that belong
+// to our wallet may be spent again.
+func (s *channelSplicer) abandonSplice() error {
+	spliceTx := s.splice.SpliceTx
+	if err := s.cfg.channel.AbandonSplice(spliceTx.TxHash()); err != nil {
+		return err
+	}
+	s.cfg.releaseInputs(spliceTx.TxIn)
+	s.state = spliceAbandoned
+
+	return nil
+}
+
+// validateSpliceAbandon ensures the pending splice with the passed splice
+// transaction can no longer confirm, as the current funding output of the
+// channel is still unspent, while one of the other inputs of the splice
+// transaction has been spent by another transaction.
+func (s *channelSplicer) validateSpliceAbandon(
+	spliceTxid chainhash.Hash) (*channeldb.PendingSplice, error) {
+
+	state := s.cfg.channel.State()
+
+	var splice *channeldb.PendingSplice
+	for _, pendingSplice := range state.PendingSplices() {
+		if pendingSplice.SpliceTx.TxHash() == spliceTxid {
+			splice = pendingSplice
+			break
+		}
+	}
+	if splice == nil {
+		return nil, channeldb.ErrSpliceNotFound
+	}
+
+	heightHint := state.ShortChanID().BlockHeight
+	if heightHint == 0 {
+		heightHint = state.FundingBroadcastHeight
+	}
+
+	fundingOutpoint := state.CurrentFundingOutpoint()
+	_, err := s.cfg.chainIO.GetUtxo(
+		&fundingOutpoint, s.cfg.channel.FundingPkScript(), heightHint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find funding output %v: %v",
+			fundingOutpoint, err)
+	}
+
+	prevOutputs := splice.PrevOutputs
+	for _, txIn := range splice.SpliceTx.TxIn {
+		prevOutPoint := txIn.PreviousOutPoint
+		if prevOutPoint == fundingOutpoint || len(prevOutputs) == 0 {
+			continue
+		}
+
+		prevOut := prevOutputs[0]
+		prevOutputs = prevOutputs[1:]
+
+		_, err := s.cfg.chainIO.GetUtxo(
+			&prevOutPoint, prevOut.PkScript, heightHint,
+		)
+		if err == btcwallet.ErrOutputSpent {
+			return splice, nil
+		}
+	}
+
+	return nil, ErrSpliceStillValid
+}
+
+// initSpliceTx creates the splice transaction requested by the local splice
+// request, along with our new commitments spending its funding output.
+func (s *channelSplicer) initSpliceTx() (*lnwire.SpliceInit, error) {
+	var (
+		req          = s.spliceReq
+		channel      = s.cfg.channel
+		capacity     = channel.State().Capacity
+		contribution btcutil.Amount
+	)
+
+	if err := checkSpliceFeeRate(req.feeRate); err != nil {
+		return nil, err
+	}
+	err := checkSpliceReplacement(channel.State(), req.feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	fundingOutpoint := channel.State().CurrentFundingOutpoint()
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&fundingOutpoint, nil, nil))
+
+	switch {
+	// For a splice out, the funds and the fee for the splice transaction
+	// are both paid from our channel balance.
+	case len(req.deliveryScript) != 0:
+		var weightEstimate input.TxWeightEstimator
+		weightEstimate.AddWitnessInput(input.WitnessSize)
+		weightEstimate.AddP2WSHOutput()
+		weightEstimate.AddP2WSHOutput()
+		fee := req.feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+
+		contribution = -(req.amt + fee)
+		spliceTx.AddTxOut(wire.NewTxOut(
+			int64(req.amt), req.deliveryScript,
+		))
+
+	// For a splice in, the funds and the fee for the splice transaction
+	// are both paid from our wallet.
+	default:
+		walletContribution, err := s.cfg.fundSplice(req.amt, req.feeRate)
+		if err != nil {
+			return nil, err
+		}
+		s.walletInputs = walletContribution.Inputs
+
+		contribution = req.amt
+		for _, txIn := range walletContribution.Inputs {
+			spliceTx.AddTxIn(txIn)
+		}
+		for _, txOut := range walletContribution.ChangeOutputs {
+			spliceTx.AddTxOut(txOut)
+		}
+	}
+
+	spliceTx.AddTxOut(wire.NewTxOut(
+		int64(capacity+contribution), channel.FundingPkScript(),
+	))
+	txsort.InPlaceSort(spliceTx)
+
+	// The remote party can only verify the value and script of the
+	// outputs spent by our wallet inputs given the whole transactions
+	// containing them.
+	var (
+		prevTxs     []*wire.MsgTx
+		prevOutputs []*wire.TxOut
+	)
+	for _, txIn := range spliceTx.TxIn {
+		prevOutPoint := txIn.PreviousOutPoint
+		if prevOutPoint == fundingOutpoint {
+			continue
+		}
+
+		prevTx, err := s.cfg.fetchTx(prevOutPoint.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if int(prevOutPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("splice input %v spends an "+
+				"unknown output", prevOutPoint)
+		}
+
+		// As SpliceSigned only carries witnesses, we're only able to
+		// contribute inputs that spend native witness programs.
+		prevOut := prevTx.TxOut[prevOutPoint.Index]
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return nil, fmt.Errorf("splice input %v doesn't spend "+
+				"a native witness program", prevOutPoint)
+		}
+
+		prevTxs = append(prevTxs, prevTx)
+		prevOutputs = append(prevOutputs, prevOut)
+	}
+
+	splice, err := channel.NewSplice(spliceTx, contribution, 0)
+	if err != nil {
+		return nil, err
+	}
+	splice.FeePerKw = btcutil.Amount(req.feeRate)
+	splice.PrevOutputs = prevOutputs
+	s.splice = splice
+
+	peerLog.Debugf("ChannelPoint(%v): proposing splice tx: %v",
+		s.chanPoint, newLogClosure(func() string {
+			return spew.Sdump(spliceTx)
+		}))
+
+	return &lnwire.SpliceInit{
+		ChannelID:           s.cid,
+		FundingContribution: contribution,
+		FundingFeePerKw:     uint32(req.feeRate),
+		SpliceTx:            spliceTx,
+		PrevTxs:             prevTxs,
+	}, nil
+}
+
+// validateSpliceInit ensures the splice transaction proposed by the remote
+// party only spends native witness programs besides the funding output, as we
+// would otherwise be unable to complete it with the witnesses carried by
+// SpliceSigned, and that it pays a sane fee rate, high enough to replace any
+// pending splices. The outputs spent by the inputs other than the funding
+// output are returned in order.
+func (s *channelSplicer) validateSpliceInit(
+	spliceInit *lnwire.SpliceInit) ([]*wire.TxOut, error) {
+
+	var (
+		spliceTx        = spliceInit.SpliceTx
+		state           = s.cfg.channel.State()
+		fundingOutpoint = state.CurrentFundingOutpoint()
+		weightEstimate  input.TxWeightEstimator
+	)
+
+	feeRate := lnwallet.SatPerKWeight(spliceInit.FundingFeePerKw)
+
+	if err := checkSpliceFeeRate(feeRate); err != nil {
+		return nil, err
+	}
+	if err := checkSpliceReplacement(state, feeRate); err != nil {
+		return nil, err
+	}
+
+	if len(spliceInit.PrevTxs) != len(spliceTx.TxIn)-1 {
+		return nil, fmt.Errorf("expected %v previous transactions, "+
+			"got %v", len(spliceTx.TxIn)-1,
+			len(spliceInit.PrevTxs))
+	}
+
+	// We'll tally the value of all inputs spent, starting with the
+	// funding output, so we're able to determine the fee paid.
+	totalIn := state.Capacity
+	prevOutPoints := make(map[wire.OutPoint]struct{})
+	prevOutputs := make([]*wire.TxOut, 0, len(spliceInit.PrevTxs))
+	prevTxs := spliceInit.PrevTxs
+	for _, txIn := range spliceTx.TxIn {
+		prevOutPoint := txIn.PreviousOutPoint
+		if prevOutPoint == fundingOutpoint {
+			weightEstimate.AddWitnessInput(input.WitnessSize)
+			continue
+		}
+		if len(prevTxs) == 0 {
+			return nil, fmt.Errorf("splice tx doesn't spend the " +
+				"funding output")
+		}
+
+		prevTx := prevTxs[0]
+		prevTxs = prevTxs[1:]
+		if prevTx.TxHash() != prevOutPoint.Hash {
+			return nil, fmt.Errorf("previous transaction %v "+
+				"doesn't match splice input %v",
+				prevTx.TxHash(), prevOutPoint)
+		}
+		if int(prevOutPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("splice input %v spends an "+
+				"unknown output", prevOutPoint)
+		}
+		if _, ok := prevOutPoints[prevOutPoint]; ok {
+			return nil, fmt.Errorf("duplicate splice input %v",
+				prevOutPoint)
+		}
+		prevOutPoints[prevOutPoint] = struct{}{}
+
+		prevOut := prevTx.TxOut[prevOutPoint.Index]
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return nil, fmt.Errorf("splice input %v doesn't "+
+				"spend a native witness program", prevOutPoint)
+		}
+		totalIn += btcutil.Amount(prevOut.Value)
+		prevOutputs = append(prevOutputs, prevOut)
+
+		// The witness of the input is unknown until the initiator
+		// signs it, so we'll assume the smallest one possible for
+		// the witness program it spends.
+		if txscript.IsPayToWitnessPubKeyHash(prevOut.PkScript) {
+			weightEstimate.AddP2WKHInput()
+		} else {
+			weightEstimate.AddWitnessInput(0)
+		}
+	}
+
+	var totalOut btcutil.Amount
+	for _, txOut := range spliceTx.TxOut {
+		totalOut += btcutil.Amount(txOut.Value)
+		weightEstimate.AddTxOutput(txOut)
+	}
+	if totalOut > totalIn {
+		return nil, fmt.Errorf("splice tx spends %v, but its inputs "+
+			"are only worth %v", totalOut, totalIn)
+	}
+
+	// Finally, we'll ensure the fee rate actually paid by the splice
+	// transaction is sane as well. As our weight estimate is a lower
+	// bound, the fee rate paid may be slightly overestimated.
+	fee := totalIn - totalOut
+	paidFeeRate := lnwallet.SatPerKWeight(
+		fee * 1000 / btcutil.Amount(weightEstimate.Weight()),
+	)
+
+	if err := checkSpliceFeeRate(paidFeeRate); err != nil {
+		return nil, err
+	}
+
+	return prevOutputs, nil
+}
+
+// checkSpliceFeeRate ensures the passed fee rate of a splice transaction is
+// neither below the relay fee floor nor above maxSpliceFeeRate.
+func checkSpliceFeeRate(feeRate lnwallet.SatPerKWeight) error {
+	switch {
+	case feeRate < lnwallet.FeePerKwFloor:
+		return fmt.Errorf("splice fee rate %v is below the minimum "+
+			"of %v", feeRate, lnwallet.FeePerKwFloor)
+
+	case feeRate > maxSpliceFeeRate:
+		return fmt.Errorf("splice fee rate %v is above the maximum "+
+			"of %v", feeRate, maxSpliceFeeRate)
+	}
+
+	return nil
+}
+
+// checkSpliceReplacement ensures the passed fee rate of a splice transaction
+// exceeds the fee rates of all pending splices of the channel by at least the
+// relay fee floor, as the new splice transaction replaces them.
+func checkSpliceReplacement(state *channeldb.OpenChannel,
+	feeRate lnwallet.SatPerKWeight) error {
+
+	for _, splice := range state.PendingSplices() {
+		minFeeRate := lnwallet.SatPerKWeight(splice.FeePerKw) +
+			lnwallet.FeePerKwFloor
+		if feeRate < minFeeRate {
+			return fmt.Errorf("splice fee rate %v is too low to "+
+				"replace pending splice %v, expected at "+
+				"least %v", feeRate, splice.SpliceTx.TxHash(),
+				minFeeRate)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// +build kvdb_etcd
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+const (
+	// etcdResignTimeout is the maximum amount of time we'll wait to
+	// resign leadership.
+	etcdResignTimeout = 10 * time.Second
+)
+
+// etcdLeaderElector is an implementation of the LeaderElector interface that
+// elects a leader using an etcd election. Each elector holds a lease that is
+// kept alive for as long as the elector's connection to the cluster remains
+// healthy. Once the lease expires, the leader's key is deleted, allowing the
+// next candidate to take over.
+type etcdLeaderElector struct {
+	id       string
+	cli      *clientv3.Client
+	session  *concurrency.Session
+	election *concurrency.Election
+}
+
+// A compile-time check to ensure etcdLeaderElector implements the
+// LeaderElector interface.
+var _ LeaderElector = (*etcdLeaderElector)(nil)
+
+// NewEtcdLeaderElector creates a new leader elector that campaigns under the
+// passed ID within the election identified by the passed prefix. The lease of
+// the elector expires after ttl seconds without contact to the cluster.
+func NewEtcdLeaderElector(ctx context.Context, id, electionPrefix string,
+	ttl int, cfg *kvdb.EtcdConfig) (LeaderElector, error) {
+
+	cli, err := kvdb.NewEtcdClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := concurrency.NewSession(
+		cli, concurrency.WithTTL(ttl), concurrency.WithContext(ctx),
+	)
+	if err != nil {
+		cli.Close()
+		return nil, err
+	}
+
+	return &etcdLeaderElector{
+		id:       id,
+		cli:      cli,
+		session:  session,
+		election: concurrency.NewElection(session, electionPrefix),
+	}, nil
+}
+
+// Campaign blocks until this instance is elected leader, or the passed
+// context is canceled.
+//
+// NOTE: This is part of the LeaderElector interface.
+func (e *etcdLeaderElector) Campaign(ctx context.Context) error {
+	log.Infof("Campaigning for leadership as %v", e.id)
+
+	if err := e.election.Campaign(ctx, e.id); err != nil {
+		return err
+	}
+
+	log.Infof("Elected leader as %v", e.id)
+
+	return nil
+}
+
+// Resign gives up leadership, allowing a standby instance to take over.
+//
+// NOTE: This is part of the LeaderElector interface.
+func (e *etcdLeaderElector) Resign() error {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), etcdResignTimeout,
+	)
+	defer cancel()
+
+	return e.election.Resign(ctx)
+}
+
+// Leader returns the ID of the current leader.
+//
+// NOTE: This is part of the LeaderElector interface.
+func (e *etcdLeaderElector) Leader(ctx context.Context) (string, error) {
+	resp, err := e.election.Leader(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return string(resp.Kvs[0].Value), nil
+}
+
+// Done returns a channel that is closed once this instance's lease expires.
+//
+// NOTE: This is part of the LeaderElector interface.
+func (e *etcdLeaderElector) Done() <-chan struct{} {
+	return e.session.Done()
+}
+
+// Fence returns the fence identifying this instance's current term as leader.
+// The term lasts for as long as the election key written by our campaign
+// exists, which is deleted once we resign, or our lease expires.
+//
+// NOTE: This is part of the LeaderElector interface.
+func (e *etcdLeaderElector) Fence() *kvdb.LeaderFence {
+	return &kvdb.LeaderFence{
+		Key: e.election.Key(),
+		Rev: e.election.Rev(),
+	}
+}
+
+// Close resigns if this instance is the leader, revokes its lease and closes
+// the connection to the cluster.
+//
+// NOTE: This is part of the LeaderElector interface.
+func (e *etcdLeaderElector) Close() error {
+	if err := e.Resign(); err != nil {
+		log.Errorf("Unable to resign leadership: %v", err)
+	}
+
+	if err := e.session.Close(); err != nil {
+		log.Errorf("Unable to close election session: %v", err)
+	}
+
+	return e.cli.Close()
+}
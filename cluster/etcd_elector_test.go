@@ -0,0 +1,233 @@
+// +build kvdb_etcd
+
+package cluster
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+const (
+	testElectionPrefix = "/test/leader/"
+	testLeaseTTL       = 1
+	testTimeout        = 10 * time.Second
+)
+
+// startEtcd starts an embedded etcd server to be used as the lock service.
+func startEtcd(t *testing.T) (*kvdb.EtcdConfig, func()) {
+	tempDir, err := ioutil.TempDir("", "etcd")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	cfg, stopEtcd, err := kvdb.NewEmbeddedEtcdInstance(tempDir)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to start etcd: %v", err)
+	}
+
+	return cfg, func() {
+		stopEtcd()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// campaignAsync campaigns for leadership in the background, returning a
+// channel that the result of the campaign is sent over.
+func campaignAsync(ctx context.Context, elector LeaderElector) chan error {
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- elector.Campaign(ctx)
+	}()
+
+	return errChan
+}
+
+// assertLeader asserts that the leader of the election is the expected ID.
+func assertLeader(t *testing.T, elector LeaderElector, expected string) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	leader, err := elector.Leader(ctx)
+	if err != nil {
+		t.Fatalf("unable to fetch leader: %v", err)
+	}
+	if leader != expected {
+		t.Fatalf("expected leader %v, got %v", expected, leader)
+	}
+}
+
+// TestEtcdElectorFailover asserts that a standby instance only becomes the
+// leader once the active instance either resigns or its lease expires.
+func TestEtcdElectorFailover(t *testing.T) {
+	t.Parallel()
+
+	cfg, cleanUp := startEtcd(t)
+	defer cleanUp()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newElector := func(id string) LeaderElector {
+		elector, err := NewEtcdLeaderElector(
+			ctx, id, testElectionPrefix, testLeaseTTL, cfg,
+		)
+		if err != nil {
+			t.Fatalf("unable to create elector: %v", err)
+		}
+
+		return elector
+	}
+
+	alice := newElector("alice")
+	bob := newElector("bob")
+	carol := newElector("carol")
+	defer carol.Close()
+
+	// Alice campaigns first, so she should be elected immediately.
+	select {
+	case err := <-campaignAsync(ctx, alice):
+		if err != nil {
+			t.Fatalf("alice failed to campaign: %v", err)
+		}
+	case <-time.After(testTimeout):
+		t.Fatalf("alice wasn't elected")
+	}
+	assertLeader(t, bob, "alice")
+
+	// Bob should remain on standby for as long as Alice holds the lease.
+	bobElected := campaignAsync(ctx, bob)
+	select {
+	case err := <-bobElected:
+		t.Fatalf("bob elected while alice is leader: %v", err)
+	case <-time.After(2 * testLeaseTTL * time.Second):
+	}
+
+	// Once Alice resigns, Bob should take over.
+	if err := alice.Close(); err != nil {
+		t.Fatalf("unable to close alice's elector: %v", err)
+	}
+	select {
+	case err := <-bobElected:
+		if err != nil {
+			t.Fatalf("bob failed to campaign: %v", err)
+		}
+	case <-time.After(testTimeout):
+		t.Fatalf("bob wasn't elected after alice resigned")
+	}
+	assertLeader(t, carol, "bob")
+
+	// Finally, we'll simulate Bob's host failing by closing his
+	// connection to the cluster without resigning. Carol should take over
+	// once Bob's lease expires.
+	carolElected := campaignAsync(ctx, carol)
+	bob.(*etcdLeaderElector).cli.Close()
+
+	select {
+	case err := <-carolElected:
+		if err != nil {
+			t.Fatalf("carol failed to campaign: %v", err)
+		}
+	case <-time.After(testTimeout):
+		t.Fatalf("carol wasn't elected after bob's lease expired")
+	}
+	assertLeader(t, carol, "carol")
+}
+
+// TestEtcdElectorFence asserts that a database fenced by a leader's term
+// refuses the writes of the leader once it has lost its leadership, while the
+// new leader is able to write.
+func TestEtcdElectorFence(t *testing.T) {
+	t.Parallel()
+
+	cfg, cleanUp := startEtcd(t)
+	defer cleanUp()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// elect creates a new elector that campaigns until elected, and opens
+	// a database fenced by its term.
+	elect := func(id string) (LeaderElector, kvdb.Backend) {
+		elector, err := NewEtcdLeaderElector(
+			ctx, id, testElectionPrefix, testLeaseTTL, cfg,
+		)
+		if err != nil {
+			t.Fatalf("unable to create elector: %v", err)
+		}
+
+		select {
+		case err := <-campaignAsync(ctx, elector):
+			if err != nil {
+				t.Fatalf("%v failed to campaign: %v", id, err)
+			}
+		case <-time.After(testTimeout):
+			t.Fatalf("%v wasn't elected", id)
+		}
+
+		db, err := kvdb.OpenEtcd(ctx, cfg, elector.Fence())
+		if err != nil {
+			t.Fatalf("unable to open db: %v", err)
+		}
+
+		return elector, db
+	}
+
+	createBucket := func(db kvdb.Backend, name string) error {
+		return db.Update(func(tx kvdb.Tx) error {
+			_, err := tx.CreateBucket([]byte(name))
+			return err
+		})
+	}
+
+	alice, aliceDB := elect("alice")
+	defer aliceDB.Close()
+
+	if err := createBucket(aliceDB, "alice"); err != nil {
+		t.Fatalf("unable to write as leader: %v", err)
+	}
+
+	// Once Alice has lost her leadership, her writes must be refused, even
+	// before anyone else has been elected.
+	if err := alice.Close(); err != nil {
+		t.Fatalf("unable to close alice's elector: %v", err)
+	}
+	err := createBucket(aliceDB, "alice2")
+	if err != kvdb.ErrLeaderFenced {
+		t.Fatalf("expected ErrLeaderFenced, got %v", err)
+	}
+
+	bob, bobDB := elect("bob")
+	defer bob.Close()
+	defer bobDB.Close()
+
+	if err := createBucket(bobDB, "bob"); err != nil {
+		t.Fatalf("unable to write as new leader: %v", err)
+	}
+	err = createBucket(aliceDB, "alice2")
+	if err != kvdb.ErrLeaderFenced {
+		t.Fatalf("expected ErrLeaderFenced, got %v", err)
+	}
+
+	// Only the writes made during a term as leader should have been
+	// applied.
+	err = bobDB.View(func(tx kvdb.Tx) error {
+		if tx.Bucket([]byte("alice")) == nil ||
+			tx.Bucket([]byte("bob")) == nil {
+
+			t.Fatalf("leader writes not applied")
+		}
+		if tx.Bucket([]byte("alice2")) != nil {
+			t.Fatalf("fenced write applied")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read db: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+const (
+	// EtcdLeaderElector is the name of the leader elector backed by an
+	// etcd cluster.
+	EtcdLeaderElector = "etcd"
+
+	// DefaultLeaseTTL is the default number of seconds a leader's lease
+	// survives for after the leader stops refreshing it, e.g. because its
+	// host failed.
+	DefaultLeaseTTL = 60
+)
+
+// LeaderElector is implemented by any leader election mechanism that allows a
+// set of lnd instances sharing the same replicated state to elect a single
+// active instance. All other instances remain on standby until the active
+// instance fails or steps down.
+type LeaderElector interface {
+	// Campaign blocks until this instance is elected leader, or the
+	// passed context is canceled.
+	Campaign(ctx context.Context) error
+
+	// Resign gives up leadership, allowing a standby instance to take
+	// over.
+	Resign() error
+
+	// Leader returns the ID of the current leader.
+	Leader(ctx context.Context) (string, error)
+
+	// Done returns a channel that is closed once this instance's lease
+	// expires, after which it must no longer act as the leader.
+	Done() <-chan struct{}
+
+	// Fence returns the fence identifying this instance's current term as
+	// leader. A database guarded by the fence refuses all writes once the
+	// term has ended, even if we haven't noticed yet. It may only be
+	// called once elected.
+	Fence() *kvdb.LeaderFence
+
+	// Close resigns if this instance is the leader, and releases all
+	// resources held by the elector.
+	Close() error
+}
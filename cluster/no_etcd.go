@@ -0,0 +1,24 @@
+// +build !kvdb_etcd
+
+package cluster
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+// errEtcdNotAvailable is returned when an etcd leader elector is requested
+// from a binary that was built without etcd support.
+var errEtcdNotAvailable = errors.New("etcd leader election not available, " +
+	"rebuild with the kvdb_etcd build tag")
+
+// NewEtcdLeaderElector would create a leader elector backed by an etcd
+// cluster, however etcd support was not compiled in, so an error is always
+// returned.
+func NewEtcdLeaderElector(ctx context.Context, id, electionPrefix string,
+	ttl int, cfg *kvdb.EtcdConfig) (LeaderElector, error) {
+
+	return nil, errEtcdNotAvailable
+}
@@ -111,6 +111,13 @@ var newAddressCommand = cli.Command{
 	Generate a wallet new address. Address-types has to be one of:
 	    - p2wkh:  Pay to witness key hash
 	    - np2wkh: Pay to nested witness key hash`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) the name of the wallet account to " +
+				"derive the address from",
+		},
+	},
 	Action: actionDecorator(newAddress),
 }
 
@@ -135,7 +142,8 @@ func newAddress(ctx *cli.Context) error {
 
 	ctxb := context.Background()
 	addr, err := client.NewAddress(ctxb, &lnrpc.NewAddressRequest{
-		Type: addrType,
+		Type:    addrType,
+		Account: ctx.String("account"),
 	})
 	if err != nil {
 		return err
@@ -232,6 +240,11 @@ var sendCoinsCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) the name of the wallet account to " +
+				"send the coins from",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
@@ -291,6 +304,7 @@ func sendCoins(ctx *cli.Context) error {
 		TargetConf: int32(ctx.Int64("conf_target")),
 		SatPerByte: ctx.Int64("sat_per_byte"),
 		SendAll:    ctx.Bool("sweepall"),
+		Account:    ctx.String("account"),
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -335,6 +349,11 @@ var listUnspentCommand = cli.Command{
 				"true and both min_confs and max_confs are " +
 				"non-zero. (defualt: false)",
 		},
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) only list the utxos of the " +
+				"wallet account with this name",
+		},
 	},
 	Action: actionDecorator(listUnspent),
 }
@@ -398,6 +417,7 @@ func listUnspent(ctx *cli.Context) error {
 	req := &lnrpc.ListUnspentRequest{
 		MinConfs: int32(minConfirms),
 		MaxConfs: int32(maxConfirms),
+		Account:  ctx.String("account"),
 	}
 	resp, err := client.ListUnspent(ctxb, req)
 	if err != nil {
@@ -569,6 +589,137 @@ func disconnectPeer(ctx *cli.Context) error {
 	return nil
 }
 
+var peerAccessCommand = cli.Command{
+	Name:     "peeraccess",
+	Category: "Peers",
+	Usage:    "Manage the allow and deny lists of peers.",
+	Description: `
+	Rules match peers either by their hex-encoded node public key, or by
+	the IP address they connect from, given as a single address or as a
+	network in CIDR notation. Peers matching a rule of the deny list are
+	never accepted. If inbound connections are restricted, only peers
+	matching a rule of the allow list, or peers with channels, are
+	accepted.
+
+	Rules changed with these commands only apply to new inbound
+	connections, and aren't persisted across restarts.
+	`,
+	Subcommands: []cli.Command{
+		{
+			Name:      "add",
+			Usage:     "Add a rule to the allow or deny list.",
+			ArgsUsage: "rule",
+			Flags:     peerAccessRuleFlags,
+			Action:    actionDecorator(addPeerAccessRule),
+		},
+		{
+			Name:      "remove",
+			Usage:     "Remove a rule from the allow or deny list.",
+			ArgsUsage: "rule",
+			Flags:     peerAccessRuleFlags,
+			Action:    actionDecorator(removePeerAccessRule),
+		},
+		{
+			Name:   "list",
+			Usage:  "List the rules of the allow and deny lists.",
+			Action: actionDecorator(listPeerAccessRules),
+		},
+	},
+}
+
+// peerAccessRuleFlags are the flags shared by the commands that add and remove
+// peer access rules.
+var peerAccessRuleFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: "rule",
+		Usage: "the hex-encoded public key of a node, an IP address, " +
+			"or a network in CIDR notation",
+	},
+	cli.BoolFlag{
+		Name:  "deny",
+		Usage: "if set, the rule applies to the deny list",
+	},
+}
+
+// parsePeerAccessRule parses the peer access rule request of the add and
+// remove commands.
+func parsePeerAccessRule(ctx *cli.Context) (*lnrpc.PeerAccessRuleRequest,
+	error) {
+
+	var rule string
+	switch {
+	case ctx.IsSet("rule"):
+		rule = ctx.String("rule")
+	case ctx.Args().Present():
+		rule = ctx.Args().First()
+	default:
+		return nil, fmt.Errorf("rule argument missing")
+	}
+
+	list := lnrpc.PeerAccessList_ALLOW
+	if ctx.Bool("deny") {
+		list = lnrpc.PeerAccessList_DENY
+	}
+
+	return &lnrpc.PeerAccessRuleRequest{
+		List: list,
+		Rule: rule,
+	}, nil
+}
+
+func addPeerAccessRule(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req, err := parsePeerAccessRule(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddPeerAccessRule(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+func removePeerAccessRule(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req, err := parsePeerAccessRule(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RemovePeerAccessRule(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+func listPeerAccessRules(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListPeerAccessRulesRequest{}
+	resp, err := client.ListPeerAccessRules(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // TODO(roasbeef): change default number of confirmations
 var openChannelCommand = cli.Command{
 	Name:     "openchannel",
@@ -656,6 +807,28 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.StringFlag{
+			Name: "close_address",
+			Usage: "(optional) an address to enforce payout of our " +
+				"funds to on cooperative close. Note that if " +
+				"this value is set on channel open, you will " +
+				"*not* be able to cooperatively close to a " +
+				"different address.",
+		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) request a channel that can be used " +
+				"before the funding transaction confirms. The " +
+				"peer must trust us to accept it, and the " +
+				"channel must be private",
+		},
+		cli.BoolFlag{
+			Name: "dual_fund",
+			Usage: "(optional) allow the peer to contribute funds " +
+				"of their own to the channel. The funding " +
+				"transaction is then constructed together " +
+				"with the peer",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -681,6 +854,9 @@ func openChannel(ctx *cli.Context) error {
 		MinHtlcMsat:    ctx.Int64("min_htlc_msat"),
 		RemoteCsvDelay: uint32(ctx.Uint64("remote_csv_delay")),
 		MinConfs:       int32(ctx.Uint64("min_confs")),
+		CloseAddress:   ctx.String("close_address"),
+		ZeroConf:       ctx.Bool("zero_conf"),
+		DualFund:       ctx.Bool("dual_fund"),
 	}
 
 	switch {
@@ -833,7 +1009,9 @@ var closeChannelCommand = cli.Command{
 	In the case of a cooperative closure, One can manually set the fee to
 	be used for the closing transaction via either the --conf_target or
 	--sat_per_byte arguments. This will be the starting value used during
-	fee negotiation. This is optional.
+	fee negotiation. This is optional. An upper bound for the negotiated
+	fee can be set with the --max_sat_per_byte argument, and the settled
+	funds can be sent to a specific address using --delivery_addr.
 
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
@@ -869,6 +1047,17 @@ var closeChannelCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Int64Flag{
+			Name: "max_sat_per_byte",
+			Usage: "(optional) the maximum fee expressed in " +
+				"sat/byte that we're willing to pay for the " +
+				"closing transaction",
+		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) an address to deliver our " +
+				"settled funds to in a cooperative close",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -890,10 +1079,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		SatPerByte:      ctx.Int64("sat_per_byte"),
+		MaxSatPerByte:   ctx.Int64("max_sat_per_byte"),
+		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1238,6 +1429,226 @@ func abandonChannel(ctx *cli.Context) error {
 	return nil
 }
 
+// spliceFlags are the flags shared by the splicein and spliceout commands.
+var spliceFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "funding_txid",
+		Usage: "the txid of the channel's funding transaction",
+	},
+	cli.IntFlag{
+		Name: "output_index",
+		Usage: "the output index for the funding output of the funding " +
+			"transaction",
+	},
+	cli.Int64Flag{
+		Name:  "amt",
+		Usage: "the number of satoshis to splice",
+	},
+	cli.Int64Flag{
+		Name: "conf_target",
+		Usage: "(optional) the number of blocks that the splice " +
+			"transaction *should* confirm in, will be used for fee " +
+			"estimation",
+	},
+	cli.Int64Flag{
+		Name: "sat_per_byte",
+		Usage: "(optional) a manual fee expressed in sat/byte that " +
+			"should be used when crafting the splice transaction",
+	},
+}
+
+var spliceInCommand = cli.Command{
+	Name:     "splicein",
+	Category: "Channels",
+	Usage:    "Add funds from the wallet to an existing channel.",
+	Description: `
+	Adds funds from the wallet to an existing private channel, without
+	closing it. The funds are added to our balance once the splice
+	transaction confirms, while the channel keeps operating in the
+	meantime. The channel keeps its channel point and short channel ID.
+
+	A pending splice can be replaced by splicing the channel again at a
+	higher fee rate.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags:     spliceFlags,
+	Action:    actionDecorator(spliceIn),
+}
+
+func spliceIn(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "splicein")
+		return nil
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.SpliceInRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerByte:   ctx.Int64("sat_per_byte"),
+	}
+
+	resp, err := client.SpliceIn(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var spliceOutCommand = cli.Command{
+	Name:     "spliceout",
+	Category: "Channels",
+	Usage:    "Move funds from an existing channel to an on-chain address.",
+	Description: `
+	Moves funds from our balance of an existing private channel to an
+	on-chain address, without closing the channel. The fee of the splice
+	transaction is paid from our channel balance. The channel keeps
+	operating while the splice transaction confirms.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the address to send the spliced out funds to",
+		},
+	}, spliceFlags...),
+	Action: actionDecorator(spliceOut),
+}
+
+func spliceOut(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "spliceout")
+		return nil
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	if !ctx.IsSet("addr") {
+		return fmt.Errorf("addr argument missing")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.SpliceOutRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		Addr:         ctx.String("addr"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerByte:   ctx.Int64("sat_per_byte"),
+	}
+
+	resp, err := client.SpliceOut(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var abandonSpliceCommand = cli.Command{
+	Name:     "abandonsplice",
+	Category: "Channels",
+	Usage:    "Abandon a pending splice of an existing channel.",
+	Description: `
+	Discards a pending splice of an existing private channel whose splice
+	transaction can no longer confirm, as one of its inputs has been double
+	spent. The remote party must agree that the splice transaction is
+	invalid.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+		cli.StringFlag{
+			Name:  "splice_txid",
+			Usage: "the txid of the splice transaction to abandon",
+		},
+	},
+	Action: actionDecorator(abandonSplice),
+}
+
+func abandonSplice(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "abandonsplice")
+		return nil
+	}
+
+	if !ctx.IsSet("splice_txid") {
+		return fmt.Errorf("splice_txid argument missing")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.AbandonSpliceRequest{
+		ChannelPoint: channelPoint,
+		SpliceTxid:   ctx.String("splice_txid"),
+	}
+
+	resp, err := client.AbandonSplice(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // parseChannelPoint parses a funding txid and output index from the command
 // line. Both named options as well as unnamed parameters are supported.
 func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
@@ -1341,6 +1752,18 @@ var createCommand = cli.Command{
 			Name:  "multi_file",
 			Usage: "the path to a multi-channel back up file",
 		},
+		cli.BoolFlag{
+			Name: "stateless_init",
+			Usage: "do not create any macaroon files on disk, " +
+				"instead return the admin macaroon to the " +
+				"caller",
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "the file to write the admin macaroon to when " +
+				"initializing statelessly, if not set the " +
+				"macaroon is printed hex encoded",
+		},
 	},
 	Action: actionDecorator(create),
 }
@@ -1615,13 +2038,39 @@ mnemonicCheck:
 		AezeedPassphrase:   aezeedPass,
 		RecoveryWindow:     recoveryWindow,
 		ChannelBackups:     chanBackups,
+		StatelessInit:      ctx.Bool("stateless_init"),
 	}
-	if _, err := client.InitWallet(ctxb, req); err != nil {
+	resp, err := client.InitWallet(ctxb, req)
+	if err != nil {
 		return err
 	}
 
 	fmt.Println("\nlnd successfully initialized!")
 
+	// When initializing statelessly, the admin macaroon is only ever
+	// handed to us, so we'll need to store it ourselves.
+	if ctx.Bool("stateless_init") {
+		return saveMacaroon(ctx.String("save_to"), resp.AdminMacaroon)
+	}
+
+	return nil
+}
+
+// saveMacaroon writes the passed serialized macaroon to the given file, or
+// prints it hex encoded if no file was given.
+func saveMacaroon(fileName string, macBytes []byte) error {
+	if fileName == "" {
+		fmt.Printf("\nAdmin macaroon: %x\n", macBytes)
+		return nil
+	}
+
+	if err := ioutil.WriteFile(fileName, macBytes, 0600); err != nil {
+		return fmt.Errorf("unable to write macaroon to %v: %v",
+			fileName, err)
+	}
+
+	fmt.Printf("\nAdmin macaroon written to %v\n", fileName)
+
 	return nil
 }
 
@@ -1644,6 +2093,17 @@ var unlockCommand = cli.Command{
 				"maximum number of consecutive, unused " +
 				"addresses ever generated by the wallet.",
 		},
+		cli.BoolFlag{
+			Name: "stateless_init",
+			Usage: "do not create any macaroon files on disk, " +
+				"a new admin macaroon is returned instead",
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "the file to write the admin macaroon to when " +
+				"unlocking statelessly, if not set the " +
+				"macaroon is printed hex encoded",
+		},
 	},
 	Action: actionDecorator(unlock),
 }
@@ -1680,14 +2140,21 @@ func unlock(ctx *cli.Context) error {
 	req := &lnrpc.UnlockWalletRequest{
 		WalletPassword: pw,
 		RecoveryWindow: recoveryWindow,
+		StatelessInit:  ctx.Bool("stateless_init"),
 	}
-	_, err = client.UnlockWallet(ctxb, req)
+	resp, err := client.UnlockWallet(ctxb, req)
 	if err != nil {
 		return err
 	}
 
 	fmt.Println("\nlnd successfully unlocked!")
 
+	// No macaroon files are written in stateless mode, so we'll store
+	// the admin macaroon we were handed ourselves.
+	if ctx.Bool("stateless_init") {
+		return saveMacaroon(ctx.String("save_to"), resp.AdminMacaroon)
+	}
+
 	// TODO(roasbeef): add ability to accept hex single and multi backups
 
 	return nil
@@ -1757,7 +2224,14 @@ var walletBalanceCommand = cli.Command{
 	Name:     "walletbalance",
 	Category: "Wallet",
 	Usage:    "Compute and display the wallet's current balance.",
-	Action:   actionDecorator(walletBalance),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) only compute the balance of the " +
+				"wallet account with this name",
+		},
+	},
+	Action: actionDecorator(walletBalance),
 }
 
 func walletBalance(ctx *cli.Context) error {
@@ -1765,7 +2239,9 @@ func walletBalance(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.WalletBalanceRequest{}
+	req := &lnrpc.WalletBalanceRequest{
+		Account: ctx.String("account"),
+	}
 	resp, err := client.WalletBalance(ctxb, req)
 	if err != nil {
 		return err
@@ -1998,6 +2474,63 @@ func closedChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var forceCloseReportCommand = cli.Command{
+	Name:     "forceclosereport",
+	Category: "Channels",
+	Usage:    "Show the resolution of a force closed channel's outputs.",
+	Description: `
+	Shows a detailed report on the resolution of the outputs of a force
+	closed channel. Outputs that have already been resolved are listed with
+	their outcome and sweep transaction, outputs that are still being
+	resolved are listed with the height they are waiting for.
+
+	The report remains available after the channel has been fully closed.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+	},
+	Action: actionDecorator(forceCloseReport),
+}
+
+func forceCloseReport(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "forceclosereport")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.ForceCloseReportRequest{
+		ChannelPoint: channelPoint,
+	}
+
+	resp, err := client.GetForceCloseReport(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var cltvLimitFlag = cli.UintFlag{
 	Name: "cltv_limit",
 	Usage: "the maximum time lock that may be used for " +
@@ -2857,6 +3390,132 @@ func listPayments(ctx *cli.Context) error {
 	return nil
 }
 
+var rebalanceCommand = cli.Command{
+	Name:     "rebalance",
+	Category: "Payments",
+	Usage:    "Move funds between two of our channels.",
+	Description: `
+	Moves funds between two of our channels by paying an internal invoice
+	through a circular route. The payment leaves through the outgoing
+	channel and arrives back through either the incoming channel or any
+	channel of the last hop.
+
+	Failed attempts are retried along other routes as long as the total
+	fee stays within max_fee. The outcome is recorded as a rebalance, and
+	can be inspected through listrebalances.`,
+	ArgsUsage: "outgoing_chan_id amt",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "outgoing_chan_id",
+			Usage: "short channel id of the channel to move funds out of",
+		},
+		cli.Uint64Flag{
+			Name: "incoming_chan_id",
+			Usage: "short channel id of the channel to move funds " +
+				"into",
+		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "the hex-encoded public key of the peer to " +
+				"receive the funds back from, instead of " +
+				"incoming_chan_id",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount to move in satoshis",
+		},
+		cli.Int64Flag{
+			Name:  "max_fee",
+			Usage: "the maximum total fee to pay in satoshis",
+		},
+	},
+	Action: actionDecorator(rebalance),
+}
+
+func rebalance(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "rebalance")
+		return nil
+	}
+
+	var (
+		args = ctx.Args()
+		req  = &lnrpc.RebalanceRequest{
+			IncomingChanId: ctx.Uint64("incoming_chan_id"),
+			MaxFee:         ctx.Int64("max_fee"),
+		}
+		err error
+	)
+
+	switch {
+	case ctx.IsSet("outgoing_chan_id"):
+		req.OutgoingChanId = ctx.Uint64("outgoing_chan_id")
+	case args.Present():
+		req.OutgoingChanId, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode outgoing_chan_id: "+
+				"%v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("outgoing_chan_id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		req.Amt = ctx.Int64("amt")
+	case args.Present():
+		req.Amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt: %v", err)
+		}
+	default:
+		return fmt.Errorf("amt argument missing")
+	}
+
+	if ctx.IsSet("last_hop") {
+		req.LastHopPubkey, err = hex.DecodeString(ctx.String("last_hop"))
+		if err != nil {
+			return fmt.Errorf("unable to decode last_hop: %v", err)
+		}
+	}
+
+	resp, err := client.Rebalance(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listRebalancesCommand = cli.Command{
+	Name:     "listrebalances",
+	Category: "Payments",
+	Usage:    "List all rebalances between our channels.",
+	Action:   actionDecorator(listRebalances),
+}
+
+func listRebalances(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListRebalancesRequest{}
+
+	rebalances, err := client.ListRebalances(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(rebalances)
+	return nil
+}
+
 var getChanInfoCommand = cli.Command{
 	Name:     "getchaninfo",
 	Category: "Channels",
@@ -3199,6 +3858,40 @@ func stopDaemon(ctx *cli.Context) error {
 	return nil
 }
 
+var rotateMacaroonKeyCommand = cli.Command{
+	Name:  "rotatemacaroonkey",
+	Usage: "Rotate the root key used to bake macaroons.",
+	Description: `
+	Replace the root key used to bake and verify macaroons with a freshly
+	generated one. All macaroons baked so far, including the admin,
+	read-only and invoice macaroons, are invalidated and their files are
+	removed from disk. A new admin macaroon is returned, which is either
+	written to the file given by --save_to, or printed hex encoded.
+	Additional macaroons will be baked by lnd on its next start up, unless
+	it's started statelessly.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "save_to",
+			Usage: "the file to write the new admin macaroon to",
+		},
+	},
+	Action: actionDecorator(rotateMacaroonKey),
+}
+
+func rotateMacaroonKey(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.RotateMacaroonRootKeyRequest{}
+	resp, err := client.RotateMacaroonRootKey(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	return saveMacaroon(ctx.String("save_to"), resp.AdminMacaroon)
+}
+
 var signMessageCommand = cli.Command{
 	Name:      "signmessage",
 	Category:  "Wallet",
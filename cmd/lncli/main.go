@@ -263,10 +263,14 @@ func main() {
 		listUnspentCommand,
 		connectCommand,
 		disconnectCommand,
+		peerAccessCommand,
 		openChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		spliceInCommand,
+		spliceOutCommand,
+		abandonSpliceCommand,
 		listPeersCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
@@ -280,7 +284,10 @@ func main() {
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
+		forceCloseReportCommand,
 		listPaymentsCommand,
+		rebalanceCommand,
+		listRebalancesCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
 		getNodeInfoCommand,
@@ -290,6 +297,7 @@ func main() {
 		decodePayReqCommand,
 		listChainTxnsCommand,
 		stopCommand,
+		rotateMacaroonKeyCommand,
 		signMessageCommand,
 		verifyMessageCommand,
 		feeReportCommand,
@@ -303,6 +311,10 @@ func main() {
 	// Add any extra autopilot commands determined by build flags.
 	app.Commands = append(app.Commands, autopilotCommands()...)
 	app.Commands = append(app.Commands, invoicesCommands()...)
+	app.Commands = append(app.Commands, swapCommands()...)
+	app.Commands = append(app.Commands, routerCommands()...)
+	app.Commands = append(app.Commands, signerCommands()...)
+	app.Commands = append(app.Commands, walletCommands()...)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
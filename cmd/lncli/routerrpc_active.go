@@ -0,0 +1,133 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli"
+	"github.com/wakiyamap/lnd/lnrpc/routerrpc"
+)
+
+// routerCommands will return nil for non-routerrpc builds.
+func routerCommands() []cli.Command {
+	return []cli.Command{
+		probePaymentCommand,
+	}
+}
+
+func getRouterClient(ctx *cli.Context) (routerrpc.RouterClient, func()) {
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return routerrpc.NewRouterClient(conn), cleanUp
+}
+
+var probePaymentCommand = cli.Command{
+	Name:     "probepayment",
+	Category: "Payments",
+	Usage:    "Probe whether a payment to a destination would succeed.",
+	Description: `
+	Determines whether a payment of the given amount to the destination
+	would succeed, without paying it. HTLCs with a random payment hash are
+	sent along candidate routes, which the destination fails as it doesn't
+	know the payment hash.
+
+	If the full amount can't be delivered, the largest deliverable amount
+	is searched for. The response contains this amount, along with the fee
+	of the route it is deliverable along.`,
+	ArgsUsage: "dest amt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "dest",
+			Usage: "the hex-encoded public key of the destination " +
+				"to probe",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount to probe in satoshis",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "the maximum fee in satoshis of the probed " +
+				"routes",
+		},
+		cli.Int64Flag{
+			Name:  "final_cltv_delta",
+			Usage: "the CLTV delta the destination requires",
+		},
+		cli.Int64Flag{
+			Name:  "timeout",
+			Usage: "the maximum time in seconds to spend probing",
+			Value: 60,
+		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "short channel id of the outgoing channel to " +
+				"use for the first hop",
+		},
+	},
+	Action: actionDecorator(probePayment),
+}
+
+func probePayment(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getRouterClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "probepayment")
+		return nil
+	}
+
+	var (
+		args = ctx.Args()
+		req  = &routerrpc.ProbePaymentRequest{
+			FeeLimitSat:       ctx.Int64("fee_limit"),
+			FinalCltvDelta:    int32(ctx.Int64("final_cltv_delta")),
+			TimeoutSeconds:    int32(ctx.Int64("timeout")),
+			OutgoingChannelId: int64(ctx.Uint64("outgoing_chan_id")),
+		}
+		err error
+	)
+
+	switch {
+	case ctx.IsSet("dest"):
+		req.Dest, err = hex.DecodeString(ctx.String("dest"))
+	case args.Present():
+		req.Dest, err = hex.DecodeString(args.First())
+		args = args.Tail()
+	default:
+		return fmt.Errorf("dest argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode dest: %v", err)
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		req.AmtSat = ctx.Int64("amt")
+	case args.Present():
+		req.AmtSat, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt: %v", err)
+		}
+	default:
+		return fmt.Errorf("amt argument missing")
+	}
+
+	resp, err := client.ProbePayment(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
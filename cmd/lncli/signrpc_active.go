@@ -0,0 +1,275 @@
+// +build signrpc
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/urfave/cli"
+	"github.com/wakiyamap/lnd/lnrpc/signrpc"
+)
+
+func getSignerClient(ctx *cli.Context) (signrpc.SignerClient, func()) {
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return signrpc.NewSignerClient(conn), cleanUp
+}
+
+// keyDescFlags are the flags used to select the key of a signer command.
+var keyDescFlags = []cli.Flag{
+	cli.Int64Flag{
+		Name:  "key_family",
+		Usage: "the family of the key to use",
+	},
+	cli.Int64Flag{
+		Name:  "key_index",
+		Usage: "the index of the key to use within its family",
+	},
+	cli.StringFlag{
+		Name: "pubkey",
+		Usage: "the hex-encoded public key of the key to use, " +
+			"instead of its family and index",
+	},
+}
+
+// parseKeyDesc creates the key descriptor selected by the keyDescFlags.
+func parseKeyDesc(ctx *cli.Context) (*signrpc.KeyDescriptor, error) {
+	if ctx.IsSet("pubkey") {
+		pubKey, err := hex.DecodeString(ctx.String("pubkey"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode pubkey: %v",
+				err)
+		}
+
+		return &signrpc.KeyDescriptor{
+			RawKeyBytes: pubKey,
+		}, nil
+	}
+
+	return &signrpc.KeyDescriptor{
+		KeyLoc: &signrpc.KeyLocator{
+			KeyFamily: int32(ctx.Int64("key_family")),
+			KeyIndex:  int32(ctx.Int64("key_index")),
+		},
+	}, nil
+}
+
+var signMessageWithKeyCommand = cli.Command{
+	Name:  "signmessage",
+	Usage: "Sign a message with an arbitrary key.",
+	Description: `
+	Signs the double SHA-256 hash of the message with the key selected by
+	its family and index, or by its public key. The signature is returned
+	DER encoded.`,
+	ArgsUsage: "msg",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "msg",
+			Usage: "the message to sign",
+		},
+	}, keyDescFlags...),
+	Action: actionDecorator(signMessageWithKey),
+}
+
+func signMessageWithKey(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getSignerClient(ctx)
+	defer cleanUp()
+
+	var msg []byte
+	switch {
+	case ctx.IsSet("msg"):
+		msg = []byte(ctx.String("msg"))
+	case ctx.Args().Present():
+		msg = []byte(ctx.Args().First())
+	default:
+		return fmt.Errorf("msg argument missing")
+	}
+
+	keyDesc, err := parseKeyDesc(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SignMessage(ctxb, &signrpc.SignMessageReq{
+		Msg:     msg,
+		KeyDesc: keyDesc,
+	})
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		Signature string `json:"signature"`
+	}{
+		Signature: hex.EncodeToString(resp.Signature),
+	})
+	return nil
+}
+
+var verifyMessageWithKeyCommand = cli.Command{
+	Name:  "verifymessage",
+	Usage: "Verify a message signed with an arbitrary key.",
+	Description: `
+	Verifies a DER encoded signature over the double SHA-256 hash of the
+	message, as created by signmessage, against the given public key.`,
+	ArgsUsage: "msg signature pubkey",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "msg",
+			Usage: "the message the signature is over",
+		},
+		cli.StringFlag{
+			Name:  "sig",
+			Usage: "the hex-encoded signature",
+		},
+		cli.StringFlag{
+			Name: "pubkey",
+			Usage: "the hex-encoded public key the signature has " +
+				"to be valid for",
+		},
+	},
+	Action: actionDecorator(verifyMessageWithKey),
+}
+
+func verifyMessageWithKey(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getSignerClient(ctx)
+	defer cleanUp()
+
+	var (
+		args = ctx.Args()
+		req  = &signrpc.VerifyMessageReq{}
+		err  error
+	)
+
+	switch {
+	case ctx.IsSet("msg"):
+		req.Msg = []byte(ctx.String("msg"))
+	case args.Present():
+		req.Msg = []byte(args.First())
+		args = args.Tail()
+	default:
+		return fmt.Errorf("msg argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("sig"):
+		req.Signature, err = hex.DecodeString(ctx.String("sig"))
+	case args.Present():
+		req.Signature, err = hex.DecodeString(args.First())
+		args = args.Tail()
+	default:
+		return fmt.Errorf("signature argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode signature: %v", err)
+	}
+
+	switch {
+	case ctx.IsSet("pubkey"):
+		req.Pubkey, err = hex.DecodeString(ctx.String("pubkey"))
+	case args.Present():
+		req.Pubkey, err = hex.DecodeString(args.First())
+	default:
+		return fmt.Errorf("pubkey argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode pubkey: %v", err)
+	}
+
+	resp, err := client.VerifyMessage(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deriveSharedKeyCommand = cli.Command{
+	Name:  "sharedkey",
+	Usage: "Derive a shared secret key with an ephemeral public key.",
+	Description: `
+	Performs Diffie-Hellman key derivation between the ephemeral public key
+	and the private key selected by its family and index, or by its public
+	key. The shared key is the SHA-256 hash of the compressed shared
+	point.`,
+	ArgsUsage: "ephemeral_pubkey",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "ephemeral_pubkey",
+			Usage: "the hex-encoded ephemeral public key",
+		},
+	}, keyDescFlags...),
+	Action: actionDecorator(deriveSharedKey),
+}
+
+func deriveSharedKey(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getSignerClient(ctx)
+	defer cleanUp()
+
+	var (
+		ephemeralPubkey []byte
+		err             error
+	)
+	switch {
+	case ctx.IsSet("ephemeral_pubkey"):
+		ephemeralPubkey, err = hex.DecodeString(
+			ctx.String("ephemeral_pubkey"),
+		)
+	case ctx.Args().Present():
+		ephemeralPubkey, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("ephemeral_pubkey argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode ephemeral pubkey: %v", err)
+	}
+
+	keyDesc, err := parseKeyDesc(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeriveSharedKey(ctxb, &signrpc.SharedKeyRequest{
+		EphemeralPubkey: ephemeralPubkey,
+		KeyDesc:         keyDesc,
+	})
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		SharedKey string `json:"shared_key"`
+	}{
+		SharedKey: hex.EncodeToString(resp.SharedKey),
+	})
+	return nil
+}
+
+// signerCommands will return the set of commands to enable for signrpc
+// builds.
+func signerCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:     "signer",
+			Category: "Signer",
+			Usage: "Sign, verify and derive shared keys with " +
+				"arbitrary keys.",
+			Description: "",
+			Subcommands: []cli.Command{
+				signMessageWithKeyCommand,
+				verifyMessageWithKeyCommand,
+				deriveSharedKeyCommand,
+			},
+		},
+	}
+}
@@ -0,0 +1,10 @@
+// +build !signrpc
+
+package main
+
+import "github.com/urfave/cli"
+
+// signerCommands will return nil for non-signrpc builds.
+func signerCommands() []cli.Command {
+	return nil
+}
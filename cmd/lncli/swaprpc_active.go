@@ -0,0 +1,264 @@
+// +build swaprpc
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/urfave/cli"
+	"github.com/wakiyamap/lnd/lnrpc/swaprpc"
+)
+
+// swapCommands returns the swap client commands for swaprpc builds.
+func swapCommands() []cli.Command {
+	return []cli.Command{
+		loopOutCommand,
+		loopInCommand,
+		listSwapsCommand,
+		swapInfoCommand,
+		swapTermsCommand,
+	}
+}
+
+func getSwapClient(ctx *cli.Context) (swaprpc.SwapClientClient, func()) {
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return swaprpc.NewSwapClientClient(conn), cleanUp
+}
+
+var loopOutCommand = cli.Command{
+	Name:      "loopout",
+	Category:  "Swaps",
+	Usage:     "Swap off-chain funds into the on-chain wallet.",
+	ArgsUsage: "amt",
+	Description: `
+	Initiate a loop out swap with the configured swap server. The swap
+	invoice is paid off-chain, after which the on-chain HTLC published by
+	the server is swept into the wallet.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount in satoshis to swap out",
+		},
+		cli.Int64Flag{
+			Name: "max_swap_fee",
+			Usage: "the maximum fee in satoshis we're willing to " +
+				"pay to the swap server",
+		},
+		cli.Int64Flag{
+			Name: "max_payment_fee",
+			Usage: "the maximum routing fee in satoshis of the " +
+				"off-chain payment",
+		},
+	},
+	Action: actionDecorator(loopOut),
+}
+
+func loopOut(ctx *cli.Context) error {
+	amt, err := parseSwapAmt(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getSwapClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LoopOut(context.Background(), &swaprpc.LoopOutRequest{
+		Amt:           amt,
+		MaxSwapFee:    ctx.Int64("max_swap_fee"),
+		MaxPaymentFee: ctx.Int64("max_payment_fee"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var loopInCommand = cli.Command{
+	Name:      "loopin",
+	Category:  "Swaps",
+	Usage:     "Swap on-chain funds into our channels.",
+	ArgsUsage: "amt",
+	Description: `
+	Initiate a loop in swap with the configured swap server. An on-chain
+	HTLC is funded from the wallet, after which the server pays our hold
+	invoice off-chain.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount in satoshis to swap in",
+		},
+		cli.Int64Flag{
+			Name: "max_swap_fee",
+			Usage: "the maximum fee in satoshis we're willing to " +
+				"pay to the swap server",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "the confirmation target used to fund the " +
+				"on-chain HTLC",
+		},
+	},
+	Action: actionDecorator(loopIn),
+}
+
+func loopIn(ctx *cli.Context) error {
+	amt, err := parseSwapAmt(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getSwapClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LoopIn(context.Background(), &swaprpc.LoopInRequest{
+		Amt:            amt,
+		MaxSwapFee:     ctx.Int64("max_swap_fee"),
+		HtlcConfTarget: int32(ctx.Int64("conf_target")),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parseSwapAmt reads the swap amount from either the flag or the first
+// positional argument.
+func parseSwapAmt(ctx *cli.Context) (int64, error) {
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("amt"):
+		return ctx.Int64("amt"), nil
+
+	case args.Present():
+		var amt int64
+		if _, err := fmt.Sscan(args.First(), &amt); err != nil {
+			return 0, fmt.Errorf("unable to decode amount: %v", err)
+		}
+		return amt, nil
+
+	default:
+		return 0, fmt.Errorf("amt argument missing")
+	}
+}
+
+var listSwapsCommand = cli.Command{
+	Name:     "listswaps",
+	Category: "Swaps",
+	Usage:    "List all swaps known to the swap client.",
+	Action:   actionDecorator(listSwaps),
+}
+
+func listSwaps(ctx *cli.Context) error {
+	client, cleanUp := getSwapClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListSwaps(
+		context.Background(), &swaprpc.ListSwapsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var swapInfoCommand = cli.Command{
+	Name:      "swapinfo",
+	Category:  "Swaps",
+	Usage:     "Show the status of a single swap.",
+	ArgsUsage: "id",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the hex-encoded swap hash of the swap",
+		},
+	},
+	Action: actionDecorator(swapInfo),
+}
+
+func swapInfo(ctx *cli.Context) error {
+	var (
+		id  []byte
+		err error
+	)
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("id"):
+		id, err = hex.DecodeString(ctx.String("id"))
+	case args.Present():
+		id, err = hex.DecodeString(args.First())
+	default:
+		return fmt.Errorf("id argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to parse swap id: %v", err)
+	}
+
+	client, cleanUp := getSwapClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SwapInfo(context.Background(), &swaprpc.SwapInfoRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var swapTermsCommand = cli.Command{
+	Name:     "swapterms",
+	Category: "Swaps",
+	Usage:    "Show the terms of the swap server.",
+	Action:   actionDecorator(swapTerms),
+}
+
+func swapTerms(ctx *cli.Context) error {
+	client, cleanUp := getSwapClient(ctx)
+	defer cleanUp()
+
+	loopOutTerms, err := client.LoopOutTerms(
+		context.Background(), &swaprpc.TermsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	loopInTerms, err := client.LoopInTerms(
+		context.Background(), &swaprpc.TermsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		LoopOut *swaprpc.TermsResponse `json:"loop_out"`
+		LoopIn  *swaprpc.TermsResponse `json:"loop_in"`
+	}{
+		LoopOut: loopOutTerms,
+		LoopIn:  loopInTerms,
+	})
+
+	return nil
+}
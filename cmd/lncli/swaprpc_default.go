@@ -0,0 +1,10 @@
+// +build !swaprpc
+
+package main
+
+import "github.com/urfave/cli"
+
+// swapCommands will return nil for non-swaprpc builds.
+func swapCommands() []cli.Command {
+	return nil
+}
@@ -0,0 +1,780 @@
+// +build walletrpc
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/urfave/cli"
+	"github.com/wakiyamap/lnd/lnrpc/walletrpc"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+func getWalletClient(ctx *cli.Context) (walletrpc.WalletKitClient, func()) {
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return walletrpc.NewWalletKitClient(conn), cleanUp
+}
+
+// parsePsbtArg decodes the base64 encoded PSBT that is either passed as the
+// flag of the given name, or as the first argument.
+func parsePsbtArg(ctx *cli.Context, name string) ([]byte, error) {
+	var encoded string
+	switch {
+	case ctx.IsSet(name):
+		encoded = ctx.String(name)
+	case ctx.Args().Present():
+		encoded = ctx.Args().First()
+	default:
+		return nil, fmt.Errorf("%v argument missing", name)
+	}
+
+	packet, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode psbt: %v", err)
+	}
+
+	return packet, nil
+}
+
+var fundPsbtCommand = cli.Command{
+	Name:  "fund",
+	Usage: "Fund the outputs of a template PSBT with wallet inputs.",
+	Description: `
+	Selects wallet inputs to fund the outputs of the base64 encoded
+	template PSBT. If the template already spends inputs, only the amount
+	they don't cover is funded. A change output is added if required. The
+	selected inputs are locked until they're spent, released using
+	releaseoutput, their lock expires, or lnd is restarted. The funded PSBT
+	is returned base64 encoded.
+
+	If the inputs are selected from a watch-only account, the PSBT carries
+	the derivation paths of the inputs and the change output, such that it
+	can be signed by the hardware wallet holding the account's keys.`,
+	ArgsUsage: "template_psbt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "template_psbt",
+			Usage: "the base64 encoded template PSBT to fund",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks the transaction should " +
+				"confirm within",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_kw",
+			Usage: "a manual fee rate expressed in sat/kw, " +
+				"instead of a confirmation target",
+		},
+		cli.Uint64Flag{
+			Name: "lock_expiration_seconds",
+			Usage: "the number of seconds the selected inputs " +
+				"are locked for, if not set they are locked " +
+				"for 10 minutes",
+		},
+		cli.StringFlag{
+			Name: "account",
+			Usage: "the account to select the inputs from, if " +
+				"not set the default account is used",
+		},
+	},
+	Action: actionDecorator(fundPsbt),
+}
+
+func fundPsbt(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	template, err := parsePsbtArg(ctx, "template_psbt")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.FundPsbt(ctxb, &walletrpc.FundPsbtRequest{
+		Psbt:                  template,
+		ConfTarget:            int32(ctx.Int64("conf_target")),
+		SatPerKw:              ctx.Int64("sat_per_kw"),
+		LockExpirationSeconds: ctx.Uint64("lock_expiration_seconds"),
+		Account:               ctx.String("account"),
+	})
+	if err != nil {
+		return err
+	}
+
+	type lockedUtxo struct {
+		Txid        string `json:"txid"`
+		OutputIndex uint32 `json:"output_index"`
+	}
+	lockedUtxos := make([]lockedUtxo, 0, len(resp.LockedUtxos))
+	for _, utxo := range resp.LockedUtxos {
+		// The txid is shown in its usual byte reversed order.
+		txid := make([]byte, len(utxo.TxidBytes))
+		for i, b := range utxo.TxidBytes {
+			txid[len(txid)-1-i] = b
+		}
+
+		lockedUtxos = append(lockedUtxos, lockedUtxo{
+			Txid:        hex.EncodeToString(txid),
+			OutputIndex: utxo.OutputIndex,
+		})
+	}
+
+	printJSON(struct {
+		FundedPsbt        string       `json:"funded_psbt"`
+		ChangeOutputIndex int32        `json:"change_output_index"`
+		LockedUtxos       []lockedUtxo `json:"locked_utxos"`
+		LockExpiration    int64        `json:"lock_expiration"`
+	}{
+		FundedPsbt: base64.StdEncoding.EncodeToString(
+			resp.FundedPsbt,
+		),
+		ChangeOutputIndex: resp.ChangeOutputIndex,
+		LockedUtxos:       lockedUtxos,
+		LockExpiration:    resp.LockExpiration,
+	})
+	return nil
+}
+
+var releaseOutputCommand = cli.Command{
+	Name:      "releaseoutput",
+	Usage:     "Release an output locked by psbt fund.",
+	ArgsUsage: "outpoint",
+	Description: `
+	Releases the lock of an output that was selected to fund a PSBT, making
+	it available to other transactions again. The outpoint takes the form
+	of txid:output_index.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "outpoint",
+			Usage: "the outpoint of the output to release",
+		},
+	},
+	Action: actionDecorator(releaseOutput),
+}
+
+func releaseOutput(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	var outpointStr string
+	switch {
+	case ctx.IsSet("outpoint"):
+		outpointStr = ctx.String("outpoint")
+	case ctx.Args().Present():
+		outpointStr = ctx.Args().First()
+	default:
+		return fmt.Errorf("outpoint argument missing")
+	}
+
+	// The outpoint has the same form as a channel point.
+	outpoint, err := parseChanPoint(outpointStr)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ReleaseOutput(ctxb, &walletrpc.ReleaseOutputRequest{
+		Outpoint: &walletrpc.OutPoint{
+			TxidBytes:   outpoint.GetFundingTxidBytes(),
+			OutputIndex: outpoint.OutputIndex,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Released output %v\n", outpointStr)
+	return nil
+}
+
+var signPsbtCommand = cli.Command{
+	Name:  "sign",
+	Usage: "Sign the inputs of a PSBT that belong to the wallet.",
+	Description: `
+	Signs all inputs of the base64 encoded PSBT that belong to the wallet,
+	leaving all other inputs untouched. The signed PSBT is returned base64
+	encoded.`,
+	ArgsUsage: "funded_psbt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funded_psbt",
+			Usage: "the base64 encoded PSBT to sign",
+		},
+	},
+	Action: actionDecorator(signPsbt),
+}
+
+func signPsbt(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	packet, err := parsePsbtArg(ctx, "funded_psbt")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SignPsbt(ctxb, &walletrpc.SignPsbtRequest{
+		FundedPsbt: packet,
+	})
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		SignedPsbt   string   `json:"signed_psbt"`
+		SignedInputs []uint32 `json:"signed_inputs"`
+	}{
+		SignedPsbt: base64.StdEncoding.EncodeToString(
+			resp.SignedPsbt,
+		),
+		SignedInputs: resp.SignedInputs,
+	})
+	return nil
+}
+
+var finalizePsbtCommand = cli.Command{
+	Name:  "finalize",
+	Usage: "Sign and finalize a PSBT, returning the final transaction.",
+	Description: `
+	Signs all inputs of the base64 encoded PSBT that belong to the wallet,
+	and finalizes all of its inputs. All other inputs must already carry
+	their signatures. The fully signed transaction is returned hex encoded,
+	but isn't published.`,
+	ArgsUsage: "funded_psbt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funded_psbt",
+			Usage: "the base64 encoded PSBT to finalize",
+		},
+	},
+	Action: actionDecorator(finalizePsbt),
+}
+
+func finalizePsbt(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	packet, err := parsePsbtArg(ctx, "funded_psbt")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.FinalizePsbt(ctxb, &walletrpc.FinalizePsbtRequest{
+		FundedPsbt: packet,
+	})
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		SignedPsbt string `json:"signed_psbt"`
+		RawFinalTx string `json:"raw_final_tx"`
+	}{
+		SignedPsbt: base64.StdEncoding.EncodeToString(
+			resp.SignedPsbt,
+		),
+		RawFinalTx: hex.EncodeToString(resp.RawFinalTx),
+	})
+	return nil
+}
+
+var listAccountsCommand = cli.Command{
+	Name:   "list",
+	Usage:  "List all accounts of the wallet, along with their balances.",
+	Action: actionDecorator(listAccounts),
+}
+
+func listAccounts(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAccounts(
+		ctxb, &walletrpc.ListAccountsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var createAccountCommand = cli.Command{
+	Name:  "create",
+	Usage: "Create a new named account within the wallet.",
+	Description: `
+	Creates a new account with the given name, which must not be in use by
+	any other account yet. The funds of the account are kept apart from
+	those of all other accounts, and are never used to fund channels. Use
+	the --account flag of the on-chain commands to receive and send coins
+	of the account.`,
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the account to create",
+		},
+	},
+	Action: actionDecorator(createAccount),
+}
+
+func createAccount(ctx *cli.Context) error {
+	var name string
+	switch {
+	case ctx.IsSet("name"):
+		name = ctx.String("name")
+	case ctx.Args().Present():
+		name = ctx.Args().First()
+	default:
+		return fmt.Errorf("name argument missing")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CreateAccount(ctxb, &walletrpc.CreateAccountRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// parseImportAddressType parses the address type flag of the import commands.
+func parseImportAddressType(ctx *cli.Context) (walletrpc.AddressType, error) {
+	switch ctx.String("address_type") {
+	case "p2wkh":
+		return walletrpc.AddressType_WITNESS_PUBKEY_HASH, nil
+
+	case "np2wkh":
+		return walletrpc.AddressType_NESTED_WITNESS_PUBKEY_HASH, nil
+
+	default:
+		return 0, fmt.Errorf("invalid address type %v, supported "+
+			"types are p2wkh and np2wkh",
+			ctx.String("address_type"))
+	}
+}
+
+var importAccountCommand = cli.Command{
+	Name:  "import",
+	Usage: "Import a watch-only account from its extended public key.",
+	Description: `
+	Imports an account from its extended public key, such as one exported
+	by a hardware wallet. The key must be at the account level of its BIP
+	44 derivation path (m/purpose'/coin_type'/account'). The wallet tracks
+	the outputs and balance of the account, but can't sign for them. Its
+	coins can only be spent by funding a PSBT with psbt fund --account,
+	which is then signed by the hardware wallet.
+
+	If a birthday height is given, the chain is rescanned from that height
+	for outputs paying to the account.`,
+	ArgsUsage: "name extended_public_key",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the account to import",
+		},
+		cli.StringFlag{
+			Name:  "extended_public_key",
+			Usage: "the extended public key of the account",
+		},
+		cli.StringFlag{
+			Name: "master_key_fingerprint",
+			Usage: "the hex encoded fingerprint of the root key " +
+				"the account is derived from",
+		},
+		cli.StringFlag{
+			Name: "address_type",
+			Usage: "the type of the addresses derived from the " +
+				"account, either p2wkh or np2wkh",
+			Value: "p2wkh",
+		},
+		cli.Uint64Flag{
+			Name: "birthday_height",
+			Usage: "the height of the block the account was " +
+				"first used in",
+		},
+	},
+	Action: actionDecorator(importAccount),
+}
+
+func importAccount(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	var name string
+	switch {
+	case ctx.IsSet("name"):
+		name = ctx.String("name")
+	case args.Present():
+		name = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("name argument missing")
+	}
+
+	var xpub string
+	switch {
+	case ctx.IsSet("extended_public_key"):
+		xpub = ctx.String("extended_public_key")
+	case args.Present():
+		xpub = args.First()
+	default:
+		return fmt.Errorf("extended_public_key argument missing")
+	}
+
+	fingerprint, err := hex.DecodeString(
+		ctx.String("master_key_fingerprint"),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode master key fingerprint: "+
+			"%v", err)
+	}
+
+	addrType, err := parseImportAddressType(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportAccount(ctxb, &walletrpc.ImportAccountRequest{
+		Name:                 name,
+		ExtendedPublicKey:    xpub,
+		MasterKeyFingerprint: fingerprint,
+		AddressType:          addrType,
+		BirthdayHeight:       uint32(ctx.Uint64("birthday_height")),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importPublicKeyCommand = cli.Command{
+	Name:  "importpubkey",
+	Usage: "Import a single public key as watch-only.",
+	Description: `
+	Imports a single public key, whose outputs are tracked as part of the
+	"imported" account. If a birthday height is given, the chain is
+	rescanned from that height for outputs paying to the key.`,
+	ArgsUsage: "pub_key",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pub_key",
+			Usage: "the hex encoded public key to import",
+		},
+		cli.StringFlag{
+			Name: "address_type",
+			Usage: "the type of the address the key is watched " +
+				"as, either p2wkh or np2wkh",
+			Value: "p2wkh",
+		},
+		cli.Uint64Flag{
+			Name: "birthday_height",
+			Usage: "the height of the block the key was first " +
+				"used in",
+		},
+	},
+	Action: actionDecorator(importPublicKey),
+}
+
+func importPublicKey(ctx *cli.Context) error {
+	var pubKeyHex string
+	switch {
+	case ctx.IsSet("pub_key"):
+		pubKeyHex = ctx.String("pub_key")
+	case ctx.Args().Present():
+		pubKeyHex = ctx.Args().First()
+	default:
+		return fmt.Errorf("pub_key argument missing")
+	}
+
+	pubKey, err := hex.DecodeString(pubKeyHex)
+	if err != nil {
+		return fmt.Errorf("unable to decode pub key: %v", err)
+	}
+
+	addrType, err := parseImportAddressType(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportPublicKey(
+		ctxb, &walletrpc.ImportPublicKeyRequest{
+			PublicKey:      pubKey,
+			AddressType:    addrType,
+			BirthdayHeight: uint32(ctx.Uint64("birthday_height")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importAddressCommand = cli.Command{
+	Name:  "importaddress",
+	Usage: "Import a single address as watch-only.",
+	Description: `
+	Imports a single P2WKH address, whose outputs are tracked as part of
+	the "imported" account. If a birthday height is given, the chain is
+	rescanned from that height for outputs paying to the address.`,
+	ArgsUsage: "address",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "the address to import",
+		},
+		cli.Uint64Flag{
+			Name: "birthday_height",
+			Usage: "the height of the block the address was " +
+				"first used in",
+		},
+	},
+	Action: actionDecorator(importAddress),
+}
+
+func importAddress(ctx *cli.Context) error {
+	var addr string
+	switch {
+	case ctx.IsSet("address"):
+		addr = ctx.String("address")
+	case ctx.Args().Present():
+		addr = ctx.Args().First()
+	default:
+		return fmt.Errorf("address argument missing")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportAddress(ctxb, &walletrpc.ImportAddressRequest{
+		Address:        addr,
+		BirthdayHeight: uint32(ctx.Uint64("birthday_height")),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// readMnemonic prompts the user for their 24-word cipher seed mnemonic, along
+// with the passphrase it is enciphered with.
+func readMnemonic() ([]string, []byte, error) {
+	fmt.Printf("Input your 24-word mnemonic separated by spaces: ")
+	reader := bufio.NewReader(os.Stdin)
+	mnemonic, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, nil, err
+	}
+	fmt.Println()
+
+	mnemonic = strings.ToLower(strings.TrimSpace(mnemonic))
+	words := strings.Fields(mnemonic)
+	if len(words) != 24 {
+		return nil, nil, fmt.Errorf("wrong cipher seed mnemonic "+
+			"length: got %v words, expecting %v words",
+			len(words), 24)
+	}
+
+	fmt.Printf("Input your cipher seed passphrase (press enter if " +
+		"your seed doesn't have a passphrase): ")
+	passphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return nil, nil, err
+	}
+	fmt.Println()
+
+	return words, passphrase, nil
+}
+
+var verifySeedCommand = cli.Command{
+	Name:  "verify",
+	Usage: "Verify a backup of the wallet seed.",
+	Description: `
+	Prompts for a 24-word cipher seed mnemonic and its passphrase, and
+	checks that they decipher to the seed the running wallet was created
+	from. This allows a written down seed to be verified without wiping the
+	wallet.`,
+	Action: actionDecorator(verifySeed),
+}
+
+func verifySeed(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	mnemonic, passphrase, err := readMnemonic()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.VerifySeed(ctxb, &walletrpc.VerifySeedRequest{
+		CipherSeedMnemonic: mnemonic,
+		AezeedPassphrase:   passphrase,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var changeSeedPassphraseCommand = cli.Command{
+	Name:  "changepassphrase",
+	Usage: "Re-encipher the wallet seed with a new passphrase.",
+	Description: `
+	Prompts for the 24-word cipher seed mnemonic of the wallet, its current
+	passphrase, and a new passphrase. Once the mnemonic is verified against
+	the running wallet, the seed is re-enciphered with the new passphrase,
+	and the resulting mnemonic is displayed. The old mnemonic remains valid,
+	so it must be destroyed to complete the rotation.`,
+	Action: actionDecorator(changeSeedPassphrase),
+}
+
+func changeSeedPassphrase(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	mnemonic, currentPassphrase, err := readMnemonic()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Input the new cipher seed passphrase (press enter to " +
+		"proceed without a passphrase): ")
+	newPassphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if len(newPassphrase) != 0 {
+		fmt.Printf("Confirm new cipher seed passphrase: ")
+		confirmPassphrase, err := terminal.ReadPassword(
+			int(syscall.Stdin),
+		)
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+
+		if !bytes.Equal(newPassphrase, confirmPassphrase) {
+			return fmt.Errorf("cipher seed pass phrases " +
+				"don't match")
+		}
+	}
+
+	req := &walletrpc.ChangeSeedPassphraseRequest{
+		CipherSeedMnemonic:      mnemonic,
+		CurrentAezeedPassphrase: currentPassphrase,
+		NewAezeedPassphrase:     newPassphrase,
+	}
+	resp, err := client.ChangeSeedPassphrase(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
+		"RESTORE THE WALLET!!!\n")
+
+	fmt.Println("---------------BEGIN LND CIPHER SEED---------------")
+
+	numCols := 4
+	colWords := monowidthColumns(resp.CipherSeedMnemonic, numCols)
+	for i := 0; i < len(colWords); i += numCols {
+		fmt.Printf("%2d. %3s  %2d. %3s  %2d. %3s  %2d. %3s\n",
+			i+1, colWords[i], i+2, colWords[i+1], i+3,
+			colWords[i+2], i+4, colWords[i+3])
+	}
+
+	fmt.Println("---------------END LND CIPHER SEED-----------------")
+
+	fmt.Println("\n!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
+		"RESTORE THE WALLET!!!")
+
+	return nil
+}
+
+// walletCommands will return the set of commands to enable for walletrpc
+// builds.
+func walletCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:     "wallet",
+			Category: "Wallet",
+			Usage:    "Interact with the wallet.",
+			Subcommands: []cli.Command{
+				{
+					Name: "psbt",
+					Usage: "Fund, sign and finalize partially " +
+						"signed transactions.",
+					Subcommands: []cli.Command{
+						fundPsbtCommand,
+						signPsbtCommand,
+						finalizePsbtCommand,
+						releaseOutputCommand,
+					},
+				},
+				{
+					Name: "accounts",
+					Usage: "List, create and import " +
+						"named wallet accounts.",
+					Subcommands: []cli.Command{
+						listAccountsCommand,
+						createAccountCommand,
+						importAccountCommand,
+						importPublicKeyCommand,
+						importAddressCommand,
+					},
+				},
+				{
+					Name: "seed",
+					Usage: "Verify the wallet seed, and " +
+						"change its passphrase.",
+					Subcommands: []cli.Command{
+						verifySeedCommand,
+						changeSeedPassphraseCommand,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli"
+	"github.com/wakiyamap/lnd/channeldb"
+)
+
+func printJSON(resp interface{}) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		fatal(err)
+	}
+
+	var out bytes.Buffer
+	json.Indent(&out, b, "", "\t")
+	out.WriteString("\n")
+	out.WriteTo(os.Stdout)
+}
+
+var exportCommand = cli.Command{
+	Name:  "export",
+	Usage: "Export the channel database to a portable format.",
+	Description: `
+	Export every bucket and key/value pair within the channel database to a
+	stream of newline delimited JSON records. The stream begins with a
+	header carrying the version of the export format and the schema version
+	of the database, and ends with a footer carrying the number of records
+	and a checksum over the entire stream. The format is documented within
+	the channeldb package.
+
+	lnd must not be running while the database is being exported.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "output",
+			Usage: "the file to write the export to, if unset the " +
+				"export is written to stdout",
+		},
+	},
+	Action: exportDB,
+}
+
+func exportDB(ctx *cli.Context) error {
+	path, err := dbPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	db, err := openExistingDB(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var w io.Writer = os.Stdout
+	if ctx.String("output") != "" {
+		f, err := os.OpenFile(
+			cleanAndExpandPath(ctx.String("output")),
+			os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600,
+		)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	summary, err := db.Export(w)
+	if err != nil {
+		return fmt.Errorf("unable to export database: %v", err)
+	}
+
+	// If the export was written to stdout, then we'll write the summary
+	// to stderr so we don't corrupt the export itself.
+	if w == os.Stdout {
+		b, err := json.MarshalIndent(summary, "", "\t")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "%s\n", b)
+		return nil
+	}
+
+	printJSON(summary)
+	return nil
+}
+
+var importCommand = cli.Command{
+	Name:  "import",
+	Usage: "Import an export into a fresh channel database.",
+	Description: `
+	Create a fresh channel database from an export made with the export
+	command. The target directory must not already contain a channel
+	database. The export's checksum is verified before the import is
+	committed, and once imported, any necessary migrations are applied and
+	the integrity of the new database is checked.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "input",
+			Usage: "the file to read the export from, if unset the " +
+				"export is read from stdin",
+		},
+	},
+	Action: importDB,
+}
+
+func importDB(ctx *cli.Context) error {
+	path, err := dbPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if ctx.String("input") != "" {
+		f, err := os.Open(cleanAndExpandPath(ctx.String("input")))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	if _, err := channeldb.Import(path, r); err != nil {
+		return fmt.Errorf("unable to import database: %v", err)
+	}
+
+	db, err := channeldb.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open imported database: %v", err)
+	}
+	defer db.Close()
+
+	return checkIntegrity(db)
+}
+
+var checkCommand = cli.Command{
+	Name:  "check",
+	Usage: "Check the integrity of the channel database.",
+	Description: `
+	Decode every channel, close summary, invoice, payment, graph node and
+	edge, forwarding event and forwarding package within the channel
+	database, and report any orphaned records: forwarding packages that
+	belong to an unknown channel, link nodes without any channels, and
+	channels without a link node.
+
+	lnd must not be running while the database is being checked.
+	`,
+	Action: checkDB,
+}
+
+func checkDB(ctx *cli.Context) error {
+	path, err := dbPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	db, err := openExistingDB(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return checkIntegrity(db)
+}
+
+// integrityReport is the JSON representation of a channeldb.IntegrityReport.
+type integrityReport struct {
+	NumChannels             int      `json:"num_channels"`
+	NumClosedChannels       int      `json:"num_closed_channels"`
+	NumInvoices             int      `json:"num_invoices"`
+	NumPayments             int      `json:"num_payments"`
+	NumGraphNodes           int      `json:"num_graph_nodes"`
+	NumGraphEdges           int      `json:"num_graph_edges"`
+	NumForwardingEvents     int      `json:"num_forwarding_events"`
+	NumFwdPkgs              int      `json:"num_fwd_pkgs"`
+	OrphanedFwdPkgs         []uint64 `json:"orphaned_fwd_pkgs"`
+	OrphanedLinkNodes       []string `json:"orphaned_link_nodes"`
+	ChannelsWithoutLinkNode []string `json:"channels_without_link_node"`
+}
+
+// checkIntegrity runs an integrity check of the passed database, and prints
+// the resulting report. An error is returned if any orphaned records were
+// found.
+func checkIntegrity(db *channeldb.DB) error {
+	report, err := db.CheckIntegrity()
+	if err != nil {
+		return fmt.Errorf("integrity check failed: %v", err)
+	}
+
+	resp := &integrityReport{
+		NumChannels:         report.NumChannels,
+		NumClosedChannels:   report.NumClosedChannels,
+		NumInvoices:         report.NumInvoices,
+		NumPayments:         report.NumPayments,
+		NumGraphNodes:       report.NumGraphNodes,
+		NumGraphEdges:       report.NumGraphEdges,
+		NumForwardingEvents: report.NumForwardingEvents,
+		NumFwdPkgs:          report.NumFwdPkgs,
+	}
+	for _, source := range report.OrphanedFwdPkgs {
+		resp.OrphanedFwdPkgs = append(
+			resp.OrphanedFwdPkgs, source.ToUint64(),
+		)
+	}
+	for _, pub := range report.OrphanedLinkNodes {
+		resp.OrphanedLinkNodes = append(
+			resp.OrphanedLinkNodes,
+			hex.EncodeToString(pub.SerializeCompressed()),
+		)
+	}
+	for _, chanPoint := range report.ChannelsWithoutLinkNode {
+		resp.ChannelsWithoutLinkNode = append(
+			resp.ChannelsWithoutLinkNode, chanPoint.String(),
+		)
+	}
+
+	printJSON(resp)
+
+	if report.HasOrphans() {
+		return fmt.Errorf("orphaned records found")
+	}
+
+	return nil
+}
+
+// openExistingDB opens the channel database within the passed directory,
+// ensuring that we don't inadvertently create a fresh one.
+func openExistingDB(path string) (*channeldb.DB, error) {
+	if _, err := os.Stat(filepath.Join(path, "channel.db")); err != nil {
+		return nil, fmt.Errorf("unable to find channel database: %v",
+			err)
+	}
+
+	return channeldb.Open(path)
+}
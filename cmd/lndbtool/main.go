@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/urfave/cli"
+	"github.com/wakiyamap/lnd/build"
+)
+
+const (
+	defaultDataDir     = "data"
+	defaultGraphSubDir = "graph"
+)
+
+var (
+	defaultLndDir = btcutil.AppDataDir("lnd", false)
+)
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "[lndbtool] %v\n", err)
+	os.Exit(1)
+}
+
+// dbPath returns the directory containing the channel database that the
+// command should operate on. This is either the path specified by the user, or
+// lnddir/data/graph/<network>.
+func dbPath(ctx *cli.Context) (string, error) {
+	if ctx.GlobalString("dbpath") != "" {
+		return cleanAndExpandPath(ctx.GlobalString("dbpath")), nil
+	}
+
+	network := strings.ToLower(ctx.GlobalString("network"))
+	switch network {
+	case "mainnet", "testnet", "regtest", "simnet":
+	default:
+		return "", fmt.Errorf("unknown network: %v", network)
+	}
+
+	lndDir := cleanAndExpandPath(ctx.GlobalString("lnddir"))
+	return filepath.Join(
+		lndDir, defaultDataDir, defaultGraphSubDir, network,
+	), nil
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "lndbtool"
+	app.Version = build.Version()
+	app.Usage = "offline export, import and inspection of lnd's channel " +
+		"database"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "lnddir",
+			Value: defaultLndDir,
+			Usage: "path to lnd's base directory",
+		},
+		cli.StringFlag{
+			Name: "network, n",
+			Usage: "the network lnd is running on e.g. mainnet, " +
+				"testnet, etc.",
+			Value: "mainnet",
+		},
+		cli.StringFlag{
+			Name: "dbpath",
+			Usage: "path to the directory containing channel.db, " +
+				"overrides lnddir and network",
+		},
+	}
+	app.Commands = []cli.Command{
+		exportCommand,
+		importCommand,
+		checkCommand,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fatal(err)
+	}
+}
+
+// cleanAndExpandPath expands environment variables and leading ~ in the
+// passed path, cleans the result, and returns it.
+// This function is taken from https://github.com/btcsuite/btcd
+func cleanAndExpandPath(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	// Expand initial ~ to OS specific home directory.
+	if strings.HasPrefix(path, "~") {
+		var homeDir string
+		user, err := user.Current()
+		if err == nil {
+			homeDir = user.HomeDir
+		} else {
+			homeDir = os.Getenv("HOME")
+		}
+
+		path = strings.Replace(path, "~", homeDir, 1)
+	}
+
+	// NOTE: The os.ExpandEnv doesn't work with Windows-style %VARIABLE%,
+	// but the variables can still be expanded via POSIX-style $VARIABLE.
+	return filepath.Clean(os.ExpandEnv(path))
+}
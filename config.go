@@ -24,6 +24,8 @@ import (
 	"github.com/wakiyamap/lnd/build"
 	"github.com/wakiyamap/lnd/chanbackup"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/cluster"
 	"github.com/wakiyamap/lnd/discovery"
 	"github.com/wakiyamap/lnd/htlcswitch/hodl"
 	"github.com/wakiyamap/lnd/lncfg"
@@ -60,6 +62,7 @@ const (
 	defaultMaxLogFileSize           = 10
 	defaultMinBackoff               = time.Second
 	defaultMaxBackoff               = time.Hour
+	defaultMaxJusticeFeeRate        = 1000
 
 	defaultTorSOCKSPort            = 9050
 	defaultTorDNSHost              = "soa.nodes.lightning.directory"
@@ -298,8 +301,18 @@ type config struct {
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	UpfrontShutdownAddr string `long:"upfront-shutdown-address" description:"The address that lnd will commit to as the cooperative close destination for all new channels with peers that support upfront shutdown scripts. This can be overridden per channel with the close_address field of an open channel request."`
+
+	ZeroConfPeers []string `long:"zeroconfpeer" description:"The hex-encoded public key of a peer that is trusted to open zero-conf channels with us, which are usable before their funding transaction confirms. Can be specified multiple times."`
+
+	DualFundMaxContribution int64 `long:"dualfundmax" description:"The maximum amount in satoshis that lnd will contribute to a dual funded channel opened by a remote peer. Contributions match the remote peer's own, up to this maximum. If zero, lnd won't contribute to dual funded channels."`
+
+	MaxJusticeFeeRate uint64 `long:"maxjusticefeerate" description:"The maximum fee rate in sat/byte that lnd will pay for the justice transactions sweeping the funds of a breached channel. The fee rate is raised on every block until the justice transactions confirm, but never beyond this value. If zero, the fee rate isn't capped."`
+
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
+	SignerOnly bool `long:"signeronly" description:"If true, lnd only runs its wallet and serves the signrpc and walletrpc sub-servers, to act as the remote signer of a watch-only node. No peer-to-peer connections are made, and no channels are operated. Requires lnd to be built with the signrpc and walletrpc tags."`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -307,6 +320,16 @@ type config struct {
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
+
+	DB *lncfg.DB `group:"db" namespace:"db"`
+
+	Cluster *lncfg.Cluster `group:"cluster" namespace:"cluster"`
+
+	RemoteSigner *lncfg.RemoteSigner `group:"remotesigner" namespace:"remotesigner"`
+
+	WalletUnlock *lncfg.WalletUnlock `group:"walletunlock"`
+
+	PeerAccess *lncfg.PeerAccess `group:"peeraccess" namespace:"peeraccess"`
 }
 
 // loadConfig initializes and parses the config using a config file and command
@@ -364,6 +387,7 @@ func loadConfig() (*config, error) {
 		NoSeedBackup:       defaultNoSeedBackup,
 		MinBackoff:         defaultMinBackoff,
 		MaxBackoff:         defaultMaxBackoff,
+		MaxJusticeFeeRate:  defaultMaxJusticeFeeRate,
 		SubRPCServers: &subRPCServerConfigs{
 			SignRPC: &signrpc.Config{},
 		},
@@ -400,6 +424,20 @@ func loadConfig() (*config, error) {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
+		DB: lncfg.DefaultDB(),
+		Cluster: &lncfg.Cluster{
+			LeaderElector:      cluster.EtcdLeaderElector,
+			EtcdElectionPrefix: lncfg.DefaultEtcdElectionPrefix,
+			LeaseTTL:           cluster.DefaultLeaseTTL,
+		},
+		RemoteSigner: &lncfg.RemoteSigner{
+			Timeout: lncfg.DefaultRemoteSignerRPCTimeout,
+		},
+		WalletUnlock: &lncfg.WalletUnlock{},
+		PeerAccess: &lncfg.PeerAccess{
+			MaxPendingHandshakes:      lncfg.DefaultMaxPendingHandshakes,
+			MaxPendingHandshakesPerIP: lncfg.DefaultMaxPendingHandshakesPerIP,
+		},
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -496,6 +534,21 @@ func loadConfig() (*config, error) {
 	cfg.BitcoindMode.Dir = cleanAndExpandPath(cfg.BitcoindMode.Dir)
 	cfg.MonacoindMode.Dir = cleanAndExpandPath(cfg.MonacoindMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
+	cfg.RemoteSigner.MacaroonPath = cleanAndExpandPath(
+		cfg.RemoteSigner.MacaroonPath,
+	)
+	cfg.RemoteSigner.TLSCertPath = cleanAndExpandPath(
+		cfg.RemoteSigner.TLSCertPath,
+	)
+	cfg.WalletUnlock.PasswordFile = cleanAndExpandPath(
+		cfg.WalletUnlock.PasswordFile,
+	)
+	cfg.WalletUnlock.PasswordSocket = cleanAndExpandPath(
+		cfg.WalletUnlock.PasswordSocket,
+	)
+	cfg.WalletUnlock.SeedFile = cleanAndExpandPath(
+		cfg.WalletUnlock.SeedFile,
+	)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -1043,15 +1096,53 @@ func loadConfig() (*config, error) {
 			"minbackoff")
 	}
 
-	// Validate the subconfigs for workers and caches.
+	// Validate the subconfigs for workers, caches, the database,
+	// clustering, the remote signer, unlocking the wallet and peer
+	// access.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.DB,
+		cfg.Cluster,
+		cfg.RemoteSigner,
+		cfg.WalletUnlock,
+		cfg.PeerAccess,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// With --noseedbackup, the wallet is always unlocked with the default
+	// passphrase, so there's no password to obtain from elsewhere.
+	if cfg.NoSeedBackup && cfg.WalletUnlock.Enabled() {
+		return nil, fmt.Errorf("noseedbackup can't be used along " +
+			"with a wallet unlock password source")
+	}
+
+	// A watch-only node doesn't hold any private keys, so its wallet
+	// isn't protected by a password either.
+	if cfg.RemoteSigner.Enable && cfg.WalletUnlock.Enabled() {
+		return nil, fmt.Errorf("remotesigner.enable can't be used " +
+			"along with a wallet unlock password source")
+	}
+
+	// A signer-only node is the remote signer of a watch-only node, so it
+	// can't delegate its own signing to a remote signer.
+	if cfg.SignerOnly && cfg.RemoteSigner.Enable {
+		return nil, fmt.Errorf("signeronly can't be used along with " +
+			"remotesigner.enable")
+	}
+
+	// Electing a leader through etcd is only meaningful if the instances
+	// of the cluster share their state through etcd as well.
+	if cfg.Cluster.EnableLeaderElection &&
+		cfg.Cluster.LeaderElector == cluster.EtcdLeaderElector &&
+		cfg.DB.Backend != kvdb.EtcdBackendName {
+
+		return nil, fmt.Errorf("etcd leader election requires the " +
+			"etcd db backend")
+	}
+
 	// Finally, ensure that the user's color is correctly formatted,
 	// otherwise the server will not be able to start after the unlocking
 	// the wallet.
@@ -8,8 +8,8 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lnwallet"
 )
@@ -56,8 +56,10 @@ type ArbitratorLog interface {
 
 	// InsertUnresolvedContracts inserts a set of unresolved contracts into
 	// the log. The log will then persistently store each contract until
-	// they've been swapped out, or resolved.
-	InsertUnresolvedContracts(...ContractResolver) error
+	// they've been swapped out, or resolved. The passed resolver reports
+	// are stored atomically along with the contracts.
+	InsertUnresolvedContracts(reports []*channeldb.ResolverReport,
+		resolvers ...ContractResolver) error
 
 	// FetchUnresolvedContracts returns all unresolved contracts that have
 	// been previously written to the log.
@@ -282,7 +284,7 @@ var (
 // boltArbitratorLog is an implementation of the ArbitratorLog interface backed
 // by a bolt DB instance.
 type boltArbitratorLog struct {
-	db *bbolt.DB
+	db kvdb.Backend
 
 	cfg ChannelArbitratorConfig
 
@@ -291,7 +293,7 @@ type boltArbitratorLog struct {
 
 // newBoltArbitratorLog returns a new instance of the boltArbitratorLog given
 // an arbitrator config, and the items needed to create its log scope.
-func newBoltArbitratorLog(db *bbolt.DB, cfg ChannelArbitratorConfig,
+func newBoltArbitratorLog(db kvdb.Backend, cfg ChannelArbitratorConfig,
 	chainHash chainhash.Hash, chanPoint wire.OutPoint) (*boltArbitratorLog, error) {
 
 	scope, err := newLogScope(chainHash, chanPoint)
@@ -310,7 +312,7 @@ func newBoltArbitratorLog(db *bbolt.DB, cfg ChannelArbitratorConfig,
 // interface.
 var _ ArbitratorLog = (*boltArbitratorLog)(nil)
 
-func fetchContractReadBucket(tx *bbolt.Tx, scopeKey []byte) (*bbolt.Bucket, error) {
+func fetchContractReadBucket(tx kvdb.Tx, scopeKey []byte) (kvdb.Bucket, error) {
 	scopeBucket := tx.Bucket(scopeKey)
 	if scopeBucket == nil {
 		return nil, errScopeBucketNoExist
@@ -324,7 +326,7 @@ func fetchContractReadBucket(tx *bbolt.Tx, scopeKey []byte) (*bbolt.Bucket, erro
 	return contractBucket, nil
 }
 
-func fetchContractWriteBucket(tx *bbolt.Tx, scopeKey []byte) (*bbolt.Bucket, error) {
+func fetchContractWriteBucket(tx kvdb.Tx, scopeKey []byte) (kvdb.Bucket, error) {
 	scopeBucket, err := tx.CreateBucketIfNotExists(scopeKey)
 	if err != nil {
 		return nil, err
@@ -342,7 +344,7 @@ func fetchContractWriteBucket(tx *bbolt.Tx, scopeKey []byte) (*bbolt.Bucket, err
 
 // writeResolver is a helper method that writes a contract resolver and stores
 // it it within the passed contractBucket using its unique resolutionsKey key.
-func (b *boltArbitratorLog) writeResolver(contractBucket *bbolt.Bucket,
+func (b *boltArbitratorLog) writeResolver(contractBucket kvdb.Bucket,
 	res ContractResolver) error {
 
 	// First, we'll write to the buffer the type of this resolver. Using
@@ -383,7 +385,7 @@ func (b *boltArbitratorLog) writeResolver(contractBucket *bbolt.Bucket,
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) CurrentState() (ArbitratorState, error) {
 	var s ArbitratorState
-	err := b.db.View(func(tx *bbolt.Tx) error {
+	err := b.db.View(func(tx kvdb.Tx) error {
 		scopeBucket := tx.Bucket(b.scopeKey[:])
 		if scopeBucket == nil {
 			return errScopeBucketNoExist
@@ -408,7 +410,7 @@ func (b *boltArbitratorLog) CurrentState() (ArbitratorState, error) {
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) CommitState(s ArbitratorState) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
 		if err != nil {
 			return err
@@ -428,7 +430,7 @@ func (b *boltArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver, erro
 		Checkpoint:              b.checkpointContract,
 	}
 	var contracts []ContractResolver
-	err := b.db.View(func(tx *bbolt.Tx) error {
+	err := b.db.View(func(tx kvdb.Tx) error {
 		contractBucket, err := fetchContractReadBucket(tx, b.scopeKey[:])
 		if err != nil {
 			return err
@@ -518,8 +520,11 @@ func (b *boltArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver, erro
 // swapped out, or resolved.
 //
 // NOTE: Part of the ContractResolver interface.
-func (b *boltArbitratorLog) InsertUnresolvedContracts(resolvers ...ContractResolver) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+func (b *boltArbitratorLog) InsertUnresolvedContracts(
+	reports []*channeldb.ResolverReport,
+	resolvers ...ContractResolver) error {
+
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		contractBucket, err := fetchContractWriteBucket(tx, b.scopeKey[:])
 		if err != nil {
 			return err
@@ -532,17 +537,32 @@ func (b *boltArbitratorLog) InsertUnresolvedContracts(resolvers ...ContractResol
 			}
 		}
 
-		return nil
+		return b.putResolverReports(tx, reports)
 	})
 }
 
+// putResolverReports stores the passed resolver reports within the given
+// transaction. The reports are kept outside of the log's scope, so that they
+// survive the wipe of the log once the channel is fully resolved.
+func (b *boltArbitratorLog) putResolverReports(tx kvdb.Tx,
+	reports []*channeldb.ResolverReport) error {
+
+	for _, report := range reports {
+		if err := b.cfg.PutResolverReport(tx, report); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SwapContract performs an atomic swap of the old contract for the new
 // contract. This method is used when after a contract has been fully resolved,
 // it produces another contract that needs to be resolved.
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) SwapContract(oldContract, newContract ContractResolver) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		contractBucket, err := fetchContractWriteBucket(tx, b.scopeKey[:])
 		if err != nil {
 			return err
@@ -562,7 +582,7 @@ func (b *boltArbitratorLog) SwapContract(oldContract, newContract ContractResolv
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) ResolveContract(res ContractResolver) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		contractBucket, err := fetchContractWriteBucket(tx, b.scopeKey[:])
 		if err != nil {
 			return err
@@ -580,7 +600,7 @@ func (b *boltArbitratorLog) ResolveContract(res ContractResolver) error {
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) LogContractResolutions(c *ContractResolutions) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
 		if err != nil {
 			return err
@@ -641,7 +661,7 @@ func (b *boltArbitratorLog) LogContractResolutions(c *ContractResolutions) error
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) FetchContractResolutions() (*ContractResolutions, error) {
 	c := &ContractResolutions{}
-	err := b.db.View(func(tx *bbolt.Tx) error {
+	err := b.db.View(func(tx kvdb.Tx) error {
 		scopeBucket := tx.Bucket(b.scopeKey[:])
 		if scopeBucket == nil {
 			return errScopeBucketNoExist
@@ -727,7 +747,7 @@ func (b *boltArbitratorLog) FetchContractResolutions() (*ContractResolutions, er
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) LogChainActions(actions ChainActionMap) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
 		if err != nil {
 			return err
@@ -766,7 +786,7 @@ func (b *boltArbitratorLog) LogChainActions(actions ChainActionMap) error {
 func (b *boltArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 	actionsMap := make(ChainActionMap)
 
-	err := b.db.View(func(tx *bbolt.Tx) error {
+	err := b.db.View(func(tx kvdb.Tx) error {
 		scopeBucket := tx.Bucket(b.scopeKey[:])
 		if scopeBucket == nil {
 			return errScopeBucketNoExist
@@ -808,7 +828,7 @@ func (b *boltArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) WipeHistory() error {
-	return b.db.Update(func(tx *bbolt.Tx) error {
+	return b.db.Update(func(tx kvdb.Tx) error {
 		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
 		if err != nil {
 			return err
@@ -870,14 +890,20 @@ func (b *boltArbitratorLog) WipeHistory() error {
 // checkpointContract is a private method that will be fed into
 // ContractResolver instances to checkpoint their state once they reach
 // milestones during contract resolution.
-func (b *boltArbitratorLog) checkpointContract(c ContractResolver) error {
-	return b.db.Batch(func(tx *bbolt.Tx) error {
+func (b *boltArbitratorLog) checkpointContract(c ContractResolver,
+	reports ...*channeldb.ResolverReport) error {
+
+	return b.db.Batch(func(tx kvdb.Tx) error {
 		contractBucket, err := fetchContractWriteBucket(tx, b.scopeKey[:])
 		if err != nil {
 			return err
 		}
 
-		return b.writeResolver(contractBucket, c)
+		if err := b.writeResolver(contractBucket, c); err != nil {
+			return err
+		}
+
+		return b.putResolverReports(tx, reports)
 	})
 }
 
@@ -14,9 +14,9 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
@@ -96,7 +96,7 @@ var (
 	}
 )
 
-func makeTestDB() (*bbolt.DB, func(), error) {
+func makeTestDB() (kvdb.Backend, func(), error) {
 	// First, create a temporary directory to be used for the duration of
 	// this test.
 	tempDirName, err := ioutil.TempDir("", "arblog")
@@ -104,7 +104,7 @@ func makeTestDB() (*bbolt.DB, func(), error) {
 		return nil, nil, err
 	}
 
-	db, err := bbolt.Open(tempDirName+"/test.db", 0600, nil)
+	db, err := kvdb.OpenBolt(tempDirName + "/test.db")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,6 +181,10 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("expected %v, got %v", ogRes.resolved,
 				diskRes.resolved)
 		}
+		if ogRes.deadlineHeight != diskRes.deadlineHeight {
+			t.Fatalf("expected %v, got %v", ogRes.deadlineHeight,
+				diskRes.deadlineHeight)
+		}
 		if ogRes.broadcastHeight != diskRes.broadcastHeight {
 			t.Fatalf("expected %v, got %v",
 				ogRes.broadcastHeight, diskRes.broadcastHeight)
@@ -280,7 +284,7 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		resolved:         true,
 		broadcastHeight:  109,
 		payHash:          testPreimage,
-		sweepTx:          nil,
+		deadlineHeight:   150,
 	}
 	resolvers := []ContractResolver{
 		&timeoutResolver,
@@ -322,7 +326,7 @@ func TestContractInsertionRetrieval(t *testing.T) {
 	resolverMap[string(resolvers[4].ResolverKey())] = resolvers[4]
 
 	// Now, we'll insert the resolver into the log.
-	if err := testLog.InsertUnresolvedContracts(resolvers...); err != nil {
+	if err := testLog.InsertUnresolvedContracts(nil, resolvers...); err != nil {
 		t.Fatalf("unable to insert resolvers: %v", err)
 	}
 
@@ -398,7 +402,7 @@ func TestContractResolution(t *testing.T) {
 
 	// First, we'll insert the resolver into the database and ensure that
 	// we get the same resolver out the other side.
-	err = testLog.InsertUnresolvedContracts(timeoutResolver)
+	err = testLog.InsertUnresolvedContracts(nil, timeoutResolver)
 	if err != nil {
 		t.Fatalf("unable to insert contract into db: %v", err)
 	}
@@ -459,7 +463,7 @@ func TestContractSwapping(t *testing.T) {
 	}
 
 	// We'll first insert the contest resolver into the log.
-	err = testLog.InsertUnresolvedContracts(contestResolver)
+	err = testLog.InsertUnresolvedContracts(nil, contestResolver)
 	if err != nil {
 		t.Fatalf("unable to insert contract into db: %v", err)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/invoices"
 	"github.com/wakiyamap/lnd/lnwallet"
@@ -46,6 +47,21 @@ type ResolutionMsg struct {
 	PreImage *[32]byte
 }
 
+// UtxoSweeper defines the sweep functions that contract resolvers need.
+type UtxoSweeper interface {
+	// SweepInput offers an input to the sweeper. The returned channel is
+	// sent upon once the input has been swept, or an error occurred.
+	//
+	// NOTE: The sweeper doesn't wait for time locked inputs to mature, so
+	// they should only be offered once they can be spent.
+	SweepInput(input input.Input) (chan sweep.Result, error)
+
+	// CreateSweepTx creates a transaction that sweeps the passed inputs
+	// back into the wallet.
+	CreateSweepTx(inputs []input.Input, feePref sweep.FeePreference,
+		currentBlockHeight uint32) (*wire.MsgTx, error)
+}
+
 // ChainArbitratorConfig is a configuration struct that contains all the
 // function closures and interface that required to arbitrate on-chain
 // contracts for a particular chain.
@@ -107,16 +123,6 @@ type ChainArbitratorConfig struct {
 	// returned.
 	IsOurAddress func(btcutil.Address) bool
 
-	// IncubateOutput sends either an incoming HTLC, an outgoing HTLC, or
-	// both to the utxo nursery. Once this function returns, the nursery
-	// should have safely persisted the outputs to disk, and should start
-	// the process of incubation. This is used when a resolver wishes to
-	// pass off the output to the nursery as we're only waiting on an
-	// absolute/relative item block.
-	IncubateOutputs func(wire.OutPoint, *lnwallet.CommitOutputResolution,
-		*lnwallet.OutgoingHtlcResolution,
-		*lnwallet.IncomingHtlcResolution, uint32) error
-
 	// PreimageDB is a global store of all known pre-images. We'll use this
 	// to decide if we should broadcast a commitment transaction to claim
 	// an HTLC on-chain.
@@ -142,7 +148,7 @@ type ChainArbitratorConfig struct {
 	DisableChannel func(wire.OutPoint) error
 
 	// Sweeper allows resolvers to sweep their final outputs.
-	Sweeper *sweep.UtxoSweeper
+	Sweeper UtxoSweeper
 
 	// Registry is the invoice database that is used by resolvers to lookup
 	// preimages and settle invoices.
@@ -256,6 +262,17 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 				return nil, err
 			}
 
+			// If the transaction of a pending splice has already
+			// confirmed, the prior funding output has been spent,
+			// so we'll broadcast the commitment spending the
+			// funding output created by the splice instead.
+			if spliceTxid := channel.ConfirmedSplice(); spliceTxid != nil {
+				err := channel.LockInSplice(*spliceTxid)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			// Finally, we'll force close the channel completing
 			// the force close workflow.
 			chanMachine, err := lnwallet.NewLightningChannel(
@@ -275,6 +292,7 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			return nil
 		},
 		IsPendingClose:        false,
+		PutResolverReport:     c.putResolverReport(chanPoint),
 		ChainArbitratorConfig: c.cfg,
 		ChainEvents:           chanEvents,
 	}
@@ -286,7 +304,7 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 	// TODO(roasbeef); abstraction leak...
 	//  * rework: adaptor method to set log scope w/ factory func
 	chanLog, err := newBoltArbitratorLog(
-		c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
+		c.chanSource.Backend, arbCfg, c.cfg.ChainHash, chanPoint,
 	)
 	if err != nil {
 		blockEpoch.Cancel()
@@ -302,6 +320,18 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 	), nil
 }
 
+// putResolverReport returns a closure that stores the reports of the contract
+// resolvers of the passed channel within the channel database.
+func (c *ChainArbitrator) putResolverReport(chanPoint wire.OutPoint) func(
+	kvdb.Tx, *channeldb.ResolverReport) error {
+
+	return func(tx kvdb.Tx, report *channeldb.ResolverReport) error {
+		return c.chanSource.PutResolverReport(
+			tx, c.cfg.ChainHash, &chanPoint, report,
+		)
+	}
+}
+
 // resolveContract marks a contract as fully resolved within the database.
 // This is only to be done once all contracts which were live on the channel
 // before hitting the chain have been resolved.
@@ -434,9 +464,10 @@ func (c *ChainArbitrator) Start() error {
 			IsPendingClose:        true,
 			ClosingHeight:         closeChanInfo.CloseHeight,
 			CloseType:             closeChanInfo.CloseType,
+			PutResolverReport:     c.putResolverReport(chanPoint),
 		}
 		chanLog, err := newBoltArbitratorLog(
-			c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
+			c.chanSource.Backend, arbCfg, c.cfg.ChainHash, chanPoint,
 		)
 		if err != nil {
 			blockEpoch.Cancel()
@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -70,6 +71,16 @@ type ChainEventSubscription struct {
 	// material required to bring the cheating channel peer to justice.
 	ContractBreach chan *lnwallet.BreachRetribution
 
+	// SpliceConfirmed is a channel that will be sent upon once the
+	// transaction of a pending splice of the channel has confirmed. From
+	// then on, the funding output created by the splice is watched
+	// instead, while the splice is locked in once both parties have sent
+	// SpliceLocked.
+	//
+	// NOTE: Sends on this channel don't block, so subscribers that aren't
+	// interested in splices may ignore it.
+	SpliceConfirmed chan *channeldb.PendingSplice
+
 	// Cancel cancels the subscription to the event stream for a particular
 	// channel. This method should be called once the caller no longer needs to
 	// be notified of any on-chain events for a particular channel.
@@ -186,8 +197,9 @@ func (c *chainWatcher) Start() error {
 		chanState.FundingOutpoint)
 
 	// First, we'll register for a notification to be dispatched if the
-	// funding output is spent.
-	fundingOut := &chanState.FundingOutpoint
+	// funding output is spent. If the channel has been spliced, this is
+	// the funding output created by the latest splice.
+	fundingOut := chanState.CurrentFundingOutpoint()
 
 	// As a height hint, we'll try to use the opening height, but if the
 	// channel isn't yet open, then we'll use the height it was broadcast
@@ -197,32 +209,41 @@ func (c *chainWatcher) Start() error {
 		heightHint = chanState.FundingBroadcastHeight
 	}
 
+	spendNtfn, err := c.registerFundingSpend(fundingOut, heightHint)
+	if err != nil {
+		return err
+	}
+
+	// With the spend notification obtained, we'll now dispatch the
+	// closeObserver which will properly react to any changes.
+	c.wg.Add(1)
+	go c.closeObserver(spendNtfn, nil)
+
+	return nil
+}
+
+// registerFundingSpend registers for a notification to be dispatched once the
+// passed funding output of the channel is spent.
+func (c *chainWatcher) registerFundingSpend(fundingOut wire.OutPoint,
+	heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	chanState := c.cfg.chanState
 	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey.SerializeCompressed()
 	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
 	multiSigScript, err := input.GenMultiSigScript(
 		localKey, remoteKey,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pkScript, err := input.WitnessScriptHash(multiSigScript)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	spendNtfn, err := c.cfg.notifier.RegisterSpendNtfn(
-		fundingOut, pkScript, heightHint,
+	return c.cfg.notifier.RegisterSpendNtfn(
+		&fundingOut, pkScript, heightHint,
 	)
-	if err != nil {
-		return err
-	}
-
-	// With the spend notification obtained, we'll now dispatch the
-	// closeObserver which will properly react to any changes.
-	c.wg.Add(1)
-	go c.closeObserver(spendNtfn)
-
-	return nil
 }
 
 // Stop signals the close observer to gracefully exit.
@@ -258,6 +279,7 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *lnwallet.BreachRetribution, 1),
+		SpliceConfirmed:         make(chan *channeldb.PendingSplice, 1),
 		Cancel: func() {
 			c.Lock()
 			delete(c.clientSubscriptions, clientID)
@@ -345,8 +367,12 @@ func isOurCommitment(localChanCfg, remoteChanCfg channeldb.ChannelConfig,
 // channel that it's watching on chain. In the event of an on-chain event, the
 // close observer will assembled the proper materials required to claim the
 // funds of the channel on-chain (if required), then dispatch these as
-// notifications to all subscribers.
-func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
+// notifications to all subscribers. If the watched funding output was created
+// by a confirmed splice, its splice transaction is passed, so the splice can
+// be locked in before the spend is examined.
+func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent,
+	spliceTxid *chainhash.Hash) {
+
 	defer c.wg.Done()
 
 	log.Infof("Close observer for ChannelPoint(%v) active",
@@ -367,6 +393,44 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			return
 		}
 
+		// If the spent funding output was created by a splice that
+		// hasn't been locked in yet, we'll do so now, as the channel
+		// can only be closed using the commitments spending it.
+		if spliceTxid != nil {
+			err := c.cfg.chanState.LockInSplice(*spliceTxid)
+			if err != nil {
+				log.Errorf("Unable to lock in splice for "+
+					"chan_point=%v: %v",
+					c.cfg.chanState.FundingOutpoint, err)
+				return
+			}
+		}
+
+		// The funding output may have been spent by the transaction of
+		// a pending splice, in which case the channel lives on at the
+		// funding output created by the splice.
+		splice, err := c.cfg.chanState.MarkSpliceConfirmed(
+			*commitSpend.SpenderTxHash,
+		)
+		switch {
+		case err == nil:
+			err := c.dispatchSpliceConfirmed(
+				splice, uint32(commitSpend.SpendingHeight),
+			)
+			if err != nil {
+				log.Errorf("unable to handle splice for "+
+					"chan_point=%v: %v",
+					c.cfg.chanState.FundingOutpoint, err)
+			}
+			return
+
+		case err != channeldb.ErrSpliceNotFound:
+			log.Errorf("Unable to mark splice confirmed for "+
+				"chan_point=%v: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return
+		}
+
 		// Otherwise, the remote party might have broadcast a prior
 		// revoked state...!!!
 		commitTxBroadcast := commitSpend.SpendingTx
@@ -625,6 +689,39 @@ func (c *chainWatcher) toSelfAmount(tx *wire.MsgTx) btcutil.Amount {
 	return selfAmt
 }
 
+// dispatchSpliceConfirmed processes the confirmation of the transaction of a
+// pending splice of the channel. We'll notify all subscribers of the splice,
+// then start watching the funding output it created.
+func (c *chainWatcher) dispatchSpliceConfirmed(splice *channeldb.PendingSplice,
+	spendHeight uint32) error {
+
+	log.Infof("Splice of ChannelPoint(%v) confirmed, new funding "+
+		"output: %v", c.cfg.chanState.FundingOutpoint,
+		splice.FundingOutpoint)
+
+	c.Lock()
+	for _, sub := range c.clientSubscriptions {
+		select {
+		case sub.SpliceConfirmed <- splice:
+		default:
+		}
+	}
+	c.Unlock()
+
+	spendNtfn, err := c.registerFundingSpend(
+		splice.FundingOutpoint, spendHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+	spliceTxid := splice.SpliceTx.TxHash()
+	go c.closeObserver(spendNtfn, &spliceTxid)
+
+	return nil
+}
+
 // dispatchCooperativeClose processed a detect cooperative channel closure.
 // We'll use the spending transaction to locate our output within the
 // transaction, then clean up the database state. We'll also dispatch a
@@ -11,6 +11,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/lntypes"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
@@ -125,16 +126,38 @@ type ChannelArbitratorConfig struct {
 	// TODO(roasbeef): need RPC's to combine for pendingchannels RPC
 	MarkChannelResolved func() error
 
+	// PutResolverReport stores the report of a contract resolver of this
+	// channel within the passed database transaction. The reports are
+	// kept after the channel has been fully resolved.
+	PutResolverReport func(tx kvdb.Tx, report *channeldb.ResolverReport) error
+
 	ChainArbitratorConfig
 }
 
+// ReportOutputType describes the type of output that is being reported on.
+type ReportOutputType uint8
+
+const (
+	// ReportOutputIncomingHtlc is an incoming hash time locked contract on
+	// the commitment tx.
+	ReportOutputIncomingHtlc ReportOutputType = iota
+
+	// ReportOutputOutgoingHtlc is an outgoing hash time locked contract on
+	// the commitment tx.
+	ReportOutputOutgoingHtlc
+
+	// ReportOutputUnencumbered is an uncontested output on the commitment
+	// transaction paying to us directly.
+	ReportOutputUnencumbered
+)
+
 // ContractReport provides a summary of a commitment tx output.
 type ContractReport struct {
 	// Outpoint is the final output that will be swept back to the wallet.
 	Outpoint wire.OutPoint
 
-	// Incoming indicates whether the htlc was incoming to this channel.
-	Incoming bool
+	// Type indicates the type of the reported output.
+	Type ReportOutputType
 
 	// Amount is the final value that will be swept in back to the wallet.
 	Amount btcutil.Amount
@@ -156,6 +179,15 @@ type ContractReport struct {
 	// RecoveredBalance is the total value that has been successfully swept
 	// back to the user's wallet.
 	RecoveredBalance btcutil.Amount
+
+	// DeadlineHeight is the height before which the output must be swept
+	// to prevent a loss of funds. It is zero if the output has no
+	// deadline, or if the deadline no longer applies.
+	DeadlineHeight uint32
+
+	// DeadlineMissed is true if the deadline of the output has passed
+	// while it still hasn't been swept.
+	DeadlineMissed bool
 }
 
 // htlcSet represents the set of active HTLCs on a given commitment
@@ -496,6 +528,14 @@ func (c *ChannelArbitrator) Report() []*ContractReport {
 	c.activeResolversLock.RLock()
 	defer c.activeResolversLock.RUnlock()
 
+	// Fetch the current height, so we can flag outputs that have missed
+	// their deadline.
+	_, bestHeight, err := c.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		log.Errorf("ChannelArbitrator(%v): unable to fetch best "+
+			"height: %v", c.cfg.ChanPoint, err)
+	}
+
 	var reports []*ContractReport
 	for _, resolver := range c.activeResolvers {
 		r, ok := resolver.(reportingContractResolver)
@@ -512,6 +552,12 @@ func (c *ChannelArbitrator) Report() []*ContractReport {
 			continue
 		}
 
+		if err == nil && report.DeadlineHeight != 0 &&
+			uint32(bestHeight) >= report.DeadlineHeight {
+
+			report.DeadlineMissed = true
+		}
+
 		reports = append(reports, report)
 	}
 
@@ -807,27 +853,6 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 			break
 		}
 
-		// If we've have broadcast the commitment transaction, we send
-		// our commitment output for incubation, but only if it wasn't
-		// trimmed.  We'll need to wait for a CSV timeout before we can
-		// reclaim the funds.
-		commitRes := contractResolutions.CommitResolution
-		if commitRes != nil && commitRes.MaturityDelay > 0 {
-			log.Infof("ChannelArbitrator(%v): sending commit "+
-				"output for incubation", c.cfg.ChanPoint)
-
-			err = c.cfg.IncubateOutputs(
-				c.cfg.ChanPoint, commitRes,
-				nil, nil, triggerHeight,
-			)
-			if err != nil {
-				// TODO(roasbeef): check for AlreadyExists errors
-				log.Errorf("unable to incubate commitment "+
-					"output: %v", err)
-				return StateError, closeTx, err
-			}
-		}
-
 		// Now that we know we'll need to act, we'll process the htlc
 		// actions, wen create the structures we need to resolve all
 		// outstanding contracts.
@@ -858,7 +883,7 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 		log.Debugf("ChannelArbitrator(%v): inserting %v contract "+
 			"resolvers", c.cfg.ChanPoint, len(htlcResolvers))
 
-		err = c.log.InsertUnresolvedContracts(htlcResolvers...)
+		err = c.log.InsertUnresolvedContracts(nil, htlcResolvers...)
 		if err != nil {
 			return StateError, closeTx, err
 		}
@@ -1279,8 +1304,10 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 	// resolver so they each can do their duty.
 	resKit := ResolverKit{
 		ChannelArbitratorConfig: c.cfg,
-		Checkpoint: func(res ContractResolver) error {
-			return c.log.InsertUnresolvedContracts(res)
+		Checkpoint: func(res ContractResolver,
+			reports ...*channeldb.ResolverReport) error {
+
+			return c.log.InsertUnresolvedContracts(reports, res)
 		},
 	}
 
@@ -1331,6 +1358,7 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					broadcastHeight: height,
 					payHash:         htlc.RHash,
 					htlcAmt:         htlc.Amt,
+					deadlineHeight:  htlc.RefundTimeout,
 					ResolverKit:     resKit,
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
@@ -1393,6 +1421,7 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 						broadcastHeight: height,
 						payHash:         htlc.RHash,
 						htlcAmt:         htlc.Amt,
+						deadlineHeight:  htlc.RefundTimeout,
 						ResolverKit:     resKit,
 					},
 				}
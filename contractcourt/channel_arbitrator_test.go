@@ -13,6 +13,7 @@ import (
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/sweep"
 )
 
 type mockArbitratorLog struct {
@@ -63,7 +64,7 @@ func (b *mockArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver,
 }
 
 func (b *mockArbitratorLog) InsertUnresolvedContracts(
-	resolvers ...ContractResolver) error {
+	_ []*channeldb.ResolverReport, resolvers ...ContractResolver) error {
 
 	b.Lock()
 	for _, resolver := range resolvers {
@@ -122,6 +123,36 @@ func (b *mockArbitratorLog) WipeHistory() error {
 	return nil
 }
 
+// mockSweeper is a mock implementation of the UtxoSweeper interface that
+// hands all offered inputs to the test, and reports them as swept right away.
+type mockSweeper struct {
+	sweptInputs chan input.Input
+}
+
+func newMockSweeper() *mockSweeper {
+	return &mockSweeper{
+		sweptInputs: make(chan input.Input),
+	}
+}
+
+func (s *mockSweeper) SweepInput(inp input.Input) (chan sweep.Result, error) {
+	s.sweptInputs <- inp
+
+	result := make(chan sweep.Result, 1)
+	result <- sweep.Result{
+		Tx: &wire.MsgTx{},
+	}
+
+	return result, nil
+}
+
+func (s *mockSweeper) CreateSweepTx(inputs []input.Input,
+	feePref sweep.FeePreference, currentBlockHeight uint32) (*wire.MsgTx,
+	error) {
+
+	return &wire.MsgTx{}, nil
+}
+
 type mockChainIO struct{}
 
 func (*mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
@@ -172,11 +203,7 @@ func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 			spendChan: make(chan *chainntnfs.SpendDetail),
 			confChan:  make(chan *chainntnfs.TxConfirmation),
 		},
-		IncubateOutputs: func(wire.OutPoint, *lnwallet.CommitOutputResolution,
-			*lnwallet.OutgoingHtlcResolution,
-			*lnwallet.IncomingHtlcResolution, uint32) error {
-			return nil
-		},
+		Sweeper: newMockSweeper(),
 	}
 
 	// We'll use the resolvedChan to synchronize on call to
@@ -464,13 +491,15 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 		t.Fatalf("unable to create ChannelArbitrator: %v", err)
 	}
 
-	incubateChan := make(chan struct{})
-	chanArb.cfg.IncubateOutputs = func(_ wire.OutPoint,
-		_ *lnwallet.CommitOutputResolution,
-		_ *lnwallet.OutgoingHtlcResolution,
-		_ *lnwallet.IncomingHtlcResolution, _ uint32) error {
-
-		incubateChan <- struct{}{}
+	// We'll use the timeoutTxChan to be notified once the second-level
+	// timeout transaction has been broadcast.
+	timeoutTxChan := make(chan *wire.MsgTx, 1)
+	chanArb.cfg.PublishTx = func(tx *wire.MsgTx) error {
+		// The commitment transaction has no inputs within this test,
+		// so we'll only pass on the timeout transaction.
+		if len(tx.TxIn) != 0 {
+			timeoutTxChan <- tx
+		}
 
 		return nil
 	}
@@ -588,10 +617,10 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	)
 
 	// htlcOutgoingContestResolver is now active and waiting for the HTLC to
-	// expire. It should not yet have passed it on for incubation.
+	// expire. It should not yet have broadcast the timeout transaction.
 	select {
-	case <-incubateChan:
-		t.Fatalf("contract should not be incubated yet")
+	case <-timeoutTxChan:
+		t.Fatalf("timeout tx should not be broadcast yet")
 	default:
 	}
 
@@ -600,9 +629,19 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	notifier.epochChan <- &chainntnfs.BlockEpoch{Height: 10}
 
 	// htlcOutgoingContestResolver is now transforming into a
-	// htlcTimeoutResolver and should send the contract off for incubation.
+	// htlcTimeoutResolver, which should broadcast the timeout transaction
+	// once it learns of the current height.
+	select {
+	case notifier.epochChan <- &chainntnfs.BlockEpoch{Height: 10}:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout resolver didn't request block epochs")
+	}
+
 	select {
-	case <-incubateChan:
+	case tx := <-timeoutTxChan:
+		if tx != outgoingRes.SignedTimeoutTx {
+			t.Fatalf("expected timeout tx to be broadcast")
+		}
 	case <-time.After(5 * time.Second):
 		t.Fatalf("no response received")
 	}
@@ -620,8 +659,27 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	default:
 	}
 
-	// Notify resolver that the second level transaction is spent.
-	notifier.spendChan <- &chainntnfs.SpendDetail{SpendingTx: closeTx}
+	// Once the csv delay of the second-level output has expired, the
+	// resolver should offer it to the sweeper.
+	select {
+	case notifier.epochChan <- &chainntnfs.BlockEpoch{Height: 11}:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout resolver didn't request block epochs")
+	}
+
+	sweeper := chanArb.cfg.Sweeper.(*mockSweeper)
+	select {
+	case inp := <-sweeper.sweptInputs:
+		if *inp.OutPoint() != outgoingRes.ClaimOutpoint {
+			t.Fatalf("expected second-level output to be swept")
+		}
+		if inp.WitnessType() != input.HtlcOfferedTimeoutSecondLevel {
+			t.Fatalf("unexpected witness type: %v",
+				inp.WitnessType())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("second-level output not swept")
+	}
 
 	// At this point channel should be marked as resolved.
 	assertStateTransitions(t, arbLog.newStates, StateFullyResolved)
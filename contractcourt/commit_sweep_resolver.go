@@ -4,17 +4,21 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sync/atomic"
 
-	"github.com/wakiyamap/lnd/input"
-
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lnwallet"
 )
 
 // commitSweepResolver is a resolver that will attempt to sweep the commitment
-// output paying to us, in the case that the remote party broadcasts their
-// version of the commitment transaction. We can sweep this output immediately,
-// as it doesn't have a time-lock delay.
+// output paying to us. In the case that the remote party broadcasts their
+// version of the commitment transaction, we can sweep this output immediately,
+// as it doesn't have a time-lock delay. If our own commitment transaction was
+// broadcast, the output is only swept once its csv delay has expired.
 type commitSweepResolver struct {
 	// commitResolution contains all data required to successfully sweep
 	// this HTLC on-chain.
@@ -31,6 +35,13 @@ type commitSweepResolver struct {
 	// chanPoint is the channel point of the original contract.
 	chanPoint wire.OutPoint
 
+	// maturityHeight is the height at which the commitment output can be
+	// swept. It is only known once the commitment transaction has
+	// confirmed, until then it is zero.
+	//
+	// NOTE: This MUST be accessed atomically.
+	maturityHeight uint32
+
 	ResolverKit
 }
 
@@ -56,8 +67,6 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	// First, we'll register for a notification once the commitment output
 	// itself has been confirmed.
-	//
-	// TODO(roasbeef): instead sweep asap if remote commit? yeh
 	commitTXID := c.commitResolution.SelfOutPoint.Hash
 	sweepScript := c.commitResolution.SelfOutputSignDesc.Output.PkScript
 	confNtfn, err := c.Notifier.RegisterConfirmationsNtfn(
@@ -69,134 +78,124 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	log.Debugf("%T(%v): waiting for commit tx to confirm", c, c.chanPoint)
 
+	var confHeight uint32
 	select {
-	case _, ok := <-confNtfn.Confirmed:
+	case confInfo, ok := <-confNtfn.Confirmed:
 		if !ok {
 			return nil, fmt.Errorf("quitting")
 		}
+		confHeight = confInfo.BlockHeight
 
 	case <-c.Quit:
 		return nil, fmt.Errorf("quitting")
 	}
 
 	// We're dealing with our commitment transaction if the delay on the
-	// resolution isn't zero.
-	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
-
-	if !isLocalCommitTx {
-		// We'll craft an input with all the information required for
-		// the sweeper to create a fully valid sweeping transaction to
-		// recover these coins.
-		inp := input.MakeBaseInput(
-			&c.commitResolution.SelfOutPoint,
-			input.CommitmentNoDelay,
-			&c.commitResolution.SelfOutputSignDesc,
-			c.broadcastHeight,
+	// resolution isn't zero. In that case, the output can only be swept
+	// once the csv delay has expired.
+	maturityDelay := c.commitResolution.MaturityDelay
+	isLocalCommitTx := maturityDelay != 0
+
+	atomic.StoreUint32(&c.maturityHeight, confHeight+maturityDelay)
+
+	// We'll craft an input with all the information required for the
+	// sweeper to create a fully valid sweeping transaction to recover
+	// these coins.
+	var inp input.Input
+	if isLocalCommitTx {
+		log.Infof("%T(%v): waiting for csv lock to expire at height "+
+			"%v", c, c.chanPoint, confHeight+maturityDelay)
+
+		// The sweeper doesn't wait for inputs to mature, so we'll
+		// hold on to the output until it can be spent.
+		err := waitForHeight(
+			confHeight+maturityDelay, c.Notifier, c.Quit,
 		)
-
-		// With our input constructed, we'll now offer it to the
-		// sweeper.
-		log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
-
-		resultChan, err := c.Sweeper.SweepInput(&inp)
 		if err != nil {
-			log.Errorf("%T(%v): unable to sweep input: %v",
-				c, c.chanPoint, err)
-
 			return nil, err
 		}
 
-		// Sweeper is going to join this input with other inputs if
-		// possible and publish the sweep tx. When the sweep tx
-		// confirms, it signals us through the result channel with the
-		// outcome. Wait for this to happen.
-		select {
-		case sweepResult := <-resultChan:
-			if sweepResult.Err != nil {
-				log.Errorf("%T(%v): unable to sweep input: %v",
-					c, c.chanPoint, sweepResult.Err)
-
-				return nil, sweepResult.Err
-			}
-
-			log.Infof("ChannelPoint(%v) commit tx is fully resolved by "+
-				"sweep tx: %v", c.chanPoint, sweepResult.Tx.TxHash())
-		case <-c.Quit:
-			return nil, fmt.Errorf("quitting")
-		}
-
-		c.resolved = true
-		return nil, c.Checkpoint(c)
+		inp = input.NewCsvInput(
+			&c.commitResolution.SelfOutPoint,
+			input.CommitmentTimeLock,
+			&c.commitResolution.SelfOutputSignDesc,
+			c.broadcastHeight, maturityDelay,
+		)
+	} else {
+		inp = input.NewBaseInput(
+			&c.commitResolution.SelfOutPoint,
+			input.CommitmentNoDelay,
+			&c.commitResolution.SelfOutputSignDesc,
+			c.broadcastHeight,
+		)
 	}
 
-	// Otherwise we are dealing with a local commitment transaction and the
-	// output we need to sweep has been sent to the nursery for incubation.
-	// In this case, we'll wait until the commitment output has been spent.
-	spendNtfn, err := c.Notifier.RegisterSpendNtfn(
-		&c.commitResolution.SelfOutPoint,
-		c.commitResolution.SelfOutputSignDesc.Output.PkScript,
-		c.broadcastHeight,
-	)
+	// With our input constructed, we'll now offer it to the sweeper.
+	log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
+
+	resultChan, err := c.Sweeper.SweepInput(inp)
 	if err != nil {
+		log.Errorf("%T(%v): unable to sweep input: %v",
+			c, c.chanPoint, err)
+
 		return nil, err
 	}
 
-	log.Infof("%T(%v): waiting for commit output to be swept", c,
-		c.chanPoint)
-
-	var sweepTx *wire.MsgTx
+	// Sweeper is going to join this input with other inputs if possible
+	// and publish the sweep tx. When the sweep tx confirms, it signals us
+	// through the result channel with the outcome. Wait for this to
+	// happen.
+	var sweepTxID chainhash.Hash
 	select {
-	case commitSpend, ok := <-spendNtfn.Spend:
-		if !ok {
-			return nil, fmt.Errorf("quitting")
-		}
+	case sweepResult := <-resultChan:
+		if sweepResult.Err != nil {
+			log.Errorf("%T(%v): unable to sweep input: %v",
+				c, c.chanPoint, sweepResult.Err)
 
-		// Once we detect the commitment output has been spent,
-		// we'll extract the spending transaction itself, as we
-		// now consider this to be our sweep transaction.
-		sweepTx = commitSpend.SpendingTx
+			return nil, sweepResult.Err
+		}
 
-		log.Infof("%T(%v): commit output swept by txid=%v",
-			c, c.chanPoint, sweepTx.TxHash())
+		sweepTxID = sweepResult.Tx.TxHash()
 
-		if err := c.Checkpoint(c); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
-			return nil, err
-		}
+		log.Infof("ChannelPoint(%v) commit tx is fully resolved by "+
+			"sweep tx: %v", c.chanPoint, sweepTxID)
 	case <-c.Quit:
 		return nil, fmt.Errorf("quitting")
 	}
 
-	log.Infof("%T(%v): waiting for commit sweep txid=%v conf", c, c.chanPoint,
-		sweepTx.TxHash())
+	// Once the sweep transaction has confirmed, we'll mark ourselves as
+	// fully resolved and exit.
+	c.resolved = true
 
-	// Now we'll wait until the sweeping transaction has been fully
-	// confirmed.  Once it's confirmed, we can mark this contract resolved.
-	sweepTXID := sweepTx.TxHash()
-	sweepingScript := sweepTx.TxOut[0].PkScript
-	confNtfn, err = c.Notifier.RegisterConfirmationsNtfn(
-		&sweepTXID, sweepingScript, 1, c.broadcastHeight,
-	)
-	if err != nil {
-		return nil, err
+	report := &channeldb.ResolverReport{
+		OutPoint: c.commitResolution.SelfOutPoint,
+		Amount: btcutil.Amount(
+			c.commitResolution.SelfOutputSignDesc.Output.Value,
+		),
+		ResolverType:    channeldb.ResolverTypeCommit,
+		ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+		SpendTxID:       &sweepTxID,
+	}
+	if isLocalCommitTx {
+		report.WaitHeight = confHeight + maturityDelay
 	}
-	select {
-	case confInfo, ok := <-confNtfn.Confirmed:
-		if !ok {
-			return nil, fmt.Errorf("quitting")
-		}
 
-		log.Infof("ChannelPoint(%v) commit tx is fully resolved, at height: %v",
-			c.chanPoint, confInfo.BlockHeight)
+	return nil, c.Checkpoint(c, report)
+}
 
-	case <-c.Quit:
-		return nil, fmt.Errorf("quitting")
-	}
+// report returns a report on the resolution state of the contract.
+func (c *commitSweepResolver) report() *ContractReport {
+	amt := btcutil.Amount(
+		c.commitResolution.SelfOutputSignDesc.Output.Value,
+	)
 
-	// Once the transaction has received a sufficient number of
-	// confirmations, we'll mark ourselves as fully resolved and exit.
-	c.resolved = true
-	return nil, c.Checkpoint(c)
+	return &ContractReport{
+		Outpoint:       c.commitResolution.SelfOutPoint,
+		Type:           ReportOutputUnencumbered,
+		Amount:         amt,
+		MaturityHeight: atomic.LoadUint32(&c.maturityHeight),
+		LimboBalance:   amt,
+	}
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -286,5 +285,5 @@ func (c *commitSweepResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure commitSweepResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*commitSweepResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*commitSweepResolver)(nil)
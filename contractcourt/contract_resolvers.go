@@ -2,19 +2,17 @@ package contractcourt
 
 import (
 	"encoding/binary"
+	"fmt"
 	"io"
+
+	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
 )
 
 var (
 	endian = binary.BigEndian
 )
 
-const (
-	// sweepConfTarget is the default number of blocks that we'll use as a
-	// confirmation target when sweeping.
-	sweepConfTarget = 6
-)
-
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Bitcoin
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
@@ -78,8 +76,40 @@ type ResolverKit struct {
 
 	// Checkpoint allows a resolver to check point its state. This function
 	// should write the state of the resolver to persistent storage, and
-	// return a non-nil error upon success.
-	Checkpoint func(ContractResolver) error
+	// return a non-nil error upon success. Any passed reports describing
+	// the outcome of the resolution are stored along with the state.
+	Checkpoint func(ContractResolver, ...*channeldb.ResolverReport) error
 
 	Quit chan struct{}
 }
+
+// waitForHeight blocks until the tip of the main chain has reached the passed
+// height, or the quit channel is closed.
+func waitForHeight(height uint32, notifier chainntnfs.ChainNotifier,
+	quit <-chan struct{}) error {
+
+	// As we don't pass in a best block, the notifier will dispatch the
+	// current tip right away, so we'll return immediately if the target
+	// height has already been reached.
+	blockEpochs, err := notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case newBlock, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return fmt.Errorf("quitting")
+			}
+
+			if uint32(newBlock.Height) >= height {
+				return nil
+			}
+
+		case <-quit:
+			return fmt.Errorf("quitting")
+		}
+	}
+}
@@ -68,8 +68,7 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		log.Infof("%T(%v): HTLC has timed out (expiry=%v, height=%v), "+
 			"abandoning", h, h.htlcResolution.ClaimOutpoint,
 			h.htlcExpiry, currentHeight)
-		h.resolved = true
-		return nil, h.Checkpoint(h)
+		return nil, h.abandon()
 	}
 
 	// tryApplyPreimage is a helper function that will populate our internal
@@ -86,6 +85,10 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		// Update htlcResolution with the matching preimage.
 		h.htlcResolution.Preimage = preimage
 
+		// The inner resolver must claim the HTLC before the remote
+		// party is able to time it out.
+		h.deadlineHeight = h.htlcExpiry
+
 		log.Infof("%T(%v): extracted preimage=%v from beacon!", h,
 			h.htlcResolution.ClaimOutpoint, preimage)
 
@@ -196,8 +199,7 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 					"(expiry=%v, height=%v), abandoning", h,
 					h.htlcResolution.ClaimOutpoint,
 					h.htlcExpiry, currentHeight)
-				h.resolved = true
-				return nil, h.Checkpoint(h)
+				return nil, h.abandon()
 			}
 
 		case <-h.Quit:
@@ -206,6 +208,29 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 	}
 }
 
+// abandon marks the resolver as resolved once the HTLC has expired without us
+// learning the preimage, and records that the HTLC was abandoned.
+func (h *htlcIncomingContestResolver) abandon() error {
+	h.resolved = true
+
+	// If this is our commitment, the HTLC output on the commitment
+	// transaction is the one spent by the success transaction.
+	outpoint := h.htlcResolution.ClaimOutpoint
+	if h.htlcResolution.SignedSuccessTx != nil {
+		outpoint = h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
+	}
+
+	report := &channeldb.ResolverReport{
+		OutPoint:        outpoint,
+		Amount:          h.htlcAmt.ToSatoshis(),
+		ResolverType:    channeldb.ResolverTypeIncomingHtlc,
+		ResolverOutcome: channeldb.ResolverOutcomeAbandoned,
+		WaitHeight:      h.htlcExpiry,
+	}
+
+	return h.Checkpoint(h, report)
+}
+
 // report returns a report on the resolution state of the contract.
 func (h *htlcIncomingContestResolver) report() *ContractReport {
 	// No locking needed as these values are read-only.
@@ -219,7 +244,7 @@ func (h *htlcIncomingContestResolver) report() *ContractReport {
 
 	return &ContractReport{
 		Outpoint:       h.htlcResolution.ClaimOutpoint,
-		Incoming:       true,
+		Type:           ReportOutputIncomingHtlc,
 		Amount:         finalAmt,
 		MaturityHeight: h.htlcExpiry,
 		LimboBalance:   finalAmt,
@@ -4,8 +4,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sync/atomic"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/input"
@@ -18,9 +21,9 @@ import (
 // htlcSuccessResolver is a resolver that's capable of sweeping an incoming
 // HTLC output on-chain. If this is the remote party's commitment, we'll sweep
 // it directly from the commitment output *immediately*. If this is our
-// commitment, we'll first broadcast the success transaction, then send it to
-// the incubator for sweeping. That's it, no need to send any clean up
-// messages.
+// commitment, we'll first broadcast the success transaction, then sweep its
+// output once the csv delay has expired. That's it, no need to send any clean
+// up messages.
 //
 // TODO(roasbeef): don't need to broadcast?
 type htlcSuccessResolver struct {
@@ -28,8 +31,8 @@ type htlcSuccessResolver struct {
 	// contains everything we need to properly resolve this HTLC.
 	htlcResolution lnwallet.IncomingHtlcResolution
 
-	// outputIncubating returns true if we've sent the output to the output
-	// incubator (utxo nursery).
+	// outputIncubating returns true if we've broadcast the second-level
+	// success transaction.
 	outputIncubating bool
 
 	// resolved reflects if the contract has been fully resolved or not.
@@ -43,17 +46,28 @@ type htlcSuccessResolver struct {
 	// payHash is the payment hash of the original HTLC extended to us.
 	payHash lntypes.Hash
 
-	// sweepTx will be non-nil if we've already crafted a transaction to
-	// sweep a direct HTLC output. This is only a concern if we're sweeping
-	// from the commitment transaction of the remote party.
-	//
-	// TODO(roasbeef): send off to utxobundler
-	sweepTx *wire.MsgTx
-
 	// htlcAmt is the original amount of the htlc, not taking into
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliSatoshi
 
+	// deadlineHeight is the height before which the HTLC output must be
+	// claimed. From this height on, the remote party is able to time out
+	// the HTLC. A value of zero indicates that the deadline isn't known.
+	deadlineHeight uint32
+
+	// htlcClaimed is set to 1 once the HTLC output on the commitment
+	// transaction has been spent by our success or sweep transaction.
+	//
+	// NOTE: This MUST be accessed atomically.
+	htlcClaimed uint32
+
+	// secondLevelMaturity is the height at which the output of the
+	// confirmed second-level success transaction can be swept. It is zero
+	// as long as the second-level transaction hasn't confirmed.
+	//
+	// NOTE: This MUST be accessed atomically.
+	secondLevelMaturity uint32
+
 	ResolverKit
 }
 
@@ -79,8 +93,8 @@ func (h *htlcSuccessResolver) ResolverKey() []byte {
 
 // Resolve attempts to resolve an unresolved incoming HTLC that we know the
 // preimage to. If the HTLC is on the commitment of the remote party, then
-// we'll simply sweep it directly. Otherwise, we'll hand this off to the utxo
-// nursery to do its duty.
+// we'll simply sweep it directly. Otherwise, we'll broadcast the second-level
+// success transaction, and sweep its output once the csv delay has expired.
 //
 // TODO(roasbeef): create multi to batch
 //
@@ -94,89 +108,71 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// If we don't have a success transaction, then this means that this is
 	// an output on the remote party's commitment transaction.
 	if h.htlcResolution.SignedSuccessTx == nil {
-		// If we don't already have the sweep transaction constructed,
-		// we'll do so and broadcast it.
-		if h.sweepTx == nil {
-			log.Infof("%T(%x): crafting sweep tx for "+
-				"incoming+remote htlc confirmed", h,
-				h.payHash[:])
-
-			// Before we can craft out sweeping transaction, we
-			// need to create an input which contains all the items
-			// required to add this input to a sweeping transaction,
-			// and generate a witness.
-			inp := input.MakeHtlcSucceedInput(
-				&h.htlcResolution.ClaimOutpoint,
-				&h.htlcResolution.SweepSignDesc,
-				h.htlcResolution.Preimage[:],
-				h.broadcastHeight,
-			)
-
-			// With the input created, we can now generate the full
-			// sweep transaction, that we'll use to move these
-			// coins back into the backing wallet.
-			//
-			// TODO: Set tx lock time to current block height
-			// instead of zero. Will be taken care of once sweeper
-			// implementation is complete.
-			//
-			// TODO: Use time-based sweeper and result chan.
-			var err error
-			h.sweepTx, err = h.Sweeper.CreateSweepTx(
-				[]input.Input{&inp},
-				sweep.FeePreference{
-					ConfTarget: sweepConfTarget,
-				}, 0,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			log.Infof("%T(%x): crafted sweep tx=%v", h,
-				h.payHash[:], spew.Sdump(h.sweepTx))
-
-			// With the sweep transaction signed, we'll now
-			// Checkpoint our state.
-			if err := h.Checkpoint(h); err != nil {
-				log.Errorf("unable to Checkpoint: %v", err)
-				return nil, err
-			}
-		}
-
-		// Regardless of whether an existing transaction was found or newly
-		// constructed, we'll broadcast the sweep transaction to the
-		// network.
-		err := h.PublishTx(h.sweepTx)
-		if err != nil {
-			log.Infof("%T(%x): unable to publish tx: %v",
-				h, h.payHash[:], err)
-			return nil, err
-		}
-
-		// With the sweep transaction broadcast, we'll wait for its
-		// confirmation.
-		sweepTXID := h.sweepTx.TxHash()
-		sweepScript := h.sweepTx.TxOut[0].PkScript
-		confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
-			&sweepTXID, sweepScript, 1, h.broadcastHeight,
+		log.Infof("%T(%x): offering incoming+remote htlc output to "+
+			"sweeper with deadline %v", h, h.payHash[:],
+			h.deadlineHeight)
+
+		// Before we can offer the output to the sweeper, we need to
+		// create an input which contains all the items required to
+		// add this input to a sweeping transaction, and generate a
+		// witness. The HTLC must be claimed before the remote party is
+		// able to time it out, so we'll pass along its expiry as the
+		// deadline of the input.
+		inp := input.MakeHtlcSucceedInput(
+			&h.htlcResolution.ClaimOutpoint,
+			&h.htlcResolution.SweepSignDesc,
+			h.htlcResolution.Preimage[:],
+			h.broadcastHeight,
 		)
+		inp.SetDeadlineHeight(h.deadlineHeight)
+
+		resultChan, err := h.Sweeper.SweepInput(&inp)
 		if err != nil {
 			return nil, err
 		}
 
-		log.Infof("%T(%x): waiting for sweep tx (txid=%v) to be "+
-			"confirmed", h, h.payHash[:], sweepTXID)
-
+		var sweepTxID chainhash.Hash
 		select {
-		case _, ok := <-confNtfn.Confirmed:
-			if !ok {
-				return nil, fmt.Errorf("quitting")
+		case sweepResult := <-resultChan:
+			switch {
+			// If the remote party swept the output, then they were
+			// able to time out the HTLC before our sweep confirmed.
+			// There's nothing left for us to do.
+			case sweepResult.Err == sweep.ErrRemoteSpend:
+				log.Errorf("%T(%x): htlc output timed out by "+
+					"remote party in tx=%v", h,
+					h.payHash[:], sweepResult.Tx.TxHash())
+
+				h.resolved = true
+
+				spendTxID := sweepResult.Tx.TxHash()
+				report := &channeldb.ResolverReport{
+					OutPoint:        h.htlcResolution.ClaimOutpoint,
+					Amount:          h.htlcAmt.ToSatoshis(),
+					ResolverType:    channeldb.ResolverTypeIncomingHtlc,
+					ResolverOutcome: channeldb.ResolverOutcomeTimeout,
+					SpendTxID:       &spendTxID,
+					WaitHeight:      h.deadlineHeight,
+				}
+
+				return nil, h.Checkpoint(h, report)
+
+			case sweepResult.Err != nil:
+				return nil, fmt.Errorf("unable to sweep HTLC "+
+					"output: %v", sweepResult.Err)
 			}
 
+			sweepTxID = sweepResult.Tx.TxHash()
+
+			log.Infof("%T(%x): htlc output swept by tx=%v", h,
+				h.payHash[:], sweepTxID)
+
 		case <-h.Quit:
 			return nil, fmt.Errorf("quitting")
 		}
 
+		atomic.StoreUint32(&h.htlcClaimed, 1)
+
 		// With the HTLC claimed, we can attempt to settle its
 		// corresponding invoice if we were the original destination. As
 		// the htlc is already settled at this point, we don't need to
@@ -193,7 +189,16 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		// Once the transaction has received a sufficient number of
 		// confirmations, we'll mark ourselves as fully resolved and exit.
 		h.resolved = true
-		return nil, h.Checkpoint(h)
+
+		report := &channeldb.ResolverReport{
+			OutPoint:        h.htlcResolution.ClaimOutpoint,
+			Amount:          h.htlcAmt.ToSatoshis(),
+			ResolverType:    channeldb.ResolverTypeIncomingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &sweepTxID,
+		}
+
+		return nil, h.Checkpoint(h, report)
 	}
 
 	log.Infof("%T(%x): broadcasting second-layer transition tx: %v",
@@ -208,21 +213,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		return nil, err
 	}
 
-	// Otherwise, this is an output on our commitment transaction. In this
-	// case, we'll send it to the incubator, but only if we haven't already
-	// done so.
 	if !h.outputIncubating {
-		log.Infof("%T(%x): incubating incoming htlc output",
-			h, h.payHash[:])
-
-		err := h.IncubateOutputs(
-			h.ChanPoint, nil, nil, &h.htlcResolution,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return nil, err
-		}
-
 		h.outputIncubating = true
 
 		if err := h.Checkpoint(h); err != nil {
@@ -231,25 +222,73 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		}
 	}
 
-	// To wrap this up, we'll wait until the second-level transaction has
-	// been spent, then fully resolve the contract.
-	spendNtfn, err := h.Notifier.RegisterSpendNtfn(
-		&h.htlcResolution.ClaimOutpoint,
-		h.htlcResolution.SweepSignDesc.Output.PkScript,
+	// Otherwise, this is an output on our commitment transaction. In this
+	// case, we'll wait for the second-level transaction to confirm, after
+	// which its output is locked for the csv delay.
+	successTx := h.htlcResolution.SignedSuccessTx
+	successTXID := successTx.TxHash()
+	confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
+		&successTXID, successTx.TxOut[0].PkScript, 1,
 		h.broadcastHeight,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Infof("%T(%x): waiting for second-level HTLC output to be spent "+
-		"after csv_delay=%v", h, h.payHash[:], h.htlcResolution.CsvDelay)
+	log.Infof("%T(%x): waiting for second-layer transition tx (txid=%v) "+
+		"to be confirmed", h, h.payHash[:], successTXID)
 
+	var confHeight uint32
 	select {
-	case _, ok := <-spendNtfn.Spend:
+	case confInfo, ok := <-confNtfn.Confirmed:
 		if !ok {
 			return nil, fmt.Errorf("quitting")
 		}
+		confHeight = confInfo.BlockHeight
+
+	case <-h.Quit:
+		return nil, fmt.Errorf("quitting")
+	}
+
+	maturityHeight := confHeight + h.htlcResolution.CsvDelay
+
+	atomic.StoreUint32(&h.htlcClaimed, 1)
+	atomic.StoreUint32(&h.secondLevelMaturity, maturityHeight)
+
+	log.Infof("%T(%x): waiting for csv_delay=%v of second-level HTLC "+
+		"output to expire at height %v", h, h.payHash[:],
+		h.htlcResolution.CsvDelay, maturityHeight)
+
+	err = waitForHeight(maturityHeight, h.Notifier, h.Quit)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the csv delay expired, we can now offer the second-level output
+	// to the sweeper, and wait for it to be swept.
+	inp := input.NewCsvInput(
+		&h.htlcResolution.ClaimOutpoint,
+		input.HtlcAcceptedSuccessSecondLevel,
+		&h.htlcResolution.SweepSignDesc,
+		h.broadcastHeight, h.htlcResolution.CsvDelay,
+	)
+	resultChan, err := h.Sweeper.SweepInput(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	var sweepTxID chainhash.Hash
+	select {
+	case sweepResult := <-resultChan:
+		if sweepResult.Err != nil {
+			return nil, fmt.Errorf("unable to sweep second-level "+
+				"HTLC output: %v", sweepResult.Err)
+		}
+
+		sweepTxID = sweepResult.Tx.TxHash()
+
+		log.Infof("%T(%x): second-level HTLC output swept by tx=%v",
+			h, h.payHash[:], sweepTxID)
 
 	case <-h.Quit:
 		return nil, fmt.Errorf("quitting")
@@ -266,8 +305,63 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 			"hash %x: %v", h.payHash, err)
 	}
 
+	// Finally, we'll mark ourselves as resolved, and record both stages
+	// of the resolution.
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+
+	reports := []*channeldb.ResolverReport{
+		{
+			OutPoint:        successTx.TxIn[0].PreviousOutPoint,
+			Amount:          h.htlcAmt.ToSatoshis(),
+			ResolverType:    channeldb.ResolverTypeIncomingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeFirstStage,
+			SpendTxID:       &successTXID,
+		},
+		{
+			OutPoint:        h.htlcResolution.ClaimOutpoint,
+			Amount:          btcutil.Amount(successTx.TxOut[0].Value),
+			ResolverType:    channeldb.ResolverTypeIncomingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &sweepTxID,
+			WaitHeight:      maturityHeight,
+		},
+	}
+
+	return nil, h.Checkpoint(h, reports...)
+}
+
+// report returns a report on the resolution state of the contract.
+func (h *htlcSuccessResolver) report() *ContractReport {
+	finalAmt := h.htlcAmt.ToSatoshis()
+	if h.htlcResolution.SignedSuccessTx != nil {
+		finalAmt = btcutil.Amount(
+			h.htlcResolution.SignedSuccessTx.TxOut[0].Value,
+		)
+	}
+
+	// Until the second-level transaction has confirmed, the maturity
+	// height of the HTLC isn't known yet.
+	report := &ContractReport{
+		Outpoint:     h.htlcResolution.ClaimOutpoint,
+		Type:         ReportOutputIncomingHtlc,
+		Amount:       finalAmt,
+		LimboBalance: finalAmt,
+		Stage:        1,
+	}
+
+	// As long as the HTLC output itself hasn't been claimed, the remote
+	// party may still be able to time it out.
+	if atomic.LoadUint32(&h.htlcClaimed) == 0 {
+		report.DeadlineHeight = h.deadlineHeight
+	}
+
+	secondLevelMaturity := atomic.LoadUint32(&h.secondLevelMaturity)
+	if secondLevelMaturity != 0 {
+		report.MaturityHeight = secondLevelMaturity
+		report.Stage = 2
+	}
+
+	return report
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -310,6 +404,9 @@ func (h *htlcSuccessResolver) Encode(w io.Writer) error {
 	if _, err := w.Write(h.payHash[:]); err != nil {
 		return err
 	}
+	if err := binary.Write(w, endian, h.deadlineHeight); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -339,6 +436,13 @@ func (h *htlcSuccessResolver) Decode(r io.Reader) error {
 		return err
 	}
 
+	// Resolvers that were stored before deadlines were tracked end here.
+	// Their HTLCs will be swept without a deadline.
+	err := binary.Read(r, endian, &h.deadlineHeight)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -352,5 +456,5 @@ func (h *htlcSuccessResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure htlcSuccessResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*htlcSuccessResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*htlcSuccessResolver)(nil)
@@ -4,10 +4,14 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sync/atomic"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lntypes"
 	"github.com/wakiyamap/lnd/lnwallet"
@@ -17,17 +21,17 @@ import (
 // htlcTimeoutResolver is a ContractResolver that's capable of resolving an
 // outgoing HTLC. The HTLC may be on our commitment transaction, or on the
 // commitment transaction of the remote party. An output on our commitment
-// transaction is considered fully resolved once the second-level transaction
-// has been confirmed (and reached a sufficient depth). An output on the
-// commitment transaction of the remote party is resolved once we detect a
-// spend of the direct HTLC output using the timeout clause.
+// transaction is considered fully resolved once the output of the second-level
+// transaction has been swept back into the wallet after its csv delay. An
+// output on the commitment transaction of the remote party is resolved once
+// we detect a spend of the direct HTLC output using the timeout clause.
 type htlcTimeoutResolver struct {
 	// htlcResolution contains all the information required to properly
 	// resolve this outgoing HTLC.
 	htlcResolution lnwallet.OutgoingHtlcResolution
 
-	// outputIncubating returns true if we've sent the output to the output
-	// incubator (utxo nursery).
+	// outputIncubating returns true if we've broadcast the second-level
+	// timeout transaction, or offered the timed out output to the sweeper.
 	outputIncubating bool
 
 	// resolved reflects if the contract has been fully resolved or not.
@@ -48,6 +52,13 @@ type htlcTimeoutResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliSatoshi
 
+	// secondLevelMaturity is the height at which the output of the
+	// confirmed second-level timeout transaction can be swept. It is zero
+	// as long as the second-level transaction hasn't confirmed.
+	//
+	// NOTE: This MUST be accessed atomically.
+	secondLevelMaturity uint32
+
 	ResolverKit
 }
 
@@ -148,7 +159,22 @@ func (h *htlcTimeoutResolver) claimCleanUp(
 		return nil, err
 	}
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+
+	// The remote party claimed the HTLC, so we'll record that the HTLC
+	// was lost to them.
+	outpoint, _, err := h.chainDetailsToWatch()
+	if err != nil {
+		return nil, err
+	}
+	report := &channeldb.ResolverReport{
+		OutPoint:        *outpoint,
+		Amount:          h.htlcAmt.ToSatoshis(),
+		ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+		ResolverOutcome: channeldb.ResolverOutcomeTimeout,
+		SpendTxID:       commitSpend.SpenderTxHash,
+	}
+
+	return nil, h.Checkpoint(h, report)
 }
 
 // chainDetailsToWatch returns the output and script which we use to watch for
@@ -219,10 +245,13 @@ func isSuccessSpend(spend *chainntnfs.SpendDetail, localCommit bool) bool {
 	return len(spendingWitness[localPreimageIndex]) == lntypes.HashSize
 }
 
-// Resolve kicks off full resolution of an outgoing HTLC output. If it's our
-// commitment, it isn't resolved until we see the second level HTLC txn
-// confirmed. If it's the remote party's commitment, we don't resolve until we
-// see a direct sweep via the timeout clause.
+// Resolve kicks off full resolution of an outgoing HTLC output. Once the HTLC
+// has expired, we'll either broadcast our second-level timeout transaction if
+// it's our commitment, or offer the output to the sweeper if it's the remote
+// party's commitment. If it's our commitment, it isn't resolved until the
+// output of the second level HTLC txn has been swept after its csv delay. If
+// it's the remote party's commitment, we don't resolve until we see a direct
+// sweep via the timeout clause.
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
@@ -231,60 +260,10 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		return nil, nil
 	}
 
-	// If we haven't already sent the output to the utxo nursery, then
-	// we'll do so now.
-	if !h.outputIncubating {
-		log.Tracef("%T(%v): incubating htlc output", h,
-			h.htlcResolution.ClaimOutpoint)
-
-		err := h.IncubateOutputs(
-			h.ChanPoint, nil, &h.htlcResolution, nil,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		h.outputIncubating = true
-
-		if err := h.Checkpoint(h); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
-			return nil, err
-		}
-	}
-
-	// waitForOutputResolution waits for the HTLC output to be fully
-	// resolved. The output is considered fully resolved once it has been
-	// spent, and the spending transaction has been fully confirmed.
-	waitForOutputResolution := func() error {
-		// We first need to register to see when the HTLC output itself
-		// has been spent by a confirmed transaction.
-		spendNtfn, err := h.Notifier.RegisterSpendNtfn(
-			&h.htlcResolution.ClaimOutpoint,
-			h.htlcResolution.SweepSignDesc.Output.PkScript,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return err
-		}
-
-		select {
-		case _, ok := <-spendNtfn.Spend:
-			if !ok {
-				return fmt.Errorf("notifier quit")
-			}
-
-		case <-h.Quit:
-			return fmt.Errorf("quitting")
-		}
-
-		return nil
-	}
-
-	// Now that we've handed off the HTLC to the nursery, we'll watch for a
-	// spend of the output, and make our next move off of that. Depending
-	// on if this is our commitment, or the remote party's commitment,
-	// we'll be watching a different outpoint and script.
+	// We'll watch for a spend of the output, and make our next move off
+	// of that. Depending on if this is our commitment, or the remote
+	// party's commitment, we'll be watching a different outpoint and
+	// script.
 	outpointToWatch, scriptToWatch, err := h.chainDetailsToWatch()
 	if err != nil {
 		return nil, err
@@ -296,24 +275,58 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		return nil, err
 	}
 
+	// We'll also need to know when the HTLC expires, as we can only time
+	// out the HTLC from that point on.
+	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer blockEpochs.Cancel()
+
 	log.Infof("%T(%v): waiting for HTLC output %v to be spent"+
 		"fully confirmed", h, h.htlcResolution.ClaimOutpoint,
 		outpointToWatch)
 
 	// We'll block here until either we exit, or the HTLC output on the
-	// commitment transaction has been spent.
+	// commitment transaction has been spent. As the remote party may
+	// still sweep the HTLC with the preimage, we'll keep watching for a
+	// spend after the HTLC has been timed out.
+	//
+	// NOTE: Even if we've already broadcast the timeout transaction or
+	// offered the output to the sweeper before a restart, we'll do so
+	// again as neither is persisted across restarts. The same goes for
+	// outputs we handed to the utxo nursery before it was removed, as
+	// their incubation wasn't taken over by the sweeper.
 	var (
-		spend *chainntnfs.SpendDetail
-		ok    bool
+		spend    *chainntnfs.SpendDetail
+		timedOut bool
 	)
-	select {
-	case spend, ok = <-spendNtfn.Spend:
-		if !ok {
+	for spend == nil {
+		select {
+		case newBlock, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+
+			newHeight := uint32(newBlock.Height)
+			if timedOut || newHeight < h.htlcResolution.Expiry {
+				continue
+			}
+
+			if err := h.timeoutHtlc(); err != nil {
+				return nil, err
+			}
+			timedOut = true
+
+		case commitSpend, ok := <-spendNtfn.Spend:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+			spend = commitSpend
+
+		case <-h.Quit:
 			return nil, fmt.Errorf("quitting")
 		}
-
-	case <-h.Quit:
-		return nil, fmt.Errorf("quitting")
 	}
 
 	// If the spend reveals the pre-image, then we'll enter the clean up
@@ -322,7 +335,7 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	if isSuccessSpend(spend, h.htlcResolution.SignedTimeoutTx != nil) {
 		log.Infof("%T(%v): HTLC has been swept with pre-image by "+
 			"remote party during timeout flow! Adding pre-image to "+
-			"witness cache", h.htlcResolution.ClaimOutpoint)
+			"witness cache", h, h.htlcResolution.ClaimOutpoint)
 
 		return h.claimCleanUp(spend)
 	}
@@ -343,21 +356,189 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		return nil, err
 	}
 
-	// Finally, if this was an output on our commitment transaction, we'll
-	// wait for the second-level HTLC output to be spent, and for that
-	// transaction itself to confirm.
-	if h.htlcResolution.SignedTimeoutTx != nil {
-		log.Infof("%T(%v): waiting for nursery to spend CSV delayed "+
-			"output", h, h.htlcResolution.ClaimOutpoint)
-		if err := waitForOutputResolution(); err != nil {
-			return nil, err
+	// If this was an output on the remote party's commitment, the HTLC
+	// was swept directly back into our wallet.
+	if h.htlcResolution.SignedTimeoutTx == nil {
+		h.resolved = true
+
+		report := &channeldb.ResolverReport{
+			OutPoint:        h.htlcResolution.ClaimOutpoint,
+			Amount:          h.htlcAmt.ToSatoshis(),
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       spend.SpenderTxHash,
+			WaitHeight:      h.htlcResolution.Expiry,
 		}
+
+		return nil, h.Checkpoint(h, report)
+	}
+
+	// Otherwise, this was an output on our commitment transaction, so
+	// we'll sweep the output of the second-level transaction once its csv
+	// delay has expired.
+	sweepTxID, err := h.sweepSecondLevelOutput(
+		uint32(spend.SpendingHeight),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	// With the clean up message sent, we'll now mark the contract
-	// resolved, and wait.
+	// With the second-level output swept, we'll now mark the contract
+	// resolved, and record both stages of the resolution.
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+
+	timeoutTx := h.htlcResolution.SignedTimeoutTx
+	timeoutTxID := timeoutTx.TxHash()
+	reports := []*channeldb.ResolverReport{
+		{
+			OutPoint:        *outpointToWatch,
+			Amount:          h.htlcAmt.ToSatoshis(),
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeFirstStage,
+			SpendTxID:       &timeoutTxID,
+			WaitHeight:      h.htlcResolution.Expiry,
+		},
+		{
+			OutPoint:        h.htlcResolution.ClaimOutpoint,
+			Amount:          btcutil.Amount(timeoutTx.TxOut[0].Value),
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       sweepTxID,
+			WaitHeight:      atomic.LoadUint32(&h.secondLevelMaturity),
+		},
+	}
+
+	return nil, h.Checkpoint(h, reports...)
+}
+
+// timeoutHtlc is called once the HTLC has expired. If this is our commitment,
+// the second-level timeout transaction is broadcast. Otherwise, the HTLC
+// output is offered to the sweeper, which will sweep it using the timeout
+// clause.
+func (h *htlcTimeoutResolver) timeoutHtlc() error {
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		log.Infof("%T(%v): broadcasting second-level timeout tx=%v",
+			h, h.htlcResolution.ClaimOutpoint,
+			h.htlcResolution.SignedTimeoutTx.TxHash())
+
+		// If the remote party already swept the HTLC with the
+		// preimage, the timeout transaction is a double spend. The
+		// spend will be picked up by our spend notification, so we
+		// can safely ignore the error.
+		err := h.PublishTx(h.htlcResolution.SignedTimeoutTx)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			return err
+		}
+	} else {
+		log.Infof("%T(%v): sweeping timed out htlc output", h,
+			h.htlcResolution.ClaimOutpoint)
+
+		// The sweep result is ignored, as we'll learn of the sweep
+		// through our spend notification.
+		inp := input.NewBaseInput(
+			&h.htlcResolution.ClaimOutpoint,
+			input.HtlcOfferedRemoteTimeout,
+			&h.htlcResolution.SweepSignDesc,
+			h.broadcastHeight,
+		)
+		if _, err := h.Sweeper.SweepInput(inp); err != nil {
+			return err
+		}
+	}
+
+	if h.outputIncubating {
+		return nil
+	}
+	h.outputIncubating = true
+
+	return h.Checkpoint(h)
+}
+
+// sweepSecondLevelOutput waits for the csv delay on the output of our
+// second-level timeout transaction, that confirmed at the passed height, to
+// expire and then sweeps it back into the wallet. The hash of the sweep
+// transaction is returned.
+func (h *htlcTimeoutResolver) sweepSecondLevelOutput(
+	confHeight uint32) (*chainhash.Hash, error) {
+
+	maturityHeight := confHeight + h.htlcResolution.CsvDelay
+
+	atomic.StoreUint32(&h.secondLevelMaturity, maturityHeight)
+
+	log.Infof("%T(%v): waiting for csv lock of second-level output to "+
+		"expire at height %v", h, h.htlcResolution.ClaimOutpoint,
+		maturityHeight)
+
+	err := waitForHeight(maturityHeight, h.Notifier, h.Quit)
+	if err != nil {
+		return nil, err
+	}
+
+	inp := input.NewCsvInput(
+		&h.htlcResolution.ClaimOutpoint,
+		input.HtlcOfferedTimeoutSecondLevel,
+		&h.htlcResolution.SweepSignDesc,
+		h.broadcastHeight, h.htlcResolution.CsvDelay,
+	)
+
+	log.Infof("%T(%v): sweeping second-level output", h,
+		h.htlcResolution.ClaimOutpoint)
+
+	resultChan, err := h.Sweeper.SweepInput(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case sweepResult := <-resultChan:
+		if sweepResult.Err != nil {
+			return nil, fmt.Errorf("unable to sweep second-level "+
+				"output: %v", sweepResult.Err)
+		}
+
+		sweepTxID := sweepResult.Tx.TxHash()
+
+		log.Infof("%T(%v): second-level output swept by tx=%v", h,
+			h.htlcResolution.ClaimOutpoint, sweepTxID)
+
+		return &sweepTxID, nil
+
+	case <-h.Quit:
+		return nil, fmt.Errorf("quitting")
+	}
+}
+
+// report returns a report on the resolution state of the contract.
+func (h *htlcTimeoutResolver) report() *ContractReport {
+	finalAmt := h.htlcAmt.ToSatoshis()
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		finalAmt = btcutil.Amount(
+			h.htlcResolution.SignedTimeoutTx.TxOut[0].Value,
+		)
+	}
+
+	// Until the second-level transaction has confirmed, the HTLC is in
+	// the CLTV-timeout stage.
+	secondLevelMaturity := atomic.LoadUint32(&h.secondLevelMaturity)
+	if secondLevelMaturity == 0 {
+		return &ContractReport{
+			Outpoint:       h.htlcResolution.ClaimOutpoint,
+			Type:           ReportOutputOutgoingHtlc,
+			Amount:         finalAmt,
+			MaturityHeight: h.htlcResolution.Expiry,
+			LimboBalance:   finalAmt,
+			Stage:          1,
+		}
+	}
+
+	return &ContractReport{
+		Outpoint:       h.htlcResolution.ClaimOutpoint,
+		Type:           ReportOutputOutgoingHtlc,
+		Amount:         finalAmt,
+		MaturityHeight: secondLevelMaturity,
+		LimboBalance:   finalAmt,
+		Stage:          2,
+	}
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -446,5 +627,5 @@ func (h *htlcTimeoutResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure htlcTimeoutResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*htlcTimeoutResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*htlcTimeoutResolver)(nil)
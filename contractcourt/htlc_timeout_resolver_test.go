@@ -9,9 +9,9 @@ import (
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/lntypes"
-	"github.com/wakiyamap/lnd/lnwallet"
 )
 
 type mockSigner struct {
@@ -74,6 +74,11 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				Witness:          [][]byte{{0x01}},
 			},
 		},
+		TxOut: []*wire.TxOut{
+			{
+				Value: 1000,
+			},
+		},
 	}
 	fakeTimeout := int32(5)
 
@@ -103,6 +108,10 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		// can use this to customize the witness used when spending to
 		// trigger various redemption cases.
 		txToBroadcast func() (*wire.MsgTx, error)
+
+		// outcomes are the outcomes of the resolver reports we expect
+		// to be written once the resolver has finished.
+		outcomes []channeldb.ResolverOutcome
 	}{
 		// Remote commitment is broadcast, we time out the HTLC on
 		// chain, and should expect a fail HTLC resolution.
@@ -122,6 +131,9 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcomes: []channeldb.ResolverOutcome{
+				channeldb.ResolverOutcomeClaimed,
+			},
 		},
 
 		// Our local commitment is broadcast, we timeout the HTLC and
@@ -141,6 +153,10 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcomes: []channeldb.ResolverOutcome{
+				channeldb.ResolverOutcomeFirstStage,
+				channeldb.ResolverOutcomeClaimed,
+			},
 		},
 
 		// The remote commitment is broadcast, they sweep with the
@@ -161,6 +177,9 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcomes: []channeldb.ResolverOutcome{
+				channeldb.ResolverOutcomeTimeout,
+			},
 		},
 
 		// The local commitment is broadcast, they sweep it with a
@@ -182,6 +201,9 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcomes: []channeldb.ResolverOutcome{
+				channeldb.ResolverOutcomeTimeout,
+			},
 		},
 	}
 
@@ -198,23 +220,20 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Logf("Running test case: %v", testCase.name)
 
-		checkPointChan := make(chan struct{}, 1)
-		incubateChan := make(chan struct{}, 1)
+		checkPointChan := make(chan []*channeldb.ResolverReport, 1)
+		publishChan := make(chan *wire.MsgTx, 1)
 		resolutionChan := make(chan ResolutionMsg, 1)
+		sweeper := newMockSweeper()
 
 		chainCfg := ChannelArbitratorConfig{
 			ChainArbitratorConfig: ChainArbitratorConfig{
 				Notifier:   notifier,
 				PreimageDB: witnessBeacon,
-				IncubateOutputs: func(wire.OutPoint,
-					*lnwallet.CommitOutputResolution,
-					*lnwallet.OutgoingHtlcResolution,
-					*lnwallet.IncomingHtlcResolution,
-					uint32) error {
-
-					incubateChan <- struct{}{}
+				PublishTx: func(tx *wire.MsgTx) error {
+					publishChan <- tx
 					return nil
 				},
+				Sweeper: sweeper,
 				DeliverResolutionMsg: func(msgs ...ResolutionMsg) error {
 					if len(msgs) != 1 {
 						return fmt.Errorf("expected 1 "+
@@ -231,8 +250,10 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		resolver := &htlcTimeoutResolver{
 			ResolverKit: ResolverKit{
 				ChannelArbitratorConfig: chainCfg,
-				Checkpoint: func(_ ContractResolver) error {
-					checkPointChan <- struct{}{}
+				Checkpoint: func(_ ContractResolver,
+					reports ...*channeldb.ResolverReport) error {
+
+					checkPointChan <- reports
 					return nil
 				},
 			},
@@ -260,14 +281,48 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 			}
 		}()
 
-		// At the output isn't yet in the nursery, we expect that we
-		// should receive an incubation request.
+		// Once the HTLC expires, we expect the resolver to broadcast
+		// the timeout transaction if this is our commitment, or to
+		// offer the HTLC output to the sweeper otherwise.
+		select {
+		case notifier.epochChan <- &chainntnfs.BlockEpoch{}:
+		case err := <-resolveErr:
+			t.Fatalf("unable to resolve HTLC: %v", err)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("failed to request block epochs")
+		}
+
+		if testCase.remoteCommit {
+			select {
+			case inp := <-sweeper.sweptInputs:
+				witnessType := inp.WitnessType()
+				if witnessType != input.HtlcOfferedRemoteTimeout {
+					t.Fatalf("unexpected witness type: %v",
+						witnessType)
+				}
+			case <-time.After(time.Second * 5):
+				t.Fatalf("htlc output not offered to sweeper")
+			}
+		} else {
+			select {
+			case tx := <-publishChan:
+				if tx != sweepTx {
+					t.Fatalf("expected timeout tx to be " +
+						"broadcast")
+				}
+			case <-time.After(time.Second * 5):
+				t.Fatalf("timeout tx not broadcast")
+			}
+		}
+
+		// The resolver should checkpoint its state once the HTLC has
+		// been timed out.
 		select {
-		case <-incubateChan:
+		case <-checkPointChan:
 		case err := <-resolveErr:
 			t.Fatalf("unable to resolve HTLC: %v", err)
 		case <-time.After(time.Second * 5):
-			t.Fatalf("failed to receive incubation request")
+			t.Fatalf("check point not received")
 		}
 
 		// Next, the resolver should request a spend notification for
@@ -329,25 +384,47 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				t.Fatalf("resolution not sent")
 			}
 
-			// We should also get another request for the spend
-			// notification of the second-level transaction to
-			// indicate that it's been swept by the nursery, but
-			// only if this is a local commitment transaction.
+			// If this is a local commitment transaction, the
+			// resolver should wait for the csv delay of the
+			// second-level output to expire, and then offer it to
+			// the sweeper.
 			if !testCase.remoteCommit {
 				select {
-				case notifier.spendChan <- &chainntnfs.SpendDetail{
-					SpendingTx: spendingTx,
-				}:
+				case notifier.epochChan <- &chainntnfs.BlockEpoch{}:
 				case <-time.After(time.Second * 5):
-					t.Fatalf("failed to request spend ntfn")
+					t.Fatalf("failed to request block " +
+						"epochs")
+				}
+
+				select {
+				case inp := <-sweeper.sweptInputs:
+					witnessType := inp.WitnessType()
+					if witnessType != input.HtlcOfferedTimeoutSecondLevel {
+						t.Fatalf("unexpected witness "+
+							"type: %v", witnessType)
+					}
+				case <-time.After(time.Second * 5):
+					t.Fatalf("second-level output not " +
+						"offered to sweeper")
 				}
 			}
 		}
 
 		// In any case, before the resolver exits, it should checkpoint
-		// its final state.
+		// its final state, along with the reports of the resolution.
 		select {
-		case <-checkPointChan:
+		case reports := <-checkPointChan:
+			if len(reports) != len(testCase.outcomes) {
+				t.Fatalf("expected %v reports, got %v",
+					len(testCase.outcomes), len(reports))
+			}
+			for i, report := range reports {
+				if report.ResolverOutcome != testCase.outcomes[i] {
+					t.Fatalf("expected outcome %v, got %v",
+						testCase.outcomes[i],
+						report.ResolverOutcome)
+				}
+			}
 		case err := <-resolveErr:
 			t.Fatalf("unable to resolve HTLC: %v", err)
 		case <-time.After(time.Second * 5):
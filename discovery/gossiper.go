@@ -184,6 +184,14 @@ type Config struct {
 	// here?
 	AnnSigner lnwallet.MessageSigner
 
+	// FetchRemoteAlias returns the alias the remote party chose for the
+	// zero-conf channel with the given funding outpoint. The remote party
+	// doesn't know the channel by our alias, so the channel updates sent
+	// to it directly must use its own alias instead. An empty short
+	// channel ID is returned if the alias isn't known yet.
+	FetchRemoteAlias func(chanPoint wire.OutPoint) (lnwire.ShortChannelID,
+		error)
+
 	// NumActiveSyncers is the number of peers for which we should have
 	// active syncers with. After reaching NumActiveSyncers, any future
 	// gossip syncers will be passive.
@@ -1341,8 +1349,19 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 			remotePubKey := remotePubFromChanInfo(
 				edgeInfo.info, chanUpdate.ChannelFlags,
 			)
-			err := d.reliableSender.sendMessage(
-				chanUpdate, remotePubKey,
+			remoteUpdate, err := d.remoteChanUpdate(
+				edgeInfo.info, chanUpdate,
+			)
+			if err != nil {
+				log.Errorf("Unable to create %v for "+
+					"channel=%v to peer=%x: %v",
+					chanUpdate.MsgType(),
+					chanUpdate.ShortChannelID,
+					remotePubKey, err)
+				continue
+			}
+			err = d.reliableSender.sendMessage(
+				remoteUpdate, remotePubKey,
 			)
 			if err != nil {
 				log.Errorf("Unable to reliably send %v for "+
@@ -1569,6 +1588,22 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		// Alias short channel IDs are only used for our own private
+		// zero-conf channels, so remote announcements referencing an
+		// alias can't be validated against the chain and are rejected.
+		if nMsg.isRemote && msg.ShortChannelID.IsAlias() {
+			err := fmt.Errorf("Ignoring ChannelAnnouncement for "+
+				"alias short_chan_id=%v", msg.ShortChannelID)
+			log.Errorf(err.Error())
+
+			d.rejectMtx.Lock()
+			d.recentRejects[msg.ShortChannelID.ToUint64()] = struct{}{}
+			d.rejectMtx.Unlock()
+
+			nMsg.err <- err
+			return nil
+		}
+
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
@@ -1801,7 +1836,11 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
-		if nMsg.isRemote && isPremature(msg.ShortChannelID, 0) {
+		// Updates for zero-conf channels reference an alias, which
+		// never matures, so they're processed right away.
+		if nMsg.isRemote && !msg.ShortChannelID.IsAlias() &&
+			isPremature(msg.ShortChannelID, 0) {
+
 			log.Infof("Update announcement for "+
 				"short_chan_id(%v), is premature: advertises "+
 				"height %v, only height %v is known",
@@ -1999,11 +2038,23 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 				chanInfo, msg.ChannelFlags,
 			)
 
+			remoteUpdate, err := d.remoteChanUpdate(chanInfo, msg)
+			if err != nil {
+				err := fmt.Errorf("unable to create %v for "+
+					"channel=%v to peer=%x: %v",
+					msg.MsgType(), msg.ShortChannelID,
+					remotePubKey, err)
+				nMsg.err <- err
+				return nil
+			}
+
 			// Now, we'll attempt to send the channel update message
 			// reliably to the remote peer in the background, so
 			// that we don't block if the peer happens to be offline
 			// at the moment.
-			err := d.reliableSender.sendMessage(msg, remotePubKey)
+			err = d.reliableSender.sendMessage(
+				remoteUpdate, remotePubKey,
+			)
 			if err != nil {
 				err := fmt.Errorf("unable to reliably send %v "+
 					"for channel=%v to peer=%x: %v",
@@ -2544,6 +2595,42 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 	return chanAnn, chanUpdate, err
 }
 
+// remoteChanUpdate returns the channel update to send directly to the remote
+// party of a private channel. Zero-conf channels are referred to by our alias
+// within the graph, so the update is re-signed using the alias the remote
+// party chose for the channel.
+func (d *AuthenticatedGossiper) remoteChanUpdate(
+	info *channeldb.ChannelEdgeInfo,
+	chanUpdate *lnwire.ChannelUpdate) (*lnwire.ChannelUpdate, error) {
+
+	if !chanUpdate.ShortChannelID.IsAlias() {
+		return chanUpdate, nil
+	}
+
+	alias, err := d.cfg.FetchRemoteAlias(info.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	if alias == (lnwire.ShortChannelID{}) {
+		return nil, fmt.Errorf("remote alias of channel %v is unknown",
+			info.ChannelPoint)
+	}
+
+	remoteUpdate := *chanUpdate
+	remoteUpdate.ShortChannelID = alias
+
+	sig, err := SignAnnouncement(d.cfg.AnnSigner, d.selfKey, &remoteUpdate)
+	if err != nil {
+		return nil, err
+	}
+	remoteUpdate.Signature, err = lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteUpdate, nil
+}
+
 // SyncManager returns the gossiper's SyncManager instance.
 func (d *AuthenticatedGossiper) SyncManager() *SyncManager {
 	return d.syncMgr
@@ -56,6 +56,9 @@ func (p *mockPeer) Address() net.Addr { return nil }
 func (p *mockPeer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
+func (p *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
 
 // mockMessageStore is an in-memory implementation of the MessageStore interface
 // used for the gossiper's unit tests.
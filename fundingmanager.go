@@ -10,13 +10,15 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/discovery"
 	"github.com/wakiyamap/lnd/htlcswitch"
 	"github.com/wakiyamap/lnd/input"
@@ -108,6 +110,14 @@ type reservationWithCtx struct {
 	remoteCsvDelay uint16
 	remoteMinHtlc  lnwire.MilliSatoshi
 
+	// zeroConf indicates whether we requested the channel to be usable
+	// before its funding transaction confirms.
+	zeroConf bool
+
+	// dualFund holds the state of the interactive construction of the
+	// funding transaction. This is only set for dual funded channels.
+	dualFund *interactiveFunding
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -141,6 +151,48 @@ func (r *reservationWithCtx) updateTimestamp() {
 	r.lastUpdated = time.Now()
 }
 
+// interactiveFunding houses the state of the interactive construction of the
+// funding transaction of a dual funded channel. Both parties first add their
+// inputs and outputs, followed by TxComplete. Once the commitment signatures
+// have been exchanged and the channel persisted, both parties release the
+// signatures for their inputs via TxSignatures.
+type interactiveFunding struct {
+	// initiator is true if we initiated the funding workflow. The
+	// initiator uses even serial IDs, while the responder uses odd ones.
+	initiator bool
+
+	// remoteContribution is the remote party's contribution to the
+	// channel. Their inputs and outputs are added as they're received.
+	remoteContribution *lnwallet.ChannelContribution
+
+	// serialIDs is the set of serial IDs used by the remote party so far.
+	serialIDs map[uint64]struct{}
+
+	// localComplete is true once we've sent all our inputs and outputs,
+	// followed by TxComplete.
+	localComplete bool
+
+	// remoteComplete is true once the remote party has sent TxComplete.
+	remoteComplete bool
+
+	// completeChan is the pending channel, which is persisted once the
+	// commitment signatures have been exchanged.
+	completeChan *channeldb.OpenChannel
+
+	// sigsSent is true once we've released the signatures for our inputs
+	// to the funding transaction.
+	sigsSent bool
+}
+
+// newInteractiveFunding creates the state for the interactive construction of
+// a funding transaction.
+func newInteractiveFunding(initiator bool) *interactiveFunding {
+	return &interactiveFunding{
+		initiator: initiator,
+		serialIDs: make(map[uint64]struct{}),
+	}
+}
+
 // initFundingMsg is sent by an outside subsystem to the funding manager in
 // order to kick off a funding workflow with a specified target peer. The
 // original request which defines the parameters of the funding workflow are
@@ -191,6 +243,14 @@ type fundingLockedMsg struct {
 	peer lnpeer.Peer
 }
 
+// interactiveTxMsg couples one of the messages used to interactively construct
+// the funding transaction of a dual funded channel with the peer who sent the
+// message.
+type interactiveTxMsg struct {
+	msg  lnwire.Message
+	peer lnpeer.Peer
+}
+
 // fundingErrorMsg couples an lnwire.Error message with the peer who sent the
 // message. This allows the funding manager to properly process the error.
 type fundingErrorMsg struct {
@@ -324,6 +384,20 @@ type fundingConfig struct {
 	// sub-systems.
 	ReportShortChanID func(wire.OutPoint) error
 
+	// AcceptZeroConf is a function closure that decides whether we accept
+	// a zero-conf channel from the passed peer. As the funding transaction
+	// of a zero-conf channel could be double spent before it confirms,
+	// only trusted peers should be accepted.
+	AcceptZeroConf func(*btcec.PublicKey) bool
+
+	// DualFundContribution is a function closure that returns the amount
+	// we're willing to contribute to a dual funded channel that the passed
+	// peer opens to us with the passed amount of their own. Returning zero
+	// declines to contribute, in which case the single funder workflow is
+	// used.
+	DualFundContribution func(*btcec.PublicKey,
+		btcutil.Amount) btcutil.Amount
+
 	// ZombieSweeperInterval is the periodic time interval in which the
 	// zombie sweeper is run.
 	ZombieSweeperInterval time.Duration
@@ -341,6 +415,13 @@ type fundingConfig struct {
 	// NotifyOpenChannelEvent informs the ChannelNotifier when channels
 	// transition from pending open to open.
 	NotifyOpenChannelEvent func(wire.OutPoint)
+
+	// UpfrontShutdownScript is the default script that we'll commit to as
+	// our cooperative close delivery script for all new channels with
+	// peers that support option_upfront_shutdown_script. If this is nil,
+	// then we won't commit to a script unless one is specified within the
+	// open channel request.
+	UpfrontShutdownScript lnwire.DeliveryAddress
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -407,6 +488,12 @@ type fundingManager struct {
 	localDiscoveryMtx     sync.Mutex
 	localDiscoverySignals map[lnwire.ChannelID]chan struct{}
 
+	// remoteAliasSignals is a map from a channel ID to a signal which will
+	// be closed once the alias the remote party chose for the zero-conf
+	// channel has been received and stored.
+	remoteAliasMtx     sync.Mutex
+	remoteAliasSignals map[lnwire.ChannelID]chan struct{}
+
 	handleFundingLockedMtx      sync.RWMutex
 	handleFundingLockedBarriers map[lnwire.ChannelID]struct{}
 
@@ -462,6 +549,7 @@ func newFundingManager(cfg fundingConfig) (*fundingManager, error) {
 		fundingMsgs:                 make(chan interface{}, msgBufferSize),
 		fundingRequests:             make(chan *initFundingMsg, msgBufferSize),
 		localDiscoverySignals:       make(map[lnwire.ChannelID]chan struct{}),
+		remoteAliasSignals:          make(map[lnwire.ChannelID]chan struct{}),
 		handleFundingLockedBarriers: make(map[lnwire.ChannelID]struct{}),
 		queries:                     make(chan interface{}, 1),
 		quit:                        make(chan struct{}),
@@ -594,6 +682,15 @@ func (f *fundingManager) Start() error {
 	}
 
 	for _, channel := range openChannels {
+		// Resume waiting for the funding transaction of any zero-conf
+		// channel that's still unconfirmed.
+		if channel.ShortChanID().IsAlias() &&
+			channel.ConfirmedShortChanID() == (lnwire.ShortChannelID{}) {
+
+			f.wg.Add(1)
+			go f.waitForZeroConfConfirmation(channel)
+		}
+
 		channelState, shortChanID, err := f.getChannelOpeningState(
 			&channel.FundingOutpoint)
 		if err == ErrChannelNotFound {
@@ -900,6 +997,8 @@ func (f *fundingManager) reservationCoordinator() {
 			case *fundingLockedMsg:
 				f.wg.Add(1)
 				go f.handleFundingLocked(fmsg)
+			case *interactiveTxMsg:
+				f.handleInteractiveTx(fmsg)
 			case *fundingErrorMsg:
 				f.handleErrorMsg(fmsg)
 			}
@@ -1050,6 +1149,44 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If the initiator proposed a channel type, we'll have to either
+	// accept it as is, or fail the funding flow. Besides the default
+	// channel type, we only support zero-conf channels, which are
+	// identified by an alias until their funding transaction confirms. As
+	// the alias can't be announced, and the funding transaction could be
+	// double spent before it confirms, we'll only accept private zero-conf
+	// channels from peers we trust.
+	var zeroConf bool
+	if msg.ChannelType != nil {
+		var chanTypeErr error
+		switch {
+		// An empty channel type requests the default channel type.
+		case msg.ChannelType.Equals(lnwire.NewRawFeatureVector()):
+
+		case !msg.ChannelType.Equals(zeroConfChannelType()):
+			chanTypeErr = lnwallet.ErrUnsupportedChannelType()
+
+		case msg.ChannelFlags&lnwire.FFAnnounceChannel != 0:
+			chanTypeErr = lnwallet.ErrZeroConfChanPublic()
+
+		case !f.cfg.AcceptZeroConf(peerPubKey):
+			chanTypeErr = lnwallet.ErrZeroConfUntrusted()
+
+		default:
+			zeroConf = true
+		}
+
+		if chanTypeErr != nil {
+			fndgLog.Warnf("Rejecting channel type of pendingId=%x "+
+				"from peer(%x): %v", msg.PendingChannelID,
+				peerPubKey.SerializeCompressed(), chanTypeErr)
+			f.failFundingFlow(
+				fmsg.peer, msg.PendingChannelID, chanTypeErr,
+			)
+			return
+		}
+	}
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1087,6 +1224,15 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// the amount of the channel, and also if any funds are being pushed to
 	// us.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+
+	// If we accepted a zero-conf channel, we won't require any
+	// confirmations at all.
+	if zeroConf {
+		fndgLog.Infof("Accepting zero-conf channel with pendingId=%x",
+			msg.PendingChannelID)
+
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1106,6 +1252,49 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If the initiator committed to an upfront shutdown script, then
+	// we'll ensure it's a standard script before recording it within the
+	// reservation. Any later Shutdown message from them must pay to this
+	// same script.
+	if len(msg.UpfrontShutdownScript) != 0 {
+		err := validateUpfrontShutdownScript(msg.UpfrontShutdownScript)
+		if err != nil {
+			fndgLog.Errorf("Invalid upfront shutdown script: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+
+		reservation.SetTheirUpfrontShutdown(msg.UpfrontShutdownScript)
+	}
+
+	// If the initiator supports upfront shutdown scripts, then we'll also
+	// commit to our default delivery script if one is configured.
+	ourShutdownScript := f.defaultUpfrontShutdownScript(fmsg.peer)
+	reservation.SetOurUpfrontShutdown(ourShutdownScript)
+
+	// If the initiator is willing to construct the funding transaction
+	// interactively, then we'll consult our policy to determine whether
+	// we'll contribute funds of our own. We won't contribute to zero-conf
+	// channels, as their funding transaction may never confirm, nor to
+	// channels the initiator pushes funds over in.
+	var ourFundingAmt btcutil.Amount
+	if msg.DualFund && numConfsReq != 0 && msg.PushAmount == 0 {
+
+		ourFundingAmt = f.dualFundContribution(fmsg.peer, msg)
+	}
+	if ourFundingAmt != 0 {
+		err := f.contributeFunding(reservation, ourFundingAmt)
+		if err != nil {
+			// We're not obligated to contribute, so rather than
+			// failing the funding flow, we'll fall back to the
+			// single funder workflow.
+			fndgLog.Warnf("Unable to contribute %v to dual funded "+
+				"pendingChan(%x): %v", ourFundingAmt,
+				msg.PendingChannelID, err)
+			ourFundingAmt = 0
+		}
+	}
+
 	fndgLog.Infof("Requiring %v confirmations for pendingChan(%x): "+
 		"amt=%v, push_amt=%v", numConfsReq, fmsg.msg.PendingChannelID,
 		amt, msg.PushAmount)
@@ -1132,6 +1321,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		err:            make(chan error, 1),
 		peer:           fmsg.peer,
 	}
+	if ourFundingAmt != 0 {
+		resCtx.dualFund = newInteractiveFunding(false)
+	}
 	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
 	f.resMtx.Unlock()
 
@@ -1169,11 +1361,21 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 			},
 		},
 	}
-	err = reservation.ProcessSingleContribution(remoteContribution)
-	if err != nil {
-		fndgLog.Errorf("unable to add contribution reservation: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+
+	// If we're contributing to the channel, then the initiator's inputs
+	// and outputs will be added to their contribution during the
+	// interactive construction of the funding transaction. Otherwise,
+	// they'll fund the channel in its entirety.
+	if resCtx.dualFund != nil {
+		resCtx.dualFund.remoteContribution = remoteContribution
+	} else {
+		err = reservation.ProcessSingleContribution(remoteContribution)
+		if err != nil {
+			fndgLog.Errorf("unable to add contribution "+
+				"reservation: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
 	}
 
 	fndgLog.Infof("Sending fundingResp for pendingID(%x)",
@@ -1185,20 +1387,23 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// contribution in the next message of the workflow.
 	ourContribution := reservation.OurContribution()
 	fundingAccept := lnwire.AcceptChannel{
-		PendingChannelID:     msg.PendingChannelID,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		MinAcceptDepth:       uint32(numConfsReq),
-		HtlcMinimum:          minHtlc,
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		MinAcceptDepth:        uint32(numConfsReq),
+		HtlcMinimum:           minHtlc,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		UpfrontShutdownScript: ourShutdownScript,
+		ChannelType:           msg.ChannelType,
+		FundingAmount:         ourFundingAmt,
 	}
 	if err := fmsg.peer.SendMessage(false, &fundingAccept); err != nil {
 		fndgLog.Errorf("unable to send funding response to peer: %v", err)
@@ -1251,6 +1456,30 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// As we only propose a channel type for zero-conf channels, the
+	// responder must echo it back if we requested a zero-conf channel, and
+	// mustn't send one otherwise.
+	if resCtx.zeroConf && (msg.ChannelType == nil ||
+		!msg.ChannelType.Equals(zeroConfChannelType())) ||
+		!resCtx.zeroConf && msg.ChannelType != nil {
+
+		err := fmt.Errorf("peer didn't accept the proposed channel " +
+			"type")
+		fndgLog.Warnf("Unacceptable channel type: %v", err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
+
+	// The responder may only skip the confirmation of the funding
+	// transaction if we requested a zero-conf channel.
+	if msg.MinAcceptDepth == 0 && !resCtx.zeroConf {
+		err := fmt.Errorf("peer requires zero confirmations for a " +
+			"channel that wasn't requested to be zero-conf")
+		fndgLog.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -1270,6 +1499,22 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// If the responder committed to an upfront shutdown script, then
+	// we'll validate and record it so we can enforce it once the channel
+	// is cooperatively closed.
+	if len(msg.UpfrontShutdownScript) != 0 {
+		err := validateUpfrontShutdownScript(msg.UpfrontShutdownScript)
+		if err != nil {
+			fndgLog.Warnf("Invalid upfront shutdown script: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+
+		resCtx.reservation.SetTheirUpfrontShutdown(
+			msg.UpfrontShutdownScript,
+		)
+	}
+
 	// As they've accepted our channel constraints, we'll regenerate them
 	// here so we can properly commit their accepted constraints to the
 	// reservation.
@@ -1309,6 +1554,22 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 			},
 		},
 	}
+
+	// If the responder contributes funds of their own, then we'll go on
+	// to construct the funding transaction interactively, starting by
+	// sending over our inputs and outputs. Otherwise, we'll continue with
+	// the single funder workflow.
+	if msg.FundingAmount != 0 {
+		err := f.acceptDualFunding(resCtx, msg, remoteContribution)
+		if err != nil {
+			fndgLog.Errorf("Unable to dual fund pendingChan(%x): %v",
+				pendingChanID[:], err)
+			f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		}
+		return
+	}
+	resCtx.dualFund = nil
+
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
 		fndgLog.Errorf("Unable to process contribution from %v: %v",
@@ -1322,6 +1583,24 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
 		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
 
+	// Now that we have their contribution, we can send over both the
+	// funding outpoint and our signature for their version of the
+	// commitment transaction to the remote peer.
+	err = f.sendFundingCreated(fmsg.peer, pendingChanID, resCtx)
+	if err != nil {
+		fndgLog.Errorf("Unable to send funding complete message: %v", err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+}
+
+// sendFundingCreated sends the funding outpoint, along with our signature for
+// the remote party's version of the commitment transaction, to the remote
+// peer. This must only be called once the remote party's contribution has
+// been processed.
+func (f *fundingManager) sendFundingCreated(peer lnpeer.Peer,
+	pendingChanID [32]byte, resCtx *reservationWithCtx) error {
+
 	// Now that we have their contribution, we can extract, then send over
 	// both the funding out point and our signature for their version of
 	// the commitment transaction to the remote peer.
@@ -1353,17 +1632,13 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
 	}
-	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
+	commitSig, err := lnwire.NewSigFromRawSignature(sig)
 	if err != nil {
-		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
-	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
-		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+		return fmt.Errorf("unable to parse signature: %v", err)
 	}
+	fundingCreated.CommitSig = commitSig
+
+	return peer.SendMessage(false, fundingCreated)
 }
 
 // processFundingCreated queues a funding complete message coupled with the
@@ -1393,6 +1668,14 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 		return
 	}
 
+	// If we contributed to the channel, then the funding transaction was
+	// constructed interactively, and both parties have yet to sign their
+	// inputs.
+	if resCtx.dualFund != nil {
+		f.handleDualFundingCreated(fmsg, resCtx)
+		return
+	}
+
 	// The channel initiator has responded with the funding outpoint of the
 	// final funding transaction, as well as a signature for our version of
 	// the commitment transaction. So at this point, we can validate the
@@ -1421,29 +1704,6 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// from the set of active reservations.
 	f.deleteReservationCtx(peerKey, fmsg.msg.PendingChannelID)
 
-	// If something goes wrong before the funding transaction is confirmed,
-	// we use this convenience method to delete the pending OpenChannel
-	// from the database.
-	deleteFromDatabase := func() {
-		localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChanPoint:               completeChan.FundingOutpoint,
-			ChainHash:               completeChan.ChainHash,
-			RemotePub:               completeChan.IdentityPub,
-			CloseType:               channeldb.FundingCanceled,
-			Capacity:                completeChan.Capacity,
-			SettledBalance:          localBalance,
-			RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
-			RemoteNextRevocation:    completeChan.RemoteNextRevocation,
-			LocalChanConfig:         completeChan.LocalChanCfg,
-		}
-
-		if err := completeChan.CloseChannel(closeInfo); err != nil {
-			fndgLog.Errorf("Failed closing channel %v: %v",
-				completeChan.FundingOutpoint, err)
-		}
-	}
-
 	// A new channel has almost finished the funding process. In order to
 	// properly synchronize with the writeHandler goroutine, we add a new
 	// channel to the barriers map which will be closed once the channel is
@@ -1464,7 +1724,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	if err != nil {
 		fndgLog.Errorf("unable to parse signature: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		f.deletePendingChannel(completeChan)
 		return
 	}
 
@@ -1475,7 +1735,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
 		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		f.deletePendingChannel(completeChan)
 		return
 	}
 
@@ -1511,48 +1771,80 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// transaction in 288 blocks (~ 48 hrs), by canceling the reservation
 	// and canceling the wait for the funding confirmation.
 	f.wg.Add(1)
-	go func() {
-		defer f.wg.Done()
-		confChan := make(chan *lnwire.ShortChannelID)
-		timeoutChan := make(chan struct{})
-		go f.waitForFundingWithTimeout(completeChan, confChan,
-			timeoutChan)
+	go f.awaitFundingConfirmation(fmsg.peer, pendingChanID, completeChan)
+}
 
-		var shortChanID *lnwire.ShortChannelID
-		var ok bool
-		select {
-		case <-timeoutChan:
-			// We did not see the funding confirmation before
-			// timeout, so we forget the channel.
-			err := fmt.Errorf("timeout waiting for funding tx "+
-				"(%v) to confirm", completeChan.FundingOutpoint)
-			fndgLog.Warnf(err.Error())
-			f.failFundingFlow(fmsg.peer, pendingChanID, err)
-			deleteFromDatabase()
-			return
-		case <-f.quit:
-			// The fundingManager is shutting down, will resume
-			// wait for funding transaction on startup.
-			return
-		case shortChanID, ok = <-confChan:
-			if !ok {
-				fndgLog.Errorf("waiting for funding confirmation" +
-					" failed")
-				return
-			}
-			// Fallthrough.
-		}
+// awaitFundingConfirmation waits for the funding transaction of a channel for
+// which we're not responsible for broadcasting the funding transaction to
+// confirm, after which the channel is opened. If the funding transaction
+// doesn't confirm in time, then the channel is forgotten.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) awaitFundingConfirmation(peer lnpeer.Peer,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
 
-		// Success, funding transaction was confirmed.
-		err := f.handleFundingConfirmation(
-			fmsg.peer, completeChan, shortChanID,
-		)
-		if err != nil {
-			fndgLog.Errorf("failed to handle funding"+
-				"confirmation: %v", err)
+	defer f.wg.Done()
+
+	confChan := make(chan *lnwire.ShortChannelID)
+	timeoutChan := make(chan struct{})
+	go f.waitForFundingWithTimeout(completeChan, confChan, timeoutChan)
+
+	var shortChanID *lnwire.ShortChannelID
+	var ok bool
+	select {
+	case <-timeoutChan:
+		// We did not see the funding confirmation before timeout, so
+		// we forget the channel.
+		err := fmt.Errorf("timeout waiting for funding tx (%v) to "+
+			"confirm", completeChan.FundingOutpoint)
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(peer, pendingChanID, err)
+		f.deletePendingChannel(completeChan)
+		return
+	case <-f.quit:
+		// The fundingManager is shutting down, will resume wait for
+		// funding transaction on startup.
+		return
+	case shortChanID, ok = <-confChan:
+		if !ok {
+			fndgLog.Errorf("waiting for funding confirmation" +
+				" failed")
 			return
 		}
-	}()
+		// Fallthrough.
+	}
+
+	// Success, funding transaction was confirmed.
+	err := f.handleFundingConfirmation(peer, completeChan, shortChanID)
+	if err != nil {
+		fndgLog.Errorf("failed to handle funding"+
+			"confirmation: %v", err)
+		return
+	}
+}
+
+// deletePendingChannel deletes a pending channel whose funding transaction
+// won't confirm from the database.
+func (f *fundingManager) deletePendingChannel(
+	completeChan *channeldb.OpenChannel) {
+
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+
+	if err := completeChan.CloseChannel(closeInfo); err != nil {
+		fndgLog.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+	}
 }
 
 // processFundingSigned sends a single funding sign complete message along with
@@ -1621,16 +1913,38 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
+	// If the funding transaction was constructed interactively, then we
+	// still need the remote party's signatures for their inputs before we
+	// can broadcast it. We'll hold on to the reservation until they
+	// arrive via TxSignatures.
+	if resCtx.dualFund != nil {
+		resCtx.dualFund.completeChan = completeChan
+		resCtx.updateTimestamp()
+		return
+	}
+
 	// The channel is now marked IsPending in the database, and we can
 	// delete it from our set of active reservations.
 	f.deleteReservationCtx(peerKey, pendingChanID)
 
+	f.publishFundingTx(fmsg.peer, pendingChanID, resCtx, completeChan)
+}
+
+// publishFundingTx broadcasts the finalized funding transaction of a channel
+// we initiated, then waits for it to confirm in order to open the channel.
+func (f *fundingManager) publishFundingTx(peer lnpeer.Peer,
+	pendingChanID [32]byte, resCtx *reservationWithCtx,
+	completeChan *channeldb.OpenChannel) {
+
+	peerKey := peer.IdentityKey()
+	fundingPoint := &completeChan.FundingOutpoint
+
 	// Broadcast the finalized funding transaction to the network.
 	fundingTx := completeChan.FundingTxn
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
-	err = f.cfg.PublishTransaction(fundingTx)
+	err := f.cfg.PublishTransaction(fundingTx)
 	if err != nil {
 		fndgLog.Errorf("Unable to broadcast funding tx for "+
 			"ChannelPoint(%v): %v", completeChan.FundingOutpoint,
@@ -1720,7 +2034,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		}
 
 		err = f.sendFundingLocked(
-			fmsg.peer, completeChan, lnChannel, shortChanID,
+			peer, completeChan, lnChannel, shortChanID,
 		)
 		if err != nil {
 			fndgLog.Errorf("failed sending fundingLocked: %v", err)
@@ -1768,175 +2082,721 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	}()
 }
 
-// waitForFundingWithTimeout is a wrapper around waitForFundingConfirmation that
-// will cancel the wait for confirmation if we are not the channel initiator and
-// the maxWaitNumBlocksFundingConf has passed from bestHeight.
-// In the case of timeout, the timeoutChan will be closed. In case of error,
-// confChan will be closed. In case of success, a *lnwire.ShortChannelID will be
-// passed to confChan.
-func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenChannel,
-	confChan chan<- *lnwire.ShortChannelID, timeoutChan chan<- struct{}) {
+// dualFundContribution determines the amount we'll contribute to a dual funded
+// channel opened by the passed peer, according to our contribution policy. The
+// contribution is capped such that the channel doesn't exceed the maximum
+// channel size, and is declined altogether if it would leave us below the
+// reserve the initiator requires us to maintain.
+func (f *fundingManager) dualFundContribution(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel) btcutil.Amount {
 
-	epochClient, err := f.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	amt := f.cfg.DualFundContribution(peer.IdentityKey(), msg.FundingAmount)
+	if msg.FundingAmount+amt > maxFundingAmount {
+		amt = maxFundingAmount - msg.FundingAmount
+	}
+	if amt <= msg.DustLimit || amt < msg.ChannelReserve {
+		return 0
+	}
+
+	return amt
+}
+
+// contributeFunding performs coin selection for our contribution to a dual
+// funded channel opened by the remote party.
+func (f *fundingManager) contributeFunding(
+	reservation *lnwallet.ChannelReservation, amt btcutil.Amount) error {
+
+	// We'll target the confirmation of the funding transaction within the
+	// next few blocks, as we do by default for channels we open.
+	feeRate, err := f.cfg.FeeEstimator.EstimateFeePerKW(6)
 	if err != nil {
-		fndgLog.Errorf("unable to register for epoch notification: %v",
-			err)
-		close(confChan)
-		return
+		return err
 	}
 
-	defer epochClient.Cancel()
+	return reservation.ContributeFunding(amt, feeRate, 1)
+}
 
-	waitingConfChan := make(chan *lnwire.ShortChannelID)
-	cancelChan := make(chan struct{})
+// acceptDualFunding records the responder's contribution to a dual funded
+// channel we initiated, then starts the interactive construction of the
+// funding transaction by sending over our inputs and outputs.
+func (f *fundingManager) acceptDualFunding(resCtx *reservationWithCtx,
+	msg *lnwire.AcceptChannel,
+	remoteContribution *lnwallet.ChannelContribution) error {
 
-	// Add this goroutine to wait group so we can be sure that it is
-	// properly stopped before the funding manager can be shut down.
-	f.wg.Add(1)
-	go func() {
-		defer f.wg.Done()
-		f.waitForFundingConfirmation(completeChan, cancelChan,
-			waitingConfChan)
-	}()
+	if resCtx.dualFund == nil {
+		return fmt.Errorf("peer contributed %v to a channel that "+
+			"isn't dual funded", msg.FundingAmount)
+	}
+	if resCtx.chanAmt+msg.FundingAmount > maxFundingAmount {
+		return fmt.Errorf("peer contribution of %v exceeds the "+
+			"maximum channel size of %v", msg.FundingAmount,
+			maxFundingAmount)
+	}
 
-	// On block maxHeight we will cancel the funding confirmation wait.
-	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocksFundingConf
-	for {
-		select {
-		case epoch, ok := <-epochClient.Epochs:
-			if !ok {
-				fndgLog.Warnf("Epoch client shutting down")
-				return
-			}
+	err := resCtx.reservation.SetRemoteFunding(msg.FundingAmount)
+	if err != nil {
+		return err
+	}
 
-			// If we are not the channel initiator it's safe
-			// to timeout the channel
-			if uint32(epoch.Height) >= maxHeight && !completeChan.IsInitiator {
-				fndgLog.Warnf("waited for %v blocks without "+
-					"seeing funding transaction confirmed,"+
-					" cancelling.", maxWaitNumBlocksFundingConf)
+	fndgLog.Infof("Peer contributes %v to dual funded pendingChan(%x)",
+		msg.FundingAmount, msg.PendingChannelID[:])
 
-				// Cancel the waitForFundingConfirmation
-				// goroutine.
-				close(cancelChan)
+	remoteContribution.FundingAmount = msg.FundingAmount
+	resCtx.dualFund.remoteContribution = remoteContribution
 
-				// Notify the caller of the timeout.
-				close(timeoutChan)
-				return
-			}
+	return f.sendFundingContribution(
+		resCtx.peer, msg.PendingChannelID, resCtx,
+	)
+}
 
-			// TODO: If we are the channel initiator implement
-			// a method for recovering the funds from the funding
-			// transaction
+// sendFundingContribution sends our inputs and outputs to the funding
+// transaction of a dual funded channel to the remote peer, followed by
+// TxComplete.
+func (f *fundingManager) sendFundingContribution(peer lnpeer.Peer,
+	pendingChanID [32]byte, resCtx *reservationWithCtx) error {
+
+	dualFund := resCtx.dualFund
+	chanID := lnwire.ChannelID(pendingChanID)
+	ourContribution := resCtx.reservation.OurContribution()
+
+	// The initiator uses even serial IDs, while the responder uses odd
+	// ones, ensuring both parties never pick the same one.
+	serialID := uint64(1)
+	if dualFund.initiator {
+		serialID = 0
+	}
+
+	var msgs []lnwire.Message
+	for _, txIn := range ourContribution.Inputs {
+		// The remote party can only verify the value and script of the
+		// output we're spending given the whole transaction containing
+		// it.
+		prevOutPoint := txIn.PreviousOutPoint
+		prevTx, err := f.cfg.Wallet.FetchTx(prevOutPoint.Hash)
+		if err != nil {
+			return err
+		}
+		if int(prevOutPoint.Index) >= len(prevTx.TxOut) {
+			return fmt.Errorf("funding input %v spends an unknown "+
+				"output", prevOutPoint)
+		}
 
-		case <-f.quit:
-			// The fundingManager is shutting down, will resume
-			// waiting for the funding transaction on startup.
-			return
-		case shortChanID, ok := <-waitingConfChan:
-			if !ok {
-				// Failed waiting for confirmation, close
-				// confChan to indicate failure.
-				close(confChan)
-				return
-			}
+		// As TxSignatures only carries witnesses, we're only able to
+		// contribute inputs that spend native witness programs.
+		prevOut := prevTx.TxOut[prevOutPoint.Index]
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return fmt.Errorf("funding input %v doesn't spend a "+
+				"native witness program", prevOutPoint)
+		}
 
-			select {
-			case confChan <- shortChanID:
-			case <-f.quit:
-				return
-			}
+		msgs = append(msgs, &lnwire.TxAddInput{
+			ChannelID:  chanID,
+			SerialID:   serialID,
+			PrevTx:     prevTx,
+			PrevTxVout: prevOutPoint.Index,
+			Sequence:   txIn.Sequence,
+		})
+		serialID += 2
+	}
+	for _, txOut := range ourContribution.ChangeOutputs {
+		msgs = append(msgs, &lnwire.TxAddOutput{
+			ChannelID: chanID,
+			SerialID:  serialID,
+			Amount:    btcutil.Amount(txOut.Value),
+			PkScript:  txOut.PkScript,
+		})
+		serialID += 2
+	}
+	msgs = append(msgs, &lnwire.TxComplete{ChannelID: chanID})
+
+	for _, msg := range msgs {
+		if err := peer.SendMessage(false, msg); err != nil {
+			return err
 		}
 	}
+	dualFund.localComplete = true
+
+	return nil
 }
 
-// makeFundingScript re-creates the funding script for the funding transaction
-// of the target channel.
-func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
-	localKey := channel.LocalChanCfg.MultiSigKey.PubKey.SerializeCompressed()
-	remoteKey := channel.RemoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+// processInteractiveTxMsg sends a message used to interactively construct the
+// funding transaction of a dual funded channel to the fundingManager.
+func (f *fundingManager) processInteractiveTxMsg(msg lnwire.Message,
+	peer lnpeer.Peer) {
 
-	multiSigScript, err := input.GenMultiSigScript(localKey, remoteKey)
-	if err != nil {
-		return nil, err
+	select {
+	case f.fundingMsgs <- &interactiveTxMsg{msg, peer}:
+	case <-f.quit:
+		return
 	}
-
-	return input.WitnessScriptHash(multiSigScript)
 }
 
-// waitForFundingConfirmation handles the final stages of the channel funding
-// process once the funding transaction has been broadcast. The primary
-// function of waitForFundingConfirmation is to wait for blockchain
-// confirmation, and then to notify the other systems that must be notified
-// when a channel has become active for lightning transactions.
-// The wait can be canceled by closing the cancelChan. In case of success,
-// a *lnwire.ShortChannelID will be passed to confChan.
-func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.OpenChannel,
-	cancelChan <-chan struct{}, confChan chan<- *lnwire.ShortChannelID) {
-
-	defer close(confChan)
+// handleInteractiveTx progresses the interactive construction of the funding
+// transaction of a dual funded channel.
+func (f *fundingManager) handleInteractiveTx(fmsg *interactiveTxMsg) {
+	var pendingChanID [32]byte
+	switch msg := fmsg.msg.(type) {
+	case *lnwire.TxAddInput:
+		pendingChanID = msg.ChannelID
+	case *lnwire.TxAddOutput:
+		pendingChanID = msg.ChannelID
+	case *lnwire.TxComplete:
+		pendingChanID = msg.ChannelID
+	case *lnwire.TxSignatures:
+		pendingChanID = msg.ChannelID
+	default:
+		fndgLog.Errorf("Unknown interactive tx message: %T", msg)
+		return
+	}
 
-	// Register with the ChainNotifier for a notification once the funding
-	// transaction reaches `numConfs` confirmations.
-	txid := completeChan.FundingOutpoint.Hash
-	fundingScript, err := makeFundingScript(completeChan)
+	peerKey := fmsg.peer.IdentityKey()
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
 	if err != nil {
-		fndgLog.Errorf("unable to create funding script for "+
-			"ChannelPoint(%v): %v", completeChan.FundingOutpoint, err)
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
 		return
 	}
-	numConfs := uint32(completeChan.NumConfsRequired)
-	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
-		&txid, fundingScript, numConfs, completeChan.FundingBroadcastHeight,
+	if resCtx.dualFund == nil {
+		err := fmt.Errorf("received %v for pendingChan(%x) which "+
+			"isn't dual funded", fmsg.msg.MsgType(), pendingChanID[:])
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// Update the timestamp once the message has been handled.
+	defer resCtx.updateTimestamp()
+
+	switch msg := fmsg.msg.(type) {
+	case *lnwire.TxAddInput:
+		err = resCtx.dualFund.addRemoteInput(msg)
+	case *lnwire.TxAddOutput:
+		err = resCtx.dualFund.addRemoteOutput(msg)
+	case *lnwire.TxComplete:
+		err = f.handleTxComplete(fmsg.peer, pendingChanID, resCtx)
+	case *lnwire.TxSignatures:
+		err = f.handleTxSignatures(fmsg.peer, pendingChanID, resCtx, msg)
+	}
+	if err != nil {
+		fndgLog.Errorf("Unable to handle %v for pendingChan(%x): %v",
+			fmsg.msg.MsgType(), pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+	}
+}
+
+// checkRemoteSerialID ensures the remote party is still allowed to add inputs
+// and outputs, and that the passed serial ID is valid for them to use.
+func (i *interactiveFunding) checkRemoteSerialID(serialID uint64) error {
+	if i.remoteComplete {
+		return fmt.Errorf("remote party already sent TxComplete")
+	}
+
+	// The remote party must use even serial IDs if they're the initiator,
+	// and odd ones otherwise.
+	if (serialID%2 == 0) == i.initiator {
+		return fmt.Errorf("invalid parity for serial id %v", serialID)
+	}
+	if _, ok := i.serialIDs[serialID]; ok {
+		return fmt.Errorf("duplicate serial id %v", serialID)
+	}
+	i.serialIDs[serialID] = struct{}{}
+
+	return nil
+}
+
+// addRemoteInput adds an input to the remote party's contribution to the
+// funding transaction. The value and script of the output being spent are
+// taken from the previous transaction sent along, as they're committed to by
+// its txid, which is in turn committed to by the funding transaction.
+func (i *interactiveFunding) addRemoteInput(msg *lnwire.TxAddInput) error {
+	if err := i.checkRemoteSerialID(msg.SerialID); err != nil {
+		return err
+	}
+
+	prevOutPoint := msg.PrevOutPoint()
+	if int(msg.PrevTxVout) >= len(msg.PrevTx.TxOut) {
+		return fmt.Errorf("funding input %v spends an output that "+
+			"doesn't exist", prevOutPoint)
+	}
+	prevOut := msg.PrevTx.TxOut[msg.PrevTxVout]
+
+	// We'll only accept inputs spending witness programs, as otherwise
+	// the txid of the funding transaction could be malleated.
+	if !txscript.IsWitnessProgram(prevOut.PkScript) {
+		return fmt.Errorf("funding input %v doesn't spend a witness "+
+			"program", prevOutPoint)
+	}
+
+	contribution := i.remoteContribution
+	if contribution.PrevOutputs == nil {
+		contribution.PrevOutputs = make(map[wire.OutPoint]*wire.TxOut)
+	}
+	if _, ok := contribution.PrevOutputs[prevOutPoint]; ok {
+		return fmt.Errorf("duplicate funding input %v", prevOutPoint)
+	}
+
+	txIn := wire.NewTxIn(&prevOutPoint, nil, nil)
+	txIn.Sequence = msg.Sequence
+	contribution.Inputs = append(contribution.Inputs, txIn)
+	contribution.PrevOutputs[prevOutPoint] = prevOut
+
+	return nil
+}
+
+// addRemoteOutput adds an output to the remote party's contribution to the
+// funding transaction.
+func (i *interactiveFunding) addRemoteOutput(msg *lnwire.TxAddOutput) error {
+	if err := i.checkRemoteSerialID(msg.SerialID); err != nil {
+		return err
+	}
+	if msg.Amount <= 0 {
+		return fmt.Errorf("invalid output amount %v", msg.Amount)
+	}
+
+	// Only standard output scripts are accepted, as the funding
+	// transaction would otherwise fail to relay.
+	switch txscript.GetScriptClass(msg.PkScript) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+
+	default:
+		return fmt.Errorf("non-standard output script %x",
+			msg.PkScript)
+	}
+
+	// For the same reason, the output must be above the dust limit for
+	// its script type.
+	dustLimit := txrules.GetDustThreshold(
+		len(msg.PkScript), txrules.DefaultRelayFeePerKb,
+	)
+	if msg.Amount < dustLimit {
+		return fmt.Errorf("output amount %v below dust limit %v",
+			msg.Amount, dustLimit)
+	}
+
+	i.remoteContribution.ChangeOutputs = append(
+		i.remoteContribution.ChangeOutputs, &wire.TxOut{
+			Value:    int64(msg.Amount),
+			PkScript: msg.PkScript,
+		},
 	)
+
+	return nil
+}
+
+// handleTxComplete processes the remote party's TxComplete. If we're the
+// responder, then we'll follow up with our own inputs and outputs. As both
+// contributions are known at this point, the funding transaction and both
+// commitment transactions are constructed, after which the initiator sends
+// over its signature for the responder's commitment transaction.
+func (f *fundingManager) handleTxComplete(peer lnpeer.Peer,
+	pendingChanID [32]byte, resCtx *reservationWithCtx) error {
+
+	dualFund := resCtx.dualFund
+	if dualFund.remoteComplete {
+		return fmt.Errorf("duplicate TxComplete")
+	}
+	dualFund.remoteComplete = true
+
+	// The remote party's inputs must cover their share of the channel, as
+	// well as any of their outputs. Whatever remains goes towards the fee
+	// of the funding transaction. The value of each input was taken from
+	// the transaction it spends, rather than claimed by the remote party,
+	// so the remote party can't misrepresent its contribution.
+	contribution := dualFund.remoteContribution
+	var inputTotal, outputTotal btcutil.Amount
+	for _, prevOut := range contribution.PrevOutputs {
+		inputTotal += btcutil.Amount(prevOut.Value)
+	}
+	for _, txOut := range contribution.ChangeOutputs {
+		outputTotal += btcutil.Amount(txOut.Value)
+	}
+	if inputTotal < contribution.FundingAmount+outputTotal {
+		return fmt.Errorf("remote inputs of %v don't cover funding "+
+			"amount of %v and outputs of %v", inputTotal,
+			contribution.FundingAmount, outputTotal)
+	}
+
+	// The remote party also mustn't attempt to spend any of our inputs.
+	for _, txIn := range resCtx.reservation.OurContribution().Inputs {
+		_, ok := contribution.PrevOutputs[txIn.PreviousOutPoint]
+		if ok {
+			return fmt.Errorf("remote party added our funding "+
+				"input %v", txIn.PreviousOutPoint)
+		}
+	}
+
+	// If we're the responder, then we have yet to send our own inputs
+	// and outputs.
+	if !dualFund.localComplete {
+		err := f.sendFundingContribution(peer, pendingChanID, resCtx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// With both contributions known, we can now construct the funding
+	// transaction, along with both commitment transactions.
+	err := resCtx.reservation.ProcessContribution(contribution)
 	if err != nil {
-		fndgLog.Errorf("Unable to register for confirmation of "+
-			"ChannelPoint(%v): %v", completeChan.FundingOutpoint, err)
+		return err
+	}
+
+	fndgLog.Infof("Constructed funding tx %v for dual funded "+
+		"pendingChan(%x)", resCtx.reservation.FinalFundingTx().TxHash(),
+		pendingChanID[:])
+
+	// As the initiator, we'll continue the funding workflow by sending
+	// over the funding outpoint, along with our commitment signature.
+	if !dualFund.initiator {
+		return nil
+	}
+
+	return f.sendFundingCreated(peer, pendingChanID, resCtx)
+}
+
+// handleDualFundingCreated progresses the funding workflow of a dual funded
+// channel once the initiator has sent its signature for our version of the
+// commitment transaction. After the channel has been persisted, we'll send our
+// own commitment signature followed by the signatures for our inputs to the
+// funding transaction, allowing the initiator to broadcast it.
+func (f *fundingManager) handleDualFundingCreated(fmsg *fundingCreatedMsg,
+	resCtx *reservationWithCtx) {
+
+	peerKey := fmsg.peer.IdentityKey()
+	pendingChanID := fmsg.msg.PendingChannelID
+	dualFund := resCtx.dualFund
+
+	// Both parties must have finished constructing the funding
+	// transaction, and agree on its outpoint.
+	if !dualFund.localComplete || !dualFund.remoteComplete {
+		err := fmt.Errorf("funding transaction for pendingChan(%x) "+
+			"not yet constructed", pendingChanID[:])
+		fndgLog.Errorf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	fundingOut := fmsg.msg.FundingPoint
+	if fundingOut != *resCtx.reservation.FundingOutpoint() {
+		err := fmt.Errorf("funding outpoint mismatch: expected %v, "+
+			"got %v", resCtx.reservation.FundingOutpoint(),
+			fundingOut)
+		fndgLog.Errorf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
 		return
 	}
 
-	fndgLog.Infof("Waiting for funding tx (%v) to reach %v confirmations",
-		txid, numConfs)
+	fndgLog.Infof("completing dual funded pendingID(%x) with "+
+		"ChannelPoint(%v)", pendingChanID[:], fundingOut)
 
-	var confDetails *chainntnfs.TxConfirmation
-	var ok bool
+	// We'll verify their signature for our commitment transaction, after
+	// which the channel is persisted as pending. The signatures for their
+	// inputs will follow once we've released ours.
+	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, commitSig,
+	)
+	if err != nil {
+		fndgLog.Errorf("unable to complete dual funded reservation: %v",
+			err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	dualFund.completeChan = completeChan
 
-	// Wait until the specified number of confirmations has been reached,
-	// we get a cancel signal, or the wallet signals a shutdown.
-	select {
-	case confDetails, ok = <-confNtfn.Confirmed:
-		// fallthrough
-	case <-cancelChan:
-		fndgLog.Warnf("canceled waiting for funding confirmation, "+
-			"stopping funding flow for ChannelPoint(%v)",
-			completeChan.FundingOutpoint)
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	f.barrierMtx.Lock()
+	channelID := lnwire.NewChanIDFromOutPoint(&fundingOut)
+	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
+	f.newChanBarriers[channelID] = make(chan struct{})
+	f.barrierMtx.Unlock()
+
+	_, sig := resCtx.reservation.OurSignatures()
+	ourCommitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("unable to parse signature: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
 		return
-	case <-f.quit:
-		fndgLog.Warnf("fundingManager shutting down, stopping funding "+
-			"flow for ChannelPoint(%v)", completeChan.FundingOutpoint)
+	}
+	fundingSigned := &lnwire.FundingSigned{
+		ChanID:    channelID,
+		CommitSig: ourCommitSig,
+	}
+	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
+		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
 		return
 	}
 
-	if !ok {
-		fndgLog.Warnf("ChainNotifier shutting down, cannot complete "+
-			"funding flow for ChannelPoint(%v)",
-			completeChan.FundingOutpoint)
+	// Now that both parties hold a signed commitment transaction, it's
+	// safe to release the signatures for our inputs.
+	if err := f.sendTxSignatures(fmsg.peer, pendingChanID, resCtx); err != nil {
+		fndgLog.Errorf("unable to send TxSignatures message: %v", err)
+	}
+
+	// From here on, the channel is handled just like a single funded
+	// channel we're the responder of: we'll watch it for on-chain actions,
+	// and wait for the funding transaction to confirm. Should it not
+	// confirm in time, then the channel is forgotten.
+	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
+		fndgLog.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", fundingOut, err)
+	}
+
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[channelID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	f.wg.Add(1)
+	go f.awaitFundingConfirmation(fmsg.peer, pendingChanID, completeChan)
+}
+
+// sendTxSignatures sends the signatures for our inputs to the funding
+// transaction of a dual funded channel to the remote peer.
+func (f *fundingManager) sendTxSignatures(peer lnpeer.Peer,
+	pendingChanID [32]byte, resCtx *reservationWithCtx) error {
+
+	inputScripts, _ := resCtx.reservation.OurSignatures()
+	witnesses := make([]wire.TxWitness, len(inputScripts))
+	for i, inputScript := range inputScripts {
+		witnesses[i] = inputScript.Witness
+	}
+
+	// We'll consider the signatures released as soon as we attempt to
+	// send them, as we can't be sure they didn't reach the peer.
+	resCtx.dualFund.sigsSent = true
+
+	return peer.SendMessage(false, &lnwire.TxSignatures{
+		ChannelID: lnwire.ChannelID(pendingChanID),
+		TxHash:    resCtx.reservation.FinalFundingTx().TxHash(),
+		Witnesses: witnesses,
+	})
+}
+
+// handleTxSignatures processes the signatures for the remote party's inputs
+// to the funding transaction of a dual funded channel. The responder sends
+// these first, after which the initiator replies with its own and broadcasts
+// the funding transaction. The responder broadcasts the funding transaction
+// as well once it receives the initiator's signatures.
+func (f *fundingManager) handleTxSignatures(peer lnpeer.Peer,
+	pendingChanID [32]byte, resCtx *reservationWithCtx,
+	msg *lnwire.TxSignatures) error {
+
+	dualFund := resCtx.dualFund
+	if dualFund.completeChan == nil {
+		return fmt.Errorf("received TxSignatures before commitment " +
+			"signatures were exchanged")
+	}
+
+	fundingTx := resCtx.reservation.FinalFundingTx()
+	if fundingTx.TxHash() != msg.TxHash {
+		return fmt.Errorf("TxSignatures for unknown funding tx %v",
+			msg.TxHash)
+	}
+
+	inputScripts := make([]*input.Script, len(msg.Witnesses))
+	for i, witness := range msg.Witnesses {
+		inputScripts[i] = &input.Script{Witness: witness}
+	}
+	fundingTx, err := resCtx.reservation.FinalizeFundingTx(inputScripts)
+	if err != nil {
+		return err
+	}
+
+	// The funding transaction is now fully signed, so we no longer need
+	// to track the reservation.
+	f.deleteReservationCtx(peer.IdentityKey(), pendingChanID)
+
+	// As the responder, our signatures have already been released, so
+	// all that's left is to broadcast the funding transaction.
+	if !dualFund.initiator {
+		fndgLog.Infof("Broadcasting dual funded funding tx for "+
+			"ChannelPoint(%v)", dualFund.completeChan.FundingOutpoint)
+
+		if err := f.cfg.PublishTransaction(fundingTx); err != nil {
+			fndgLog.Errorf("Unable to broadcast funding tx for "+
+				"ChannelPoint(%v): %v",
+				dualFund.completeChan.FundingOutpoint, err)
+		}
+		return nil
+	}
+
+	// Otherwise, we'll send over our own signatures so the responder is
+	// able to broadcast the funding transaction too, before broadcasting
+	// it ourselves and waiting for the channel to open.
+	if err := f.sendTxSignatures(peer, pendingChanID, resCtx); err != nil {
+		fndgLog.Errorf("unable to send TxSignatures message: %v", err)
+	}
+
+	f.publishFundingTx(peer, pendingChanID, resCtx, dualFund.completeChan)
+
+	return nil
+}
+
+// waitForFundingWithTimeout is a wrapper around waitForFundingConfirmation that
+// will cancel the wait for confirmation if we are not the channel initiator and
+// the maxWaitNumBlocksFundingConf has passed from bestHeight.
+// In the case of timeout, the timeoutChan will be closed. In case of error,
+// confChan will be closed. In case of success, a *lnwire.ShortChannelID will be
+// passed to confChan.
+func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenChannel,
+	confChan chan<- *lnwire.ShortChannelID, timeoutChan chan<- struct{}) {
+
+	epochClient, err := f.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		fndgLog.Errorf("unable to register for epoch notification: %v",
+			err)
+		close(confChan)
 		return
 	}
 
+	defer epochClient.Cancel()
+
+	waitingConfChan := make(chan *lnwire.ShortChannelID)
+	cancelChan := make(chan struct{})
+
+	// Add this goroutine to wait group so we can be sure that it is
+	// properly stopped before the funding manager can be shut down.
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		f.waitForFundingConfirmation(completeChan, cancelChan,
+			waitingConfChan)
+	}()
+
+	// On block maxHeight we will cancel the funding confirmation wait.
+	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocksFundingConf
+	for {
+		select {
+		case epoch, ok := <-epochClient.Epochs:
+			if !ok {
+				fndgLog.Warnf("Epoch client shutting down")
+				return
+			}
+
+			// If we are not the channel initiator, and
+			// haven't contributed any funds of our own, it's
+			// safe to timeout the channel.
+			fundedByUs := completeChan.IsInitiator ||
+				completeChan.ChanType == channeldb.DualFunder
+			if uint32(epoch.Height) >= maxHeight && !fundedByUs {
+				fndgLog.Warnf("waited for %v blocks without "+
+					"seeing funding transaction confirmed,"+
+					" cancelling.", maxWaitNumBlocksFundingConf)
+
+				// Cancel the waitForFundingConfirmation
+				// goroutine.
+				close(cancelChan)
+
+				// Notify the caller of the timeout.
+				close(timeoutChan)
+				return
+			}
+
+			// TODO: If we are the channel initiator implement
+			// a method for recovering the funds from the funding
+			// transaction
+
+		case <-f.quit:
+			// The fundingManager is shutting down, will resume
+			// waiting for the funding transaction on startup.
+			return
+		case shortChanID, ok := <-waitingConfChan:
+			if !ok {
+				// Failed waiting for confirmation, close
+				// confChan to indicate failure.
+				close(confChan)
+				return
+			}
+
+			select {
+			case confChan <- shortChanID:
+			case <-f.quit:
+				return
+			}
+		}
+	}
+}
+
+// zeroConfChannelType returns the channel type identifying zero-conf channels,
+// which are referred to by an alias until the funding transaction confirms.
+func zeroConfChannelType() *lnwire.RawFeatureVector {
+	return lnwire.NewRawFeatureVector(
+		lnwire.ScidAliasRequired, lnwire.ZeroConfRequired,
+	)
+}
+
+// makeFundingScript re-creates the funding script for the funding transaction
+// of the target channel.
+func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
+	localKey := channel.LocalChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+	remoteKey := channel.RemoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+
+	multiSigScript, err := input.GenMultiSigScript(localKey, remoteKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.WitnessScriptHash(multiSigScript)
+}
+
+// waitForFundingConfirmation handles the final stages of the channel funding
+// process once the funding transaction has been broadcast. The primary
+// function of waitForFundingConfirmation is to wait for blockchain
+// confirmation, and then to notify the other systems that must be notified
+// when a channel has become active for lightning transactions.
+// The wait can be canceled by closing the cancelChan. In case of success,
+// a *lnwire.ShortChannelID will be passed to confChan.
+func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.OpenChannel,
+	cancelChan <-chan struct{}, confChan chan<- *lnwire.ShortChannelID) {
+
+	defer close(confChan)
+
 	fundingPoint := completeChan.FundingOutpoint
 	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
 
-	fndgLog.Infof("ChannelPoint(%v) is now active: ChannelID(%x)",
-		fundingPoint, chanID[:])
+	var shortChanID lnwire.ShortChannelID
 
-	// With the block height and the transaction index known, we can
-	// construct the compact chanID which is used on the network to unique
-	// identify channels.
-	shortChanID := lnwire.ShortChannelID{
-		BlockHeight: confDetails.BlockHeight,
-		TxIndex:     confDetails.TxIndex,
-		TxPosition:  uint16(fundingPoint.Index),
+	// A zero-conf channel can be used right away. Until its funding
+	// transaction confirms, the channel will be identified by its alias,
+	// so we'll wait for the confirmation in the background.
+	if completeChan.NumConfsRequired == 0 {
+		alias, err := lnwire.NewAliasShortChanID()
+		if err != nil {
+			fndgLog.Errorf("unable to generate alias for "+
+				"ChannelPoint(%v): %v", fundingPoint, err)
+			return
+		}
+		shortChanID = alias
+
+		fndgLog.Infof("Zero-conf ChannelPoint(%v) is now active with "+
+			"alias short_chan_id=%v", fundingPoint, shortChanID)
+
+		f.wg.Add(1)
+		go f.waitForZeroConfConfirmation(completeChan)
+	} else {
+		confDetails, ok := f.waitForConfs(
+			completeChan, uint32(completeChan.NumConfsRequired),
+			cancelChan,
+		)
+		if !ok {
+			return
+		}
+
+		fndgLog.Infof("ChannelPoint(%v) is now active: ChannelID(%x)",
+			fundingPoint, chanID[:])
+
+		// With the block height and the transaction index known, we
+		// can construct the compact chanID which is used on the
+		// network to unique identify channels.
+		shortChanID = lnwire.ShortChannelID{
+			BlockHeight: confDetails.BlockHeight,
+			TxIndex:     confDetails.TxIndex,
+			TxPosition:  uint16(fundingPoint.Index),
+		}
 	}
 
 	// Now that the channel has been fully confirmed, we'll mark it as open
@@ -1962,7 +2822,7 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 	// TODO(halseth): make the two db transactions (MarkChannelAsOpen and
 	// saveChannelOpeningState) atomic by doing them in the same transaction.
 	// Needed to be properly fault-tolerant.
-	err = f.saveChannelOpeningState(&completeChan.FundingOutpoint, markedOpen,
+	err := f.saveChannelOpeningState(&completeChan.FundingOutpoint, markedOpen,
 		&shortChanID)
 	if err != nil {
 		fndgLog.Errorf("error setting channel state to markedOpen: %v",
@@ -1995,6 +2855,104 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 	f.localDiscoveryMtx.Unlock()
 }
 
+// waitForConfs waits for the funding transaction of the passed channel to
+// reach numConfs confirmations. False is returned if the wait was canceled by
+// closing the cancelChan, or if we're shutting down.
+func (f *fundingManager) waitForConfs(completeChan *channeldb.OpenChannel,
+	numConfs uint32, cancelChan <-chan struct{}) (*chainntnfs.TxConfirmation,
+	bool) {
+
+	// Register with the ChainNotifier for a notification once the funding
+	// transaction reaches `numConfs` confirmations.
+	txid := completeChan.FundingOutpoint.Hash
+	fundingScript, err := makeFundingScript(completeChan)
+	if err != nil {
+		fndgLog.Errorf("unable to create funding script for "+
+			"ChannelPoint(%v): %v", completeChan.FundingOutpoint, err)
+		return nil, false
+	}
+	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
+		&txid, fundingScript, numConfs, completeChan.FundingBroadcastHeight,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to register for confirmation of "+
+			"ChannelPoint(%v): %v", completeChan.FundingOutpoint, err)
+		return nil, false
+	}
+
+	fndgLog.Infof("Waiting for funding tx (%v) to reach %v confirmations",
+		txid, numConfs)
+
+	var confDetails *chainntnfs.TxConfirmation
+	var ok bool
+
+	// Wait until the specified number of confirmations has been reached,
+	// we get a cancel signal, or the wallet signals a shutdown.
+	select {
+	case confDetails, ok = <-confNtfn.Confirmed:
+		// fallthrough
+	case <-cancelChan:
+		fndgLog.Warnf("canceled waiting for funding confirmation, "+
+			"stopping funding flow for ChannelPoint(%v)",
+			completeChan.FundingOutpoint)
+		return nil, false
+	case <-f.quit:
+		fndgLog.Warnf("fundingManager shutting down, stopping funding "+
+			"flow for ChannelPoint(%v)", completeChan.FundingOutpoint)
+		return nil, false
+	}
+
+	if !ok {
+		fndgLog.Warnf("ChainNotifier shutting down, cannot complete "+
+			"funding flow for ChannelPoint(%v)",
+			completeChan.FundingOutpoint)
+		return nil, false
+	}
+
+	return confDetails, true
+}
+
+// waitForZeroConfConfirmation waits for the funding transaction of a zero-conf
+// channel to confirm. The channel keeps being identified by its alias, but
+// once the funding transaction confirmed, its confirmed short channel ID is
+// stored and reported to the switch, so that it can also be used to forward
+// HTLCs.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) waitForZeroConfConfirmation(
+	completeChan *channeldb.OpenChannel) {
+
+	defer f.wg.Done()
+
+	confDetails, ok := f.waitForConfs(completeChan, 1, nil)
+	if !ok {
+		return
+	}
+
+	fundingPoint := completeChan.FundingOutpoint
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: confDetails.BlockHeight,
+		TxIndex:     confDetails.TxIndex,
+		TxPosition:  uint16(fundingPoint.Index),
+	}
+
+	fndgLog.Infof("Funding tx of zero-conf ChannelPoint(%v) confirmed "+
+		"with short_chan_id=%v", fundingPoint, shortChanID)
+
+	if err := completeChan.MarkConfirmed(shortChanID); err != nil {
+		fndgLog.Errorf("Unable to mark zero-conf ChannelPoint(%v) as "+
+			"confirmed: %v", fundingPoint, err)
+		return
+	}
+
+	// If the link of the channel is already active, the switch will add
+	// the confirmed short channel ID to its forwarding index.
+	if err := f.cfg.ReportShortChanID(fundingPoint); err != nil {
+		fndgLog.Debugf("Unable to report confirmed short chan id of "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+}
+
 // handleFundingConfirmation is a wrapper method for creating a new
 // lnwallet.LightningChannel object, calling sendFundingLocked,
 // addToRouterGraph, and annAfterSixConfs. This is called after the funding
@@ -2052,6 +3010,13 @@ func (f *fundingManager) sendFundingLocked(peer lnpeer.Peer,
 	}
 	fundingLockedMsg := lnwire.NewFundingLocked(chanID, nextRevocation)
 
+	// A zero-conf channel is referred to by our alias until its funding
+	// transaction confirms, so we'll tell the remote party about it.
+	if shortChanID.IsAlias() {
+		alias := *shortChanID
+		fundingLockedMsg.AliasScid = &alias
+	}
+
 	// If the peer has disconnected before we reach this point, we will need
 	// to wait for him to come back online before sending the fundingLocked
 	// message. This is special for fundingLocked, since failing to send any
@@ -2116,6 +3081,15 @@ func (f *fundingManager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 
 	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
 
+	// The channel update of a zero-conf channel is sent to the remote
+	// party using the alias it chose, so we'll have to wait until we know
+	// about it.
+	if shortChanID.IsAlias() {
+		if err := f.waitForRemoteAlias(completeChan); err != nil {
+			return err
+		}
+	}
+
 	// We'll obtain the min HTLC value we can forward in our direction, as
 	// we'll use this value within our ChannelUpdate. This constraint is
 	// originally set by the remote node, as it will be the one that will
@@ -2195,6 +3169,46 @@ func (f *fundingManager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 	return nil
 }
 
+// waitForRemoteAlias blocks until the alias the remote party chose for the
+// zero-conf channel has been received within its fundingLocked message.
+func (f *fundingManager) waitForRemoteAlias(
+	completeChan *channeldb.OpenChannel) error {
+
+	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
+
+	f.remoteAliasMtx.Lock()
+	remoteAliasSignal, ok := f.remoteAliasSignals[chanID]
+	if !ok {
+		remoteAliasSignal = make(chan struct{})
+		f.remoteAliasSignals[chanID] = remoteAliasSignal
+	}
+	f.remoteAliasMtx.Unlock()
+
+	defer func() {
+		f.remoteAliasMtx.Lock()
+		delete(f.remoteAliasSignals, chanID)
+		f.remoteAliasMtx.Unlock()
+	}()
+
+	// The alias may already have been stored, possibly before a restart,
+	// so we'll check the database before waiting for the signal.
+	if err := completeChan.RefreshShortChanID(); err != nil {
+		return err
+	}
+	if completeChan.RemoteAlias() != (lnwire.ShortChannelID{}) {
+		return nil
+	}
+
+	fndgLog.Debugf("Waiting for the remote alias of ChannelID(%v)", chanID)
+
+	select {
+	case <-remoteAliasSignal:
+		return nil
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
 // annAfterSixConfs broadcasts the necessary channel announcement messages to
 // the network after 6 confs. Should be called after the fundingLocked message
 // is sent and the channel is added to the router graph (channelState is
@@ -2418,6 +3432,19 @@ func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
 		return
 	}
 
+	// If this is a zero-conf channel, the remote party tells us the alias
+	// it refers to the channel by. We'll store it before checking for
+	// duplicates, as the message may be resent after a restart, before we
+	// stored the alias.
+	if channel.ShortChanID().IsAlias() {
+		err := f.storeRemoteAlias(channel, fmsg.msg.AliasScid)
+		if err != nil {
+			fndgLog.Errorf("Unable to store remote alias of "+
+				"ChannelID(%v): %v", chanID, err)
+			return
+		}
+	}
+
 	// If the RemoteNextRevocation is non-nil, it means that we have
 	// already processed fundingLocked for this channel, so ignore.
 	if channel.RemoteNextRevocation != nil {
@@ -2460,6 +3487,44 @@ func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
 	}
 }
 
+// storeRemoteAlias stores the alias the remote party chose for the zero-conf
+// channel, and signals anyone waiting for it.
+func (f *fundingManager) storeRemoteAlias(channel *channeldb.OpenChannel,
+	alias *lnwire.ShortChannelID) error {
+
+	if alias == nil {
+		return fmt.Errorf("fundingLocked of zero-conf channel is " +
+			"missing the alias")
+	}
+
+	// The alias mustn't change once chosen, as it may already be part of
+	// the route hints of our invoices.
+	remoteAlias := channel.RemoteAlias()
+	switch {
+	case remoteAlias == *alias:
+		return nil
+
+	case remoteAlias != (lnwire.ShortChannelID{}):
+		return fmt.Errorf("remote alias changed from %v to %v",
+			remoteAlias, *alias)
+	}
+
+	if err := channel.SetRemoteAlias(*alias); err != nil {
+		return err
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+
+	f.remoteAliasMtx.Lock()
+	if remoteAliasSignal, ok := f.remoteAliasSignals[chanID]; ok {
+		close(remoteAliasSignal)
+		delete(f.remoteAliasSignals, chanID)
+	}
+	f.remoteAliasMtx.Unlock()
+
+	return nil
+}
+
 // channelProof is one half of the proof necessary to create an authenticated
 // announcement on the network. The two signatures individually sign a
 // statement of the existence of a channel.
@@ -2753,6 +3818,79 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		channelFlags = lnwire.FFAnnounceChannel
 	}
 
+	// If the caller requested a zero-conf channel, the remote peer must
+	// understand explicit channel types, zero-conf channels, and the
+	// aliases they are identified by until the funding transaction
+	// confirms. As aliases can't be announced, zero-conf channels must be
+	// private.
+	var chanType *lnwire.RawFeatureVector
+	if msg.openChanReq.zeroConf {
+		if !msg.openChanReq.private {
+			msg.err <- fmt.Errorf("zero-conf channels must be " +
+				"private")
+			return
+		}
+
+		remoteFeatures := msg.peer.RemoteLocalFeatures()
+		if !remoteFeatures.HasFeature(lnwire.ChannelTypeOptional) ||
+			!remoteFeatures.HasFeature(lnwire.ZeroConfOptional) ||
+			!remoteFeatures.HasFeature(lnwire.ScidAliasOptional) {
+
+			msg.err <- fmt.Errorf("peer %x does not support "+
+				"zero-conf channels",
+				peerKey.SerializeCompressed())
+			return
+		}
+
+		chanType = zeroConfChannelType()
+	}
+
+	// If the caller requested a dual funded channel, then the remote peer
+	// must support constructing the funding transaction interactively.
+	// As the responder's funds are only committed once the funding
+	// transaction confirms, dual funded channels can't be zero-conf, nor
+	// can we push any funds to the responder.
+	if msg.openChanReq.dualFund {
+		remoteFeatures := msg.peer.RemoteLocalFeatures()
+		switch {
+		case msg.openChanReq.zeroConf:
+			msg.err <- fmt.Errorf("dual funded channels can't be " +
+				"zero-conf")
+			return
+
+		case msg.pushAmt != 0:
+			msg.err <- fmt.Errorf("dual funded channels can't " +
+				"push funds to the remote peer")
+			return
+
+		case !remoteFeatures.HasFeature(lnwire.DualFundOptional):
+			msg.err <- fmt.Errorf("peer %x does not support "+
+				"dual funded channels",
+				peerKey.SerializeCompressed())
+			return
+		}
+	}
+
+	// If the caller specified an upfront shutdown script, then the remote
+	// peer must support the feature, as otherwise they won't enforce it.
+	// Otherwise, we'll fall back to our default script, if the peer
+	// understands upfront shutdown scripts at all.
+	shutdownScript := msg.shutdownScript
+	if len(shutdownScript) != 0 {
+		remoteFeatures := msg.peer.RemoteLocalFeatures()
+		if !remoteFeatures.HasFeature(
+			lnwire.UpfrontShutdownScriptOptional,
+		) {
+
+			msg.err <- fmt.Errorf("peer %x does not support "+
+				"upfront shutdown scripts",
+				peerKey.SerializeCompressed())
+			return
+		}
+	} else {
+		shutdownScript = f.defaultUpfrontShutdownScript(msg.peer)
+	}
+
 	// Initialize a funding reservation with the local wallet. If the
 	// wallet doesn't have enough funds to commit to this channel, then the
 	// request will fail, and be aborted.
@@ -2774,6 +3912,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		msg.err <- err
 		return
 	}
+	reservation.SetOurUpfrontShutdown(shutdownScript)
 
 	// Obtain a new pending channel ID which is used to track this
 	// reservation throughout its lifetime.
@@ -2807,11 +3946,15 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		chanAmt:        capacity,
 		remoteCsvDelay: remoteCsvDelay,
 		remoteMinHtlc:  minHtlc,
+		zeroConf:       msg.openChanReq.zeroConf,
 		reservation:    reservation,
 		peer:           msg.peer,
 		updates:        msg.updates,
 		err:            msg.err,
 	}
+	if msg.openChanReq.dualFund {
+		resCtx.dualFund = newInteractiveFunding(true)
+	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
 
@@ -2833,24 +3976,27 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		msg.peer.Address(), chanID)
 
 	fundingOpen := lnwire.OpenChannel{
-		ChainHash:            *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
-		PendingChannelID:     chanID,
-		FundingAmount:        capacity,
-		PushAmount:           msg.pushAmt,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		HtlcMinimum:          minHtlc,
-		FeePerKiloWeight:     uint32(commitFeePerKw),
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-		ChannelFlags:         channelFlags,
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingAmount:         capacity,
+		PushAmount:            msg.pushAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		HtlcMinimum:           minHtlc,
+		FeePerKiloWeight:      uint32(commitFeePerKw),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: shutdownScript,
+		ChannelType:           chanType,
+		DualFund:              msg.openChanReq.dualFund,
 	}
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
@@ -2868,6 +4014,43 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	}
 }
 
+// defaultUpfrontShutdownScript returns the default upfront shutdown script
+// that we should commit to when opening a channel with the given peer. If the
+// peer doesn't support upfront shutdown scripts, or no default script is
+// configured, then nil is returned.
+func (f *fundingManager) defaultUpfrontShutdownScript(
+	peer lnpeer.Peer) lnwire.DeliveryAddress {
+
+	if len(f.cfg.UpfrontShutdownScript) == 0 {
+		return nil
+	}
+
+	remoteFeatures := peer.RemoteLocalFeatures()
+	if !remoteFeatures.HasFeature(lnwire.UpfrontShutdownScriptOptional) {
+		fndgLog.Debugf("Peer %x does not support upfront shutdown "+
+			"scripts, not committing to default script",
+			peer.IdentityKey().SerializeCompressed())
+		return nil
+	}
+
+	return f.cfg.UpfrontShutdownScript
+}
+
+// validateUpfrontShutdownScript ensures that the passed upfront shutdown script
+// is one of the standard script types permitted within a Shutdown message:
+// p2pkh, p2sh, p2wpkh or p2wsh.
+func validateUpfrontShutdownScript(script lnwire.DeliveryAddress) error {
+	switch txscript.GetScriptClass(script) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+
+		return nil
+
+	default:
+		return lnwallet.ErrInvalidUpfrontShutdownScript(script)
+	}
+}
+
 // waitUntilChannelOpen is designed to prevent other lnd subsystems from
 // sending new update messages to a channel before the channel is fully
 // opened.
@@ -3003,9 +4186,23 @@ func (f *fundingManager) cancelReservationCtx(peerKey *btcec.PublicKey,
 			"peer(%x)", pendingChanID[:], peerIDKey[:])
 	}
 
-	if err := ctx.reservation.Cancel(); err != nil {
-		return nil, errors.Errorf("unable to cancel reservation: %v",
-			err)
+	// A dual funded channel may have already been persisted while we
+	// wait for the signatures of the funding transaction. If we have yet
+	// to release our own signatures, then the funding transaction can't
+	// be broadcast, so it's safe to forget the channel altogether.
+	// Otherwise, the channel is left pending until it's either confirmed
+	// or abandoned.
+	switch {
+	case ctx.dualFund != nil && ctx.dualFund.completeChan != nil:
+		if !ctx.dualFund.sigsSent {
+			f.deletePendingChannel(ctx.dualFund.completeChan)
+		}
+
+	default:
+		if err := ctx.reservation.Cancel(); err != nil {
+			return nil, errors.Errorf("unable to cancel "+
+				"reservation: %v", err)
+		}
 	}
 
 	delete(nodeReservations, pendingChanID)
@@ -3089,7 +4286,7 @@ func copyPubKey(pub *btcec.PublicKey) *btcec.PublicKey {
 // chanPoint to the channelOpeningStateBucket.
 func (f *fundingManager) saveChannelOpeningState(chanPoint *wire.OutPoint,
 	state channelOpeningState, shortChanID *lnwire.ShortChannelID) error {
-	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bbolt.Tx) error {
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx kvdb.Tx) error {
 
 		bucket, err := tx.CreateBucketIfNotExists(channelOpeningStateBucket)
 		if err != nil {
@@ -3119,7 +4316,7 @@ func (f *fundingManager) getChannelOpeningState(chanPoint *wire.OutPoint) (
 
 	var state channelOpeningState
 	var shortChanID lnwire.ShortChannelID
-	err := f.cfg.Wallet.Cfg.Database.View(func(tx *bbolt.Tx) error {
+	err := f.cfg.Wallet.Cfg.Database.View(func(tx kvdb.Tx) error {
 
 		bucket := tx.Bucket(channelOpeningStateBucket)
 		if bucket == nil {
@@ -3151,7 +4348,7 @@ func (f *fundingManager) getChannelOpeningState(chanPoint *wire.OutPoint) (
 
 // deleteChannelOpeningState removes any state for chanPoint from the database.
 func (f *fundingManager) deleteChannelOpeningState(chanPoint *wire.OutPoint) error {
-	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bbolt.Tx) error {
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(channelOpeningStateBucket)
 		if bucket == nil {
 			return fmt.Errorf("Bucket not found")
@@ -3,6 +3,7 @@
 package lnd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -18,8 +19,10 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
 
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
@@ -183,6 +186,18 @@ func (n *testNode) QuitSignal() <-chan struct{} {
 	return n.shutdownChannel
 }
 
+func (n *testNode) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.UpfrontShutdownScriptOptional,
+			lnwire.ChannelTypeOptional,
+			lnwire.ScidAliasOptional,
+			lnwire.ZeroConfOptional,
+			lnwire.DualFundOptional,
+		), lnwire.LocalFeatures,
+	)
+}
+
 func (n *testNode) AddNewChannel(channel *channeldb.OpenChannel,
 	quit <-chan struct{}) error {
 
@@ -252,7 +267,8 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 	shutdownChan := make(chan struct{})
 
 	wc := &mockWalletController{
-		rootKey: alicePrivKey,
+		rootKey:  alicePrivKey,
+		utxoHash: chainhash.HashH(privKey.Serialize()),
 	}
 	signer := &mockSigner{
 		key: alicePrivKey,
@@ -356,6 +372,14 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		ReportShortChanID: func(wire.OutPoint) error {
 			return nil
 		},
+		AcceptZeroConf: func(*btcec.PublicKey) bool {
+			return true
+		},
+		DualFundContribution: func(*btcec.PublicKey,
+			btcutil.Amount) btcutil.Amount {
+
+			return 0
+		},
 		PublishTransaction: func(txn *wire.MsgTx) error {
 			publTxChan <- txn
 			return nil
@@ -445,6 +469,8 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			TimeLockDelta: 10,
 		},
 		RequiredRemoteMaxValue: oldCfg.RequiredRemoteMaxValue,
+		AcceptZeroConf:         oldCfg.AcceptZeroConf,
+		DualFundContribution:   oldCfg.DualFundContribution,
 		PublishTransaction: func(txn *wire.MsgTx) error {
 			publishChan <- txn
 			return nil
@@ -685,6 +711,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
@@ -693,6 +721,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		sentMsg, ok = msg.(*lnwire.FundingSigned)
 	case "FundingLocked":
 		sentMsg, ok = msg.(*lnwire.FundingLocked)
+	case "TxSignatures":
+		sentMsg, ok = msg.(*lnwire.TxSignatures)
 	case "Error":
 		sentMsg, ok = msg.(*lnwire.Error)
 	default:
@@ -2668,3 +2698,729 @@ func TestFundingManagerMaxConfs(t *testing.T) {
 			string(err.Data))
 	}
 }
+
+// TestFundingManagerUpfrontShutdown ensures that an upfront shutdown script
+// specified within the open channel request is sent to the remote peer, and
+// that the remote peer rejects any script that isn't a standard type.
+func TestFundingManagerUpfrontShutdown(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We'll commit to a p2wpkh script, which is one of the permitted
+	// upfront shutdown script types.
+	shutdownScript := append([]byte{0x00, 0x14}, bytes.Repeat([]byte{1}, 20)...)
+
+	// Create a funding request and start the workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         false,
+		shutdownScript:  shutdownScript,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob, committing to
+	// the upfront shutdown script.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from "+
+			"alice, instead got %T", aliceMsg)
+	}
+	if !bytes.Equal(openChannelReq.UpfrontShutdownScript, shutdownScript) {
+		t.Fatalf("expected upfront shutdown script %x, got %x",
+			shutdownScript, openChannelReq.UpfrontShutdownScript)
+	}
+
+	// Now, we'll modify the script to one that isn't a standard type and
+	// let Bob handle the init message. He should reject it.
+	openChannelReq.UpfrontShutdownScript = lnwire.DeliveryAddress{
+		txscript.OP_RETURN,
+	}
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	err := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if !strings.Contains(string(err.Data), "upfront shutdown script") {
+		t.Fatalf("expected invalid upfront shutdown script error, "+
+			"got \"%v\"", string(err.Data))
+	}
+}
+
+// TestFundingManagerZeroConf checks that a zero-conf channel can be used
+// before its funding transaction confirms, using its alias as short channel
+// ID, and that the confirmed short channel ID is recorded once the funding
+// transaction confirms.
+func TestFundingManagerZeroConf(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Create a funding request for a zero-conf channel and start the
+	// workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	localAmt := btcutil.Amount(500000)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: localAmt,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         true,
+		zeroConf:        true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if openChannelReq.ChannelType == nil ||
+		!openChannelReq.ChannelType.Equals(zeroConfChannelType()) {
+
+		t.Fatalf("expected zero-conf channel type to be proposed")
+	}
+
+	// As Bob trusts Alice, he should accept the zero-conf channel type,
+	// and not require any confirmations.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	acceptedType := acceptChannelResponse.ChannelType
+	if acceptedType == nil || !acceptedType.Equals(zeroConfChannelType()) {
+
+		t.Fatalf("expected zero-conf channel type to be accepted")
+	}
+	if acceptChannelResponse.MinAcceptDepth != 0 {
+		t.Fatalf("expected min accept depth of 0, got %v",
+			acceptChannelResponse.MinAcceptDepth)
+	}
+
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	var publ *wire.MsgTx
+	select {
+	case publ = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	fundingOutPoint := &wire.OutPoint{
+		Hash:  publ.TxHash(),
+		Index: 0,
+	}
+
+	// Without the funding transaction being mined, both funding managers
+	// should consider the channel open and send fundingLocked.
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	// Both parties should tell each other about the alias they chose for
+	// the channel.
+	if fundingLockedAlice.AliasScid == nil ||
+		!fundingLockedAlice.AliasScid.IsAlias() {
+
+		t.Fatalf("expected alice to send her alias")
+	}
+	if fundingLockedBob.AliasScid == nil ||
+		!fundingLockedBob.AliasScid.IsAlias() {
+
+		t.Fatalf("expected bob to send his alias")
+	}
+	aliceAlias := *fundingLockedAlice.AliasScid
+	bobAlias := *fundingLockedBob.AliasScid
+
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+
+	// The channel is only added to the graph once the remote alias is
+	// known, as our channel update must be sent to the remote party using
+	// its alias.
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+	assertHandleFundingLocked(t, alice, bob)
+
+	assertChannelAnnouncements(t, alice, bob, localAmt)
+	waitForOpenUpdate(t, updateChan)
+
+	// Each party should identify the channel by its own alias, and record
+	// the alias of the remote party.
+	assertZeroConfShortChanIDs(
+		t, alice, fundingOutPoint, aliceAlias, bobAlias,
+		lnwire.ShortChannelID{},
+	)
+	assertZeroConfShortChanIDs(
+		t, bob, fundingOutPoint, bobAlias, aliceAlias,
+		lnwire.ShortChannelID{},
+	)
+
+	// Once the funding transaction confirms, the confirmed short channel
+	// ID should be recorded, while the alias is kept.
+	conf := &chainntnfs.TxConfirmation{
+		BlockHeight: 500,
+		TxIndex:     2,
+	}
+	alice.mockNotifier.oneConfChannel <- conf
+	bob.mockNotifier.oneConfChannel <- conf
+
+	confirmedID := lnwire.ShortChannelID{
+		BlockHeight: 500,
+		TxIndex:     2,
+		TxPosition:  uint16(fundingOutPoint.Index),
+	}
+	assertZeroConfShortChanIDs(
+		t, alice, fundingOutPoint, aliceAlias, bobAlias, confirmedID,
+	)
+	assertZeroConfShortChanIDs(
+		t, bob, fundingOutPoint, bobAlias, aliceAlias, confirmedID,
+	)
+}
+
+// TestFundingManagerZeroConfDeclined checks that a zero-conf channel request
+// from an untrusted peer is rejected, rather than silently falling back to a
+// channel that requires confirmations.
+func TestFundingManagerZeroConfDeclined(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	bob.fundingMgr.cfg.AcceptZeroConf = func(*btcec.PublicKey) bool {
+		return false
+	}
+
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         true,
+		zeroConf:        true,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             make(chan error, 1),
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	err := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if !strings.Contains(string(err.Data), "trusted peers") {
+		t.Fatalf("expected untrusted zero-conf error, got \"%v\"",
+			string(err.Data))
+	}
+}
+
+// assertZeroConfShortChanIDs asserts that the node's channel with the passed
+// funding outpoint eventually has the expected aliases and confirmed short
+// channel ID.
+func assertZeroConfShortChanIDs(t *testing.T, node *testNode,
+	fundingOutPoint *wire.OutPoint, alias, remoteAlias,
+	confirmedID lnwire.ShortChannelID) {
+
+	t.Helper()
+
+	var channel *channeldb.OpenChannel
+	for i := 0; i < testPollNumTries; i++ {
+		// If this is not the first try, sleep before retrying.
+		if i > 0 {
+			time.Sleep(testPollSleepMs * time.Millisecond)
+		}
+
+		var err error
+		channel, err = node.fundingMgr.cfg.FindChannel(
+			lnwire.NewChanIDFromOutPoint(fundingOutPoint),
+		)
+		if err != nil {
+			t.Fatalf("unable to find channel: %v", err)
+		}
+
+		if channel.ShortChanID() == alias &&
+			channel.RemoteAlias() == remoteAlias &&
+			channel.ConfirmedShortChanID() == confirmedID {
+
+			return
+		}
+	}
+
+	t.Fatalf("expected short_chan_id=%v, remote alias=%v and confirmed "+
+		"short_chan_id=%v, got %v, %v and %v", alias, remoteAlias,
+		confirmedID, channel.ShortChanID(), channel.RemoteAlias(),
+		channel.ConfirmedShortChanID())
+}
+
+// forwardInteractiveTxMsgs forwards the inputs and outputs sent by the sender
+// to the receiver, up to and including TxComplete.
+func forwardInteractiveTxMsgs(t *testing.T, sender, receiver *testNode) {
+	t.Helper()
+
+	for {
+		var msg lnwire.Message
+		select {
+		case msg = <-sender.msgChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("peer did not send TxComplete message")
+		}
+
+		switch msg.(type) {
+		case *lnwire.TxAddInput, *lnwire.TxAddOutput:
+		case *lnwire.TxComplete:
+		default:
+			t.Fatalf("expected interactive tx message, got %T", msg)
+		}
+
+		receiver.fundingMgr.processInteractiveTxMsg(msg, sender)
+
+		if _, ok := msg.(*lnwire.TxComplete); ok {
+			return
+		}
+	}
+}
+
+// assertPendingChannelBalances asserts that the node has a single pending
+// channel with the given capacity and balances.
+func assertPendingChannelBalances(t *testing.T, node *testNode,
+	capacity btcutil.Amount, localBalance, remoteBalance lnwire.MilliSatoshi) {
+
+	t.Helper()
+
+	pendingChannels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchPendingChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channels: %v", err)
+	}
+	if len(pendingChannels) != 1 {
+		t.Fatalf("expected 1 pending channel, got %v",
+			len(pendingChannels))
+	}
+
+	channel := pendingChannels[0]
+	if channel.ChanType != channeldb.DualFunder {
+		t.Fatalf("expected dual funded channel, got %v",
+			channel.ChanType)
+	}
+	if channel.Capacity != capacity {
+		t.Fatalf("expected capacity of %v, got %v", capacity,
+			channel.Capacity)
+	}
+
+	commitment := channel.LocalCommitment
+	if commitment.LocalBalance != localBalance {
+		t.Fatalf("expected local balance of %v, got %v", localBalance,
+			commitment.LocalBalance)
+	}
+	if commitment.RemoteBalance != remoteBalance {
+		t.Fatalf("expected remote balance of %v, got %v",
+			remoteBalance, commitment.RemoteBalance)
+	}
+}
+
+// TestFundingManagerDualFunded checks that both parties are able to contribute
+// to a channel by constructing its funding transaction interactively.
+func TestFundingManagerDualFunded(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Bob will contribute less than Alice to the channel.
+	localAmt := btcutil.Amount(500000)
+	remoteAmt := btcutil.Amount(300000)
+	bob.fundingMgr.cfg.DualFundContribution = func(*btcec.PublicKey,
+		btcutil.Amount) btcutil.Amount {
+
+		return remoteAmt
+	}
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: localAmt,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		dualFund:        true,
+		updates:         updateChan,
+		err:             make(chan error, 1),
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !openChannelReq.DualFund {
+		t.Fatalf("expected dual funding to be proposed")
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if acceptChannelResponse.FundingAmount != remoteAmt {
+		t.Fatalf("expected bob to contribute %v, got %v", remoteAmt,
+			acceptChannelResponse.FundingAmount)
+	}
+
+	// Alice should now send over her inputs and outputs, after which Bob
+	// follows up with his own.
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	forwardInteractiveTxMsgs(t, alice, bob)
+	forwardInteractiveTxMsgs(t, bob, alice)
+
+	// With the funding transaction constructed, the commitment signatures
+	// are exchanged as usual.
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	// Bob should release the signatures for his inputs right after.
+	bobTxSigs := assertFundingMsgSent(
+		t, bob.msgChan, "TxSignatures",
+	).(*lnwire.TxSignatures)
+	if bobTxSigs.TxHash != fundingCreated.FundingPoint.Hash {
+		t.Fatalf("expected signatures for funding tx %v, got %v",
+			fundingCreated.FundingPoint.Hash, bobTxSigs.TxHash)
+	}
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	// Alice shouldn't broadcast the funding transaction before receiving
+	// Bob's signatures.
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("alice published funding tx without bob's signatures")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	alice.fundingMgr.processInteractiveTxMsg(bobTxSigs, bob)
+	aliceTxSigs := assertFundingMsgSent(
+		t, alice.msgChan, "TxSignatures",
+	).(*lnwire.TxSignatures)
+
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	var alicePubl *wire.MsgTx
+	select {
+	case alicePubl = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+
+	// Bob should broadcast the same, fully signed funding transaction
+	// once he receives Alice's signatures.
+	bob.fundingMgr.processInteractiveTxMsg(aliceTxSigs, alice)
+
+	var bobPubl *wire.MsgTx
+	select {
+	case bobPubl = <-bob.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("bob did not publish funding tx")
+	}
+
+	fundingOutPoint := &fundingCreated.FundingPoint
+	if alicePubl.TxHash() != fundingOutPoint.Hash ||
+		bobPubl.TxHash() != fundingOutPoint.Hash {
+
+		t.Fatalf("expected funding tx %v to be published, got %v "+
+			"and %v", fundingOutPoint.Hash, alicePubl.TxHash(),
+			bobPubl.TxHash())
+	}
+	for i, txIn := range alicePubl.TxIn {
+		if len(txIn.Witness) == 0 {
+			t.Fatalf("funding input %v isn't signed", i)
+		}
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+
+	// Both parties should have their contribution as their balance, with
+	// Alice paying the commitment fee as the initiator.
+	capacity := localAmt + remoteAmt
+	aliceChannels, err := alice.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchPendingChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channels: %v", err)
+	}
+	if len(aliceChannels) != 1 {
+		t.Fatalf("expected 1 pending channel, got %v",
+			len(aliceChannels))
+	}
+	commitFee := aliceChannels[0].LocalCommitment.CommitFee
+	assertPendingChannelBalances(
+		t, alice, capacity,
+		lnwire.NewMSatFromSatoshis(localAmt-commitFee),
+		lnwire.NewMSatFromSatoshis(remoteAmt),
+	)
+	assertPendingChannelBalances(
+		t, bob, capacity, lnwire.NewMSatFromSatoshis(remoteAmt),
+		lnwire.NewMSatFromSatoshis(localAmt-commitFee),
+	)
+
+	// Once the funding transaction confirms, both parties should consider
+	// the channel open.
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{}
+	bob.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{}
+
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+	assertFundingMsgSent(t, alice.msgChan, "FundingLocked")
+	assertFundingMsgSent(t, bob.msgChan, "FundingLocked")
+}
+
+// TestFundingManagerDualFundDeclined checks that a dual funded channel falls
+// back to the single funder workflow if the responder doesn't contribute.
+func TestFundingManagerDualFundDeclined(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		dualFund:        true,
+		updates:         updateChan,
+		err:             make(chan error, 1),
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if acceptChannelResponse.FundingAmount != 0 {
+		t.Fatalf("expected bob not to contribute, got %v",
+			acceptChannelResponse.FundingAmount)
+	}
+
+	// Alice should continue with the single funder workflow.
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	select {
+	case <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+}
+
+// TestInteractiveFundingRemoteInput checks that the value and script of the
+// outputs spent by the remote party's funding inputs are taken from the
+// previous transaction it sends along, and that invalid inputs are rejected.
+func TestInteractiveFundingRemoteInput(t *testing.T) {
+	t.Parallel()
+
+	witnessScript := []byte{txscript.OP_0, 20}
+	witnessScript = append(witnessScript, make([]byte, 20)...)
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(&wire.TxIn{})
+	prevTx.AddTxOut(wire.NewTxOut(100000, []byte{txscript.OP_TRUE}))
+	prevTx.AddTxOut(wire.NewTxOut(200000, witnessScript))
+
+	dualFund := newInteractiveFunding(true)
+	dualFund.remoteContribution = &lnwallet.ChannelContribution{}
+
+	testCases := []struct {
+		name     string
+		serialID uint64
+		vout     uint32
+		valid    bool
+	}{
+		{
+			name:     "output doesn't exist",
+			serialID: 1,
+			vout:     2,
+		},
+		{
+			name:     "output isn't a witness program",
+			serialID: 3,
+			vout:     0,
+		},
+		{
+			name:     "valid input",
+			serialID: 5,
+			vout:     1,
+			valid:    true,
+		},
+		{
+			name:     "duplicate input",
+			serialID: 7,
+			vout:     1,
+		},
+	}
+
+	for _, test := range testCases {
+		err := dualFund.addRemoteInput(&lnwire.TxAddInput{
+			SerialID:   test.serialID,
+			PrevTx:     prevTx,
+			PrevTxVout: test.vout,
+		})
+		if test.valid && err != nil {
+			t.Fatalf("%v: unable to add input: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%v: expected input to be rejected", test.name)
+		}
+	}
+
+	prevOutPoint := wire.OutPoint{Hash: prevTx.TxHash(), Index: 1}
+	prevOut, ok := dualFund.remoteContribution.PrevOutputs[prevOutPoint]
+	if !ok {
+		t.Fatalf("input %v wasn't added", prevOutPoint)
+	}
+	if prevOut.Value != 200000 {
+		t.Fatalf("expected input value of 200000, got %v",
+			prevOut.Value)
+	}
+}
+
+// TestInteractiveFundingRemoteOutput checks that the remote party's outputs
+// to the funding transaction are rejected if they're non-standard or below the
+// dust limit for their script type.
+func TestInteractiveFundingRemoteOutput(t *testing.T) {
+	t.Parallel()
+
+	p2wkhScript := []byte{txscript.OP_0, 20}
+	p2wkhScript = append(p2wkhScript, make([]byte, 20)...)
+	p2wshScript := []byte{txscript.OP_0, 32}
+	p2wshScript = append(p2wshScript, make([]byte, 32)...)
+
+	p2wkhDust := txrules.GetDustThreshold(
+		len(p2wkhScript), txrules.DefaultRelayFeePerKb,
+	)
+	p2wshDust := txrules.GetDustThreshold(
+		len(p2wshScript), txrules.DefaultRelayFeePerKb,
+	)
+
+	dualFund := newInteractiveFunding(true)
+	dualFund.remoteContribution = &lnwallet.ChannelContribution{}
+
+	testCases := []struct {
+		name     string
+		serialID uint64
+		amount   btcutil.Amount
+		pkScript []byte
+		valid    bool
+	}{
+		{
+			name:     "non-standard script",
+			serialID: 1,
+			amount:   100000,
+			pkScript: []byte{txscript.OP_TRUE},
+		},
+		{
+			name:     "p2wkh output below dust limit",
+			serialID: 3,
+			amount:   p2wkhDust - 1,
+			pkScript: p2wkhScript,
+		},
+		{
+			name:     "p2wkh output at dust limit",
+			serialID: 5,
+			amount:   p2wkhDust,
+			pkScript: p2wkhScript,
+			valid:    true,
+		},
+		{
+			name:     "p2wsh output below its own dust limit",
+			serialID: 7,
+			amount:   p2wkhDust,
+			pkScript: p2wshScript,
+		},
+		{
+			name:     "p2wsh output at dust limit",
+			serialID: 9,
+			amount:   p2wshDust,
+			pkScript: p2wshScript,
+			valid:    true,
+		},
+	}
+
+	for _, test := range testCases {
+		err := dualFund.addRemoteOutput(&lnwire.TxAddOutput{
+			SerialID: test.serialID,
+			Amount:   test.amount,
+			PkScript: test.pkScript,
+		})
+		if test.valid && err != nil {
+			t.Fatalf("%v: unable to add output: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%v: expected output to be rejected", test.name)
+		}
+	}
+
+	outputs := dualFund.remoteContribution.ChangeOutputs
+	if len(outputs) != 2 {
+		t.Fatalf("expected 2 outputs, got %v", len(outputs))
+	}
+}
@@ -256,19 +256,19 @@ type SphinxErrorDecrypter struct {
 // NOTE: Part of the ErrorDecrypter interface.
 func (s *SphinxErrorDecrypter) DecryptError(reason lnwire.OpaqueReason) (*ForwardingError, error) {
 
-	source, failureData, err := s.OnionErrorDecrypter.DecryptError(reason)
+	decryptedError, err := s.OnionErrorDecrypter.DecryptError(reason)
 	if err != nil {
 		return nil, err
 	}
 
-	r := bytes.NewReader(failureData)
+	r := bytes.NewReader(decryptedError.Message)
 	failureMsg, err := lnwire.DecodeFailure(r, 0)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ForwardingError{
-		ErrorSource:    source,
+		ErrorSource:    decryptedError.Sender,
 		FailureMessage: failureMsg,
 	}, nil
 }
@@ -86,6 +86,13 @@ type ChannelLink interface {
 	// the original funding output can be found.
 	ShortChanID() lnwire.ShortChannelID
 
+	// ConfirmedShortChanID returns the short channel ID that encodes the
+	// location of the confirmed funding transaction. It differs from
+	// ShortChanID for zero-conf channels, which are identified by their
+	// alias, and is empty as long as their funding transaction is
+	// unconfirmed.
+	ConfirmedShortChanID() lnwire.ShortChannelID
+
 	// UpdateShortChanID updates the short channel ID for a link. This may
 	// be required in the event that a link is created before the short
 	// chan ID for it is known, or a re-org occurs, and the funding
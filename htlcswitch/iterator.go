@@ -241,6 +241,11 @@ func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 		}
 	}
 
+	// We're only able to forward HTLCs carrying a legacy hop payload.
+	if sphinxPacket.ForwardingInstructions == nil {
+		return nil, lnwire.CodeInvalidRealm
+	}
+
 	return makeSphinxHopIterator(onionPkt, sphinxPacket), lnwire.CodeNone
 }
 
@@ -389,6 +394,13 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 			continue
 		}
 
+		// We're only able to forward HTLCs carrying a legacy hop
+		// payload.
+		if packets[i].ForwardingInstructions == nil {
+			resp.FailCode = lnwire.CodeInvalidRealm
+			continue
+		}
+
 		// Finally, construct a hop iterator from our processed sphinx
 		// packet, simultaneously caching the original onion packet.
 		resp.HopIterator = makeSphinxHopIterator(&onionPkts[i], &packets[i])
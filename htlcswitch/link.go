@@ -347,6 +347,11 @@ type channelLink struct {
 	// or on shutdown to avoid doing a write for each preimage received.
 	uncommittedPreimages []lntypes.Preimage
 
+	// spliceCommitSigs buffers the signatures of the remote peer for the
+	// variants of the next commitment spending the funding outputs of
+	// pending splices, which precede the CommitSig they belong to.
+	spliceCommitSigs []*lnwire.SpliceCommitSig
+
 	sync.RWMutex
 
 	// hodlQueue is used to receive exit hop htlc resolutions from invoice
@@ -632,6 +637,14 @@ func (l *channelLink) syncChanStates() error {
 			fundingLockedMsg := lnwire.NewFundingLocked(
 				l.ChanID(), nextRevocation,
 			)
+
+			// A zero-conf channel is referred to by our alias,
+			// which the remote party may not have received yet.
+			alias := l.channel.ShortChanID()
+			if alias.IsAlias() {
+				fundingLockedMsg.AliasScid = &alias
+			}
+
 			err = l.cfg.Peer.SendMessage(false, fundingLockedMsg)
 			if err != nil {
 				return fmt.Errorf("unable to re-send "+
@@ -1638,6 +1651,11 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			return
 		}
 
+	case *lnwire.SpliceCommitSig:
+		// The signature is processed along with the CommitSig that
+		// follows it.
+		l.spliceCommitSigs = append(l.spliceCommitSigs, msg)
+
 	case *lnwire.CommitSig:
 		// Since we may have learned new preimages for the first time,
 		// we'll add them to our preimage cache. By doing this, we
@@ -1671,7 +1689,11 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		// We just received a new updates to our local commitment
 		// chain, validate this new commitment, closing the link if
 		// invalid.
-		err = l.channel.ReceiveNewCommitment(msg.CommitSig, msg.HtlcSigs)
+		spliceSigs := l.spliceCommitSigs
+		l.spliceCommitSigs = nil
+		err = l.channel.ReceiveNewCommitmentWithSplices(
+			msg.CommitSig, msg.HtlcSigs, spliceSigs,
+		)
 		if err != nil {
 			// If we were unable to reconstruct their proposed
 			// commitment, then we'll examine the type of error. If
@@ -1884,7 +1906,8 @@ func (l *channelLink) updateCommitTx() error {
 		return nil
 	}
 
-	theirCommitSig, htlcSigs, err := l.channel.SignNextCommitment()
+	theirCommitSig, htlcSigs, spliceSigs, err :=
+		l.channel.SignNextCommitmentWithSplices()
 	if err == lnwallet.ErrNoWindow {
 		l.tracef("revocation window exhausted, unable to send: %v, "+
 			"dangling_opens=%v, dangling_closes%v",
@@ -1904,12 +1927,18 @@ func (l *channelLink) updateCommitTx() error {
 		return err
 	}
 
-	commitSig := &lnwire.CommitSig{
+	// The signatures for the variants spending the funding outputs of
+	// pending splices are sent ahead of the CommitSig they belong to.
+	msgs := make([]lnwire.Message, 0, len(spliceSigs)+1)
+	for _, spliceSig := range spliceSigs {
+		msgs = append(msgs, spliceSig)
+	}
+	msgs = append(msgs, &lnwire.CommitSig{
 		ChanID:    l.ChanID(),
 		CommitSig: theirCommitSig,
 		HtlcSigs:  htlcSigs,
-	}
-	l.cfg.Peer.SendMessage(false, commitSig)
+	})
+	l.cfg.Peer.SendMessage(false, msgs...)
 
 	// We've just initiated a state transition, attempt to stop the
 	// logCommitTimer. If the timer already ticked, then we'll consume the
@@ -1955,6 +1984,15 @@ func (l *channelLink) ShortChanID() lnwire.ShortChannelID {
 	return l.shortChanID
 }
 
+// ConfirmedShortChanID returns the short channel ID that encodes the location
+// of the confirmed funding transaction. It differs from ShortChanID for
+// zero-conf channels, which are identified by their alias.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ConfirmedShortChanID() lnwire.ShortChannelID {
+	return l.channel.State().ConfirmedShortChanID()
+}
+
 // UpdateShortChanID updates the short channel ID for a link. This may be
 // required in the event that a link is created before the short chan ID for it
 // is known, or a re-org occurs, and the funding transaction changes location
@@ -1979,6 +2017,7 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	l.infof("Updating to short_chan_id=%v for chan_id=%v", sid, chanID)
 
 	l.Lock()
+	oldSid := l.shortChanID
 	l.shortChanID = sid
 	l.Unlock()
 
@@ -1994,9 +2033,13 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	}()
 
 	// Now that the short channel ID has been properly updated, we can begin
-	// garbage collecting any forwarding packages we create.
-	l.wg.Add(1)
-	go l.fwdPkgGarbager()
+	// garbage collecting any forwarding packages we create. If the link
+	// already had a live short channel ID, the garbage collector is
+	// already running.
+	if oldSid == sourceHop {
+		l.wg.Add(1)
+		go l.fwdPkgGarbager()
+	}
 
 	return sid, nil
 }
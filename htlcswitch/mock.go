@@ -264,6 +264,10 @@ func (s *mockServer) QuitSignal() <-chan struct{} {
 	return s.quit
 }
 
+func (s *mockServer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 // mockHopIterator represents the test version of hop iterator which instead
 // of encrypting the path in onion blob just stores the path as a list of hops.
 type mockHopIterator struct {
@@ -710,6 +714,7 @@ func (f *mockChannelLink) Start() error {
 
 func (f *mockChannelLink) ChanID() lnwire.ChannelID                     { return f.chanID }
 func (f *mockChannelLink) ShortChanID() lnwire.ShortChannelID           { return f.shortChanID }
+func (f *mockChannelLink) ConfirmedShortChanID() lnwire.ShortChannelID  { return f.shortChanID }
 func (f *mockChannelLink) Bandwidth() lnwire.MilliSatoshi               { return 99999999 }
 func (f *mockChannelLink) Peer() lnpeer.Peer                            { return f.peer }
 func (f *mockChannelLink) ChannelPoint() *wire.OutPoint                 { return &wire.OutPoint{} }
@@ -12,11 +12,11 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/contractcourt"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
@@ -116,6 +116,17 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw lnwallet.SatPerKWeight
 
+	// MaxFeePerKw is the highest fee rate that we're willing to pay for
+	// the cooperative closure transaction. If this is zero, then no upper
+	// bound will be enforced during fee negotiation. This value is only
+	// utilized if the closure type is CloseRegular.
+	MaxFeePerKw lnwallet.SatPerKWeight
+
+	// DeliveryScript is an optional script that our settled channel funds
+	// should be paid out to. If this is nil, then a fresh address from the
+	// internal wallet will be used instead.
+	DeliveryScript lnwire.DeliveryAddress
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan interface{}
@@ -1390,7 +1401,7 @@ func (s *Switch) closeCircuit(pkt *htlcPacket) (*PaymentCircuit, error) {
 // we're the originator of the payment, so the link stops attempting to
 // re-broadcast.
 func (s *Switch) ackSettleFail(settleFailRef channeldb.SettleFailRef) error {
-	return s.cfg.DB.Batch(func(tx *bbolt.Tx) error {
+	return s.cfg.DB.Batch(func(tx kvdb.Tx) error {
 		return s.cfg.SwitchPackager.AckSettleFails(tx, settleFailRef)
 	})
 }
@@ -1452,11 +1463,13 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then targetFeePerKw should be the ideal fee-per-kw that will be used as a
+// starting point for close negotiation, maxFeePerKw an optional ceiling for
+// the negotiated fee rate, and deliveryScript an optional script to pay our
+// settled funds to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan interface{},
-	chan error) {
+	targetFeePerKw, maxFeePerKw lnwallet.SatPerKWeight,
+	deliveryScript lnwire.DeliveryAddress) (chan interface{}, chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
 	updateChan := make(chan interface{}, 2)
@@ -1467,6 +1480,8 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKw: targetFeePerKw,
+		MaxFeePerKw:    maxFeePerKw,
+		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
 
@@ -1800,7 +1815,7 @@ func (s *Switch) reforwardResponses() error {
 func (s *Switch) loadChannelFwdPkgs(source lnwire.ShortChannelID) ([]*channeldb.FwdPkg, error) {
 
 	var fwdPkgs []*channeldb.FwdPkg
-	if err := s.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	if err := s.cfg.DB.Update(func(tx kvdb.Tx) error {
 		var err error
 		fwdPkgs, err = s.cfg.SwitchPackager.LoadChannelFwdPkgs(
 			tx, source,
@@ -1989,6 +2004,7 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	// in the multi-hop setting.
 	s.linkIndex[link.ChanID()] = link
 	s.forwardingIndex[link.ShortChanID()] = link
+	s.addConfirmedShortChanID(link)
 
 	// Next we'll add the link to the interface index so we can
 	// quickly look up all the channels for a particular node.
@@ -2004,6 +2020,24 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	}
 }
 
+// addConfirmedShortChanID adds the confirmed short channel ID of a zero-conf
+// link to the forwarding index, next to its alias. Links that aren't zero-conf,
+// or whose funding transaction is still unconfirmed, are ignored.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) addConfirmedShortChanID(link ChannelLink) {
+	confirmedID := link.ConfirmedShortChanID()
+	if confirmedID == sourceHop || confirmedID == link.ShortChanID() {
+		return
+	}
+
+	log.Infof("Adding confirmed short_chan_id=%v for ChannelLink(%v) "+
+		"with alias short_chan_id=%v", confirmedID, link.ChanID(),
+		link.ShortChanID())
+
+	s.forwardingIndex[confirmedID] = link
+}
+
 // GetLink is used to initiate the handling of the get link command. The
 // request will be propagated/handled to/in the main goroutine.
 func (s *Switch) GetLink(chanID lnwire.ChannelID) (ChannelLink, error) {
@@ -2084,6 +2118,9 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if s.forwardingIndex[link.ConfirmedShortChanID()] == link {
+		delete(s.forwardingIndex, link.ConfirmedShortChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2104,15 +2141,31 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 // UpdateShortChanID updates the short chan ID for an existing channel. This is
 // required in the case of a re-org and re-confirmation or a channel, or in the
 // case that a link was added to the switch before its short chan ID was known.
+// For live zero-conf links, which keep forwarding using their alias, the
+// confirmed short chan ID is added to the forwarding index once known.
 func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	s.indexMtx.Lock()
 	defer s.indexMtx.Unlock()
 
 	// Locate the target link in the pending link index. If no such link
-	// exists, then we will ignore the request.
+	// exists, then we'll check whether it's a live zero-conf link whose
+	// funding transaction just confirmed.
 	link, ok := s.pendingLinkIndex[chanID]
 	if !ok {
-		return fmt.Errorf("link %v not found", chanID)
+		link, ok = s.linkIndex[chanID]
+		if !ok || !link.ShortChanID().IsAlias() {
+			return fmt.Errorf("link %v not found", chanID)
+		}
+
+		// Refresh the link's channel state so that it learns about
+		// the confirmed short chan ID.
+		if _, err := link.UpdateShortChanID(); err != nil {
+			return err
+		}
+
+		s.addConfirmedShortChanID(link)
+
+		return nil
 	}
 
 	oldShortChanID := link.ShortChanID()
@@ -41,6 +41,13 @@ type Input interface {
 	// HeightHint returns the minimum height at which a confirmed spending
 	// tx can occur.
 	HeightHint() uint32
+
+	// DeadlineHeight returns the absolute block height before which the
+	// input must be swept, and a boolean indicating whether such a
+	// deadline exists at all. Missing the deadline may result in a loss
+	// of funds, for example when the remote party is able to time out an
+	// incoming HTLC.
+	DeadlineHeight() (uint32, bool)
 }
 
 type inputKit struct {
@@ -48,6 +55,15 @@ type inputKit struct {
 	witnessType WitnessType
 	signDesc    SignDescriptor
 	heightHint  uint32
+
+	// blockToMaturity is the relative timelock, as a number of blocks,
+	// that must be built on top of the confirmation height of the output
+	// before it can be spent.
+	blockToMaturity uint32
+
+	// deadlineHeight is the absolute block height before which the input
+	// must be swept. A value of zero indicates that there is no deadline.
+	deadlineHeight uint32
 }
 
 // OutPoint returns the breached output's identifier that is to be included as
@@ -74,6 +90,19 @@ func (i *inputKit) HeightHint() uint32 {
 	return i.heightHint
 }
 
+// DeadlineHeight returns the absolute block height before which the input
+// must be swept, and a boolean indicating whether such a deadline exists.
+func (i *inputKit) DeadlineHeight() (uint32, bool) {
+	return i.deadlineHeight, i.deadlineHeight != 0
+}
+
+// SetDeadlineHeight sets the absolute block height before which the input
+// must be swept. It must be called before the input is offered to the
+// sweeper.
+func (i *inputKit) SetDeadlineHeight(height uint32) {
+	i.deadlineHeight = height
+}
+
 // BaseInput contains all the information needed to sweep a basic output
 // (CSV/CLTV/no time lock)
 type BaseInput struct {
@@ -107,6 +136,24 @@ func NewBaseInput(outpoint *wire.OutPoint, witnessType WitnessType,
 	return &input
 }
 
+// NewCsvInput assembles a new csv-locked input that can be used to construct
+// a sweep transaction. The input can only be spent once blockToMaturity
+// blocks have been built on top of its confirmation.
+func NewCsvInput(outpoint *wire.OutPoint, witnessType WitnessType,
+	signDescriptor *SignDescriptor, heightHint uint32,
+	blockToMaturity uint32) *BaseInput {
+
+	return &BaseInput{
+		inputKit{
+			outpoint:        *outpoint,
+			witnessType:     witnessType,
+			signDesc:        *signDescriptor,
+			heightHint:      heightHint,
+			blockToMaturity: blockToMaturity,
+		},
+	}
+}
+
 // CraftInputScript returns a valid set of input scripts allowing this output
 // to be spent. The returns input scripts should target the input at location
 // txIndex within the passed transaction. The input scripts generated by this
@@ -125,7 +172,7 @@ func (bi *BaseInput) CraftInputScript(signer Signer, txn *wire.MsgTx,
 // must be built on top of the confirmation height before the output can be
 // spent. For non-CSV locked inputs this is always zero.
 func (bi *BaseInput) BlocksToMaturity() uint32 {
-	return 0
+	return bi.blockToMaturity
 }
 
 // HtlcSucceedInput constitutes a sweep input that needs a pre-image. The input
@@ -184,7 +231,61 @@ func (h *HtlcSucceedInput) BlocksToMaturity() uint32 {
 	return 0
 }
 
+// SwapHtlcSuccessInput constitutes a sweep input that claims the on-chain HTLC
+// of a submarine swap using the swap preimage.
+type SwapHtlcSuccessInput struct {
+	inputKit
+
+	preimage []byte
+}
+
+// MakeSwapHtlcSuccessInput assembles a new swap HTLC success input that can be
+// used to construct a sweep transaction.
+func MakeSwapHtlcSuccessInput(outpoint *wire.OutPoint,
+	signDescriptor *SignDescriptor, preimage []byte,
+	heightHint uint32) SwapHtlcSuccessInput {
+
+	return SwapHtlcSuccessInput{
+		inputKit: inputKit{
+			outpoint:    *outpoint,
+			witnessType: SwapHtlcSuccess,
+			signDesc:    *signDescriptor,
+			heightHint:  heightHint,
+		},
+		preimage: preimage,
+	}
+}
+
+// CraftInputScript returns a valid set of input scripts allowing this output
+// to be spent. The returns input scripts should target the input at location
+// txIndex within the passed transaction.
+func (s *SwapHtlcSuccessInput) CraftInputScript(signer Signer,
+	txn *wire.MsgTx, hashCache *txscript.TxSigHashes,
+	txinIdx int) (*Script, error) {
+
+	desc := s.signDesc
+	desc.SigHashes = hashCache
+	desc.InputIndex = txinIdx
+
+	witness, err := SwapHtlcSpendSuccess(signer, &desc, txn, s.preimage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Script{
+		Witness: witness,
+	}, nil
+}
+
+// BlocksToMaturity returns the relative timelock, as a number of blocks, that
+// must be built on top of the confirmation height before the output can be
+// spent.
+func (s *SwapHtlcSuccessInput) BlocksToMaturity() uint32 {
+	return 0
+}
+
 // Compile-time constraints to ensure each input struct implement the Input
 // interface.
 var _ Input = (*BaseInput)(nil)
 var _ Input = (*HtlcSucceedInput)(nil)
+var _ Input = (*SwapHtlcSuccessInput)(nil)
@@ -760,6 +760,103 @@ func HtlcSecondLevelSpend(signer Signer, signDesc *SignDescriptor,
 	return witnessStack, nil
 }
 
+// SwapHtlcScript constructs the public key script for the on-chain leg of a
+// submarine swap. The output can either be claimed by the receiver once the
+// swap preimage is known, or swept back by the sender after an absolute
+// timeout.
+//
+// Possible Input Scripts:
+//    RECVR: <recvr sig> <preimage>
+//    SENDR: <sendr sig> 0 (after the absolute timeout)
+//
+// OP_SIZE 32 OP_EQUAL
+// OP_IF
+//     OP_HASH160 <ripemd160(swap hash)> OP_EQUALVERIFY
+//     <receiver key>
+// OP_ELSE
+//     OP_DROP
+//     <cltv expiry> OP_CHECKLOCKTIMEVERIFY OP_DROP
+//     <sender key>
+// OP_ENDIF
+// OP_CHECKSIG
+func SwapHtlcScript(cltvExpiry uint32, senderKey, receiverKey *btcec.PublicKey,
+	swapHash []byte) ([]byte, error) {
+
+	builder := txscript.NewScriptBuilder()
+
+	// The size of the top stack element decides which clause we'll
+	// execute: only a 32-byte element can be the swap preimage.
+	builder.AddOp(txscript.OP_SIZE)
+	builder.AddInt64(32)
+	builder.AddOp(txscript.OP_EQUAL)
+	builder.AddOp(txscript.OP_IF)
+
+	// In the success clause, the element must hash to the swap hash, in
+	// which case the receiver is able to claim the output.
+	builder.AddOp(txscript.OP_HASH160)
+	builder.AddData(Ripemd160H(swapHash))
+	builder.AddOp(txscript.OP_EQUALVERIFY)
+	builder.AddData(receiverKey.SerializeCompressed())
+
+	builder.AddOp(txscript.OP_ELSE)
+
+	// Otherwise, we'll drop the element and require that the absolute
+	// timeout has passed before the sender can reclaim the funds.
+	builder.AddOp(txscript.OP_DROP)
+	builder.AddInt64(int64(cltvExpiry))
+	builder.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	builder.AddOp(txscript.OP_DROP)
+	builder.AddData(senderKey.SerializeCompressed())
+
+	builder.AddOp(txscript.OP_ENDIF)
+
+	// Whichever key ended up on the stack must have signed the spending
+	// transaction.
+	builder.AddOp(txscript.OP_CHECKSIG)
+
+	return builder.Script()
+}
+
+// SwapHtlcSpendSuccess constructs a valid witness allowing the receiver of a
+// swap HTLC to claim the output using the swap preimage.
+func SwapHtlcSpendSuccess(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx, preimage []byte) (wire.TxWitness, error) {
+
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	witnessStack := wire.TxWitness(make([][]byte, 3))
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = preimage
+	witnessStack[2] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
+// SwapHtlcSpendTimeout constructs a valid witness allowing the sender of a
+// swap HTLC to reclaim the output once its absolute timeout has passed. The
+// caller is expected to have set the lock time of the sweep transaction to a
+// height at or beyond the expiry of the HTLC.
+func SwapHtlcSpendTimeout(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx) (wire.TxWitness, error) {
+
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// We place an empty element in the place of the preimage in order to
+	// force execution into the timeout clause.
+	witnessStack := wire.TxWitness(make([][]byte, 3))
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = nil
+	witnessStack[2] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
 // LockTimeToSequence converts the passed relative locktime to a sequence
 // number in accordance to BIP-68.
 // See: https://github.com/bitcoin/bips/blob/master/bip-0068.mediawiki
@@ -847,6 +847,175 @@ func TestSecondLevelHtlcSpends(t *testing.T) {
 	}
 }
 
+// TestSwapHtlcSpends checks that the on-chain HTLC of a submarine swap can
+// only be claimed by the receiver with the swap preimage, or by the sender once
+// the absolute timeout has passed.
+func TestSwapHtlcSpends(t *testing.T) {
+	t.Parallel()
+
+	const (
+		htlcAmt    = btcutil.Amount(1 * 10e8)
+		cltvExpiry = 500
+	)
+
+	// Alice will act as the sender of the swap HTLC, while Bob is able to
+	// claim it with the preimage.
+	aliceKeyPriv, aliceKeyPub := btcec.PrivKeyFromBytes(btcec.S256(),
+		testWalletPrivKey)
+	bobKeyPriv, bobKeyPub := btcec.PrivKeyFromBytes(btcec.S256(),
+		bobsPrivKey)
+
+	var preimage [32]byte
+	copy(preimage[:], bytes.Repeat([]byte{2}, 32))
+	swapHash := sha256.Sum256(preimage[:])
+
+	htlcWitnessScript, err := SwapHtlcScript(
+		cltvExpiry, aliceKeyPub, bobKeyPub, swapHash[:],
+	)
+	if err != nil {
+		t.Fatalf("unable to create htlc script: %v", err)
+	}
+	if len(htlcWitnessScript) > SwapHtlcScriptSize {
+		t.Fatalf("script size %v exceeds estimate %v",
+			len(htlcWitnessScript), SwapHtlcScriptSize)
+	}
+	htlcPkScript, err := WitnessScriptHash(htlcWitnessScript)
+	if err != nil {
+		t.Fatalf("unable to create htlc output: %v", err)
+	}
+	htlcOutput := &wire.TxOut{
+		PkScript: htlcPkScript,
+		Value:    int64(htlcAmt),
+	}
+
+	txid, err := chainhash.NewHash(testHdSeed.CloneBytes())
+	if err != nil {
+		t.Fatalf("unable to create txid: %v", err)
+	}
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: *txid},
+		Sequence:         0,
+	})
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: []byte("doesn't matter"),
+		Value:    1 * 10e8,
+	})
+
+	aliceSigner := &MockSigner{Privkeys: []*btcec.PrivateKey{aliceKeyPriv}}
+	bobSigner := &MockSigner{Privkeys: []*btcec.PrivateKey{bobKeyPriv}}
+
+	signDesc := func(pub *btcec.PublicKey) *SignDescriptor {
+		return &SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: pub,
+			},
+			WitnessScript: htlcWitnessScript,
+			Output:        htlcOutput,
+			HashType:      txscript.SigHashAll,
+			SigHashes:     txscript.NewTxSigHashes(sweepTx),
+			InputIndex:    0,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		lockTime uint32
+		witness  func() (wire.TxWitness, error)
+		valid    bool
+	}{
+		{
+			name: "receiver claims with preimage",
+			witness: func() (wire.TxWitness, error) {
+				return SwapHtlcSpendSuccess(
+					bobSigner, signDesc(bobKeyPub),
+					sweepTx, preimage[:],
+				)
+			},
+			valid: true,
+		},
+		{
+			name: "receiver claims with wrong preimage",
+			witness: func() (wire.TxWitness, error) {
+				return SwapHtlcSpendSuccess(
+					bobSigner, signDesc(bobKeyPub),
+					sweepTx, swapHash[:],
+				)
+			},
+			valid: false,
+		},
+		{
+			name: "sender claims with preimage",
+			witness: func() (wire.TxWitness, error) {
+				return SwapHtlcSpendSuccess(
+					aliceSigner, signDesc(aliceKeyPub),
+					sweepTx, preimage[:],
+				)
+			},
+			valid: false,
+		},
+		{
+			name:     "sender times out before expiry",
+			lockTime: cltvExpiry - 1,
+			witness: func() (wire.TxWitness, error) {
+				return SwapHtlcSpendTimeout(
+					aliceSigner, signDesc(aliceKeyPub),
+					sweepTx,
+				)
+			},
+			valid: false,
+		},
+		{
+			name:     "receiver times out after expiry",
+			lockTime: cltvExpiry,
+			witness: func() (wire.TxWitness, error) {
+				return SwapHtlcSpendTimeout(
+					bobSigner, signDesc(bobKeyPub),
+					sweepTx,
+				)
+			},
+			valid: false,
+		},
+		{
+			name:     "sender times out after expiry",
+			lockTime: cltvExpiry,
+			witness: func() (wire.TxWitness, error) {
+				return SwapHtlcSpendTimeout(
+					aliceSigner, signDesc(aliceKeyPub),
+					sweepTx,
+				)
+			},
+			valid: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sweepTx.LockTime = testCase.lockTime
+
+		witness, err := testCase.witness()
+		if err != nil {
+			t.Fatalf("%v: unable to create witness: %v",
+				testCase.name, err)
+		}
+		sweepTx.TxIn[0].Witness = witness
+
+		vm, err := txscript.NewEngine(htlcPkScript,
+			sweepTx, 0, txscript.StandardVerifyFlags, nil,
+			nil, int64(htlcAmt))
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+
+		err = vm.Execute()
+		if err != nil && testCase.valid {
+			t.Fatalf("%v: spend should be valid: %v",
+				testCase.name, err)
+		} else if err == nil && !testCase.valid {
+			t.Fatalf("%v: spend should be invalid", testCase.name)
+		}
+	}
+}
+
 // TestSpecificationKeyDerivation implements the test vectors provided in
 // BOLT-03, Appendix E.
 func TestSpecificationKeyDerivation(t *testing.T) {
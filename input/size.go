@@ -357,6 +357,50 @@ const (
 	//      - witness_script_length: 1 byte
 	//      - witness_script (offered_htlc_script)
 	OfferedHtlcPenaltyWitnessSize = 1 + 1 + 73 + 1 + 33 + 1 + OfferedHtlcScriptSize
+
+	// SwapHtlcScriptSize 107 bytes
+	//      - OP_SIZE: 1 byte
+	//      - OP_DATA: 1 byte (32 length)
+	//      - 32: 1 byte
+	//      - OP_EQUAL: 1 byte
+	//      - OP_IF: 1 byte
+	//              - OP_HASH160: 1 byte
+	//              - OP_DATA: 1 byte (RIPEMD160(swap_hash) length)
+	//              - RIPEMD160(swap_hash): 20 bytes
+	//              - OP_EQUALVERIFY: 1 byte
+	//              - OP_DATA: 1 byte (receiver_key length)
+	//              - receiver_key: 33 bytes
+	//      - OP_ELSE: 1 byte
+	//              - OP_DROP: 1 byte
+	//              - OP_DATA: 1 byte (cltv_expiry length)
+	//              - cltv_expiry: 4 bytes
+	//              - OP_CHECKLOCKTIMEVERIFY: 1 byte
+	//              - OP_DROP: 1 byte
+	//              - OP_DATA: 1 byte (sender_key length)
+	//              - sender_key: 33 bytes
+	//      - OP_ENDIF: 1 byte
+	//      - OP_CHECKSIG: 1 byte
+	SwapHtlcScriptSize = 5*1 + 2*1 + 20 + 2*1 + 33 + 3*1 + 4 + 3*1 + 33 +
+		2*1
+
+	// SwapHtlcSuccessWitnessSize 216 bytes
+	//      - number_of_witness_elements: 1 byte
+	//      - receiver_sig_length: 1 byte
+	//      - receiver_sig: 73 bytes
+	//      - preimage_length: 1 byte
+	//      - preimage: 32 bytes
+	//      - witness_script_length: 1 byte
+	//      - witness_script (swap_htlc_script)
+	SwapHtlcSuccessWitnessSize = 1 + 1 + 73 + 1 + 32 + 1 + SwapHtlcScriptSize
+
+	// SwapHtlcTimeoutWitnessSize 184 bytes
+	//      - number_of_witness_elements: 1 byte
+	//      - sender_sig_length: 1 byte
+	//      - sender_sig: 73 bytes
+	//      - nil_length: 1 byte
+	//      - witness_script_length: 1 byte
+	//      - witness_script (swap_htlc_script)
+	SwapHtlcTimeoutWitnessSize = 1 + 1 + 73 + 1 + 1 + SwapHtlcScriptSize
 )
 
 // EstimateCommitTxWeight estimate commitment transaction weight depending on
@@ -478,6 +522,19 @@ func (twe *TxWeightEstimator) AddP2SHOutput() *TxWeightEstimator {
 	return twe
 }
 
+// AddTxOutput updates the weight estimate to account for the passed output,
+// which may pay to an arbitrary script.
+func (twe *TxWeightEstimator) AddTxOutput(
+	txOut *wire.TxOut) *TxWeightEstimator {
+
+	twe.outputSize += 8 + wire.VarIntSerializeSize(
+		uint64(len(txOut.PkScript)),
+	) + len(txOut.PkScript)
+	twe.outputCount++
+
+	return twe
+}
+
 // Weight gets the estimated weight of the transaction.
 func (twe *TxWeightEstimator) Weight() int {
 	txSizeStripped := BaseTxSize +
@@ -79,6 +79,15 @@ const (
 	// output that sends to a nested P2SH script that pays to a key solely
 	// under our control. The witness generated needs to include the
 	NestedWitnessKeyHash WitnessType = 11
+
+	// SwapHtlcSuccess is a witness type that allows the receiver of a
+	// submarine swap HTLC to claim the output using the swap preimage.
+	SwapHtlcSuccess WitnessType = 12
+
+	// SwapHtlcTimeout is a witness type that allows the sender of a
+	// submarine swap HTLC to reclaim the output once its absolute timeout
+	// has passed.
+	SwapHtlcTimeout WitnessType = 13
 )
 
 // Stirng returns a human readable version of the target WitnessType.
@@ -114,6 +123,12 @@ func (wt WitnessType) String() string {
 	case HtlcSecondLevelRevoke:
 		return "HtlcSecondLevelRevoke"
 
+	case SwapHtlcSuccess:
+		return "SwapHtlcSuccess"
+
+	case SwapHtlcTimeout:
+		return "SwapHtlcTimeout"
+
 	default:
 		return fmt.Sprintf("Unknown WitnessType: %v", uint32(wt))
 	}
@@ -235,6 +250,16 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 				Witness: witness,
 			}, nil
 
+		case SwapHtlcTimeout:
+			witness, err := SwapHtlcSpendTimeout(signer, desc, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
 		case WitnessKeyHash:
 			fallthrough
 		case NestedWitnessKeyHash:
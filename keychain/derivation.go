@@ -96,6 +96,10 @@ const (
 	// session keys are limited to the lifetime of the session and are used
 	// to increase privacy in the watchtower protocol.
 	KeyFamilyTowerSession KeyFamily = 8
+
+	// KeyFamilySwap is the family of keys that will be used to derive the
+	// keys we use within the on-chain HTLCs of submarine swaps.
+	KeyFamilySwap KeyFamily = 9
 )
 
 // KeyLocator is a two-tuple that can be used to derive *any* key that has ever
@@ -187,5 +191,20 @@ type SecretKeyRing interface {
 	ScalarMult(keyDesc KeyDescriptor, pubKey *btcec.PublicKey) ([]byte, error)
 }
 
+// ECDHRing is an interface that abstracts away the ability to derive keys and
+// perform ECDH operations with them, without access to the private keys
+// themselves.
+type ECDHRing interface {
+	// DeriveKey attempts to derive an arbitrary key specified by the
+	// passed KeyLocator.
+	DeriveKey(keyLoc KeyLocator) (KeyDescriptor, error)
+
+	// ScalarMult performs a scalar multiplication (ECDH-like operation)
+	// between the target key descriptor and remote public key. The output
+	// returned will be the sha256 of the resulting shared point serialized
+	// in compressed format.
+	ScalarMult(keyDesc KeyDescriptor, pubKey *btcec.PublicKey) ([]byte, error)
+}
+
 // TODO(roasbeef): extend to actually support scalar mult of key?
 //  * would allow to push in initial handshake auth into interface as well
@@ -0,0 +1,101 @@
+package keychain
+
+import (
+	"crypto/sha256"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// SingleKeyECDH is an abstraction interface that hides the implementation of
+// an ECDH operation against a specific key, such that the private key doesn't
+// need to be held by the caller.
+type SingleKeyECDH interface {
+	// PubKey returns the public key of the private key that is abstracted
+	// away by the interface.
+	PubKey() *btcec.PublicKey
+
+	// ECDH performs a scalar multiplication (ECDH-like operation) between
+	// the abstracted private key and a remote public key. The output
+	// returned will be the sha256 of the resulting shared point serialized
+	// in compressed format.
+	ECDH(pubKey *btcec.PublicKey) ([32]byte, error)
+}
+
+// PrivKeyECDH is an implementation of the SingleKeyECDH interface in which the
+// private key is held in memory.
+type PrivKeyECDH struct {
+	// PrivKey is the private key that is used for the ECDH operation.
+	PrivKey *btcec.PrivateKey
+}
+
+// PubKey returns the public key of the private key that is abstracted away by
+// the interface.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PrivKeyECDH) PubKey() *btcec.PublicKey {
+	return p.PrivKey.PubKey()
+}
+
+// ECDH performs a scalar multiplication (ECDH-like operation) between the
+// private key and a remote public key. The output returned will be the sha256
+// of the resulting shared point serialized in compressed format. If k is our
+// private key, and P is the public key, we perform the following operation:
+//
+//  sx := k*P
+//  s := sha256(sx.SerializeCompressed())
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PrivKeyECDH) ECDH(pub *btcec.PublicKey) ([32]byte, error) {
+	s := &btcec.PublicKey{}
+	s.X, s.Y = btcec.S256().ScalarMult(pub.X, pub.Y, p.PrivKey.D.Bytes())
+
+	return sha256.Sum256(s.SerializeCompressed()), nil
+}
+
+// PubKeyECDH is an implementation of the SingleKeyECDH interface that only
+// knows the public key of the key descriptor, and carries out the ECDH
+// operation through an ECDHRing. This allows the private key to be held by a
+// remote signer.
+type PubKeyECDH struct {
+	keyDesc KeyDescriptor
+	keyRing ECDHRing
+}
+
+// NewPubKeyECDH creates a new PubKeyECDH that performs the ECDH operation with
+// the key of the passed key descriptor through the given key ring. The public
+// key of the key descriptor must be set.
+func NewPubKeyECDH(keyDesc KeyDescriptor, keyRing ECDHRing) *PubKeyECDH {
+	return &PubKeyECDH{
+		keyDesc: keyDesc,
+		keyRing: keyRing,
+	}
+}
+
+// PubKey returns the public key of the private key that is abstracted away by
+// the interface.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PubKeyECDH) PubKey() *btcec.PublicKey {
+	return p.keyDesc.PubKey
+}
+
+// ECDH performs a scalar multiplication (ECDH-like operation) between the
+// abstracted private key and a remote public key through the key ring.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PubKeyECDH) ECDH(pub *btcec.PublicKey) ([32]byte, error) {
+	var sharedSecret [32]byte
+
+	secret, err := p.keyRing.ScalarMult(p.keyDesc, pub)
+	if err != nil {
+		return sharedSecret, err
+	}
+	copy(sharedSecret[:], secret)
+
+	return sharedSecret, nil
+}
+
+// A compile time check to ensure PrivKeyECDH and PubKeyECDH implement the
+// SingleKeyECDH interface.
+var _ SingleKeyECDH = (*PrivKeyECDH)(nil)
+var _ SingleKeyECDH = (*PubKeyECDH)(nil)
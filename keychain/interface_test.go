@@ -417,6 +417,54 @@ func TestSecretKeyRingDerivation(t *testing.T) {
 	}
 }
 
+// TestDerivePubKeyFromSeed tests that the keys derived directly from the seed
+// of a wallet match those derived by a key ring backed by that wallet.
+func TestDerivePubKeyFromSeed(t *testing.T) {
+	t.Parallel()
+
+	coinTypes := []uint32{
+		CoinTypeBitcoin, CoinTypeMonacoin, CoinTypeTestnet,
+	}
+	for _, coinType := range coinTypes {
+		cleanUp, wallet, err := createTestBtcWallet(coinType)
+		if err != nil {
+			t.Fatalf("unable to create wallet: %v", err)
+		}
+		defer cleanUp()
+
+		keyRing := NewBtcWalletKeyRing(wallet, coinType)
+
+		for _, keyFam := range versionZeroKeyFamilies {
+			keyLoc := KeyLocator{
+				Family: keyFam,
+				Index:  uint32(rand.Int31n(100)),
+			}
+
+			keyDesc, err := keyRing.DeriveKey(keyLoc)
+			if err != nil {
+				t.Fatalf("unable to derive key: %v", err)
+			}
+
+			pubKey, err := DerivePubKeyFromSeed(
+				testHDSeed[:], &chaincfg.SimNetParams, coinType,
+				keyLoc,
+			)
+			if err != nil {
+				t.Fatalf("unable to derive key from seed: %v",
+					err)
+			}
+
+			if !keyDesc.PubKey.IsEqual(pubKey) {
+				t.Fatalf("coin type %v, key locator %v: "+
+					"expected %x, got %x", coinType,
+					spew.Sdump(keyLoc),
+					keyDesc.PubKey.SerializeCompressed(),
+					pubKey.SerializeCompressed())
+			}
+		}
+	}
+}
+
 func init() {
 	// We'll clamp the max range scan to constrain the run time of the
 	// private key scan test.
@@ -0,0 +1,44 @@
+package keychain
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+// externalBranch is the branch of an account that all keys of a key family
+// are derived from, following the BIP0044 external chain.
+const externalBranch = 0
+
+// DerivePubKeyFromSeed derives the public key at the given key locator from
+// the root seed of a wallet, without requiring access to the wallet itself.
+// The derivation path is identical to the one used by the BtcWalletKeyRing,
+// namely m/1017'/coinType'/keyFamily'/0/index, such that the derived keys
+// match those of a key ring backed by a wallet created from the same seed.
+// This allows a backup of the seed to be checked against a running wallet.
+func DerivePubKeyFromSeed(seed []byte, netParams *chaincfg.Params,
+	coinType uint32, keyLoc KeyLocator) (*btcec.PublicKey, error) {
+
+	rootKey, err := hdkeychain.NewMaster(seed, netParams)
+	if err != nil {
+		return nil, err
+	}
+
+	path := []uint32{
+		hdkeychain.HardenedKeyStart + BIP0043Purpose,
+		hdkeychain.HardenedKeyStart + coinType,
+		hdkeychain.HardenedKeyStart + uint32(keyLoc.Family),
+		externalBranch,
+		keyLoc.Index,
+	}
+
+	key := rootKey
+	for _, index := range path {
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key.ECPubKey()
+}
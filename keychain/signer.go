@@ -0,0 +1,83 @@
+package keychain
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SingleKeyMessageSigner is an abstraction interface that hides the
+// implementation of message signing with a specific key, such that the
+// private key doesn't need to be held by the caller.
+type SingleKeyMessageSigner interface {
+	// PubKey returns the public key of the key the messages are signed
+	// with.
+	PubKey() *btcec.PublicKey
+
+	// SignMessage signs the given message, single or double SHA256
+	// hashing it first, with the abstracted private key.
+	SignMessage(message []byte, doubleHash bool) (*btcec.Signature, error)
+
+	// SignMessageCompact signs the given message, single or double SHA256
+	// hashing it first, with the abstracted private key and returns the
+	// signature in the compact, public key recoverable format.
+	SignMessageCompact(message []byte, doubleHash bool) ([]byte, error)
+}
+
+// PrivKeyMessageSigner is an implementation of the SingleKeyMessageSigner
+// interface in which the private key is held in memory.
+type PrivKeyMessageSigner struct {
+	privKey *btcec.PrivateKey
+}
+
+// NewPrivKeyMessageSigner creates a new PrivKeyMessageSigner that signs
+// messages with the passed private key.
+func NewPrivKeyMessageSigner(privKey *btcec.PrivateKey) *PrivKeyMessageSigner {
+	return &PrivKeyMessageSigner{
+		privKey: privKey,
+	}
+}
+
+// PubKey returns the public key of the key the messages are signed with.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PrivKeyMessageSigner) PubKey() *btcec.PublicKey {
+	return p.privKey.PubKey()
+}
+
+// SignMessage signs the given message, single or double SHA256 hashing it
+// first, with the private key.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PrivKeyMessageSigner) SignMessage(message []byte,
+	doubleHash bool) (*btcec.Signature, error) {
+
+	return p.privKey.Sign(MessageDigest(message, doubleHash))
+}
+
+// SignMessageCompact signs the given message, single or double SHA256 hashing
+// it first, with the private key and returns the signature in the compact,
+// public key recoverable format.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PrivKeyMessageSigner) SignMessageCompact(message []byte,
+	doubleHash bool) ([]byte, error) {
+
+	return btcec.SignCompact(
+		btcec.S256(), p.privKey, MessageDigest(message, doubleHash),
+		true,
+	)
+}
+
+// MessageDigest returns the single or double SHA256 hash of the message, which
+// is the digest that is signed by a SingleKeyMessageSigner.
+func MessageDigest(message []byte, doubleHash bool) []byte {
+	if doubleHash {
+		return chainhash.DoubleHashB(message)
+	}
+
+	return chainhash.HashB(message)
+}
+
+// A compile time check to ensure PrivKeyMessageSigner implements the
+// SingleKeyMessageSigner interface.
+var _ SingleKeyMessageSigner = (*PrivKeyMessageSigner)(nil)
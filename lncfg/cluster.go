@@ -0,0 +1,67 @@
+package lncfg
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	// DefaultEtcdElectionPrefix is the default prefix of the election
+	// that lnd instances sharing the same etcd cluster campaign within.
+	DefaultEtcdElectionPrefix = "/leader/"
+)
+
+// Cluster holds the configuration for running several lnd instances against
+// the same replicated state, with only a single active instance at a time.
+type Cluster struct {
+	// EnableLeaderElection enables leader election. If enabled, lnd only
+	// starts its server once it's been elected leader.
+	EnableLeaderElection bool `long:"enable-leader-election" description:"Enables leader election. If set, lnd will remain on standby until it's been elected leader, and only then start the server."`
+
+	// LeaderElector is the name of the selected leader election
+	// mechanism.
+	LeaderElector string `long:"leader-elector" choice:"etcd" description:"The leader elector to use when leader election is enabled."`
+
+	// EtcdElectionPrefix is the prefix of the election that all instances
+	// campaign within.
+	EtcdElectionPrefix string `long:"etcd-election-prefix" description:"The prefix that is used to store election related data within etcd."`
+
+	// ID is the unique ID of this instance within the cluster.
+	ID string `long:"id" description:"Identifier for this node inside the cluster, used in leader election. Defaults to the hostname."`
+
+	// LeaseTTL is the number of seconds the leader's lease survives for
+	// once the leader fails.
+	LeaseTTL int `long:"lease-ttl" description:"The number of seconds after which a failed leader's lease expires, allowing a standby instance to take over."`
+}
+
+// Validate checks that the leader election settings are sane if leader
+// election is enabled.
+func (c *Cluster) Validate() error {
+	if !c.EnableLeaderElection {
+		return nil
+	}
+
+	if c.LeaderElector == "" {
+		return fmt.Errorf("leader elector must be set when leader " +
+			"election is enabled")
+	}
+
+	if c.LeaseTTL <= 0 {
+		return fmt.Errorf("lease ttl (%d) must be positive", c.LeaseTTL)
+	}
+
+	if c.ID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("unable to determine cluster id: %v",
+				err)
+		}
+		c.ID = hostname
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Cluster implements the Validator
+// interface.
+var _ Validator = (*Cluster)(nil)
@@ -0,0 +1,70 @@
+package lncfg
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
+
+const (
+	// channelDBName is the name of the bbolt database file that holds the
+	// channel state when the bolt backend is selected.
+	channelDBName = "channel.db"
+)
+
+// DB holds the configuration of the database backend that lnd stores its
+// channel state within.
+type DB struct {
+	// Backend is the name of the selected backend.
+	Backend string `long:"backend" description:"The selected database backend." choice:"bolt" choice:"etcd"`
+
+	// Etcd holds the settings used to connect to an etcd cluster when the
+	// etcd backend is selected.
+	Etcd *kvdb.EtcdConfig `group:"etcd" namespace:"etcd" description:"Etcd settings."`
+}
+
+// DefaultDB returns the default database configuration, which stores all
+// state within a local bbolt database.
+func DefaultDB() *DB {
+	return &DB{
+		Backend: kvdb.BoltBackendName,
+		Etcd:    &kvdb.EtcdConfig{},
+	}
+}
+
+// Validate checks that the selected backend is known, and that enough
+// information has been provided to connect to it.
+func (db *DB) Validate() error {
+	switch db.Backend {
+	case kvdb.BoltBackendName:
+
+	case kvdb.EtcdBackendName:
+		if db.Etcd == nil || db.Etcd.Host == "" {
+			return fmt.Errorf("etcd host must be set when using " +
+				"the etcd backend")
+		}
+
+	default:
+		return fmt.Errorf("unknown db backend: %v", db.Backend)
+	}
+
+	return nil
+}
+
+// GetBackend opens the configured database backend. The bolt backend stores
+// its database file within the passed directory. If a leader fence is passed,
+// the etcd backend only commits writes while our term as leader lasts.
+func (db *DB) GetBackend(ctx context.Context, dbPath string,
+	fence *kvdb.LeaderFence) (kvdb.Backend, error) {
+
+	if db.Backend == kvdb.EtcdBackendName {
+		return kvdb.OpenEtcd(ctx, db.Etcd, fence)
+	}
+
+	return kvdb.OpenBolt(filepath.Join(dbPath, channelDBName))
+}
+
+// Compile-time constraint to ensure DB implements the Validator interface.
+var _ Validator = (*DB)(nil)
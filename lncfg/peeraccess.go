@@ -0,0 +1,58 @@
+package lncfg
+
+import "fmt"
+
+const (
+	// DefaultMaxPendingHandshakes is the default maximum number of inbound
+	// handshakes that are done in parallel.
+	DefaultMaxPendingHandshakes = 1000
+
+	// DefaultMaxPendingHandshakesPerIP is the default maximum number of
+	// inbound handshakes that are done in parallel with a single IP
+	// address. Connections from loopback addresses, which includes all
+	// inbound connections over Tor, aren't subject to this limit.
+	DefaultMaxPendingHandshakesPerIP = 10
+)
+
+// PeerAccess holds the configuration that controls which peers may connect to
+// us, and how many inbound handshakes are done in parallel.
+type PeerAccess struct {
+	// Allow is the list of rules matching peers that are always accepted.
+	Allow []string `long:"allow" description:"The hex-encoded public key of a node, an IP address, or a network in CIDR notation, whose inbound connections are accepted even if restrictinbound is set. Can be specified multiple times."`
+
+	// Deny is the list of rules matching peers that are never accepted.
+	Deny []string `long:"deny" description:"The hex-encoded public key of a node, an IP address, or a network in CIDR notation, whose inbound connections are always rejected. Takes precedence over the allow list. Can be specified multiple times."`
+
+	// RestrictInbound restricts inbound connections to peers that are on
+	// the allow list, or that we have channels with.
+	RestrictInbound bool `long:"restrictinbound" description:"Only accept inbound connections from peers on the allow list, or peers that we have channels with."`
+
+	// MaxPendingHandshakes is the maximum number of inbound handshakes
+	// that are done in parallel.
+	MaxPendingHandshakes int `long:"maxpendinghandshakes" description:"The maximum number of inbound handshakes that are done in parallel."`
+
+	// MaxPendingHandshakesPerIP is the maximum number of inbound
+	// handshakes that are done in parallel with a single IP address.
+	// Connections from loopback addresses are exempt, as all inbound
+	// connections over Tor originate from the local Tor daemon.
+	MaxPendingHandshakesPerIP int `long:"maxpendinghandshakesperip" description:"The maximum number of inbound handshakes that are done in parallel with a single IP address. Further connections from the address are rejected until a handshake completes. Connections from loopback addresses, such as all inbound connections over Tor, are exempt. Set to 0 to disable the limit."`
+}
+
+// Validate checks that the handshake limits are sane.
+func (p *PeerAccess) Validate() error {
+	switch {
+	case p.MaxPendingHandshakes <= 0:
+		return fmt.Errorf("peeraccess.maxpendinghandshakes (%d) must "+
+			"be positive", p.MaxPendingHandshakes)
+
+	case p.MaxPendingHandshakesPerIP < 0:
+		return fmt.Errorf("peeraccess.maxpendinghandshakesperip (%d) "+
+			"must be non-negative", p.MaxPendingHandshakesPerIP)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure PeerAccess implements the Validator
+// interface.
+var _ Validator = (*PeerAccess)(nil)
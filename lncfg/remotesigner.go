@@ -0,0 +1,66 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRemoteSignerRPCTimeout is the default timeout of a single
+	// request to the remote signer.
+	DefaultRemoteSignerRPCTimeout = 5 * time.Second
+)
+
+// RemoteSigner holds the configuration for running lnd as a watch-only node
+// that delegates all operations requiring private keys to a remote signer.
+// The remote signer is a second lnd that serves the signrpc and walletrpc
+// sub-servers. The watch-only node never holds the seed: its on-chain wallet
+// is created from the extended public keys of the remote signer's accounts.
+type RemoteSigner struct {
+	// Enable enables the remote signer.
+	Enable bool `long:"enable" description:"Use a remote signer for all operations that require private keys. The remote signer must be an lnd with the signrpc and walletrpc sub-servers. The on-chain wallet of this node is created as a watch-only copy of the remote signer's wallet, so no seed is required."`
+
+	// RPCHost is the host:port of the gRPC interface of the remote
+	// signer.
+	RPCHost string `long:"rpchost" description:"The remote signer's RPC host:port"`
+
+	// MacaroonPath is the path to a macaroon that grants access to the
+	// signrpc and walletrpc calls of the remote signer.
+	MacaroonPath string `long:"macaroonpath" description:"The macaroon to use for authenticating with the remote signer"`
+
+	// TLSCertPath is the path to the TLS certificate of the remote
+	// signer.
+	TLSCertPath string `long:"tlscertpath" description:"The TLS certificate to use for establishing the remote signer's identity"`
+
+	// Timeout is the timeout of a single request to the remote signer.
+	Timeout time.Duration `long:"timeout" description:"The timeout for connecting to and signing requests with the remote signer. Valid time units are {s, m, h}."`
+}
+
+// Validate checks that all settings required to connect to the remote signer
+// are set if the remote signer is enabled.
+func (r *RemoteSigner) Validate() error {
+	if !r.Enable {
+		return nil
+	}
+
+	switch {
+	case r.RPCHost == "":
+		return fmt.Errorf("remote signer rpchost must be set")
+
+	case r.MacaroonPath == "":
+		return fmt.Errorf("remote signer macaroonpath must be set")
+
+	case r.TLSCertPath == "":
+		return fmt.Errorf("remote signer tlscertpath must be set")
+
+	case r.Timeout <= 0:
+		return fmt.Errorf("remote signer timeout (%v) must be "+
+			"positive", r.Timeout)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure RemoteSigner implements the Validator
+// interface.
+var _ Validator = (*RemoteSigner)(nil)
@@ -0,0 +1,78 @@
+package lncfg
+
+import "fmt"
+
+// WalletUnlock holds the configuration for unlocking the wallet on startup
+// without any user interaction. The wallet password is obtained from one of
+// several sources, such as a file, an environment variable, or a local agent
+// listening on a unix socket.
+type WalletUnlock struct {
+	// PasswordFile is the path to a file containing the wallet password.
+	PasswordFile string `long:"wallet-unlock-password-file" description:"The full path to a file that contains the password for unlocking the wallet on startup. A single trailing newline is ignored. If set, lnd doesn't wait for the password to be provided over RPC."`
+
+	// PasswordEnv is the name of an environment variable containing the
+	// wallet password.
+	PasswordEnv string `long:"wallet-unlock-password-env" description:"The name of an environment variable that contains the password for unlocking the wallet on startup."`
+
+	// PasswordSocket is the path to a unix socket of a local agent that
+	// hands out the wallet password.
+	PasswordSocket string `long:"wallet-unlock-password-socket" description:"The full path to a unix socket of a local agent that writes the password for unlocking the wallet on startup to every connection, then closes it."`
+
+	// AllowCreate allows a new wallet to be created from the seed file if
+	// no wallet exists yet.
+	AllowCreate bool `long:"wallet-unlock-allow-create" description:"Create a new wallet from the seed in wallet-unlock-seed-file, encrypted with the configured unlock password, if no wallet exists yet. Meant for automated test setups."`
+
+	// SeedFile is the path to a file containing the aezeed mnemonic a new
+	// wallet is created from.
+	SeedFile string `long:"wallet-unlock-seed-file" description:"The full path to a file that contains the 24-word aezeed mnemonic, without a passphrase, to create a new wallet from if wallet-unlock-allow-create is set."`
+}
+
+// numSources returns the number of configured password sources.
+func (w *WalletUnlock) numSources() int {
+	var n int
+	for _, source := range []string{
+		w.PasswordFile, w.PasswordEnv, w.PasswordSocket,
+	} {
+		if source != "" {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Enabled returns true if the wallet should be unlocked on startup using one
+// of the configured password sources.
+func (w *WalletUnlock) Enabled() bool {
+	return w.numSources() != 0
+}
+
+// Validate checks that at most a single password source is configured, and
+// that wallet creation is only allowed along with a password source and a
+// seed file.
+func (w *WalletUnlock) Validate() error {
+	switch {
+	case w.numSources() > 1:
+		return fmt.Errorf("only one of wallet-unlock-password-file, " +
+			"wallet-unlock-password-env and " +
+			"wallet-unlock-password-socket can be set")
+
+	case w.AllowCreate && !w.Enabled():
+		return fmt.Errorf("wallet-unlock-allow-create requires a " +
+			"wallet unlock password source")
+
+	case w.AllowCreate && w.SeedFile == "":
+		return fmt.Errorf("wallet-unlock-allow-create requires " +
+			"wallet-unlock-seed-file to be set")
+
+	case !w.AllowCreate && w.SeedFile != "":
+		return fmt.Errorf("wallet-unlock-seed-file is only used " +
+			"with wallet-unlock-allow-create")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure WalletUnlock implements the Validator
+// interface.
+var _ Validator = (*WalletUnlock)(nil)
@@ -0,0 +1,80 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/wakiyamap/lnd/lncfg"
+)
+
+// TestValidateWalletUnlock asserts that validating the WalletUnlock config
+// only succeeds if at most a single password source is set, and wallet
+// creation is only allowed along with a password source and a seed file.
+func TestValidateWalletUnlock(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   *lncfg.WalletUnlock
+		valid bool
+	}{
+		{
+			name:  "disabled",
+			cfg:   &lncfg.WalletUnlock{},
+			valid: true,
+		},
+		{
+			name: "password file",
+			cfg: &lncfg.WalletUnlock{
+				PasswordFile: "/pw",
+			},
+			valid: true,
+		},
+		{
+			name: "allow create",
+			cfg: &lncfg.WalletUnlock{
+				PasswordEnv: "LND_PW",
+				AllowCreate: true,
+				SeedFile:    "/seed",
+			},
+			valid: true,
+		},
+		{
+			name: "multiple sources",
+			cfg: &lncfg.WalletUnlock{
+				PasswordFile:   "/pw",
+				PasswordSocket: "/agent.sock",
+			},
+		},
+		{
+			name: "allow create without source",
+			cfg: &lncfg.WalletUnlock{
+				AllowCreate: true,
+				SeedFile:    "/seed",
+			},
+		},
+		{
+			name: "allow create without seed file",
+			cfg: &lncfg.WalletUnlock{
+				PasswordFile: "/pw",
+				AllowCreate:  true,
+			},
+		},
+		{
+			name: "seed file without allow create",
+			cfg: &lncfg.WalletUnlock{
+				PasswordFile: "/pw",
+				SeedFile:     "/seed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
@@ -35,7 +35,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcwallet/wallet"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/lightninglabs/neutrino"
@@ -43,6 +42,8 @@ import (
 	"github.com/wakiyamap/lnd/autopilot"
 	"github.com/wakiyamap/lnd/build"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/cluster"
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lncfg"
 	"github.com/wakiyamap/lnd/lnrpc"
@@ -160,19 +161,6 @@ func Main() error {
 		defaultGraphSubDirname,
 		normalizeNetwork(activeNetParams.Name))
 
-	// Open the channeldb, which is dedicated to storing channel, and
-	// network related metadata.
-	chanDB, err := channeldb.Open(
-		graphDir,
-		channeldb.OptionSetRejectCacheSize(cfg.Caches.RejectCacheSize),
-		channeldb.OptionSetChannelCacheSize(cfg.Caches.ChannelCacheSize),
-	)
-	if err != nil {
-		ltndLog.Errorf("unable to open channeldb: %v", err)
-		return err
-	}
-	defer chanDB.Close()
-
 	// Only process macaroons if --no-macaroons isn't set.
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
@@ -188,25 +176,6 @@ func Main() error {
 
 	restDialOpts := []grpc.DialOption{grpc.WithTransportCredentials(*restCreds)}
 
-	// Before starting the wallet, we'll create and start our Neutrino
-	// light client instance, if enabled, in order to allow it to sync
-	// while the rest of the daemon continues startup.
-	mainChain := cfg.Bitcoin
-	if registeredChains.PrimaryChain() == monacoinChain {
-		mainChain = cfg.Monacoin
-	}
-	var neutrinoCS *neutrino.ChainService
-	if mainChain.Node == "neutrino" {
-		neutrinoBackend, neutrinoCleanUp, err := initNeutrinoBackend(
-			mainChain.ChainDir,
-		)
-		defer neutrinoCleanUp()
-		if err != nil {
-			return err
-		}
-		neutrinoCS = neutrinoBackend
-	}
-
 	var (
 		walletInitParams WalletUnlockParams
 		privateWalletPw  = lnwallet.DefaultPrivatePassphrase
@@ -220,15 +189,29 @@ func Main() error {
 
 	// We wait until the user provides a password over RPC. In case lnd is
 	// started with the --noseedbackup flag, we use the default password
-	// for wallet encryption.
-	if !cfg.NoSeedBackup {
-		params, err := waitForWalletPassword(
+	// for wallet encryption. The same goes for a watch-only node using a
+	// remote signer, as its wallet doesn't hold any private keys.
+	shutdownUnlocker := func(graceful bool) {}
+	if !cfg.NoSeedBackup && !cfg.RemoteSigner.Enable {
+		params, shutdown, err := waitForWalletPassword(
 			cfg.RPCListeners, cfg.RESTListeners, serverOpts,
 			restDialOpts, restProxyDest, tlsCfg,
 		)
 		if err != nil {
 			return err
 		}
+		shutdownUnlocker = shutdown
+
+		// If we fail to start up before the admin macaroon was handed
+		// over, the pending InitWallet or UnlockWallet call is aborted.
+		defer shutdownUnlocker(false)
+
+		// Unless the admin macaroon still needs to be returned to the
+		// caller of InitWallet or UnlockWallet, the wallet unlocker
+		// isn't needed anymore.
+		if params.MacResponseChan == nil {
+			shutdownUnlocker(true)
+		}
 
 		walletInitParams = *params
 		privateWalletPw = walletInitParams.Password
@@ -241,6 +224,71 @@ func Main() error {
 		}
 	}
 
+	// If leader election is enabled, we'll remain on standby until we've
+	// been elected leader, as only a single instance of the cluster may
+	// drive the state of our channels at a time. Until then, only the
+	// wallet unlocker has been started, so a standby instance doesn't
+	// touch the database, or any other shared state. Once elected, all
+	// writes to the database are fenced by our term as leader.
+	var leaderFence *kvdb.LeaderFence
+	if cfg.Cluster.EnableLeaderElection {
+		elector, err := waitForLeadership(ctx, cfg.Cluster, cfg.DB)
+		if err != nil {
+			ltndLog.Errorf("unable to become leader: %v", err)
+			return err
+		}
+
+		// The elector may be nil if we were requested to shut down
+		// while on standby.
+		if elector == nil {
+			return nil
+		}
+		defer elector.Close()
+
+		leaderFence = elector.Fence()
+	}
+
+	// Open the backend of the channeldb, which is either a local bbolt
+	// database within the graph directory, or a remote etcd cluster.
+	chanDBBackend, err := cfg.DB.GetBackend(ctx, graphDir, leaderFence)
+	if err != nil {
+		ltndLog.Errorf("unable to open %v db backend: %v",
+			cfg.DB.Backend, err)
+		return err
+	}
+
+	// Open the channeldb, which is dedicated to storing channel, and
+	// network related metadata.
+	chanDB, err := channeldb.CreateWithBackend(
+		chanDBBackend, graphDir,
+		channeldb.OptionSetRejectCacheSize(cfg.Caches.RejectCacheSize),
+		channeldb.OptionSetChannelCacheSize(cfg.Caches.ChannelCacheSize),
+	)
+	if err != nil {
+		ltndLog.Errorf("unable to open channeldb: %v", err)
+		return err
+	}
+	defer chanDB.Close()
+
+	// Before starting the wallet, we'll create and start our Neutrino
+	// light client instance, if enabled, in order to allow it to sync
+	// while the rest of the daemon continues startup.
+	mainChain := cfg.Bitcoin
+	if registeredChains.PrimaryChain() == monacoinChain {
+		mainChain = cfg.Monacoin
+	}
+	var neutrinoCS *neutrino.ChainService
+	if mainChain.Node == "neutrino" {
+		neutrinoBackend, neutrinoCleanUp, err := initNeutrinoBackend(
+			mainChain.ChainDir,
+		)
+		defer neutrinoCleanUp()
+		if err != nil {
+			return err
+		}
+		neutrinoCS = neutrinoBackend
+	}
+
 	var macaroonService *macaroons.Service
 	if !cfg.NoMacaroons {
 		// Create the macaroon authentication/authorization service.
@@ -260,8 +308,11 @@ func Main() error {
 			return err
 		}
 
-		// Create macaroon files for lncli to use if they don't exist.
-		if !fileExists(cfg.AdminMacPath) && !fileExists(cfg.ReadMacPath) &&
+		// Create macaroon files for lncli to use if they don't exist,
+		// unless the user requested a stateless initialization.
+		if !walletInitParams.StatelessInit &&
+			!fileExists(cfg.AdminMacPath) &&
+			!fileExists(cfg.ReadMacPath) &&
 			!fileExists(cfg.InvoiceMacPath) {
 
 			err = genMacaroons(
@@ -274,8 +325,50 @@ func Main() error {
 				return err
 			}
 		}
+
+		// As a service to the user, we'll warn them if macaroon files
+		// were left behind on disk, even though they asked for a
+		// stateless initialization.
+		if walletInitParams.StatelessInit {
+			for _, file := range macaroonFiles() {
+				if !fileExists(file) {
+					continue
+				}
+
+				ltndLog.Warnf("Found macaroon file %v even "+
+					"though stateless initialization was "+
+					"requested, use `lncli "+
+					"rotatemacaroonkey` to delete it and "+
+					"invalidate all existing macaroons",
+					file)
+			}
+		}
 	}
 
+	// If the wallet was created or unlocked stateless, then the admin
+	// macaroon is only handed to the caller of InitWallet or UnlockWallet,
+	// which is waiting for it.
+	// Without macaroons, there's no macaroon to hand over, but we'll
+	// still release the caller.
+	if walletInitParams.MacResponseChan != nil {
+		var adminMac []byte
+		if macaroonService != nil {
+			adminMac, err = bakeMacaroon(
+				ctx, macaroonService, adminPermissions(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		walletInitParams.MacResponseChan <- adminMac
+	}
+
+	// Now that the admin macaroon was handed over, if requested, we can
+	// shut down the wallet unlocker, freeing up the RPC listeners for the
+	// RPC server.
+	shutdownUnlocker(true)
+
 	// With the information parsed from the configuration, create valid
 	// instances of the pertinent interfaces required to operate the
 	// Lightning Network Daemon.
@@ -295,17 +388,30 @@ func Main() error {
 	primaryChain := registeredChains.PrimaryChain()
 	registeredChains.RegisterChain(primaryChain, activeChainControl)
 
-	// TODO(roasbeef): add rotation
-	idPrivKey, err := activeChainControl.wallet.DerivePrivKey(keychain.KeyDescriptor{
-		KeyLocator: keychain.KeyLocator{
-			Family: keychain.KeyFamilyNodeKey,
-			Index:  0,
-		},
-	})
-	if err != nil {
-		return err
+	// In signer-only mode, we only serve the signing and wallet operations
+	// required by a watch-only node, so neither the peer-to-peer nor the
+	// channel subsystems are started.
+	if cfg.SignerOnly {
+		signerServer, err := newSignerRPCServer(
+			activeChainControl, chanDB, macaroonService,
+			walletInitParams.StatelessInit, cfg.SubRPCServers,
+			serverOpts,
+		)
+		if err != nil {
+			srvrLog.Errorf("unable to create signer RPC server: %v",
+				err)
+			return err
+		}
+		if err := signerServer.Start(); err != nil {
+			return err
+		}
+		defer signerServer.Stop()
+
+		ltndLog.Infof("Running in signer-only mode")
+
+		<-signal.ShutdownChannel()
+		return nil
 	}
-	idPrivKey.Curve = btcec.S256()
 
 	if cfg.Tor.Active {
 		srvrLog.Infof("Proxying all network traffic via Tor "+
@@ -316,7 +422,7 @@ func Main() error {
 	// Set up the core server which will listen for incoming peer
 	// connections.
 	server, err := newServer(
-		cfg.Listeners, chanDB, activeChainControl, idPrivKey,
+		cfg.Listeners, chanDB, activeChainControl,
 		walletInitParams.ChansToRestore,
 	)
 	if err != nil {
@@ -347,9 +453,9 @@ func Main() error {
 	// Initialize, and register our implementation of the gRPC interface
 	// exported by the rpcServer.
 	rpcServer, err := newRPCServer(
-		server, macaroonService, cfg.SubRPCServers, serverOpts,
-		restDialOpts, restProxyDest, atplManager, server.invoices,
-		tlsCfg,
+		server, macaroonService, walletInitParams.StatelessInit,
+		cfg.SubRPCServers, serverOpts, restDialOpts, restProxyDest,
+		atplManager, server.invoices, tlsCfg,
 	)
 	if err != nil {
 		srvrLog.Errorf("unable to start RPC server: %v", err)
@@ -424,6 +530,71 @@ func Main() error {
 	return nil
 }
 
+// waitForLeadership campaigns for leadership of the cluster, blocking until
+// we've been elected leader. Once elected, a shutdown is requested as soon as
+// our lease expires, as another instance may then take over. If a shutdown is
+// requested while campaigning, a nil elector is returned.
+func waitForLeadership(ctx context.Context, clusterCfg *lncfg.Cluster,
+	dbCfg *lncfg.DB) (cluster.LeaderElector, error) {
+
+	var (
+		elector cluster.LeaderElector
+		err     error
+	)
+	switch clusterCfg.LeaderElector {
+	case cluster.EtcdLeaderElector:
+		elector, err = cluster.NewEtcdLeaderElector(
+			ctx, clusterCfg.ID, clusterCfg.EtcdElectionPrefix,
+			clusterCfg.LeaseTTL, dbCfg.Etcd,
+		)
+
+	default:
+		err = fmt.Errorf("unknown leader elector: %v",
+			clusterCfg.LeaderElector)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll stop campaigning if we're requested to shut down while on
+	// standby.
+	campaignCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-signal.ShutdownChannel():
+			cancel()
+		case <-campaignCtx.Done():
+		}
+	}()
+
+	ltndLog.Infof("Waiting to be elected leader of the cluster (id=%v)",
+		clusterCfg.ID)
+
+	if err := elector.Campaign(campaignCtx); err != nil {
+		elector.Close()
+
+		if !signal.Alive() {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ltndLog.Infof("Elected leader of the cluster, starting server")
+
+	go func() {
+		select {
+		case <-elector.Done():
+			ltndLog.Errorf("Leader lease expired, shutting down")
+			signal.RequestShutdown()
+
+		case <-signal.ShutdownChannel():
+		}
+	}()
+
+	return elector, nil
+}
+
 // getTLSConfig returns a TLS configuration for the gRPC server and credentials
 // and a proxy destination for the REST reverse proxy.
 func getTLSConfig(cfg *config) (*tls.Config, *credentials.TransportCredentials,
@@ -659,14 +830,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the admin macaroon and write it to a file.
-	adminPermissions := append(readPermissions, writePermissions...)
-	admMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, adminPermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	admBytes, err := admMacaroon.M().MarshalBinary()
+	admBytes, err := bakeMacaroon(ctx, svc, adminPermissions())
 	if err != nil {
 		return err
 	}
@@ -677,6 +841,56 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	return nil
 }
 
+// adminPermissions returns the permissions of the admin macaroon, which
+// include all read and write permissions.
+func adminPermissions() []bakery.Op {
+	admin := make([]bakery.Op, 0, len(readPermissions)+
+		len(writePermissions))
+	admin = append(admin, readPermissions...)
+	return append(admin, writePermissions...)
+}
+
+// bakeMacaroon creates a new macaroon with the given permissions, and returns
+// it in its binary serialized form.
+func bakeMacaroon(ctx context.Context, svc *macaroons.Service,
+	permissions []bakery.Op) ([]byte, error) {
+
+	mac, err := svc.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, permissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac.M().MarshalBinary()
+}
+
+// macaroonFiles returns the paths of all macaroon files the daemon and its
+// sub-servers write to disk by default.
+func macaroonFiles() []string {
+	files := []string{
+		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
+	}
+
+	// The sub-servers write their macaroons into the network directory.
+	subServerFiles, err := filepath.Glob(
+		filepath.Join(networkDir, "*.macaroon"),
+	)
+	if err != nil {
+		return files
+	}
+	for _, file := range subServerFiles {
+		if file == cfg.AdminMacPath || file == cfg.ReadMacPath ||
+			file == cfg.InvoiceMacPath {
+
+			continue
+		}
+		files = append(files, file)
+	}
+
+	return files
+}
+
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
 // lnd's wallet after it has already been created.
 type WalletUnlockParams struct {
@@ -701,19 +915,76 @@ type WalletUnlockParams struct {
 	// ChansToRestore a set of static channel backups that should be
 	// restored before the main server instance starts up.
 	ChansToRestore walletunlocker.ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to be
+	// initialized stateless, which means no macaroon files should be
+	// created on disk.
+	StatelessInit bool
+
+	// MacResponseChan is the channel the admin macaroon must be sent over
+	// once it was baked, if the wallet was created or unlocked stateless.
+	// It is nil otherwise.
+	MacResponseChan chan []byte
 }
 
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
 // WalletUnlocker server, and block until a password is provided by
-// the user to this RPC server.
+// the user to this RPC server. Once a password was provided, the endpoints
+// keep running until the returned closure is called, as the admin macaroon of
+// a stateless initialization is only returned to the user over them after the
+// wallet was created or unlocked. A graceful shutdown waits for that response
+// to be sent, so it must only be requested once the macaroon was handed over.
 func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	serverOpts []grpc.ServerOption, restDialOpts []grpc.DialOption,
-	restProxyDest string, tlsConf *tls.Config) (*WalletUnlockParams, error) {
+	restProxyDest string, tlsConf *tls.Config) (*WalletUnlockParams,
+	func(bool), error) {
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
 	grpcServer := grpc.NewServer(serverOpts...)
 
+	// The REST proxy forwards requests to the gRPC server above over a
+	// connection that is closed once its context is canceled.
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	srv := &http.Server{}
+
+	// shutdown stops the gRPC server and the REST proxy, and closes all
+	// listening sockets. If graceful, pending requests are allowed to
+	// complete, so that the caller of InitWallet or UnlockWallet still
+	// receives its response. REST requests are proxied to the gRPC server,
+	// so they need to complete first.
+	var (
+		listeners    []net.Listener
+		shutdownOnce sync.Once
+	)
+	shutdown := func(graceful bool) {
+		shutdownOnce.Do(func() {
+			if graceful {
+				srv.Shutdown(context.Background())
+				cancel()
+				grpcServer.GracefulStop()
+			} else {
+				srv.Close()
+				cancel()
+				grpcServer.Stop()
+			}
+
+			for _, lis := range listeners {
+				lis.Close()
+			}
+		})
+	}
+
+	// Unless a password was provided, we'll shut down right away.
+	var unlocked bool
+	defer func() {
+		if !unlocked {
+			shutdown(false)
+		}
+	}()
+
 	chainConfig := cfg.Bitcoin
 	if registeredChains.PrimaryChain() == monacoinChain {
 		chainConfig = cfg.Monacoin
@@ -732,6 +1003,21 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
+	// If the wallet password can be obtained without user interaction,
+	// we'll unlock the wallet right away, before any of the RPC listeners
+	// are started. The resulting message is picked up below, just as if
+	// it was sent over RPC.
+	var autoUnlocked bool
+	if cfg.WalletUnlock.Enabled() {
+		var err error
+		autoUnlocked, err = unlockWalletFromSource(
+			pwService, chainConfig.ChainDir,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Use a WaitGroup so we can be sure the instructions on how to input the
 	// password is the last thing to be printed to the console.
 	var wg sync.WaitGroup
@@ -745,9 +1031,9 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 				"password RPC server unable to listen on %s",
 				grpcEndpoint,
 			)
-			return nil, err
+			return nil, nil, err
 		}
-		defer lis.Close()
+		listeners = append(listeners, lis)
 
 		wg.Add(1)
 		go func() {
@@ -761,20 +1047,16 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	}
 
 	// Start a REST proxy for our gRPC server above.
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	mux := proxy.NewServeMux()
 
 	err := lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
 		ctx, mux, restProxyDest, restDialOpts,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	srv := &http.Server{Handler: mux}
+	srv.Handler = mux
 
 	for _, restEndpoint := range restEndpoints {
 		lis, err := lncfg.TLSListenOnAddress(restEndpoint, tlsConf)
@@ -783,9 +1065,9 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 				"password gRPC proxy unable to listen on %s",
 				restEndpoint,
 			)
-			return nil, err
+			return nil, nil, err
 		}
-		defer lis.Close()
+		listeners = append(listeners, lis)
 
 		wg.Add(1)
 		go func() {
@@ -802,10 +1084,13 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	wg.Wait()
 
 	// Wait for user to provide the password.
-	ltndLog.Infof("Waiting for wallet encryption password. Use `lncli " +
-		"create` to create a wallet, `lncli unlock` to unlock an " +
-		"existing wallet, or `lncli changepassword` to change the " +
-		"password of an existing wallet and unlock it.")
+	if !autoUnlocked {
+		ltndLog.Infof("Waiting for wallet encryption password. Use " +
+			"`lncli create` to create a wallet, `lncli unlock` to " +
+			"unlock an existing wallet, or `lncli changepassword` " +
+			"to change the password of an existing wallet and " +
+			"unlock it.")
+	}
 
 	// We currently don't distinguish between getting a password to be used
 	// for creation or unlocking, as a new wallet db will be created if
@@ -825,7 +1110,7 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 		// version, then we'll return an error as we don't understand
 		// this.
 		if cipherSeed.InternalVersion != keychain.KeyDerivationVersion {
-			return nil, fmt.Errorf("invalid internal seed version "+
+			return nil, nil, fmt.Errorf("invalid internal seed version "+
 				"%v, current version is %v",
 				cipherSeed.InternalVersion,
 				keychain.KeyDerivationVersion)
@@ -851,28 +1136,130 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 				ltndLog.Errorf("Could not unload new "+
 					"wallet: %v", err)
 			}
-			return nil, err
+			return nil, nil, err
 		}
 
-		return &WalletUnlockParams{
+		params := &WalletUnlockParams{
 			Password:       password,
 			Birthday:       birthday,
 			RecoveryWindow: recoveryWindow,
 			Wallet:         newWallet,
 			ChansToRestore: initMsg.ChanBackups,
-		}, nil
+			StatelessInit:  initMsg.StatelessInit,
+		}
+		if initMsg.StatelessInit {
+			params.MacResponseChan = pwService.MacResponseChan
+		}
+
+		unlocked = true
+		return params, shutdown, nil
 
 	// The wallet has already been created in the past, and is simply being
 	// unlocked. So we'll just return these passphrases.
 	case unlockMsg := <-pwService.UnlockMsgs:
-		return &WalletUnlockParams{
+		params := &WalletUnlockParams{
 			Password:       unlockMsg.Passphrase,
 			RecoveryWindow: unlockMsg.RecoveryWindow,
 			Wallet:         unlockMsg.Wallet,
 			ChansToRestore: unlockMsg.ChanBackups,
-		}, nil
+			StatelessInit:  unlockMsg.StatelessInit,
+		}
+		if unlockMsg.StatelessInit {
+			params.MacResponseChan = pwService.MacResponseChan
+		}
+
+		unlocked = true
+		return params, shutdown, nil
 
 	case <-signal.ShutdownChannel():
-		return nil, fmt.Errorf("shutting down")
+		return nil, nil, fmt.Errorf("shutting down")
+	}
+}
+
+// walletPasswordSource returns the source of the wallet password configured
+// by the user, or nil if the password must be provided over RPC.
+func walletPasswordSource(
+	unlockCfg *lncfg.WalletUnlock) walletunlocker.PasswordSource {
+
+	switch {
+	case unlockCfg.PasswordFile != "":
+		return walletunlocker.NewFileSource(unlockCfg.PasswordFile)
+
+	case unlockCfg.PasswordEnv != "":
+		return walletunlocker.NewEnvSource(unlockCfg.PasswordEnv)
+
+	case unlockCfg.PasswordSocket != "":
+		return walletunlocker.NewSocketSource(
+			unlockCfg.PasswordSocket,
+			walletunlocker.DefaultSocketTimeout,
+		)
 	}
+
+	return nil
+}
+
+// unlockWalletFromSource unlocks the wallet with the password obtained from
+// the configured password source, by handing it to the UnlockerService just
+// like an RPC client would. If no wallet exists yet and creating one is
+// allowed, a new wallet is created from the configured seed file instead.
+// False is returned if no wallet exists and none may be created, in which
+// case the wallet must still be created over RPC.
+func unlockWalletFromSource(pwService *walletunlocker.UnlockerService,
+	chainDir string) (bool, error) {
+
+	source := walletPasswordSource(cfg.WalletUnlock)
+	password, err := source.Password()
+	if err != nil {
+		return false, fmt.Errorf("unable to obtain wallet password "+
+			"from %v: %v", source, err)
+	}
+
+	netDir := btcwallet.NetworkDir(chainDir, activeNetParams.Params)
+	loader := wallet.NewLoader(activeNetParams.Params, netDir, 0)
+	walletExists, err := loader.WalletExists()
+	if err != nil {
+		return false, err
+	}
+
+	ctx := context.Background()
+	switch {
+	case walletExists:
+		ltndLog.Infof("Unlocking wallet with password from %v", source)
+
+		req := &lnrpc.UnlockWalletRequest{
+			WalletPassword: password,
+		}
+		if _, err := pwService.UnlockWallet(ctx, req); err != nil {
+			return false, fmt.Errorf("unable to unlock wallet "+
+				"with password from %v: %v", source, err)
+		}
+
+	case cfg.WalletUnlock.AllowCreate:
+		seedFile := cfg.WalletUnlock.SeedFile
+		mnemonic, err := walletunlocker.ReadMnemonicFile(seedFile)
+		if err != nil {
+			return false, fmt.Errorf("unable to read seed file: %v",
+				err)
+		}
+
+		ltndLog.Infof("Creating wallet from seed in %v with password "+
+			"from %v", seedFile, source)
+
+		req := &lnrpc.InitWalletRequest{
+			WalletPassword:     password,
+			CipherSeedMnemonic: mnemonic[:],
+		}
+		if _, err := pwService.InitWallet(ctx, req); err != nil {
+			return false, fmt.Errorf("unable to create wallet: %v",
+				err)
+		}
+
+	default:
+		ltndLog.Infof("No wallet found to unlock with password from "+
+			"%v, it must be created over RPC first", source)
+
+		return false, nil
+	}
+
+	return true, nil
 }
@@ -371,7 +371,8 @@ func assertChannelClosed(ctx context.Context, t *harnessTest,
 }
 
 // waitForChannelPendingForceClose waits for the node to report that the
-// channel is pending force close, and that the UTXO nursery is aware of it.
+// channel is pending force close, and that the maturity height of its
+// commitment output is known.
 func waitForChannelPendingForceClose(ctx context.Context,
 	node *lntest.HarnessNode, fundingChanPoint *lnrpc.ChannelPoint) error {
 
@@ -403,8 +404,8 @@ func waitForChannelPendingForceClose(ctx context.Context,
 			return false
 		}
 
-		// We must wait until the UTXO nursery has received the channel
-		// and is aware of its maturity height.
+		// We must wait until the commitment transaction has confirmed,
+		// so that the maturity height of its output is known.
 		if forceClose.MaturityHeight == 0 {
 			predErr = fmt.Errorf("channel had maturity height of 0")
 			return false
@@ -2644,7 +2645,7 @@ func testChannelForceClosure(net *lntest.NetworkHarness, t *harnessTest) {
 
 	// Send payments from Alice to Carol, since Carol is htlchodl mode, the
 	// htlc outputs should be left unsettled, and should be swept by the
-	// utxo nursery.
+	// contract resolvers.
 	ctx, cancel := context.WithCancel(ctxb)
 	defer cancel()
 
@@ -2751,9 +2752,9 @@ func testChannelForceClosure(net *lntest.NetworkHarness, t *harnessTest) {
 	}
 
 	// The several restarts in this test are intended to ensure that when a
-	// channel is force-closed, the UTXO nursery has persisted the state of
-	// the channel in the closure process and will recover the correct state
-	// when the system comes back on line. This restart tests state
+	// channel is force-closed, the contract resolvers have persisted the
+	// state of the channel in the closure process and will recover the
+	// correct state when the system comes back on line. This restart tests state
 	// persistence at the beginning of the process, when the commitment
 	// transaction has been broadcast but not yet confirmed in a block.
 	if err := net.RestartNode(net.Alice, nil); err != nil {
@@ -2879,7 +2880,7 @@ func testChannelForceClosure(net *lntest.NetworkHarness, t *harnessTest) {
 			return err
 		}
 
-		// At this point, the nursery should show that the commitment
+		// At this point, the resolver should show that the commitment
 		// output has 1 block left before its CSV delay expires. In
 		// total, we have mined exactly defaultCSV blocks, so the htlc
 		// outputs should also reflect that this many blocks have
@@ -3013,7 +3014,7 @@ func testChannelForceClosure(net *lntest.NetworkHarness, t *harnessTest) {
 	// Compute the height preceding that which will cause the htlc CLTV
 	// timeouts will expire. The outputs entered at the same height as the
 	// output spending from the commitment txn, so we must deduct the number
-	// of blocks we have generated since adding it to the nursery, and take
+	// of blocks we have generated since the commitment confirmed, and take
 	// an additional block off so that we end up one block shy of the expiry
 	// height.
 	cltvHeightDelta := defaultCLTV - defaultCSV - 2 - 1
@@ -3056,8 +3057,9 @@ func testChannelForceClosure(net *lntest.NetworkHarness, t *harnessTest) {
 			return err
 		}
 
-		// We should now be at the block just before the utxo nursery
-		// will attempt to broadcast the htlc timeout transactions.
+		// We should now be at the block just before the timeout
+		// resolvers will attempt to broadcast the htlc timeout
+		// transactions.
 		err = checkPendingChannelNumHtlcs(forceClose, numInvoices)
 		if err != nil {
 			return err
@@ -3253,9 +3255,9 @@ func testChannelForceClosure(net *lntest.NetworkHarness, t *harnessTest) {
 		}
 	}
 
-	// The following restart checks to ensure that the nursery store is
-	// storing the txid of the previously broadcast htlc sweep txn, and that
-	// it begins watching that txid after restarting.
+	// The following restart checks to ensure that the resolvers pick up
+	// the previously broadcast htlc sweep txn, and that they begin
+	// watching it after restarting.
 	if err := net.RestartNode(net.Alice, nil); err != nil {
 		t.Fatalf("Node restart failed: %v", err)
 	}
@@ -9979,7 +9981,7 @@ func testMultiHopLocalForceCloseOnChainHtlcTimeout(net *lntest.NetworkHarness,
 	}
 
 	// We'll now mine enough blocks for the HTLC to expire. After this, Bob
-	// should hand off the now expired HTLC output to the utxo nursery.
+	// should offer the now expired HTLC output to his sweeper.
 	if _, err := net.Miner.Node.Generate(finalCltvDelta - defaultCSV - 1); err != nil {
 		t.Fatalf("unable to generate blocks: %v", err)
 	}
@@ -10230,8 +10232,8 @@ func testMultiHopRemoteForceCloseOnChainHtlcTimeout(net *lntest.NetworkHarness,
 	}
 
 	// Next, we'll mine enough blocks for the HTLC to expire. At this
-	// point, Bob should hand off the output to his internal utxo nursery,
-	// which will broadcast a sweep transaction.
+	// point, Bob should offer the output to his sweeper, which will
+	// broadcast a sweep transaction.
 	if _, err := net.Miner.Node.Generate(finalCltvDelta - 1); err != nil {
 		t.Fatalf("unable to generate blocks: %v", err)
 	}
@@ -10519,7 +10521,7 @@ func testMultiHopHtlcLocalChainClaim(net *lntest.NetworkHarness, t *harnessTest)
 	}
 
 	// At this point, Bob should have broadcast his second layer success
-	// transaction, and should have sent it to the nursery for incubation.
+	// transaction, and should be waiting for its csv delay to expire.
 	pendingChansRequest := &lnrpc.PendingChannelsRequest{}
 	err = lntest.WaitPredicate(func() bool {
 		ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
@@ -349,11 +349,25 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				continue
 			}
 
+			// Zero-conf channels are referred to by an alias until
+			// they confirm. The payer must use the alias our
+			// counterparty chose, as that's the one it forwards
+			// HTLCs over, so we'll skip the channel until we know
+			// about it.
+			hintChanID := chanID
+			if channel.ShortChanID().IsAlias() {
+				remoteAlias := channel.RemoteAlias()
+				if remoteAlias == (lnwire.ShortChannelID{}) {
+					continue
+				}
+				hintChanID = remoteAlias.ToUint64()
+			}
+
 			// Finally, create the routing hint for this channel and
 			// add it to our list of route hints.
 			hint := zpay32.HopHint{
 				NodeID:      channel.IdentityPub,
-				ChannelID:   chanID,
+				ChannelID:   hintChanID,
 				FeeBaseMSat: uint32(remotePolicy.FeeBaseMSat),
 				FeeProportionalMillionths: uint32(
 					remotePolicy.FeeProportionalMillionths,
@@ -382,7 +396,11 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	payReqString, err := payReq.Encode(
 		zpay32.MessageSigner{
-			SignCompact: cfg.NodeSigner.SignDigestCompact,
+			SignCompact: func(msg []byte) ([]byte, error) {
+				return cfg.NodeSigner.SignMessageCompact(
+					msg, false,
+				)
+			},
 		},
 	)
 	if err != nil {
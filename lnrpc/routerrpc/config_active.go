@@ -3,7 +3,10 @@
 package routerrpc
 
 import (
+	"time"
+
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/macaroons"
 	"github.com/wakiyamap/lnd/routing"
 )
@@ -19,6 +22,18 @@ type Config struct {
 	// directory, named DefaultRouterMacFilename.
 	RouterMacPath string `long:"routermacaroonpath" description:"Path to the router macaroon"`
 
+	// BackgroundProbe enables the background prober, which periodically
+	// probes random destinations so mission control learns about the
+	// liquidity in the network.
+	BackgroundProbe bool `long:"backgroundprobe" description:"Periodically probe random destinations to learn about the liquidity in the network"`
+
+	// ProbeAmtSat is the amount probed by the background prober.
+	ProbeAmtSat int64 `long:"probeamt" description:"The amount in satoshis probed by the background prober"`
+
+	// ProbeInterval is the minimum time between two probes of the
+	// background prober.
+	ProbeInterval time.Duration `long:"probeinterval" description:"The minimum time between two probes of the background prober"`
+
 	// NetworkDir is the main network directory wherein the router rpc
 	// server will find the macaroon named DefaultRouterMacFilename.
 	NetworkDir string
@@ -41,6 +56,10 @@ type Config struct {
 	// TODO(roasbeef): assumes router handles saving payment state
 	Router *routing.ChannelRouter
 
+	// ChanGraph is the channel graph the background prober selects its
+	// destinations from.
+	ChanGraph *channeldb.ChannelGraph
+
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
@@ -59,7 +59,7 @@ func (m *PaymentRequest) Reset()         { *m = PaymentRequest{} }
 func (m *PaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentRequest) ProtoMessage()    {}
 func (*PaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{0}
+	return fileDescriptor_router_b39a6790919465dc, []int{0}
 }
 func (m *PaymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentRequest.Unmarshal(m, b)
@@ -134,7 +134,7 @@ func (m *PaymentResponse) Reset()         { *m = PaymentResponse{} }
 func (m *PaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentResponse) ProtoMessage()    {}
 func (*PaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{1}
+	return fileDescriptor_router_b39a6790919465dc, []int{1}
 }
 func (m *PaymentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentResponse.Unmarshal(m, b)
@@ -191,7 +191,7 @@ func (m *RouteFeeRequest) Reset()         { *m = RouteFeeRequest{} }
 func (m *RouteFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeRequest) ProtoMessage()    {}
 func (*RouteFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{2}
+	return fileDescriptor_router_b39a6790919465dc, []int{2}
 }
 func (m *RouteFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeRequest.Unmarshal(m, b)
@@ -244,7 +244,7 @@ func (m *RouteFeeResponse) Reset()         { *m = RouteFeeResponse{} }
 func (m *RouteFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeResponse) ProtoMessage()    {}
 func (*RouteFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{3}
+	return fileDescriptor_router_b39a6790919465dc, []int{3}
 }
 func (m *RouteFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeResponse.Unmarshal(m, b)
@@ -278,11 +278,186 @@ func (m *RouteFeeResponse) GetTimeLockDelay() int64 {
 	return 0
 }
 
+type ProbePaymentRequest struct {
+	// / The compressed public key of the destination to probe.
+	Dest []byte `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`
+	// / The amount to probe in satoshis.
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// *
+	// An absolute limit on the fee of the probed routes. Routes with fees higher
+	// than this will be ignored.
+	FeeLimitSat int64 `protobuf:"varint,3,opt,name=fee_limit_sat,json=feeLimitSat,proto3" json:"fee_limit_sat,omitempty"`
+	// *
+	// The CLTV delta the destination requires for the final hop. If zero, the
+	// default delta is used.
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// An upper limit on the amount of time we should spend probing, expressed
+	// in seconds.
+	TimeoutSeconds int32 `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// *
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used.
+	OutgoingChannelId    int64    `protobuf:"varint,6,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbePaymentRequest) Reset()         { *m = ProbePaymentRequest{} }
+func (m *ProbePaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentRequest) ProtoMessage()    {}
+func (*ProbePaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_b39a6790919465dc, []int{4}
+}
+func (m *ProbePaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentRequest.Unmarshal(m, b)
+}
+func (m *ProbePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProbePaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentRequest.Merge(dst, src)
+}
+func (m *ProbePaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentRequest.Size(m)
+}
+func (m *ProbePaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentRequest proto.InternalMessageInfo
+
+func (m *ProbePaymentRequest) GetDest() []byte {
+	if m != nil {
+		return m.Dest
+	}
+	return nil
+}
+
+func (m *ProbePaymentRequest) GetAmtSat() int64 {
+	if m != nil {
+		return m.AmtSat
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFeeLimitSat() int64 {
+	if m != nil {
+		return m.FeeLimitSat
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetOutgoingChannelId() int64 {
+	if m != nil {
+		return m.OutgoingChannelId
+	}
+	return 0
+}
+
+type ProbePaymentResponse struct {
+	// *
+	// The largest amount, up to the probed amount, that was found to be
+	// deliverable to the destination, expressed in milli-satoshis.
+	MaxAmtMsat int64 `protobuf:"varint,1,opt,name=max_amt_msat,json=maxAmtMsat,proto3" json:"max_amt_msat,omitempty"`
+	// *
+	// The fee of the route along which the maximum amount is deliverable,
+	// expressed in milli-satoshis.
+	RoutingFeeMsat int64 `protobuf:"varint,2,opt,name=routing_fee_msat,json=routingFeeMsat,proto3" json:"routing_fee_msat,omitempty"`
+	// *
+	// The total time lock of the route along which the maximum amount is
+	// deliverable.
+	TimeLockDelay int64 `protobuf:"varint,3,opt,name=time_lock_delay,json=timeLockDelay,proto3" json:"time_lock_delay,omitempty"`
+	// / The public keys of the hops of the route.
+	HopPubkeys [][]byte `protobuf:"bytes,4,rep,name=hop_pubkeys,json=hopPubkeys,proto3" json:"hop_pubkeys,omitempty"`
+	// / The number of probe HTLCs that were sent.
+	Attempts             int32    `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbePaymentResponse) Reset()         { *m = ProbePaymentResponse{} }
+func (m *ProbePaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentResponse) ProtoMessage()    {}
+func (*ProbePaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_b39a6790919465dc, []int{5}
+}
+func (m *ProbePaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentResponse.Unmarshal(m, b)
+}
+func (m *ProbePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentResponse.Marshal(b, m, deterministic)
+}
+func (dst *ProbePaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentResponse.Merge(dst, src)
+}
+func (m *ProbePaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentResponse.Size(m)
+}
+func (m *ProbePaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentResponse proto.InternalMessageInfo
+
+func (m *ProbePaymentResponse) GetMaxAmtMsat() int64 {
+	if m != nil {
+		return m.MaxAmtMsat
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetRoutingFeeMsat() int64 {
+	if m != nil {
+		return m.RoutingFeeMsat
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetTimeLockDelay() int64 {
+	if m != nil {
+		return m.TimeLockDelay
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetHopPubkeys() [][]byte {
+	if m != nil {
+		return m.HopPubkeys
+	}
+	return nil
+}
+
+func (m *ProbePaymentResponse) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PaymentRequest)(nil), "routerrpc.PaymentRequest")
 	proto.RegisterType((*PaymentResponse)(nil), "routerrpc.PaymentResponse")
 	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
 	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+	proto.RegisterType((*ProbePaymentRequest)(nil), "routerrpc.ProbePaymentRequest")
+	proto.RegisterType((*ProbePaymentResponse)(nil), "routerrpc.ProbePaymentResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -308,6 +483,14 @@ type RouterClient interface {
 	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
 	// may cost to send an HTLC to the target end destination.
 	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
+	// *
+	// ProbePayment determines whether a payment to the destination would
+	// succeed, without paying it. HTLCs with a random payment hash are sent
+	// along candidate routes, and the destination failing them for the unknown
+	// payment hash is taken as success. All other failures are fed into mission
+	// control. If the full amount can't be delivered, the largest deliverable
+	// amount is searched for.
+	ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error)
 }
 
 type routerClient struct {
@@ -336,6 +519,15 @@ func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest
 	return out, nil
 }
 
+func (c *routerClient) ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error) {
+	out := new(ProbePaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ProbePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 type RouterServer interface {
 	// *
@@ -349,6 +541,14 @@ type RouterServer interface {
 	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
 	// may cost to send an HTLC to the target end destination.
 	EstimateRouteFee(context.Context, *RouteFeeRequest) (*RouteFeeResponse, error)
+	// *
+	// ProbePayment determines whether a payment to the destination would
+	// succeed, without paying it. HTLCs with a random payment hash are sent
+	// along candidate routes, and the destination failing them for the unknown
+	// payment hash is taken as success. All other failures are fed into mission
+	// control. If the full amount can't be delivered, the largest deliverable
+	// amount is searched for.
+	ProbePayment(context.Context, *ProbePaymentRequest) (*ProbePaymentResponse, error)
 }
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
@@ -391,6 +591,24 @@ func _Router_EstimateRouteFee_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_ProbePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ProbePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ProbePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ProbePayment(ctx, req.(*ProbePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "routerrpc.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -403,39 +621,52 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateRouteFee",
 			Handler:    _Router_EstimateRouteFee_Handler,
 		},
+		{
+			MethodName: "ProbePayment",
+			Handler:    _Router_ProbePayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "routerrpc/router.proto",
 }
 
-func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_e218e7c710fe8172) }
-
-var fileDescriptor_router_e218e7c710fe8172 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x15, 0xb6, 0x85, 0xe5, 0x74, 0x6b, 0x8b, 0x91, 0x20, 0xeb, 0x84, 0xa8, 0x72, 0x01,
-	0xb9, 0x0a, 0x12, 0xdc, 0x73, 0xc3, 0x36, 0x31, 0x31, 0x24, 0xe4, 0x3e, 0x80, 0x65, 0x92, 0xb3,
-	0x26, 0x34, 0x8e, 0x5d, 0xdb, 0x41, 0xca, 0xb3, 0xf0, 0x3c, 0xbc, 0x17, 0xb2, 0xe3, 0x96, 0x82,
-	0x7a, 0xe7, 0x7c, 0xe7, 0xef, 0xf7, 0x3b, 0x81, 0x17, 0x5a, 0xf6, 0x16, 0xb5, 0x56, 0xe5, 0xbb,
-	0xf1, 0x55, 0x28, 0x2d, 0xad, 0x24, 0xc9, 0x5e, 0xcf, 0x7e, 0x47, 0x30, 0xfd, 0xc6, 0x07, 0x81,
-	0x9d, 0xa5, 0xb8, 0xed, 0xd1, 0x58, 0xf2, 0x12, 0x9e, 0x2a, 0x3e, 0x30, 0x8d, 0xdb, 0x34, 0x5a,
-	0x46, 0x79, 0x42, 0x63, 0xc5, 0x07, 0x8a, 0x5b, 0x92, 0xc1, 0xe5, 0x23, 0x22, 0x6b, 0x1b, 0xd1,
-	0x58, 0x66, 0xb8, 0x4d, 0x9f, 0x2c, 0xa3, 0xfc, 0x84, 0x4e, 0x1e, 0x11, 0x1f, 0x9c, 0xb6, 0xe2,
-	0x96, 0xbc, 0x02, 0x28, 0x5b, 0xfb, 0x73, 0x4c, 0x4a, 0x4f, 0x96, 0x51, 0x7e, 0x46, 0x13, 0xa7,
-	0xf8, 0x0c, 0xf2, 0x16, 0x66, 0xb6, 0x11, 0x28, 0x7b, 0xcb, 0x0c, 0x96, 0xb2, 0xab, 0x4c, 0x7a,
-	0xea, 0x73, 0xa6, 0x41, 0x5e, 0x8d, 0x2a, 0x29, 0xe0, 0xb9, 0xec, 0xed, 0x5a, 0x36, 0xdd, 0x9a,
-	0x95, 0x35, 0xef, 0x3a, 0x6c, 0x59, 0x53, 0xa5, 0x67, 0x7e, 0xe2, 0xb3, 0x5d, 0xe8, 0xd3, 0x18,
-	0xb9, 0xaf, 0xb2, 0x1f, 0x30, 0xdb, 0xdb, 0x30, 0x4a, 0x76, 0x06, 0xc9, 0x15, 0x9c, 0x3b, 0x1f,
-	0x35, 0x37, 0xb5, 0x37, 0x72, 0x41, 0x9d, 0xaf, 0xcf, 0xdc, 0xd4, 0xe4, 0x1a, 0x12, 0xa5, 0x91,
-	0x35, 0x82, 0xaf, 0xd1, 0xbb, 0xb8, 0xa0, 0xe7, 0x4a, 0xe3, 0xbd, 0xfb, 0x26, 0xaf, 0x61, 0xa2,
-	0xc6, 0x56, 0x0c, 0xb5, 0xf6, 0x1e, 0x12, 0x0a, 0x41, 0xba, 0xd5, 0x3a, 0xfb, 0x08, 0x33, 0xea,
-	0x00, 0xde, 0x21, 0xee, 0x98, 0x11, 0x38, 0xad, 0xd0, 0xd8, 0x30, 0xc7, 0xbf, 0x1d, 0x47, 0x2e,
-	0x0e, 0x41, 0xc5, 0x5c, 0x38, 0x46, 0x59, 0x05, 0xf3, 0xbf, 0xf5, 0x61, 0xd9, 0x1c, 0xe6, 0xee,
-	0x28, 0xce, 0xae, 0x63, 0x2c, 0x5c, 0x55, 0xe4, 0xab, 0xa6, 0x41, 0xbf, 0x43, 0xfc, 0x6a, 0xb8,
-	0x25, 0x6f, 0x46, 0x84, 0xac, 0x95, 0xe5, 0x86, 0x55, 0xd8, 0xf2, 0x21, 0xb4, 0xbf, 0x74, 0xf2,
-	0x83, 0x2c, 0x37, 0x37, 0x4e, 0x7c, 0xff, 0x2b, 0x82, 0xd8, 0x8f, 0xd1, 0xe4, 0x06, 0x26, 0x2b,
-	0xec, 0xaa, 0x00, 0x88, 0x5c, 0x15, 0xfb, 0xfb, 0x17, 0xff, 0xde, 0x7e, 0xb1, 0x38, 0x16, 0x0a,
-	0x2b, 0x7e, 0x81, 0xf9, 0xad, 0xb1, 0x8d, 0xe0, 0x16, 0x77, 0xeb, 0x93, 0xc3, 0xfc, 0xff, 0x98,
-	0x2c, 0xae, 0x8f, 0xc6, 0xc6, 0x66, 0xdf, 0x63, 0xff, 0x27, 0x7e, 0xf8, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x95, 0xc2, 0xf8, 0xec, 0xa3, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_b39a6790919465dc) }
+
+var fileDescriptor_router_b39a6790919465dc = []byte{
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xd1, 0x6e, 0xda, 0x30,
+	0x14, 0x86, 0x95, 0x06, 0x18, 0x1c, 0x28, 0x30, 0x77, 0xda, 0x28, 0xd5, 0x56, 0x94, 0x8b, 0x2d,
+	0x57, 0x4c, 0xda, 0xee, 0x27, 0x4d, 0xa5, 0xd5, 0xaa, 0x75, 0x1a, 0x0a, 0x0f, 0x60, 0x99, 0xe4,
+	0x40, 0x32, 0xe2, 0xd8, 0xd8, 0xa6, 0x2a, 0x0f, 0x38, 0xed, 0x59, 0x26, 0xed, 0x21, 0x26, 0x27,
+	0x81, 0xd1, 0x42, 0xa5, 0xf5, 0x0e, 0xff, 0xe7, 0x70, 0xec, 0xff, 0xfb, 0x0f, 0xc0, 0x4b, 0x25,
+	0x56, 0x06, 0x95, 0x92, 0xe1, 0xfb, 0xe2, 0xd3, 0x50, 0x2a, 0x61, 0x04, 0x69, 0x6c, 0x75, 0xef,
+	0xa7, 0x03, 0xed, 0x31, 0x5b, 0x73, 0xcc, 0x4c, 0x80, 0xcb, 0x15, 0x6a, 0x43, 0x5e, 0xc1, 0x33,
+	0xc9, 0xd6, 0x54, 0xe1, 0xb2, 0xe7, 0x0c, 0x1c, 0xbf, 0x11, 0xd4, 0x24, 0x5b, 0x07, 0xb8, 0x24,
+	0x1e, 0x1c, 0xcf, 0x10, 0x69, 0x9a, 0xf0, 0xc4, 0x50, 0xcd, 0x4c, 0xef, 0x68, 0xe0, 0xf8, 0x6e,
+	0xd0, 0x9c, 0x21, 0xde, 0x58, 0x6d, 0xc2, 0x0c, 0x79, 0x0d, 0x10, 0xa6, 0xe6, 0xb6, 0x68, 0xea,
+	0xb9, 0x03, 0xc7, 0xaf, 0x06, 0x0d, 0xab, 0xe4, 0x1d, 0xe4, 0x1d, 0x74, 0x4c, 0xc2, 0x51, 0xac,
+	0x0c, 0xd5, 0x18, 0x8a, 0x2c, 0xd2, 0xbd, 0x4a, 0xde, 0xd3, 0x2e, 0xe5, 0x49, 0xa1, 0x92, 0x21,
+	0x9c, 0x88, 0x95, 0x99, 0x8b, 0x24, 0x9b, 0xd3, 0x30, 0x66, 0x59, 0x86, 0x29, 0x4d, 0xa2, 0x5e,
+	0x35, 0xbf, 0xf1, 0xf9, 0xa6, 0x74, 0x51, 0x54, 0xae, 0x23, 0xef, 0x07, 0x74, 0xb6, 0x36, 0xb4,
+	0x14, 0x99, 0x46, 0x72, 0x0a, 0x75, 0xeb, 0x23, 0x66, 0x3a, 0xce, 0x8d, 0xb4, 0x02, 0xeb, 0xeb,
+	0x0b, 0xd3, 0x31, 0x39, 0x83, 0x86, 0x54, 0x48, 0x13, 0xce, 0xe6, 0x98, 0xbb, 0x68, 0x05, 0x75,
+	0xa9, 0xf0, 0xda, 0x9e, 0xc9, 0x39, 0x34, 0x65, 0x31, 0x8a, 0xa2, 0x52, 0xb9, 0x87, 0x46, 0x00,
+	0xa5, 0x74, 0xa9, 0x94, 0xf7, 0x09, 0x3a, 0x81, 0x05, 0x78, 0x85, 0xb8, 0x61, 0x46, 0xa0, 0x12,
+	0xa1, 0x36, 0xe5, 0x3d, 0x95, 0xa8, 0xe4, 0xc8, 0xf8, 0x2e, 0xa8, 0x1a, 0xe3, 0x96, 0x91, 0x17,
+	0x41, 0xf7, 0xdf, 0xf7, 0xcb, 0xc7, 0xfa, 0xd0, 0xb5, 0xa1, 0x58, 0xbb, 0x96, 0x31, 0xd7, 0xac,
+	0x18, 0xe6, 0x06, 0xed, 0x52, 0xbf, 0x42, 0xfc, 0xa6, 0x99, 0x21, 0x6f, 0x0b, 0x84, 0x34, 0x15,
+	0xe1, 0x82, 0x46, 0x98, 0xb2, 0x75, 0x39, 0xfe, 0xd8, 0xca, 0x37, 0x22, 0x5c, 0x8c, 0xac, 0xe8,
+	0xfd, 0x76, 0xe0, 0x64, 0xac, 0xc4, 0x14, 0x1f, 0xc4, 0xfb, 0x94, 0xa7, 0xee, 0x47, 0xee, 0xee,
+	0x47, 0xee, 0x43, 0x77, 0x96, 0x64, 0x2c, 0xa5, 0x79, 0xf0, 0x11, 0xa6, 0x86, 0x6d, 0x42, 0xcd,
+	0xf5, 0x8b, 0xd4, 0xdc, 0x8e, 0xac, 0x7a, 0x28, 0xfd, 0xea, 0x53, 0xd2, 0xaf, 0x3d, 0x96, 0xfe,
+	0x2f, 0x07, 0x5e, 0xdc, 0xf7, 0x5a, 0x62, 0x1d, 0x40, 0x8b, 0xb3, 0x3b, 0x6a, 0xcd, 0xed, 0x20,
+	0x05, 0xce, 0xee, 0x3e, 0x73, 0x93, 0xe3, 0x3c, 0x04, 0xfe, 0xe8, 0x7f, 0xc1, 0xbb, 0x07, 0xc0,
+	0xdb, 0xfd, 0x89, 0x85, 0xa4, 0x72, 0x35, 0x5d, 0xe0, 0xda, 0xee, 0xb7, 0xeb, 0xb7, 0x02, 0x88,
+	0x85, 0x1c, 0x17, 0x0a, 0xe9, 0x43, 0x9d, 0x19, 0x83, 0x5c, 0x9a, 0x8d, 0xff, 0xed, 0xf9, 0xc3,
+	0x1f, 0x07, 0x6a, 0xf9, 0x72, 0x28, 0x32, 0x82, 0xe6, 0x04, 0xb3, 0xa8, 0xb4, 0x44, 0x4e, 0x87,
+	0xdb, 0x5f, 0xed, 0xf0, 0x7e, 0xa4, 0xfd, 0xfe, 0xa1, 0x52, 0x49, 0xe0, 0x2b, 0x74, 0x2f, 0xb5,
+	0x49, 0x38, 0x33, 0xb8, 0x59, 0x3a, 0xb2, 0xdb, 0xff, 0x60, 0x93, 0xfb, 0x67, 0x07, 0x6b, 0xe5,
+	0xb0, 0xef, 0xd0, 0xda, 0xc5, 0x4c, 0xde, 0xec, 0x5e, 0xbc, 0xbf, 0x6b, 0xfd, 0xf3, 0x47, 0xeb,
+	0xc5, 0xc0, 0x69, 0x2d, 0xff, 0x43, 0xfa, 0xf8, 0x77, 0x00, 0x56, 0x46, 0x4f, 0x7e, 0xaa, 0x04,
+	0x00, 0x00,
 }
@@ -48,8 +48,16 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/ProbePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
+	// DefaultProbeAmtSat is the default amount probed by the background
+	// prober.
+	DefaultProbeAmtSat int64 = 100000
+
 	// DefaultRouterMacFilename is the default name of the router macaroon
 	// that we expect to find via a file handle within the main
 	// configuration file in this package.
@@ -60,6 +68,10 @@ var (
 // allows clients to route arbitrary payment through the Lightning Network.
 type Server struct {
 	cfg *Config
+
+	// prober probes random destinations in the background. It is nil if
+	// background probing isn't enabled.
+	prober *routing.Prober
 }
 
 // A compile time check to ensure that Server fully implements the RouterServer
@@ -122,6 +134,42 @@ func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
 		cfg: cfg,
 	}
 
+	if cfg.BackgroundProbe {
+		probeAmt := lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(cfg.ProbeAmtSat),
+		)
+		if probeAmt == 0 {
+			probeAmt = lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(DefaultProbeAmtSat),
+			)
+		}
+
+		probeInterval := cfg.ProbeInterval
+		switch {
+		case probeInterval == 0:
+			probeInterval = routing.DefaultProbeInterval
+
+		case probeInterval < 0:
+			return nil, nil, fmt.Errorf("probe interval must be "+
+				"positive, got %v", probeInterval)
+		}
+
+		// The probes are never settled, so the fee limit only serves
+		// to keep the probed routes in line with those of a regular
+		// payment of the same amount.
+		prober, err := routing.NewProber(&routing.ProberConfig{
+			Graph:        cfg.ChanGraph,
+			ProbePayment: cfg.Router.ProbePayment,
+			Amount:       probeAmt,
+			FeeLimit:     probeAmt / 20,
+			Interval:     probeInterval,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		routerServer.prober = prober
+	}
+
 	return routerServer, macPermissions, nil
 }
 
@@ -129,6 +177,10 @@ func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
 //
 // NOTE: This is part of the lnrpc.SubServer interface.
 func (s *Server) Start() error {
+	if s.prober != nil {
+		return s.prober.Start()
+	}
+
 	return nil
 }
 
@@ -136,6 +188,10 @@ func (s *Server) Start() error {
 //
 // NOTE: This is part of the lnrpc.SubServer interface.
 func (s *Server) Stop() error {
+	if s.prober != nil {
+		return s.prober.Stop()
+	}
+
 	return nil
 }
 
@@ -266,3 +322,59 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 		TimeLockDelay:  int64(routes[0].TotalTimeLock),
 	}, nil
 }
+
+// ProbePayment determines whether a payment to the destination would succeed,
+// without paying it. If the full amount can't be delivered, the largest
+// deliverable amount is searched for.
+func (s *Server) ProbePayment(ctx context.Context,
+	req *ProbePaymentRequest) (*ProbePaymentResponse, error) {
+
+	if len(req.Dest) != 33 {
+		return nil, errors.New("invalid length destination key")
+	}
+	var destNode route.Vertex
+	copy(destNode[:], req.Dest)
+
+	if req.AmtSat <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	payment := &routing.LightningPayment{
+		Target: destNode,
+		Amount: lnwire.NewMSatFromSatoshis(btcutil.Amount(req.AmtSat)),
+		FeeLimit: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.FeeLimitSat),
+		),
+		PayAttemptTimeout: time.Second * time.Duration(req.TimeoutSeconds),
+	}
+
+	if req.FinalCltvDelta != 0 {
+		finalDelta := uint16(req.FinalCltvDelta)
+		payment.FinalCLTVDelta = &finalDelta
+	}
+
+	// Pin to an outgoing channel if specified.
+	if req.OutgoingChannelId != 0 {
+		chanID := uint64(req.OutgoingChannelId)
+		payment.OutgoingChannelID = &chanID
+	}
+
+	result, err := s.cfg.Router.ProbePayment(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ProbePaymentResponse{
+		MaxAmtMsat:     int64(result.MaxAmount),
+		RoutingFeeMsat: int64(result.Route.TotalFees),
+		TimeLockDelay:  int64(result.Route.TotalTimeLock),
+		Attempts:       int32(result.Attempts),
+	}
+	for _, hop := range result.Route.Hops {
+		resp.HopPubkeys = append(
+			resp.HopPubkeys, append([]byte(nil), hop.PubKeyBytes[:]...),
+		)
+	}
+
+	return resp, nil
+}
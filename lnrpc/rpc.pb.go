@@ -55,7 +55,138 @@ func (x AddressType) String() string {
 	return proto.EnumName(AddressType_name, int32(x))
 }
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{0}
+}
+
+type PeerAccessList int32
+
+const (
+	PeerAccessList_ALLOW PeerAccessList = 0
+	PeerAccessList_DENY  PeerAccessList = 1
+)
+
+var PeerAccessList_name = map[int32]string{
+	0: "ALLOW",
+	1: "DENY",
+}
+var PeerAccessList_value = map[string]int32{
+	"ALLOW": 0,
+	"DENY":  1,
+}
+
+func (x PeerAccessList) String() string {
+	return proto.EnumName(PeerAccessList_name, int32(x))
+}
+func (PeerAccessList) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{1}
+}
+
+type ResolutionType int32
+
+const (
+	ResolutionType_TYPE_UNKNOWN ResolutionType = 0
+	// / The output on the commitment transaction that pays to us.
+	ResolutionType_COMMIT ResolutionType = 1
+	// / An incoming HTLC output.
+	ResolutionType_INCOMING_HTLC ResolutionType = 2
+	// / An outgoing HTLC output.
+	ResolutionType_OUTGOING_HTLC ResolutionType = 3
+)
+
+var ResolutionType_name = map[int32]string{
+	0: "TYPE_UNKNOWN",
+	1: "COMMIT",
+	2: "INCOMING_HTLC",
+	3: "OUTGOING_HTLC",
+}
+var ResolutionType_value = map[string]int32{
+	"TYPE_UNKNOWN":  0,
+	"COMMIT":        1,
+	"INCOMING_HTLC": 2,
+	"OUTGOING_HTLC": 3,
+}
+
+func (x ResolutionType) String() string {
+	return proto.EnumName(ResolutionType_name, int32(x))
+}
+func (ResolutionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{2}
+}
+
+type ResolutionOutcome int32
+
+const (
+	// / The outcome of the resolution is unknown.
+	ResolutionOutcome_OUTCOME_UNKNOWN ResolutionOutcome = 0
+	// / The output was claimed on chain.
+	ResolutionOutcome_CLAIMED ResolutionOutcome = 1
+	// / The output was not claimed on chain.
+	ResolutionOutcome_UNCLAIMED ResolutionOutcome = 2
+	// / The output was abandoned, because we never learned the preimage.
+	ResolutionOutcome_ABANDONED ResolutionOutcome = 3
+	// *
+	// The first stage of a two stage HTLC resolution confirmed, the output of
+	// the second-level transaction is reported separately.
+	ResolutionOutcome_FIRST_STAGE ResolutionOutcome = 4
+	// / The output was claimed by the remote party.
+	ResolutionOutcome_TIMEOUT ResolutionOutcome = 5
+)
+
+var ResolutionOutcome_name = map[int32]string{
+	0: "OUTCOME_UNKNOWN",
+	1: "CLAIMED",
+	2: "UNCLAIMED",
+	3: "ABANDONED",
+	4: "FIRST_STAGE",
+	5: "TIMEOUT",
+}
+var ResolutionOutcome_value = map[string]int32{
+	"OUTCOME_UNKNOWN": 0,
+	"CLAIMED":         1,
+	"UNCLAIMED":       2,
+	"ABANDONED":       3,
+	"FIRST_STAGE":     4,
+	"TIMEOUT":         5,
+}
+
+func (x ResolutionOutcome) String() string {
+	return proto.EnumName(ResolutionOutcome_name, int32(x))
+}
+func (ResolutionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{3}
+}
+
+type BreachEventType int32
+
+const (
+	// / A breach was detected and handed off to the breach arbiter.
+	BreachEventType_BREACH_DETECTED BreachEventType = 0
+	// / A justice transaction was broadcast.
+	BreachEventType_JUSTICE_TX_BROADCAST BreachEventType = 1
+	// / One of the breached outputs was spent.
+	BreachEventType_OUTPUT_SPENT BreachEventType = 2
+	// / All breached outputs were spent and the channel is fully closed.
+	BreachEventType_BREACH_RESOLVED BreachEventType = 3
+)
+
+var BreachEventType_name = map[int32]string{
+	0: "BREACH_DETECTED",
+	1: "JUSTICE_TX_BROADCAST",
+	2: "OUTPUT_SPENT",
+	3: "BREACH_RESOLVED",
+}
+var BreachEventType_value = map[string]int32{
+	"BREACH_DETECTED":      0,
+	"JUSTICE_TX_BROADCAST": 1,
+	"OUTPUT_SPENT":         2,
+	"BREACH_RESOLVED":      3,
+}
+
+func (x BreachEventType) String() string {
+	return proto.EnumName(BreachEventType_name, int32(x))
+}
+func (BreachEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{4}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -90,7 +221,7 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{41, 0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{51, 0}
 }
 
 type Peer_SyncType int32
@@ -122,7 +253,7 @@ func (x Peer_SyncType) String() string {
 	return proto.EnumName(Peer_SyncType_name, int32(x))
 }
 func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{44, 0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{58, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
@@ -151,7 +282,7 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
 }
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{62, 0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{76, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -180,7 +311,7 @@ func (x Invoice_InvoiceState) String() string {
 	return proto.EnumName(Invoice_InvoiceState_name, int32(x))
 }
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{92, 0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{110, 0}
 }
 
 type GenSeedRequest struct {
@@ -201,7 +332,7 @@ func (m *GenSeedRequest) Reset()         { *m = GenSeedRequest{} }
 func (m *GenSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenSeedRequest) ProtoMessage()    {}
 func (*GenSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{0}
 }
 func (m *GenSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedRequest.Unmarshal(m, b)
@@ -256,7 +387,7 @@ func (m *GenSeedResponse) Reset()         { *m = GenSeedResponse{} }
 func (m *GenSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenSeedResponse) ProtoMessage()    {}
 func (*GenSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{1}
+	return fileDescriptor_rpc_b24756c01b185350, []int{1}
 }
 func (m *GenSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedResponse.Unmarshal(m, b)
@@ -319,17 +450,23 @@ type InitWalletRequest struct {
 	// total data loss occurred. If specified, then after on-chain recovery of
 	// funds, lnd begin to carry out the data loss recovery protocol in order to
 	// recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	// *
+	// stateless_init is an optional argument instructing the daemon NOT to create
+	// any macaroon files in its file system. If this parameter is set, then the
+	// admin macaroon returned in the response MUST be stored by the caller of the
+	// RPC as otherwise all access to the daemon will be lost!
+	StatelessInit        bool     `protobuf:"varint,6,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
 func (m *InitWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*InitWalletRequest) ProtoMessage()    {}
 func (*InitWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{2}
+	return fileDescriptor_rpc_b24756c01b185350, []int{2}
 }
 func (m *InitWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletRequest.Unmarshal(m, b)
@@ -384,7 +521,19 @@ func (m *InitWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *InitWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type InitWalletResponse struct {
+	// *
+	// The binary serialized admin macaroon that can be used to access the daemon
+	// after creating the wallet. This is only set if the stateless_init flag was
+	// set in the request.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -394,7 +543,7 @@ func (m *InitWalletResponse) Reset()         { *m = InitWalletResponse{} }
 func (m *InitWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*InitWalletResponse) ProtoMessage()    {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{3}
+	return fileDescriptor_rpc_b24756c01b185350, []int{3}
 }
 func (m *InitWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletResponse.Unmarshal(m, b)
@@ -414,6 +563,13 @@ func (m *InitWalletResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InitWalletResponse proto.InternalMessageInfo
 
+func (m *InitWalletResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type UnlockWalletRequest struct {
 	// *
 	// wallet_password should be the current valid passphrase for the daemon. This
@@ -434,17 +590,23 @@ type UnlockWalletRequest struct {
 	// total data loss occurred. If specified, then after on-chain recovery of
 	// funds, lnd begin to carry out the data loss recovery protocol in order to
 	// recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,3,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,3,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	// *
+	// stateless_init is an optional argument instructing the daemon NOT to create
+	// any macaroon files in its file system. A new admin macaroon is returned
+	// instead, which can also be used to recover access if the one returned by
+	// InitWallet was never received.
+	StatelessInit        bool     `protobuf:"varint,4,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{4}
+	return fileDescriptor_rpc_b24756c01b185350, []int{4}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -485,7 +647,19 @@ func (m *UnlockWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *UnlockWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type UnlockWalletResponse struct {
+	// *
+	// The binary serialized admin macaroon that can be used to access the daemon
+	// after unlocking the wallet. This is only set if the stateless_init flag was
+	// set in the request.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -495,7 +669,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{5}
+	return fileDescriptor_rpc_b24756c01b185350, []int{5}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -515,6 +689,13 @@ func (m *UnlockWalletResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UnlockWalletResponse proto.InternalMessageInfo
 
+func (m *UnlockWalletResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type ChangePasswordRequest struct {
 	// *
 	// current_password should be the current valid passphrase used to unlock the
@@ -533,7 +714,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{6}
+	return fileDescriptor_rpc_b24756c01b185350, []int{6}
 }
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
@@ -577,7 +758,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{7}
+	return fileDescriptor_rpc_b24756c01b185350, []int{7}
 }
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
@@ -609,7 +790,9 @@ type Utxo struct {
 	// / The outpoint in format txid:n
 	Outpoint *OutPoint `protobuf:"bytes,5,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
 	// / The number of confirmations for the Utxo
-	Confirmations        int64    `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Confirmations int64 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// / The name of the wallet account the utxo belongs to
+	Account              string   `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -619,7 +802,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{8}
+	return fileDescriptor_rpc_b24756c01b185350, []int{8}
 }
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Utxo.Unmarshal(m, b)
@@ -681,6 +864,13 @@ func (m *Utxo) GetConfirmations() int64 {
 	return 0
 }
 
+func (m *Utxo) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type Transaction struct {
 	// / The transaction hash
 	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,proto3" json:"tx_hash,omitempty"`
@@ -697,17 +887,19 @@ type Transaction struct {
 	// / Fees paid for this transaction
 	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees,proto3" json:"total_fees,omitempty"`
 	// / Addresses that received funds for this transaction
-	DestAddresses        []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
+	// / The net amounts of the transaction for each wallet account it is relevant to
+	AccountAmounts       []*AccountAmount `protobuf:"bytes,9,rep,name=account_amounts,proto3" json:"account_amounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{9}
+	return fileDescriptor_rpc_b24756c01b185350, []int{9}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -783,6 +975,61 @@ func (m *Transaction) GetDestAddresses() []string {
 	return nil
 }
 
+func (m *Transaction) GetAccountAmounts() []*AccountAmount {
+	if m != nil {
+		return m.AccountAmounts
+	}
+	return nil
+}
+
+type AccountAmount struct {
+	// / The name of the wallet account
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// / The net amount of the transaction for the account, in satoshis
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountAmount) Reset()         { *m = AccountAmount{} }
+func (m *AccountAmount) String() string { return proto.CompactTextString(m) }
+func (*AccountAmount) ProtoMessage()    {}
+func (*AccountAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{10}
+}
+func (m *AccountAmount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountAmount.Unmarshal(m, b)
+}
+func (m *AccountAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountAmount.Marshal(b, m, deterministic)
+}
+func (dst *AccountAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAmount.Merge(dst, src)
+}
+func (m *AccountAmount) XXX_Size() int {
+	return xxx_messageInfo_AccountAmount.Size(m)
+}
+func (m *AccountAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAmount proto.InternalMessageInfo
+
+func (m *AccountAmount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountAmount) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 type GetTransactionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -793,7 +1040,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{10}
+	return fileDescriptor_rpc_b24756c01b185350, []int{11}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -825,7 +1072,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{11}
+	return fileDescriptor_rpc_b24756c01b185350, []int{12}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -866,7 +1113,7 @@ func (m *FeeLimit) Reset()         { *m = FeeLimit{} }
 func (m *FeeLimit) String() string { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()    {}
 func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{12}
+	return fileDescriptor_rpc_b24756c01b185350, []int{13}
 }
 func (m *FeeLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeLimit.Unmarshal(m, b)
@@ -1030,7 +1277,7 @@ func (m *SendRequest) Reset()         { *m = SendRequest{} }
 func (m *SendRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()    {}
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{13}
+	return fileDescriptor_rpc_b24756c01b185350, []int{14}
 }
 func (m *SendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
@@ -1134,7 +1381,7 @@ func (m *SendResponse) Reset()         { *m = SendResponse{} }
 func (m *SendResponse) String() string { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()    {}
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{14}
+	return fileDescriptor_rpc_b24756c01b185350, []int{15}
 }
 func (m *SendResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendResponse.Unmarshal(m, b)
@@ -1182,6 +1429,314 @@ func (m *SendResponse) GetPaymentHash() []byte {
 	return nil
 }
 
+type RebalanceRequest struct {
+	// / The channel the circular payment needs to leave through.
+	OutgoingChanId uint64 `protobuf:"varint,1,opt,name=outgoing_chan_id,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The channel the circular payment needs to arrive back through. The peer
+	// of this channel is used as the last hop. Either this or last_hop_pubkey
+	// must be set.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id,proto3" json:"incoming_chan_id,omitempty"`
+	// *
+	// The compressed public key of the peer the circular payment needs to
+	// arrive back from. Either this or incoming_chan_id must be set.
+	LastHopPubkey []byte `protobuf:"bytes,3,opt,name=last_hop_pubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// / The amount to move between the channels in satoshis.
+	Amt int64 `protobuf:"varint,4,opt,name=amt,proto3" json:"amt,omitempty"`
+	// / The maximum total fee in satoshis that may be paid for the rebalance.
+	MaxFee               int64    `protobuf:"varint,5,opt,name=max_fee,proto3" json:"max_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceRequest) Reset()         { *m = RebalanceRequest{} }
+func (m *RebalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceRequest) ProtoMessage()    {}
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{16}
+}
+func (m *RebalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceRequest.Unmarshal(m, b)
+}
+func (m *RebalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceRequest.Merge(dst, src)
+}
+func (m *RebalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceRequest.Size(m)
+}
+func (m *RebalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceRequest proto.InternalMessageInfo
+
+func (m *RebalanceRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *RebalanceRequest) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetMaxFee() int64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+type RebalanceResponse struct {
+	// / The outcome of the rebalance.
+	Rebalance *Rebalance `protobuf:"bytes,1,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	// / The route the rebalance took. Only set if the rebalance succeeded.
+	Route                *Route   `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceResponse) Reset()         { *m = RebalanceResponse{} }
+func (m *RebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceResponse) ProtoMessage()    {}
+func (*RebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{17}
+}
+func (m *RebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceResponse.Unmarshal(m, b)
+}
+func (m *RebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceResponse.Merge(dst, src)
+}
+func (m *RebalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_RebalanceResponse.Size(m)
+}
+func (m *RebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceResponse proto.InternalMessageInfo
+
+func (m *RebalanceResponse) GetRebalance() *Rebalance {
+	if m != nil {
+		return m.Rebalance
+	}
+	return nil
+}
+
+func (m *RebalanceResponse) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+type Rebalance struct {
+	// / The payment hash of the internal invoice paid by the rebalance.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The channel the circular payment left through.
+	OutgoingChanId uint64 `protobuf:"varint,2,opt,name=outgoing_chan_id,proto3" json:"outgoing_chan_id,omitempty"`
+	// / The channel the circular payment arrived back through.
+	IncomingChanId uint64 `protobuf:"varint,3,opt,name=incoming_chan_id,proto3" json:"incoming_chan_id,omitempty"`
+	// / The hex-encoded public key of the last hop.
+	LastHopPubkey string `protobuf:"bytes,4,opt,name=last_hop_pubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// / The amount moved between the channels in millisatoshis.
+	AmtMsat int64 `protobuf:"varint,5,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	// / The total fee paid for the rebalance in millisatoshis.
+	FeeMsat int64 `protobuf:"varint,6,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// / Whether the rebalance succeeded.
+	Succeeded bool `protobuf:"varint,7,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// / The reason the rebalance failed, if it did.
+	FailureReason string `protobuf:"bytes,8,opt,name=failure_reason,proto3" json:"failure_reason,omitempty"`
+	// / The time the rebalance completed in seconds since the unix epoch.
+	Timestamp            int64    `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rebalance) Reset()         { *m = Rebalance{} }
+func (m *Rebalance) String() string { return proto.CompactTextString(m) }
+func (*Rebalance) ProtoMessage()    {}
+func (*Rebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{18}
+}
+func (m *Rebalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rebalance.Unmarshal(m, b)
+}
+func (m *Rebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rebalance.Marshal(b, m, deterministic)
+}
+func (dst *Rebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rebalance.Merge(dst, src)
+}
+func (m *Rebalance) XXX_Size() int {
+	return xxx_messageInfo_Rebalance.Size(m)
+}
+func (m *Rebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rebalance proto.InternalMessageInfo
+
+func (m *Rebalance) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *Rebalance) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *Rebalance) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *Rebalance) GetLastHopPubkey() string {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return ""
+}
+
+func (m *Rebalance) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *Rebalance) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *Rebalance) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *Rebalance) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *Rebalance) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ListRebalancesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRebalancesRequest) Reset()         { *m = ListRebalancesRequest{} }
+func (m *ListRebalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRebalancesRequest) ProtoMessage()    {}
+func (*ListRebalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{19}
+}
+func (m *ListRebalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRebalancesRequest.Unmarshal(m, b)
+}
+func (m *ListRebalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRebalancesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRebalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRebalancesRequest.Merge(dst, src)
+}
+func (m *ListRebalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRebalancesRequest.Size(m)
+}
+func (m *ListRebalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRebalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRebalancesRequest proto.InternalMessageInfo
+
+type ListRebalancesResponse struct {
+	// / The list of rebalances.
+	Rebalances           []*Rebalance `protobuf:"bytes,1,rep,name=rebalances,proto3" json:"rebalances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListRebalancesResponse) Reset()         { *m = ListRebalancesResponse{} }
+func (m *ListRebalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRebalancesResponse) ProtoMessage()    {}
+func (*ListRebalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{20}
+}
+func (m *ListRebalancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRebalancesResponse.Unmarshal(m, b)
+}
+func (m *ListRebalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRebalancesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRebalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRebalancesResponse.Merge(dst, src)
+}
+func (m *ListRebalancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRebalancesResponse.Size(m)
+}
+func (m *ListRebalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRebalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRebalancesResponse proto.InternalMessageInfo
+
+func (m *ListRebalancesResponse) GetRebalances() []*Rebalance {
+	if m != nil {
+		return m.Rebalances
+	}
+	return nil
+}
+
 type SendToRouteRequest struct {
 	// / The payment hash to use for the HTLC.
 	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -1204,7 +1759,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{15}
+	return fileDescriptor_rpc_b24756c01b185350, []int{21}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -1269,7 +1824,7 @@ func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()    {}
 func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{16}
+	return fileDescriptor_rpc_b24756c01b185350, []int{22}
 }
 func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
@@ -1415,7 +1970,7 @@ func (m *OutPoint) Reset()         { *m = OutPoint{} }
 func (m *OutPoint) String() string { return proto.CompactTextString(m) }
 func (*OutPoint) ProtoMessage()    {}
 func (*OutPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{17}
+	return fileDescriptor_rpc_b24756c01b185350, []int{23}
 }
 func (m *OutPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OutPoint.Unmarshal(m, b)
@@ -1470,7 +2025,7 @@ func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
 func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()    {}
 func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{18}
+	return fileDescriptor_rpc_b24756c01b185350, []int{24}
 }
 func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
@@ -1518,7 +2073,7 @@ func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
 func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeRequest) ProtoMessage()    {}
 func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{19}
+	return fileDescriptor_rpc_b24756c01b185350, []int{25}
 }
 func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
@@ -1566,7 +2121,7 @@ func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
 func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeResponse) ProtoMessage()    {}
 func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{20}
+	return fileDescriptor_rpc_b24756c01b185350, []int{26}
 }
 func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
@@ -1616,7 +2171,7 @@ func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()    {}
 func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{21}
+	return fileDescriptor_rpc_b24756c01b185350, []int{27}
 }
 func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
@@ -1669,7 +2224,7 @@ func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()    {}
 func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{22}
+	return fileDescriptor_rpc_b24756c01b185350, []int{28}
 }
 func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
@@ -1709,7 +2264,11 @@ type SendCoinsRequest struct {
 	// If set, then the amount field will be ignored, and lnd will attempt to
 	// send all the coins under control of the internal wallet to the specified
 	// address.
-	SendAll              bool     `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	// *
+	// The name of the wallet account to send the coins from. If unset, the coins
+	// of the default account are spent.
+	Account              string   `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1719,7 +2278,7 @@ func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()    {}
 func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{23}
+	return fileDescriptor_rpc_b24756c01b185350, []int{29}
 }
 func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
@@ -1774,6 +2333,13 @@ func (m *SendCoinsRequest) GetSendAll() bool {
 	return false
 }
 
+func (m *SendCoinsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type SendCoinsResponse struct {
 	// / The transaction ID of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -1786,7 +2352,7 @@ func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()    {}
 func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{24}
+	return fileDescriptor_rpc_b24756c01b185350, []int{30}
 }
 func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
@@ -1817,7 +2383,9 @@ type ListUnspentRequest struct {
 	// / The minimum number of confirmations to be included.
 	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
 	// / The maximum number of confirmations to be included.
-	MaxConfs             int32    `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
+	MaxConfs int32 `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
+	// / The name of the wallet account to list utxos of. If unset, all accounts.
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1827,7 +2395,7 @@ func (m *ListUnspentRequest) Reset()         { *m = ListUnspentRequest{} }
 func (m *ListUnspentRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentRequest) ProtoMessage()    {}
 func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{25}
+	return fileDescriptor_rpc_b24756c01b185350, []int{31}
 }
 func (m *ListUnspentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentRequest.Unmarshal(m, b)
@@ -1861,6 +2429,13 @@ func (m *ListUnspentRequest) GetMaxConfs() int32 {
 	return 0
 }
 
+func (m *ListUnspentRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type ListUnspentResponse struct {
 	// / A list of utxos
 	Utxos                []*Utxo  `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
@@ -1873,7 +2448,7 @@ func (m *ListUnspentResponse) Reset()         { *m = ListUnspentResponse{} }
 func (m *ListUnspentResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentResponse) ProtoMessage()    {}
 func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{26}
+	return fileDescriptor_rpc_b24756c01b185350, []int{32}
 }
 func (m *ListUnspentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentResponse.Unmarshal(m, b)
@@ -1902,17 +2477,21 @@ func (m *ListUnspentResponse) GetUtxos() []*Utxo {
 
 type NewAddressRequest struct {
 	// / The address type
-	Type                 AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Type AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
+	// *
+	// The name of the wallet account to derive the address from. If unset, the
+	// default account is used.
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()    {}
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{27}
+	return fileDescriptor_rpc_b24756c01b185350, []int{33}
 }
 func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
@@ -1939,6 +2518,13 @@ func (m *NewAddressRequest) GetType() AddressType {
 	return AddressType_WITNESS_PUBKEY_HASH
 }
 
+func (m *NewAddressRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type NewAddressResponse struct {
 	// / The newly generated wallet address
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -1951,7 +2537,7 @@ func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()    {}
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{28}
+	return fileDescriptor_rpc_b24756c01b185350, []int{34}
 }
 func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
@@ -1990,7 +2576,7 @@ func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()    {}
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{29}
+	return fileDescriptor_rpc_b24756c01b185350, []int{35}
 }
 func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
@@ -2029,7 +2615,7 @@ func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()    {}
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{30}
+	return fileDescriptor_rpc_b24756c01b185350, []int{36}
 }
 func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
@@ -2070,7 +2656,7 @@ func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()    {}
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{31}
+	return fileDescriptor_rpc_b24756c01b185350, []int{37}
 }
 func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
@@ -2118,7 +2704,7 @@ func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()    {}
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{32}
+	return fileDescriptor_rpc_b24756c01b185350, []int{38}
 }
 func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
@@ -2167,7 +2753,7 @@ func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()    {}
 func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{33}
+	return fileDescriptor_rpc_b24756c01b185350, []int{39}
 }
 func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
@@ -2211,7 +2797,7 @@ func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()    {}
 func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{34}
+	return fileDescriptor_rpc_b24756c01b185350, []int{40}
 }
 func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
@@ -2229,76 +2815,245 @@ func (m *ConnectPeerResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_ConnectPeerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConnectPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConnectPeerResponse proto.InternalMessageInfo
+
+type DisconnectPeerRequest struct {
+	// / The pubkey of the node to disconnect from
+	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
+func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerRequest) ProtoMessage()    {}
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{41}
+}
+func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
+}
+func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
+}
+func (dst *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerRequest.Merge(dst, src)
+}
+func (m *DisconnectPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_DisconnectPeerRequest.Size(m)
+}
+func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
+
+func (m *DisconnectPeerRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type DisconnectPeerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{} }
+func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerResponse) ProtoMessage()    {}
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{42}
+}
+func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
+}
+func (m *DisconnectPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectPeerResponse.Marshal(b, m, deterministic)
+}
+func (dst *DisconnectPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerResponse.Merge(dst, src)
+}
+func (m *DisconnectPeerResponse) XXX_Size() int {
+	return xxx_messageInfo_DisconnectPeerResponse.Size(m)
+}
+func (m *DisconnectPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectPeerResponse proto.InternalMessageInfo
+
+type PeerAccessRuleRequest struct {
+	// / The list the rule is added to, or removed from.
+	List PeerAccessList `protobuf:"varint,1,opt,name=list,proto3,enum=lnrpc.PeerAccessList" json:"list,omitempty"`
+	// *
+	// The hex-encoded public key of a node, an IP address, or a network in CIDR
+	// notation.
+	Rule                 string   `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerAccessRuleRequest) Reset()         { *m = PeerAccessRuleRequest{} }
+func (m *PeerAccessRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*PeerAccessRuleRequest) ProtoMessage()    {}
+func (*PeerAccessRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{43}
+}
+func (m *PeerAccessRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerAccessRuleRequest.Unmarshal(m, b)
+}
+func (m *PeerAccessRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerAccessRuleRequest.Marshal(b, m, deterministic)
+}
+func (dst *PeerAccessRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAccessRuleRequest.Merge(dst, src)
+}
+func (m *PeerAccessRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_PeerAccessRuleRequest.Size(m)
+}
+func (m *PeerAccessRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAccessRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAccessRuleRequest proto.InternalMessageInfo
+
+func (m *PeerAccessRuleRequest) GetList() PeerAccessList {
+	if m != nil {
+		return m.List
+	}
+	return PeerAccessList_ALLOW
+}
+
+func (m *PeerAccessRuleRequest) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+type PeerAccessRuleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerAccessRuleResponse) Reset()         { *m = PeerAccessRuleResponse{} }
+func (m *PeerAccessRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerAccessRuleResponse) ProtoMessage()    {}
+func (*PeerAccessRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{44}
+}
+func (m *PeerAccessRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerAccessRuleResponse.Unmarshal(m, b)
+}
+func (m *PeerAccessRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerAccessRuleResponse.Marshal(b, m, deterministic)
+}
+func (dst *PeerAccessRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAccessRuleResponse.Merge(dst, src)
+}
+func (m *PeerAccessRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_PeerAccessRuleResponse.Size(m)
+}
+func (m *PeerAccessRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAccessRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAccessRuleResponse proto.InternalMessageInfo
 
-type DisconnectPeerRequest struct {
-	// / The pubkey of the node to disconnect from
-	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+type ListPeerAccessRulesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
-func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
-func (*DisconnectPeerRequest) ProtoMessage()    {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{35}
+func (m *ListPeerAccessRulesRequest) Reset()         { *m = ListPeerAccessRulesRequest{} }
+func (m *ListPeerAccessRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPeerAccessRulesRequest) ProtoMessage()    {}
+func (*ListPeerAccessRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{45}
 }
-func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
+func (m *ListPeerAccessRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPeerAccessRulesRequest.Unmarshal(m, b)
 }
-func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
+func (m *ListPeerAccessRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPeerAccessRulesRequest.Marshal(b, m, deterministic)
 }
-func (dst *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisconnectPeerRequest.Merge(dst, src)
+func (dst *ListPeerAccessRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeerAccessRulesRequest.Merge(dst, src)
 }
-func (m *DisconnectPeerRequest) XXX_Size() int {
-	return xxx_messageInfo_DisconnectPeerRequest.Size(m)
+func (m *ListPeerAccessRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPeerAccessRulesRequest.Size(m)
 }
-func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
+func (m *ListPeerAccessRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeerAccessRulesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
-
-func (m *DisconnectPeerRequest) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
-	}
-	return ""
-}
+var xxx_messageInfo_ListPeerAccessRulesRequest proto.InternalMessageInfo
 
-type DisconnectPeerResponse struct {
+type ListPeerAccessRulesResponse struct {
+	// / The rules of the allow list.
+	AllowRules []string `protobuf:"bytes,1,rep,name=allow_rules,json=allowRules,proto3" json:"allow_rules,omitempty"`
+	// / The rules of the deny list.
+	DenyRules []string `protobuf:"bytes,2,rep,name=deny_rules,json=denyRules,proto3" json:"deny_rules,omitempty"`
+	// *
+	// Whether inbound connections are restricted to peers on the allow list, and
+	// peers we have channels with.
+	RestrictInbound      bool     `protobuf:"varint,3,opt,name=restrict_inbound,json=restrictInbound,proto3" json:"restrict_inbound,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{} }
-func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*DisconnectPeerResponse) ProtoMessage()    {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{36}
+func (m *ListPeerAccessRulesResponse) Reset()         { *m = ListPeerAccessRulesResponse{} }
+func (m *ListPeerAccessRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPeerAccessRulesResponse) ProtoMessage()    {}
+func (*ListPeerAccessRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{46}
 }
-func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
+func (m *ListPeerAccessRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPeerAccessRulesResponse.Unmarshal(m, b)
 }
-func (m *DisconnectPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisconnectPeerResponse.Marshal(b, m, deterministic)
+func (m *ListPeerAccessRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPeerAccessRulesResponse.Marshal(b, m, deterministic)
 }
-func (dst *DisconnectPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisconnectPeerResponse.Merge(dst, src)
+func (dst *ListPeerAccessRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeerAccessRulesResponse.Merge(dst, src)
 }
-func (m *DisconnectPeerResponse) XXX_Size() int {
-	return xxx_messageInfo_DisconnectPeerResponse.Size(m)
+func (m *ListPeerAccessRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPeerAccessRulesResponse.Size(m)
 }
-func (m *DisconnectPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisconnectPeerResponse.DiscardUnknown(m)
+func (m *ListPeerAccessRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeerAccessRulesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DisconnectPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListPeerAccessRulesResponse proto.InternalMessageInfo
+
+func (m *ListPeerAccessRulesResponse) GetAllowRules() []string {
+	if m != nil {
+		return m.AllowRules
+	}
+	return nil
+}
+
+func (m *ListPeerAccessRulesResponse) GetDenyRules() []string {
+	if m != nil {
+		return m.DenyRules
+	}
+	return nil
+}
+
+func (m *ListPeerAccessRulesResponse) GetRestrictInbound() bool {
+	if m != nil {
+		return m.RestrictInbound
+	}
+	return false
+}
 
 type HTLC struct {
 	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
@@ -2314,7 +3069,7 @@ func (m *HTLC) Reset()         { *m = HTLC{} }
 func (m *HTLC) String() string { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()    {}
 func (*HTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{37}
+	return fileDescriptor_rpc_b24756c01b185350, []int{47}
 }
 func (m *HTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTLC.Unmarshal(m, b)
@@ -2419,7 +3174,12 @@ type Channel struct {
 	// / True if we were the ones that created the channel.
 	Initiator bool `protobuf:"varint,18,opt,name=initiator,proto3" json:"initiator,omitempty"`
 	// / A set of flags showing the current state of the cahnnel.
-	ChanStatusFlags      string   `protobuf:"bytes,19,opt,name=chan_status_flags,proto3" json:"chan_status_flags,omitempty"`
+	ChanStatusFlags string `protobuf:"bytes,19,opt,name=chan_status_flags,proto3" json:"chan_status_flags,omitempty"`
+	// *
+	// The short channel ID of the confirmed funding transaction. For zero-conf
+	// channels, chan_id is an alias and this field is only set once the funding
+	// transaction confirmed.
+	ConfirmedChanId      uint64   `protobuf:"varint,20,opt,name=confirmed_chan_id,proto3" json:"confirmed_chan_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2429,7 +3189,7 @@ func (m *Channel) Reset()         { *m = Channel{} }
 func (m *Channel) String() string { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()    {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{38}
+	return fileDescriptor_rpc_b24756c01b185350, []int{48}
 }
 func (m *Channel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Channel.Unmarshal(m, b)
@@ -2582,6 +3342,13 @@ func (m *Channel) GetChanStatusFlags() string {
 	return ""
 }
 
+func (m *Channel) GetConfirmedChanId() uint64 {
+	if m != nil {
+		return m.ConfirmedChanId
+	}
+	return 0
+}
+
 type ListChannelsRequest struct {
 	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
@@ -2596,7 +3363,7 @@ func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()    {}
 func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{39}
+	return fileDescriptor_rpc_b24756c01b185350, []int{49}
 }
 func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
@@ -2656,7 +3423,7 @@ func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()    {}
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{40}
+	return fileDescriptor_rpc_b24756c01b185350, []int{50}
 }
 func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
@@ -2703,17 +3470,21 @@ type ChannelCloseSummary struct {
 	// / The sum of all the time-locked outputs at the time of channel closure
 	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance,proto3" json:"time_locked_balance,omitempty"`
 	// / Details on how the channel was closed.
-	CloseType            ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	// *
+	// The resolutions of the outputs of the channel that were resolved on
+	// chain. Only populated for force closed channels.
+	Resolutions          []*Resolution `protobuf:"bytes,11,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()    {}
 func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{41}
+	return fileDescriptor_rpc_b24756c01b185350, []int{51}
 }
 func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
@@ -2803,6 +3574,297 @@ func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
 	return ChannelCloseSummary_COOPERATIVE_CLOSE
 }
 
+func (m *ChannelCloseSummary) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+type Resolution struct {
+	// / The type of output that was resolved.
+	ResolutionType ResolutionType `protobuf:"varint,1,opt,name=resolution_type,proto3,enum=lnrpc.ResolutionType" json:"resolution_type,omitempty"`
+	// / The outcome of the resolution.
+	Outcome ResolutionOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=lnrpc.ResolutionOutcome" json:"outcome,omitempty"`
+	// / The outpoint that was resolved.
+	Outpoint *OutPoint `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The amount of the output in satoshis.
+	AmountSat uint64 `protobuf:"varint,4,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	// *
+	// The hex-encoded txid of the transaction that spent the output, if it was
+	// spent.
+	SweepTxid string `protobuf:"bytes,5,opt,name=sweep_txid,proto3" json:"sweep_txid,omitempty"`
+	// *
+	// The height the resolver had to wait for before it could act on the
+	// output, such as the expiry of an HTLC or the maturity of a csv-locked
+	// output. Zero if there was no need to wait.
+	WaitHeight           uint32   `protobuf:"varint,6,opt,name=wait_height,proto3" json:"wait_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resolution) Reset()         { *m = Resolution{} }
+func (m *Resolution) String() string { return proto.CompactTextString(m) }
+func (*Resolution) ProtoMessage()    {}
+func (*Resolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{52}
+}
+func (m *Resolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resolution.Unmarshal(m, b)
+}
+func (m *Resolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resolution.Marshal(b, m, deterministic)
+}
+func (dst *Resolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resolution.Merge(dst, src)
+}
+func (m *Resolution) XXX_Size() int {
+	return xxx_messageInfo_Resolution.Size(m)
+}
+func (m *Resolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resolution proto.InternalMessageInfo
+
+func (m *Resolution) GetResolutionType() ResolutionType {
+	if m != nil {
+		return m.ResolutionType
+	}
+	return ResolutionType_TYPE_UNKNOWN
+}
+
+func (m *Resolution) GetOutcome() ResolutionOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return ResolutionOutcome_OUTCOME_UNKNOWN
+}
+
+func (m *Resolution) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *Resolution) GetAmountSat() uint64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *Resolution) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+func (m *Resolution) GetWaitHeight() uint32 {
+	if m != nil {
+		return m.WaitHeight
+	}
+	return 0
+}
+
+type PendingResolution struct {
+	// / The type of output that is being resolved.
+	ResolutionType ResolutionType `protobuf:"varint,1,opt,name=resolution_type,proto3,enum=lnrpc.ResolutionType" json:"resolution_type,omitempty"`
+	// / The outpoint that is being resolved.
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The amount of the output in satoshis.
+	AmountSat uint64 `protobuf:"varint,3,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	// / The amount of the output that is still in limbo, in satoshis.
+	LimboBalanceSat uint64 `protobuf:"varint,4,opt,name=limbo_balance_sat,proto3" json:"limbo_balance_sat,omitempty"`
+	// *
+	// The height at which the output can be swept. Zero if the height isn't
+	// known yet.
+	MaturityHeight uint32 `protobuf:"varint,5,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	// / The stage of a two stage HTLC resolution the output is in.
+	Stage uint32 `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	// *
+	// The height before which an incoming HTLC must be claimed, before the
+	// remote party is able to time it out. Zero if there is no deadline.
+	DeadlineHeight uint32 `protobuf:"varint,7,opt,name=deadline_height,proto3" json:"deadline_height,omitempty"`
+	// / Whether the deadline of the output has passed.
+	DeadlineMissed       bool     `protobuf:"varint,8,opt,name=deadline_missed,proto3" json:"deadline_missed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingResolution) Reset()         { *m = PendingResolution{} }
+func (m *PendingResolution) String() string { return proto.CompactTextString(m) }
+func (*PendingResolution) ProtoMessage()    {}
+func (*PendingResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{53}
+}
+func (m *PendingResolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingResolution.Unmarshal(m, b)
+}
+func (m *PendingResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingResolution.Marshal(b, m, deterministic)
+}
+func (dst *PendingResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingResolution.Merge(dst, src)
+}
+func (m *PendingResolution) XXX_Size() int {
+	return xxx_messageInfo_PendingResolution.Size(m)
+}
+func (m *PendingResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingResolution proto.InternalMessageInfo
+
+func (m *PendingResolution) GetResolutionType() ResolutionType {
+	if m != nil {
+		return m.ResolutionType
+	}
+	return ResolutionType_TYPE_UNKNOWN
+}
+
+func (m *PendingResolution) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *PendingResolution) GetAmountSat() uint64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetLimboBalanceSat() uint64 {
+	if m != nil {
+		return m.LimboBalanceSat
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetStage() uint32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetDeadlineHeight() uint32 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetDeadlineMissed() bool {
+	if m != nil {
+		return m.DeadlineMissed
+	}
+	return false
+}
+
+type ForceCloseReportRequest struct {
+	// / The channel point of the force closed channel.
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ForceCloseReportRequest) Reset()         { *m = ForceCloseReportRequest{} }
+func (m *ForceCloseReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceCloseReportRequest) ProtoMessage()    {}
+func (*ForceCloseReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{54}
+}
+func (m *ForceCloseReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceCloseReportRequest.Unmarshal(m, b)
+}
+func (m *ForceCloseReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceCloseReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *ForceCloseReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceCloseReportRequest.Merge(dst, src)
+}
+func (m *ForceCloseReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceCloseReportRequest.Size(m)
+}
+func (m *ForceCloseReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceCloseReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceCloseReportRequest proto.InternalMessageInfo
+
+func (m *ForceCloseReportRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+type ForceCloseReportResponse struct {
+	// / The outputs of the channel that have been resolved.
+	Resolutions []*Resolution `protobuf:"bytes,1,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	// *
+	// The outputs of the channel that are still being resolved. Empty once the
+	// channel has been fully resolved.
+	PendingResolutions   []*PendingResolution `protobuf:"bytes,2,rep,name=pending_resolutions,proto3" json:"pending_resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ForceCloseReportResponse) Reset()         { *m = ForceCloseReportResponse{} }
+func (m *ForceCloseReportResponse) String() string { return proto.CompactTextString(m) }
+func (*ForceCloseReportResponse) ProtoMessage()    {}
+func (*ForceCloseReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{55}
+}
+func (m *ForceCloseReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceCloseReportResponse.Unmarshal(m, b)
+}
+func (m *ForceCloseReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceCloseReportResponse.Marshal(b, m, deterministic)
+}
+func (dst *ForceCloseReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceCloseReportResponse.Merge(dst, src)
+}
+func (m *ForceCloseReportResponse) XXX_Size() int {
+	return xxx_messageInfo_ForceCloseReportResponse.Size(m)
+}
+func (m *ForceCloseReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceCloseReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceCloseReportResponse proto.InternalMessageInfo
+
+func (m *ForceCloseReportResponse) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+func (m *ForceCloseReportResponse) GetPendingResolutions() []*PendingResolution {
+	if m != nil {
+		return m.PendingResolutions
+	}
+	return nil
+}
+
 type ClosedChannelsRequest struct {
 	Cooperative          bool     `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
 	LocalForce           bool     `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
@@ -2819,7 +3881,7 @@ func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()    {}
 func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{42}
+	return fileDescriptor_rpc_b24756c01b185350, []int{56}
 }
 func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
@@ -2892,7 +3954,7 @@ func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{}
 func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()    {}
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{43}
+	return fileDescriptor_rpc_b24756c01b185350, []int{57}
 }
 func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
@@ -2947,7 +4009,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{44}
+	return fileDescriptor_rpc_b24756c01b185350, []int{58}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -3040,7 +4102,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{45}
+	return fileDescriptor_rpc_b24756c01b185350, []int{59}
 }
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
@@ -3072,7 +4134,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{46}
+	return fileDescriptor_rpc_b24756c01b185350, []int{60}
 }
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
@@ -3109,7 +4171,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{47}
+	return fileDescriptor_rpc_b24756c01b185350, []int{61}
 }
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
@@ -3169,7 +4231,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{48}
+	return fileDescriptor_rpc_b24756c01b185350, []int{62}
 }
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
@@ -3302,7 +4364,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{49}
+	return fileDescriptor_rpc_b24756c01b185350, []int{63}
 }
 func (m *Chain) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chain.Unmarshal(m, b)
@@ -3349,7 +4411,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{50}
+	return fileDescriptor_rpc_b24756c01b185350, []int{64}
 }
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
@@ -3401,7 +4463,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{51}
+	return fileDescriptor_rpc_b24756c01b185350, []int{65}
 }
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
@@ -3440,7 +4502,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{52}
+	return fileDescriptor_rpc_b24756c01b185350, []int{66}
 }
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
@@ -3485,7 +4547,16 @@ type CloseChannelRequest struct {
 	// / The target number of blocks that the closure transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// *
+	// An optional address to send our settled funds to in the case of a
+	// cooperative close. If unset, a fresh address from the wallet will be used.
+	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	// *
+	// The maximum fee rate in sat/byte that we're willing to pay for the
+	// cooperative closure transaction. Fee negotiation will never settle on a fee
+	// above this rate. If unset, no limit is enforced.
+	MaxSatPerByte        int64    `protobuf:"varint,6,opt,name=max_sat_per_byte,json=maxSatPerByte,proto3" json:"max_sat_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3495,7 +4566,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{53}
+	return fileDescriptor_rpc_b24756c01b185350, []int{67}
 }
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
@@ -3543,6 +4614,20 @@ func (m *CloseChannelRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *CloseChannelRequest) GetDeliveryAddress() string {
+	if m != nil {
+		return m.DeliveryAddress
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetMaxSatPerByte() int64 {
+	if m != nil {
+		return m.MaxSatPerByte
+	}
+	return 0
+}
+
 type CloseStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*CloseStatusUpdate_ClosePending
@@ -3557,7 +4642,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{54}
+	return fileDescriptor_rpc_b24756c01b185350, []int{68}
 }
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
@@ -3700,7 +4785,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{55}
+	return fileDescriptor_rpc_b24756c01b185350, []int{69}
 }
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
@@ -3756,7 +4841,24 @@ type OpenChannelRequest struct {
 	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
 	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
 	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed     bool     `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// *
+	// The address that funds will be paid out to when the channel is
+	// cooperatively closed. This commits us to an upfront shutdown script, so
+	// the channel can only be cooperatively closed to this address.
+	CloseAddress string `protobuf:"bytes,13,opt,name=close_address,proto3" json:"close_address,omitempty"`
+	// *
+	// Whether the channel should be usable before its funding transaction
+	// confirms. The remote peer must trust us to accept a zero-conf channel.
+	// Zero-conf channels must be private, as they are identified by an alias
+	// short channel ID.
+	ZeroConf bool `protobuf:"varint,14,opt,name=zero_conf,proto3" json:"zero_conf,omitempty"`
+	// *
+	// Whether the remote peer may contribute funds of their own to the channel,
+	// in which case the funding transaction is constructed interactively. The
+	// remote peer must support dual funded channels. Dual funded channels can't
+	// be zero-conf, nor can they push funds to the remote peer.
+	DualFund             bool     `protobuf:"varint,15,opt,name=dual_fund,proto3" json:"dual_fund,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3766,7 +4868,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{56}
+	return fileDescriptor_rpc_b24756c01b185350, []int{70}
 }
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
@@ -3839,26 +4941,47 @@ func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
 	if m != nil {
 		return m.MinHtlcMsat
 	}
-	return 0
+	return 0
+}
+
+func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
 }
 
-func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+func (m *OpenChannelRequest) GetCloseAddress() string {
 	if m != nil {
-		return m.RemoteCsvDelay
+		return m.CloseAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *OpenChannelRequest) GetMinConfs() int32 {
+func (m *OpenChannelRequest) GetZeroConf() bool {
 	if m != nil {
-		return m.MinConfs
+		return m.ZeroConf
 	}
-	return 0
+	return false
 }
 
-func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+func (m *OpenChannelRequest) GetDualFund() bool {
 	if m != nil {
-		return m.SpendUnconfirmed
+		return m.DualFund
 	}
 	return false
 }
@@ -3877,7 +5000,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{57}
+	return fileDescriptor_rpc_b24756c01b185350, []int{71}
 }
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
@@ -4033,7 +5156,7 @@ func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()    {}
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{58}
+	return fileDescriptor_rpc_b24756c01b185350, []int{72}
 }
 func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
@@ -4105,7 +5228,7 @@ func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{}
 func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()    {}
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{59}
+	return fileDescriptor_rpc_b24756c01b185350, []int{73}
 }
 func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
@@ -4145,7 +5268,7 @@ func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse
 func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()    {}
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60}
+	return fileDescriptor_rpc_b24756c01b185350, []int{74}
 }
 func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
@@ -4217,7 +5340,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 0}
+	return fileDescriptor_rpc_b24756c01b185350, []int{74, 0}
 }
 func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
@@ -4304,7 +5427,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 1}
+	return fileDescriptor_rpc_b24756c01b185350, []int{74, 1}
 }
 func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
@@ -4377,7 +5500,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 2}
+	return fileDescriptor_rpc_b24756c01b185350, []int{74, 2}
 }
 func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
@@ -4425,7 +5548,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 3}
+	return fileDescriptor_rpc_b24756c01b185350, []int{74, 3}
 }
 func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
@@ -4489,7 +5612,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 4}
+	return fileDescriptor_rpc_b24756c01b185350, []int{74, 4}
 }
 func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
@@ -4568,7 +5691,7 @@ func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscripti
 func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventSubscription) ProtoMessage()    {}
 func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{61}
+	return fileDescriptor_rpc_b24756c01b185350, []int{75}
 }
 func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
@@ -4605,7 +5728,7 @@ func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
 func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventUpdate) ProtoMessage()    {}
 func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{62}
+	return fileDescriptor_rpc_b24756c01b185350, []int{76}
 }
 func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
@@ -4807,7 +5930,164 @@ func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type BreachEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BreachEventSubscription) Reset()         { *m = BreachEventSubscription{} }
+func (m *BreachEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*BreachEventSubscription) ProtoMessage()    {}
+func (*BreachEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{77}
+}
+func (m *BreachEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BreachEventSubscription.Unmarshal(m, b)
+}
+func (m *BreachEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BreachEventSubscription.Marshal(b, m, deterministic)
+}
+func (dst *BreachEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BreachEventSubscription.Merge(dst, src)
+}
+func (m *BreachEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_BreachEventSubscription.Size(m)
+}
+func (m *BreachEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_BreachEventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BreachEventSubscription proto.InternalMessageInfo
+
+type BreachEvent struct {
+	// / The type of the event.
+	Type BreachEventType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.BreachEventType" json:"type,omitempty"`
+	// / The outpoint (txid:index) of the funding transaction.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// *
+	// The txid of the transaction the event refers to. This is the revoked
+	// commitment for BREACH_DETECTED, the justice transaction for
+	// JUSTICE_TX_BROADCAST and the spending transaction for OUTPUT_SPENT.
+	Txid string `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	// / The breached output that was spent, for OUTPUT_SPENT.
+	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The number of breached outputs swept, for JUSTICE_TX_BROADCAST.
+	NumInputs uint32 `protobuf:"varint,5,opt,name=num_inputs,proto3" json:"num_inputs,omitempty"`
+	// / The fee rate of the justice transaction, for JUSTICE_TX_BROADCAST.
+	SatPerKw int64 `protobuf:"varint,6,opt,name=sat_per_kw,proto3" json:"sat_per_kw,omitempty"`
+	// / Whether the output was swept by our justice transaction, for OUTPUT_SPENT.
+	Claimed bool `protobuf:"varint,7,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// *
+	// Whether the cheating party took the HTLC output to the second level, for
+	// OUTPUT_SPENT. The second-level output will be swept instead.
+	SecondLevel bool `protobuf:"varint,8,opt,name=second_level,proto3" json:"second_level,omitempty"`
+	// / The revoked funds that were claimed, for BREACH_RESOLVED.
+	RevokedFundsSat int64 `protobuf:"varint,9,opt,name=revoked_funds_sat,proto3" json:"revoked_funds_sat,omitempty"`
+	// / The total funds that were claimed, for BREACH_RESOLVED.
+	TotalFundsSat        int64    `protobuf:"varint,10,opt,name=total_funds_sat,proto3" json:"total_funds_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BreachEvent) Reset()         { *m = BreachEvent{} }
+func (m *BreachEvent) String() string { return proto.CompactTextString(m) }
+func (*BreachEvent) ProtoMessage()    {}
+func (*BreachEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{78}
+}
+func (m *BreachEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BreachEvent.Unmarshal(m, b)
+}
+func (m *BreachEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BreachEvent.Marshal(b, m, deterministic)
+}
+func (dst *BreachEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BreachEvent.Merge(dst, src)
+}
+func (m *BreachEvent) XXX_Size() int {
+	return xxx_messageInfo_BreachEvent.Size(m)
+}
+func (m *BreachEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BreachEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BreachEvent proto.InternalMessageInfo
+
+func (m *BreachEvent) GetType() BreachEventType {
+	if m != nil {
+		return m.Type
+	}
+	return BreachEventType_BREACH_DETECTED
+}
+
+func (m *BreachEvent) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *BreachEvent) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *BreachEvent) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *BreachEvent) GetNumInputs() uint32 {
+	if m != nil {
+		return m.NumInputs
+	}
+	return 0
+}
+
+func (m *BreachEvent) GetSatPerKw() int64 {
+	if m != nil {
+		return m.SatPerKw
+	}
+	return 0
+}
+
+func (m *BreachEvent) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+func (m *BreachEvent) GetSecondLevel() bool {
+	if m != nil {
+		return m.SecondLevel
+	}
+	return false
+}
+
+func (m *BreachEvent) GetRevokedFundsSat() int64 {
+	if m != nil {
+		return m.RevokedFundsSat
+	}
+	return 0
+}
+
+func (m *BreachEvent) GetTotalFundsSat() int64 {
+	if m != nil {
+		return m.TotalFundsSat
+	}
+	return 0
+}
+
 type WalletBalanceRequest struct {
+	// / The name of the wallet account to return the balance of. If unset, all accounts.
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4817,7 +6097,7 @@ func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()    {}
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{63}
+	return fileDescriptor_rpc_b24756c01b185350, []int{79}
 }
 func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
@@ -4837,6 +6117,13 @@ func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
 
+func (m *WalletBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type WalletBalanceResponse struct {
 	// / The balance of the wallet
 	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
@@ -4853,7 +6140,7 @@ func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()    {}
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{64}
+	return fileDescriptor_rpc_b24756c01b185350, []int{80}
 }
 func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
@@ -4904,7 +6191,7 @@ func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()    {}
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{65}
+	return fileDescriptor_rpc_b24756c01b185350, []int{81}
 }
 func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
@@ -4938,7 +6225,7 @@ func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{}
 func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()    {}
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{66}
+	return fileDescriptor_rpc_b24756c01b185350, []int{82}
 }
 func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
@@ -5008,7 +6295,7 @@ func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()    {}
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{67}
+	return fileDescriptor_rpc_b24756c01b185350, []int{83}
 }
 func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
@@ -5103,7 +6390,7 @@ func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
 func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
 func (*EdgeLocator) ProtoMessage()    {}
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{68}
+	return fileDescriptor_rpc_b24756c01b185350, []int{84}
 }
 func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EdgeLocator.Unmarshal(m, b)
@@ -5148,7 +6435,7 @@ func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()    {}
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{69}
+	return fileDescriptor_rpc_b24756c01b185350, []int{85}
 }
 func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
@@ -5200,7 +6487,7 @@ func (m *Hop) Reset()         { *m = Hop{} }
 func (m *Hop) String() string { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()    {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{70}
+	return fileDescriptor_rpc_b24756c01b185350, []int{86}
 }
 func (m *Hop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Hop.Unmarshal(m, b)
@@ -5321,7 +6608,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{71}
+	return fileDescriptor_rpc_b24756c01b185350, []int{87}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -5397,7 +6684,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{72}
+	return fileDescriptor_rpc_b24756c01b185350, []int{88}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -5442,7 +6729,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{73}
+	return fileDescriptor_rpc_b24756c01b185350, []int{89}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
@@ -5503,7 +6790,7 @@ func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{74}
+	return fileDescriptor_rpc_b24756c01b185350, []int{90}
 }
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
@@ -5570,7 +6857,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{75}
+	return fileDescriptor_rpc_b24756c01b185350, []int{91}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -5620,7 +6907,7 @@ func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{76}
+	return fileDescriptor_rpc_b24756c01b185350, []int{92}
 }
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
@@ -5710,7 +6997,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{77}
+	return fileDescriptor_rpc_b24756c01b185350, []int{93}
 }
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
@@ -5801,7 +7088,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{78}
+	return fileDescriptor_rpc_b24756c01b185350, []int{94}
 }
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
@@ -5843,7 +7130,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{79}
+	return fileDescriptor_rpc_b24756c01b185350, []int{95}
 }
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
@@ -5892,7 +7179,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{80}
+	return fileDescriptor_rpc_b24756c01b185350, []int{96}
 }
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
@@ -5929,7 +7216,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{81}
+	return fileDescriptor_rpc_b24756c01b185350, []int{97}
 }
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
@@ -5969,7 +7256,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{82}
+	return fileDescriptor_rpc_b24756c01b185350, []int{98}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
@@ -6069,7 +7356,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{83}
+	return fileDescriptor_rpc_b24756c01b185350, []int{99}
 }
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
@@ -6099,7 +7386,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{84}
+	return fileDescriptor_rpc_b24756c01b185350, []int{100}
 }
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
@@ -6119,6 +7406,78 @@ func (m *StopResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StopResponse proto.InternalMessageInfo
 
+type RotateMacaroonRootKeyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateMacaroonRootKeyRequest) Reset()         { *m = RotateMacaroonRootKeyRequest{} }
+func (m *RotateMacaroonRootKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateMacaroonRootKeyRequest) ProtoMessage()    {}
+func (*RotateMacaroonRootKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{101}
+}
+func (m *RotateMacaroonRootKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateMacaroonRootKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateMacaroonRootKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateMacaroonRootKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RotateMacaroonRootKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateMacaroonRootKeyRequest.Merge(dst, src)
+}
+func (m *RotateMacaroonRootKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateMacaroonRootKeyRequest.Size(m)
+}
+func (m *RotateMacaroonRootKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateMacaroonRootKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateMacaroonRootKeyRequest proto.InternalMessageInfo
+
+type RotateMacaroonRootKeyResponse struct {
+	// *
+	// The binary serialized admin macaroon, baked with the new root key. As all
+	// prior macaroons are invalidated, this macaroon MUST be stored by the caller
+	// of the RPC as otherwise all access to the daemon will be lost!
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateMacaroonRootKeyResponse) Reset()         { *m = RotateMacaroonRootKeyResponse{} }
+func (m *RotateMacaroonRootKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateMacaroonRootKeyResponse) ProtoMessage()    {}
+func (*RotateMacaroonRootKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{102}
+}
+func (m *RotateMacaroonRootKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateMacaroonRootKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateMacaroonRootKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateMacaroonRootKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RotateMacaroonRootKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateMacaroonRootKeyResponse.Merge(dst, src)
+}
+func (m *RotateMacaroonRootKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateMacaroonRootKeyResponse.Size(m)
+}
+func (m *RotateMacaroonRootKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateMacaroonRootKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateMacaroonRootKeyResponse proto.InternalMessageInfo
+
+func (m *RotateMacaroonRootKeyResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type GraphTopologySubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -6129,7 +7488,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{85}
+	return fileDescriptor_rpc_b24756c01b185350, []int{103}
 }
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
@@ -6162,7 +7521,7 @@ func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{86}
+	return fileDescriptor_rpc_b24756c01b185350, []int{104}
 }
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
@@ -6217,7 +7576,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{87}
+	return fileDescriptor_rpc_b24756c01b185350, []int{105}
 }
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
@@ -6285,7 +7644,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{88}
+	return fileDescriptor_rpc_b24756c01b185350, []int{106}
 }
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
@@ -6365,7 +7724,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{89}
+	return fileDescriptor_rpc_b24756c01b185350, []int{107}
 }
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
@@ -6435,7 +7794,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{90}
+	return fileDescriptor_rpc_b24756c01b185350, []int{108}
 }
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HopHint.Unmarshal(m, b)
@@ -6504,7 +7863,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{91}
+	return fileDescriptor_rpc_b24756c01b185350, []int{109}
 }
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
@@ -6619,7 +7978,7 @@ func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{92}
+	return fileDescriptor_rpc_b24756c01b185350, []int{110}
 }
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Invoice.Unmarshal(m, b)
@@ -6811,7 +8170,7 @@ func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()    {}
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{93}
+	return fileDescriptor_rpc_b24756c01b185350, []int{111}
 }
 func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
@@ -6868,7 +8227,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{94}
+	return fileDescriptor_rpc_b24756c01b185350, []int{112}
 }
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
@@ -6924,7 +8283,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{95}
+	return fileDescriptor_rpc_b24756c01b185350, []int{113}
 }
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
@@ -6994,7 +8353,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{96}
+	return fileDescriptor_rpc_b24756c01b185350, []int{114}
 }
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
@@ -7057,7 +8416,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{97}
+	return fileDescriptor_rpc_b24756c01b185350, []int{115}
 }
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
@@ -7117,7 +8476,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{98}
+	return fileDescriptor_rpc_b24756c01b185350, []int{116}
 }
 func (m *Payment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payment.Unmarshal(m, b)
@@ -7204,7 +8563,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{99}
+	return fileDescriptor_rpc_b24756c01b185350, []int{117}
 }
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
@@ -7236,7 +8595,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{100}
+	return fileDescriptor_rpc_b24756c01b185350, []int{118}
 }
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
@@ -7273,7 +8632,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{101}
+	return fileDescriptor_rpc_b24756c01b185350, []int{119}
 }
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
@@ -7303,7 +8662,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{102}
+	return fileDescriptor_rpc_b24756c01b185350, []int{120}
 }
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
@@ -7334,7 +8693,7 @@ func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{103}
+	return fileDescriptor_rpc_b24756c01b185350, []int{121}
 }
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
@@ -7371,7 +8730,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{104}
+	return fileDescriptor_rpc_b24756c01b185350, []int{122}
 }
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
@@ -7389,8 +8748,236 @@ func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
-
+var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
+
+type SpliceInRequest struct {
+	// / The outpoint (txid:index) of the funding transaction of the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The amount in satoshis to add to the channel from the wallet.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The target number of blocks that the splice transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the splice transaction.
+	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpliceInRequest) Reset()         { *m = SpliceInRequest{} }
+func (m *SpliceInRequest) String() string { return proto.CompactTextString(m) }
+func (*SpliceInRequest) ProtoMessage()    {}
+func (*SpliceInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{123}
+}
+func (m *SpliceInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpliceInRequest.Unmarshal(m, b)
+}
+func (m *SpliceInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpliceInRequest.Marshal(b, m, deterministic)
+}
+func (dst *SpliceInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpliceInRequest.Merge(dst, src)
+}
+func (m *SpliceInRequest) XXX_Size() int {
+	return xxx_messageInfo_SpliceInRequest.Size(m)
+}
+func (m *SpliceInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpliceInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpliceInRequest proto.InternalMessageInfo
+
+func (m *SpliceInRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *SpliceInRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpliceInRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *SpliceInRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type SpliceOutRequest struct {
+	// / The outpoint (txid:index) of the funding transaction of the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The amount in satoshis to move out of the channel.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The address to send the funds moved out of the channel to.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// / The target number of blocks that the splice transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,4,opt,name=target_conf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the splice transaction.
+	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpliceOutRequest) Reset()         { *m = SpliceOutRequest{} }
+func (m *SpliceOutRequest) String() string { return proto.CompactTextString(m) }
+func (*SpliceOutRequest) ProtoMessage()    {}
+func (*SpliceOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{124}
+}
+func (m *SpliceOutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpliceOutRequest.Unmarshal(m, b)
+}
+func (m *SpliceOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpliceOutRequest.Marshal(b, m, deterministic)
+}
+func (dst *SpliceOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpliceOutRequest.Merge(dst, src)
+}
+func (m *SpliceOutRequest) XXX_Size() int {
+	return xxx_messageInfo_SpliceOutRequest.Size(m)
+}
+func (m *SpliceOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpliceOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpliceOutRequest proto.InternalMessageInfo
+
+func (m *SpliceOutRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *SpliceOutRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpliceOutRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SpliceOutRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *SpliceOutRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type SpliceResponse struct {
+	// / The txid of the splice transaction.
+	SpliceTxid           string   `protobuf:"bytes,1,opt,name=splice_txid,proto3" json:"splice_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpliceResponse) Reset()         { *m = SpliceResponse{} }
+func (m *SpliceResponse) String() string { return proto.CompactTextString(m) }
+func (*SpliceResponse) ProtoMessage()    {}
+func (*SpliceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{125}
+}
+func (m *SpliceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpliceResponse.Unmarshal(m, b)
+}
+func (m *SpliceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpliceResponse.Marshal(b, m, deterministic)
+}
+func (dst *SpliceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpliceResponse.Merge(dst, src)
+}
+func (m *SpliceResponse) XXX_Size() int {
+	return xxx_messageInfo_SpliceResponse.Size(m)
+}
+func (m *SpliceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpliceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpliceResponse proto.InternalMessageInfo
+
+func (m *SpliceResponse) GetSpliceTxid() string {
+	if m != nil {
+		return m.SpliceTxid
+	}
+	return ""
+}
+
+type AbandonSpliceRequest struct {
+	// / The outpoint (txid:index) of the funding transaction of the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The txid of the splice transaction of the pending splice to abandon.
+	SpliceTxid           string   `protobuf:"bytes,2,opt,name=splice_txid,proto3" json:"splice_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbandonSpliceRequest) Reset()         { *m = AbandonSpliceRequest{} }
+func (m *AbandonSpliceRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonSpliceRequest) ProtoMessage()    {}
+func (*AbandonSpliceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_b24756c01b185350, []int{126}
+}
+func (m *AbandonSpliceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonSpliceRequest.Unmarshal(m, b)
+}
+func (m *AbandonSpliceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonSpliceRequest.Marshal(b, m, deterministic)
+}
+func (dst *AbandonSpliceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonSpliceRequest.Merge(dst, src)
+}
+func (m *AbandonSpliceRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonSpliceRequest.Size(m)
+}
+func (m *AbandonSpliceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonSpliceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonSpliceRequest proto.InternalMessageInfo
+
+func (m *AbandonSpliceRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *AbandonSpliceRequest) GetSpliceTxid() string {
+	if m != nil {
+		return m.SpliceTxid
+	}
+	return ""
+}
+
 type DebugLevelRequest struct {
 	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
 	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
@@ -7403,7 +8990,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{105}
+	return fileDescriptor_rpc_b24756c01b185350, []int{127}
 }
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
@@ -7448,7 +9035,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{106}
+	return fileDescriptor_rpc_b24756c01b185350, []int{128}
 }
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
@@ -7487,7 +9074,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{107}
+	return fileDescriptor_rpc_b24756c01b185350, []int{129}
 }
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
@@ -7534,7 +9121,7 @@ func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{108}
+	return fileDescriptor_rpc_b24756c01b185350, []int{130}
 }
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReq.Unmarshal(m, b)
@@ -7634,7 +9221,7 @@ func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()    {}
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{109}
+	return fileDescriptor_rpc_b24756c01b185350, []int{131}
 }
 func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
@@ -7672,7 +9259,7 @@ func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()    {}
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{110}
+	return fileDescriptor_rpc_b24756c01b185350, []int{132}
 }
 func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
@@ -7738,7 +9325,7 @@ func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()    {}
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{111}
+	return fileDescriptor_rpc_b24756c01b185350, []int{133}
 }
 func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
@@ -7806,7 +9393,7 @@ func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()    {}
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{112}
+	return fileDescriptor_rpc_b24756c01b185350, []int{134}
 }
 func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
@@ -7967,7 +9554,7 @@ func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()    {}
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{113}
+	return fileDescriptor_rpc_b24756c01b185350, []int{135}
 }
 func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
@@ -8005,7 +9592,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{114}
+	return fileDescriptor_rpc_b24756c01b185350, []int{136}
 }
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
@@ -8077,7 +9664,7 @@ func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()    {}
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{115}
+	return fileDescriptor_rpc_b24756c01b185350, []int{137}
 }
 func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
@@ -8160,7 +9747,7 @@ func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResp
 func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()    {}
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{116}
+	return fileDescriptor_rpc_b24756c01b185350, []int{138}
 }
 func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
@@ -8206,7 +9793,7 @@ func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupR
 func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportChannelBackupRequest) ProtoMessage()    {}
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{117}
+	return fileDescriptor_rpc_b24756c01b185350, []int{139}
 }
 func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportChannelBackupRequest.Unmarshal(m, b)
@@ -8251,7 +9838,7 @@ func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
 func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackup) ProtoMessage()    {}
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{118}
+	return fileDescriptor_rpc_b24756c01b185350, []int{140}
 }
 func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackup.Unmarshal(m, b)
@@ -8303,7 +9890,7 @@ func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
 func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
 func (*MultiChanBackup) ProtoMessage()    {}
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{119}
+	return fileDescriptor_rpc_b24756c01b185350, []int{141}
 }
 func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiChanBackup.Unmarshal(m, b)
@@ -8347,7 +9934,7 @@ func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest
 func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupExportRequest) ProtoMessage()    {}
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{120}
+	return fileDescriptor_rpc_b24756c01b185350, []int{142}
 }
 func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanBackupExportRequest.Unmarshal(m, b)
@@ -8385,7 +9972,7 @@ func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
 func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupSnapshot) ProtoMessage()    {}
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{121}
+	return fileDescriptor_rpc_b24756c01b185350, []int{143}
 }
 func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanBackupSnapshot.Unmarshal(m, b)
@@ -8432,7 +10019,7 @@ func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
 func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackups) ProtoMessage()    {}
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{122}
+	return fileDescriptor_rpc_b24756c01b185350, []int{144}
 }
 func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackups.Unmarshal(m, b)
@@ -8473,7 +10060,7 @@ func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupReque
 func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreChanBackupRequest) ProtoMessage()    {}
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{123}
+	return fileDescriptor_rpc_b24756c01b185350, []int{145}
 }
 func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreChanBackupRequest.Unmarshal(m, b)
@@ -8610,7 +10197,7 @@ func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
 func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupResponse) ProtoMessage()    {}
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{124}
+	return fileDescriptor_rpc_b24756c01b185350, []int{146}
 }
 func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
@@ -8640,7 +10227,7 @@ func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscrip
 func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackupSubscription) ProtoMessage()    {}
 func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{125}
+	return fileDescriptor_rpc_b24756c01b185350, []int{147}
 }
 func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
@@ -8670,7 +10257,7 @@ func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupRespon
 func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyChanBackupResponse) ProtoMessage()    {}
 func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{126}
+	return fileDescriptor_rpc_b24756c01b185350, []int{148}
 }
 func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyChanBackupResponse.Unmarshal(m, b)
@@ -8701,11 +10288,17 @@ func init() {
 	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
 	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
 	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
+	proto.RegisterType((*AccountAmount)(nil), "lnrpc.AccountAmount")
 	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
 	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
 	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
 	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
 	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
+	proto.RegisterType((*RebalanceRequest)(nil), "lnrpc.RebalanceRequest")
+	proto.RegisterType((*RebalanceResponse)(nil), "lnrpc.RebalanceResponse")
+	proto.RegisterType((*Rebalance)(nil), "lnrpc.Rebalance")
+	proto.RegisterType((*ListRebalancesRequest)(nil), "lnrpc.ListRebalancesRequest")
+	proto.RegisterType((*ListRebalancesResponse)(nil), "lnrpc.ListRebalancesResponse")
 	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
 	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
 	proto.RegisterType((*OutPoint)(nil), "lnrpc.OutPoint")
@@ -8730,11 +10323,19 @@ func init() {
 	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
 	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
 	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
+	proto.RegisterType((*PeerAccessRuleRequest)(nil), "lnrpc.PeerAccessRuleRequest")
+	proto.RegisterType((*PeerAccessRuleResponse)(nil), "lnrpc.PeerAccessRuleResponse")
+	proto.RegisterType((*ListPeerAccessRulesRequest)(nil), "lnrpc.ListPeerAccessRulesRequest")
+	proto.RegisterType((*ListPeerAccessRulesResponse)(nil), "lnrpc.ListPeerAccessRulesResponse")
 	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
 	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
 	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
 	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
 	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*Resolution)(nil), "lnrpc.Resolution")
+	proto.RegisterType((*PendingResolution)(nil), "lnrpc.PendingResolution")
+	proto.RegisterType((*ForceCloseReportRequest)(nil), "lnrpc.ForceCloseReportRequest")
+	proto.RegisterType((*ForceCloseReportResponse)(nil), "lnrpc.ForceCloseReportResponse")
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
@@ -8761,6 +10362,8 @@ func init() {
 	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
 	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
 	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
+	proto.RegisterType((*BreachEventSubscription)(nil), "lnrpc.BreachEventSubscription")
+	proto.RegisterType((*BreachEvent)(nil), "lnrpc.BreachEvent")
 	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
 	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
@@ -8783,6 +10386,8 @@ func init() {
 	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
 	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
 	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
+	proto.RegisterType((*RotateMacaroonRootKeyRequest)(nil), "lnrpc.RotateMacaroonRootKeyRequest")
+	proto.RegisterType((*RotateMacaroonRootKeyResponse)(nil), "lnrpc.RotateMacaroonRootKeyResponse")
 	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
 	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
 	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
@@ -8803,6 +10408,10 @@ func init() {
 	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
 	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
 	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
+	proto.RegisterType((*SpliceInRequest)(nil), "lnrpc.SpliceInRequest")
+	proto.RegisterType((*SpliceOutRequest)(nil), "lnrpc.SpliceOutRequest")
+	proto.RegisterType((*SpliceResponse)(nil), "lnrpc.SpliceResponse")
+	proto.RegisterType((*AbandonSpliceRequest)(nil), "lnrpc.AbandonSpliceRequest")
 	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
 	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
 	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
@@ -8826,6 +10435,10 @@ func init() {
 	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
 	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterEnum("lnrpc.PeerAccessList", PeerAccessList_name, PeerAccessList_value)
+	proto.RegisterEnum("lnrpc.ResolutionType", ResolutionType_name, ResolutionType_value)
+	proto.RegisterEnum("lnrpc.ResolutionOutcome", ResolutionOutcome_name, ResolutionOutcome_value)
+	proto.RegisterEnum("lnrpc.BreachEventType", BreachEventType_name, BreachEventType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
 	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
 	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
@@ -9150,10 +10763,22 @@ type LightningClient interface {
 	// sent over. Events include new active channels, inactive channels, and closed
 	// channels.
 	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
+	// *
+	// SubscribeBreachEvents creates a uni-directional stream from the server to
+	// the client in which updates on the retribution of breached channels are
+	// sent over. Events include detected breaches, broadcast justice
+	// transactions, spends of breached outputs and fully resolved breaches.
+	SubscribeBreachEvents(ctx context.Context, in *BreachEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeBreachEventsClient, error)
 	// * lncli: `closedchannels`
 	// ClosedChannels returns a description of all the closed channels that
 	// this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// * lncli: `forceclosereport`
+	// GetForceCloseReport returns a detailed report on the resolution of the
+	// outputs of a force closed channel. It lists the outputs that have already
+	// been resolved, as well as the outputs that are still waiting to be
+	// resolved. Reports are kept after the channel has been fully closed.
+	GetForceCloseReport(ctx context.Context, in *ForceCloseReportRequest, opts ...grpc.CallOption) (*ForceCloseReportResponse, error)
 	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
@@ -9182,6 +10807,25 @@ type LightningClient interface {
 	// channels due to bugs fixed in newer versions of lnd. Only available
 	// when in debug builds of lnd.
 	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// * lncli: `splicein`
+	// SpliceIn adds funds from the wallet to an existing private channel, without
+	// closing it. The funds are added to our balance once the splice transaction
+	// confirms, while the channel keeps operating in the meantime. The channel
+	// keeps its channel ID and short channel ID. A pending splice can be
+	// replaced by splicing the channel again at a higher fee rate.
+	SpliceIn(ctx context.Context, in *SpliceInRequest, opts ...grpc.CallOption) (*SpliceResponse, error)
+	// * lncli: `spliceout`
+	// SpliceOut moves funds from our balance of an existing private channel to
+	// an on-chain address, without closing the channel. The fee of the splice
+	// transaction is paid from our channel balance. The channel keeps operating
+	// while the splice transaction confirms.
+	SpliceOut(ctx context.Context, in *SpliceOutRequest, opts ...grpc.CallOption) (*SpliceResponse, error)
+	// * lncli: `abandonsplice`
+	// AbandonSplice discards a pending splice of an existing private channel
+	// whose splice transaction can no longer confirm, as one of its inputs has
+	// been double spent. The remote party must agree that the splice
+	// transaction is invalid.
+	AbandonSplice(ctx context.Context, in *AbandonSpliceRequest, opts ...grpc.CallOption) (*SpliceResponse, error)
 	// * lncli: `sendpayment`
 	// SendPayment dispatches a bi-directional streaming RPC for sending payments
 	// through the Lightning Network. A single RPC invocation creates a persistent
@@ -9204,6 +10848,17 @@ type LightningClient interface {
 	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
 	// until the payment either fails or succeeds.
 	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `rebalance`
+	// Rebalance moves funds between two of our channels by paying an internal
+	// invoice through a circular route. The route leaves through the outgoing
+	// channel and arrives back from the peer of the incoming channel, or from
+	// the specified last hop. Failed attempts are retried along other routes
+	// within the fee budget, and the outcome is recorded as a rebalance rather
+	// than as a regular payment.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	// * lncli: `listrebalances`
+	// ListRebalances returns the outcome of all rebalances that were attempted.
+	ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error)
 	// * lncli: `addinvoice`
 	// AddInvoice attempts to add a new invoice to the invoice database. Any
 	// duplicated invoices are rejected, therefore all invoices *must* have a
@@ -9347,6 +11002,23 @@ type LightningClient interface {
 	// ups, but the updated set of encrypted multi-chan backups with the closed
 	// channel(s) removed.
 	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	// * lncli: `rotatemacaroonkey`
+	// RotateMacaroonRootKey deletes all macaroon files from the file system of the
+	// daemon, and replaces the root key of all macaroons with a new one. This
+	// invalidates every macaroon that was created so far. A new admin macaroon is
+	// only returned in the response, and isn't written to disk.
+	RotateMacaroonRootKey(ctx context.Context, in *RotateMacaroonRootKeyRequest, opts ...grpc.CallOption) (*RotateMacaroonRootKeyResponse, error)
+	// * lncli: `peeraccess add`
+	// AddPeerAccessRule adds a rule to the allow or deny list of peers. Rules added
+	// over RPC only apply to new inbound connections, and aren't persisted across
+	// restarts.
+	AddPeerAccessRule(ctx context.Context, in *PeerAccessRuleRequest, opts ...grpc.CallOption) (*PeerAccessRuleResponse, error)
+	// * lncli: `peeraccess remove`
+	// RemovePeerAccessRule removes a rule from the allow or deny list of peers.
+	RemovePeerAccessRule(ctx context.Context, in *PeerAccessRuleRequest, opts ...grpc.CallOption) (*PeerAccessRuleResponse, error)
+	// * lncli: `peeraccess list`
+	// ListPeerAccessRules returns the rules of the allow and deny lists of peers.
+	ListPeerAccessRules(ctx context.Context, in *ListPeerAccessRulesRequest, opts ...grpc.CallOption) (*ListPeerAccessRulesResponse, error)
 }
 
 type lightningClient struct {
@@ -9565,6 +11237,38 @@ func (x *lightningSubscribeChannelEventsClient) Recv() (*ChannelEventUpdate, err
 	return m, nil
 }
 
+func (c *lightningClient) SubscribeBreachEvents(ctx context.Context, in *BreachEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeBreachEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeBreachEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeBreachEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeBreachEventsClient interface {
+	Recv() (*BreachEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeBreachEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeBreachEventsClient) Recv() (*BreachEvent, error) {
+	m := new(BreachEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
 	out := new(ClosedChannelsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, opts...)
@@ -9574,6 +11278,15 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) GetForceCloseReport(ctx context.Context, in *ForceCloseReportRequest, opts ...grpc.CallOption) (*ForceCloseReportResponse, error) {
+	out := new(ForceCloseReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetForceCloseReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
@@ -9584,7 +11297,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9616,7 +11329,7 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 }
 
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9656,8 +11369,35 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 	return out, nil
 }
 
+func (c *lightningClient) SpliceIn(ctx context.Context, in *SpliceInRequest, opts ...grpc.CallOption) (*SpliceResponse, error) {
+	out := new(SpliceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SpliceIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SpliceOut(ctx context.Context, in *SpliceOutRequest, opts ...grpc.CallOption) (*SpliceResponse, error) {
+	out := new(SpliceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SpliceOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AbandonSplice(ctx context.Context, in *AbandonSpliceRequest, opts ...grpc.CallOption) (*SpliceResponse, error) {
+	out := new(SpliceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AbandonSplice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9697,7 +11437,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9736,6 +11476,24 @@ func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRe
 	return out, nil
 }
 
+func (c *lightningClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error) {
+	out := new(ListRebalancesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListRebalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
 	out := new(AddInvoiceResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, opts...)
@@ -9764,7 +11522,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9877,7 +11635,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9981,7 +11739,7 @@ func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *Restore
 }
 
 func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10012,6 +11770,42 @@ func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, er
 	return m, nil
 }
 
+func (c *lightningClient) RotateMacaroonRootKey(ctx context.Context, in *RotateMacaroonRootKeyRequest, opts ...grpc.CallOption) (*RotateMacaroonRootKeyResponse, error) {
+	out := new(RotateMacaroonRootKeyResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RotateMacaroonRootKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AddPeerAccessRule(ctx context.Context, in *PeerAccessRuleRequest, opts ...grpc.CallOption) (*PeerAccessRuleResponse, error) {
+	out := new(PeerAccessRuleResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddPeerAccessRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RemovePeerAccessRule(ctx context.Context, in *PeerAccessRuleRequest, opts ...grpc.CallOption) (*PeerAccessRuleResponse, error) {
+	out := new(PeerAccessRuleResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RemovePeerAccessRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPeerAccessRules(ctx context.Context, in *ListPeerAccessRulesRequest, opts ...grpc.CallOption) (*ListPeerAccessRulesResponse, error) {
+	out := new(ListPeerAccessRulesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPeerAccessRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	// * lncli: `walletbalance`
@@ -10101,10 +11895,22 @@ type LightningServer interface {
 	// sent over. Events include new active channels, inactive channels, and closed
 	// channels.
 	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
+	// *
+	// SubscribeBreachEvents creates a uni-directional stream from the server to
+	// the client in which updates on the retribution of breached channels are
+	// sent over. Events include detected breaches, broadcast justice
+	// transactions, spends of breached outputs and fully resolved breaches.
+	SubscribeBreachEvents(*BreachEventSubscription, Lightning_SubscribeBreachEventsServer) error
 	// * lncli: `closedchannels`
 	// ClosedChannels returns a description of all the closed channels that
 	// this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// * lncli: `forceclosereport`
+	// GetForceCloseReport returns a detailed report on the resolution of the
+	// outputs of a force closed channel. It lists the outputs that have already
+	// been resolved, as well as the outputs that are still waiting to be
+	// resolved. Reports are kept after the channel has been fully closed.
+	GetForceCloseReport(context.Context, *ForceCloseReportRequest) (*ForceCloseReportResponse, error)
 	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
@@ -10133,6 +11939,25 @@ type LightningServer interface {
 	// channels due to bugs fixed in newer versions of lnd. Only available
 	// when in debug builds of lnd.
 	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// * lncli: `splicein`
+	// SpliceIn adds funds from the wallet to an existing private channel, without
+	// closing it. The funds are added to our balance once the splice transaction
+	// confirms, while the channel keeps operating in the meantime. The channel
+	// keeps its channel ID and short channel ID. A pending splice can be
+	// replaced by splicing the channel again at a higher fee rate.
+	SpliceIn(context.Context, *SpliceInRequest) (*SpliceResponse, error)
+	// * lncli: `spliceout`
+	// SpliceOut moves funds from our balance of an existing private channel to
+	// an on-chain address, without closing the channel. The fee of the splice
+	// transaction is paid from our channel balance. The channel keeps operating
+	// while the splice transaction confirms.
+	SpliceOut(context.Context, *SpliceOutRequest) (*SpliceResponse, error)
+	// * lncli: `abandonsplice`
+	// AbandonSplice discards a pending splice of an existing private channel
+	// whose splice transaction can no longer confirm, as one of its inputs has
+	// been double spent. The remote party must agree that the splice
+	// transaction is invalid.
+	AbandonSplice(context.Context, *AbandonSpliceRequest) (*SpliceResponse, error)
 	// * lncli: `sendpayment`
 	// SendPayment dispatches a bi-directional streaming RPC for sending payments
 	// through the Lightning Network. A single RPC invocation creates a persistent
@@ -10155,6 +11980,17 @@ type LightningServer interface {
 	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
 	// until the payment either fails or succeeds.
 	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
+	// * lncli: `rebalance`
+	// Rebalance moves funds between two of our channels by paying an internal
+	// invoice through a circular route. The route leaves through the outgoing
+	// channel and arrives back from the peer of the incoming channel, or from
+	// the specified last hop. Failed attempts are retried along other routes
+	// within the fee budget, and the outcome is recorded as a rebalance rather
+	// than as a regular payment.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	// * lncli: `listrebalances`
+	// ListRebalances returns the outcome of all rebalances that were attempted.
+	ListRebalances(context.Context, *ListRebalancesRequest) (*ListRebalancesResponse, error)
 	// * lncli: `addinvoice`
 	// AddInvoice attempts to add a new invoice to the invoice database. Any
 	// duplicated invoices are rejected, therefore all invoices *must* have a
@@ -10298,6 +12134,23 @@ type LightningServer interface {
 	// ups, but the updated set of encrypted multi-chan backups with the closed
 	// channel(s) removed.
 	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	// * lncli: `rotatemacaroonkey`
+	// RotateMacaroonRootKey deletes all macaroon files from the file system of the
+	// daemon, and replaces the root key of all macaroons with a new one. This
+	// invalidates every macaroon that was created so far. A new admin macaroon is
+	// only returned in the response, and isn't written to disk.
+	RotateMacaroonRootKey(context.Context, *RotateMacaroonRootKeyRequest) (*RotateMacaroonRootKeyResponse, error)
+	// * lncli: `peeraccess add`
+	// AddPeerAccessRule adds a rule to the allow or deny list of peers. Rules added
+	// over RPC only apply to new inbound connections, and aren't persisted across
+	// restarts.
+	AddPeerAccessRule(context.Context, *PeerAccessRuleRequest) (*PeerAccessRuleResponse, error)
+	// * lncli: `peeraccess remove`
+	// RemovePeerAccessRule removes a rule from the allow or deny list of peers.
+	RemovePeerAccessRule(context.Context, *PeerAccessRuleRequest) (*PeerAccessRuleResponse, error)
+	// * lncli: `peeraccess list`
+	// ListPeerAccessRules returns the rules of the allow and deny lists of peers.
+	ListPeerAccessRules(context.Context, *ListPeerAccessRulesRequest) (*ListPeerAccessRulesResponse, error)
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -10634,6 +12487,27 @@ func (x *lightningSubscribeChannelEventsServer) Send(m *ChannelEventUpdate) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_SubscribeBreachEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BreachEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeBreachEvents(m, &lightningSubscribeBreachEventsServer{stream})
+}
+
+type Lightning_SubscribeBreachEventsServer interface {
+	Send(*BreachEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeBreachEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeBreachEventsServer) Send(m *BreachEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClosedChannelsRequest)
 	if err := dec(in); err != nil {
@@ -10652,6 +12526,24 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetForceCloseReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCloseReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetForceCloseReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetForceCloseReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetForceCloseReport(ctx, req.(*ForceCloseReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -10730,6 +12622,60 @@ func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SpliceIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceIn(ctx, req.(*SpliceInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SpliceOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceOut(ctx, req.(*SpliceOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AbandonSplice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonSpliceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AbandonSplice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AbandonSplice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AbandonSplice(ctx, req.(*AbandonSpliceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
 }
@@ -10818,6 +12764,42 @@ func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListRebalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRebalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListRebalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListRebalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListRebalances(ctx, req.(*ListRebalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Invoice)
 	if err := dec(in); err != nil {
@@ -11241,6 +13223,78 @@ func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_RotateMacaroonRootKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateMacaroonRootKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).RotateMacaroonRootKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/RotateMacaroonRootKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).RotateMacaroonRootKey(ctx, req.(*RotateMacaroonRootKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AddPeerAccessRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerAccessRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AddPeerAccessRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AddPeerAccessRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AddPeerAccessRule(ctx, req.(*PeerAccessRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_RemovePeerAccessRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerAccessRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).RemovePeerAccessRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/RemovePeerAccessRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).RemovePeerAccessRule(ctx, req.(*PeerAccessRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPeerAccessRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeerAccessRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPeerAccessRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPeerAccessRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPeerAccessRules(ctx, req.(*ListPeerAccessRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -11313,6 +13367,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClosedChannels",
 			Handler:    _Lightning_ClosedChannels_Handler,
 		},
+		{
+			MethodName: "GetForceCloseReport",
+			Handler:    _Lightning_GetForceCloseReport_Handler,
+		},
 		{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
@@ -11321,6 +13379,18 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
 		},
+		{
+			MethodName: "SpliceIn",
+			Handler:    _Lightning_SpliceIn_Handler,
+		},
+		{
+			MethodName: "SpliceOut",
+			Handler:    _Lightning_SpliceOut_Handler,
+		},
+		{
+			MethodName: "AbandonSplice",
+			Handler:    _Lightning_AbandonSplice_Handler,
+		},
 		{
 			MethodName: "SendPaymentSync",
 			Handler:    _Lightning_SendPaymentSync_Handler,
@@ -11329,6 +13399,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendToRouteSync",
 			Handler:    _Lightning_SendToRouteSync_Handler,
 		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Lightning_Rebalance_Handler,
+		},
+		{
+			MethodName: "ListRebalances",
+			Handler:    _Lightning_ListRebalances_Handler,
+		},
 		{
 			MethodName: "AddInvoice",
 			Handler:    _Lightning_AddInvoice_Handler,
@@ -11409,6 +13487,22 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreChannelBackups",
 			Handler:    _Lightning_RestoreChannelBackups_Handler,
 		},
+		{
+			MethodName: "RotateMacaroonRootKey",
+			Handler:    _Lightning_RotateMacaroonRootKey_Handler,
+		},
+		{
+			MethodName: "AddPeerAccessRule",
+			Handler:    _Lightning_AddPeerAccessRule_Handler,
+		},
+		{
+			MethodName: "RemovePeerAccessRule",
+			Handler:    _Lightning_RemovePeerAccessRule_Handler,
+		},
+		{
+			MethodName: "ListPeerAccessRules",
+			Handler:    _Lightning_ListPeerAccessRules_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -11421,6 +13515,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeBreachEvents",
+			Handler:       _Lightning_SubscribeBreachEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "OpenChannel",
 			Handler:       _Lightning_OpenChannel_Handler,
@@ -11462,492 +13561,571 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_85b0b58f0d9865bd) }
-
-var fileDescriptor_rpc_85b0b58f0d9865bd = []byte{
-	// 7735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x5b, 0x6c, 0x24, 0xd9,
-	0x59, 0xb0, 0xab, 0x2f, 0x76, 0xf7, 0xd7, 0x6d, 0xbb, 0x7d, 0x7c, 0xeb, 0xe9, 0x99, 0x9d, 0xf5,
-	0x56, 0x26, 0x33, 0x8e, 0x77, 0xff, 0xf1, 0xec, 0x24, 0xd9, 0x4c, 0x76, 0xfe, 0xfc, 0xf9, 0x7d,
-	0x9b, 0xf1, 0x64, 0xbd, 0x1e, 0xa7, 0x3c, 0x93, 0xf9, 0x77, 0x93, 0x5f, 0x9d, 0x72, 0xf7, 0x71,
-	0xbb, 0x76, 0xba, 0xab, 0x7a, 0xab, 0xaa, 0xed, 0x71, 0x96, 0x41, 0x08, 0x21, 0x40, 0x08, 0x84,
-	0x02, 0x02, 0x11, 0x04, 0x42, 0x4a, 0x90, 0x20, 0xe2, 0x89, 0x87, 0x20, 0x24, 0x08, 0xaf, 0x48,
-	0x91, 0x10, 0x42, 0x79, 0x44, 0x02, 0x21, 0x78, 0x41, 0x3c, 0x20, 0x90, 0x78, 0x44, 0x42, 0xe7,
-	0x3b, 0x97, 0x3a, 0xa7, 0xaa, 0x7a, 0x3c, 0x9b, 0x04, 0x9e, 0xba, 0xcf, 0x77, 0xbe, 0x3a, 0xd7,
-	0xef, 0x7e, 0xbe, 0x73, 0xa0, 0x1a, 0x0e, 0x3b, 0x37, 0x87, 0x61, 0x10, 0x07, 0xa4, 0xdc, 0xf7,
-	0xc3, 0x61, 0xa7, 0x75, 0xa5, 0x17, 0x04, 0xbd, 0x3e, 0x5d, 0x77, 0x87, 0xde, 0xba, 0xeb, 0xfb,
-	0x41, 0xec, 0xc6, 0x5e, 0xe0, 0x47, 0x1c, 0xc9, 0xfe, 0x3a, 0xcc, 0xdc, 0xa7, 0xfe, 0x21, 0xa5,
-	0x5d, 0x87, 0x7e, 0x38, 0xa2, 0x51, 0x4c, 0x5e, 0x87, 0x39, 0x97, 0x7e, 0x83, 0xd2, 0x6e, 0x7b,
-	0xe8, 0x46, 0xd1, 0xf0, 0x24, 0x74, 0x23, 0xda, 0xb4, 0x56, 0xac, 0xd5, 0xba, 0xd3, 0xe0, 0x15,
-	0x07, 0x0a, 0x4e, 0x5e, 0x83, 0x7a, 0xc4, 0x50, 0xa9, 0x1f, 0x87, 0xc1, 0xf0, 0xbc, 0x59, 0x40,
-	0xbc, 0x1a, 0x83, 0xed, 0x70, 0x90, 0xdd, 0x87, 0x59, 0xd5, 0x43, 0x34, 0x0c, 0xfc, 0x88, 0x92,
-	0x5b, 0xb0, 0xd0, 0xf1, 0x86, 0x27, 0x34, 0x6c, 0xe3, 0xc7, 0x03, 0x9f, 0x0e, 0x02, 0xdf, 0xeb,
-	0x34, 0xad, 0x95, 0xe2, 0x6a, 0xd5, 0x21, 0xbc, 0x8e, 0x7d, 0xf1, 0xae, 0xa8, 0x21, 0x37, 0x60,
-	0x96, 0xfa, 0x1c, 0x4e, 0xbb, 0xf8, 0x95, 0xe8, 0x6a, 0x26, 0x01, 0xb3, 0x0f, 0xec, 0x5f, 0x2c,
-	0xc0, 0xdc, 0x03, 0xdf, 0x8b, 0x9f, 0xb8, 0xfd, 0x3e, 0x8d, 0xe5, 0x9c, 0x6e, 0xc0, 0xec, 0x19,
-	0x02, 0x70, 0x4e, 0x67, 0x41, 0xd8, 0x15, 0x33, 0x9a, 0xe1, 0xe0, 0x03, 0x01, 0x1d, 0x3b, 0xb2,
-	0xc2, 0xd8, 0x91, 0xe5, 0x2e, 0x57, 0x71, 0xcc, 0x72, 0xdd, 0x80, 0xd9, 0x90, 0x76, 0x82, 0x53,
-	0x1a, 0x9e, 0xb7, 0xcf, 0x3c, 0xbf, 0x1b, 0x9c, 0x35, 0x4b, 0x2b, 0xd6, 0x6a, 0xd9, 0x99, 0x91,
-	0xe0, 0x27, 0x08, 0x25, 0x9b, 0x30, 0xdb, 0x39, 0x71, 0x7d, 0x9f, 0xf6, 0xdb, 0x47, 0x6e, 0xe7,
-	0xe9, 0x68, 0x18, 0x35, 0xcb, 0x2b, 0xd6, 0x6a, 0xed, 0xf6, 0xa5, 0x9b, 0xb8, 0xab, 0x37, 0xb7,
-	0x4e, 0x5c, 0x7f, 0x13, 0x6b, 0x0e, 0x7d, 0x77, 0x18, 0x9d, 0x04, 0xb1, 0x33, 0x23, 0xbe, 0xe0,
-	0xe0, 0xc8, 0x5e, 0x00, 0xa2, 0xaf, 0x04, 0x5f, 0x7b, 0xfb, 0x8f, 0x2c, 0x98, 0x7f, 0xec, 0xf7,
-	0x83, 0xce, 0xd3, 0x1f, 0x71, 0x89, 0x72, 0xe6, 0x50, 0x78, 0xd9, 0x39, 0x14, 0x3f, 0xee, 0x1c,
-	0x96, 0x60, 0xc1, 0x1c, 0xac, 0x98, 0x05, 0x85, 0x45, 0xf6, 0x75, 0x8f, 0xca, 0x61, 0xc9, 0x69,
-	0x7c, 0x0a, 0x1a, 0x9d, 0x51, 0x18, 0x52, 0x3f, 0x33, 0x8f, 0x59, 0x01, 0x57, 0x13, 0x79, 0x0d,
-	0xea, 0x3e, 0x3d, 0x4b, 0xd0, 0x04, 0xed, 0xfa, 0xf4, 0x4c, 0xa2, 0xd8, 0x4d, 0x58, 0x4a, 0x77,
-	0x23, 0x06, 0xf0, 0x0f, 0x16, 0x94, 0x1e, 0xc7, 0xcf, 0x02, 0x72, 0x13, 0x4a, 0xf1, 0xf9, 0x90,
-	0x73, 0xc8, 0xcc, 0x6d, 0x22, 0xa6, 0xb6, 0xd1, 0xed, 0x86, 0x34, 0x8a, 0x1e, 0x9d, 0x0f, 0xa9,
-	0x53, 0x77, 0x79, 0xa1, 0xcd, 0xf0, 0x48, 0x13, 0xa6, 0x44, 0x19, 0x3b, 0xac, 0x3a, 0xb2, 0x48,
-	0xae, 0x02, 0xb8, 0x83, 0x60, 0xe4, 0xc7, 0xed, 0xc8, 0x8d, 0x71, 0xa9, 0x8a, 0x8e, 0x06, 0x21,
-	0x57, 0xa0, 0x3a, 0x7c, 0xda, 0x8e, 0x3a, 0xa1, 0x37, 0x8c, 0x91, 0x6c, 0xaa, 0x4e, 0x02, 0x20,
-	0xaf, 0x43, 0x25, 0x18, 0xc5, 0xc3, 0xc0, 0xf3, 0x63, 0x41, 0x2a, 0xb3, 0x62, 0x2c, 0x0f, 0x47,
-	0xf1, 0x01, 0x03, 0x3b, 0x0a, 0x81, 0x5c, 0x83, 0xe9, 0x4e, 0xe0, 0x1f, 0x7b, 0xe1, 0x80, 0x0b,
-	0x83, 0xe6, 0x24, 0xf6, 0x66, 0x02, 0xed, 0x6f, 0x15, 0xa0, 0xf6, 0x28, 0x74, 0xfd, 0xc8, 0xed,
-	0x30, 0x00, 0x1b, 0x7a, 0xfc, 0xac, 0x7d, 0xe2, 0x46, 0x27, 0x38, 0xdb, 0xaa, 0x23, 0x8b, 0x64,
-	0x09, 0x26, 0xf9, 0x40, 0x71, 0x4e, 0x45, 0x47, 0x94, 0xc8, 0x1b, 0x30, 0xe7, 0x8f, 0x06, 0x6d,
-	0xb3, 0xaf, 0x22, 0x52, 0x4b, 0xb6, 0x82, 0x2d, 0xc0, 0x11, 0xdb, 0x6b, 0xde, 0x05, 0x9f, 0xa1,
-	0x06, 0x21, 0x36, 0xd4, 0x45, 0x89, 0x7a, 0xbd, 0x13, 0x3e, 0xcd, 0xb2, 0x63, 0xc0, 0x58, 0x1b,
-	0xb1, 0x37, 0xa0, 0xed, 0x28, 0x76, 0x07, 0x43, 0x31, 0x2d, 0x0d, 0x82, 0xf5, 0x41, 0xec, 0xf6,
-	0xdb, 0xc7, 0x94, 0x46, 0xcd, 0x29, 0x51, 0xaf, 0x20, 0xe4, 0x3a, 0xcc, 0x74, 0x69, 0x14, 0xb7,
-	0xc5, 0xa6, 0xd0, 0xa8, 0x59, 0x41, 0xd6, 0x4f, 0x41, 0x19, 0x65, 0xdc, 0xa7, 0xb1, 0xb6, 0x3a,
-	0x91, 0xa0, 0x40, 0x7b, 0x0f, 0x88, 0x06, 0xde, 0xa6, 0xb1, 0xeb, 0xf5, 0x23, 0xf2, 0x16, 0xd4,
-	0x63, 0x0d, 0x19, 0x45, 0x5d, 0x4d, 0x91, 0x8b, 0xf6, 0x81, 0x63, 0xe0, 0xd9, 0xf7, 0xa1, 0x72,
-	0x8f, 0xd2, 0x3d, 0x6f, 0xe0, 0xc5, 0x64, 0x09, 0xca, 0xc7, 0xde, 0x33, 0xca, 0x09, 0xba, 0xb8,
-	0x3b, 0xe1, 0xf0, 0x22, 0x69, 0xc1, 0xd4, 0x90, 0x86, 0x1d, 0x2a, 0x97, 0x7f, 0x77, 0xc2, 0x91,
-	0x80, 0xcd, 0x29, 0x28, 0xf7, 0xd9, 0xc7, 0xf6, 0xbf, 0x15, 0xa0, 0x76, 0x48, 0x7d, 0xc5, 0x28,
-	0x04, 0x4a, 0x6c, 0x4a, 0x82, 0x39, 0xf0, 0x3f, 0x79, 0x15, 0x6a, 0x38, 0xcd, 0x28, 0x0e, 0x3d,
-	0xbf, 0x27, 0xe8, 0x13, 0x18, 0xe8, 0x10, 0x21, 0xa4, 0x01, 0x45, 0x77, 0x20, 0x69, 0x93, 0xfd,
-	0x65, 0x4c, 0x34, 0x74, 0xcf, 0x07, 0x8c, 0xdf, 0xd4, 0xae, 0xd5, 0x9d, 0x9a, 0x80, 0xed, 0xb2,
-	0x6d, 0xbb, 0x09, 0xf3, 0x3a, 0x8a, 0x6c, 0xbd, 0x8c, 0xad, 0xcf, 0x69, 0x98, 0xa2, 0x93, 0x1b,
-	0x30, 0x2b, 0xf1, 0x43, 0x3e, 0x58, 0xdc, 0xc7, 0xaa, 0x33, 0x23, 0xc0, 0x72, 0x0a, 0xab, 0xd0,
-	0x38, 0xf6, 0x7c, 0xb7, 0xdf, 0xee, 0xf4, 0xe3, 0xd3, 0x76, 0x97, 0xf6, 0x63, 0x17, 0x77, 0xb4,
-	0xec, 0xcc, 0x20, 0x7c, 0xab, 0x1f, 0x9f, 0x6e, 0x33, 0x28, 0x79, 0x03, 0xaa, 0xc7, 0x94, 0xb6,
-	0x71, 0x25, 0x9a, 0x15, 0x83, 0x3b, 0xe4, 0xea, 0x3a, 0x95, 0x63, 0xb9, 0xce, 0xab, 0xd0, 0x08,
-	0x46, 0x71, 0x2f, 0xf0, 0xfc, 0x5e, 0x9b, 0xc9, 0xa3, 0xb6, 0xd7, 0x6d, 0x56, 0x57, 0xac, 0xd5,
-	0x92, 0x33, 0x23, 0xe1, 0x4c, 0x2a, 0x3c, 0xe8, 0x92, 0x57, 0x00, 0xb0, 0x6f, 0xde, 0x30, 0xac,
-	0x58, 0xab, 0xd3, 0x4e, 0x95, 0x41, 0xb0, 0x21, 0xfb, 0x4f, 0x2d, 0xa8, 0xf3, 0x35, 0x17, 0x8a,
-	0xef, 0x1a, 0x4c, 0xcb, 0xa9, 0xd1, 0x30, 0x0c, 0x42, 0xc1, 0x47, 0x26, 0x90, 0xac, 0x41, 0x43,
-	0x02, 0x86, 0x21, 0xf5, 0x06, 0x6e, 0x8f, 0x0a, 0xe1, 0x94, 0x81, 0x93, 0xdb, 0x49, 0x8b, 0x61,
-	0x30, 0x8a, 0xa9, 0x10, 0xb1, 0x75, 0x31, 0x3b, 0x87, 0xc1, 0x1c, 0x13, 0x85, 0xf1, 0x51, 0xce,
-	0x9e, 0x19, 0x30, 0xfb, 0x7b, 0x16, 0x10, 0x36, 0xf4, 0x47, 0x01, 0x6f, 0x42, 0x2c, 0x79, 0x7a,
-	0xbb, 0xad, 0x97, 0xde, 0xee, 0xc2, 0xb8, 0xed, 0x5e, 0x85, 0x49, 0x1c, 0x16, 0x13, 0x0c, 0xc5,
-	0xf4, 0xd0, 0x37, 0x0b, 0x4d, 0xcb, 0x11, 0xf5, 0xc4, 0x86, 0x32, 0x9f, 0x63, 0x29, 0x67, 0x8e,
-	0xbc, 0xca, 0xfe, 0xb6, 0x05, 0xf5, 0x2d, 0xae, 0x43, 0x50, 0xe8, 0x91, 0x5b, 0x40, 0x8e, 0x47,
-	0x7e, 0x97, 0xed, 0x65, 0xfc, 0xcc, 0xeb, 0xb6, 0x8f, 0xce, 0x59, 0x57, 0x38, 0xee, 0xdd, 0x09,
-	0x27, 0xa7, 0x8e, 0xbc, 0x01, 0x0d, 0x03, 0x1a, 0xc5, 0x21, 0x1f, 0xfd, 0xee, 0x84, 0x93, 0xa9,
-	0x61, 0x8b, 0xc9, 0xc4, 0xea, 0x28, 0x6e, 0x7b, 0x7e, 0x97, 0x3e, 0xc3, 0xf5, 0x9f, 0x76, 0x0c,
-	0xd8, 0xe6, 0x0c, 0xd4, 0xf5, 0xef, 0xec, 0x0f, 0xa0, 0x22, 0x85, 0x32, 0x0a, 0xa4, 0xd4, 0xb8,
-	0x1c, 0x0d, 0x42, 0x5a, 0x50, 0x31, 0x47, 0xe1, 0x54, 0x3e, 0x4e, 0xdf, 0xf6, 0xff, 0x81, 0xc6,
-	0x1e, 0x93, 0x8c, 0xbe, 0xe7, 0xf7, 0x84, 0x56, 0x62, 0xe2, 0x7a, 0x38, 0x3a, 0x7a, 0x4a, 0xcf,
-	0x05, 0xfd, 0x89, 0x12, 0x93, 0x09, 0x27, 0x41, 0x14, 0x8b, 0x7e, 0xf0, 0xbf, 0xfd, 0x97, 0x16,
-	0x90, 0x9d, 0x28, 0xf6, 0x06, 0x6e, 0x4c, 0xef, 0x51, 0x45, 0x08, 0x0f, 0xa1, 0xce, 0x5a, 0x7b,
-	0x14, 0x6c, 0x70, 0xb9, 0xcf, 0xe5, 0xd9, 0xeb, 0x62, 0x4b, 0xb2, 0x1f, 0xdc, 0xd4, 0xb1, 0x99,
-	0x69, 0x78, 0xee, 0x18, 0x0d, 0x30, 0xd9, 0x13, 0xbb, 0x61, 0x8f, 0xc6, 0xa8, 0x14, 0x84, 0x49,
-	0x01, 0x1c, 0xb4, 0x15, 0xf8, 0xc7, 0xad, 0x2f, 0xc2, 0x5c, 0xa6, 0x0d, 0x26, 0x90, 0x92, 0x69,
-	0xb0, 0xbf, 0x64, 0x01, 0xca, 0xa7, 0x6e, 0x7f, 0x44, 0x85, 0x26, 0xe2, 0x85, 0xb7, 0x0b, 0x77,
-	0x2c, 0xbb, 0x03, 0xf3, 0xc6, 0xb8, 0x04, 0x4f, 0x36, 0x61, 0x8a, 0xc9, 0x06, 0xa6, 0x73, 0x51,
-	0xae, 0x3a, 0xb2, 0x48, 0x6e, 0xc3, 0xc2, 0x31, 0xa5, 0xa1, 0x1b, 0x63, 0xb1, 0x3d, 0xa4, 0x21,
-	0xee, 0x89, 0x68, 0x39, 0xb7, 0xce, 0xfe, 0x47, 0x0b, 0x66, 0x19, 0xdf, 0xbc, 0xeb, 0xfa, 0xe7,
-	0x72, 0xad, 0xf6, 0x72, 0xd7, 0x6a, 0x55, 0xac, 0x55, 0x0a, 0xfb, 0xe3, 0x2e, 0x54, 0x31, 0xbd,
-	0x50, 0x64, 0x05, 0xea, 0xc6, 0x70, 0xcb, 0x5c, 0xc9, 0x45, 0x6e, 0x7c, 0x40, 0xc3, 0xcd, 0xf3,
-	0x98, 0xfe, 0xf8, 0x4b, 0x79, 0x1d, 0x1a, 0xc9, 0xb0, 0xc5, 0x3a, 0x12, 0x28, 0x31, 0xc2, 0x14,
-	0x0d, 0xe0, 0x7f, 0xfb, 0x77, 0x2c, 0x8e, 0xb8, 0x15, 0x78, 0x4a, 0x41, 0x32, 0x44, 0xa6, 0x47,
-	0x25, 0x22, 0xfb, 0x3f, 0xd6, 0x80, 0xf8, 0xf1, 0x27, 0x4b, 0x2e, 0x41, 0x25, 0xa2, 0x7e, 0xb7,
-	0xed, 0xf6, 0xfb, 0xa8, 0x47, 0x2a, 0xce, 0x14, 0x2b, 0x6f, 0xf4, 0xfb, 0xf6, 0x0d, 0x98, 0xd3,
-	0x46, 0xf7, 0x82, 0x79, 0xec, 0x03, 0xd9, 0xf3, 0xa2, 0xf8, 0xb1, 0x1f, 0x0d, 0x35, 0xfd, 0x73,
-	0x19, 0xaa, 0x03, 0xcf, 0xc7, 0x91, 0x71, 0xce, 0x2d, 0x3b, 0x95, 0x81, 0xe7, 0xb3, 0x71, 0x45,
-	0x58, 0xe9, 0x3e, 0x13, 0x95, 0x05, 0x51, 0xe9, 0x3e, 0xc3, 0x4a, 0xfb, 0x0e, 0xcc, 0x1b, 0xed,
-	0x89, 0xae, 0x5f, 0x83, 0xf2, 0x28, 0x7e, 0x16, 0x48, 0xeb, 0xa0, 0x26, 0x28, 0x84, 0xd9, 0x99,
-	0x0e, 0xaf, 0xb1, 0xef, 0xc2, 0xdc, 0x3e, 0x3d, 0x13, 0x8c, 0x2c, 0x07, 0x72, 0xfd, 0x42, 0x1b,
-	0x14, 0xeb, 0xed, 0x9b, 0x40, 0xf4, 0x8f, 0x13, 0x06, 0x90, 0x16, 0xa9, 0x65, 0x58, 0xa4, 0xf6,
-	0x75, 0x20, 0x87, 0x5e, 0xcf, 0x7f, 0x97, 0x46, 0x91, 0xdb, 0x53, 0xac, 0xdf, 0x80, 0xe2, 0x20,
-	0xea, 0x09, 0x51, 0xc5, 0xfe, 0xda, 0x9f, 0x86, 0x79, 0x03, 0x4f, 0x34, 0x7c, 0x05, 0xaa, 0x91,
-	0xd7, 0xf3, 0xdd, 0x78, 0x14, 0x52, 0xd1, 0x74, 0x02, 0xb0, 0xef, 0xc1, 0xc2, 0x57, 0x68, 0xe8,
-	0x1d, 0x9f, 0x5f, 0xd4, 0xbc, 0xd9, 0x4e, 0x21, 0xdd, 0xce, 0x0e, 0x2c, 0xa6, 0xda, 0x11, 0xdd,
-	0x73, 0xf2, 0x15, 0x3b, 0x59, 0x71, 0x78, 0x41, 0x93, 0x7d, 0x05, 0x5d, 0xf6, 0xd9, 0x8f, 0x81,
-	0x6c, 0x05, 0xbe, 0x4f, 0x3b, 0xf1, 0x01, 0xa5, 0x61, 0xe2, 0x0c, 0x27, 0xb4, 0x5a, 0xbb, 0xbd,
-	0x2c, 0x56, 0x36, 0x2d, 0x50, 0x05, 0x11, 0x13, 0x28, 0x0d, 0x69, 0x38, 0xc0, 0x86, 0x2b, 0x0e,
-	0xfe, 0xb7, 0x17, 0x61, 0xde, 0x68, 0x56, 0xb8, 0x0f, 0x6f, 0xc2, 0xe2, 0xb6, 0x17, 0x75, 0xb2,
-	0x1d, 0x36, 0x61, 0x6a, 0x38, 0x3a, 0x6a, 0x27, 0x9c, 0x28, 0x8b, 0xcc, 0xe2, 0x4c, 0x7f, 0x22,
-	0x1a, 0xfb, 0x79, 0x0b, 0x4a, 0xbb, 0x8f, 0xf6, 0xb6, 0x98, 0xae, 0xf0, 0xfc, 0x4e, 0x30, 0x60,
-	0xfa, 0x96, 0x4f, 0x5a, 0x95, 0xc7, 0x72, 0xd8, 0x15, 0xa8, 0xa2, 0x9a, 0x66, 0x46, 0xb4, 0xf0,
-	0x5b, 0x13, 0x00, 0x33, 0xe0, 0xe9, 0xb3, 0xa1, 0x17, 0xa2, 0x85, 0x2e, 0xed, 0xee, 0x12, 0xaa,
-	0x99, 0x6c, 0x85, 0xfd, 0x83, 0x32, 0x4c, 0x09, 0xe5, 0x8b, 0xfd, 0x75, 0x62, 0xef, 0x94, 0x8a,
-	0x91, 0x88, 0x12, 0x33, 0x81, 0x42, 0x3a, 0x08, 0x62, 0xda, 0x36, 0xb6, 0xc1, 0x04, 0xa2, 0x83,
-	0x22, 0x7c, 0x47, 0xee, 0xd2, 0x14, 0x39, 0x96, 0x01, 0x64, 0x8b, 0x25, 0xed, 0xb3, 0x12, 0xda,
-	0x67, 0xb2, 0xc8, 0x56, 0xa2, 0xe3, 0x0e, 0xdd, 0x8e, 0x17, 0x9f, 0x0b, 0x91, 0xa0, 0xca, 0xac,
-	0xed, 0x7e, 0xd0, 0x71, 0x99, 0x57, 0xda, 0x77, 0xfd, 0x0e, 0x95, 0xce, 0x8f, 0x01, 0x64, 0x8e,
-	0x80, 0x18, 0x92, 0x44, 0xe3, 0xce, 0x42, 0x0a, 0xca, 0xf4, 0x77, 0x27, 0x18, 0x0c, 0xbc, 0x98,
-	0xf9, 0x0f, 0x68, 0x5b, 0x16, 0x1d, 0x0d, 0xc2, 0x5d, 0x2d, 0x2c, 0x9d, 0xf1, 0xd5, 0xab, 0x4a,
-	0x57, 0x4b, 0x03, 0xb2, 0x56, 0x98, 0xd6, 0x61, 0x62, 0xec, 0xe9, 0x19, 0x1a, 0x92, 0x45, 0x47,
-	0x83, 0xb0, 0x7d, 0x18, 0xf9, 0x11, 0x8d, 0xe3, 0x3e, 0xed, 0xaa, 0x01, 0xd5, 0x10, 0x2d, 0x5b,
-	0x41, 0x6e, 0xc1, 0x3c, 0x77, 0x69, 0x22, 0x37, 0x0e, 0xa2, 0x13, 0x2f, 0x6a, 0x47, 0xcc, 0x39,
-	0xa8, 0x23, 0x7e, 0x5e, 0x15, 0xb9, 0x03, 0xcb, 0x29, 0x70, 0x48, 0x3b, 0xd4, 0x3b, 0xa5, 0xdd,
-	0xe6, 0x34, 0x7e, 0x35, 0xae, 0x9a, 0xac, 0x40, 0x8d, 0x79, 0x72, 0xa3, 0x61, 0xd7, 0x65, 0x06,
-	0xcc, 0x0c, 0xee, 0x83, 0x0e, 0x22, 0x6f, 0xc2, 0xf4, 0x90, 0x72, 0xeb, 0xe7, 0x24, 0xee, 0x77,
-	0xa2, 0xe6, 0xac, 0x21, 0xdd, 0x18, 0xe5, 0x3a, 0x26, 0x06, 0x23, 0xca, 0x4e, 0x84, 0x26, 0xbd,
-	0x7b, 0xde, 0x6c, 0x08, 0xb3, 0x5a, 0x02, 0x90, 0x47, 0x42, 0xef, 0xd4, 0x8d, 0x69, 0x73, 0x8e,
-	0x0b, 0x74, 0x51, 0x64, 0xdf, 0x79, 0xbe, 0x17, 0x7b, 0x6e, 0x1c, 0x84, 0x4d, 0x82, 0x75, 0x09,
-	0x80, 0x2d, 0x22, 0xd2, 0x47, 0x14, 0xbb, 0xf1, 0x28, 0x6a, 0x1f, 0xf7, 0xdd, 0x5e, 0xd4, 0x9c,
-	0xe7, 0x76, 0x69, 0xa6, 0xc2, 0xfe, 0x3d, 0x8b, 0x0b, 0x69, 0x41, 0xd0, 0x4a, 0xd8, 0xbe, 0x0a,
-	0x35, 0x4e, 0xca, 0xed, 0xc0, 0xef, 0x9f, 0x0b, 0xea, 0x06, 0x0e, 0x7a, 0xe8, 0xf7, 0xcf, 0xc9,
-	0x27, 0x60, 0xda, 0xf3, 0x75, 0x14, 0x2e, 0x0f, 0xea, 0x12, 0x88, 0x48, 0xaf, 0x42, 0x6d, 0x38,
-	0x3a, 0xea, 0x7b, 0x1d, 0x8e, 0x52, 0xe4, 0xad, 0x70, 0x10, 0x22, 0x30, 0x4b, 0x9b, 0xcf, 0x8a,
-	0x63, 0x94, 0x10, 0xa3, 0x26, 0x60, 0x0c, 0xc5, 0xde, 0x84, 0x05, 0x73, 0x80, 0x42, 0xf0, 0xad,
-	0x41, 0x45, 0xf0, 0x49, 0xd4, 0xac, 0xe1, 0x5a, 0xcf, 0x68, 0x11, 0x17, 0x9f, 0xf6, 0x1d, 0x55,
-	0x6f, 0xff, 0x49, 0x09, 0xe6, 0x05, 0x74, 0xab, 0x1f, 0x44, 0xf4, 0x70, 0x34, 0x18, 0xb8, 0x61,
-	0x0e, 0x03, 0x5a, 0x17, 0x30, 0x60, 0xc1, 0x64, 0x40, 0xc6, 0x16, 0x27, 0xae, 0xe7, 0x73, 0x37,
-	0x81, 0x73, 0xaf, 0x06, 0x21, 0xab, 0x30, 0xdb, 0xe9, 0x07, 0x11, 0x37, 0x89, 0x75, 0x87, 0x3f,
-	0x0d, 0xce, 0x0a, 0x8c, 0x72, 0x9e, 0xc0, 0xd0, 0x19, 0x7e, 0x32, 0xc5, 0xf0, 0x36, 0xd4, 0x59,
-	0xa3, 0x54, 0xca, 0xaf, 0x29, 0x6e, 0x26, 0xeb, 0x30, 0x36, 0x9e, 0x34, 0x7b, 0x71, 0x5e, 0x9e,
-	0xcd, 0x63, 0x2e, 0x6f, 0x40, 0x51, 0x3e, 0x6a, 0xd8, 0x55, 0xc1, 0x5c, 0xd9, 0x2a, 0x72, 0x8f,
-	0x79, 0x89, 0xac, 0x2f, 0x54, 0xd2, 0x80, 0x4a, 0xfa, 0xba, 0xb9, 0x23, 0xfa, 0xda, 0xdf, 0x64,
-	0x85, 0x51, 0x48, 0x51, 0x71, 0x6b, 0x5f, 0xda, 0xbf, 0x64, 0x41, 0x4d, 0xab, 0x23, 0x8b, 0x30,
-	0xb7, 0xf5, 0xf0, 0xe1, 0xc1, 0x8e, 0xb3, 0xf1, 0xe8, 0xc1, 0x57, 0x76, 0xda, 0x5b, 0x7b, 0x0f,
-	0x0f, 0x77, 0x1a, 0x13, 0x0c, 0xbc, 0xf7, 0x70, 0x6b, 0x63, 0xaf, 0x7d, 0xef, 0xa1, 0xb3, 0x25,
-	0xc1, 0x16, 0x59, 0x02, 0xe2, 0xec, 0xbc, 0xfb, 0xf0, 0xd1, 0x8e, 0x01, 0x2f, 0x90, 0x06, 0xd4,
-	0x37, 0x9d, 0x9d, 0x8d, 0xad, 0x5d, 0x01, 0x29, 0x92, 0x05, 0x68, 0xdc, 0x7b, 0xbc, 0xbf, 0xfd,
-	0x60, 0xff, 0x7e, 0x7b, 0x6b, 0x63, 0x7f, 0x6b, 0x67, 0x6f, 0x67, 0xbb, 0x51, 0x22, 0xd3, 0x50,
-	0xdd, 0xd8, 0xdc, 0xd8, 0xdf, 0x7e, 0xb8, 0xbf, 0xb3, 0xdd, 0x28, 0xdb, 0x7f, 0x67, 0xc1, 0x22,
-	0x8e, 0xba, 0x9b, 0x66, 0x90, 0x15, 0xa8, 0x75, 0x82, 0x60, 0xc8, 0x8c, 0xe3, 0x44, 0xfc, 0xeb,
-	0x20, 0x46, 0xfc, 0x5c, 0xd8, 0x1e, 0x07, 0x61, 0x87, 0x0a, 0xfe, 0x00, 0x04, 0xdd, 0x63, 0x10,
-	0x46, 0xfc, 0x62, 0x7b, 0x39, 0x06, 0x67, 0x8f, 0x1a, 0x87, 0x71, 0x94, 0x25, 0x98, 0x3c, 0x0a,
-	0xa9, 0xdb, 0x39, 0x11, 0x9c, 0x21, 0x4a, 0xe4, 0x53, 0x89, 0xf7, 0xd6, 0x61, 0xab, 0xdf, 0xa7,
-	0x5d, 0xa4, 0x98, 0x8a, 0x33, 0x2b, 0xe0, 0x5b, 0x02, 0xcc, 0xa4, 0x85, 0x7b, 0xe4, 0xfa, 0xdd,
-	0xc0, 0xa7, 0x5d, 0x61, 0x1a, 0x26, 0x00, 0xfb, 0x00, 0x96, 0xd2, 0xf3, 0x13, 0xfc, 0xf5, 0x96,
-	0xc6, 0x5f, 0xdc, 0x52, 0x6b, 0x8d, 0xdf, 0x4d, 0x8d, 0xd7, 0xfe, 0xbe, 0x00, 0x25, 0xa6, 0xb8,
-	0xc7, 0x2b, 0x79, 0xdd, 0x16, 0x2b, 0x66, 0xa2, 0x83, 0xe8, 0x10, 0x72, 0x51, 0xce, 0xd5, 0x9d,
-	0x06, 0x49, 0xea, 0x43, 0xda, 0x39, 0xc5, 0x19, 0xab, 0x7a, 0x06, 0x61, 0x0c, 0xc2, 0x0c, 0x65,
-	0xfc, 0x5a, 0x30, 0x88, 0x2c, 0xcb, 0x3a, 0xfc, 0x72, 0x2a, 0xa9, 0xc3, 0xef, 0x9a, 0x30, 0xe5,
-	0xf9, 0x47, 0xc1, 0xc8, 0xef, 0x22, 0x43, 0x54, 0x1c, 0x59, 0xc4, 0x78, 0x24, 0x32, 0xaa, 0x37,
-	0x90, 0xe4, 0x9f, 0x00, 0xc8, 0x6d, 0xa8, 0x46, 0xe7, 0x7e, 0x47, 0xa7, 0xf9, 0x05, 0xb1, 0x4a,
-	0x6c, 0x0d, 0x6e, 0x1e, 0x9e, 0xfb, 0x1d, 0xa4, 0xf0, 0x04, 0xcd, 0xfe, 0x22, 0x54, 0x24, 0x98,
-	0x91, 0xe5, 0xe3, 0xfd, 0x77, 0xf6, 0x1f, 0x3e, 0xd9, 0x6f, 0x1f, 0xbe, 0xb7, 0xbf, 0xd5, 0x98,
-	0x20, 0xb3, 0x50, 0xdb, 0xd8, 0x42, 0x4a, 0x47, 0x80, 0xc5, 0x50, 0x0e, 0x36, 0x0e, 0x0f, 0x15,
-	0xa4, 0x60, 0x13, 0xe6, 0xec, 0x46, 0x68, 0x1d, 0xa9, 0x78, 0xdc, 0x5b, 0x30, 0xa7, 0xc1, 0x12,
-	0x4b, 0x7b, 0xc8, 0x00, 0x29, 0x4b, 0x1b, 0xcd, 0x2a, 0x5e, 0x63, 0x37, 0x60, 0xe6, 0x3e, 0x8d,
-	0x1f, 0xf8, 0xc7, 0x81, 0x6c, 0xe9, 0x0f, 0x4a, 0x30, 0xab, 0x40, 0xa2, 0xa1, 0x55, 0x98, 0xf5,
-	0xba, 0xd4, 0x8f, 0xbd, 0xf8, 0xbc, 0x6d, 0xf8, 0xd4, 0x69, 0x30, 0x33, 0x47, 0xdd, 0xbe, 0xe7,
-	0xca, 0xb0, 0x2f, 0x2f, 0x30, 0x1f, 0x93, 0xe9, 0x4a, 0xa9, 0xfe, 0x14, 0x5d, 0x71, 0x57, 0x3e,
-	0xb7, 0x8e, 0x49, 0x20, 0x06, 0x17, 0x2a, 0x46, 0x7d, 0xc2, 0xcd, 0xb2, 0xbc, 0x2a, 0xb6, 0x55,
-	0xbc, 0x25, 0x36, 0xe5, 0x32, 0xd7, 0xa7, 0x0a, 0x90, 0x89, 0xab, 0x4e, 0x72, 0xf9, 0x98, 0x8e,
-	0xab, 0x6a, 0xb1, 0xd9, 0x4a, 0x26, 0x36, 0xcb, 0xe4, 0xe7, 0xb9, 0xdf, 0xa1, 0xdd, 0x76, 0x1c,
-	0xb4, 0x51, 0xce, 0x23, 0x49, 0x54, 0x9c, 0x34, 0x98, 0x5c, 0x81, 0xa9, 0x98, 0x46, 0xb1, 0x4f,
-	0x79, 0xc0, 0xac, 0x82, 0x21, 0x1e, 0x09, 0x62, 0x36, 0xf4, 0x28, 0xf4, 0xa2, 0x66, 0x1d, 0xa3,
-	0xae, 0xf8, 0x9f, 0x7c, 0x06, 0x16, 0x8f, 0x68, 0x14, 0xb7, 0x4f, 0xa8, 0xdb, 0xa5, 0x21, 0x92,
-	0x17, 0x0f, 0xef, 0x72, 0xd3, 0x24, 0xbf, 0x92, 0x11, 0xee, 0x29, 0x0d, 0x23, 0x2f, 0xf0, 0xd1,
-	0x28, 0xa9, 0x3a, 0xb2, 0xc8, 0xda, 0x63, 0x93, 0x57, 0x4a, 0x5a, 0xad, 0xe0, 0x2c, 0x4e, 0x3c,
-	0xbf, 0x92, 0x5c, 0x83, 0x49, 0x9c, 0x40, 0xd4, 0x6c, 0x18, 0x71, 0xaa, 0x2d, 0x06, 0x74, 0x44,
-	0xdd, 0x97, 0x4a, 0x95, 0x5a, 0xa3, 0x6e, 0x7f, 0x0e, 0xca, 0x08, 0x66, 0x9b, 0xce, 0x17, 0x83,
-	0x13, 0x05, 0x2f, 0xb0, 0xa1, 0xf9, 0x34, 0x3e, 0x0b, 0xc2, 0xa7, 0xf2, 0x0c, 0x40, 0x14, 0xed,
-	0x6f, 0xa0, 0x17, 0xa2, 0x62, 0xe2, 0x8f, 0xd1, 0x84, 0x62, 0xbe, 0x24, 0x5f, 0xea, 0xe8, 0xc4,
-	0x15, 0x8e, 0x51, 0x05, 0x01, 0x87, 0x27, 0x2e, 0x93, 0x95, 0xc6, 0xee, 0x71, 0x5f, 0xb3, 0x86,
-	0xb0, 0x5d, 0xbe, 0x79, 0xd7, 0x60, 0x46, 0x46, 0xdb, 0xa3, 0x76, 0x9f, 0x1e, 0xc7, 0x32, 0x52,
-	0xe4, 0x8f, 0x06, 0xe8, 0x90, 0xee, 0xd1, 0xe3, 0xd8, 0xde, 0x87, 0x39, 0x21, 0xbf, 0x1e, 0x0e,
-	0xa9, 0xec, 0xfa, 0xf3, 0x79, 0x76, 0x40, 0xed, 0xf6, 0xbc, 0x29, 0xf0, 0xf8, 0xf9, 0x82, 0x89,
-	0x69, 0x3b, 0x40, 0x74, 0x79, 0x28, 0x1a, 0x14, 0xca, 0x58, 0xc6, 0xc2, 0xc4, 0x74, 0x0c, 0x18,
-	0x5b, 0x9f, 0x68, 0xd4, 0xe9, 0xc8, 0x33, 0x12, 0xe6, 0xb1, 0xf3, 0xa2, 0xfd, 0x87, 0x16, 0xcc,
-	0x63, 0x6b, 0xd2, 0x92, 0x11, 0x3a, 0xe7, 0xce, 0xc7, 0x18, 0x66, 0xbd, 0xa3, 0xc7, 0x07, 0x17,
-	0xa0, 0xac, 0x6b, 0x21, 0x5e, 0xf8, 0xf8, 0x71, 0x87, 0x52, 0x3a, 0xee, 0x60, 0xff, 0x96, 0x05,
-	0x73, 0x5c, 0x11, 0xa0, 0x55, 0x29, 0xa6, 0xff, 0xbf, 0x61, 0x9a, 0x6b, 0x74, 0xc1, 0xd5, 0x62,
-	0xa0, 0x89, 0x68, 0x44, 0x28, 0x47, 0xde, 0x9d, 0x70, 0x4c, 0x64, 0x72, 0x17, 0xad, 0x2a, 0xbf,
-	0x8d, 0xd0, 0x9c, 0xd3, 0x34, 0x73, 0xad, 0x77, 0x27, 0x1c, 0x0d, 0x7d, 0xb3, 0x02, 0x93, 0xdc,
-	0x24, 0xb7, 0xef, 0xc3, 0xb4, 0xd1, 0x91, 0x11, 0xf3, 0xa8, 0xf3, 0x98, 0x47, 0x26, 0xb8, 0x58,
-	0xc8, 0x09, 0x2e, 0xfe, 0x71, 0x11, 0x08, 0x23, 0x96, 0xd4, 0x6e, 0x30, 0x9f, 0x20, 0xe8, 0x1a,
-	0x1e, 0x5e, 0xdd, 0xd1, 0x41, 0xe4, 0x26, 0x10, 0xad, 0x28, 0x63, 0xc4, 0x5c, 0xe5, 0xe5, 0xd4,
-	0x30, 0x31, 0x29, 0x2c, 0x06, 0xa1, 0xdb, 0x85, 0x2f, 0xcb, 0x97, 0x3d, 0xb7, 0x8e, 0x69, 0xb5,
-	0xe1, 0x28, 0x3a, 0xc1, 0xc8, 0x9e, 0xf0, 0x01, 0x65, 0x39, 0xbd, 0xbf, 0x93, 0x17, 0xee, 0xef,
-	0x54, 0x26, 0xae, 0xa4, 0x79, 0x21, 0x15, 0xd3, 0x0b, 0xb9, 0x06, 0xd3, 0x03, 0x66, 0xe7, 0xc6,
-	0xfd, 0x4e, 0x7b, 0xc0, 0x7a, 0x17, 0x2e, 0x9f, 0x01, 0x24, 0x6b, 0xd0, 0x10, 0x36, 0x4e, 0xe2,
-	0xea, 0xf0, 0x13, 0x84, 0x0c, 0x9c, 0xc9, 0xef, 0x24, 0xd2, 0x54, 0xc3, 0xc1, 0x26, 0x00, 0xe6,
-	0xd7, 0x44, 0x8c, 0x42, 0xda, 0x23, 0x5f, 0x1c, 0xa8, 0xd1, 0x2e, 0x3a, 0x7b, 0x15, 0x27, 0x5b,
-	0x61, 0xff, 0x9a, 0x05, 0x0d, 0xb6, 0x67, 0x06, 0x59, 0xbe, 0x0d, 0xc8, 0x15, 0x2f, 0x49, 0x95,
-	0x06, 0x2e, 0xb9, 0x03, 0x55, 0x2c, 0x07, 0x43, 0xea, 0x0b, 0x9a, 0x6c, 0x9a, 0x34, 0x99, 0xc8,
-	0x93, 0xdd, 0x09, 0x27, 0x41, 0xd6, 0x28, 0xf2, 0xaf, 0x2d, 0xa8, 0x89, 0x5e, 0x7e, 0xe4, 0x48,
-	0x46, 0x4b, 0x3b, 0x01, 0xe5, 0x94, 0x94, 0x1c, 0x78, 0xae, 0xc2, 0xec, 0xc0, 0x8d, 0x47, 0x21,
-	0xd3, 0xc7, 0x46, 0x14, 0x23, 0x0d, 0x66, 0xca, 0x15, 0x45, 0x67, 0xd4, 0x8e, 0xbd, 0x7e, 0x5b,
-	0xd6, 0x8a, 0xb3, 0xc6, 0xbc, 0x2a, 0x26, 0x41, 0xa2, 0xd8, 0xed, 0x51, 0xa1, 0x37, 0x79, 0xc1,
-	0x6e, 0xc2, 0x92, 0x98, 0x50, 0xca, 0x3e, 0xb6, 0xbf, 0x5f, 0x87, 0xe5, 0x4c, 0x95, 0xca, 0x8c,
-	0x10, 0xee, 0x79, 0xdf, 0x1b, 0x1c, 0x05, 0xca, 0xb9, 0xb0, 0x74, 0xcf, 0xdd, 0xa8, 0x22, 0x3d,
-	0x58, 0x94, 0x06, 0x02, 0x5b, 0xd3, 0x44, 0x99, 0x15, 0x50, 0x4b, 0xbd, 0x69, 0x6e, 0x61, 0xba,
-	0x43, 0x09, 0xd7, 0x99, 0x38, 0xbf, 0x3d, 0x72, 0x02, 0x4d, 0x65, 0x89, 0x08, 0x61, 0xad, 0x59,
-	0x2b, 0xac, 0xaf, 0x37, 0x2e, 0xe8, 0xcb, 0x30, 0xa7, 0x9d, 0xb1, 0xad, 0x91, 0x73, 0xb8, 0x2a,
-	0xeb, 0x50, 0x1a, 0x67, 0xfb, 0x2b, 0xbd, 0xd4, 0xdc, 0xd0, 0x51, 0x30, 0x3b, 0xbd, 0xa0, 0x61,
-	0xf2, 0x01, 0x2c, 0x9d, 0xb9, 0x5e, 0x2c, 0x87, 0xa5, 0xd9, 0x06, 0x65, 0xec, 0xf2, 0xf6, 0x05,
-	0x5d, 0x3e, 0xe1, 0x1f, 0x1b, 0x2a, 0x6a, 0x4c, 0x8b, 0xad, 0x1f, 0x58, 0x30, 0x63, 0xb6, 0xc3,
-	0xc8, 0x54, 0xf0, 0xbe, 0x94, 0x81, 0xd2, 0x9a, 0x4c, 0x81, 0xb3, 0xfe, 0x79, 0x21, 0xcf, 0x3f,
-	0xd7, 0xbd, 0xe2, 0xe2, 0x45, 0x61, 0xb0, 0xd2, 0xcb, 0x85, 0xc1, 0xca, 0x79, 0x61, 0xb0, 0xd6,
-	0x7f, 0x58, 0x40, 0xb2, 0xb4, 0x44, 0xee, 0xf3, 0x00, 0x81, 0x4f, 0xfb, 0x42, 0xa4, 0xfc, 0xaf,
-	0x97, 0xa3, 0x47, 0xb9, 0x76, 0xf2, 0x6b, 0xc6, 0x18, 0x7a, 0xb2, 0x80, 0x6e, 0xec, 0x4c, 0x3b,
-	0x79, 0x55, 0xa9, 0xc0, 0x5c, 0xe9, 0xe2, 0xc0, 0x5c, 0xf9, 0xe2, 0xc0, 0xdc, 0x64, 0x3a, 0x30,
-	0xd7, 0xfa, 0x39, 0x0b, 0xe6, 0x73, 0x36, 0xfd, 0x27, 0x37, 0x71, 0xb6, 0x4d, 0x86, 0x2c, 0x28,
-	0x88, 0x6d, 0xd2, 0x81, 0xad, 0x9f, 0x82, 0x69, 0x83, 0xd0, 0x7f, 0x72, 0xfd, 0xa7, 0xed, 0x35,
-	0x4e, 0x67, 0x06, 0xac, 0xf5, 0x2f, 0x05, 0x20, 0x59, 0x66, 0xfb, 0x1f, 0x1d, 0x43, 0x76, 0x9d,
-	0x8a, 0x39, 0xeb, 0xf4, 0xdf, 0xaa, 0x07, 0xde, 0x80, 0x39, 0x91, 0x01, 0xa5, 0x85, 0x85, 0x38,
-	0xc5, 0x64, 0x2b, 0x98, 0xc5, 0x6a, 0x46, 0x45, 0x2b, 0x46, 0x46, 0x88, 0xa6, 0x0c, 0x53, 0xc1,
-	0x51, 0xbb, 0x05, 0x4d, 0xb1, 0x42, 0x3b, 0xa7, 0xd4, 0x8f, 0x0f, 0x47, 0x47, 0x3c, 0x05, 0xc8,
-	0x0b, 0x7c, 0xfb, 0x7b, 0x45, 0x65, 0x74, 0x63, 0xa5, 0x50, 0xef, 0x9f, 0x81, 0xba, 0x2e, 0xcc,
-	0xc5, 0x76, 0xa4, 0xa2, 0x82, 0x4c, 0xb1, 0xeb, 0x58, 0x64, 0x1b, 0x66, 0x50, 0x64, 0x75, 0xd5,
-	0x77, 0x05, 0xfc, 0xee, 0x05, 0xd1, 0x8e, 0xdd, 0x09, 0x27, 0xf5, 0x0d, 0xf9, 0x02, 0xcc, 0x98,
-	0xae, 0x94, 0xb0, 0x11, 0xf2, 0x6c, 0x73, 0xf6, 0xb9, 0x89, 0x4c, 0x36, 0xa0, 0x91, 0xf6, 0xc5,
-	0xc4, 0xf9, 0xff, 0x98, 0x06, 0x32, 0xe8, 0xe4, 0x8e, 0x38, 0x1e, 0x2b, 0x63, 0x14, 0xe2, 0x9a,
-	0xf9, 0x99, 0xb6, 0x4c, 0x37, 0xf9, 0x8f, 0x76, 0x60, 0xf6, 0x35, 0x80, 0x04, 0x46, 0x1a, 0x50,
-	0x7f, 0x78, 0xb0, 0xb3, 0xdf, 0xde, 0xda, 0xdd, 0xd8, 0xdf, 0xdf, 0xd9, 0x6b, 0x4c, 0x10, 0x02,
-	0x33, 0x18, 0x34, 0xdb, 0x56, 0x30, 0x8b, 0xc1, 0x44, 0x98, 0x42, 0xc2, 0x0a, 0x64, 0x01, 0x1a,
-	0x0f, 0xf6, 0x53, 0xd0, 0xe2, 0x66, 0x55, 0xf1, 0x87, 0xbd, 0x04, 0x0b, 0x3c, 0xc3, 0x6d, 0x93,
-	0x93, 0x87, 0xb4, 0x15, 0x7e, 0xd7, 0x82, 0xc5, 0x54, 0x45, 0x92, 0x4a, 0xc2, 0xcd, 0x01, 0xd3,
-	0x46, 0x30, 0x81, 0x18, 0xf2, 0x96, 0x96, 0x5f, 0x4a, 0x82, 0x64, 0x2b, 0x18, 0xcd, 0x6b, 0x96,
-	0x62, 0x8a, 0x93, 0xf2, 0xaa, 0xec, 0x65, 0x9e, 0x87, 0x87, 0x19, 0x7b, 0xc6, 0xc0, 0x8f, 0x79,
-	0xe6, 0x9c, 0x5e, 0x91, 0x1c, 0x37, 0x9a, 0x43, 0x96, 0x45, 0x66, 0xe4, 0x1b, 0xa6, 0x87, 0x39,
-	0xde, 0xdc, 0x3a, 0xfb, 0x2f, 0x0a, 0x40, 0xbe, 0x3c, 0xa2, 0xe1, 0x39, 0x66, 0x81, 0xa8, 0x18,
-	0xe4, 0x72, 0x3a, 0xc2, 0x36, 0x39, 0x1c, 0x1d, 0xbd, 0x43, 0xcf, 0x65, 0x06, 0x53, 0x41, 0xcf,
-	0x60, 0x02, 0xe6, 0x1c, 0xab, 0x1c, 0x14, 0x6b, 0xb5, 0x8c, 0x21, 0x89, 0xaa, 0x3f, 0x1a, 0xf0,
-	0x46, 0x73, 0x13, 0x8d, 0x4a, 0x17, 0x27, 0x1a, 0x95, 0x2f, 0x4a, 0x34, 0xfa, 0x04, 0x4c, 0x7b,
-	0x3d, 0x3f, 0x60, 0x62, 0x81, 0x29, 0xf6, 0xa8, 0x39, 0xb9, 0x52, 0x64, 0xce, 0xb0, 0x00, 0xee,
-	0x33, 0x18, 0xf9, 0x5c, 0x82, 0x44, 0xbb, 0x3d, 0x4c, 0x5a, 0xd3, 0x05, 0xc5, 0x4e, 0xb7, 0x47,
-	0xf7, 0x82, 0x8e, 0x1b, 0x07, 0xa1, 0xfa, 0x90, 0xc1, 0x22, 0xe6, 0xf5, 0x47, 0xc1, 0x88, 0x99,
-	0x39, 0x72, 0x29, 0x78, 0xd8, 0xa6, 0xce, 0xa1, 0x07, 0xb8, 0x20, 0xf6, 0x7b, 0x50, 0xd3, 0x9a,
-	0xc0, 0x8c, 0x26, 0x61, 0x42, 0x08, 0x7f, 0xb0, 0xc4, 0x2d, 0x76, 0x9f, 0xf6, 0x1f, 0x74, 0xc9,
-	0xeb, 0x30, 0xd7, 0xf5, 0x42, 0x8a, 0xc9, 0x69, 0xed, 0x90, 0x9e, 0xd2, 0x30, 0x92, 0x9e, 0x73,
-	0x43, 0x55, 0x38, 0x1c, 0x6e, 0xdf, 0x85, 0x79, 0x63, 0x6b, 0x14, 0xe5, 0xca, 0x84, 0x1f, 0x2b,
-	0x9b, 0xf0, 0x23, 0x93, 0x7d, 0xec, 0x5f, 0x28, 0x40, 0x71, 0x37, 0x18, 0xea, 0x47, 0x0c, 0x96,
-	0x79, 0xc4, 0x20, 0x4c, 0xa0, 0xb6, 0xb2, 0x70, 0x84, 0x66, 0x34, 0x80, 0x64, 0x0d, 0x66, 0xdc,
-	0x41, 0xdc, 0x8e, 0x03, 0x66, 0xf2, 0x9d, 0xb9, 0x61, 0x97, 0x93, 0x33, 0x6e, 0x71, 0xaa, 0x86,
-	0x2c, 0x40, 0x51, 0xd9, 0x0a, 0x88, 0xc0, 0x8a, 0xcc, 0xdf, 0xc0, 0xa3, 0xce, 0x73, 0x11, 0x39,
-	0x13, 0x25, 0xc6, 0x2d, 0xe6, 0xf7, 0xdc, 0xd9, 0xe3, 0x12, 0x3f, 0xaf, 0x8a, 0x99, 0x63, 0x8c,
-	0x3a, 0x10, 0x4d, 0xc4, 0x59, 0x65, 0x59, 0x8f, 0x09, 0x57, 0xcc, 0x83, 0xdf, 0x7f, 0xb6, 0xa0,
-	0x8c, 0x6b, 0xc3, 0xb4, 0x17, 0x67, 0x6f, 0x75, 0xca, 0x80, 0x6b, 0x32, 0xed, 0xa4, 0xc1, 0xc4,
-	0x36, 0xd2, 0x1c, 0x0b, 0x6a, 0x42, 0x7a, 0xaa, 0xe3, 0x0a, 0x54, 0x79, 0x49, 0xa5, 0xf4, 0x71,
-	0xba, 0x57, 0x40, 0x72, 0x15, 0x4a, 0x27, 0xc1, 0x50, 0x9a, 0xdb, 0x20, 0x0f, 0xec, 0x82, 0xa1,
-	0x83, 0xf0, 0x64, 0x3c, 0xac, 0x3d, 0x3e, 0x2d, 0x6e, 0x44, 0xa5, 0xc1, 0xcc, 0x8c, 0x54, 0xcd,
-	0xea, 0xcb, 0x94, 0x82, 0xda, 0x6b, 0x30, 0xcb, 0xa8, 0x5e, 0x8b, 0xba, 0x8e, 0x65, 0x65, 0xfb,
-	0x67, 0x2c, 0xa8, 0x48, 0x64, 0xb2, 0x0a, 0x25, 0xc6, 0x42, 0x29, 0xc7, 0x55, 0x1d, 0xd4, 0x33,
-	0x3c, 0x07, 0x31, 0x98, 0x31, 0x81, 0xc1, 0xb0, 0xc4, 0x4f, 0x92, 0xa1, 0xb0, 0xc4, 0x0d, 0x50,
-	0xc3, 0x4d, 0x59, 0xcf, 0x29, 0xa8, 0xfd, 0x5d, 0x0b, 0xa6, 0x8d, 0x3e, 0xc8, 0x0a, 0xd4, 0xfa,
-	0x6e, 0x14, 0x8b, 0xc3, 0x4f, 0xb1, 0x3d, 0x3a, 0x48, 0xdf, 0xe8, 0x82, 0x19, 0xfc, 0x57, 0x11,
-	0xe2, 0xa2, 0x1e, 0x21, 0xbe, 0x05, 0xd5, 0x24, 0x19, 0xb5, 0x64, 0xf0, 0x3e, 0xeb, 0x51, 0xa6,
-	0x20, 0x24, 0x48, 0x18, 0x74, 0x0c, 0xfa, 0x41, 0x28, 0x4e, 0xca, 0x78, 0xc1, 0xbe, 0x0b, 0x35,
-	0x0d, 0x5f, 0x8f, 0x41, 0x5a, 0x46, 0x0c, 0x52, 0xe5, 0xe7, 0x14, 0x92, 0xfc, 0x1c, 0xfb, 0x5f,
-	0x2d, 0x98, 0x66, 0x34, 0xe8, 0xf9, 0xbd, 0x83, 0xa0, 0xef, 0x75, 0xce, 0x71, 0xef, 0x25, 0xb9,
-	0x09, 0x91, 0x28, 0x69, 0xd1, 0x04, 0x33, 0xaa, 0x97, 0x91, 0x0f, 0xc1, 0xa2, 0xaa, 0xcc, 0x78,
-	0x98, 0x71, 0xc0, 0x91, 0x1b, 0x09, 0xb6, 0x10, 0x56, 0x9b, 0x01, 0x64, 0x9c, 0xc6, 0x00, 0x98,
-	0x6d, 0x35, 0xf0, 0xfa, 0x7d, 0x8f, 0xe3, 0x72, 0x9b, 0x3e, 0xaf, 0x8a, 0xf5, 0xd9, 0xf5, 0x22,
-	0xf7, 0x28, 0x39, 0xfd, 0x51, 0x65, 0x0c, 0xcf, 0xb8, 0xcf, 0xb4, 0xf0, 0xcc, 0x24, 0xca, 0x15,
-	0x13, 0x68, 0xff, 0x59, 0x01, 0x6a, 0xd2, 0x44, 0xe8, 0xf6, 0xa8, 0x38, 0xd0, 0x34, 0x05, 0xa3,
-	0x06, 0x91, 0xf5, 0x86, 0x37, 0xa6, 0x41, 0xd2, 0x84, 0x51, 0xcc, 0x12, 0xc6, 0x15, 0xa8, 0x32,
-	0x02, 0x7d, 0x13, 0xdd, 0x3e, 0x91, 0xdf, 0xad, 0x00, 0xb2, 0xf6, 0x36, 0xd6, 0x96, 0x93, 0x5a,
-	0x04, 0xbc, 0xf0, 0xf8, 0xf3, 0x0e, 0xd4, 0x45, 0x33, 0xb8, 0x73, 0x28, 0x79, 0x12, 0x16, 0x31,
-	0x76, 0xd5, 0x31, 0x30, 0xe5, 0x97, 0xb7, 0xe5, 0x97, 0x95, 0x8b, 0xbe, 0x94, 0x98, 0xf6, 0x7d,
-	0x75, 0xaa, 0x7c, 0x3f, 0x74, 0x87, 0x27, 0x92, 0x97, 0x6f, 0xc1, 0xbc, 0xe7, 0x77, 0xfa, 0xa3,
-	0x2e, 0x6d, 0x8f, 0x7c, 0xd7, 0xf7, 0x83, 0x91, 0xdf, 0xa1, 0x32, 0x41, 0x27, 0xaf, 0xca, 0xee,
-	0xaa, 0x74, 0x4e, 0x6c, 0x88, 0xac, 0x41, 0x99, 0xab, 0x4a, 0xae, 0x3b, 0xf2, 0x19, 0x9d, 0xa3,
-	0x90, 0x55, 0x28, 0x73, 0x8d, 0x59, 0x30, 0xb8, 0x46, 0xdb, 0x55, 0x87, 0x23, 0x30, 0xb1, 0x83,
-	0x19, 0xbd, 0xa6, 0xd8, 0x31, 0xf5, 0xce, 0x64, 0x07, 0x73, 0x7e, 0xed, 0x05, 0x20, 0xfb, 0x9c,
-	0x53, 0xf4, 0xb3, 0xa1, 0xef, 0x17, 0xa1, 0xa6, 0x81, 0x99, 0x04, 0xe9, 0xb1, 0x01, 0xb7, 0xbb,
-	0x9e, 0x3b, 0xa0, 0x31, 0x0d, 0x05, 0x77, 0xa4, 0xa0, 0x0c, 0xcf, 0x3d, 0xed, 0xb5, 0x83, 0x51,
-	0xdc, 0xee, 0xd2, 0x5e, 0x48, 0xb9, 0x36, 0x65, 0xaa, 0xc9, 0x80, 0x32, 0x3c, 0x46, 0x9f, 0x1a,
-	0x1e, 0xa7, 0xa0, 0x14, 0x54, 0x9e, 0xf4, 0xf0, 0x35, 0x2a, 0x25, 0x27, 0x3d, 0x7c, 0x45, 0xd2,
-	0xb2, 0xaf, 0x9c, 0x23, 0xfb, 0xde, 0x82, 0x25, 0x2e, 0xe5, 0x84, 0x3c, 0x68, 0xa7, 0x08, 0x6b,
-	0x4c, 0x2d, 0x59, 0x83, 0x06, 0x1b, 0xb3, 0x64, 0x89, 0xc8, 0xfb, 0x06, 0x8f, 0x9a, 0x5a, 0x4e,
-	0x06, 0xce, 0x70, 0x31, 0x7c, 0xa9, 0xe3, 0xf2, 0xe3, 0xf6, 0x0c, 0x1c, 0x71, 0xdd, 0x67, 0x26,
-	0x6e, 0x55, 0xe0, 0xa6, 0xe0, 0xe4, 0x0e, 0x2c, 0x0f, 0x68, 0xd7, 0x73, 0xcd, 0x26, 0x30, 0x02,
-	0xcc, 0x73, 0x6a, 0xc6, 0x55, 0xdb, 0xd3, 0x50, 0x3b, 0x8c, 0x83, 0xa1, 0xdc, 0xce, 0x19, 0xa8,
-	0xf3, 0xa2, 0x48, 0xb1, 0xba, 0x0c, 0x97, 0x90, 0xfe, 0x1e, 0x05, 0xc3, 0xa0, 0x1f, 0xf4, 0xce,
-	0x0d, 0xa7, 0xeb, 0xaf, 0x2c, 0x98, 0x37, 0x6a, 0x13, 0xaf, 0x0b, 0xe3, 0x35, 0x32, 0x37, 0x86,
-	0x93, 0xec, 0x9c, 0x26, 0xbc, 0x39, 0x22, 0x0f, 0x8d, 0x3f, 0x16, 0xe9, 0x32, 0x1b, 0xc9, 0xb5,
-	0x19, 0xf9, 0x21, 0xa7, 0xdf, 0x66, 0x96, 0x7e, 0xc5, 0xf7, 0xf2, 0xd6, 0x8c, 0x6c, 0xe2, 0x0b,
-	0x22, 0xe1, 0x81, 0x3b, 0x61, 0x32, 0x3c, 0xa7, 0xdc, 0x36, 0xdd, 0x49, 0x97, 0x23, 0xe8, 0x28,
-	0x60, 0x64, 0xff, 0xb2, 0x05, 0x90, 0x8c, 0x0e, 0x8f, 0xc9, 0x95, 0x02, 0xe2, 0x57, 0xb4, 0x34,
-	0x65, 0xf3, 0x1a, 0xd4, 0xd5, 0x49, 0x67, 0xa2, 0xd3, 0x6a, 0x12, 0xc6, 0x6c, 0xee, 0x1b, 0x30,
-	0xdb, 0xeb, 0x07, 0x47, 0x68, 0x10, 0x60, 0xce, 0x5e, 0x24, 0x12, 0xcd, 0x66, 0x38, 0xf8, 0x9e,
-	0x80, 0x26, 0x0a, 0xb0, 0xa4, 0x29, 0x40, 0xfb, 0x57, 0x0a, 0xea, 0x60, 0x2a, 0x99, 0xf3, 0x58,
-	0xfe, 0x24, 0xb7, 0x33, 0x82, 0x78, 0xcc, 0x39, 0x10, 0x9a, 0xb5, 0x07, 0x17, 0xc6, 0xc9, 0xee,
-	0xc2, 0x4c, 0xc8, 0x25, 0x9d, 0x14, 0x83, 0xa5, 0x17, 0x88, 0xc1, 0xe9, 0xd0, 0xd0, 0x92, 0x9f,
-	0x82, 0x86, 0xdb, 0x3d, 0xa5, 0x61, 0xec, 0x61, 0xa4, 0x02, 0x4d, 0x14, 0x2e, 0xbc, 0x67, 0x35,
-	0x38, 0x5a, 0x0e, 0x37, 0x60, 0x56, 0x24, 0xf7, 0x29, 0x4c, 0x71, 0xed, 0x21, 0x01, 0x33, 0x44,
-	0xfb, 0x3b, 0xf2, 0x0c, 0xcc, 0xdc, 0xc3, 0xf1, 0x2b, 0xa2, 0xcf, 0xae, 0x90, 0x9a, 0xdd, 0x27,
-	0xc4, 0x79, 0x54, 0x57, 0x86, 0x43, 0x8a, 0x5a, 0x72, 0x4c, 0x57, 0x9c, 0x1f, 0x9a, 0x4b, 0x5a,
-	0x7a, 0x99, 0x25, 0xb5, 0x7f, 0x68, 0xc1, 0xd4, 0x6e, 0x30, 0xdc, 0x15, 0x69, 0x42, 0xc8, 0x08,
-	0x2a, 0xab, 0x56, 0x16, 0x5f, 0x90, 0x40, 0x94, 0x6b, 0x19, 0x4c, 0xa7, 0x2d, 0x83, 0xff, 0x0b,
-	0x97, 0x31, 0x18, 0x17, 0x06, 0xc3, 0x20, 0x64, 0xcc, 0xe8, 0xf6, 0xb9, 0x19, 0x10, 0xf8, 0xf1,
-	0x89, 0x14, 0x80, 0x2f, 0x42, 0x41, 0x0f, 0x99, 0x79, 0x75, 0xdc, 0xa8, 0x17, 0x96, 0x0c, 0x97,
-	0x8b, 0xd9, 0x0a, 0xfb, 0xf3, 0x50, 0x45, 0x53, 0x1c, 0xa7, 0xf5, 0x06, 0x54, 0x4f, 0x82, 0x61,
-	0xfb, 0xc4, 0xf3, 0x63, 0xc9, 0xdc, 0x33, 0x89, 0x8d, 0xbc, 0x8b, 0x0b, 0xa2, 0x10, 0xec, 0xdf,
-	0x98, 0x84, 0xa9, 0x07, 0xfe, 0x69, 0xe0, 0x75, 0xf0, 0xbc, 0x6d, 0x40, 0x07, 0x81, 0xcc, 0x31,
-	0x66, 0xff, 0xc9, 0x15, 0x98, 0xc2, 0xa4, 0xba, 0x21, 0x27, 0xda, 0x3a, 0x3f, 0x17, 0x17, 0x20,
-	0x66, 0x5e, 0x84, 0xc9, 0x6d, 0x10, 0xce, 0x3e, 0x1a, 0x84, 0x39, 0x29, 0xa1, 0x7e, 0x9b, 0x43,
-	0x94, 0x92, 0x1c, 0xee, 0xb2, 0x96, 0xc3, 0xcd, 0xfa, 0x12, 0x69, 0x4d, 0x3c, 0xef, 0x85, 0xf7,
-	0x25, 0x40, 0xe8, 0x58, 0x85, 0x94, 0x07, 0x53, 0xd1, 0x58, 0x99, 0x12, 0x8e, 0x95, 0x0e, 0x64,
-	0x06, 0x0d, 0xff, 0x80, 0xe3, 0x70, 0xf1, 0xad, 0x83, 0x98, 0x89, 0x98, 0xbe, 0xc8, 0x53, 0xe5,
-	0xb4, 0x9f, 0x02, 0x33, 0x19, 0xdf, 0xa5, 0x4a, 0xa0, 0xf2, 0x79, 0x00, 0xbf, 0xf1, 0x92, 0x86,
-	0x6b, 0xee, 0x18, 0xcf, 0x7f, 0x94, 0xee, 0x18, 0x23, 0x18, 0xb7, 0xdf, 0x3f, 0x72, 0x3b, 0x4f,
-	0xf1, 0x9e, 0x16, 0x9e, 0x80, 0x55, 0x1d, 0x13, 0x88, 0xc9, 0x49, 0xc9, 0xae, 0x62, 0x06, 0x41,
-	0xc9, 0xd1, 0x41, 0xe4, 0x36, 0xd4, 0xd0, 0x05, 0x15, 0xfb, 0x3a, 0x83, 0xfb, 0xda, 0xd0, 0x7d,
-	0x54, 0xdc, 0x59, 0x1d, 0x49, 0x3f, 0x0b, 0x9c, 0xcd, 0x64, 0x24, 0xba, 0xdd, 0xae, 0x38, 0x42,
-	0x6d, 0x70, 0x77, 0x5a, 0x01, 0x98, 0x3e, 0x16, 0x0b, 0xc6, 0x11, 0xe6, 0x10, 0xc1, 0x80, 0x91,
-	0xab, 0x50, 0x61, 0xee, 0xd1, 0xd0, 0xf5, 0xba, 0x98, 0xd2, 0xc8, 0xbd, 0x34, 0x05, 0x63, 0x6d,
-	0xc8, 0xff, 0xa8, 0xe8, 0xe6, 0x71, 0x55, 0x0c, 0x18, 0x5b, 0x1b, 0x55, 0x46, 0x66, 0x5a, 0xe0,
-	0x3b, 0x6a, 0x00, 0xc9, 0x9b, 0x78, 0x90, 0x15, 0xd3, 0xe6, 0x22, 0x06, 0xca, 0x2e, 0x8b, 0x39,
-	0x0b, 0xa2, 0x95, 0xbf, 0x87, 0x0c, 0xc5, 0xe1, 0x98, 0xf6, 0x06, 0xd4, 0x75, 0x30, 0xa9, 0x40,
-	0xe9, 0xe1, 0xc1, 0xce, 0x7e, 0x63, 0x82, 0xd4, 0x60, 0xea, 0x70, 0xe7, 0xd1, 0xa3, 0xbd, 0x9d,
-	0xed, 0x86, 0x45, 0xea, 0x50, 0x51, 0x99, 0x64, 0x05, 0x56, 0xda, 0xd8, 0xda, 0xda, 0x39, 0x78,
-	0xb4, 0xb3, 0xdd, 0x28, 0xda, 0x31, 0x90, 0x8d, 0x6e, 0x57, 0xb4, 0xa2, 0x82, 0x04, 0x09, 0x3d,
-	0x5b, 0x06, 0x3d, 0xe7, 0xd0, 0x54, 0x21, 0x9f, 0xa6, 0x5e, 0xb8, 0xf2, 0xf6, 0x0e, 0xd4, 0x0e,
-	0xb4, 0x4b, 0x4b, 0xc8, 0x5e, 0xf2, 0xba, 0x92, 0x60, 0x4b, 0x0d, 0xa2, 0x0d, 0xa7, 0xa0, 0x0f,
-	0xc7, 0xfe, 0x7d, 0x8b, 0xdf, 0x0c, 0x50, 0xc3, 0xe7, 0x7d, 0xdb, 0x50, 0x57, 0xd1, 0xaa, 0x24,
-	0x49, 0xd4, 0x80, 0x31, 0x1c, 0x1c, 0x4a, 0x3b, 0x38, 0x3e, 0x8e, 0xa8, 0x4c, 0xe9, 0x32, 0x60,
-	0x8c, 0x2f, 0x98, 0x6d, 0xc6, 0xec, 0x1c, 0x8f, 0xf7, 0x10, 0x89, 0xd4, 0xae, 0x0c, 0x9c, 0x49,
-	0x79, 0x11, 0x90, 0x91, 0xc9, 0x6c, 0xaa, 0xac, 0x72, 0x59, 0xd3, 0xab, 0xbc, 0x06, 0x15, 0xd5,
-	0xae, 0x29, 0xc0, 0x24, 0xa6, 0xaa, 0x67, 0x82, 0x12, 0xbd, 0x15, 0x63, 0xd0, 0x5c, 0x68, 0x67,
-	0x2b, 0xc8, 0x4d, 0x20, 0xc7, 0x5e, 0x98, 0x46, 0x2f, 0x22, 0x7a, 0x4e, 0x8d, 0xfd, 0x04, 0xe6,
-	0x25, 0x21, 0x69, 0xa6, 0x95, 0xb9, 0x89, 0xd6, 0x45, 0xec, 0x53, 0xc8, 0xb2, 0x8f, 0xfd, 0x9f,
-	0x16, 0x4c, 0x89, 0x9d, 0xce, 0x5c, 0x7c, 0xe3, 0xfb, 0x6c, 0xc0, 0x48, 0xd3, 0xb8, 0xf4, 0x82,
-	0xbc, 0x26, 0x84, 0x66, 0x46, 0x2c, 0x16, 0xf3, 0xc4, 0x22, 0x81, 0xd2, 0xd0, 0x8d, 0x4f, 0xd0,
-	0x53, 0xaf, 0x3a, 0xf8, 0x9f, 0x34, 0x78, 0x5c, 0x89, 0x8b, 0x60, 0x8c, 0x29, 0xe5, 0x5d, 0xf1,
-	0xe3, 0xda, 0x3e, 0x7b, 0xc5, 0xef, 0x0a, 0x54, 0x71, 0x00, 0xed, 0x24, 0x6c, 0x94, 0x00, 0x18,
-	0xe5, 0xf2, 0x02, 0xf2, 0xb5, 0xc8, 0x3f, 0x4f, 0x20, 0xf6, 0x22, 0xdf, 0x79, 0xb1, 0x04, 0xea,
-	0x10, 0x5a, 0xe4, 0x0e, 0x27, 0xe0, 0x84, 0x22, 0xc4, 0x00, 0xd2, 0x14, 0x21, 0x50, 0x1d, 0x55,
-	0x6f, 0xb7, 0xa0, 0xb9, 0x4d, 0xfb, 0x34, 0xa6, 0x1b, 0xfd, 0x7e, 0xba, 0xfd, 0xcb, 0x70, 0x29,
-	0xa7, 0x4e, 0x58, 0xd3, 0x5f, 0x86, 0xc5, 0x0d, 0x9e, 0x67, 0xf9, 0x93, 0x4a, 0xe3, 0xb1, 0x9b,
-	0xb0, 0x94, 0x6e, 0x52, 0x74, 0x76, 0x0f, 0xe6, 0xb6, 0xe9, 0xd1, 0xa8, 0xb7, 0x47, 0x4f, 0x93,
-	0x8e, 0x08, 0x94, 0xa2, 0x93, 0xe0, 0x4c, 0x30, 0x26, 0xfe, 0x27, 0xaf, 0x00, 0xf4, 0x19, 0x4e,
-	0x3b, 0x1a, 0xd2, 0x8e, 0xbc, 0x67, 0x82, 0x90, 0xc3, 0x21, 0xed, 0xd8, 0x6f, 0x01, 0xd1, 0xdb,
-	0x11, 0xeb, 0xc5, 0xb4, 0xe0, 0xe8, 0xa8, 0x1d, 0x9d, 0x47, 0x31, 0x1d, 0xc8, 0x0b, 0x34, 0x3a,
-	0xc8, 0xbe, 0x01, 0xf5, 0x03, 0xf7, 0xdc, 0xa1, 0x1f, 0x8a, 0xfb, 0x8e, 0xcb, 0x30, 0x35, 0x74,
-	0xcf, 0x99, 0x98, 0x52, 0xf1, 0x2c, 0xac, 0xb6, 0xff, 0xbd, 0x00, 0x93, 0x1c, 0x93, 0xb5, 0xda,
-	0xa5, 0x51, 0xec, 0xf9, 0x48, 0x58, 0xb2, 0x55, 0x0d, 0x94, 0x21, 0xe5, 0x42, 0x0e, 0x29, 0x0b,
-	0x6f, 0x4f, 0xe6, 0xec, 0x0b, 0x7a, 0x35, 0x60, 0x8c, 0xb8, 0x92, 0x7c, 0x3a, 0x1e, 0x50, 0x49,
-	0x00, 0xa9, 0xd0, 0x67, 0xa2, 0x6b, 0xf9, 0xf8, 0x24, 0x97, 0x0a, 0xca, 0xd5, 0x41, 0xb9, 0x1a,
-	0x7d, 0x8a, 0x13, 0x78, 0x46, 0xa3, 0x67, 0x34, 0x77, 0xe5, 0x25, 0x34, 0x37, 0x77, 0x01, 0x5f,
-	0xa4, 0xb9, 0xe1, 0x25, 0x34, 0xb7, 0x4d, 0xa0, 0x81, 0x97, 0x01, 0x99, 0x6d, 0x28, 0x69, 0xf7,
-	0x5b, 0x16, 0x34, 0x04, 0x15, 0xa9, 0x3a, 0xf2, 0x9a, 0x61, 0x03, 0xe7, 0x66, 0xc3, 0x5f, 0x83,
-	0x69, 0xb4, 0x4c, 0x55, 0x8c, 0x57, 0x04, 0xa4, 0x0d, 0x20, 0x9b, 0x87, 0x3c, 0x3f, 0x1e, 0x78,
-	0x7d, 0xb1, 0x29, 0x3a, 0x48, 0x86, 0x89, 0x43, 0x57, 0xe4, 0x95, 0x59, 0x8e, 0x2a, 0xdb, 0x7f,
-	0x6e, 0xc1, 0x9c, 0x36, 0x60, 0x41, 0x85, 0x77, 0x41, 0x72, 0x03, 0x0f, 0xf8, 0x72, 0xce, 0x5d,
-	0x36, 0xd9, 0x26, 0xf9, 0xcc, 0x40, 0xc6, 0xcd, 0x74, 0xcf, 0x71, 0x80, 0xd1, 0x68, 0x20, 0x84,
-	0xa8, 0x0e, 0x62, 0x84, 0x74, 0x46, 0xe9, 0x53, 0x85, 0xc2, 0xc5, 0xb8, 0x01, 0xc3, 0xa8, 0x1a,
-	0xb3, 0xa8, 0x15, 0x52, 0x49, 0x44, 0xd5, 0x74, 0xa0, 0xfd, 0xb7, 0x16, 0xcc, 0x73, 0xd7, 0x48,
-	0x38, 0x9e, 0xea, 0xda, 0xd3, 0x24, 0xf7, 0x05, 0x39, 0x47, 0xee, 0x4e, 0x38, 0xa2, 0x4c, 0x3e,
-	0xfb, 0x92, 0xee, 0x9c, 0x4a, 0x76, 0x1b, 0xb3, 0x17, 0xc5, 0xbc, 0xbd, 0x78, 0xc1, 0x4a, 0xe7,
-	0x05, 0x38, 0xcb, 0xb9, 0x01, 0xce, 0xcd, 0x29, 0x28, 0x47, 0x9d, 0x60, 0x48, 0xed, 0x25, 0x58,
-	0x30, 0x27, 0x27, 0x44, 0xd0, 0xb7, 0x2d, 0x68, 0xde, 0xe3, 0x07, 0x01, 0x9e, 0xdf, 0xdb, 0xf5,
-	0xa2, 0x38, 0x08, 0xd5, 0xed, 0xd0, 0xab, 0x00, 0x51, 0xec, 0x86, 0x31, 0xcf, 0xa3, 0x16, 0x81,
-	0xc5, 0x04, 0xc2, 0xc6, 0x48, 0xfd, 0x2e, 0xaf, 0xe5, 0x7b, 0xa3, 0xca, 0x19, 0x1b, 0x42, 0x38,
-	0x6f, 0x86, 0x26, 0xbe, 0xce, 0x93, 0x3f, 0x99, 0xad, 0x40, 0x4f, 0x51, 0xae, 0x73, 0xaf, 0x28,
-	0x05, 0xb5, 0xff, 0xc6, 0x82, 0xd9, 0x64, 0x90, 0x78, 0x2c, 0x6a, 0x4a, 0x07, 0xa1, 0x7e, 0x13,
-	0xe9, 0x20, 0x43, 0x9e, 0x1e, 0xd3, 0xc7, 0x62, 0x6c, 0x1a, 0x04, 0x39, 0x56, 0x94, 0x82, 0x91,
-	0x34, 0x70, 0x74, 0x10, 0x4f, 0xe5, 0x62, 0x96, 0x80, 0xb0, 0x6a, 0x44, 0x09, 0xd3, 0xe0, 0x07,
-	0x31, 0x7e, 0xc5, 0x83, 0xb3, 0xb2, 0x28, 0x55, 0xe9, 0x14, 0x42, 0x51, 0x95, 0xea, 0x87, 0x2a,
-	0x15, 0xbe, 0x3e, 0xb2, 0x6c, 0xff, 0xaa, 0x05, 0x97, 0x72, 0x16, 0x5e, 0x70, 0xcd, 0x36, 0xcc,
-	0x1d, 0xab, 0x4a, 0xb9, 0x38, 0x9c, 0x75, 0x96, 0xe4, 0xa1, 0x9d, 0xb9, 0x20, 0x4e, 0xf6, 0x03,
-	0x65, 0x17, 0xf1, 0xe5, 0x36, 0x92, 0x25, 0xb3, 0x15, 0xf6, 0x01, 0xb4, 0x76, 0x9e, 0x31, 0x26,
-	0xdc, 0xd2, 0x1f, 0x3a, 0x91, 0xb4, 0x70, 0x3b, 0x23, 0x64, 0x2e, 0x76, 0xb4, 0x8f, 0x61, 0xda,
-	0x68, 0x8b, 0x7c, 0xfa, 0x65, 0x1b, 0x49, 0x85, 0xa7, 0xb1, 0xc4, 0x5f, 0x6a, 0x91, 0x29, 0x9b,
-	0x1a, 0xc8, 0x3e, 0x85, 0xd9, 0x77, 0x47, 0xfd, 0xd8, 0x4b, 0x5e, 0x6d, 0x21, 0x9f, 0x15, 0x1f,
-	0x61, 0x13, 0x72, 0xe9, 0x72, 0xbb, 0xd2, 0xf1, 0xd8, 0x8a, 0x0d, 0x58, 0x4b, 0xed, 0x6c, 0x8f,
-	0xd9, 0x0a, 0xfb, 0x12, 0x2c, 0x27, 0x5d, 0xf2, 0xb5, 0x93, 0x82, 0xfa, 0x3b, 0x16, 0xcf, 0x76,
-	0x30, 0x1f, 0x91, 0x21, 0xf7, 0x61, 0x3e, 0xf2, 0xfc, 0x5e, 0x9f, 0xea, 0xed, 0x44, 0x62, 0x25,
-	0x16, 0xcd, 0xe1, 0x89, 0x87, 0x66, 0x9c, 0xbc, 0x2f, 0x18, 0x81, 0xe4, 0x0f, 0x34, 0x21, 0x90,
-	0xd4, 0x92, 0xe4, 0x4d, 0xe0, 0x4b, 0x30, 0x63, 0x76, 0x46, 0xee, 0x88, 0x6c, 0xcb, 0x64, 0x64,
-	0x7a, 0x2c, 0xdb, 0xa4, 0x0c, 0x03, 0xd3, 0xfe, 0xa6, 0x05, 0x4d, 0x87, 0x32, 0x32, 0xa6, 0x5a,
-	0xa7, 0x82, 0x7a, 0xee, 0x66, 0x9a, 0x1d, 0x3f, 0x61, 0x95, 0xc5, 0x29, 0xe7, 0x7a, 0x73, 0xec,
-	0xa6, 0xec, 0x4e, 0xe4, 0xcc, 0x6a, 0xb3, 0x02, 0x93, 0x62, 0x7e, 0xcb, 0xb0, 0x28, 0x86, 0x24,
-	0x87, 0x93, 0x04, 0x4d, 0x8d, 0x4e, 0x8d, 0xa0, 0x69, 0x0b, 0x9a, 0xfc, 0xda, 0xae, 0x3e, 0x0f,
-	0xfe, 0xe1, 0xda, 0x73, 0xa8, 0x69, 0x97, 0x97, 0xc9, 0x32, 0xcc, 0x3f, 0x79, 0xf0, 0x68, 0x7f,
-	0xe7, 0xf0, 0xb0, 0x7d, 0xf0, 0x78, 0xf3, 0x9d, 0x9d, 0xf7, 0xda, 0xbb, 0x1b, 0x87, 0xbb, 0x8d,
-	0x09, 0xb2, 0x04, 0x64, 0x7f, 0xe7, 0xf0, 0xd1, 0xce, 0xb6, 0x01, 0xb7, 0xc8, 0x55, 0x68, 0x3d,
-	0xde, 0x7f, 0x7c, 0xb8, 0xb3, 0xdd, 0xce, 0xfb, 0xae, 0x40, 0x5e, 0x81, 0x4b, 0xa2, 0x3e, 0xe7,
-	0xf3, 0xe2, 0xed, 0x6f, 0x16, 0x61, 0x86, 0x27, 0x5d, 0xf0, 0xb7, 0x87, 0x68, 0x48, 0xde, 0x85,
-	0x29, 0xf1, 0x88, 0x15, 0x91, 0xeb, 0x69, 0x3e, 0x9b, 0xd5, 0x5a, 0x4a, 0x83, 0xc5, 0x22, 0xcc,
-	0xff, 0xec, 0x0f, 0xff, 0xe9, 0xd7, 0x0b, 0xd3, 0xa4, 0xb6, 0x7e, 0xfa, 0xe6, 0x7a, 0x8f, 0xfa,
-	0x11, 0x6b, 0xe3, 0x6b, 0x00, 0xc9, 0xd3, 0x4c, 0xa4, 0xa9, 0x7c, 0xae, 0xd4, 0xbb, 0x55, 0xad,
-	0x4b, 0x39, 0x35, 0xa2, 0xdd, 0x4b, 0xd8, 0xee, 0xbc, 0x3d, 0xc3, 0xda, 0xf5, 0x7c, 0x2f, 0xe6,
-	0xcf, 0x34, 0xbd, 0x6d, 0xad, 0x91, 0x2e, 0xd4, 0xf5, 0x47, 0x93, 0x88, 0x0c, 0xfc, 0xe6, 0x3c,
-	0xfb, 0xd4, 0xba, 0x9c, 0x5b, 0x27, 0x37, 0x10, 0xfb, 0x58, 0xb4, 0x1b, 0xac, 0x8f, 0x11, 0x62,
-	0x24, 0xbd, 0xf4, 0x39, 0x59, 0x27, 0x6f, 0x23, 0x91, 0x2b, 0x1a, 0xa5, 0x65, 0x5e, 0x66, 0x6a,
-	0xbd, 0x32, 0xa6, 0x56, 0xf4, 0xf5, 0x0a, 0xf6, 0xb5, 0x6c, 0x13, 0xd6, 0x57, 0x07, 0x71, 0xe4,
-	0xcb, 0x4c, 0x6f, 0x5b, 0x6b, 0xb7, 0x7f, 0xf3, 0x3a, 0x54, 0xd5, 0x21, 0x0f, 0xf9, 0x00, 0xa6,
-	0x8d, 0xac, 0x18, 0x22, 0xa7, 0x91, 0x97, 0x44, 0xd3, 0xba, 0x92, 0x5f, 0x29, 0x3a, 0xbe, 0x8a,
-	0x1d, 0x37, 0xc9, 0x12, 0xeb, 0x58, 0xa4, 0x95, 0xac, 0x63, 0x7e, 0x17, 0xbf, 0xac, 0xf1, 0x54,
-	0x63, 0x5f, 0xde, 0xd9, 0x95, 0x34, 0x47, 0x19, 0xbd, 0xbd, 0x32, 0xa6, 0x56, 0x74, 0x77, 0x05,
-	0xbb, 0x5b, 0x22, 0x0b, 0x7a, 0x77, 0xea, 0xf0, 0x85, 0xe2, 0x0d, 0x23, 0xfd, 0x59, 0x21, 0xf2,
-	0x8a, 0x22, 0xac, 0xbc, 0xe7, 0x86, 0x14, 0x89, 0x64, 0xdf, 0x1c, 0xb2, 0x9b, 0xd8, 0x15, 0x21,
-	0xb8, 0x7d, 0xfa, 0xab, 0x42, 0xe4, 0x08, 0x6a, 0xda, 0x53, 0x18, 0xe4, 0xd2, 0xd8, 0x67, 0x3b,
-	0x5a, 0xad, 0xbc, 0xaa, 0xbc, 0xa9, 0xe8, 0xed, 0xaf, 0x33, 0xbd, 0xfc, 0x55, 0xa8, 0xaa, 0xc7,
-	0x15, 0xc8, 0xb2, 0xf6, 0xd8, 0x85, 0xfe, 0x18, 0x44, 0xab, 0x99, 0xad, 0xc8, 0x23, 0x3e, 0xbd,
-	0x75, 0x46, 0x7c, 0x4f, 0xa0, 0xa6, 0x3d, 0xa0, 0xa0, 0x26, 0x90, 0x7d, 0xa4, 0x41, 0x4d, 0x20,
-	0xe7, 0xbd, 0x05, 0x7b, 0x0e, 0xbb, 0xa8, 0x91, 0x2a, 0xd2, 0x77, 0xfc, 0x2c, 0x88, 0xc8, 0x1e,
-	0x2c, 0x0a, 0x31, 0x75, 0x44, 0x3f, 0xce, 0x36, 0xe4, 0xbc, 0xe4, 0x74, 0xcb, 0x22, 0x77, 0xa1,
-	0x22, 0xdf, 0xc9, 0x20, 0x4b, 0xf9, 0xef, 0x7d, 0xb4, 0x96, 0x33, 0x70, 0x61, 0x9e, 0xbc, 0x07,
-	0x90, 0xbc, 0xd6, 0xa0, 0x84, 0x44, 0xe6, 0xf5, 0x07, 0x45, 0x01, 0xd9, 0xa7, 0x1d, 0xec, 0x25,
-	0x9c, 0x60, 0x83, 0xa0, 0x90, 0xf0, 0xe9, 0x99, 0xbc, 0x4c, 0xf8, 0x75, 0xa8, 0x69, 0x0f, 0x36,
-	0xa8, 0xe5, 0xcb, 0x3e, 0xf6, 0xa0, 0x96, 0x2f, 0xe7, 0x7d, 0x07, 0xbb, 0x85, 0xad, 0x2f, 0xd8,
-	0xb3, 0xac, 0xf5, 0xc8, 0xeb, 0xf9, 0x03, 0x8e, 0xc0, 0x36, 0xe8, 0x04, 0xa6, 0x8d, 0x57, 0x19,
-	0x14, 0x87, 0xe6, 0xbd, 0xf9, 0xa0, 0x38, 0x34, 0xf7, 0x21, 0x07, 0x49, 0x67, 0xf6, 0x1c, 0xeb,
-	0xe7, 0x14, 0x51, 0xb4, 0x9e, 0xde, 0x87, 0x9a, 0xf6, 0xc2, 0x82, 0x9a, 0x4b, 0xf6, 0x31, 0x07,
-	0x35, 0x97, 0xbc, 0x07, 0x19, 0x16, 0xb0, 0x8f, 0x19, 0x1b, 0x49, 0x01, 0xaf, 0xc5, 0xb1, 0xb6,
-	0x3f, 0x80, 0x19, 0xf3, 0xcd, 0x05, 0xc5, 0xfb, 0xb9, 0xaf, 0x37, 0x28, 0xde, 0x1f, 0xf3, 0x50,
-	0x83, 0x20, 0xe9, 0xb5, 0x79, 0xd5, 0xc9, 0xfa, 0x47, 0x22, 0xf9, 0xe3, 0x39, 0xf9, 0x32, 0x13,
-	0x70, 0xe2, 0x9e, 0x22, 0x59, 0xd6, 0xa8, 0x56, 0xbf, 0xcd, 0xa8, 0xf8, 0x25, 0x73, 0xa5, 0xd1,
-	0x24, 0x66, 0x7e, 0xb1, 0x0f, 0xb5, 0x16, 0xde, 0x57, 0xd4, 0xb4, 0x96, 0x7e, 0xa5, 0x51, 0xd3,
-	0x5a, 0xc6, 0xb5, 0xc6, 0xb4, 0xd6, 0x8a, 0x3d, 0xd6, 0x86, 0x0f, 0xb3, 0xa9, 0xcc, 0x5d, 0xc5,
-	0x15, 0xf9, 0x57, 0x1d, 0x5a, 0x57, 0x5f, 0x9c, 0xf0, 0x6b, 0x4a, 0x10, 0x29, 0x04, 0xd7, 0xe5,
-	0xc5, 0x92, 0xff, 0x0f, 0x75, 0xfd, 0x7e, 0x3b, 0xd1, 0x59, 0x39, 0xdd, 0xd3, 0xe5, 0xdc, 0x3a,
-	0x73, 0x73, 0x49, 0x5d, 0xef, 0x86, 0x7c, 0x05, 0x96, 0x14, 0xab, 0xeb, 0xc9, 0xa0, 0x11, 0x79,
-	0x35, 0x27, 0x45, 0x54, 0x37, 0x5e, 0x5a, 0x97, 0xc6, 0xe6, 0x90, 0xde, 0xb2, 0x18, 0xd1, 0x98,
-	0x17, 0x87, 0x13, 0x85, 0x91, 0x77, 0x5f, 0x3a, 0x51, 0x18, 0xb9, 0xb7, 0x8d, 0x25, 0xd1, 0x90,
-	0x79, 0x63, 0x8d, 0xf8, 0xf9, 0x1c, 0x79, 0x1f, 0x66, 0xb5, 0x74, 0xfb, 0xc3, 0x73, 0xbf, 0xa3,
-	0x18, 0x20, 0x7b, 0x2f, 0xab, 0x95, 0x67, 0x9a, 0xdb, 0xcb, 0xd8, 0xfe, 0x9c, 0x6d, 0x2c, 0x0e,
-	0x23, 0xfe, 0x2d, 0xa8, 0xe9, 0xa9, 0xfc, 0x2f, 0x68, 0x77, 0x59, 0xab, 0xd2, 0xaf, 0x15, 0xdd,
-	0xb2, 0xc8, 0x6f, 0x5b, 0x50, 0x37, 0x12, 0xe3, 0x8d, 0x53, 0xe8, 0x54, 0x3b, 0x4d, 0xbd, 0x4e,
-	0x6f, 0xc8, 0x76, 0x70, 0x90, 0x7b, 0x6b, 0x5f, 0x32, 0x16, 0xe1, 0x23, 0x23, 0xfe, 0x72, 0x33,
-	0xfd, 0x36, 0xd7, 0xf3, 0x34, 0x82, 0x7e, 0x77, 0xed, 0xf9, 0x2d, 0x8b, 0x7c, 0xd7, 0x82, 0x19,
-	0x33, 0x6a, 0xa8, 0xb6, 0x2a, 0x37, 0x3e, 0xa9, 0xb6, 0x6a, 0x4c, 0xa8, 0xf1, 0x7d, 0x1c, 0xe5,
-	0xa3, 0x35, 0xc7, 0x18, 0xa5, 0xb8, 0x52, 0xfe, 0xe3, 0x8d, 0x96, 0xbc, 0xcd, 0x9f, 0xef, 0x93,
-	0xa1, 0x6c, 0xa2, 0x69, 0x8d, 0xf4, 0xf6, 0xea, 0x4f, 0xce, 0xad, 0x5a, 0xb7, 0x2c, 0xf2, 0x75,
-	0xfe, 0x26, 0x95, 0xf8, 0x16, 0xa9, 0xe4, 0x65, 0xbf, 0xb7, 0xaf, 0xe1, 0x9c, 0xae, 0xda, 0x97,
-	0x8c, 0x39, 0xa5, 0xf5, 0xf1, 0x06, 0x1f, 0x9d, 0x78, 0x2d, 0x2e, 0x51, 0x28, 0x99, 0x17, 0xe4,
-	0xc6, 0x0f, 0x72, 0xc0, 0x07, 0x29, 0xd0, 0x0d, 0x52, 0x7e, 0xc9, 0x66, 0xec, 0x35, 0x1c, 0xeb,
-	0x35, 0xfb, 0xd5, 0xb1, 0x63, 0x5d, 0xc7, 0xd8, 0x1f, 0x1b, 0xf1, 0x01, 0x40, 0x72, 0xec, 0x44,
-	0x52, 0xc7, 0x1e, 0x8a, 0xc1, 0xb3, 0x27, 0x53, 0x26, 0xbf, 0xc8, 0xd3, 0x11, 0xd6, 0xe2, 0x57,
-	0xb9, 0xb8, 0x7a, 0x20, 0x0f, 0x4c, 0x74, 0xa3, 0xc4, 0x3c, 0x1f, 0x32, 0x8c, 0x92, 0x74, 0xfb,
-	0x86, 0xb0, 0x52, 0xa7, 0x2f, 0x8f, 0x61, 0x7a, 0x2f, 0x08, 0x9e, 0x8e, 0x86, 0xea, 0x08, 0xd9,
-	0x0c, 0xcb, 0xef, 0xba, 0xd1, 0x49, 0x2b, 0x35, 0x0b, 0x7b, 0x05, 0x9b, 0x6a, 0x91, 0xa6, 0xd6,
-	0xd4, 0xfa, 0x47, 0xc9, 0xb1, 0xd6, 0x73, 0xe2, 0xc2, 0x9c, 0x92, 0x81, 0x6a, 0xe0, 0x2d, 0xb3,
-	0x19, 0x43, 0xf2, 0xa5, 0xbb, 0x30, 0xac, 0x67, 0x39, 0xda, 0xf5, 0x48, 0xb6, 0x79, 0xcb, 0x22,
-	0x07, 0x50, 0xdf, 0xa6, 0x9d, 0xa0, 0x4b, 0x45, 0x6c, 0x7b, 0x3e, 0x19, 0xb8, 0x0a, 0x8a, 0xb7,
-	0xa6, 0x0d, 0xa0, 0xa9, 0x17, 0x86, 0xee, 0x79, 0x48, 0x3f, 0x5c, 0xff, 0x48, 0x44, 0xcd, 0x9f,
-	0x4b, 0xbd, 0x20, 0x8f, 0x15, 0x0c, 0xbd, 0x90, 0x3a, 0x87, 0x30, 0xf4, 0x42, 0xe6, 0x1c, 0xc2,
-	0x58, 0x6a, 0x79, 0xac, 0x41, 0xfa, 0x30, 0x97, 0x39, 0xba, 0x50, 0x2a, 0x61, 0xdc, 0x81, 0x47,
-	0x6b, 0x65, 0x3c, 0x82, 0xd9, 0xdb, 0x9a, 0xd9, 0xdb, 0x21, 0x4c, 0x6f, 0x53, 0xbe, 0x58, 0x3c,
-	0xc3, 0x2d, 0x75, 0xbb, 0x42, 0xcf, 0x9f, 0x4b, 0x0b, 0x70, 0xac, 0x33, 0x15, 0x3f, 0xa6, 0x97,
-	0x91, 0xaf, 0x42, 0xed, 0x3e, 0x8d, 0x65, 0x4a, 0x9b, 0x32, 0x3d, 0x53, 0x39, 0x6e, 0xad, 0x9c,
-	0x8c, 0x38, 0x93, 0x66, 0xb0, 0xb5, 0x75, 0xda, 0xed, 0x51, 0x2e, 0x9c, 0xda, 0x5e, 0xf7, 0x39,
-	0xf9, 0x7f, 0xd8, 0xb8, 0xca, 0xbc, 0x5d, 0xd2, 0xf2, 0x99, 0xf4, 0xc6, 0x67, 0x53, 0xf0, 0xbc,
-	0x96, 0xfd, 0xa0, 0x4b, 0x35, 0x13, 0xc8, 0x87, 0x9a, 0x96, 0x30, 0xae, 0x18, 0x28, 0x9b, 0xdf,
-	0xaf, 0x18, 0x28, 0x27, 0xbf, 0xdc, 0x5e, 0xc5, 0x7e, 0x6c, 0xb2, 0x92, 0xf4, 0xc3, 0x73, 0xca,
-	0x93, 0x9e, 0xd6, 0x3f, 0x72, 0x07, 0xf1, 0x73, 0xf2, 0x04, 0x9f, 0x78, 0xd0, 0xd3, 0xf6, 0x12,
-	0x5b, 0x3a, 0x9d, 0xe1, 0xa7, 0x16, 0x4b, 0xab, 0x32, 0xed, 0x6b, 0xde, 0x15, 0x5a, 0x4a, 0x9f,
-	0x05, 0x38, 0x8c, 0x83, 0xe1, 0xb6, 0x4b, 0x07, 0x81, 0x9f, 0xc8, 0xda, 0x24, 0xc1, 0x2c, 0x91,
-	0x5f, 0x5a, 0x96, 0x19, 0x79, 0xa2, 0x39, 0x1f, 0x46, 0xd6, 0xa3, 0x24, 0xae, 0xb1, 0x39, 0x68,
-	0x6a, 0x41, 0x72, 0xf2, 0xd0, 0x6e, 0x59, 0x64, 0x03, 0x20, 0x39, 0xbb, 0x52, 0xae, 0x44, 0xe6,
-	0x58, 0x4c, 0x89, 0xbd, 0x9c, 0x83, 0xae, 0x03, 0xa8, 0x26, 0x87, 0x21, 0xcb, 0xc9, 0x9d, 0x06,
-	0xe3, 0xe8, 0x44, 0x69, 0xf0, 0xcc, 0x11, 0x85, 0xdd, 0xc0, 0xa5, 0x02, 0x52, 0x61, 0x4b, 0x85,
-	0xe7, 0x0e, 0x1e, 0xcc, 0xf3, 0x01, 0x2a, 0x73, 0x04, 0x53, 0xa6, 0xe4, 0x4c, 0x72, 0x8e, 0x09,
-	0x14, 0x37, 0xe7, 0x46, 0xd9, 0x8d, 0x88, 0x08, 0xa3, 0x56, 0x9e, 0xae, 0xc5, 0x44, 0xf3, 0x00,
-	0xe6, 0x32, 0x61, 0x60, 0xc5, 0xd2, 0xe3, 0x22, 0xf3, 0x8a, 0xa5, 0xc7, 0x46, 0x90, 0xed, 0x45,
-	0xec, 0x72, 0xd6, 0x06, 0xf4, 0x80, 0xce, 0xbc, 0xb8, 0x73, 0xc2, 0xba, 0xfb, 0x8e, 0x05, 0xf3,
-	0x39, 0x51, 0x5e, 0xf2, 0x9a, 0x74, 0xa6, 0xc7, 0x46, 0x80, 0x5b, 0xb9, 0x41, 0x40, 0xfb, 0x10,
-	0xfb, 0x79, 0x97, 0xbc, 0x63, 0x28, 0x36, 0x1e, 0x7f, 0x13, 0x9c, 0xf9, 0x42, 0xa3, 0x22, 0xd7,
-	0xa2, 0xf8, 0x10, 0x96, 0xf9, 0x40, 0x36, 0xfa, 0xfd, 0x54, 0x80, 0xf2, 0x6a, 0xe6, 0x85, 0x6e,
-	0x23, 0xf0, 0xda, 0x1a, 0xff, 0x82, 0xf7, 0x18, 0x73, 0x95, 0x0f, 0x95, 0x8c, 0xa0, 0x91, 0x0e,
-	0xfa, 0x91, 0xf1, 0x6d, 0xb5, 0x5e, 0x35, 0xdc, 0xc2, 0x6c, 0xa0, 0xd0, 0xfe, 0x24, 0x76, 0xf6,
-	0xaa, 0xdd, 0xca, 0x5b, 0x17, 0xee, 0x29, 0xb2, 0xfd, 0xf8, 0x69, 0x15, 0xa1, 0x4c, 0xcd, 0x53,
-	0x76, 0x30, 0x2e, 0xa4, 0xaa, 0x1c, 0xd3, 0xfc, 0x00, 0xe7, 0x75, 0xec, 0x7e, 0xc5, 0xbe, 0x9c,
-	0xd7, 0x7d, 0xc8, 0x3f, 0xe1, 0x2e, 0xea, 0x72, 0x9a, 0xaf, 0xe5, 0x08, 0x56, 0xf2, 0xf6, 0x7b,
-	0xac, 0xaf, 0x91, 0x5a, 0xeb, 0x89, 0x5b, 0xd6, 0xe6, 0x8d, 0xf7, 0x3f, 0xd9, 0xf3, 0xe2, 0x93,
-	0xd1, 0xd1, 0xcd, 0x4e, 0x30, 0x58, 0xef, 0xcb, 0x10, 0x99, 0x48, 0xcf, 0x5d, 0xef, 0xfb, 0xdd,
-	0x75, 0xfc, 0xfe, 0x68, 0x12, 0x1f, 0xfc, 0xff, 0xf4, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb7,
-	0x24, 0x9f, 0x62, 0x22, 0x60, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_b24756c01b185350) }
+
+var fileDescriptor_rpc_b24756c01b185350 = []byte{
+	// 8995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x3d, 0x5b, 0x6c, 0x64, 0xc9,
+	0x55, 0xd3, 0x0f, 0x8f, 0xbb, 0xab, 0xed, 0xb6, 0x7d, 0x6d, 0x8f, 0x3d, 0x3d, 0xbb, 0xb3, 0xb3,
+	0x37, 0x9b, 0xdd, 0xc9, 0x24, 0x8c, 0x77, 0x67, 0x93, 0x65, 0xb3, 0x4b, 0x12, 0x3c, 0xb6, 0x67,
+	0xc6, 0xbb, 0x1e, 0xdb, 0xb9, 0xb6, 0x77, 0xb2, 0x9b, 0xa0, 0xce, 0x75, 0xf7, 0xb5, 0xdd, 0x3b,
+	0xdd, 0x7d, 0x7b, 0xfb, 0x76, 0xdb, 0xe3, 0x0d, 0x83, 0x10, 0x42, 0x80, 0x10, 0x08, 0x42, 0x84,
+	0xc4, 0x43, 0x08, 0x29, 0x41, 0x42, 0xe1, 0x87, 0xaf, 0x20, 0x24, 0x08, 0xf0, 0x87, 0x84, 0x14,
+	0x21, 0xc8, 0x17, 0x42, 0x82, 0x8f, 0xc0, 0x07, 0xca, 0x07, 0x22, 0x12, 0x12, 0x12, 0x20, 0x71,
+	0x1e, 0x55, 0x75, 0xab, 0xee, 0xbd, 0x3d, 0x9e, 0xc9, 0x6e, 0xf8, 0x72, 0xd7, 0xa9, 0xba, 0xf5,
+	0x38, 0x75, 0xce, 0xa9, 0xf3, 0xaa, 0xb2, 0x28, 0xf7, 0x7b, 0x8d, 0xeb, 0xbd, 0x7e, 0x38, 0x08,
+	0x9d, 0xb1, 0x76, 0x17, 0x0a, 0xb5, 0xa7, 0x0e, 0xc3, 0xf0, 0xb0, 0x1d, 0x2c, 0xf9, 0xbd, 0xd6,
+	0x92, 0xdf, 0xed, 0x86, 0x03, 0x7f, 0xd0, 0x0a, 0xbb, 0x11, 0x37, 0x72, 0xbf, 0x2c, 0xaa, 0xb7,
+	0x83, 0xee, 0x4e, 0x10, 0x34, 0xbd, 0xe0, 0xbd, 0x61, 0x10, 0x0d, 0x9c, 0x8f, 0x8b, 0x19, 0x3f,
+	0x78, 0x1f, 0x00, 0xf5, 0x9e, 0x1f, 0x45, 0xbd, 0xa3, 0xbe, 0x1f, 0x05, 0x8b, 0xb9, 0x2b, 0xb9,
+	0xab, 0x13, 0xde, 0x34, 0x57, 0x6c, 0x6b, 0xb8, 0xf3, 0xac, 0x98, 0x88, 0xb0, 0x69, 0xd0, 0x1d,
+	0xf4, 0xc3, 0xde, 0xe9, 0x62, 0x9e, 0xda, 0x55, 0x10, 0xb6, 0xc6, 0x20, 0xb7, 0x2d, 0xa6, 0xf4,
+	0x08, 0x51, 0x0f, 0x46, 0x0e, 0x9c, 0x17, 0xc5, 0x5c, 0xa3, 0xd5, 0x3b, 0x0a, 0xfa, 0x75, 0xfa,
+	0xb8, 0xd3, 0x0d, 0x3a, 0x61, 0xb7, 0xd5, 0x80, 0x51, 0x0a, 0x57, 0xcb, 0x9e, 0xc3, 0x75, 0xf8,
+	0xc5, 0x5d, 0x59, 0xe3, 0xbc, 0x20, 0xa6, 0x82, 0x2e, 0xc3, 0xe1, 0x03, 0xfc, 0x4a, 0x0e, 0x55,
+	0x8d, 0xc1, 0xf8, 0x81, 0xfb, 0xc7, 0x79, 0x31, 0xb3, 0xde, 0x6d, 0x0d, 0xee, 0xf9, 0xed, 0x76,
+	0x30, 0x50, 0x6b, 0x82, 0xcf, 0x4f, 0x08, 0x40, 0x6b, 0x3a, 0x09, 0xfb, 0x4d, 0xb9, 0xa2, 0x2a,
+	0x83, 0xb7, 0x25, 0x74, 0xe4, 0xcc, 0xf2, 0x23, 0x67, 0x96, 0x89, 0xae, 0xc2, 0x08, 0x74, 0xc1,
+	0x3c, 0xfa, 0x41, 0x23, 0x3c, 0x0e, 0xfa, 0xa7, 0xf5, 0x93, 0x56, 0xb7, 0x19, 0x9e, 0x2c, 0x16,
+	0xa1, 0xe9, 0x98, 0x57, 0x55, 0xe0, 0x7b, 0x04, 0x75, 0x6e, 0x8a, 0xa9, 0xc6, 0x11, 0xec, 0x56,
+	0xd0, 0xae, 0xef, 0xfb, 0x8d, 0xfb, 0xc3, 0x5e, 0xb4, 0x38, 0x06, 0x0d, 0x2b, 0x37, 0x2e, 0x5e,
+	0xa7, 0x5d, 0xbd, 0xbe, 0x02, 0xb5, 0x37, 0xa9, 0x66, 0xa7, 0xeb, 0xf7, 0xa2, 0xa3, 0x70, 0xe0,
+	0x55, 0xe5, 0x17, 0x0c, 0x8e, 0x9c, 0x8f, 0x8a, 0x6a, 0x04, 0x9b, 0x1d, 0xb4, 0x83, 0x28, 0xaa,
+	0xb7, 0x00, 0x27, 0x8b, 0xe7, 0xa1, 0x8b, 0x92, 0x37, 0xa9, 0xa1, 0x88, 0x28, 0xf7, 0x75, 0xe1,
+	0x98, 0x08, 0x93, 0x5b, 0x04, 0x1f, 0xfb, 0xcd, 0x4e, 0xab, 0x5b, 0xef, 0xf8, 0x0d, 0xbf, 0x1f,
+	0x86, 0x5d, 0x89, 0xb0, 0x49, 0x82, 0xde, 0x95, 0x40, 0xf7, 0xef, 0x73, 0x62, 0x76, 0xaf, 0xdb,
+	0x0e, 0x1b, 0xf7, 0x7f, 0x48, 0x84, 0x67, 0x60, 0x24, 0xff, 0xb8, 0x18, 0x29, 0x7c, 0x70, 0x8c,
+	0x14, 0xb3, 0x30, 0xf2, 0x19, 0x31, 0x67, 0xaf, 0xe9, 0xc9, 0x70, 0x12, 0x88, 0x79, 0x9c, 0xcb,
+	0x61, 0xa0, 0x16, 0xa9, 0x90, 0xf2, 0x31, 0x31, 0xdd, 0x18, 0xf6, 0xfb, 0xc0, 0x2b, 0x49, 0xac,
+	0x4c, 0x49, 0xb8, 0x46, 0x0b, 0xf0, 0x55, 0x37, 0x38, 0x89, 0x9b, 0x49, 0xbe, 0x02, 0x98, 0x6a,
+	0xe2, 0x2e, 0x8a, 0x0b, 0xc9, 0x61, 0x78, 0x9e, 0xee, 0x7f, 0xe7, 0x44, 0x71, 0x6f, 0xf0, 0x20,
+	0x74, 0xae, 0x8b, 0xe2, 0xe0, 0xb4, 0xc7, 0xdc, 0x5b, 0xbd, 0xe1, 0x48, 0x44, 0x2d, 0x37, 0x9b,
+	0x7d, 0x58, 0xea, 0x2e, 0xd4, 0x78, 0x13, 0x3e, 0x17, 0xea, 0xd8, 0xce, 0x59, 0x14, 0xe3, 0xb2,
+	0x4c, 0x03, 0x96, 0x3d, 0x55, 0x74, 0x2e, 0x0b, 0xe1, 0x77, 0xc2, 0x21, 0xcc, 0x3c, 0xf2, 0x07,
+	0x84, 0xf8, 0x82, 0x67, 0x40, 0x9c, 0xa7, 0x44, 0xb9, 0x77, 0xbf, 0x1e, 0x35, 0xfa, 0xad, 0x1e,
+	0x23, 0xb5, 0xec, 0xc5, 0x00, 0xe0, 0x91, 0x52, 0x38, 0x1c, 0xf4, 0xc2, 0x56, 0x77, 0x20, 0xc9,
+	0x78, 0x4a, 0xce, 0x65, 0x6b, 0x38, 0xd8, 0x46, 0xb0, 0xa7, 0x1b, 0x38, 0xcf, 0x89, 0xc9, 0x46,
+	0xd8, 0x3d, 0x68, 0xf5, 0x3b, 0x2c, 0xa8, 0x88, 0x6a, 0x0b, 0x9e, 0x0d, 0xa4, 0xa9, 0x36, 0x1a,
+	0x38, 0xfe, 0xe2, 0xb8, 0x9c, 0x2a, 0x17, 0xdd, 0x7f, 0xc8, 0x8b, 0xca, 0x6e, 0xdf, 0xef, 0x46,
+	0x7e, 0x03, 0x9b, 0x62, 0xcb, 0xc1, 0x83, 0xfa, 0x91, 0x1f, 0x1d, 0x11, 0x1e, 0xa0, 0xa5, 0x2c,
+	0x3a, 0x17, 0xc4, 0x79, 0x5e, 0x02, 0xad, 0xb6, 0xe0, 0xc9, 0x92, 0xf3, 0x09, 0x31, 0xd3, 0x1d,
+	0x76, 0xea, 0xf6, 0x2c, 0x0a, 0x44, 0x95, 0xe9, 0x0a, 0x44, 0xcd, 0x3e, 0x12, 0x0b, 0x0f, 0xc1,
+	0x6b, 0x37, 0x20, 0x8e, 0x2b, 0x26, 0x64, 0x29, 0x68, 0x1d, 0x1e, 0x31, 0x02, 0xc6, 0x3c, 0x0b,
+	0x86, 0x7d, 0x0c, 0x5a, 0x9d, 0xa0, 0x0e, 0x74, 0xd8, 0xe9, 0xc9, 0x05, 0x1b, 0x10, 0xaa, 0x07,
+	0xc1, 0xdd, 0xae, 0x1f, 0x04, 0x41, 0x44, 0x0b, 0xc6, 0x7a, 0x0d, 0x71, 0x9e, 0x17, 0xd5, 0x26,
+	0x50, 0x58, 0x5d, 0x6e, 0x17, 0xb4, 0x29, 0x91, 0xc0, 0x4a, 0x40, 0x9d, 0xcf, 0x8a, 0x29, 0x89,
+	0xa6, 0x3a, 0xaf, 0x35, 0x5a, 0x2c, 0x43, 0xc3, 0xca, 0x8d, 0x39, 0x45, 0x1b, 0x5c, 0xbb, 0x4c,
+	0x95, 0x5e, 0xb2, 0xb1, 0xbb, 0x2c, 0x26, 0xad, 0x16, 0xe6, 0x36, 0xe4, 0xac, 0x6d, 0x18, 0x85,
+	0x5c, 0x24, 0xdb, 0xdb, 0xc1, 0xc0, 0xd8, 0xa0, 0x48, 0xb2, 0x87, 0xbb, 0x21, 0x1c, 0x03, 0xbc,
+	0x1a, 0x0c, 0xfc, 0x56, 0x3b, 0x72, 0x5e, 0x11, 0x13, 0x03, 0xa3, 0x31, 0x9d, 0x11, 0x15, 0x4d,
+	0xcb, 0xc6, 0x07, 0x9e, 0xd5, 0xce, 0xbd, 0x2d, 0x4a, 0xb7, 0x82, 0x60, 0xa3, 0xd5, 0x69, 0xe1,
+	0x5c, 0xc6, 0x0e, 0x5a, 0x0f, 0x02, 0xe6, 0xb6, 0xc2, 0x9d, 0x73, 0x1e, 0x17, 0x9d, 0x9a, 0x18,
+	0xef, 0x05, 0xfd, 0x46, 0xa0, 0x26, 0x09, 0x35, 0x0a, 0x70, 0x73, 0x5c, 0x8c, 0xb5, 0xf1, 0x63,
+	0xf7, 0x3f, 0x80, 0x9e, 0x76, 0x82, 0xae, 0xe6, 0x62, 0x47, 0x14, 0x11, 0xab, 0x92, 0x73, 0xe9,
+	0xb7, 0xf3, 0x8c, 0xa8, 0x10, 0xa6, 0xa3, 0x41, 0xbf, 0xd5, 0x3d, 0x94, 0xcc, 0x23, 0x10, 0xb4,
+	0x43, 0x10, 0x67, 0x5a, 0x14, 0xfc, 0x8e, 0x62, 0x1c, 0xfc, 0x89, 0x1c, 0xde, 0xf3, 0x4f, 0x3b,
+	0x28, 0x0c, 0x34, 0xe1, 0x00, 0x87, 0x4b, 0xd8, 0x1d, 0xa4, 0x9c, 0xeb, 0x62, 0xd6, 0x6c, 0xa2,
+	0x7a, 0x1f, 0xa3, 0xde, 0x67, 0x8c, 0x96, 0x72, 0x10, 0x90, 0xa5, 0xaa, 0x7d, 0x9f, 0x27, 0x4b,
+	0xa4, 0x04, 0x64, 0x20, 0xc1, 0x6a, 0x09, 0x57, 0xc5, 0xf4, 0x41, 0xab, 0x0b, 0xc4, 0xd3, 0x68,
+	0x0f, 0x8e, 0xeb, 0xcd, 0xa0, 0x3d, 0xf0, 0x89, 0xa8, 0x40, 0xea, 0x12, 0x7c, 0x05, 0xc0, 0xab,
+	0x08, 0x05, 0x56, 0x28, 0x03, 0x81, 0xd5, 0x09, 0x13, 0x40, 0x53, 0x26, 0xeb, 0x2a, 0xec, 0x7a,
+	0xa5, 0x03, 0x85, 0x67, 0xe8, 0x17, 0xd8, 0xf8, 0x10, 0xd8, 0xf8, 0xb0, 0x8e, 0xa2, 0xb7, 0xde,
+	0x6a, 0x02, 0x7d, 0xe5, 0xae, 0x16, 0xbd, 0xaa, 0x82, 0xa3, 0xc8, 0x5a, 0x6f, 0x3a, 0x4f, 0x0b,
+	0x41, 0x63, 0x73, 0xc7, 0x02, 0xda, 0x4c, 0x7a, 0x65, 0x84, 0x50, 0x47, 0xee, 0x9f, 0xe6, 0xc4,
+	0x04, 0xe3, 0x5c, 0x8a, 0x5e, 0x10, 0x0a, 0x6a, 0x69, 0x41, 0xbf, 0x1f, 0xf6, 0x25, 0xb5, 0xd9,
+	0x40, 0xe7, 0x9a, 0x98, 0x56, 0x80, 0x5e, 0x3f, 0x68, 0x75, 0xfc, 0xc3, 0x40, 0x4a, 0xce, 0x14,
+	0xdc, 0xb9, 0x11, 0xf7, 0xd8, 0x87, 0xc9, 0x05, 0xf2, 0x34, 0x99, 0x90, 0xab, 0xf3, 0x10, 0xe6,
+	0xd9, 0x4d, 0x90, 0x95, 0x33, 0xf6, 0xcc, 0x82, 0xb9, 0x7f, 0x95, 0x13, 0xd3, 0x5e, 0xb0, 0xef,
+	0xb7, 0xfd, 0x6e, 0x23, 0x50, 0x08, 0xbf, 0x96, 0x81, 0x98, 0x1c, 0x21, 0x26, 0x05, 0xc7, 0xb6,
+	0xad, 0x6e, 0x23, 0xec, 0x98, 0x6d, 0xf3, 0xdc, 0x36, 0x09, 0x07, 0x84, 0x4f, 0xb5, 0x7d, 0xa0,
+	0xbb, 0xa3, 0xb0, 0x57, 0xef, 0x0d, 0xf7, 0xef, 0x07, 0xa7, 0x52, 0xf5, 0x48, 0x82, 0x15, 0x01,
+	0x16, 0x63, 0x02, 0x04, 0xd6, 0xed, 0xf8, 0x0f, 0x50, 0x7e, 0x10, 0x45, 0x15, 0x3c, 0x55, 0x74,
+	0x0f, 0xc5, 0x8c, 0xb1, 0x02, 0xb9, 0x03, 0xd7, 0x45, 0xb9, 0xaf, 0x80, 0x34, 0xf7, 0xca, 0x8d,
+	0x69, 0x85, 0x2b, 0xdd, 0x38, 0x6e, 0x02, 0xb8, 0x1a, 0x63, 0xbc, 0xe6, 0x33, 0xf0, 0xca, 0x55,
+	0xee, 0x77, 0xf2, 0xa2, 0xec, 0x19, 0x5f, 0xd8, 0xd8, 0xcd, 0xa5, 0xb1, 0x9b, 0x89, 0xc8, 0xfc,
+	0x13, 0x20, 0xb2, 0xf0, 0xf8, 0x88, 0x64, 0x49, 0x9e, 0x42, 0x64, 0x4d, 0x94, 0x00, 0x7b, 0xf5,
+	0x0e, 0x9e, 0x83, 0x8c, 0x37, 0x5d, 0xc6, 0x3a, 0xe4, 0x16, 0xaa, 0x63, 0x21, 0xae, 0xcb, 0x78,
+	0x42, 0x46, 0xc3, 0x46, 0x03, 0xf4, 0x41, 0x90, 0x43, 0xe3, 0xa4, 0x76, 0xc4, 0x00, 0x14, 0xe0,
+	0x07, 0x20, 0xee, 0x86, 0xfd, 0x00, 0x58, 0xd7, 0x8f, 0x40, 0xb5, 0x28, 0x31, 0xe7, 0xda, 0x50,
+	0xec, 0x05, 0x8f, 0x05, 0x3e, 0x27, 0xca, 0x34, 0x44, 0x0c, 0x70, 0x17, 0xc4, 0xfc, 0x46, 0x2b,
+	0x1a, 0x68, 0x94, 0x6a, 0xd1, 0xfa, 0x86, 0xb8, 0x90, 0xac, 0xd0, 0xaa, 0xb8, 0xd0, 0x7b, 0xa6,
+	0x84, 0x6b, 0x7a, 0x5f, 0x8d, 0x36, 0xee, 0xb7, 0x72, 0xc2, 0x41, 0xde, 0xdc, 0x0d, 0x79, 0x2f,
+	0x25, 0x89, 0x3f, 0x9b, 0xb9, 0x7b, 0x8f, 0x23, 0xcf, 0xf2, 0xa3, 0xe4, 0xd9, 0x55, 0x71, 0x9e,
+	0xe8, 0x04, 0x0f, 0xdf, 0x42, 0x92, 0x86, 0x6e, 0xe6, 0x17, 0x73, 0x9e, 0xac, 0x8f, 0x89, 0xad,
+	0x38, 0x9a, 0xd8, 0xbe, 0x0e, 0x32, 0x65, 0x85, 0xf5, 0x41, 0x52, 0x39, 0x60, 0xe9, 0xce, 0xc1,
+	0xb0, 0xdb, 0x44, 0x32, 0x18, 0x3c, 0x68, 0x35, 0xeb, 0xfb, 0xa7, 0x03, 0x42, 0x01, 0xcc, 0x1b,
+	0x0e, 0x82, 0x8c, 0x3a, 0x90, 0x86, 0xd3, 0x16, 0x14, 0x16, 0xc0, 0xb3, 0x87, 0xf6, 0xa9, 0x1a,
+	0xa4, 0x67, 0x54, 0x6a, 0x86, 0x03, 0x50, 0x35, 0x9b, 0xc1, 0x03, 0xa2, 0xbd, 0x49, 0xcf, 0x82,
+	0xdd, 0xac, 0x8a, 0x09, 0xf3, 0x3b, 0xf7, 0x5d, 0x51, 0x52, 0x2a, 0x11, 0x1d, 0xfa, 0x89, 0x79,
+	0x79, 0x06, 0x04, 0xa9, 0xcd, 0x9e, 0x85, 0x57, 0x7a, 0x92, 0xb1, 0xdd, 0xcf, 0x8a, 0xe9, 0x0d,
+	0xd4, 0x3e, 0xba, 0x30, 0xba, 0xd4, 0x09, 0xf1, 0xd4, 0x96, 0xe4, 0xcf, 0x02, 0x56, 0x96, 0xf0,
+	0xd0, 0x3b, 0x0a, 0xa3, 0x81, 0x1c, 0x87, 0x7e, 0xbb, 0x7f, 0x0d, 0x84, 0xb0, 0x16, 0x01, 0xf5,
+	0x81, 0xee, 0x0c, 0x87, 0x81, 0x22, 0x84, 0x2d, 0x31, 0x81, 0xbd, 0xed, 0x86, 0xac, 0x22, 0x48,
+	0x9a, 0xfa, 0xb8, 0xdc, 0x92, 0xf4, 0x07, 0xd7, 0xcd, 0xd6, 0x68, 0x34, 0x9e, 0x7a, 0x56, 0x07,
+	0x78, 0xb8, 0x0e, 0xfc, 0xfe, 0x21, 0xd8, 0x12, 0xa8, 0x78, 0x49, 0xf3, 0x40, 0x30, 0x68, 0x05,
+	0x20, 0xb5, 0xcf, 0x89, 0x99, 0x54, 0x1f, 0x28, 0xf0, 0xe2, 0x65, 0xe0, 0x4f, 0x67, 0x4e, 0x8c,
+	0x1d, 0xfb, 0xed, 0x61, 0x20, 0x15, 0x12, 0x2e, 0xbc, 0x96, 0x7f, 0x35, 0xe7, 0x36, 0xc4, 0xac,
+	0x35, 0x2f, 0xc9, 0x1b, 0x20, 0x21, 0x91, 0x7d, 0x91, 0x9b, 0x73, 0x2c, 0x21, 0x65, 0x11, 0x0e,
+	0x8f, 0x39, 0xf8, 0xd9, 0x87, 0xf6, 0x58, 0xac, 0x83, 0xce, 0x40, 0x7b, 0x22, 0x7b, 0xce, 0xac,
+	0x73, 0xbf, 0x97, 0x13, 0x53, 0xc8, 0x37, 0x77, 0xfd, 0xee, 0xa9, 0xc2, 0xd5, 0x46, 0x26, 0xae,
+	0xae, 0x4a, 0x5c, 0x25, 0x5a, 0x3f, 0x29, 0xa2, 0x0a, 0x49, 0x44, 0x39, 0x57, 0xc0, 0x5a, 0x37,
+	0xa7, 0xcb, 0xf2, 0x4b, 0x00, 0x6c, 0x3b, 0xe8, 0xdf, 0x04, 0xc8, 0x07, 0x47, 0xe5, 0xf3, 0x62,
+	0x3a, 0x9e, 0xb6, 0xc4, 0x23, 0x10, 0x0f, 0x12, 0xa6, 0xec, 0x80, 0x7e, 0xbb, 0x7f, 0x92, 0xe3,
+	0x86, 0x2b, 0x40, 0xea, 0x91, 0xa1, 0x5a, 0xa1, 0xae, 0xaa, 0x1a, 0xe2, 0xef, 0x91, 0x4a, 0xfa,
+	0x07, 0x5f, 0xac, 0x73, 0x51, 0x94, 0x22, 0x98, 0x42, 0x1d, 0xac, 0x3c, 0x69, 0x1a, 0x8f, 0x63,
+	0x79, 0xb9, 0xdd, 0x7e, 0x84, 0x79, 0xf1, 0x82, 0x98, 0x31, 0xe6, 0xfd, 0x88, 0x15, 0x1e, 0x09,
+	0x07, 0x65, 0xee, 0x5e, 0x37, 0xea, 0x19, 0xaa, 0xd7, 0x25, 0x51, 0x46, 0x0b, 0x12, 0xe7, 0xcc,
+	0x3c, 0x3d, 0xe6, 0x95, 0x00, 0x80, 0x33, 0x8e, 0xa8, 0x12, 0xce, 0x60, 0xae, 0xcc, 0xcb, 0x4a,
+	0xff, 0x01, 0x57, 0x1a, 0x53, 0x2a, 0xd8, 0x53, 0x7a, 0x55, 0xcc, 0x5a, 0x23, 0xc9, 0x49, 0x3d,
+	0x2b, 0xc6, 0x86, 0x60, 0x05, 0x2a, 0xa9, 0x5e, 0x91, 0x54, 0x85, 0x96, 0xa1, 0xc7, 0x35, 0xee,
+	0x9e, 0x98, 0xd9, 0x0c, 0x4e, 0x24, 0xf3, 0xab, 0x29, 0x3e, 0x7f, 0xa6, 0xd5, 0x58, 0xd4, 0xd6,
+	0xa2, 0x9c, 0x50, 0xde, 0x9e, 0xd0, 0x75, 0xe1, 0x98, 0xdd, 0xc6, 0xec, 0xa4, 0xac, 0xcb, 0x9c,
+	0x65, 0x5d, 0x02, 0xd1, 0x38, 0x3b, 0xad, 0xc3, 0xee, 0x5d, 0xf8, 0x0d, 0xaa, 0x99, 0x9a, 0x07,
+	0x90, 0x5d, 0x27, 0x3a, 0x94, 0x82, 0x0f, 0x7f, 0xba, 0x2f, 0x8b, 0x59, 0xab, 0x9d, 0xec, 0x18,
+	0x8f, 0x56, 0x00, 0xfb, 0x03, 0x38, 0x28, 0x65, 0xd7, 0x31, 0xc0, 0xbd, 0x25, 0xe6, 0xde, 0x0a,
+	0xfa, 0xad, 0x83, 0xd3, 0xb3, 0xba, 0xb7, 0xfb, 0xc9, 0x27, 0xfb, 0x59, 0x13, 0xf3, 0x89, 0x7e,
+	0xe4, 0xf0, 0xcc, 0x0c, 0x72, 0xf7, 0x4b, 0x1e, 0x17, 0x0c, 0x49, 0x9a, 0x37, 0x25, 0x29, 0xa0,
+	0xdc, 0x81, 0xfd, 0xec, 0x06, 0x0d, 0x20, 0xc3, 0xa0, 0x1f, 0x3b, 0xdd, 0x62, 0xca, 0xaf, 0xdc,
+	0x58, 0x90, 0x38, 0x4f, 0x8a, 0x67, 0xc9, 0x12, 0x40, 0x6d, 0x40, 0xd5, 0x1d, 0xea, 0xb8, 0xe4,
+	0xd1, 0x6f, 0x77, 0x5e, 0xcc, 0x5a, 0xdd, 0x4a, 0x57, 0xc0, 0x4b, 0x62, 0x7e, 0xb5, 0x15, 0x35,
+	0xd2, 0x03, 0xc2, 0x66, 0xc0, 0x84, 0xea, 0x31, 0x5f, 0xab, 0x22, 0x1a, 0x68, 0xc9, 0x4f, 0x64,
+	0x67, 0x6f, 0x89, 0x79, 0x2c, 0x83, 0x05, 0x88, 0x73, 0x19, 0xb6, 0x83, 0xd8, 0xb1, 0x51, 0x6c,
+	0xb7, 0xa4, 0x49, 0x54, 0xbd, 0x31, 0x2f, 0x67, 0x1f, 0xb7, 0x25, 0xdd, 0x83, 0x9a, 0xe0, 0xdc,
+	0xfb, 0xf0, 0xa5, 0x3a, 0x48, 0xf0, 0x37, 0x8e, 0x98, 0xec, 0x57, 0x8e, 0xf8, 0x94, 0xa8, 0xe1,
+	0xb7, 0x76, 0xad, 0xd6, 0x6a, 0x7e, 0x29, 0x27, 0x2e, 0x65, 0x56, 0xcb, 0x8d, 0x01, 0x11, 0x01,
+	0xac, 0x1d, 0x9e, 0xd4, 0x71, 0x94, 0x48, 0x7a, 0x17, 0x05, 0x81, 0xa8, 0x21, 0x5a, 0x21, 0xcd,
+	0xa0, 0x7b, 0x2a, 0xeb, 0xd9, 0xc7, 0x57, 0x46, 0x08, 0x57, 0x7f, 0x4c, 0x4c, 0x03, 0xd6, 0x41,
+	0x17, 0x69, 0xe0, 0x91, 0xb9, 0x0f, 0xa4, 0xcd, 0x0a, 0x64, 0xc9, 0x9b, 0x52, 0xf0, 0x75, 0x06,
+	0xbb, 0xbf, 0x90, 0x13, 0xc5, 0x3b, 0xbb, 0x1b, 0x2b, 0x78, 0x28, 0x2b, 0xe5, 0x52, 0xd2, 0x83,
+	0x2e, 0x8f, 0x14, 0x65, 0x40, 0x77, 0xa4, 0x0f, 0xa1, 0x47, 0x40, 0xea, 0xef, 0x31, 0x00, 0xbd,
+	0x11, 0xc1, 0x83, 0x5e, 0xab, 0x4f, 0xee, 0x06, 0xe5, 0x44, 0x28, 0xd2, 0x79, 0x9e, 0xae, 0x70,
+	0xff, 0x6b, 0x4c, 0x8c, 0x4b, 0x2d, 0x87, 0xc6, 0x03, 0x6b, 0xf8, 0x38, 0x90, 0x33, 0x91, 0x25,
+	0x34, 0xa6, 0xfa, 0x41, 0x27, 0x84, 0x03, 0xca, 0xa2, 0x50, 0x1b, 0x48, 0x7e, 0x18, 0xe9, 0x70,
+	0x63, 0xcf, 0x0d, 0x4b, 0x1d, 0x1b, 0x88, 0x74, 0xa4, 0x74, 0xeb, 0x22, 0xe9, 0xd6, 0xaa, 0x88,
+	0x98, 0x68, 0xf8, 0x3d, 0xbf, 0xd1, 0x1a, 0x9c, 0x2a, 0x45, 0x59, 0x95, 0xb1, 0x6f, 0x58, 0x9b,
+	0x8f, 0xae, 0x3c, 0x36, 0x28, 0xa4, 0x8f, 0xc7, 0x02, 0xa2, 0x52, 0x2c, 0xa7, 0xa4, 0x9a, 0xb1,
+	0xe7, 0x23, 0x01, 0x45, 0x45, 0x09, 0x30, 0x0c, 0x76, 0x23, 0x19, 0x33, 0x25, 0x96, 0xf3, 0x31,
+	0x84, 0x3d, 0x4a, 0x54, 0x3a, 0x61, 0xec, 0x95, 0x95, 0x47, 0xc9, 0x00, 0x62, 0x2f, 0x78, 0xbc,
+	0xe3, 0x79, 0x71, 0xff, 0x84, 0x4c, 0x52, 0xe8, 0x25, 0x86, 0xe0, 0x3e, 0x0c, 0x81, 0xaa, 0x06,
+	0x83, 0x76, 0xd0, 0xd4, 0x13, 0xaa, 0x50, 0xb3, 0x74, 0x05, 0x28, 0x97, 0xb3, 0xec, 0x9f, 0x81,
+	0xf3, 0x26, 0x8c, 0x8e, 0x5a, 0x51, 0x3d, 0x42, 0x37, 0xc3, 0x04, 0xb5, 0xcf, 0xaa, 0x72, 0x5e,
+	0x15, 0x0b, 0x09, 0x70, 0x3f, 0x68, 0x04, 0xb0, 0x5f, 0xcd, 0xc5, 0x49, 0xfa, 0x6a, 0x54, 0x35,
+	0x9c, 0x74, 0x15, 0x74, 0x4b, 0x0d, 0x7b, 0x4d, 0x1f, 0x35, 0xc5, 0x2a, 0xed, 0x83, 0x09, 0x72,
+	0x5e, 0x02, 0x63, 0x37, 0x60, 0x35, 0xf3, 0x68, 0xd0, 0x6e, 0x44, 0x8b, 0x53, 0xd6, 0x91, 0x80,
+	0x94, 0xeb, 0xd9, 0x2d, 0x90, 0x28, 0x1b, 0x11, 0x39, 0x07, 0xfc, 0xd3, 0xc5, 0x69, 0x69, 0xa0,
+	0x2b, 0x00, 0x89, 0x8f, 0x7e, 0xeb, 0x18, 0x3a, 0x5f, 0x9c, 0xe1, 0x93, 0x53, 0x16, 0xf1, 0x3b,
+	0xf4, 0xac, 0xb6, 0x60, 0x96, 0xfd, 0x45, 0x87, 0xed, 0x1c, 0x0d, 0x40, 0x24, 0x12, 0x7d, 0xa0,
+	0xc3, 0x75, 0x18, 0xd5, 0x0f, 0xda, 0xfe, 0x61, 0xb4, 0x38, 0xcb, 0x06, 0x40, 0xaa, 0x82, 0x5a,
+	0xb3, 0xaf, 0x0d, 0x30, 0xab, 0xc8, 0x6c, 0x8e, 0x96, 0x97, 0xae, 0x70, 0x7f, 0x3f, 0xc7, 0xe7,
+	0xa0, 0x24, 0x7f, 0x7d, 0x9e, 0xa1, 0x18, 0x20, 0xc2, 0xaf, 0x87, 0xdd, 0xf6, 0xa9, 0xe4, 0x05,
+	0xc1, 0xa0, 0x2d, 0x80, 0x38, 0x1f, 0x11, 0x93, 0xad, 0xae, 0xd9, 0x84, 0x05, 0xeb, 0x84, 0x02,
+	0x52, 0x23, 0xe8, 0x05, 0x18, 0xa3, 0xdd, 0x6a, 0x70, 0x13, 0x96, 0x03, 0x82, 0x41, 0xd4, 0x00,
+	0x0d, 0x20, 0xc6, 0x01, 0xb7, 0x60, 0xd7, 0x72, 0x45, 0xc2, 0xb0, 0x89, 0x7b, 0x53, 0xcc, 0xd9,
+	0x13, 0x94, 0x82, 0xea, 0x1a, 0xb0, 0x8a, 0x84, 0x01, 0x45, 0xe1, 0xce, 0x54, 0x0d, 0xa7, 0x36,
+	0x80, 0x3d, 0x5d, 0xef, 0x7e, 0xbf, 0x08, 0x92, 0x9e, 0x0b, 0x2b, 0xed, 0x30, 0x0a, 0x76, 0x86,
+	0x9d, 0x8e, 0xdf, 0xcf, 0x60, 0xd7, 0xdc, 0x19, 0xec, 0x9a, 0xb7, 0xd9, 0x15, 0x99, 0xe8, 0xc8,
+	0x07, 0xd5, 0x84, 0xac, 0x37, 0xe6, 0x75, 0x03, 0x82, 0x16, 0x72, 0x03, 0xc6, 0x63, 0x4b, 0xc5,
+	0xf4, 0x75, 0x26, 0xc1, 0x69, 0xf1, 0x32, 0x96, 0x25, 0x5e, 0x4c, 0xf1, 0x70, 0x3e, 0x21, 0x1e,
+	0xc0, 0x7a, 0xc1, 0x4e, 0x03, 0x25, 0xed, 0xc6, 0xd9, 0x7a, 0x31, 0x61, 0x38, 0x9f, 0x24, 0x33,
+	0x32, 0xe7, 0x4f, 0x65, 0xb1, 0x22, 0xba, 0x52, 0x51, 0x9a, 0x1a, 0xad, 0xcb, 0x92, 0x15, 0xd3,
+	0x55, 0xce, 0x2d, 0xf4, 0x4e, 0xe1, 0x58, 0xa4, 0x07, 0x09, 0x3a, 0xd5, 0x9e, 0xb7, 0x77, 0xc4,
+	0xc4, 0xfd, 0x75, 0x2c, 0x80, 0x8a, 0x40, 0xba, 0x91, 0xf1, 0xa5, 0xf3, 0xb2, 0xa8, 0xc0, 0x41,
+	0x11, 0xb6, 0x87, 0xec, 0xba, 0xe4, 0xad, 0x9d, 0xd1, 0xd6, 0xb5, 0xaa, 0xf1, 0xcc, 0x56, 0xee,
+	0x2f, 0xe7, 0x44, 0xc5, 0xe8, 0xd0, 0x99, 0x17, 0x33, 0x2b, 0x5b, 0x5b, 0xdb, 0x6b, 0xde, 0xf2,
+	0xee, 0xfa, 0x5b, 0x6b, 0xf5, 0x95, 0x8d, 0xad, 0x9d, 0xb5, 0xe9, 0x73, 0x08, 0xde, 0xd8, 0x5a,
+	0x59, 0xde, 0xa8, 0xdf, 0xda, 0xf2, 0x56, 0x14, 0x38, 0x07, 0x32, 0xdf, 0xf1, 0xd6, 0xee, 0x6e,
+	0xed, 0xae, 0x59, 0xf0, 0x3c, 0x68, 0x3b, 0x13, 0x37, 0xbd, 0xb5, 0xe5, 0x95, 0x3b, 0x12, 0x52,
+	0x00, 0xb5, 0x65, 0xfa, 0xd6, 0xde, 0xe6, 0xea, 0xfa, 0xe6, 0xed, 0xfa, 0xca, 0xf2, 0xe6, 0xca,
+	0xda, 0xc6, 0xda, 0xea, 0x74, 0xd1, 0x99, 0x14, 0xe5, 0xe5, 0x9b, 0xcb, 0x9b, 0xab, 0x5b, 0x9b,
+	0x50, 0x1c, 0x73, 0x7f, 0x3d, 0x2f, 0x44, 0x3c, 0x51, 0xe7, 0x73, 0x18, 0xad, 0x51, 0xa5, 0xba,
+	0xa1, 0x25, 0xce, 0xa7, 0x16, 0x45, 0xc8, 0x48, 0xb6, 0x06, 0xc3, 0x69, 0x1c, 0x6c, 0x50, 0x10,
+	0xbc, 0xac, 0x01, 0x54, 0x6f, 0x2c, 0xa6, 0x3e, 0xdc, 0xe2, 0x7a, 0x4f, 0x35, 0xb4, 0xa2, 0x07,
+	0x85, 0xb3, 0xa2, 0x07, 0x76, 0xa0, 0x82, 0x8f, 0x24, 0x33, 0x50, 0x01, 0xf5, 0xd1, 0x49, 0x10,
+	0xf4, 0xc8, 0xdc, 0x96, 0x94, 0x69, 0x40, 0x50, 0x96, 0x9e, 0xf8, 0x70, 0x28, 0x48, 0xca, 0x3b,
+	0x4f, 0x94, 0x67, 0x82, 0xdc, 0xef, 0xe5, 0xc5, 0xcc, 0x36, 0x8b, 0xca, 0x0f, 0x13, 0x33, 0xe6,
+	0x2a, 0xf3, 0x4f, 0xb6, 0xca, 0x42, 0x6a, 0x95, 0x20, 0x38, 0xdb, 0xad, 0xce, 0x7e, 0xa8, 0x28,
+	0xda, 0x40, 0x46, 0xba, 0x02, 0x59, 0xa9, 0x83, 0x3a, 0x2e, 0xb0, 0x9e, 0x19, 0xa4, 0x98, 0xf4,
+	0x92, 0x60, 0x54, 0x75, 0x41, 0x40, 0x1f, 0x06, 0x12, 0x2f, 0x5c, 0xc0, 0xef, 0x9b, 0x81, 0xdf,
+	0x6c, 0xb7, 0xba, 0x09, 0x8e, 0x4d, 0x82, 0xad, 0x96, 0x9d, 0x56, 0x14, 0xc1, 0xd9, 0x56, 0x62,
+	0x85, 0x2a, 0x01, 0x76, 0x77, 0xc5, 0xc2, 0xad, 0xb0, 0xdf, 0x08, 0x88, 0xcf, 0xbc, 0xa0, 0x17,
+	0xf6, 0xb5, 0x09, 0xf5, 0xe9, 0x2c, 0x49, 0x57, 0xb9, 0x31, 0x6b, 0x33, 0x28, 0xa3, 0xcc, 0x6e,
+	0xe9, 0xfe, 0x6e, 0x4e, 0x2c, 0xa6, 0xbb, 0x95, 0x52, 0x38, 0xc1, 0xad, 0xb9, 0xc7, 0xe1, 0x56,
+	0xe7, 0x0d, 0x31, 0xab, 0xce, 0x4d, 0xf3, 0xe3, 0x3c, 0x7d, 0xbc, 0xa8, 0x35, 0xe1, 0x04, 0xb9,
+	0x78, 0x59, 0x1f, 0xb9, 0xff, 0x94, 0x13, 0xf3, 0x34, 0xb1, 0x66, 0xf2, 0x08, 0x03, 0xaa, 0x6c,
+	0x84, 0x61, 0x0f, 0xbd, 0x0a, 0xb1, 0x3a, 0x67, 0x82, 0xf0, 0x78, 0x62, 0xe5, 0xe9, 0x00, 0x97,
+	0x27, 0x4f, 0x30, 0x41, 0x20, 0x5a, 0x30, 0x1e, 0x4f, 0x52, 0x00, 0x73, 0x0b, 0x3e, 0xc0, 0x2a,
+	0x0c, 0xe3, 0x26, 0xa0, 0x2f, 0xee, 0xf7, 0x03, 0xbf, 0x71, 0x24, 0xcf, 0x2e, 0x59, 0x42, 0x3d,
+	0x58, 0x39, 0xa9, 0x1a, 0x48, 0x34, 0x20, 0x5c, 0x89, 0x40, 0x60, 0xdb, 0x24, 0x7c, 0x45, 0x82,
+	0xf1, 0xf4, 0xf7, 0xf7, 0xfd, 0x6e, 0x33, 0xec, 0x42, 0x1b, 0xb6, 0xa9, 0x63, 0x80, 0xbb, 0x2d,
+	0x2e, 0x24, 0xd7, 0x27, 0x71, 0xff, 0x8a, 0x71, 0x02, 0x32, 0xe2, 0x6b, 0xa3, 0xe5, 0xad, 0x71,
+	0x1a, 0xfe, 0x73, 0x5e, 0x14, 0x51, 0xfd, 0x1f, 0x6d, 0xcf, 0x98, 0x66, 0x67, 0x21, 0x15, 0xd4,
+	0x24, 0x4f, 0x1a, 0xab, 0x66, 0x52, 0x56, 0xc4, 0x90, 0xb8, 0x1e, 0x34, 0xad, 0x63, 0x5a, 0xb1,
+	0xae, 0x47, 0x08, 0x1e, 0x61, 0xe8, 0x61, 0xa0, 0xaf, 0xe5, 0x11, 0xa6, 0xca, 0xaa, 0x8e, 0xbe,
+	0x1c, 0x8f, 0xeb, 0xe8, 0x3b, 0x98, 0x91, 0x32, 0x27, 0x98, 0xfa, 0x55, 0x91, 0xc2, 0xa8, 0x74,
+	0x94, 0xc2, 0xa1, 0xa4, 0xdc, 0xbb, 0x1a, 0x00, 0xc2, 0xb3, 0x1c, 0x9d, 0x76, 0x1b, 0xe6, 0xa9,
+	0x34, 0x67, 0xd8, 0x5a, 0xd7, 0x77, 0xa0, 0x92, 0x84, 0x4b, 0xdc, 0xcc, 0xfd, 0x9c, 0x28, 0x29,
+	0x30, 0x9e, 0x01, 0x7b, 0x9b, 0x6f, 0x6e, 0x6e, 0xdd, 0xdb, 0xac, 0xef, 0xbc, 0xbd, 0xb9, 0x02,
+	0x87, 0xc8, 0x94, 0xa8, 0x2c, 0xaf, 0xd0, 0xb1, 0x42, 0x80, 0x1c, 0x36, 0xd9, 0x5e, 0xde, 0xd9,
+	0xd1, 0x90, 0xbc, 0xeb, 0xa0, 0x97, 0x90, 0x6d, 0x2c, 0x6d, 0x78, 0xbd, 0x02, 0x67, 0x4f, 0x0c,
+	0x8b, 0xdd, 0x0d, 0x3d, 0x04, 0x24, 0xdc, 0x0d, 0x64, 0x41, 0x72, 0x8d, 0x3b, 0x8d, 0xc9, 0x26,
+	0x60, 0x33, 0x1d, 0x84, 0xaa, 0xa7, 0x3f, 0x2c, 0x62, 0x76, 0x88, 0x04, 0xc9, 0x8e, 0x40, 0x48,
+	0xb4, 0xc0, 0x08, 0x1b, 0xa0, 0xdc, 0xb1, 0x9c, 0x91, 0x49, 0x30, 0x8a, 0x23, 0xb0, 0xb5, 0x7d,
+	0x15, 0xad, 0xe6, 0x02, 0x3a, 0xe7, 0x50, 0xf7, 0x55, 0x1c, 0xa6, 0xe9, 0x8a, 0x7d, 0xa0, 0x99,
+	0x75, 0xa8, 0x23, 0x20, 0x5c, 0x2a, 0x81, 0xfa, 0x13, 0x36, 0xb3, 0xb2, 0xaa, 0x70, 0xab, 0xb8,
+	0x27, 0x5c, 0x32, 0x8b, 0xcb, 0x18, 0x90, 0x0a, 0xfa, 0xb2, 0xbc, 0x4c, 0x05, 0x7d, 0x8d, 0xc0,
+	0x71, 0x29, 0x15, 0x38, 0x46, 0x0d, 0x07, 0xb6, 0x0e, 0xf4, 0x92, 0x41, 0x58, 0x27, 0x4d, 0x8c,
+	0x48, 0x02, 0xb8, 0x2e, 0x01, 0x86, 0xb9, 0x8c, 0x03, 0x51, 0x0e, 0xba, 0x01, 0x87, 0xd2, 0x4a,
+	0xe4, 0x1b, 0x57, 0x20, 0x34, 0xb9, 0x41, 0x88, 0x47, 0x60, 0x7b, 0xa0, 0x7d, 0x4b, 0xbf, 0x9d,
+	0x4f, 0x8a, 0xf9, 0x7d, 0x0c, 0x58, 0x1e, 0x81, 0xd8, 0x05, 0xf3, 0x26, 0x8e, 0x29, 0xb0, 0xa9,
+	0x91, 0x5d, 0x89, 0x84, 0x7b, 0x0c, 0xab, 0x03, 0x61, 0x45, 0x46, 0x06, 0xb0, 0x92, 0x2c, 0x62,
+	0x7f, 0xb8, 0x78, 0xad, 0x46, 0x6b, 0x0c, 0x4e, 0xd1, 0xc2, 0xb3, 0x2b, 0x41, 0x53, 0x3c, 0x4f,
+	0x0b, 0x88, 0xc0, 0xc0, 0x30, 0x1d, 0xfc, 0x2b, 0x08, 0xf4, 0x64, 0xdd, 0x1b, 0xc5, 0x52, 0x65,
+	0x7a, 0xc2, 0xfd, 0x71, 0x31, 0x46, 0x60, 0xdc, 0x74, 0x46, 0x06, 0x13, 0x05, 0x17, 0x70, 0x6a,
+	0xb0, 0xd6, 0x93, 0xb0, 0x7f, 0x5f, 0x39, 0xa3, 0x64, 0xd1, 0x7d, 0x9f, 0x1c, 0x2e, 0x3a, 0x60,
+	0xbf, 0x47, 0x26, 0x11, 0xba, 0xda, 0x18, 0xd5, 0xd1, 0x91, 0x2f, 0x7d, 0x40, 0x25, 0x02, 0xec,
+	0x1c, 0xf9, 0x28, 0x2b, 0xad, 0xdd, 0x63, 0x57, 0x5c, 0x85, 0x60, 0x77, 0x78, 0xf3, 0x9e, 0x13,
+	0x55, 0x95, 0x0a, 0x10, 0xd5, 0xdb, 0xc1, 0xc1, 0x40, 0xb9, 0xd8, 0x01, 0x4a, 0xfe, 0xba, 0x0d,
+	0x80, 0xb9, 0x9b, 0xa0, 0xbb, 0xf1, 0x62, 0xb7, 0x80, 0xe4, 0xe4, 0xd0, 0x1f, 0xe0, 0xfc, 0xf2,
+	0x60, 0x2d, 0x86, 0x3c, 0x94, 0x1d, 0x4a, 0x75, 0x59, 0x05, 0x11, 0x54, 0xe0, 0xcc, 0x84, 0x21,
+	0x7e, 0x28, 0xda, 0x24, 0x53, 0x3b, 0xd0, 0xd5, 0xc9, 0x45, 0xf7, 0x7f, 0xc0, 0x6c, 0xa2, 0xde,
+	0x94, 0xad, 0x21, 0xcf, 0x9c, 0x57, 0x9f, 0x60, 0x9a, 0x13, 0x0d, 0x33, 0xb0, 0x02, 0x3b, 0x64,
+	0x9e, 0x42, 0x5c, 0x78, 0x72, 0x87, 0x6d, 0x31, 0xe5, 0xb0, 0x85, 0x83, 0x08, 0xcc, 0xcf, 0x16,
+	0x25, 0x0b, 0x29, 0x99, 0xce, 0x2a, 0xdc, 0x94, 0x82, 0xab, 0x40, 0xc6, 0x0b, 0x62, 0x1a, 0x5d,
+	0xa9, 0x56, 0x87, 0xd2, 0xc9, 0x00, 0xf0, 0x1d, 0xdd, 0xa7, 0xfb, 0x5b, 0x39, 0xd8, 0x23, 0x3a,
+	0x5c, 0xc8, 0xf2, 0x94, 0x28, 0xfd, 0x09, 0x58, 0x3c, 0xe9, 0xf1, 0x52, 0x52, 0xc8, 0xc5, 0xcf,
+	0xd9, 0x07, 0x3a, 0x37, 0xbe, 0x73, 0xce, 0xb3, 0x1b, 0x3b, 0xaf, 0x93, 0x2d, 0xd5, 0xad, 0x13,
+	0x34, 0x23, 0x4d, 0xc9, 0xde, 0x3f, 0xf8, 0xde, 0x68, 0x7e, 0xb3, 0x24, 0xce, 0xb3, 0xd9, 0xee,
+	0xde, 0x16, 0x93, 0xd6, 0x40, 0x96, 0x9b, 0x79, 0x82, 0xdd, 0xcc, 0xa9, 0x48, 0x4f, 0x3e, 0x23,
+	0xd2, 0xf3, 0xb5, 0xa2, 0x70, 0x90, 0x00, 0x13, 0x3b, 0x8c, 0x7e, 0x83, 0xb0, 0x69, 0x79, 0x81,
+	0x30, 0xc7, 0x28, 0x06, 0x39, 0xd7, 0x85, 0x63, 0x14, 0x55, 0xc0, 0x8e, 0x8f, 0xd1, 0x8c, 0x1a,
+	0x14, 0xbd, 0x52, 0x0b, 0x91, 0xfa, 0x82, 0xf4, 0x77, 0xf1, 0x56, 0x66, 0xd6, 0xe1, 0x49, 0xd9,
+	0x1b, 0x62, 0x34, 0x30, 0x0e, 0xa8, 0xaa, 0x72, 0x92, 0x66, 0xce, 0x9f, 0x49, 0x33, 0xe3, 0x29,
+	0x9a, 0x31, 0x3c, 0x15, 0x25, 0xdb, 0x53, 0x01, 0x76, 0x2a, 0xba, 0xe2, 0xd1, 0xdd, 0xc1, 0x21,
+	0x5b, 0xe9, 0x16, 0xb2, 0x80, 0x18, 0x45, 0x96, 0x7a, 0x53, 0xec, 0x0e, 0xe1, 0x7c, 0x85, 0x14,
+	0x1c, 0xcf, 0x84, 0xd8, 0xb9, 0x5f, 0xa1, 0xc9, 0xc6, 0x00, 0x54, 0xca, 0xd1, 0x41, 0xdf, 0xac,
+	0x0f, 0xbb, 0xda, 0x79, 0x41, 0x0e, 0xa1, 0x92, 0x97, 0xae, 0x20, 0x7b, 0x9e, 0x88, 0x4a, 0x11,
+	0xfa, 0xa4, 0xb4, 0xe7, 0x4d, 0x20, 0x8e, 0xf8, 0x7e, 0xd0, 0x0f, 0x19, 0x3d, 0x55, 0xd6, 0xb7,
+	0x34, 0x00, 0x6b, 0x9b, 0x43, 0x89, 0x71, 0x92, 0xc4, 0x50, 0xab, 0x01, 0xee, 0x6f, 0xe4, 0xc4,
+	0x34, 0x52, 0x85, 0x45, 0xf8, 0xaf, 0x09, 0xe2, 0xe5, 0xc7, 0xa4, 0x7b, 0xab, 0x2d, 0x48, 0x8c,
+	0x32, 0x95, 0x41, 0x2b, 0xed, 0x4a, 0xaa, 0x5f, 0xb4, 0xa9, 0x3e, 0x96, 0x82, 0xf0, 0x71, 0xdc,
+	0xd8, 0xa0, 0xf9, 0xbf, 0x05, 0xeb, 0x57, 0x8e, 0xf2, 0x43, 0xfb, 0x53, 0x6b, 0x09, 0x83, 0xb1,
+	0x6c, 0x58, 0x4e, 0x19, 0xb6, 0x4e, 0x31, 0xdb, 0xd6, 0x01, 0x95, 0x80, 0x04, 0x7e, 0x04, 0x07,
+	0x60, 0xbb, 0xae, 0x6a, 0x65, 0xfa, 0x56, 0x56, 0x55, 0xb6, 0x75, 0xc4, 0xde, 0x6d, 0xd6, 0x92,
+	0x6d, 0xad, 0xde, 0xfd, 0xf6, 0x84, 0x58, 0x48, 0x55, 0xe9, 0xb8, 0xbc, 0x74, 0x12, 0x5a, 0xe6,
+	0x9a, 0x8c, 0x43, 0x66, 0x55, 0x39, 0x87, 0x62, 0x5e, 0xa9, 0x35, 0x88, 0xd3, 0xf8, 0x08, 0x66,
+	0x5b, 0xe4, 0x25, 0x7b, 0x0b, 0x93, 0x03, 0x2a, 0xb8, 0x29, 0x26, 0xb2, 0xfb, 0x73, 0x8e, 0xc4,
+	0xa2, 0xd6, 0x9f, 0xe4, 0x11, 0x63, 0xe8, 0x58, 0x38, 0xd6, 0x27, 0xce, 0x18, 0xcb, 0x32, 0x02,
+	0xbc, 0x91, 0xbd, 0x39, 0xa7, 0xe2, 0xb2, 0xaa, 0xa3, 0x33, 0x24, 0x3d, 0x5e, 0xf1, 0xb1, 0xd6,
+	0x16, 0x9b, 0x7c, 0x7a, 0xd0, 0x33, 0x3a, 0x76, 0xde, 0x15, 0x17, 0xd0, 0xe8, 0x57, 0xd3, 0x32,
+	0x34, 0x9a, 0x31, 0x1a, 0xf2, 0xc6, 0x19, 0x43, 0xde, 0xe3, 0x8f, 0xad, 0x83, 0x75, 0x44, 0x8f,
+	0xb5, 0xbf, 0xc9, 0x89, 0xaa, 0xdd, 0x0f, 0x92, 0xa9, 0x94, 0x2e, 0x4a, 0xca, 0x2a, 0x1d, 0x38,
+	0x01, 0x4e, 0xfb, 0xfd, 0xf2, 0x59, 0x7e, 0x3f, 0xd3, 0xdb, 0x56, 0x38, 0xcb, 0x19, 0x5f, 0x7c,
+	0x3c, 0x67, 0xfc, 0x58, 0x96, 0x33, 0xbe, 0xf6, 0x9f, 0x39, 0xe1, 0xa4, 0x69, 0xc9, 0xb9, 0xcd,
+	0x8e, 0x47, 0xf8, 0x29, 0x45, 0xca, 0x8f, 0x3d, 0x1e, 0x3d, 0x2a, 0xdc, 0xa9, 0xaf, 0x91, 0x31,
+	0xcc, 0xfc, 0x4b, 0x53, 0x45, 0x03, 0x4d, 0x3d, 0xa3, 0x2a, 0x11, 0x1e, 0x28, 0x9e, 0x1d, 0x1e,
+	0x18, 0x3b, 0x3b, 0x3c, 0x70, 0x3e, 0x19, 0x1e, 0xa8, 0xfd, 0x3c, 0xa8, 0x51, 0x19, 0x9b, 0xfe,
+	0xe1, 0x2d, 0x1c, 0xb7, 0xc9, 0x92, 0x05, 0x79, 0xb9, 0x4d, 0x26, 0xb0, 0xf6, 0xd3, 0x62, 0xd2,
+	0x22, 0xf4, 0x0f, 0x6f, 0xfc, 0xa4, 0x96, 0xc9, 0x74, 0x66, 0xc1, 0x6a, 0xdf, 0xcf, 0x0b, 0x27,
+	0xcd, 0x6c, 0xff, 0xaf, 0x73, 0x48, 0xe3, 0xa9, 0x90, 0x81, 0xa7, 0x1f, 0xe9, 0x39, 0x00, 0x07,
+	0xbd, 0x4c, 0x5e, 0x37, 0xdc, 0xcd, 0x4c, 0x31, 0xe9, 0x0a, 0xd4, 0xb3, 0xed, 0xd8, 0x4c, 0xc9,
+	0xca, 0x70, 0x35, 0x0e, 0xc3, 0x44, 0x88, 0xc6, 0xad, 0x89, 0x45, 0x89, 0xa1, 0xb5, 0x63, 0x30,
+	0x8c, 0x77, 0x86, 0xfb, 0x9c, 0x6f, 0x0d, 0xb4, 0xef, 0x7e, 0xab, 0xa0, 0x4d, 0x05, 0xaa, 0x94,
+	0xc7, 0xfb, 0x27, 0x41, 0x5b, 0x34, 0x84, 0xb9, 0xdc, 0x8e, 0x44, 0xb4, 0x01, 0x0f, 0x76, 0xb3,
+	0x95, 0xb3, 0x2a, 0xaa, 0x24, 0xb2, 0x9a, 0xfa, 0x3b, 0xf6, 0x50, 0x3e, 0xc2, 0x47, 0x03, 0x7d,
+	0x24, 0xbe, 0x71, 0x3e, 0x23, 0xaa, 0xb6, 0x01, 0x28, 0x75, 0x84, 0x2c, 0x8b, 0x02, 0x3f, 0xb7,
+	0x1b, 0x3b, 0xcb, 0x98, 0xce, 0x97, 0xe8, 0xa0, 0xf8, 0xa8, 0x0e, 0x52, 0xcd, 0x01, 0xd5, 0x9c,
+	0xd9, 0x30, 0x46, 0xbe, 0x93, 0xe7, 0xec, 0xcf, 0x0c, 0x34, 0x5d, 0xe7, 0x3f, 0x71, 0xae, 0x83,
+	0xfb, 0x25, 0x21, 0x62, 0x18, 0x7a, 0x49, 0xb6, 0xb6, 0xd7, 0x36, 0xeb, 0x2b, 0x77, 0x96, 0x37,
+	0x37, 0xd7, 0x36, 0xa6, 0xcf, 0x81, 0x66, 0x5e, 0x25, 0xbf, 0xfa, 0xaa, 0x86, 0xe5, 0x10, 0x26,
+	0x9d, 0x2b, 0x0a, 0x96, 0x47, 0xa7, 0xfb, 0xfa, 0x66, 0x02, 0x5a, 0xb8, 0x59, 0xd6, 0xfc, 0xe1,
+	0x5e, 0x14, 0x0b, 0x37, 0xc9, 0xeb, 0x96, 0xde, 0xd2, 0x7f, 0xcd, 0x8b, 0x8a, 0x51, 0x07, 0x9a,
+	0xa9, 0x99, 0xa7, 0x71, 0x41, 0xae, 0xc6, 0x68, 0x61, 0xe4, 0x6a, 0x3c, 0xde, 0x29, 0xa1, 0xec,
+	0x8b, 0x42, 0x9c, 0xc6, 0x62, 0x29, 0x53, 0xc5, 0x84, 0x32, 0x05, 0x32, 0x91, 0x0d, 0x7b, 0x30,
+	0x35, 0x94, 0x13, 0xc4, 0x80, 0x90, 0xb3, 0x5d, 0x6a, 0xe7, 0xb1, 0xcc, 0x8c, 0x21, 0x14, 0x8d,
+	0x6a, 0xfb, 0xad, 0x8e, 0xce, 0x88, 0x54, 0x45, 0x64, 0xf4, 0x08, 0x38, 0x05, 0x74, 0xe2, 0x76,
+	0x70, 0x0c, 0x1b, 0xcd, 0xaa, 0xbb, 0x05, 0x63, 0x36, 0x3b, 0x0e, 0x31, 0x70, 0x83, 0xfa, 0x6c,
+	0x54, 0x8f, 0x75, 0xf8, 0x74, 0x05, 0x32, 0xbc, 0x4c, 0x98, 0xd7, 0x6d, 0x39, 0xc6, 0x9b, 0x04,
+	0xbb, 0x2f, 0x8a, 0x39, 0xbe, 0xf8, 0x71, 0xd3, 0x4e, 0xe2, 0x1d, 0x99, 0xeb, 0xee, 0xfe, 0x5e,
+	0x4e, 0xcc, 0x27, 0x3e, 0x89, 0xf3, 0x96, 0xb9, 0x7b, 0x5b, 0x81, 0xb3, 0x81, 0x76, 0x9c, 0xd3,
+	0x16, 0xef, 0xe9, 0x0a, 0x14, 0x48, 0x86, 0xa1, 0x90, 0x10, 0x73, 0x59, 0x55, 0x98, 0x17, 0xba,
+	0xa2, 0x6e, 0xc2, 0x98, 0x4b, 0x72, 0x0f, 0xf8, 0x0e, 0x89, 0x59, 0x11, 0x27, 0xeb, 0xd8, 0x53,
+	0x56, 0x45, 0xb4, 0xf1, 0x2c, 0xbd, 0xd0, 0x9e, 0x6f, 0x66, 0x9d, 0xfb, 0x17, 0x70, 0x2e, 0x7c,
+	0x7e, 0x08, 0xe6, 0x39, 0x65, 0x64, 0x6a, 0xb7, 0xf6, 0x42, 0xd2, 0x69, 0x8b, 0x49, 0x32, 0x6f,
+	0xc6, 0xd9, 0xca, 0x79, 0x33, 0x5d, 0x9e, 0x08, 0x4b, 0xe7, 0x83, 0x82, 0xf0, 0x25, 0x2f, 0x17,
+	0xfa, 0xdc, 0xb8, 0xd3, 0xcc, 0xac, 0xf6, 0xe2, 0xd9, 0x59, 0xed, 0x63, 0x67, 0x65, 0xb5, 0x63,
+	0x78, 0xf8, 0xb0, 0x1b, 0xa2, 0xcc, 0x46, 0xad, 0x0b, 0x2f, 0xa4, 0x14, 0xd0, 0xbf, 0x22, 0x81,
+	0x9b, 0x08, 0x73, 0x7e, 0x3c, 0x6e, 0x14, 0x34, 0x0f, 0xe9, 0x92, 0x86, 0x29, 0xc5, 0xd7, 0x00,
+	0xb6, 0x01, 0xfa, 0xd4, 0x20, 0xec, 0xeb, 0x0f, 0x11, 0x86, 0x3e, 0xb0, 0x6a, 0x14, 0x0e, 0x51,
+	0x07, 0x55, 0xa8, 0x60, 0x4f, 0xe0, 0x04, 0x43, 0xb7, 0x09, 0x21, 0xee, 0xdb, 0xa2, 0x62, 0x74,
+	0x41, 0xe9, 0xf3, 0x92, 0x73, 0x75, 0x26, 0x79, 0x59, 0x42, 0xd6, 0x9b, 0x78, 0x27, 0xad, 0xd9,
+	0x82, 0x93, 0x86, 0xb4, 0x20, 0x60, 0x85, 0xa0, 0x1f, 0x29, 0x67, 0xcc, 0xb4, 0xae, 0xf0, 0x18,
+	0xee, 0xbe, 0x2e, 0x66, 0xad, 0xad, 0xd1, 0x94, 0xab, 0x92, 0x6f, 0x73, 0xe9, 0xe4, 0x5b, 0x95,
+	0x78, 0xeb, 0xfe, 0x62, 0x5e, 0x14, 0xee, 0x84, 0x3d, 0x33, 0xae, 0x9c, 0xb3, 0xe3, 0xca, 0x52,
+	0xf2, 0xd4, 0xb5, 0xfa, 0x29, 0xd5, 0x16, 0x0b, 0x08, 0xb2, 0xac, 0x8a, 0x59, 0xd4, 0x83, 0x10,
+	0xf5, 0xf1, 0x13, 0xbf, 0xcf, 0x32, 0xa8, 0x40, 0x5b, 0x9c, 0xa8, 0x01, 0x19, 0x5a, 0xd0, 0x8a,
+	0x1c, 0x35, 0xc0, 0x22, 0x1a, 0x83, 0x94, 0x0d, 0x73, 0x2a, 0xe5, 0x90, 0x2c, 0x21, 0xb7, 0xd8,
+	0xdf, 0x9b, 0xe9, 0xd9, 0x59, 0x55, 0x56, 0x16, 0xf7, 0x78, 0x22, 0x8b, 0xdb, 0x08, 0x33, 0x94,
+	0xec, 0xb4, 0xa9, 0x7f, 0xcb, 0x89, 0x31, 0xc2, 0x4d, 0x2c, 0x69, 0x74, 0x68, 0x99, 0x70, 0x32,
+	0xe9, 0x25, 0xc1, 0x20, 0xe5, 0xcc, 0x6b, 0x3d, 0x79, 0xbd, 0x20, 0xf3, 0x6a, 0xcf, 0x15, 0x51,
+	0xe6, 0x92, 0xbe, 0x3f, 0xc2, 0x74, 0xaf, 0x81, 0x20, 0x65, 0x8b, 0x47, 0x61, 0x4f, 0xd9, 0x42,
+	0x42, 0xe5, 0x74, 0x84, 0x3d, 0x8f, 0xe0, 0x86, 0xe4, 0x83, 0xfe, 0xcc, 0xc4, 0xf5, 0x24, 0x18,
+	0x75, 0x7c, 0xdd, 0xad, 0x89, 0xa6, 0x04, 0xd4, 0xbd, 0x26, 0xa6, 0x90, 0xea, 0x0d, 0x47, 0xfe,
+	0x48, 0x56, 0x76, 0x7f, 0x36, 0x27, 0x4a, 0xaa, 0x31, 0x4c, 0xa5, 0x88, 0x2c, 0x94, 0xf0, 0x2a,
+	0xe8, 0x34, 0x37, 0x6c, 0xe7, 0x51, 0x0b, 0x3c, 0x00, 0xc8, 0xbf, 0x1a, 0x1b, 0xb1, 0xca, 0xbb,
+	0x1a, 0xdb, 0x68, 0x7a, 0xba, 0x09, 0xd3, 0x26, 0x01, 0x75, 0xbf, 0x99, 0x13, 0x93, 0xd6, 0x18,
+	0xe8, 0xf9, 0xa2, 0xbc, 0x7e, 0x76, 0x3a, 0xc8, 0xed, 0x31, 0x41, 0xe6, 0x46, 0xe7, 0xed, 0x78,
+	0x92, 0x0e, 0x3a, 0x14, 0xcc, 0xa0, 0xc3, 0x8b, 0xa2, 0x1c, 0x5f, 0xbe, 0x2a, 0x5a, 0xbc, 0x8f,
+	0x23, 0xaa, 0x04, 0xbe, 0xb8, 0x11, 0xf9, 0xb1, 0xc3, 0x76, 0xd8, 0x97, 0x1e, 0x4c, 0x2e, 0x00,
+	0x37, 0x56, 0x8c, 0xf6, 0xa6, 0x5b, 0x3b, 0x67, 0xb9, 0xb5, 0x75, 0xae, 0x6c, 0x3e, 0xce, 0x95,
+	0x75, 0xff, 0x1d, 0x16, 0x8a, 0x34, 0x08, 0xcb, 0xdc, 0x0e, 0xdb, 0xad, 0xc6, 0x29, 0xed, 0xbd,
+	0x22, 0x37, 0x29, 0x12, 0x15, 0x2d, 0xda, 0x60, 0xa4, 0x7a, 0xe5, 0xf8, 0x92, 0x2c, 0xaa, 0xcb,
+	0xc8, 0xc3, 0xc8, 0x01, 0xfb, 0x7e, 0x24, 0xd9, 0x42, 0xaa, 0xd4, 0x16, 0x10, 0x39, 0x0d, 0x01,
+	0x94, 0xf9, 0xdc, 0x69, 0xb5, 0xdb, 0x2d, 0x6e, 0xcb, 0x06, 0x57, 0x56, 0x15, 0x8e, 0xd9, 0x6c,
+	0x45, 0xfe, 0x7e, 0x1c, 0x50, 0xd4, 0x65, 0xf2, 0xce, 0xf9, 0x0f, 0x0c, 0xef, 0xdc, 0x79, 0x92,
+	0x2b, 0x36, 0xd0, 0xfd, 0x33, 0xd0, 0x89, 0x94, 0xfe, 0x06, 0xf2, 0x51, 0x66, 0xb1, 0xd8, 0x82,
+	0xd1, 0x80, 0xa8, 0x7a, 0x4b, 0x09, 0x32, 0x20, 0x49, 0xc2, 0x28, 0xa4, 0x09, 0x03, 0xe3, 0x3e,
+	0xb0, 0x41, 0x2f, 0x91, 0x4d, 0x2e, 0x6f, 0x3a, 0x6a, 0x80, 0xaa, 0xbd, 0x41, 0xb5, 0x63, 0x71,
+	0x2d, 0x01, 0x1e, 0x99, 0xf3, 0xf2, 0x2a, 0x10, 0x3c, 0x77, 0x43, 0x3b, 0x47, 0x92, 0x27, 0x66,
+	0x11, 0x6b, 0x57, 0x3d, 0xab, 0xa5, 0xfa, 0xf2, 0x86, 0xfa, 0xb2, 0x74, 0xd6, 0x97, 0xaa, 0xa5,
+	0x7b, 0x5b, 0xa7, 0x12, 0xdd, 0xee, 0xfb, 0xbd, 0x23, 0xc5, 0xcb, 0xb0, 0x91, 0xad, 0x6e, 0xa3,
+	0x3d, 0x6c, 0x06, 0xf5, 0x61, 0x17, 0xaf, 0x8c, 0x0f, 0x31, 0xdc, 0x24, 0xdd, 0x6f, 0x59, 0x55,
+	0x6e, 0x53, 0x5f, 0xad, 0xa0, 0x8e, 0x40, 0x9c, 0x8f, 0xf1, 0x51, 0x99, 0xb3, 0x6e, 0x17, 0xda,
+	0x8c, 0xce, 0x4d, 0x80, 0x44, 0xc7, 0xf8, 0xc4, 0xcc, 0x5b, 0x5c, 0x63, 0xec, 0xaa, 0xc7, 0x0d,
+	0x50, 0xec, 0xd0, 0xf5, 0x31, 0x5b, 0xec, 0xd8, 0xe7, 0x0e, 0x06, 0x8d, 0xba, 0xeb, 0x4d, 0x77,
+	0x0e, 0x53, 0x90, 0x89, 0x53, 0xcc, 0x70, 0xe3, 0xb7, 0x0b, 0xc0, 0x5e, 0x31, 0x18, 0x25, 0xc8,
+	0x21, 0x4e, 0xb8, 0xde, 0x6c, 0xf9, 0x9d, 0x60, 0x10, 0xf4, 0x25, 0x77, 0x24, 0xa0, 0xd8, 0xce,
+	0x3f, 0x06, 0x9d, 0x66, 0x38, 0x00, 0x6e, 0x39, 0xec, 0x07, 0x7c, 0x9a, 0xe2, 0xd1, 0x64, 0x41,
+	0xb1, 0x1d, 0xd2, 0xa7, 0xd1, 0x8e, 0x29, 0x28, 0x01, 0x55, 0xc1, 0x43, 0xc6, 0x51, 0x31, 0x0e,
+	0x1e, 0x32, 0x46, 0x92, 0xb2, 0x6f, 0x2c, 0x43, 0xf6, 0xbd, 0x22, 0x2e, 0xb0, 0x94, 0x93, 0xf2,
+	0xa0, 0x9e, 0x20, 0xac, 0x11, 0xb5, 0xe8, 0xce, 0xc6, 0x39, 0x2b, 0x96, 0x88, 0x5a, 0xef, 0xb3,
+	0xd3, 0x3c, 0xe7, 0xa5, 0xe0, 0xd8, 0x96, 0xbc, 0xd7, 0x66, 0x5b, 0xce, 0xb1, 0x4a, 0xc1, 0xa9,
+	0x2d, 0xa6, 0xae, 0x9b, 0x6d, 0xcb, 0xb2, 0x6d, 0x02, 0x8e, 0x99, 0x8e, 0xa0, 0x9d, 0xb6, 0x7c,
+	0xbb, 0x0b, 0x43, 0x25, 0x1f, 0x55, 0xed, 0x4e, 0x8a, 0xca, 0xce, 0x00, 0x0e, 0x36, 0xb9, 0x9d,
+	0x55, 0x31, 0xc1, 0x45, 0x99, 0x2e, 0x7c, 0x59, 0x3c, 0xe5, 0xe1, 0xfb, 0x06, 0x81, 0xba, 0x8b,
+	0xed, 0x85, 0xe1, 0x00, 0x0e, 0x21, 0xd5, 0xfe, 0x96, 0x78, 0x7a, 0x44, 0xfd, 0x93, 0xdd, 0xf0,
+	0xbe, 0x24, 0x2e, 0x12, 0x9d, 0xef, 0x86, 0xc0, 0x48, 0xe1, 0xe1, 0xa9, 0x65, 0xa6, 0x7d, 0x27,
+	0x27, 0x66, 0xad, 0xda, 0xd8, 0xf4, 0x26, 0xa7, 0x9d, 0x4a, 0xd3, 0xb4, 0xf3, 0x4b, 0x90, 0x23,
+	0xb8, 0x21, 0x47, 0x60, 0xf6, 0x64, 0xe6, 0xe6, 0x72, 0x7c, 0xed, 0x5d, 0x7d, 0x68, 0xe7, 0x96,
+	0x18, 0x7c, 0x22, 0xbf, 0x57, 0xb7, 0xde, 0x55, 0x17, 0x9f, 0x91, 0xd9, 0x74, 0x6c, 0x89, 0x2b,
+	0x1f, 0xad, 0xb6, 0xdd, 0x4d, 0x4f, 0x8d, 0x9a, 0x41, 0x43, 0x03, 0x23, 0xf7, 0x57, 0x72, 0x42,
+	0xc4, 0xb3, 0xa3, 0x0c, 0x0f, 0x7d, 0xd0, 0x71, 0x4a, 0xb5, 0x71, 0xa8, 0x3d, 0x2b, 0x26, 0x74,
+	0x90, 0x3e, 0x3e, 0x3b, 0x2b, 0x0a, 0x86, 0xba, 0xfd, 0x0b, 0x62, 0xea, 0xb0, 0x1d, 0xee, 0x93,
+	0xe2, 0x41, 0x99, 0xf5, 0x91, 0xcc, 0x79, 0xae, 0x32, 0xf8, 0x96, 0x84, 0xc6, 0x07, 0x6d, 0xd1,
+	0x38, 0x68, 0xdd, 0x5f, 0xcd, 0xeb, 0x98, 0x6a, 0xbc, 0xe6, 0x91, 0x72, 0x00, 0xac, 0x95, 0xa4,
+	0xc0, 0x1f, 0x11, 0xc2, 0x24, 0xf5, 0x79, 0xfb, 0x4c, 0x67, 0xe9, 0xeb, 0xa2, 0xda, 0x67, 0x89,
+	0xaa, 0xc4, 0x6d, 0xf1, 0x11, 0xe2, 0x76, 0xb2, 0x6f, 0x9d, 0xc6, 0x1f, 0x03, 0xe6, 0x6b, 0x82,
+	0xd6, 0x3d, 0x68, 0x91, 0xbb, 0x8a, 0x54, 0x21, 0x19, 0xbf, 0x34, 0xe0, 0xa4, 0xa1, 0x00, 0x96,
+	0x64, 0x0a, 0xbe, 0x6e, 0x29, 0xef, 0xf2, 0xc6, 0x60, 0x6c, 0xe8, 0x7e, 0x43, 0x85, 0x6f, 0xed,
+	0x3d, 0x1c, 0x8d, 0x11, 0x73, 0x75, 0xf9, 0xc4, 0xea, 0x3e, 0x22, 0x83, 0x4e, 0x4d, 0xe5, 0x13,
+	0x2b, 0x18, 0x99, 0x97, 0x4d, 0x19, 0xfa, 0xb6, 0x51, 0x5a, 0x7c, 0x1c, 0x94, 0xba, 0xdf, 0xcd,
+	0x89, 0x71, 0xd0, 0x48, 0xef, 0xc8, 0x1c, 0x54, 0x62, 0x04, 0x7d, 0x5f, 0x46, 0x15, 0x1f, 0x91,
+	0x9d, 0x9a, 0xa9, 0x81, 0x4c, 0x26, 0x35, 0x90, 0x9f, 0x14, 0x97, 0xc8, 0x23, 0xdb, 0x0f, 0x31,
+	0xb1, 0x0b, 0x98, 0x11, 0x88, 0x8c, 0xd4, 0x8d, 0xb0, 0x3b, 0x38, 0x52, 0x82, 0xf6, 0x51, 0x4d,
+	0xc8, 0x12, 0x47, 0xeb, 0x91, 0x8d, 0x07, 0xa9, 0x31, 0xb1, 0xfc, 0x4d, 0x57, 0xb8, 0x9f, 0x16,
+	0x65, 0x52, 0xf9, 0x69, 0x59, 0x60, 0x54, 0xe2, 0x35, 0xd1, 0xa3, 0x16, 0xde, 0xaa, 0xcf, 0x59,
+	0x59, 0xbc, 0x72, 0xe5, 0x5e, 0xdc, 0xc0, 0xfd, 0xcd, 0xf3, 0x62, 0x7c, 0xbd, 0x7b, 0x1c, 0xb6,
+	0x1a, 0x14, 0xd6, 0xed, 0x04, 0x9d, 0x50, 0xdd, 0x1e, 0xc2, 0xdf, 0x98, 0xd2, 0x41, 0xf9, 0xdd,
+	0x3d, 0x26, 0xda, 0x09, 0x4e, 0xe9, 0x90, 0x20, 0x54, 0x63, 0xfa, 0xf1, 0x15, 0x67, 0x66, 0x1f,
+	0x03, 0x82, 0xc6, 0x50, 0xdf, 0xbc, 0xa2, 0x2c, 0x4b, 0xf1, 0xbd, 0xad, 0x31, 0xe3, 0xde, 0x16,
+	0x8e, 0x25, 0x73, 0x66, 0x39, 0x65, 0x8b, 0xc7, 0x92, 0x20, 0x32, 0xe0, 0xfa, 0x01, 0x7b, 0xd4,
+	0x49, 0x29, 0x1a, 0x97, 0x06, 0x9c, 0x09, 0x44, 0xc5, 0x89, 0x3f, 0xe0, 0x36, 0x7c, 0x4c, 0x98,
+	0x20, 0x54, 0x45, 0x93, 0xb7, 0xd3, 0xcb, 0x4c, 0xfb, 0x09, 0x30, 0x9e, 0x25, 0x70, 0x0c, 0x2a,
+	0x81, 0xca, 0xeb, 0x10, 0x7c, 0x8d, 0x3b, 0x09, 0x37, 0xcc, 0x3e, 0x4e, 0xc5, 0x57, 0x66, 0x1f,
+	0x12, 0x8c, 0xdf, 0x6e, 0xe3, 0x53, 0x21, 0x14, 0x2c, 0xa5, 0x40, 0x6b, 0xd9, 0xb3, 0x81, 0x94,
+	0x57, 0x17, 0xef, 0x2a, 0x85, 0x58, 0x8b, 0x9e, 0x09, 0x02, 0x62, 0xaf, 0x90, 0xa9, 0x2b, 0xf7,
+	0xb5, 0x6a, 0x5f, 0x90, 0x55, 0x9b, 0xef, 0x99, 0x8d, 0xcc, 0x90, 0xf3, 0x54, 0x2a, 0x39, 0x1e,
+	0xc6, 0x95, 0x91, 0xfa, 0x69, 0x36, 0xdb, 0x35, 0x80, 0x9d, 0x5e, 0x84, 0x30, 0x6e, 0x30, 0x43,
+	0x0d, 0x2c, 0x18, 0xec, 0x3c, 0x5d, 0x37, 0xee, 0xf9, 0xc0, 0x23, 0x8e, 0xb6, 0x06, 0x35, 0x0c,
+	0xfb, 0x50, 0xbf, 0xe9, 0x40, 0x9d, 0x25, 0xac, 0x58, 0x30, 0xc4, 0x8d, 0x2e, 0x13, 0x33, 0xcd,
+	0xf1, 0x8e, 0x5a, 0x40, 0xe7, 0x25, 0x8a, 0x66, 0xc2, 0x1a, 0xe6, 0xc9, 0xbf, 0x78, 0x49, 0xae,
+	0x59, 0x12, 0xad, 0xfa, 0x8b, 0xc1, 0xe3, 0xc0, 0xe3, 0x96, 0xee, 0xb2, 0x98, 0x30, 0xc1, 0x4e,
+	0x49, 0x14, 0xd1, 0x4f, 0x3a, 0x7d, 0xce, 0xa9, 0x88, 0xf1, 0x9d, 0xb5, 0xdd, 0x5d, 0xcc, 0x31,
+	0xce, 0x39, 0x13, 0xa2, 0xa4, 0x33, 0x8e, 0xf3, 0x58, 0x5a, 0x5e, 0x59, 0x59, 0xdb, 0xde, 0x85,
+	0x52, 0xc1, 0x1d, 0x08, 0x07, 0x6c, 0x1f, 0xd9, 0x8b, 0x3e, 0x97, 0x63, 0x7a, 0xce, 0x59, 0xf4,
+	0x9c, 0x41, 0x53, 0xf9, 0x6c, 0x9a, 0x7a, 0x24, 0xe6, 0xdd, 0x35, 0x51, 0xd9, 0x36, 0x2e, 0x2a,
+	0x13, 0x7b, 0xa9, 0x2b, 0xca, 0x92, 0x2d, 0x0d, 0x88, 0x31, 0x9d, 0xbc, 0x39, 0x1d, 0xf7, 0x0f,
+	0x72, 0x7c, 0xe7, 0x4f, 0x4f, 0x9f, 0xc7, 0xc6, 0x8b, 0xed, 0xca, 0x2b, 0x16, 0xdf, 0x40, 0xb0,
+	0x60, 0xd8, 0x86, 0xa6, 0x52, 0x0f, 0x0f, 0x0e, 0x60, 0xc3, 0x65, 0x36, 0xa2, 0x05, 0x43, 0xbe,
+	0x40, 0x1d, 0x10, 0xf5, 0xa9, 0x16, 0x8f, 0x10, 0xc9, 0xac, 0xc4, 0x14, 0x1c, 0xa5, 0xbc, 0x74,
+	0xfc, 0xa8, 0x3c, 0x4c, 0x5d, 0xd6, 0x17, 0x25, 0x92, 0x58, 0xbe, 0x86, 0xb1, 0x76, 0xd9, 0xaf,
+	0x2d, 0xc0, 0x54, 0x4b, 0x5d, 0x4f, 0x19, 0xc6, 0x68, 0x15, 0x59, 0x93, 0xce, 0xcb, 0x0c, 0xe3,
+	0x64, 0x05, 0xe6, 0x91, 0x1c, 0xb4, 0xfa, 0xc9, 0xe6, 0x9c, 0xb7, 0x9c, 0x51, 0xe3, 0xde, 0x13,
+	0xb3, 0x8a, 0x90, 0x0c, 0xd5, 0xca, 0xde, 0xc4, 0xdc, 0x59, 0xec, 0x93, 0x4f, 0xb3, 0x8f, 0xfb,
+	0xbf, 0x70, 0x0e, 0xc9, 0x9d, 0xce, 0x7c, 0x6f, 0xa0, 0x9c, 0x78, 0x6f, 0x60, 0xd1, 0xba, 0xe8,
+	0x4a, 0xbc, 0x26, 0x85, 0x66, 0x4a, 0x2c, 0x16, 0xb2, 0xc4, 0x22, 0x5e, 0xd5, 0xf3, 0x07, 0x47,
+	0xe4, 0x11, 0x00, 0xd1, 0x8e, 0xbf, 0xd1, 0xb9, 0x19, 0x3f, 0xba, 0x40, 0xbe, 0xab, 0xac, 0x77,
+	0x2b, 0xf8, 0xb4, 0x4f, 0xbf, 0x5b, 0x01, 0x38, 0xa0, 0x09, 0xd4, 0x63, 0xf7, 0x54, 0x0c, 0x40,
+	0xca, 0xe5, 0x02, 0xf1, 0xb5, 0xbc, 0x0a, 0x15, 0x43, 0xf0, 0x9a, 0x20, 0x65, 0x6e, 0x72, 0xaf,
+	0x3a, 0x13, 0x41, 0x5e, 0x4c, 0x89, 0xc1, 0x31, 0x45, 0xc8, 0x09, 0x24, 0x29, 0x42, 0x36, 0xf5,
+	0x74, 0x3d, 0x46, 0xa3, 0x56, 0x83, 0x36, 0x98, 0x4b, 0xcb, 0xed, 0x76, 0xb2, 0x7f, 0x50, 0x98,
+	0x33, 0xea, 0xa4, 0xd6, 0xfe, 0x79, 0x31, 0xbf, 0xcc, 0x29, 0xc2, 0x1f, 0x56, 0x06, 0x1a, 0xe6,
+	0x5c, 0x24, 0xbb, 0x94, 0x83, 0xfd, 0x11, 0xde, 0xc2, 0xee, 0x81, 0x36, 0x16, 0xac, 0x77, 0x3f,
+	0x78, 0x42, 0xf9, 0xc8, 0x14, 0x94, 0x2b, 0x59, 0xc9, 0x6e, 0x26, 0x88, 0xa8, 0x35, 0x9d, 0xed,
+	0x66, 0xc1, 0xdc, 0xbf, 0xc4, 0x4b, 0xd2, 0x34, 0xd9, 0xad, 0xe1, 0xe0, 0x47, 0x38, 0x5b, 0xe5,
+	0x4b, 0x2a, 0x18, 0xf7, 0xae, 0x13, 0x2b, 0x28, 0x9e, 0xbd, 0x82, 0xb1, 0x8c, 0x15, 0xdc, 0x10,
+	0x55, 0x5e, 0x80, 0x26, 0x29, 0x54, 0x14, 0x08, 0x52, 0x37, 0x6e, 0x4c, 0x9b, 0x20, 0x37, 0x12,
+	0x73, 0x72, 0xf3, 0xd4, 0xa7, 0x1f, 0x78, 0xe1, 0x89, 0x41, 0xf3, 0xe9, 0x41, 0x6f, 0x89, 0x99,
+	0xd5, 0x60, 0x7f, 0x78, 0xb8, 0x81, 0xa1, 0x25, 0xe3, 0x3e, 0x7a, 0x74, 0x14, 0x9e, 0x48, 0x81,
+	0x4d, 0xbf, 0xd1, 0xf5, 0x4e, 0xe1, 0xa7, 0x7a, 0xd4, 0x0b, 0x1a, 0xea, 0x96, 0x30, 0x41, 0x76,
+	0x00, 0xe0, 0xbe, 0x22, 0x1c, 0xb3, 0x1f, 0x63, 0xd1, 0xc3, 0xfd, 0x7a, 0x74, 0x1a, 0x0d, 0x82,
+	0x4e, 0xa4, 0x17, 0x1d, 0x83, 0xdc, 0x17, 0xc4, 0x04, 0x30, 0x06, 0x0c, 0x2c, 0xdf, 0xbe, 0x40,
+	0x7f, 0xaa, 0x7f, 0x8a, 0xc7, 0x97, 0xf6, 0xa7, 0x52, 0xb5, 0xfb, 0x83, 0xbc, 0x38, 0xcf, 0x2d,
+	0xb1, 0x57, 0x7c, 0x62, 0xa8, 0xd5, 0x25, 0x81, 0xa3, 0x7a, 0x35, 0x40, 0x29, 0x11, 0x97, 0xcf,
+	0x10, 0x71, 0xd2, 0xdb, 0xa0, 0xae, 0x15, 0x4a, 0x39, 0x66, 0xc1, 0xec, 0x67, 0x47, 0x8a, 0x89,
+	0x67, 0x47, 0x12, 0xae, 0xf7, 0x58, 0x07, 0xe3, 0xf9, 0x29, 0xe9, 0x2d, 0x25, 0x9a, 0x09, 0xca,
+	0xd4, 0xf4, 0xf8, 0xbe, 0x7d, 0x5a, 0xd3, 0x4b, 0x69, 0x74, 0xa5, 0xc7, 0xd0, 0xe8, 0xd8, 0x05,
+	0xf1, 0x28, 0x8d, 0x4e, 0x3c, 0x86, 0x46, 0x87, 0x49, 0xf0, 0xf4, 0x30, 0x84, 0x71, 0x0d, 0xc5,
+	0xfd, 0x6d, 0x60, 0x4e, 0x49, 0x73, 0xba, 0x0e, 0xc3, 0x54, 0x86, 0x6d, 0x94, 0x79, 0x05, 0x0f,
+	0xd6, 0x41, 0x16, 0x8b, 0x8e, 0x31, 0xc8, 0x80, 0x88, 0x05, 0xc4, 0x75, 0xa8, 0xe4, 0x12, 0x30,
+	0x4f, 0xe4, 0xa6, 0x98, 0x20, 0x15, 0xa6, 0x40, 0x9f, 0x2a, 0x6d, 0x49, 0xce, 0xd3, 0x65, 0xf7,
+	0xcf, 0x73, 0x62, 0xc6, 0x98, 0xb0, 0xa4, 0xc2, 0xd7, 0x85, 0x92, 0x92, 0x1c, 0x70, 0x60, 0x89,
+	0xbe, 0x60, 0xf3, 0x4f, 0xfc, 0x99, 0xd5, 0x98, 0x36, 0x13, 0x08, 0x92, 0x5e, 0xc0, 0x18, 0x76,
+	0xe4, 0xe1, 0x6a, 0x82, 0x90, 0x90, 0x4e, 0x82, 0xe0, 0xbe, 0x6e, 0xc2, 0xc7, 0xbb, 0x05, 0x23,
+	0xaf, 0x2e, 0x5a, 0x5a, 0xba, 0x51, 0x51, 0x7a, 0x75, 0x4d, 0xa0, 0xfb, 0x8f, 0xa0, 0xa0, 0xb0,
+	0xc9, 0x2c, 0x1d, 0x12, 0x3a, 0x02, 0x7b, 0x9e, 0x7d, 0x04, 0xcc, 0x91, 0x77, 0xce, 0x79, 0xb2,
+	0xec, 0x7c, 0xea, 0x31, 0xcd, 0x7c, 0x9d, 0x6b, 0x3b, 0x62, 0x2f, 0x0a, 0x59, 0x7b, 0xf1, 0x08,
+	0x4c, 0x67, 0x39, 0xd8, 0xc7, 0x32, 0x1d, 0xec, 0xf8, 0xa0, 0x58, 0xd4, 0x08, 0x7b, 0x81, 0x7b,
+	0x41, 0xcc, 0xd9, 0x8b, 0x93, 0x47, 0xd3, 0xd7, 0xf9, 0x72, 0x12, 0x06, 0xa2, 0x30, 0xe1, 0x03,
+	0xce, 0xe3, 0xb0, 0xaf, 0x5f, 0x0a, 0xc1, 0x50, 0x3a, 0x08, 0xdf, 0x01, 0x5f, 0x0d, 0x91, 0x8e,
+	0xed, 0x18, 0x82, 0x73, 0xc4, 0x0c, 0x52, 0xaa, 0xe5, 0xbd, 0xd1, 0xe5, 0x94, 0x6e, 0x29, 0x8d,
+	0x7a, 0x4b, 0x43, 0x7b, 0x9e, 0xf3, 0xd9, 0x51, 0x87, 0x0c, 0x8e, 0xe9, 0xbc, 0x67, 0x6b, 0x39,
+	0x01, 0x75, 0xff, 0x0e, 0xce, 0xcf, 0x78, 0x92, 0x9c, 0x88, 0x60, 0x49, 0x07, 0xa9, 0x96, 0xc5,
+	0xd2, 0x41, 0xb9, 0xdc, 0x5b, 0xa8, 0xa7, 0xc9, 0xb9, 0x19, 0x10, 0xe2, 0x58, 0x59, 0x02, 0x8e,
+	0x93, 0x04, 0x61, 0x82, 0xf8, 0xd8, 0x42, 0x0d, 0x51, 0x6a, 0xbb, 0xb2, 0x44, 0x01, 0x79, 0xf8,
+	0x85, 0x5f, 0x71, 0x70, 0x40, 0x15, 0x95, 0x8a, 0x35, 0x4e, 0x50, 0x52, 0xb1, 0xcc, 0xa0, 0x5e,
+	0x89, 0xf1, 0xa3, 0xca, 0xee, 0xaf, 0xe5, 0xc4, 0xc5, 0x0c, 0xc4, 0x4b, 0xae, 0x59, 0x15, 0x33,
+	0x07, 0xba, 0x52, 0x21, 0x87, 0x59, 0x47, 0xe5, 0x5c, 0x24, 0x10, 0xe2, 0xa5, 0x3f, 0xd0, 0xfa,
+	0x32, 0xa3, 0xdb, 0xca, 0xd5, 0x4e, 0x57, 0xb8, 0xdb, 0xa2, 0xb6, 0xf6, 0x00, 0x99, 0x70, 0xc5,
+	0x7c, 0xc0, 0x52, 0xd1, 0xc2, 0x8d, 0x94, 0x90, 0x39, 0xdb, 0x01, 0x73, 0x20, 0x26, 0xad, 0xbe,
+	0x9c, 0x97, 0x1f, 0xb7, 0x93, 0x44, 0x78, 0x84, 0x4a, 0xfc, 0x02, 0xa7, 0xca, 0x18, 0x37, 0x40,
+	0xee, 0xb1, 0x98, 0xba, 0x3b, 0x6c, 0x0f, 0x5a, 0xf1, 0x6b, 0x9c, 0xc0, 0x9b, 0x95, 0xb8, 0x0b,
+	0x85, 0xba, 0xcc, 0xa1, 0xcc, 0x76, 0x88, 0xb1, 0x0e, 0xf6, 0x54, 0x4f, 0x8f, 0x98, 0xae, 0xc0,
+	0xbc, 0x99, 0x78, 0x48, 0xc6, 0x9d, 0x12, 0xd4, 0xdf, 0xc8, 0x71, 0x2a, 0x94, 0xfd, 0x38, 0xa8,
+	0x73, 0x5b, 0xcc, 0xa2, 0xb7, 0xad, 0x1d, 0x98, 0xfd, 0x44, 0x12, 0x13, 0xf3, 0xf6, 0xf4, 0xe4,
+	0x03, 0xa2, 0x5e, 0xd6, 0x17, 0x48, 0x20, 0xd9, 0x13, 0x8d, 0x09, 0x24, 0x81, 0x92, 0xac, 0x05,
+	0xbc, 0x21, 0xaa, 0xf6, 0x60, 0x18, 0xd7, 0x49, 0xcc, 0xcc, 0x8c, 0xa5, 0xd8, 0x94, 0x61, 0xb5,
+	0x74, 0xbf, 0x0a, 0x92, 0x04, 0xe8, 0x17, 0xc8, 0x38, 0x30, 0x06, 0x95, 0xd4, 0xf3, 0x7a, 0xaa,
+	0xdb, 0xd1, 0x0b, 0xd6, 0x29, 0xde, 0x6a, 0xad, 0xd7, 0x47, 0x6e, 0x0a, 0x34, 0x4d, 0x57, 0x61,
+	0x62, 0xb7, 0x5c, 0xdf, 0x82, 0x98, 0x97, 0x53, 0x52, 0xd3, 0x91, 0x62, 0x0f, 0x6c, 0x03, 0x6b,
+	0x50, 0xcb, 0x99, 0x0e, 0x46, 0x05, 0x3f, 0xba, 0x62, 0xae, 0x83, 0x3f, 0xbc, 0xf6, 0x50, 0x54,
+	0x8c, 0x47, 0x69, 0x40, 0x63, 0x9a, 0xbd, 0xb7, 0xbe, 0xbb, 0xb9, 0xb6, 0xb3, 0x53, 0xdf, 0xde,
+	0xbb, 0xf9, 0xe6, 0xda, 0xdb, 0xf5, 0x3b, 0xcb, 0x3b, 0x77, 0xa6, 0xcf, 0xe1, 0x95, 0x68, 0x80,
+	0xee, 0xae, 0xad, 0x5a, 0xf0, 0x1c, 0x08, 0xa6, 0xda, 0xde, 0xe6, 0x1e, 0xe6, 0x6c, 0x65, 0x7d,
+	0x97, 0x07, 0x4d, 0xef, 0xa2, 0xac, 0xcf, 0xf8, 0xbc, 0x70, 0xed, 0xa3, 0x98, 0xb4, 0x6b, 0xbe,
+	0x70, 0xe2, 0x94, 0xc5, 0xd8, 0xf2, 0xc6, 0xc6, 0xd6, 0x3d, 0x18, 0xb3, 0x24, 0x8a, 0xab, 0x6b,
+	0x9b, 0x6f, 0x4f, 0xe7, 0xae, 0xed, 0x8a, 0xaa, 0x7d, 0xf5, 0x17, 0xb3, 0xc7, 0x76, 0xdf, 0xde,
+	0x5e, 0xab, 0xcb, 0xbb, 0x78, 0xd0, 0x5a, 0x88, 0xf3, 0x2b, 0x5b, 0x77, 0xef, 0xae, 0xef, 0xc2,
+	0xac, 0x66, 0xc4, 0xe4, 0xfa, 0x26, 0x94, 0xf0, 0x5e, 0x36, 0x26, 0xfd, 0xc1, 0x44, 0x00, 0xb4,
+	0xb5, 0xb7, 0x7b, 0x7b, 0x4b, 0x83, 0x0a, 0xd7, 0xde, 0xc3, 0x27, 0xfa, 0x12, 0x37, 0xa6, 0x9d,
+	0x59, 0x31, 0x05, 0xed, 0xe0, 0x5b, 0xb3, 0xef, 0x8a, 0x18, 0x5f, 0xd9, 0x58, 0x5e, 0xbf, 0x4b,
+	0x9e, 0x97, 0x49, 0x51, 0xde, 0xdb, 0x54, 0xc5, 0xbc, 0x7d, 0xd9, 0xbb, 0x80, 0xb7, 0x01, 0x6f,
+	0xad, 0x7b, 0x3b, 0xbb, 0xf5, 0x9d, 0xdd, 0xe5, 0xdb, 0x6b, 0xd3, 0x45, 0xfc, 0x76, 0x17, 0x9a,
+	0x42, 0xa7, 0xd3, 0x63, 0xd7, 0x0e, 0xc5, 0x54, 0x22, 0xb7, 0x0c, 0x07, 0x94, 0x97, 0xca, 0x57,
+	0xd7, 0x76, 0xd7, 0x56, 0xd0, 0x83, 0x73, 0x0e, 0xa4, 0xf2, 0xdc, 0x1b, 0x7b, 0x3b, 0xbb, 0xeb,
+	0x2b, 0x6b, 0xf5, 0xdd, 0x2f, 0xd4, 0x6f, 0x7a, 0x5b, 0xcb, 0xab, 0x2b, 0xcb, 0x3b, 0xbb, 0x7c,
+	0xb9, 0x10, 0xba, 0xda, 0xde, 0x83, 0x01, 0xb6, 0xd7, 0x36, 0x77, 0x61, 0x02, 0x71, 0x07, 0xde,
+	0xda, 0xce, 0xd6, 0xc6, 0x5b, 0x38, 0x8d, 0x1b, 0x5f, 0x2d, 0x88, 0x2a, 0x67, 0x53, 0xf1, 0x2b,
+	0xbc, 0x41, 0xdf, 0xb9, 0x2b, 0xc6, 0xe5, 0x1b, 0xd2, 0x8e, 0x22, 0x54, 0xfb, 0xd5, 0xea, 0xda,
+	0x85, 0x24, 0x58, 0x52, 0xd7, 0xec, 0xcf, 0x7d, 0xf7, 0x5f, 0xbe, 0x96, 0x9f, 0x74, 0x2a, 0x4b,
+	0xc7, 0x2f, 0x2d, 0x1d, 0x06, 0x5d, 0x7c, 0xd6, 0xd9, 0xf9, 0x92, 0x10, 0xf1, 0x93, 0xc7, 0xce,
+	0xa2, 0x76, 0x72, 0x24, 0x9e, 0x8d, 0xae, 0x5d, 0xcc, 0xa8, 0x91, 0xfd, 0x5e, 0xa4, 0x7e, 0x67,
+	0xdd, 0x2a, 0xf6, 0x8b, 0xef, 0x5b, 0xf0, 0xbb, 0xc6, 0xaf, 0xe5, 0xae, 0x39, 0x4d, 0x31, 0x61,
+	0x3e, 0x1f, 0xec, 0xa8, 0x48, 0x4b, 0xc6, 0x3b, 0xc9, 0xb5, 0x4b, 0x99, 0x75, 0x8a, 0x33, 0x68,
+	0x8c, 0x79, 0x77, 0x1a, 0xc7, 0x18, 0x52, 0x8b, 0x78, 0x94, 0x36, 0xcb, 0x8b, 0xf8, 0xf9, 0x5f,
+	0xe7, 0x29, 0x83, 0x85, 0x53, 0x8f, 0x0f, 0xd7, 0x9e, 0x1e, 0x51, 0x2b, 0xc7, 0x7a, 0x9a, 0xc6,
+	0x5a, 0x70, 0x1d, 0x1c, 0xab, 0x41, 0x6d, 0xd4, 0xe3, 0xc3, 0x30, 0xda, 0x8d, 0x1f, 0x7c, 0x42,
+	0x94, 0x75, 0xf4, 0xd6, 0x79, 0x57, 0x4c, 0x5a, 0xe9, 0x6e, 0x8e, 0x5a, 0x46, 0x56, 0xde, 0x5c,
+	0xed, 0xa9, 0xec, 0x4a, 0x15, 0xb3, 0xa3, 0x81, 0x17, 0x9d, 0x0b, 0x38, 0xb0, 0xcc, 0x17, 0x5b,
+	0xa2, 0xac, 0x5a, 0xbe, 0xd8, 0x77, 0xdf, 0x90, 0x8b, 0x3c, 0xd8, 0x53, 0x49, 0x51, 0x65, 0x8d,
+	0xf6, 0xf4, 0x88, 0x5a, 0xf5, 0xa2, 0x10, 0x0d, 0x77, 0xc1, 0x99, 0x33, 0x87, 0xd3, 0x51, 0xd5,
+	0x80, 0x6e, 0xa3, 0x9a, 0x8f, 0xd3, 0x3a, 0x4f, 0x6b, 0xc2, 0xca, 0x7a, 0xb4, 0x56, 0x93, 0x48,
+	0xfa, 0xe5, 0x5a, 0x77, 0x91, 0x86, 0x72, 0x1c, 0xda, 0x3e, 0xf3, 0x6d, 0x5a, 0x67, 0x5f, 0x54,
+	0x8c, 0xf7, 0xe6, 0x9c, 0x8b, 0x23, 0xdf, 0xc6, 0xab, 0xd5, 0xb2, 0xaa, 0xb2, 0x96, 0x62, 0xf6,
+	0xbf, 0x84, 0x0a, 0xcf, 0x17, 0x45, 0x59, 0xbf, 0x53, 0xe6, 0x2c, 0x18, 0x2f, 0xca, 0x99, 0x2f,
+	0xae, 0xd5, 0x16, 0xd3, 0x15, 0x59, 0xc4, 0x67, 0xf6, 0x8e, 0xc4, 0x77, 0x4f, 0x54, 0x8c, 0x17,
+	0xc7, 0xf4, 0x02, 0xd2, 0xef, 0x9d, 0xe9, 0x05, 0x64, 0x3c, 0x50, 0xe6, 0xce, 0xd0, 0x10, 0x15,
+	0xa7, 0x4c, 0xf4, 0x8d, 0x0f, 0x92, 0x39, 0x1b, 0x62, 0x5e, 0xca, 0xff, 0xfd, 0xe0, 0x49, 0xb6,
+	0x21, 0xe3, 0x3d, 0xe0, 0x17, 0x73, 0x70, 0xd4, 0x95, 0xd4, 0x63, 0x74, 0xce, 0x85, 0xec, 0x47,
+	0xf5, 0x6a, 0x0b, 0x29, 0xb8, 0xd4, 0xfb, 0xde, 0x16, 0x22, 0x7e, 0xc4, 0x4c, 0x0b, 0x89, 0xd4,
+	0x73, 0x69, 0x9a, 0x02, 0xd2, 0x2f, 0x9e, 0xb9, 0x17, 0x68, 0x81, 0xd3, 0x0e, 0x09, 0x89, 0x6e,
+	0x70, 0xa2, 0x6e, 0x6d, 0x7d, 0x59, 0x54, 0x8c, 0x77, 0xcc, 0x34, 0xfa, 0xd2, 0x6f, 0xa0, 0x69,
+	0xf4, 0x65, 0x3c, 0x7b, 0xe6, 0xd6, 0xa8, 0xf7, 0x39, 0x77, 0x0a, 0x7b, 0xc7, 0x77, 0xca, 0x3a,
+	0xdc, 0x00, 0x37, 0xe8, 0x48, 0x4c, 0x5a, 0x8f, 0x95, 0x69, 0x0e, 0xcd, 0x7a, 0x0a, 0x4d, 0x73,
+	0x68, 0xe6, 0xfb, 0x66, 0x8a, 0xce, 0xdc, 0x19, 0x1c, 0xe7, 0x98, 0x9a, 0x18, 0x23, 0xbd, 0x23,
+	0x2a, 0xc6, 0xc3, 0x63, 0x7a, 0x2d, 0xe9, 0x37, 0xce, 0xf4, 0x5a, 0xb2, 0xde, 0x29, 0x9b, 0xa3,
+	0x31, 0xaa, 0x2e, 0x91, 0x02, 0x5d, 0xa1, 0xc6, 0xbe, 0xdf, 0x15, 0x55, 0xfb, 0x29, 0x32, 0xcd,
+	0xfb, 0x99, 0x8f, 0x9a, 0x69, 0xde, 0x1f, 0xf1, 0x7e, 0x99, 0x24, 0xe9, 0x6b, 0xb3, 0x7a, 0x90,
+	0xa5, 0xaf, 0xc8, 0xac, 0xae, 0x87, 0xce, 0xe7, 0x51, 0xc0, 0xc9, 0x3b, 0xed, 0xce, 0x82, 0x41,
+	0xb5, 0xe6, 0xcd, 0x77, 0xcd, 0x2f, 0xa9, 0xeb, 0xef, 0x36, 0x31, 0xf3, 0x25, 0x70, 0x3a, 0xb5,
+	0xe8, 0x6e, 0xbb, 0x71, 0x6a, 0x99, 0xd7, 0xdf, 0x8d, 0x53, 0xcb, 0xba, 0x02, 0x9f, 0x3c, 0xb5,
+	0x06, 0x2d, 0xec, 0xa3, 0x2b, 0xa6, 0x12, 0xf7, 0x25, 0x34, 0x57, 0x64, 0x5f, 0x30, 0xab, 0x5d,
+	0x7e, 0xf4, 0x35, 0x0b, 0x5b, 0x82, 0x28, 0x21, 0xb8, 0xa4, 0xae, 0xf3, 0xfd, 0x94, 0x98, 0x30,
+	0x5f, 0x2b, 0x72, 0x4c, 0x56, 0x4e, 0x8e, 0x74, 0x29, 0xb3, 0xce, 0xde, 0x5c, 0x67, 0xc2, 0x1c,
+	0xc6, 0x79, 0x4b, 0x5c, 0xd0, 0xac, 0x6e, 0xa6, 0xe0, 0x47, 0xce, 0x33, 0x19, 0x89, 0xf9, 0xa6,
+	0x56, 0x58, 0xbb, 0x38, 0x32, 0x73, 0x1f, 0x98, 0xfe, 0xae, 0x21, 0x42, 0x0c, 0x85, 0x25, 0x72,
+	0x2e, 0xa7, 0x33, 0xe4, 0xad, 0x5e, 0x9d, 0x74, 0x3d, 0x74, 0x07, 0x34, 0x68, 0xbf, 0x59, 0x11,
+	0x9f, 0x3f, 0x59, 0x4f, 0x75, 0xc4, 0xe7, 0x4f, 0xe6, 0x43, 0x17, 0x8a, 0x06, 0x9d, 0x59, 0x0b,
+	0xe5, 0x1c, 0x5f, 0x07, 0x94, 0xcc, 0x02, 0x25, 0x24, 0x1f, 0x28, 0xd1, 0x13, 0x1f, 0xf1, 0x20,
+	0x4a, 0xed, 0x99, 0x91, 0xf5, 0x52, 0x94, 0xbd, 0x03, 0x8a, 0x61, 0x7c, 0x17, 0x0b, 0xdf, 0x83,
+	0xd0, 0x7c, 0x9a, 0xbe, 0x16, 0x5c, 0xcb, 0x32, 0xcd, 0xdc, 0x05, 0x9a, 0xf7, 0x8c, 0x6b, 0xed,
+	0x21, 0xf2, 0xe8, 0x8a, 0xa8, 0x98, 0xf7, 0xbc, 0x1e, 0xd1, 0xef, 0x82, 0x51, 0x65, 0xde, 0x39,
+	0x05, 0x24, 0xff, 0x0e, 0xbe, 0xcd, 0x6b, 0xde, 0x9a, 0xb2, 0xb2, 0x53, 0x12, 0xfd, 0x2c, 0x9a,
+	0x75, 0x66, 0x47, 0xae, 0x47, 0x93, 0xdc, 0xb8, 0xf6, 0x86, 0x85, 0xdc, 0xaf, 0x58, 0xfe, 0xb7,
+	0xeb, 0xc9, 0x77, 0x7a, 0x1f, 0x26, 0x1b, 0x98, 0x57, 0xa7, 0x1f, 0xc2, 0xe4, 0xbe, 0x99, 0x13,
+	0x55, 0x3b, 0x9a, 0xa0, 0x49, 0x20, 0x33, 0x6e, 0xa1, 0x49, 0x60, 0x44, 0x08, 0xe2, 0x1d, 0x9a,
+	0xe5, 0xee, 0x35, 0xcf, 0x9a, 0xa5, 0x7c, 0x25, 0xe5, 0x83, 0xcd, 0xd6, 0xf9, 0x34, 0x9c, 0x78,
+	0x32, 0xba, 0x11, 0x9f, 0x78, 0x76, 0xb8, 0xa3, 0x36, 0x6f, 0xc1, 0x0d, 0xef, 0x60, 0x59, 0xc7,
+	0x1a, 0x62, 0x85, 0x21, 0x11, 0x7d, 0x18, 0xf5, 0xf1, 0x8a, 0x98, 0xb4, 0x7c, 0xf6, 0xfa, 0xbc,
+	0xc9, 0xf2, 0xe4, 0x8f, 0xea, 0xe4, 0x35, 0x7e, 0x68, 0x5f, 0xc5, 0xe7, 0x1c, 0xe3, 0x64, 0x4e,
+	0xd2, 0xa6, 0xf9, 0x38, 0xfc, 0xd5, 0x1c, 0x6c, 0xd2, 0x97, 0xf9, 0x71, 0x5d, 0xf9, 0x2d, 0x91,
+	0xf8, 0xe3, 0x7e, 0xef, 0x3e, 0x47, 0x1b, 0x72, 0xd9, 0xbd, 0x68, 0x6d, 0x48, 0x52, 0xe7, 0x59,
+	0xe6, 0xd9, 0xc9, 0x67, 0xaf, 0xe3, 0x43, 0x3b, 0xf5, 0x14, 0xf6, 0xe8, 0x49, 0x76, 0x78, 0x92,
+	0xb2, 0xb9, 0xc5, 0x87, 0x8f, 0xd9, 0x8d, 0x7b, 0x8d, 0xe6, 0xfa, 0x9c, 0xfb, 0xcc, 0xc8, 0xb9,
+	0x2e, 0x91, 0xe3, 0x1a, 0x67, 0xfc, 0x59, 0xf3, 0x75, 0xf5, 0x85, 0xd4, 0xa3, 0xde, 0x09, 0x76,
+	0x4a, 0x3f, 0xf9, 0x7e, 0x57, 0x54, 0xed, 0x57, 0xc3, 0x35, 0xdd, 0x67, 0xbe, 0x32, 0xae, 0xe9,
+	0x7e, 0xc4, 0x53, 0xe3, 0xdb, 0x42, 0xc4, 0xa1, 0x7d, 0x27, 0x11, 0x5a, 0xd6, 0x32, 0x3d, 0x1d,
+	0xfd, 0xb7, 0x65, 0x8f, 0x8a, 0x40, 0xe3, 0x02, 0xbf, 0xc8, 0x27, 0xd4, 0xba, 0x0a, 0x4a, 0x9b,
+	0x7a, 0xa8, 0x1d, 0x83, 0xb7, 0xf4, 0xd0, 0x64, 0xff, 0xd6, 0xf9, 0xa4, 0x23, 0xdc, 0x7b, 0x62,
+	0x72, 0x23, 0x0c, 0xef, 0x0f, 0x7b, 0x3a, 0x4d, 0xc7, 0x0e, 0x7d, 0x62, 0xa6, 0x40, 0x2d, 0xb1,
+	0x0a, 0xf7, 0x0a, 0x75, 0x55, 0x73, 0x16, 0x8d, 0xae, 0x96, 0xbe, 0x12, 0xa7, 0x0e, 0x3c, 0x74,
+	0x7c, 0x31, 0xa3, 0x8f, 0x27, 0x3d, 0xf1, 0x9a, 0xdd, 0x8d, 0x75, 0x2c, 0x25, 0x87, 0xb0, 0x0c,
+	0x26, 0x35, 0xdb, 0xa5, 0x48, 0xf5, 0x09, 0x64, 0xb6, 0x2d, 0x26, 0x56, 0x83, 0x46, 0xd8, 0x0c,
+	0x64, 0x9c, 0x68, 0x36, 0x9e, 0xb8, 0x0e, 0x30, 0xd5, 0x26, 0x2d, 0xa0, 0xad, 0x0a, 0xf4, 0xfc,
+	0xd3, 0x7e, 0xf0, 0x1e, 0x28, 0x47, 0x1c, 0x81, 0x7a, 0xa8, 0x54, 0x01, 0x15, 0xba, 0xb5, 0x54,
+	0x81, 0x44, 0xac, 0xd7, 0x52, 0x05, 0x52, 0xb1, 0x5e, 0x0b, 0xd5, 0x2a, 0x74, 0x0c, 0xb6, 0xec,
+	0x4c, 0x2a, 0x3c, 0xac, 0xb5, 0x80, 0x51, 0x41, 0xe5, 0xda, 0x95, 0xd1, 0x0d, 0xec, 0xd1, 0xae,
+	0xd9, 0xa3, 0xed, 0x88, 0xc9, 0xd5, 0x80, 0x91, 0xc5, 0xd9, 0xca, 0x89, 0x6b, 0x8c, 0x66, 0x2e,
+	0x74, 0xf2, 0x30, 0xa4, 0x3a, 0x5b, 0xd7, 0xa3, 0x54, 0x61, 0x20, 0xc5, 0x0a, 0x1c, 0xdd, 0x2a,
+	0x3d, 0x59, 0xcb, 0xde, 0x44, 0xbe, 0x72, 0x2d, 0x23, 0xbb, 0xd9, 0xa6, 0x19, 0xea, 0x6d, 0x09,
+	0xf3, 0x9d, 0x59, 0xd0, 0xd7, 0x5b, 0xcd, 0x87, 0xce, 0x17, 0xa8, 0x73, 0x7d, 0x8b, 0xe2, 0x82,
+	0x91, 0x33, 0x6a, 0x76, 0x3e, 0x95, 0x80, 0x67, 0xf5, 0x8c, 0xa9, 0x76, 0x86, 0xd6, 0xdb, 0x15,
+	0x15, 0xe3, 0xf2, 0x8f, 0x66, 0xa0, 0xf4, 0x5d, 0x2d, 0xcd, 0x40, 0x19, 0x77, 0x85, 0xdc, 0xab,
+	0x34, 0x8e, 0xeb, 0x5c, 0x89, 0xc7, 0xe1, 0xfb, 0x41, 0xf1, 0x48, 0x4b, 0x5f, 0xf1, 0x3b, 0x83,
+	0x87, 0x60, 0x38, 0xe2, 0x0b, 0x50, 0x66, 0x0a, 0x76, 0x6c, 0x3e, 0x25, 0xb3, 0xb5, 0x35, 0xb2,
+	0x8c, 0x2a, 0xdb, 0xa4, 0xe2, 0xa1, 0x48, 0x39, 0xfe, 0x94, 0x10, 0x98, 0x0a, 0xbc, 0xea, 0xe3,
+	0xbf, 0xe5, 0x8a, 0x45, 0x7f, 0x9c, 0x2c, 0x1c, 0x8b, 0x53, 0x23, 0x63, 0x18, 0xe6, 0x33, 0x9f,
+	0x54, 0x42, 0x99, 0x26, 0x14, 0x71, 0x8d, 0xcc, 0xf3, 0xd5, 0x08, 0xc9, 0xc8, 0xf5, 0x05, 0x1e,
+	0x5c, 0x16, 0x22, 0x8e, 0x03, 0x6b, 0xeb, 0x31, 0x15, 0x62, 0xd6, 0x62, 0x2f, 0x23, 0x68, 0xbc,
+	0x2d, 0xca, 0x71, 0x60, 0x71, 0x21, 0xbe, 0x9f, 0x66, 0x2b, 0x7f, 0x8b, 0xe9, 0x0a, 0xb9, 0x2b,
+	0xd3, 0x84, 0x2a, 0xe1, 0x94, 0x10, 0x55, 0x14, 0xc3, 0x6b, 0x89, 0x59, 0x9e, 0xa0, 0x56, 0xed,
+	0x28, 0x2d, 0x55, 0xad, 0x24, 0x23, 0xe4, 0xa6, 0xb9, 0x39, 0x33, 0x62, 0x65, 0x39, 0xc1, 0x90,
+	0x5a, 0x39, 0x25, 0x16, 0x45, 0x73, 0x47, 0xcc, 0xa4, 0x42, 0x2a, 0xce, 0x33, 0xa9, 0x78, 0x89,
+	0x1d, 0xe5, 0xd2, 0x2c, 0x3d, 0x32, 0x1a, 0xe3, 0xce, 0xd3, 0x90, 0x53, 0xae, 0x20, 0xa3, 0xf7,
+	0xa4, 0x35, 0x68, 0x1c, 0xe1, 0x70, 0x98, 0x05, 0x9b, 0x11, 0x31, 0x71, 0x9e, 0x55, 0xfe, 0x93,
+	0x91, 0xd1, 0x94, 0x5a, 0xa6, 0x43, 0xdd, 0xdd, 0xa1, 0x71, 0xee, 0x3a, 0x6f, 0x5a, 0xe7, 0x2c,
+	0xfb, 0xb2, 0x25, 0x67, 0x3e, 0x52, 0x41, 0xcb, 0xd4, 0xce, 0xde, 0x13, 0x0b, 0x3c, 0x11, 0x10,
+	0x56, 0x09, 0x67, 0xff, 0xe5, 0xd4, 0x7f, 0x31, 0xb3, 0x82, 0x18, 0xb5, 0xd1, 0xff, 0xe5, 0x6c,
+	0x84, 0x49, 0xc1, 0x53, 0x75, 0x86, 0x62, 0x3a, 0xe9, 0x40, 0x77, 0x46, 0xf7, 0xa5, 0x4d, 0x89,
+	0x51, 0x4e, 0x77, 0xf7, 0xa3, 0x34, 0xd8, 0x33, 0x6e, 0x2d, 0x0b, 0x2f, 0xec, 0x1c, 0xc0, 0xfd,
+	0xf8, 0x19, 0xed, 0xed, 0x4f, 0xac, 0xf3, 0x99, 0xf8, 0x3d, 0xc5, 0xcc, 0xf0, 0x84, 0xf6, 0x45,
+	0x64, 0x07, 0x0b, 0x9e, 0xa7, 0xe1, 0xaf, 0xb8, 0x97, 0xb2, 0x86, 0xef, 0xf3, 0x27, 0xec, 0x95,
+	0x58, 0x48, 0xf2, 0xb5, 0x9a, 0xc1, 0x95, 0xac, 0xfd, 0x1e, 0x69, 0x5e, 0x26, 0x70, 0x7d, 0x0e,
+	0x58, 0x7b, 0x1f, 0xd6, 0x96, 0x75, 0x8b, 0xc0, 0xf9, 0x88, 0x4e, 0x22, 0x18, 0x7d, 0x07, 0xa1,
+	0xf6, 0xdc, 0xa3, 0x1b, 0x69, 0xde, 0xc7, 0xff, 0xc3, 0x60, 0x3f, 0x6c, 0xae, 0xb5, 0xaf, 0xcc,
+	0x47, 0xd8, 0x6b, 0x4f, 0x8f, 0xa8, 0x95, 0x3d, 0xee, 0x88, 0x39, 0x0f, 0x64, 0xe3, 0x71, 0xf0,
+	0x61, 0x76, 0xfa, 0x25, 0x99, 0x4e, 0x66, 0x3f, 0xc0, 0xae, 0xb9, 0x6e, 0xf4, 0xdb, 0xed, 0x35,
+	0xf7, 0x51, 0x4d, 0xb8, 0xf7, 0x9b, 0x57, 0xde, 0xb9, 0x7c, 0xd8, 0x1a, 0x1c, 0x0d, 0xf7, 0xaf,
+	0x37, 0xc2, 0xce, 0xd2, 0x89, 0x7f, 0xbf, 0x75, 0xea, 0x77, 0xfc, 0xde, 0x52, 0xbb, 0xdb, 0x5c,
+	0xa2, 0xaf, 0xf7, 0xcf, 0xd3, 0x3f, 0xb1, 0x7c, 0xf9, 0xff, 0x00, 0xdb, 0xce, 0x4c, 0x9a, 0xf6,
+	0x72, 0x00, 0x00,
 }
@@ -4,6 +4,8 @@ package signrpc
 
 import (
 	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/macaroons"
 )
 
@@ -30,4 +32,12 @@ type Config struct {
 	// job of the signer RPC server is simply to proxy valid requests to
 	// the active signer instance.
 	Signer input.Signer
+
+	// KeyRing is the key ring that is used to derive the private keys
+	// requested by the message signing and shared key derivation calls.
+	KeyRing keychain.SecretKeyRing
+
+	// MessageSigner is used to sign messages with keys that are only
+	// identified by their public key.
+	MessageSigner lnwallet.MessageSigner
 }
@@ -37,7 +37,7 @@ func (m *KeyLocator) Reset()         { *m = KeyLocator{} }
 func (m *KeyLocator) String() string { return proto.CompactTextString(m) }
 func (*KeyLocator) ProtoMessage()    {}
 func (*KeyLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{0}
+	return fileDescriptor_signer_f66818286a556745, []int{0}
 }
 func (m *KeyLocator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyLocator.Unmarshal(m, b)
@@ -89,7 +89,7 @@ func (m *KeyDescriptor) Reset()         { *m = KeyDescriptor{} }
 func (m *KeyDescriptor) String() string { return proto.CompactTextString(m) }
 func (*KeyDescriptor) ProtoMessage()    {}
 func (*KeyDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{1}
+	return fileDescriptor_signer_f66818286a556745, []int{1}
 }
 func (m *KeyDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyDescriptor.Unmarshal(m, b)
@@ -137,7 +137,7 @@ func (m *TxOut) Reset()         { *m = TxOut{} }
 func (m *TxOut) String() string { return proto.CompactTextString(m) }
 func (*TxOut) ProtoMessage()    {}
 func (*TxOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{2}
+	return fileDescriptor_signer_f66818286a556745, []int{2}
 }
 func (m *TxOut) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxOut.Unmarshal(m, b)
@@ -218,7 +218,7 @@ func (m *SignDescriptor) Reset()         { *m = SignDescriptor{} }
 func (m *SignDescriptor) String() string { return proto.CompactTextString(m) }
 func (*SignDescriptor) ProtoMessage()    {}
 func (*SignDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{3}
+	return fileDescriptor_signer_f66818286a556745, []int{3}
 }
 func (m *SignDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignDescriptor.Unmarshal(m, b)
@@ -301,7 +301,7 @@ func (m *SignReq) Reset()         { *m = SignReq{} }
 func (m *SignReq) String() string { return proto.CompactTextString(m) }
 func (*SignReq) ProtoMessage()    {}
 func (*SignReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{4}
+	return fileDescriptor_signer_f66818286a556745, []int{4}
 }
 func (m *SignReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignReq.Unmarshal(m, b)
@@ -349,7 +349,7 @@ func (m *SignResp) Reset()         { *m = SignResp{} }
 func (m *SignResp) String() string { return proto.CompactTextString(m) }
 func (*SignResp) ProtoMessage()    {}
 func (*SignResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{5}
+	return fileDescriptor_signer_f66818286a556745, []int{5}
 }
 func (m *SignResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignResp.Unmarshal(m, b)
@@ -392,7 +392,7 @@ func (m *InputScript) Reset()         { *m = InputScript{} }
 func (m *InputScript) String() string { return proto.CompactTextString(m) }
 func (*InputScript) ProtoMessage()    {}
 func (*InputScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{6}
+	return fileDescriptor_signer_f66818286a556745, []int{6}
 }
 func (m *InputScript) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InputScript.Unmarshal(m, b)
@@ -438,7 +438,7 @@ func (m *InputScriptResp) Reset()         { *m = InputScriptResp{} }
 func (m *InputScriptResp) String() string { return proto.CompactTextString(m) }
 func (*InputScriptResp) ProtoMessage()    {}
 func (*InputScriptResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_8dc402df3ea9a6a7, []int{7}
+	return fileDescriptor_signer_f66818286a556745, []int{7}
 }
 func (m *InputScriptResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InputScriptResp.Unmarshal(m, b)
@@ -465,6 +465,307 @@ func (m *InputScriptResp) GetInputScripts() []*InputScript {
 	return nil
 }
 
+type SignMessageReq struct {
+	// / The message to be signed.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// *
+	// The key to sign the message with. Either the raw bytes of the public key
+	// or the key locator must be specified.
+	KeyDesc *KeyDescriptor `protobuf:"bytes,2,opt,name=key_desc,json=keyDesc,proto3" json:"key_desc,omitempty"`
+	// *
+	// Sign the single instead of the double SHA-256 hash of the message. This
+	// requires the key locator to be specified.
+	SingleHash bool `protobuf:"varint,3,opt,name=single_hash,json=singleHash,proto3" json:"single_hash,omitempty"`
+	// *
+	// Return the signature in the compact, public key recoverable format instead
+	// of DER. This requires the key locator to be specified.
+	CompactSig           bool     `protobuf:"varint,4,opt,name=compact_sig,json=compactSig,proto3" json:"compact_sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageReq) Reset()         { *m = SignMessageReq{} }
+func (m *SignMessageReq) String() string { return proto.CompactTextString(m) }
+func (*SignMessageReq) ProtoMessage()    {}
+func (*SignMessageReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_f66818286a556745, []int{8}
+}
+func (m *SignMessageReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageReq.Unmarshal(m, b)
+}
+func (m *SignMessageReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageReq.Marshal(b, m, deterministic)
+}
+func (dst *SignMessageReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageReq.Merge(dst, src)
+}
+func (m *SignMessageReq) XXX_Size() int {
+	return xxx_messageInfo_SignMessageReq.Size(m)
+}
+func (m *SignMessageReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageReq proto.InternalMessageInfo
+
+func (m *SignMessageReq) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *SignMessageReq) GetKeyDesc() *KeyDescriptor {
+	if m != nil {
+		return m.KeyDesc
+	}
+	return nil
+}
+
+func (m *SignMessageReq) GetSingleHash() bool {
+	if m != nil {
+		return m.SingleHash
+	}
+	return false
+}
+
+func (m *SignMessageReq) GetCompactSig() bool {
+	if m != nil {
+		return m.CompactSig
+	}
+	return false
+}
+
+type SignMessageResp struct {
+	// *
+	// The signature over the double SHA-256 hash of the message, unless the
+	// single hash was requested. It's DER encoded, unless the compact format was
+	// requested.
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageResp) Reset()         { *m = SignMessageResp{} }
+func (m *SignMessageResp) String() string { return proto.CompactTextString(m) }
+func (*SignMessageResp) ProtoMessage()    {}
+func (*SignMessageResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_f66818286a556745, []int{9}
+}
+func (m *SignMessageResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageResp.Unmarshal(m, b)
+}
+func (m *SignMessageResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageResp.Marshal(b, m, deterministic)
+}
+func (dst *SignMessageResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageResp.Merge(dst, src)
+}
+func (m *SignMessageResp) XXX_Size() int {
+	return xxx_messageInfo_SignMessageResp.Size(m)
+}
+func (m *SignMessageResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageResp proto.InternalMessageInfo
+
+func (m *SignMessageResp) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SharedKeyRequest struct {
+	// / The ephemeral public key the shared secret is derived with.
+	EphemeralPubkey []byte `protobuf:"bytes,1,opt,name=ephemeral_pubkey,json=ephemeralPubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+	// *
+	// The key of which the private key is multiplied with the ephemeral public
+	// key. Either the raw bytes of the public key or the key locator must be
+	// specified.
+	KeyDesc              *KeyDescriptor `protobuf:"bytes,2,opt,name=key_desc,json=keyDesc,proto3" json:"key_desc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SharedKeyRequest) Reset()         { *m = SharedKeyRequest{} }
+func (m *SharedKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*SharedKeyRequest) ProtoMessage()    {}
+func (*SharedKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_f66818286a556745, []int{10}
+}
+func (m *SharedKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SharedKeyRequest.Unmarshal(m, b)
+}
+func (m *SharedKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SharedKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *SharedKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedKeyRequest.Merge(dst, src)
+}
+func (m *SharedKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_SharedKeyRequest.Size(m)
+}
+func (m *SharedKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedKeyRequest proto.InternalMessageInfo
+
+func (m *SharedKeyRequest) GetEphemeralPubkey() []byte {
+	if m != nil {
+		return m.EphemeralPubkey
+	}
+	return nil
+}
+
+func (m *SharedKeyRequest) GetKeyDesc() *KeyDescriptor {
+	if m != nil {
+		return m.KeyDesc
+	}
+	return nil
+}
+
+type SharedKeyResponse struct {
+	// / The SHA-256 hash of the compressed shared point.
+	SharedKey            []byte   `protobuf:"bytes,1,opt,name=shared_key,json=sharedKey,proto3" json:"shared_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SharedKeyResponse) Reset()         { *m = SharedKeyResponse{} }
+func (m *SharedKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*SharedKeyResponse) ProtoMessage()    {}
+func (*SharedKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_f66818286a556745, []int{11}
+}
+func (m *SharedKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SharedKeyResponse.Unmarshal(m, b)
+}
+func (m *SharedKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SharedKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *SharedKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedKeyResponse.Merge(dst, src)
+}
+func (m *SharedKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_SharedKeyResponse.Size(m)
+}
+func (m *SharedKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedKeyResponse proto.InternalMessageInfo
+
+func (m *SharedKeyResponse) GetSharedKey() []byte {
+	if m != nil {
+		return m.SharedKey
+	}
+	return nil
+}
+
+type VerifyMessageReq struct {
+	// / The message over which the signature is to be verified.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// *
+	// The DER encoded signature over the double SHA-256 hash of the message.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// / The public key the signature has to be valid for.
+	Pubkey               []byte   `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyMessageReq) Reset()         { *m = VerifyMessageReq{} }
+func (m *VerifyMessageReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageReq) ProtoMessage()    {}
+func (*VerifyMessageReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_f66818286a556745, []int{12}
+}
+func (m *VerifyMessageReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageReq.Unmarshal(m, b)
+}
+func (m *VerifyMessageReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageReq.Marshal(b, m, deterministic)
+}
+func (dst *VerifyMessageReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageReq.Merge(dst, src)
+}
+func (m *VerifyMessageReq) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageReq.Size(m)
+}
+func (m *VerifyMessageReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyMessageReq proto.InternalMessageInfo
+
+func (m *VerifyMessageReq) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *VerifyMessageReq) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *VerifyMessageReq) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type VerifyMessageResp struct {
+	// / Whether the signature was valid over the given message.
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyMessageResp) Reset()         { *m = VerifyMessageResp{} }
+func (m *VerifyMessageResp) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageResp) ProtoMessage()    {}
+func (*VerifyMessageResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_f66818286a556745, []int{13}
+}
+func (m *VerifyMessageResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageResp.Unmarshal(m, b)
+}
+func (m *VerifyMessageResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageResp.Marshal(b, m, deterministic)
+}
+func (dst *VerifyMessageResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageResp.Merge(dst, src)
+}
+func (m *VerifyMessageResp) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageResp.Size(m)
+}
+func (m *VerifyMessageResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyMessageResp proto.InternalMessageInfo
+
+func (m *VerifyMessageResp) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*KeyLocator)(nil), "signrpc.KeyLocator")
 	proto.RegisterType((*KeyDescriptor)(nil), "signrpc.KeyDescriptor")
@@ -474,6 +775,12 @@ func init() {
 	proto.RegisterType((*SignResp)(nil), "signrpc.SignResp")
 	proto.RegisterType((*InputScript)(nil), "signrpc.InputScript")
 	proto.RegisterType((*InputScriptResp)(nil), "signrpc.InputScriptResp")
+	proto.RegisterType((*SignMessageReq)(nil), "signrpc.SignMessageReq")
+	proto.RegisterType((*SignMessageResp)(nil), "signrpc.SignMessageResp")
+	proto.RegisterType((*SharedKeyRequest)(nil), "signrpc.SharedKeyRequest")
+	proto.RegisterType((*SharedKeyResponse)(nil), "signrpc.SharedKeyResponse")
+	proto.RegisterType((*VerifyMessageReq)(nil), "signrpc.VerifyMessageReq")
+	proto.RegisterType((*VerifyMessageResp)(nil), "signrpc.VerifyMessageResp")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +817,22 @@ type SignerClient interface {
 	// in the TxOut field, the value in that same field, and finally the input
 	// index.
 	ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error)
+	// *
+	// SignMessage signs a message with the key specified in the key descriptor.
+	// By default, the signature is over the double SHA-256 hash of the message,
+	// which matches the digest lnd signs for its own messages.
+	SignMessage(ctx context.Context, in *SignMessageReq, opts ...grpc.CallOption) (*SignMessageResp, error)
+	// *
+	// VerifyMessage verifies a signature over a message using the public key
+	// provided. The signature must be over the double SHA-256 hash of the
+	// message, as produced by SignMessage.
+	VerifyMessage(ctx context.Context, in *VerifyMessageReq, opts ...grpc.CallOption) (*VerifyMessageResp, error)
+	// *
+	// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman
+	// key derivation between the ephemeral public key in the request and the
+	// private key of the specified key descriptor. The returned key is the
+	// SHA-256 hash of the compressed shared point.
+	DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error)
 }
 
 type signerClient struct {
@@ -538,6 +861,33 @@ func (c *signerClient) ComputeInputScript(ctx context.Context, in *SignReq, opts
 	return out, nil
 }
 
+func (c *signerClient) SignMessage(ctx context.Context, in *SignMessageReq, opts ...grpc.CallOption) (*SignMessageResp, error) {
+	out := new(SignMessageResp)
+	err := c.cc.Invoke(ctx, "/signrpc.Signer/SignMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) VerifyMessage(ctx context.Context, in *VerifyMessageReq, opts ...grpc.CallOption) (*VerifyMessageResp, error) {
+	out := new(VerifyMessageResp)
+	err := c.cc.Invoke(ctx, "/signrpc.Signer/VerifyMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error) {
+	out := new(SharedKeyResponse)
+	err := c.cc.Invoke(ctx, "/signrpc.Signer/DeriveSharedKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SignerServer is the server API for Signer service.
 type SignerServer interface {
 	// *
@@ -562,6 +912,22 @@ type SignerServer interface {
 	// in the TxOut field, the value in that same field, and finally the input
 	// index.
 	ComputeInputScript(context.Context, *SignReq) (*InputScriptResp, error)
+	// *
+	// SignMessage signs a message with the key specified in the key descriptor.
+	// By default, the signature is over the double SHA-256 hash of the message,
+	// which matches the digest lnd signs for its own messages.
+	SignMessage(context.Context, *SignMessageReq) (*SignMessageResp, error)
+	// *
+	// VerifyMessage verifies a signature over a message using the public key
+	// provided. The signature must be over the double SHA-256 hash of the
+	// message, as produced by SignMessage.
+	VerifyMessage(context.Context, *VerifyMessageReq) (*VerifyMessageResp, error)
+	// *
+	// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman
+	// key derivation between the ephemeral public key in the request and the
+	// private key of the specified key descriptor. The returned key is the
+	// SHA-256 hash of the compressed shared point.
+	DeriveSharedKey(context.Context, *SharedKeyRequest) (*SharedKeyResponse, error)
 }
 
 func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
@@ -604,6 +970,60 @@ func _Signer_ComputeInputScript_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Signer_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signrpc.Signer/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignMessage(ctx, req.(*SignMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signrpc.Signer/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).VerifyMessage(ctx, req.(*VerifyMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeriveSharedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeriveSharedKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signrpc.Signer/DeriveSharedKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeriveSharedKey(ctx, req.(*SharedKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Signer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "signrpc.Signer",
 	HandlerType: (*SignerServer)(nil),
@@ -616,49 +1036,75 @@ var _Signer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ComputeInputScript",
 			Handler:    _Signer_ComputeInputScript_Handler,
 		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _Signer_SignMessage_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _Signer_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "DeriveSharedKey",
+			Handler:    _Signer_DeriveSharedKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "signrpc/signer.proto",
 }
 
-func init() { proto.RegisterFile("signrpc/signer.proto", fileDescriptor_signer_8dc402df3ea9a6a7) }
-
-var fileDescriptor_signer_8dc402df3ea9a6a7 = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x8f, 0xd3, 0x3e,
-	0x10, 0x55, 0xb7, 0xbf, 0x36, 0xdd, 0x49, 0xd2, 0x1f, 0x98, 0x0a, 0x02, 0x08, 0x51, 0x22, 0x2d,
-	0xea, 0x01, 0x5a, 0x51, 0x10, 0x12, 0x9c, 0xd0, 0x82, 0x56, 0xac, 0xba, 0xd2, 0x4a, 0x6e, 0x4f,
-	0x5c, 0xa2, 0x34, 0x35, 0xa9, 0x95, 0x34, 0xf1, 0xc6, 0x0e, 0x69, 0x6e, 0x7c, 0x07, 0xbe, 0x30,
-	0x1a, 0x3b, 0xfd, 0x07, 0x9c, 0x9a, 0xf7, 0x3c, 0x33, 0xef, 0x79, 0x5e, 0x0d, 0x03, 0xc9, 0xe3,
-	0xac, 0x10, 0xd1, 0x04, 0x7f, 0x59, 0x31, 0x16, 0x45, 0xae, 0x72, 0x62, 0x35, 0xac, 0xff, 0x15,
-	0x60, 0xc6, 0xea, 0x9b, 0x3c, 0x0a, 0x55, 0x5e, 0x90, 0x67, 0x00, 0x09, 0xab, 0x83, 0xef, 0xe1,
-	0x86, 0xa7, 0xb5, 0xd7, 0x1a, 0xb6, 0x46, 0x1d, 0x7a, 0x9e, 0xb0, 0xfa, 0x4a, 0x13, 0xe4, 0x29,
-	0x20, 0x08, 0x78, 0xb6, 0x62, 0x5b, 0xef, 0x4c, 0x9f, 0xf6, 0x12, 0x56, 0x5f, 0x23, 0xf6, 0x43,
-	0x70, 0x67, 0xac, 0xfe, 0xc2, 0x64, 0x54, 0x70, 0x81, 0xc3, 0x7c, 0x70, 0x8b, 0xb0, 0x0a, 0xb0,
-	0x63, 0x59, 0x2b, 0x26, 0xf5, 0x3c, 0x87, 0xda, 0x45, 0x58, 0xcd, 0x58, 0x7d, 0x89, 0x14, 0x79,
-	0x05, 0x16, 0x9e, 0xa7, 0x79, 0xa4, 0xe7, 0xd9, 0xd3, 0x07, 0xe3, 0xc6, 0xd9, 0xf8, 0x60, 0x8b,
-	0x76, 0x13, 0xfd, 0xed, 0x7f, 0x84, 0xce, 0x62, 0x7b, 0x5b, 0x2a, 0x32, 0x80, 0xce, 0x8f, 0x30,
-	0x2d, 0x99, 0x1e, 0xd9, 0xa6, 0x06, 0xa0, 0x3d, 0x91, 0x04, 0x46, 0x5f, 0x8f, 0x73, 0x68, 0x4f,
-	0x24, 0x73, 0x8d, 0xfd, 0x5f, 0x67, 0xd0, 0x9f, 0xf3, 0x38, 0x3b, 0x32, 0xf8, 0x06, 0xd0, 0x7d,
-	0xb0, 0x62, 0x32, 0xd2, 0x83, 0xec, 0xe9, 0xc3, 0x63, 0xf5, 0x43, 0x25, 0x45, 0x93, 0x08, 0xc9,
-	0x0b, 0x70, 0x24, 0xcf, 0xe2, 0x94, 0x05, 0xaa, 0x62, 0x61, 0xd2, 0xa8, 0xd8, 0x86, 0x5b, 0x20,
-	0x85, 0x25, 0xab, 0xbc, 0x5c, 0xee, 0x4b, 0xda, 0xa6, 0xc4, 0x70, 0xa6, 0xe4, 0x02, 0xfa, 0x15,
-	0x57, 0x19, 0x93, 0x72, 0xe7, 0xf6, 0x3f, 0x5d, 0xe4, 0x36, 0xac, 0xb1, 0x4c, 0x5e, 0x42, 0x37,
-	0x2f, 0x95, 0x28, 0x95, 0xd7, 0xd1, 0xee, 0xfa, 0x7b, 0x77, 0x7a, 0x0b, 0xb4, 0x39, 0x25, 0x1e,
-	0x60, 0x9c, 0xeb, 0x50, 0xae, 0x3d, 0x6b, 0xd8, 0x1a, 0xb9, 0x74, 0x07, 0xc9, 0x73, 0xb0, 0x79,
-	0x26, 0x4a, 0xd5, 0x44, 0xd6, 0xd3, 0x91, 0x81, 0xa6, 0x4c, 0x68, 0x11, 0x58, 0xb8, 0x14, 0xca,
-	0xee, 0xc8, 0x10, 0x1c, 0x8c, 0x4b, 0x6d, 0x4f, 0xd2, 0x82, 0x22, 0xac, 0x16, 0x5b, 0x13, 0xd6,
-	0x7b, 0x00, 0x34, 0xa0, 0x17, 0x26, 0xbd, 0xb3, 0x61, 0x7b, 0x64, 0x4f, 0x1f, 0xed, 0x3d, 0x9d,
-	0x2e, 0x97, 0x9e, 0xcb, 0x06, 0x4b, 0xff, 0x02, 0x7a, 0x46, 0x44, 0x0a, 0xf2, 0x18, 0x7a, 0xa8,
-	0x22, 0x79, 0x8c, 0x0a, 0xed, 0x91, 0x43, 0xad, 0x22, 0xac, 0xe6, 0x3c, 0x96, 0xfe, 0x15, 0xd8,
-	0xd7, 0xe8, 0xac, 0xb9, 0xbd, 0x07, 0x56, 0xb3, 0x8e, 0x5d, 0x61, 0x03, 0xf1, 0x5f, 0x2a, 0x79,
-	0x7c, 0x1a, 0x34, 0xca, 0x35, 0x49, 0xdf, 0xc0, 0xff, 0x47, 0x73, 0xb4, 0xea, 0x07, 0x70, 0xcd,
-	0x1e, 0x4c, 0x8f, 0x99, 0x68, 0x4f, 0x07, 0x7b, 0xf3, 0xc7, 0x0d, 0x0e, 0x3f, 0x00, 0x39, 0xfd,
-	0xd9, 0x82, 0xee, 0x5c, 0x3f, 0x1d, 0xf2, 0x0e, 0x5c, 0xfc, 0xba, 0xd5, 0x5b, 0xa7, 0x61, 0x45,
-	0xee, 0x9d, 0x5c, 0x9e, 0xb2, 0xbb, 0x27, 0xf7, 0xff, 0x60, 0xa4, 0x20, 0x9f, 0x80, 0x7c, 0xce,
-	0x37, 0xa2, 0x54, 0xec, 0xf8, 0x76, 0x7f, 0xb7, 0x7a, 0xff, 0x34, 0xc3, 0xa4, 0xb8, 0x9c, 0x7c,
-	0x7b, 0x1d, 0x73, 0xb5, 0x2e, 0x97, 0xe3, 0x28, 0xdf, 0x4c, 0x52, 0x1e, 0xaf, 0x55, 0xc6, 0xb3,
-	0x38, 0x63, 0xaa, 0xca, 0x8b, 0x64, 0x92, 0x66, 0xab, 0x49, 0xba, 0x7f, 0xe2, 0x85, 0x88, 0x96,
-	0x5d, 0xfd, 0xc8, 0xdf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x80, 0x01, 0xce, 0xe1, 0xfc, 0x03,
-	0x00, 0x00,
+func init() { proto.RegisterFile("signrpc/signer.proto", fileDescriptor_signer_f66818286a556745) }
+
+var fileDescriptor_signer_f66818286a556745 = []byte{
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdf, 0x8f, 0xdb, 0x44,
+	0x10, 0xd6, 0x25, 0x5c, 0xe2, 0x1b, 0x27, 0x77, 0xb9, 0xe5, 0x54, 0xdc, 0x00, 0xea, 0x61, 0xa9,
+	0x55, 0x2a, 0xa1, 0x8b, 0x08, 0x08, 0x09, 0x9e, 0x50, 0xa9, 0x4e, 0x57, 0xa5, 0xa8, 0x68, 0x73,
+	0xe2, 0xa1, 0x2f, 0xd6, 0xc6, 0x99, 0x3a, 0x2b, 0x27, 0xf6, 0x9e, 0xd7, 0xae, 0xe3, 0xbf, 0x83,
+	0x07, 0xfe, 0x31, 0xfe, 0x20, 0xb4, 0x3f, 0xe2, 0xd8, 0xe1, 0x40, 0x88, 0xa7, 0x78, 0xbe, 0x9d,
+	0x9d, 0xf9, 0xe6, 0xfb, 0xc6, 0x31, 0x5c, 0x49, 0x1e, 0x25, 0x99, 0x08, 0xa7, 0xea, 0x17, 0xb3,
+	0x1b, 0x91, 0xa5, 0x79, 0x4a, 0xfa, 0x16, 0xf5, 0xef, 0x00, 0xe6, 0x58, 0xbd, 0x4d, 0x43, 0x96,
+	0xa7, 0x19, 0xf9, 0x12, 0x20, 0xc6, 0x2a, 0xf8, 0xc0, 0xb6, 0x7c, 0x53, 0x79, 0x27, 0xd7, 0x27,
+	0x93, 0x53, 0x7a, 0x16, 0x63, 0x75, 0xab, 0x01, 0xf2, 0x39, 0xa8, 0x20, 0xe0, 0xc9, 0x0a, 0x77,
+	0x5e, 0x47, 0x9f, 0x3a, 0x31, 0x56, 0x6f, 0x54, 0xec, 0x33, 0x18, 0xce, 0xb1, 0x7a, 0x8d, 0x32,
+	0xcc, 0xb8, 0x50, 0xc5, 0x7c, 0x18, 0x66, 0xac, 0x0c, 0xd4, 0x8d, 0x65, 0x95, 0xa3, 0xd4, 0xf5,
+	0x06, 0xd4, 0xcd, 0x58, 0x39, 0xc7, 0xea, 0x95, 0x82, 0xc8, 0xd7, 0xd0, 0x57, 0xe7, 0x9b, 0x34,
+	0xd4, 0xf5, 0xdc, 0xd9, 0xa7, 0x37, 0x96, 0xd9, 0xcd, 0x81, 0x16, 0xed, 0xc5, 0xfa, 0xd9, 0xff,
+	0x11, 0x4e, 0xef, 0x77, 0xef, 0x8a, 0x9c, 0x5c, 0xc1, 0xe9, 0x47, 0xb6, 0x29, 0x50, 0x97, 0xec,
+	0x52, 0x13, 0x28, 0x7a, 0x22, 0x0e, 0x4c, 0x7f, 0x5d, 0x6e, 0x40, 0x1d, 0x11, 0x2f, 0x74, 0xec,
+	0xff, 0xde, 0x81, 0xf3, 0x05, 0x8f, 0x92, 0x06, 0xc1, 0x6f, 0x40, 0xb1, 0x0f, 0x56, 0x28, 0x43,
+	0x5d, 0xc8, 0x9d, 0x3d, 0x69, 0x76, 0x3f, 0x64, 0xd2, 0x7e, 0x6c, 0x42, 0xf2, 0x15, 0x0c, 0x24,
+	0x4f, 0xa2, 0x0d, 0x06, 0x79, 0x89, 0x2c, 0xb6, 0x5d, 0x5c, 0x83, 0xdd, 0x2b, 0x48, 0xa5, 0xac,
+	0xd2, 0x62, 0x59, 0xa7, 0x74, 0x4d, 0x8a, 0xc1, 0x4c, 0xca, 0x73, 0x38, 0x2f, 0x79, 0x9e, 0xa0,
+	0x94, 0x7b, 0xb6, 0x9f, 0xe8, 0xa4, 0xa1, 0x45, 0x0d, 0x65, 0xf2, 0x02, 0x7a, 0x69, 0x91, 0x8b,
+	0x22, 0xf7, 0x4e, 0x35, 0xbb, 0xf3, 0x9a, 0x9d, 0x56, 0x81, 0xda, 0x53, 0xe2, 0x81, 0xb2, 0x73,
+	0xcd, 0xe4, 0xda, 0xeb, 0x5f, 0x9f, 0x4c, 0x86, 0x74, 0x1f, 0x92, 0x67, 0xe0, 0xf2, 0x44, 0x14,
+	0xb9, 0xb5, 0xcc, 0xd1, 0x96, 0x81, 0x86, 0x8c, 0x69, 0x21, 0xf4, 0x95, 0x28, 0x14, 0x1f, 0xc8,
+	0x35, 0x0c, 0x94, 0x5d, 0xf9, 0xae, 0xe5, 0x16, 0x64, 0xac, 0xbc, 0xdf, 0x19, 0xb3, 0xbe, 0x07,
+	0x50, 0x04, 0xb4, 0x60, 0xd2, 0xeb, 0x5c, 0x77, 0x27, 0xee, 0xec, 0xb3, 0x9a, 0x53, 0x5b, 0x5c,
+	0x7a, 0x26, 0x6d, 0x2c, 0xfd, 0xe7, 0xe0, 0x98, 0x26, 0x52, 0x90, 0xa7, 0xe0, 0xa8, 0x2e, 0x92,
+	0x47, 0xaa, 0x43, 0x77, 0x32, 0xa0, 0xfd, 0x8c, 0x95, 0x0b, 0x1e, 0x49, 0xff, 0x16, 0xdc, 0x37,
+	0x8a, 0x99, 0x9d, 0xde, 0x83, 0xbe, 0x95, 0x63, 0x9f, 0x68, 0x43, 0xb5, 0xa5, 0x92, 0x47, 0x6d,
+	0xa3, 0x55, 0x3b, 0xeb, 0xf4, 0x5b, 0xb8, 0x68, 0xd4, 0xd1, 0x5d, 0x7f, 0x80, 0xa1, 0xd1, 0xc1,
+	0xdc, 0x31, 0x15, 0xdd, 0xd9, 0x55, 0x4d, 0xbe, 0x79, 0x61, 0xc0, 0x0f, 0x81, 0xf4, 0xff, 0x38,
+	0x31, 0x7b, 0xf3, 0x0b, 0x4a, 0xc9, 0x22, 0x54, 0x4a, 0x8d, 0xa0, 0xbb, 0x95, 0x91, 0x15, 0x48,
+	0x3d, 0xb6, 0x36, 0xa9, 0xf3, 0xdf, 0x36, 0xe9, 0x19, 0xd8, 0xad, 0x09, 0xb4, 0x71, 0x6a, 0x4b,
+	0x1c, 0x0a, 0x06, 0xba, 0xb3, 0xde, 0x85, 0xe9, 0x56, 0xb0, 0x30, 0x57, 0x6a, 0xe9, 0x0d, 0x71,
+	0x28, 0x58, 0x68, 0xc1, 0x23, 0x7f, 0x0a, 0x17, 0x2d, 0x62, 0x52, 0x90, 0x2f, 0x40, 0xcb, 0xce,
+	0xf2, 0x22, 0x43, 0xcb, 0xef, 0x00, 0xf8, 0x02, 0x46, 0x8b, 0x35, 0xcb, 0x70, 0x35, 0xc7, 0x8a,
+	0xe2, 0x43, 0x81, 0x32, 0x27, 0x2f, 0x61, 0x84, 0x62, 0x8d, 0x5b, 0xcc, 0xd8, 0x26, 0x10, 0xc5,
+	0x32, 0xc6, 0xca, 0x5e, 0xbc, 0xa8, 0xf1, 0x5f, 0x35, 0xfc, 0x3f, 0x86, 0xf4, 0x67, 0x70, 0xd9,
+	0xe8, 0x28, 0x45, 0x9a, 0x48, 0xd4, 0xf6, 0x69, 0x30, 0x38, 0x34, 0x3b, 0x93, 0xfb, 0x34, 0xff,
+	0x3d, 0x8c, 0x7e, 0xc3, 0x8c, 0x7f, 0xa8, 0xfe, 0x55, 0xf1, 0xd6, 0xa4, 0x9d, 0xa3, 0x49, 0xc9,
+	0x13, 0xe8, 0xd9, 0x59, 0xcc, 0xdb, 0x67, 0x23, 0xff, 0x25, 0x5c, 0x1e, 0xd5, 0x96, 0xc2, 0xfe,
+	0x99, 0xf0, 0x95, 0x2e, 0xef, 0x50, 0x13, 0xcc, 0xfe, 0xec, 0x40, 0x6f, 0xa1, 0xff, 0x32, 0xc9,
+	0x77, 0x30, 0x54, 0x4f, 0xef, 0xf4, 0xdb, 0x46, 0x59, 0x49, 0x46, 0xad, 0xa5, 0xa7, 0xf8, 0x30,
+	0xbe, 0x3c, 0x42, 0xa4, 0x20, 0x3f, 0x01, 0xf9, 0x39, 0xdd, 0x8a, 0x22, 0xc7, 0xe6, 0x56, 0xff,
+	0xfd, 0xaa, 0xf7, 0xe8, 0x12, 0x9a, 0x0a, 0x6e, 0xc3, 0x60, 0xd2, 0x7e, 0xd5, 0x0e, 0xea, 0x8c,
+	0xbd, 0xc7, 0x0f, 0xa4, 0x20, 0xb7, 0x30, 0x6c, 0xcd, 0x4b, 0x9e, 0xd6, 0xa9, 0xc7, 0x1a, 0x8f,
+	0xc7, 0xff, 0x74, 0x24, 0x05, 0xb9, 0x83, 0x8b, 0xd7, 0x98, 0xf1, 0x8f, 0x58, 0xbb, 0xd9, 0xa8,
+	0x74, 0xbc, 0x53, 0xe3, 0xf1, 0x63, 0x47, 0xc6, 0xfc, 0x57, 0x93, 0xf7, 0x2f, 0x22, 0x9e, 0xaf,
+	0x8b, 0xe5, 0x4d, 0x98, 0x6e, 0xa7, 0x25, 0x8b, 0x79, 0xc5, 0xb6, 0x4c, 0x4c, 0x37, 0xc9, 0x6a,
+	0xba, 0xa9, 0xbf, 0x53, 0x99, 0x08, 0x97, 0x3d, 0xfd, 0xa5, 0xfa, 0xf6, 0xaf, 0x01, 0x00, 0x04,
+	0x88, 0x3c, 0x16, 0xc1, 0x06, 0x00, 0x00,
 }
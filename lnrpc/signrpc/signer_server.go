@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/wakiyamap/lnd/input"
@@ -37,6 +38,10 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "signer",
+			Action: "read",
+		},
 	}
 
 	// macPermissions maps RPC calls to the permissions they require.
@@ -49,6 +54,18 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		}},
+		"/signrpc.Signer/SignMessage": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/signrpc.Signer/VerifyMessage": {{
+			Entity: "signer",
+			Action: "read",
+		}},
+		"/signrpc.Signer/DeriveSharedKey": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
 	}
 
 	// DefaultSignerMacFilename is the default name of the signer macaroon
@@ -201,9 +218,9 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 	for _, signDesc := range in.SignDescs {
 		keyDesc := signDesc.KeyDesc
 
-		// The caller can either specify the key using the raw pubkey,
-		// or the description of the key. Below we'll feel out the
-		// oneof field to decide which one we will attempt to parse.
+		// The caller can specify the key using the raw pubkey, the
+		// description of the key, or both. Below we'll parse whichever
+		// of the two fields are set.
 		var (
 			targetPubKey *btcec.PublicKey
 			keyLoc       keychain.KeyLocator
@@ -236,9 +253,13 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 				}
 			}
 
+		}
+
 		// Similarly, if they specified a key locator, then we'll use
-		// that instead.
-		case keyDesc.GetKeyLoc() != nil:
+		// that as well. Passing both allows the signer to derive the
+		// key directly, rather than having to look it up by its public
+		// key.
+		if keyDesc.GetKeyLoc() != nil {
 			protoLoc := keyDesc.GetKeyLoc()
 			keyLoc = keychain.KeyLocator{
 				Family: keychain.KeyFamily(
@@ -355,8 +376,9 @@ func (s *Server) ComputeInputScript(ctx context.Context,
 				Value:    signDesc.Output.Value,
 				PkScript: signDesc.Output.PkScript,
 			},
-			HashType:  txscript.SigHashType(signDesc.Sighash),
-			SigHashes: sigHashCache,
+			HashType:   txscript.SigHashType(signDesc.Sighash),
+			SigHashes:  sigHashCache,
+			InputIndex: int(signDesc.InputIndex),
 		})
 	}
 
@@ -383,3 +405,203 @@ func (s *Server) ComputeInputScript(ctx context.Context,
 
 	return resp, nil
 }
+
+// SignMessage signs a message with the key specified in the key descriptor.
+// The signature is over the double SHA-256 hash of the message, unless the
+// single hash is requested. If only the public key of the target key is known,
+// then the key is looked up within the wallet.
+func (s *Server) SignMessage(ctx context.Context,
+	in *SignMessageReq) (*SignMessageResp, error) {
+
+	if in.Msg == nil {
+		return nil, fmt.Errorf("a message to sign MUST be passed in")
+	}
+
+	keyDesc, err := parseKeyDescriptor(in.KeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// A message that is to be signed with a raw key only is signed by the
+	// message signer, which only produces DER signatures over the double
+	// SHA-256 hash of the message.
+	if keyDesc.PubKey != nil && keyDesc.KeyLocator.IsEmpty() {
+		if in.SingleHash || in.CompactSig {
+			return nil, fmt.Errorf("single_hash and compact_sig " +
+				"require the key locator to be specified")
+		}
+
+		sig, err := s.cfg.MessageSigner.SignMessage(
+			keyDesc.PubKey, in.Msg,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SignMessageResp{
+			Signature: sig.Serialize(),
+		}, nil
+	}
+
+	if err := s.checkKeyLocator(keyDesc); err != nil {
+		return nil, err
+	}
+
+	privKey, err := s.cfg.KeyRing.DerivePrivKey(keyDesc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive key: %v", err)
+	}
+	signer := keychain.NewPrivKeyMessageSigner(privKey)
+
+	if in.CompactSig {
+		sig, err := signer.SignMessageCompact(in.Msg, !in.SingleHash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign message: %v",
+				err)
+		}
+
+		return &SignMessageResp{
+			Signature: sig,
+		}, nil
+	}
+
+	sig, err := signer.SignMessage(in.Msg, !in.SingleHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign message: %v", err)
+	}
+
+	return &SignMessageResp{
+		Signature: sig.Serialize(),
+	}, nil
+}
+
+// VerifyMessage verifies a signature over a message using the public key
+// provided. The signature must be over the double SHA-256 hash of the message,
+// as produced by SignMessage.
+func (s *Server) VerifyMessage(ctx context.Context,
+	in *VerifyMessageReq) (*VerifyMessageResp, error) {
+
+	if in.Msg == nil {
+		return nil, fmt.Errorf("a message to verify MUST be passed in")
+	}
+	if in.Signature == nil {
+		return nil, fmt.Errorf("a signature to verify MUST be passed " +
+			"in")
+	}
+	if len(in.Pubkey) != 33 {
+		return nil, fmt.Errorf("pubkey must be serialized in " +
+			"compressed format")
+	}
+
+	pubKey, err := btcec.ParsePubKey(in.Pubkey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	// A signature that can't be parsed is reported as invalid, rather
+	// than as an error, as it can't be valid for any message.
+	sig, err := btcec.ParseDERSignature(in.Signature, btcec.S256())
+	if err != nil {
+		return &VerifyMessageResp{
+			Valid: false,
+		}, nil
+	}
+
+	digest := chainhash.DoubleHashB(in.Msg)
+	return &VerifyMessageResp{
+		Valid: sig.Verify(digest, pubKey),
+	}, nil
+}
+
+// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman key
+// derivation between the ephemeral public key in the request and the private
+// key of the specified key descriptor. The returned key is the SHA-256 hash of
+// the compressed shared point.
+func (s *Server) DeriveSharedKey(ctx context.Context,
+	in *SharedKeyRequest) (*SharedKeyResponse, error) {
+
+	if len(in.EphemeralPubkey) != 33 {
+		return nil, fmt.Errorf("ephemeral pubkey must be serialized " +
+			"in compressed format")
+	}
+	ephemeralPubkey, err := btcec.ParsePubKey(
+		in.EphemeralPubkey, btcec.S256(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	keyDesc, err := parseKeyDescriptor(in.KeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkKeyLocator(keyDesc); err != nil {
+		return nil, err
+	}
+
+	sharedKey, err := s.cfg.KeyRing.ScalarMult(keyDesc, ephemeralPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shared key: %v", err)
+	}
+
+	return &SharedKeyResponse{
+		SharedKey: sharedKey,
+	}, nil
+}
+
+// parseKeyDescriptor converts the passed RPC key descriptor into the key
+// descriptor used by the key ring. Unlike within a SignDescriptor, both the
+// raw public key and the key locator may be specified, in which case the key
+// family is scanned for the public key.
+func parseKeyDescriptor(in *KeyDescriptor) (keychain.KeyDescriptor, error) {
+	var keyDesc keychain.KeyDescriptor
+	if in == nil || (len(in.RawKeyBytes) == 0 && in.KeyLoc == nil) {
+		return keyDesc, fmt.Errorf("either the raw public key or the " +
+			"key locator MUST be specified")
+	}
+
+	if len(in.RawKeyBytes) != 0 {
+		if len(in.RawKeyBytes) != 33 {
+			return keyDesc, fmt.Errorf("pubkey must be " +
+				"serialized in compressed format if specified")
+		}
+
+		pubKey, err := btcec.ParsePubKey(in.RawKeyBytes, btcec.S256())
+		if err != nil {
+			return keyDesc, fmt.Errorf("unable to parse pubkey: %v",
+				err)
+		}
+		keyDesc.PubKey = pubKey
+	}
+
+	if in.KeyLoc != nil {
+		keyDesc.KeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamily(in.KeyLoc.KeyFamily),
+			Index:  uint32(in.KeyLoc.KeyIndex),
+		}
+	}
+
+	return keyDesc, nil
+}
+
+// checkKeyLocator ensures that the key at the key locator of the descriptor is
+// the public key of the descriptor, if both of them are specified. The key
+// ring only scans the key family for the public key if the key index is zero,
+// and otherwise uses the key at the locator regardless of the public key.
+func (s *Server) checkKeyLocator(keyDesc keychain.KeyDescriptor) error {
+	if keyDesc.PubKey == nil || keyDesc.Index == 0 {
+		return nil
+	}
+
+	locatedKey, err := s.cfg.KeyRing.DeriveKey(keyDesc.KeyLocator)
+	if err != nil {
+		return fmt.Errorf("unable to derive key: %v", err)
+	}
+	if !locatedKey.PubKey.IsEqual(keyDesc.PubKey) {
+		return fmt.Errorf("key at key locator %v doesn't match the "+
+			"public key", keyDesc.KeyLocator)
+	}
+
+	return nil
+}
@@ -0,0 +1,431 @@
+// +build signrpc
+
+package signrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
+	"github.com/wakiyamap/lnd/keychain"
+)
+
+var (
+	testHDSeed = chainhash.Hash{
+		0xb7, 0x94, 0x38, 0x5f, 0x2d, 0x1e, 0xf7, 0xab,
+		0x4d, 0x92, 0x73, 0xd1, 0x90, 0x63, 0x81, 0xb4,
+		0x4f, 0x2f, 0x6f, 0x25, 0x98, 0xa3, 0xef, 0xb9,
+		0x69, 0x49, 0x18, 0x83, 0x31, 0x98, 0x47, 0x53,
+	}
+
+	testMsg = []byte("test message")
+
+	testKeyLoc = &KeyLocator{
+		KeyFamily: int32(keychain.KeyFamilyNodeKey),
+		KeyIndex:  1,
+	}
+)
+
+// keyRingMessageSigner signs messages with the node keys of a key ring, which
+// are identified by their public key only.
+type keyRingMessageSigner struct {
+	keyRing keychain.SecretKeyRing
+}
+
+func (k *keyRingMessageSigner) SignMessage(pubKey *btcec.PublicKey,
+	msg []byte) (*btcec.Signature, error) {
+
+	privKey, err := k.keyRing.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+		},
+		PubKey: pubKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return privKey.Sign(chainhash.DoubleHashB(msg))
+}
+
+// newTestServer creates a signer server backed by the key ring of a fresh
+// wallet.
+func newTestServer(t *testing.T) (*Server, keychain.SecretKeyRing, func()) {
+	tempDir, err := ioutil.TempDir("", "signrpc")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	loader := wallet.NewLoader(&chaincfg.SimNetParams, tempDir, 0)
+	pass := []byte("test")
+	baseWallet, err := loader.CreateNewWallet(
+		pass, pass, testHDSeed[:], time.Time{},
+	)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	cleanUp := func() {
+		baseWallet.Lock()
+		loader.UnloadWallet()
+		os.RemoveAll(tempDir)
+	}
+
+	if err := baseWallet.Unlock(pass, nil); err != nil {
+		cleanUp()
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	// The key ring derives its keys from the lightning key scope, which
+	// the wallet doesn't create by itself.
+	chainKeyScope := waddrmgr.KeyScope{
+		Purpose: keychain.BIP0043Purpose,
+		Coin:    keychain.CoinTypeTestnet,
+	}
+	err = walletdb.Update(baseWallet.Database(), func(
+		tx walletdb.ReadWriteTx) error {
+
+		addrmgrNs := tx.ReadWriteBucket([]byte("waddrmgr"))
+		_, err := baseWallet.Manager.NewScopedKeyManager(
+			addrmgrNs, chainKeyScope, waddrmgr.ScopeAddrSchema{
+				ExternalAddrType: waddrmgr.WitnessPubKey,
+				InternalAddrType: waddrmgr.WitnessPubKey,
+			},
+		)
+		return err
+	})
+	if err != nil {
+		cleanUp()
+		t.Fatalf("unable to create key scope: %v", err)
+	}
+
+	keyRing := keychain.NewBtcWalletKeyRing(
+		baseWallet, keychain.CoinTypeTestnet,
+	)
+	server := &Server{
+		cfg: &Config{
+			KeyRing: keyRing,
+			MessageSigner: &keyRingMessageSigner{
+				keyRing: keyRing,
+			},
+		},
+	}
+
+	return server, keyRing, cleanUp
+}
+
+// deriveTestKey returns the public key at the passed RPC key locator.
+func deriveTestKey(t *testing.T, keyRing keychain.SecretKeyRing,
+	keyLoc *KeyLocator) *btcec.PublicKey {
+
+	t.Helper()
+
+	keyDesc, err := keyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamily(keyLoc.KeyFamily),
+		Index:  uint32(keyLoc.KeyIndex),
+	})
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+
+	return keyDesc.PubKey
+}
+
+// TestSignVerifyMessage asserts that messages signed with keys identified by
+// either their key locator or their public key are verified against the
+// public key, and that altered messages or signatures are rejected.
+func TestSignVerifyMessage(t *testing.T) {
+	t.Parallel()
+
+	server, keyRing, cleanUp := newTestServer(t)
+	defer cleanUp()
+
+	ctx := context.Background()
+	pubKey := deriveTestKey(t, keyRing, testKeyLoc)
+	nodeKey := deriveTestKey(t, keyRing, &KeyLocator{
+		KeyFamily: int32(keychain.KeyFamilyNodeKey),
+	})
+
+	keyDescs := []*KeyDescriptor{
+		{KeyLoc: testKeyLoc},
+		{
+			RawKeyBytes: pubKey.SerializeCompressed(),
+			KeyLoc:      testKeyLoc,
+		},
+		{RawKeyBytes: nodeKey.SerializeCompressed()},
+	}
+	signers := []*btcec.PublicKey{pubKey, pubKey, nodeKey}
+
+	for i, keyDesc := range keyDescs {
+		signResp, err := server.SignMessage(ctx, &SignMessageReq{
+			Msg:     testMsg,
+			KeyDesc: keyDesc,
+		})
+		if err != nil {
+			t.Fatalf("unable to sign message with key "+
+				"descriptor %v: %v", i, err)
+		}
+
+		verifyResp, err := server.VerifyMessage(ctx, &VerifyMessageReq{
+			Msg:       testMsg,
+			Signature: signResp.Signature,
+			Pubkey:    signers[i].SerializeCompressed(),
+		})
+		if err != nil {
+			t.Fatalf("unable to verify signature: %v", err)
+		}
+		if !verifyResp.Valid {
+			t.Fatalf("expected signature of key descriptor %v "+
+				"to be valid", i)
+		}
+	}
+
+	signResp, err := server.SignMessage(ctx, &SignMessageReq{
+		Msg:     testMsg,
+		KeyDesc: &KeyDescriptor{KeyLoc: testKeyLoc},
+	})
+	if err != nil {
+		t.Fatalf("unable to sign message: %v", err)
+	}
+
+	// A signature over a different message, a signature by a different
+	// key, and a signature that can't be parsed at all are all invalid.
+	tests := []struct {
+		name      string
+		msg       []byte
+		signature []byte
+		pubKey    *btcec.PublicKey
+	}{
+		{
+			name:      "different message",
+			msg:       []byte("other message"),
+			signature: signResp.Signature,
+			pubKey:    pubKey,
+		},
+		{
+			name:      "different key",
+			msg:       testMsg,
+			signature: signResp.Signature,
+			pubKey:    nodeKey,
+		},
+		{
+			name:      "malformed signature",
+			msg:       testMsg,
+			signature: signResp.Signature[1:],
+			pubKey:    pubKey,
+		},
+	}
+	for _, test := range tests {
+		verifyResp, err := server.VerifyMessage(ctx, &VerifyMessageReq{
+			Msg:       test.msg,
+			Signature: test.signature,
+			Pubkey:    test.pubKey.SerializeCompressed(),
+		})
+		if err != nil {
+			t.Fatalf("%v: unable to verify signature: %v",
+				test.name, err)
+		}
+		if verifyResp.Valid {
+			t.Fatalf("%v: expected signature to be invalid",
+				test.name)
+		}
+	}
+
+	// A public key that isn't compressed can't be verified against.
+	_, err = server.VerifyMessage(ctx, &VerifyMessageReq{
+		Msg:       testMsg,
+		Signature: signResp.Signature,
+		Pubkey:    pubKey.SerializeUncompressed(),
+	})
+	if err == nil {
+		t.Fatalf("expected uncompressed public key to be rejected")
+	}
+}
+
+// TestSignMessageCompact asserts that the public key can be recovered from a
+// compact signature over either the single or double SHA-256 hash of the
+// message.
+func TestSignMessageCompact(t *testing.T) {
+	t.Parallel()
+
+	server, keyRing, cleanUp := newTestServer(t)
+	defer cleanUp()
+
+	pubKey := deriveTestKey(t, keyRing, testKeyLoc)
+
+	for _, singleHash := range []bool{false, true} {
+		signResp, err := server.SignMessage(
+			context.Background(), &SignMessageReq{
+				Msg:        testMsg,
+				KeyDesc:    &KeyDescriptor{KeyLoc: testKeyLoc},
+				SingleHash: singleHash,
+				CompactSig: true,
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to sign message: %v", err)
+		}
+
+		digest := chainhash.DoubleHashB(testMsg)
+		if singleHash {
+			digest = chainhash.HashB(testMsg)
+		}
+		recoveredKey, _, err := btcec.RecoverCompact(
+			btcec.S256(), signResp.Signature, digest,
+		)
+		if err != nil {
+			t.Fatalf("unable to recover public key: %v", err)
+		}
+		if !recoveredKey.IsEqual(pubKey) {
+			t.Fatalf("recovered wrong public key with "+
+				"single_hash=%v", singleHash)
+		}
+	}
+}
+
+// TestInvalidKeyDescriptors asserts that messages aren't signed and shared
+// keys aren't derived for key descriptors that are missing, malformed, or that
+// don't match the key at their key locator.
+func TestInvalidKeyDescriptors(t *testing.T) {
+	t.Parallel()
+
+	server, keyRing, cleanUp := newTestServer(t)
+	defer cleanUp()
+
+	ctx := context.Background()
+	otherKey := deriveTestKey(t, keyRing, &KeyLocator{
+		KeyFamily: testKeyLoc.KeyFamily,
+		KeyIndex:  testKeyLoc.KeyIndex + 1,
+	})
+	ephemeralKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		keyDesc *KeyDescriptor
+	}{
+		{
+			name: "no key descriptor",
+		},
+		{
+			name:    "empty key descriptor",
+			keyDesc: &KeyDescriptor{},
+		},
+		{
+			name: "malformed public key",
+			keyDesc: &KeyDescriptor{
+				RawKeyBytes: otherKey.SerializeCompressed()[1:],
+			},
+		},
+		{
+			name: "key locator of other key",
+			keyDesc: &KeyDescriptor{
+				RawKeyBytes: otherKey.SerializeCompressed(),
+				KeyLoc:      testKeyLoc,
+			},
+		},
+	}
+	for _, test := range tests {
+		_, err := server.SignMessage(ctx, &SignMessageReq{
+			Msg:     testMsg,
+			KeyDesc: test.keyDesc,
+		})
+		if err == nil {
+			t.Fatalf("%v: expected message not to be signed",
+				test.name)
+		}
+
+		_, err = server.DeriveSharedKey(ctx, &SharedKeyRequest{
+			EphemeralPubkey: ephemeralKey.PubKey().
+				SerializeCompressed(),
+			KeyDesc: test.keyDesc,
+		})
+		if err == nil {
+			t.Fatalf("%v: expected shared key not to be derived",
+				test.name)
+		}
+	}
+
+	// Keys that are only identified by their public key can't produce
+	// compact signatures.
+	_, err = server.SignMessage(ctx, &SignMessageReq{
+		Msg: testMsg,
+		KeyDesc: &KeyDescriptor{
+			RawKeyBytes: otherKey.SerializeCompressed(),
+		},
+		CompactSig: true,
+	})
+	if err == nil {
+		t.Fatalf("expected compact signature to require a key locator")
+	}
+}
+
+// TestDeriveSharedKey asserts that the shared key is the SHA-256 hash of the
+// Diffie-Hellman point of our key and a known ephemeral key, as computed by
+// the owner of the ephemeral key.
+func TestDeriveSharedKey(t *testing.T) {
+	t.Parallel()
+
+	server, keyRing, cleanUp := newTestServer(t)
+	defer cleanUp()
+
+	pubKey := deriveTestKey(t, keyRing, testKeyLoc)
+
+	ephemeralPriv, ephemeralPub := btcec.PrivKeyFromBytes(
+		btcec.S256(), bytes.Repeat([]byte{0x02}, 32),
+	)
+	x, y := btcec.S256().ScalarMult(
+		pubKey.X, pubKey.Y, ephemeralPriv.D.Bytes(),
+	)
+	sharedPoint := &btcec.PublicKey{X: x, Y: y}
+	expectedKey := sha256.Sum256(sharedPoint.SerializeCompressed())
+
+	keyDescs := []*KeyDescriptor{
+		{KeyLoc: testKeyLoc},
+		{
+			RawKeyBytes: pubKey.SerializeCompressed(),
+			KeyLoc:      testKeyLoc,
+		},
+	}
+	for i, keyDesc := range keyDescs {
+		resp, err := server.DeriveSharedKey(
+			context.Background(), &SharedKeyRequest{
+				EphemeralPubkey: ephemeralPub.
+					SerializeCompressed(),
+				KeyDesc: keyDesc,
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to derive shared key with key "+
+				"descriptor %v: %v", i, err)
+		}
+		if !bytes.Equal(resp.SharedKey, expectedKey[:]) {
+			t.Fatalf("expected shared key %x with key descriptor "+
+				"%v, got %x", expectedKey, i, resp.SharedKey)
+		}
+	}
+
+	// An ephemeral key that isn't compressed is rejected.
+	_, err := server.DeriveSharedKey(
+		context.Background(), &SharedKeyRequest{
+			EphemeralPubkey: ephemeralPub.SerializeUncompressed(),
+			KeyDesc:         &KeyDescriptor{KeyLoc: testKeyLoc},
+		},
+	)
+	if err == nil {
+		t.Fatalf("expected uncompressed ephemeral key to be rejected")
+	}
+}
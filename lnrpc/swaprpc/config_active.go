@@ -0,0 +1,88 @@
+// +build swaprpc
+
+package swaprpc
+
+import (
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/invoices"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/macaroons"
+	"github.com/wakiyamap/lnd/netann"
+	"github.com/wakiyamap/lnd/routing"
+	"github.com/wakiyamap/lnd/sweep"
+)
+
+// Config is the primary configuration struct for the swap RPC server. It
+// contains all the items required for the rpc server to carry out its
+// duties. The fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields MUST
+// also be specified.
+type Config struct {
+	// SwapServer is the host:port of the swap server that new swaps are
+	// negotiated with. If not set, swaps can't be initiated.
+	SwapServer string `long:"swapserver" description:"The host:port of the swap server to negotiate swaps with"`
+
+	// SwapServerTLSCertPath is the path to the TLS certificate of the swap
+	// server. If not set, the system's root certificates are used.
+	SwapServerTLSCertPath string `long:"swapservertlscertpath" description:"Path to the TLS certificate of the swap server"`
+
+	// SwapServerNoTLS disables TLS for the connection to the swap server.
+	SwapServerNoTLS bool `long:"swapservernotls" description:"Connect to the swap server without TLS, only use this for testing"`
+
+	// NetworkDir is the main network directory wherein the swap rpc
+	// server will find the macaroon named DefaultSwapMacFilename.
+	NetworkDir string
+
+	// MacService is the main macaroon service that we'll use to handle
+	// authentication for the swap rpc server.
+	MacService *macaroons.Service
+
+	// ChainParams are the parameters of the chain swaps are executed on.
+	ChainParams *chaincfg.Params
+
+	// ChainNotifier is used to watch the on-chain HTLCs of swaps.
+	ChainNotifier chainntnfs.ChainNotifier
+
+	// ChainIO is used to query the current best block.
+	ChainIO lnwallet.BlockChainIO
+
+	// Wallet is the wallet that funds the on-chain HTLCs of loop in swaps.
+	Wallet *lnwallet.LightningWallet
+
+	// KeyRing is used to derive our keys within the on-chain HTLCs.
+	KeyRing keychain.KeyRing
+
+	// FeeEstimator is used to determine the fee rate of on-chain HTLCs.
+	FeeEstimator lnwallet.FeeEstimator
+
+	// Sweeper sweeps the on-chain HTLCs of swaps into the wallet.
+	Sweeper *sweep.UtxoSweeper
+
+	// Router is used to pay the invoices of loop out swaps.
+	Router *routing.ChannelRouter
+
+	// InvoiceRegistry is where the hold invoices of loop in swaps are
+	// added.
+	InvoiceRegistry *invoices.InvoiceRegistry
+
+	// IsChannelActive is used to generate valid hop hints.
+	IsChannelActive func(chanID lnwire.ChannelID) bool
+
+	// NodeSigner is an implementation of the MessageSigner implementation
+	// that's backed by the identity private key of the running lnd node.
+	NodeSigner *netann.NodeSigner
+
+	// MaxPaymentMSat is the maximum allowed payment.
+	MaxPaymentMSat lnwire.MilliSatoshi
+
+	// DefaultCLTVExpiry is the default invoice expiry if no values is
+	// specified.
+	DefaultCLTVExpiry uint32
+
+	// ChanDB is the database that swaps are persisted in.
+	ChanDB *channeldb.DB
+}
@@ -0,0 +1,156 @@
+// +build swaprpc
+
+package swaprpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/lntypes"
+	"github.com/wakiyamap/lnd/swap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// remoteServer implements the swap.Server interface on top of a gRPC
+// connection to a remote swap server.
+type remoteServer struct {
+	conn   *grpc.ClientConn
+	client SwapServerClient
+}
+
+// A compile-time constraint to ensure remoteServer implements swap.Server.
+var _ swap.Server = (*remoteServer)(nil)
+
+// dialSwapServer connects to the swap server configured within the given
+// config.
+func dialSwapServer(cfg *Config) (*remoteServer, error) {
+	var opts []grpc.DialOption
+	switch {
+	case cfg.SwapServerNoTLS:
+		opts = append(opts, grpc.WithInsecure())
+
+	case cfg.SwapServerTLSCertPath != "":
+		creds, err := credentials.NewClientTLSFromFile(
+			cfg.SwapServerTLSCertPath, "",
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+
+	default:
+		creds := credentials.NewTLS(&tls.Config{})
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
+	conn, err := grpc.Dial(cfg.SwapServer, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to swap server %v: %v",
+			cfg.SwapServer, err)
+	}
+
+	return &remoteServer{
+		conn:   conn,
+		client: NewSwapServerClient(conn),
+	}, nil
+}
+
+// LoopOutTerms returns the terms under which the server executes loop out
+// swaps.
+//
+// NOTE: This is part of the swap.Server interface.
+func (r *remoteServer) LoopOutTerms(ctx context.Context) (*swap.Terms, error) {
+	resp, err := r.client.LoopOutTerms(ctx, &TermsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshallTerms(resp), nil
+}
+
+// NewLoopOutSwap requests a new loop out swap of the given amount.
+//
+// NOTE: This is part of the swap.Server interface.
+func (r *remoteServer) NewLoopOutSwap(ctx context.Context,
+	swapHash lntypes.Hash, amt btcutil.Amount,
+	receiverKey [33]byte) (*swap.NewLoopOutResponse, error) {
+
+	resp, err := r.client.NewLoopOutSwap(ctx, &ServerLoopOutRequest{
+		SwapHash:    swapHash[:],
+		Amt:         int64(amt),
+		ReceiverKey: receiverKey[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.SenderKey) != 33 {
+		return nil, fmt.Errorf("invalid sender key of length %v",
+			len(resp.SenderKey))
+	}
+
+	loopOutResp := &swap.NewLoopOutResponse{
+		SwapInvoice: resp.SwapInvoice,
+		CltvExpiry:  resp.CltvExpiry,
+	}
+	copy(loopOutResp.SenderKey[:], resp.SenderKey)
+
+	return loopOutResp, nil
+}
+
+// LoopInTerms returns the terms under which the server executes loop in
+// swaps.
+//
+// NOTE: This is part of the swap.Server interface.
+func (r *remoteServer) LoopInTerms(ctx context.Context) (*swap.Terms, error) {
+	resp, err := r.client.LoopInTerms(ctx, &TermsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshallTerms(resp), nil
+}
+
+// NewLoopInSwap requests a new loop in swap of the given amount.
+//
+// NOTE: This is part of the swap.Server interface.
+func (r *remoteServer) NewLoopInSwap(ctx context.Context,
+	swapHash lntypes.Hash, amt btcutil.Amount, senderKey [33]byte,
+	swapInvoice string) (*swap.NewLoopInResponse, error) {
+
+	resp, err := r.client.NewLoopInSwap(ctx, &ServerLoopInRequest{
+		SwapHash:    swapHash[:],
+		Amt:         int64(amt),
+		SenderKey:   senderKey[:],
+		SwapInvoice: swapInvoice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.ReceiverKey) != 33 {
+		return nil, fmt.Errorf("invalid receiver key of length %v",
+			len(resp.ReceiverKey))
+	}
+
+	loopInResp := &swap.NewLoopInResponse{
+		CltvExpiry: resp.CltvExpiry,
+	}
+	copy(loopInResp.ReceiverKey[:], resp.ReceiverKey)
+
+	return loopInResp, nil
+}
+
+// unmarshallTerms converts the swap terms returned by the server.
+func unmarshallTerms(resp *TermsResponse) *swap.Terms {
+	return &swap.Terms{
+		MinSwapAmount: btcutil.Amount(resp.MinSwapAmount),
+		MaxSwapAmount: btcutil.Amount(resp.MaxSwapAmount),
+		SwapFeeBase:   btcutil.Amount(resp.SwapFeeBase),
+		SwapFeeRate:   resp.SwapFeeRate,
+		CltvDelta:     resp.CltvDelta,
+	}
+}
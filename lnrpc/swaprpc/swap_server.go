@@ -0,0 +1,548 @@
+// +build swaprpc
+
+package swaprpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/lnrpc"
+	"github.com/wakiyamap/lnd/lnrpc/invoicesrpc"
+	"github.com/wakiyamap/lnd/lntypes"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/routing"
+	"github.com/wakiyamap/lnd/routing/route"
+	"github.com/wakiyamap/lnd/swap"
+	"github.com/wakiyamap/lnd/sweep"
+	"github.com/wakiyamap/lnd/zpay32"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize it as the name of our
+	// RPC service.
+	subServerName = "SwapRPC"
+)
+
+var (
+	// macaroonOps are the set of capabilities that our minted macaroon (if
+	// it doesn't already exist) will have.
+	macaroonOps = []bakery.Op{
+		{
+			Entity: "swap",
+			Action: "write",
+		},
+		{
+			Entity: "swap",
+			Action: "read",
+		},
+	}
+
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/swaprpc.SwapClient/LoopOut": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/swaprpc.SwapClient/LoopIn": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/swaprpc.SwapClient/ListSwaps": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/swaprpc.SwapClient/SwapInfo": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/swaprpc.SwapClient/LoopOutTerms": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/swaprpc.SwapClient/LoopInTerms": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+	}
+
+	// DefaultSwapMacFilename is the default name of the swap macaroon
+	// that we expect to find via a file handle within the main
+	// configuration file in this package.
+	DefaultSwapMacFilename = "swap.macaroon"
+
+	// ErrNoSwapServer is returned when a swap is requested without a swap
+	// server being configured.
+	ErrNoSwapServer = errors.New("no swap server configured")
+)
+
+// Server is a sub-server of the main RPC server: the swap RPC. This sub RPC
+// server executes submarine swaps, moving funds between our channels and the
+// on-chain wallet through a remote swap server.
+type Server struct {
+	started  int32 // To be used atomically.
+	shutdown int32 // To be used atomically.
+
+	cfg *Config
+
+	// swapStore is where all swaps are persisted.
+	swapStore *swap.Store
+
+	// remote is the connection to the swap server, and client executes
+	// the swaps. Both are nil if no swap server is configured.
+	remote *remoteServer
+	client *swap.Client
+}
+
+// A compile time check to ensure that Server fully implements the
+// SwapClientServer gRPC service.
+var _ SwapClientServer = (*Server)(nil)
+
+// New returns a new instance of the swaprpc SwapClient sub-server. We also
+// return the set of permissions for the macaroons that we may create within
+// this method. If the macaroons we need aren't found in the filepath, then
+// we'll create them on start up. If we're unable to locate, or create the
+// macaroons we need, then we'll return with an error.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	// If the path of the swap macaroon wasn't specified, then we'll
+	// assume that it's found at the default network directory.
+	macFilePath := filepath.Join(cfg.NetworkDir, DefaultSwapMacFilename)
+
+	// Now that we know the full path of the swap macaroon, we can check
+	// to see if we need to create it or not.
+	if !lnrpc.FileExists(macFilePath) && cfg.MacService != nil {
+		log.Infof("Baking macaroons for swap RPC Server at: %v",
+			macFilePath)
+
+		// At this point, we know that the swap macaroon doesn't yet,
+		// exist, so we need to create it with the help of the main
+		// macaroon service.
+		swapMac, err := cfg.MacService.Oven.NewMacaroon(
+			context.Background(), bakery.LatestVersion, nil,
+			macaroonOps...,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		swapMacBytes, err := swapMac.M().MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		err = ioutil.WriteFile(macFilePath, swapMacBytes, 0644)
+		if err != nil {
+			os.Remove(macFilePath)
+			return nil, nil, err
+		}
+	}
+
+	return &Server{
+		cfg: cfg,
+	}, macPermissions, nil
+}
+
+// Start connects to the swap server and resumes all pending swaps.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return nil
+	}
+
+	store, err := swap.NewStore(s.cfg.ChanDB)
+	if err != nil {
+		return err
+	}
+	s.swapStore = store
+
+	// Without a swap server no new swaps can be initiated, but we still
+	// list the ones executed before.
+	if s.cfg.SwapServer == "" {
+		log.Infof("No swap server configured, swaps are disabled")
+		return nil
+	}
+
+	s.remote, err = dialSwapServer(s.cfg)
+	if err != nil {
+		return err
+	}
+
+	s.client = swap.New(s.swapClientConfig(store))
+	if err := s.client.Start(); err != nil {
+		s.remote.conn.Close()
+		return err
+	}
+
+	log.Infof("Swap client started using swap server %v",
+		s.cfg.SwapServer)
+
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	if !atomic.CompareAndSwapInt32(&s.shutdown, 0, 1) {
+		return nil
+	}
+
+	if s.client != nil {
+		if err := s.client.Stop(); err != nil {
+			return err
+		}
+	}
+	if s.remote != nil {
+		return s.remote.conn.Close()
+	}
+
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a sub
+// RPC server to register itself with the main gRPC root server. Until this is
+// called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterSwapClientServer(grpcServer, s)
+
+	log.Debugf("Swap RPC server successfully registered with root gRPC " +
+		"server")
+
+	return nil
+}
+
+// swapClientConfig wires the swap client into the running daemon.
+func (s *Server) swapClientConfig(store *swap.Store) *swap.Config {
+	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.cfg.InvoiceRegistry.AddInvoice,
+		IsChannelActive:   s.cfg.IsChannelActive,
+		ChainParams:       s.cfg.ChainParams,
+		NodeSigner:        s.cfg.NodeSigner,
+		MaxPaymentMSat:    s.cfg.MaxPaymentMSat,
+		DefaultCLTVExpiry: s.cfg.DefaultCLTVExpiry,
+		ChanDB:            s.cfg.ChanDB,
+	}
+
+	return &swap.Config{
+		Store:        store,
+		Server:       s.remote,
+		ChainParams:  s.cfg.ChainParams,
+		Notifier:     s.cfg.ChainNotifier,
+		KeyRing:      s.cfg.KeyRing,
+		FeeEstimator: s.cfg.FeeEstimator,
+		BestHeight: func() (int32, error) {
+			_, height, err := s.cfg.ChainIO.GetBestBlock()
+			return height, err
+		},
+		SendPayment: s.sendPayment,
+		AddHoldInvoice: func(hash lntypes.Hash, amt btcutil.Amount,
+			memo string) (string, error) {
+
+			_, invoice, err := invoicesrpc.AddInvoice(
+				context.Background(), addInvoiceCfg,
+				&invoicesrpc.AddInvoiceData{
+					Memo:  memo,
+					Hash:  &hash,
+					Value: amt,
+				},
+			)
+			if err != nil {
+				return "", err
+			}
+
+			return string(invoice.PaymentRequest), nil
+		},
+		SubscribeInvoice: func(hash lntypes.Hash) (
+			<-chan *channeldb.Invoice, func(), error) {
+
+			sub := s.cfg.InvoiceRegistry.SubscribeSingleInvoice(hash)
+			return sub.Updates, sub.Cancel, nil
+		},
+		SettleInvoice: s.cfg.InvoiceRegistry.SettleHodlInvoice,
+		CancelInvoice: s.cfg.InvoiceRegistry.CancelInvoice,
+		FundHtlc: func(output *wire.TxOut,
+			feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+			authoredTx, err := s.cfg.Wallet.CreateSimpleTx(
+				[]*wire.TxOut{output}, feeRate, false,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return authoredTx.Tx, nil
+		},
+		PublishTransaction: s.cfg.Wallet.PublishTransaction,
+		SweepInput: func(inp input.Input) (chan sweep.Result, error) {
+			return s.cfg.Sweeper.SweepInput(inp)
+		},
+	}
+}
+
+// sendPayment pays the invoice of a loop out swap through the router.
+func (s *Server) sendPayment(payReq string, maxFee btcutil.Amount) error {
+	invoice, err := zpay32.Decode(payReq, s.cfg.ChainParams)
+	if err != nil {
+		return err
+	}
+	if invoice.MilliSat == nil {
+		return fmt.Errorf("zero value invoices are not supported")
+	}
+
+	var destination route.Vertex
+	copy(destination[:], invoice.Destination.SerializeCompressed())
+
+	finalDelta := uint16(invoice.MinFinalCLTVExpiry())
+	_, _, err = s.cfg.Router.SendPayment(&routing.LightningPayment{
+		Target:         destination,
+		Amount:         *invoice.MilliSat,
+		FeeLimit:       lnwire.NewMSatFromSatoshis(maxFee),
+		PaymentHash:    *invoice.PaymentHash,
+		FinalCLTVDelta: &finalDelta,
+		RouteHints:     invoice.RouteHints,
+	})
+
+	return err
+}
+
+// LoopOut initiates a swap of off-chain funds into the on-chain wallet.
+func (s *Server) LoopOut(ctx context.Context,
+	req *LoopOutRequest) (*SwapResponse, error) {
+
+	if s.client == nil {
+		return nil, ErrNoSwapServer
+	}
+
+	swp, err := s.client.LoopOut(ctx, &swap.LoopOutRequest{
+		Amount:        btcutil.Amount(req.Amt),
+		MaxSwapFee:    btcutil.Amount(req.MaxSwapFee),
+		MaxPaymentFee: btcutil.Amount(req.MaxPaymentFee),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.marshallSwapResponse(swp)
+}
+
+// LoopIn initiates a swap of on-chain funds into our channels.
+func (s *Server) LoopIn(ctx context.Context,
+	req *LoopInRequest) (*SwapResponse, error) {
+
+	if s.client == nil {
+		return nil, ErrNoSwapServer
+	}
+
+	if req.HtlcConfTarget < 0 {
+		return nil, fmt.Errorf("invalid htlc conf target %v",
+			req.HtlcConfTarget)
+	}
+
+	swp, err := s.client.LoopIn(ctx, &swap.LoopInRequest{
+		Amount:         btcutil.Amount(req.Amt),
+		MaxSwapFee:     btcutil.Amount(req.MaxSwapFee),
+		HtlcConfTarget: uint32(req.HtlcConfTarget),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.marshallSwapResponse(swp)
+}
+
+// ListSwaps returns all swaps known to the swap client.
+func (s *Server) ListSwaps(ctx context.Context,
+	req *ListSwapsRequest) (*ListSwapsResponse, error) {
+
+	swaps, err := s.swapStore.FetchSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListSwapsResponse{
+		Swaps: make([]*SwapStatus, 0, len(swaps)),
+	}
+	for _, swp := range swaps {
+		status, err := s.marshallSwapStatus(swp)
+		if err != nil {
+			return nil, err
+		}
+		resp.Swaps = append(resp.Swaps, status)
+	}
+
+	return resp, nil
+}
+
+// SwapInfo returns the status of a single swap.
+func (s *Server) SwapInfo(ctx context.Context,
+	req *SwapInfoRequest) (*SwapStatus, error) {
+
+	hash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	swp, err := s.swapStore.FetchSwap(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.marshallSwapStatus(swp)
+}
+
+// LoopOutTerms returns the terms of the swap server for loop out swaps.
+func (s *Server) LoopOutTerms(ctx context.Context,
+	req *TermsRequest) (*TermsResponse, error) {
+
+	if s.client == nil {
+		return nil, ErrNoSwapServer
+	}
+
+	terms, err := s.client.LoopOutTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallTerms(terms), nil
+}
+
+// LoopInTerms returns the terms of the swap server for loop in swaps.
+func (s *Server) LoopInTerms(ctx context.Context,
+	req *TermsRequest) (*TermsResponse, error) {
+
+	if s.client == nil {
+		return nil, ErrNoSwapServer
+	}
+
+	terms, err := s.client.LoopInTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallTerms(terms), nil
+}
+
+// marshallSwapResponse converts a newly initiated swap to its RPC
+// representation.
+func (s *Server) marshallSwapResponse(swp *swap.Swap) (*SwapResponse, error) {
+	htlc, err := swap.NewHtlc(&swp.Contract, s.cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := swp.Hash()
+	return &SwapResponse{
+		Id:          hash[:],
+		HtlcAddress: htlc.Address.String(),
+	}, nil
+}
+
+// marshallSwapStatus converts a swap to its RPC representation.
+func (s *Server) marshallSwapStatus(swp *swap.Swap) (*SwapStatus, error) {
+	htlc, err := swap.NewHtlc(&swp.Contract, s.cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var swapType SwapType
+	switch swp.Type {
+	case swap.TypeLoopOut:
+		swapType = SwapType_LOOP_OUT
+
+	case swap.TypeLoopIn:
+		swapType = SwapType_LOOP_IN
+
+	default:
+		return nil, fmt.Errorf("unknown swap type: %v", swp.Type)
+	}
+
+	state, err := marshallSwapState(swp.State())
+	if err != nil {
+		return nil, err
+	}
+
+	var htlcTxid string
+	if txHash := swp.HtlcTxHash(); txHash != nil {
+		htlcTxid = txHash.String()
+	}
+
+	hash := swp.Hash()
+	return &SwapStatus{
+		Id:             hash[:],
+		Type:           swapType,
+		State:          state,
+		Amt:            int64(swp.Amount),
+		HtlcAddress:    htlc.Address.String(),
+		HtlcTxid:       htlcTxid,
+		CltvExpiry:     swp.CltvExpiry,
+		InitiationTime: swp.InitiationTime.UnixNano(),
+		LastUpdateTime: swp.LastUpdateTime().UnixNano(),
+	}, nil
+}
+
+// marshallSwapState converts a swap state to its RPC representation.
+func marshallSwapState(state swap.State) (SwapState, error) {
+	switch state {
+	case swap.StateInitiated:
+		return SwapState_INITIATED, nil
+
+	case swap.StateHtlcPublished:
+		return SwapState_HTLC_PUBLISHED, nil
+
+	case swap.StatePreimageRevealed:
+		return SwapState_PREIMAGE_REVEALED, nil
+
+	case swap.StateSuccess:
+		return SwapState_SUCCESS, nil
+
+	case swap.StateFailOffchainPayment:
+		return SwapState_FAILED_OFFCHAIN_PAYMENT, nil
+
+	case swap.StateFailTimeout:
+		return SwapState_FAILED_TIMEOUT, nil
+
+	case swap.StateFailInsufficientValue:
+		return SwapState_FAILED_INSUFFICIENT_VALUE, nil
+
+	case swap.StateFailHtlcPublication:
+		return SwapState_FAILED_HTLC_PUBLICATION, nil
+
+	default:
+		return 0, fmt.Errorf("unknown swap state: %v", state)
+	}
+}
+
+// marshallTerms converts swap terms to their RPC representation.
+func marshallTerms(terms *swap.Terms) *TermsResponse {
+	return &TermsResponse{
+		MinSwapAmount: int64(terms.MinSwapAmount),
+		MaxSwapAmount: int64(terms.MaxSwapAmount),
+		SwapFeeBase:   int64(terms.SwapFeeBase),
+		SwapFeeRate:   terms.SwapFeeRate,
+		CltvDelta:     terms.CltvDelta,
+	}
+}
@@ -3,6 +3,7 @@
 package walletrpc
 
 import (
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/macaroons"
@@ -33,9 +34,17 @@ type Config struct {
 
 	// Wallet is the primary wallet that the WalletKit will use to proxy
 	// any relevant requests to.
-	Wallet lnwallet.WalletController
+	Wallet *lnwallet.LightningWallet
 
 	// KeyRing is an interface that the WalletKit will use to derive any
 	// keys due to incoming client requests.
 	KeyRing keychain.KeyRing
+
+	// ChainParams are the parameters of the chain the wallet is active
+	// on, which are required to derive keys from a wallet seed.
+	ChainParams *chaincfg.Params
+
+	// CoinType is the BIP 44 coin type the keys of the KeyRing are
+	// derived with.
+	CoinType uint32
 }
@@ -24,6 +24,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type AddressType int32
+
+const (
+	AddressType_UNKNOWN                    AddressType = 0
+	AddressType_WITNESS_PUBKEY_HASH        AddressType = 1
+	AddressType_NESTED_WITNESS_PUBKEY_HASH AddressType = 2
+)
+
+var AddressType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "WITNESS_PUBKEY_HASH",
+	2: "NESTED_WITNESS_PUBKEY_HASH",
+}
+var AddressType_value = map[string]int32{
+	"UNKNOWN":                    0,
+	"WITNESS_PUBKEY_HASH":        1,
+	"NESTED_WITNESS_PUBKEY_HASH": 2,
+}
+
+func (x AddressType) String() string {
+	return proto.EnumName(AddressType_name, int32(x))
+}
+func (AddressType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{0}
+}
+
 type KeyReq struct {
 	// *
 	// Is the key finger print of the root pubkey that this request is targeting.
@@ -43,7 +69,7 @@ func (m *KeyReq) Reset()         { *m = KeyReq{} }
 func (m *KeyReq) String() string { return proto.CompactTextString(m) }
 func (*KeyReq) ProtoMessage()    {}
 func (*KeyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{0}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{0}
 }
 func (m *KeyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyReq.Unmarshal(m, b)
@@ -87,7 +113,7 @@ func (m *AddrRequest) Reset()         { *m = AddrRequest{} }
 func (m *AddrRequest) String() string { return proto.CompactTextString(m) }
 func (*AddrRequest) ProtoMessage()    {}
 func (*AddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{1}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{1}
 }
 func (m *AddrRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrRequest.Unmarshal(m, b)
@@ -120,7 +146,7 @@ func (m *AddrResponse) Reset()         { *m = AddrResponse{} }
 func (m *AddrResponse) String() string { return proto.CompactTextString(m) }
 func (*AddrResponse) ProtoMessage()    {}
 func (*AddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{2}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{2}
 }
 func (m *AddrResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrResponse.Unmarshal(m, b)
@@ -160,7 +186,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{3}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{3}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -204,7 +230,7 @@ func (m *PublishResponse) Reset()         { *m = PublishResponse{} }
 func (m *PublishResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishResponse) ProtoMessage()    {}
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{4}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{4}
 }
 func (m *PublishResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishResponse.Unmarshal(m, b)
@@ -248,7 +274,7 @@ func (m *SendOutputsRequest) Reset()         { *m = SendOutputsRequest{} }
 func (m *SendOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendOutputsRequest) ProtoMessage()    {}
 func (*SendOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{5}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{5}
 }
 func (m *SendOutputsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendOutputsRequest.Unmarshal(m, b)
@@ -295,7 +321,7 @@ func (m *SendOutputsResponse) Reset()         { *m = SendOutputsResponse{} }
 func (m *SendOutputsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendOutputsResponse) ProtoMessage()    {}
 func (*SendOutputsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{6}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{6}
 }
 func (m *SendOutputsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendOutputsResponse.Unmarshal(m, b)
@@ -335,7 +361,7 @@ func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
 func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeRequest) ProtoMessage()    {}
 func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{7}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{7}
 }
 func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
@@ -376,7 +402,7 @@ func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
 func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeResponse) ProtoMessage()    {}
 func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{8}
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{8}
 }
 func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
@@ -403,231 +429,1646 @@ func (m *EstimateFeeResponse) GetSatPerKw() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
-	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
-	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
-	proto.RegisterType((*Transaction)(nil), "walletrpc.Transaction")
-	proto.RegisterType((*PublishResponse)(nil), "walletrpc.PublishResponse")
-	proto.RegisterType((*SendOutputsRequest)(nil), "walletrpc.SendOutputsRequest")
-	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
-	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
-	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
+type OutPoint struct {
+	// / Raw bytes representing the transaction id.
+	TxidBytes []byte `protobuf:"bytes,1,opt,name=txid_bytes,json=txidBytes,proto3" json:"txid_bytes,omitempty"`
+	// / The index of the output on the transaction.
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *OutPoint) Reset()         { *m = OutPoint{} }
+func (m *OutPoint) String() string { return proto.CompactTextString(m) }
+func (*OutPoint) ProtoMessage()    {}
+func (*OutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{9}
+}
+func (m *OutPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutPoint.Unmarshal(m, b)
+}
+func (m *OutPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutPoint.Marshal(b, m, deterministic)
+}
+func (dst *OutPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutPoint.Merge(dst, src)
+}
+func (m *OutPoint) XXX_Size() int {
+	return xxx_messageInfo_OutPoint.Size(m)
+}
+func (m *OutPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutPoint.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_OutPoint proto.InternalMessageInfo
 
-// WalletKitClient is the client API for WalletKit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletKitClient interface {
+func (m *OutPoint) GetTxidBytes() []byte {
+	if m != nil {
+		return m.TxidBytes
+	}
+	return nil
+}
+
+func (m *OutPoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+type FundPsbtRequest struct {
 	// *
-	// DeriveNextKey attempts to derive the *next* key within the key family
-	// (account in BIP43) specified. This method should return the next external
-	// child within this branch.
-	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	// A serialized template PSBT of which the outputs should be funded. If the
+	// template already spends inputs, only the amount they don't cover is funded.
+	// The spent outputs of inputs that don't belong to the wallet must be
+	// described by the template. Either psbt or outputs must be set.
+	Psbt []byte `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
 	// *
-	// DeriveKey attempts to derive an arbitrary key specified by the passed
-	// KeyLocator.
-	DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	// The outputs to fund, for which a new PSBT is created. Either psbt or
+	// outputs must be set.
+	Outputs []*signrpc.TxOut `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	// *
-	// NextAddr returns the next unused address within the wallet.
-	NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error)
+	// The number of blocks the transaction should confirm within, which is used
+	// to estimate the fee rate. Only one of conf_target and sat_per_kw may be
+	// set.
+	ConfTarget int32 `protobuf:"varint,3,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
 	// *
-	// PublishTransaction attempts to publish the passed transaction to the
-	// network. Once this returns without an error, the wallet will continually
-	// attempt to re-broadcast the transaction on start up, until it enters the
-	// chain.
-	PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error)
+	// The number of satoshis per kilo weight that should be used when crafting
+	// the transaction.
+	SatPerKw int64 `protobuf:"varint,4,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
 	// *
-	// SendOutputs is similar to the existing sendmany call in Bitcoind, and
-	// allows the caller to create a transaction that sends to several outputs at
-	// once. This is ideal when wanting to batch create a set of transactions.
-	SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error)
+	// The number of seconds the selected inputs are locked for. If not set, they
+	// are locked for 10 minutes.
+	LockExpirationSeconds uint64 `protobuf:"varint,5,opt,name=lock_expiration_seconds,json=lockExpirationSeconds,proto3" json:"lock_expiration_seconds,omitempty"`
 	// *
-	// EstimateFee attempts to query the internal fee estimator of the wallet to
-	// determine the fee (in sat/kw) to attach to a transaction in order to
-	// achieve the confirmation target.
-	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// The account whose outputs fund the PSBT, which also receives the change. If
+	// not set, the default account is used. The inputs of a watch-only account
+	// are annotated with their BIP 32 derivation paths, such that they can be
+	// signed by the external signer holding the account's keys.
+	Account              string   `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type walletKitClient struct {
-	cc *grpc.ClientConn
+func (m *FundPsbtRequest) Reset()         { *m = FundPsbtRequest{} }
+func (m *FundPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtRequest) ProtoMessage()    {}
+func (*FundPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{10}
 }
-
-func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
-	return &walletKitClient{cc}
+func (m *FundPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtRequest.Unmarshal(m, b)
+}
+func (m *FundPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtRequest.Marshal(b, m, deterministic)
+}
+func (dst *FundPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtRequest.Merge(dst, src)
+}
+func (m *FundPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtRequest.Size(m)
+}
+func (m *FundPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtRequest.DiscardUnknown(m)
 }
 
-func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
-	out := new(signrpc.KeyDescriptor)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FundPsbtRequest proto.InternalMessageInfo
+
+func (m *FundPsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletKitClient) DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
-	out := new(signrpc.KeyDescriptor)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FundPsbtRequest) GetOutputs() []*signrpc.TxOut {
+	if m != nil {
+		return m.Outputs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletKitClient) NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error) {
-	out := new(AddrResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/NextAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FundPsbtRequest) GetConfTarget() int32 {
+	if m != nil {
+		return m.ConfTarget
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
-	out := new(PublishResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FundPsbtRequest) GetSatPerKw() int64 {
+	if m != nil {
+		return m.SatPerKw
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletKitClient) SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error) {
-	out := new(SendOutputsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SendOutputs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FundPsbtRequest) GetLockExpirationSeconds() uint64 {
+	if m != nil {
+		return m.LockExpirationSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
-	out := new(EstimateFeeResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/EstimateFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FundPsbtRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-// WalletKitServer is the server API for WalletKit service.
-type WalletKitServer interface {
-	// *
-	// DeriveNextKey attempts to derive the *next* key within the key family
-	// (account in BIP43) specified. This method should return the next external
-	// child within this branch.
-	DeriveNextKey(context.Context, *KeyReq) (*signrpc.KeyDescriptor, error)
-	// *
-	// DeriveKey attempts to derive an arbitrary key specified by the passed
-	// KeyLocator.
-	DeriveKey(context.Context, *signrpc.KeyLocator) (*signrpc.KeyDescriptor, error)
-	// *
-	// NextAddr returns the next unused address within the wallet.
-	NextAddr(context.Context, *AddrRequest) (*AddrResponse, error)
-	// *
-	// PublishTransaction attempts to publish the passed transaction to the
-	// network. Once this returns without an error, the wallet will continually
-	// attempt to re-broadcast the transaction on start up, until it enters the
-	// chain.
-	PublishTransaction(context.Context, *Transaction) (*PublishResponse, error)
+type FundPsbtResponse struct {
+	// / The serialized PSBT, which spends the selected wallet inputs.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
 	// *
-	// SendOutputs is similar to the existing sendmany call in Bitcoind, and
-	// allows the caller to create a transaction that sends to several outputs at
-	// once. This is ideal when wanting to batch create a set of transactions.
-	SendOutputs(context.Context, *SendOutputsRequest) (*SendOutputsResponse, error)
+	// The index of the change output that was added, or -1 if no change output
+	// was added.
+	ChangeOutputIndex int32 `protobuf:"varint,2,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
 	// *
-	// EstimateFee attempts to query the internal fee estimator of the wallet to
-	// determine the fee (in sat/kw) to attach to a transaction in order to
-	// achieve the confirmation target.
-	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// The wallet outputs that were locked, which includes both the selected
+	// outputs and the inputs of the template that belong to the wallet. They
+	// remain locked until they're spent, released using ReleaseOutput, their lock
+	// expires, or lnd is restarted. The locks are only held in memory and aren't
+	// persisted, so a PSBT that isn't published before a restart may have its
+	// inputs selected again by another transaction.
+	LockedUtxos []*OutPoint `protobuf:"bytes,3,rep,name=locked_utxos,json=lockedUtxos,proto3" json:"locked_utxos,omitempty"`
+	// / The unix timestamp in seconds at which the locks expire.
+	LockExpiration       int64    `protobuf:"varint,4,opt,name=lock_expiration,json=lockExpiration,proto3" json:"lock_expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
-	s.RegisterService(&_WalletKit_serviceDesc, srv)
+func (m *FundPsbtResponse) Reset()         { *m = FundPsbtResponse{} }
+func (m *FundPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtResponse) ProtoMessage()    {}
+func (*FundPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{11}
+}
+func (m *FundPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtResponse.Unmarshal(m, b)
+}
+func (m *FundPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtResponse.Marshal(b, m, deterministic)
+}
+func (dst *FundPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtResponse.Merge(dst, src)
+}
+func (m *FundPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtResponse.Size(m)
+}
+func (m *FundPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtResponse.DiscardUnknown(m)
 }
 
-func _WalletKit_DeriveNextKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeyReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletKitServer).DeriveNextKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/walletrpc.WalletKit/DeriveNextKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletKitServer).DeriveNextKey(ctx, req.(*KeyReq))
+var xxx_messageInfo_FundPsbtResponse proto.InternalMessageInfo
+
+func (m *FundPsbtResponse) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WalletKit_DeriveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(signrpc.KeyLocator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletKitServer).DeriveKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/walletrpc.WalletKit/DeriveKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletKitServer).DeriveKey(ctx, req.(*signrpc.KeyLocator))
+func (m *FundPsbtResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletKit_NextAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletKitServer).NextAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/walletrpc.WalletKit/NextAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletKitServer).NextAddr(ctx, req.(*AddrRequest))
+func (m *FundPsbtResponse) GetLockedUtxos() []*OutPoint {
+	if m != nil {
+		return m.LockedUtxos
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WalletKit_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Transaction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletKitServer).PublishTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/walletrpc.WalletKit/PublishTransaction",
+func (m *FundPsbtResponse) GetLockExpiration() int64 {
+	if m != nil {
+		return m.LockExpiration
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletKitServer).PublishTransaction(ctx, req.(*Transaction))
+	return 0
+}
+
+type ReleaseOutputRequest struct {
+	// / The outpoint of the output to release.
+	Outpoint             *OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{12}
+}
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(dst, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WalletKit_SendOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{13}
+}
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(dst, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
+type SignPsbtRequest struct {
+	// / The serialized PSBT to sign.
+	FundedPsbt           []byte   `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtRequest) Reset()         { *m = SignPsbtRequest{} }
+func (m *SignPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtRequest) ProtoMessage()    {}
+func (*SignPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{14}
+}
+func (m *SignPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtRequest.Unmarshal(m, b)
+}
+func (m *SignPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtRequest.Merge(dst, src)
+}
+func (m *SignPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtRequest.Size(m)
+}
+func (m *SignPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtRequest proto.InternalMessageInfo
+
+func (m *SignPsbtRequest) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+type SignPsbtResponse struct {
+	// / The serialized PSBT, carrying the signatures of the wallet.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	// / The indexes of the inputs that were signed by the wallet.
+	SignedInputs         []uint32 `protobuf:"varint,2,rep,packed,name=signed_inputs,json=signedInputs,proto3" json:"signed_inputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtResponse) Reset()         { *m = SignPsbtResponse{} }
+func (m *SignPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtResponse) ProtoMessage()    {}
+func (*SignPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{15}
+}
+func (m *SignPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtResponse.Unmarshal(m, b)
+}
+func (m *SignPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtResponse.Marshal(b, m, deterministic)
+}
+func (dst *SignPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtResponse.Merge(dst, src)
+}
+func (m *SignPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtResponse.Size(m)
+}
+func (m *SignPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtResponse proto.InternalMessageInfo
+
+func (m *SignPsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *SignPsbtResponse) GetSignedInputs() []uint32 {
+	if m != nil {
+		return m.SignedInputs
+	}
+	return nil
+}
+
+type FinalizePsbtRequest struct {
+	// *
+	// The serialized PSBT to sign and finalize. All inputs that don't belong to
+	// the wallet must already carry their signatures.
+	FundedPsbt           []byte   `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtRequest) Reset()         { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()    {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{16}
+}
+func (m *FinalizePsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtRequest.Unmarshal(m, b)
+}
+func (m *FinalizePsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtRequest.Marshal(b, m, deterministic)
+}
+func (dst *FinalizePsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtRequest.Merge(dst, src)
+}
+func (m *FinalizePsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtRequest.Size(m)
+}
+func (m *FinalizePsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtRequest proto.InternalMessageInfo
+
+func (m *FinalizePsbtRequest) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+type FinalizePsbtResponse struct {
+	// / The serialized PSBT, of which all inputs are finalized.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	// / The fully signed transaction, ready to be published.
+	RawFinalTx           []byte   `protobuf:"bytes,2,opt,name=raw_final_tx,json=rawFinalTx,proto3" json:"raw_final_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtResponse) Reset()         { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()    {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{17}
+}
+func (m *FinalizePsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtResponse.Unmarshal(m, b)
+}
+func (m *FinalizePsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtResponse.Marshal(b, m, deterministic)
+}
+func (dst *FinalizePsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtResponse.Merge(dst, src)
+}
+func (m *FinalizePsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtResponse.Size(m)
+}
+func (m *FinalizePsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtResponse proto.InternalMessageInfo
+
+func (m *FinalizePsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtResponse) GetRawFinalTx() []byte {
+	if m != nil {
+		return m.RawFinalTx
+	}
+	return nil
+}
+
+type Account struct {
+	// / The name of the account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// / The BIP 44 account number of the account.
+	AccountNumber uint32 `protobuf:"varint,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// / The balance of the account, from outputs with at least one confirmation.
+	ConfirmedBalance int64 `protobuf:"varint,3,opt,name=confirmed_balance,json=confirmedBalance,proto3" json:"confirmed_balance,omitempty"`
+	// / The balance of the account, from unconfirmed outputs.
+	UnconfirmedBalance int64 `protobuf:"varint,4,opt,name=unconfirmed_balance,json=unconfirmedBalance,proto3" json:"unconfirmed_balance,omitempty"`
+	// *
+	// Whether the account was imported, in which case the wallet only tracks its
+	// outputs and can't sign for them.
+	WatchOnly            bool     `protobuf:"varint,5,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{18}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (dst *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(dst, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *Account) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *Account) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
+func (m *Account) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+type ListAccountsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{19}
+}
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(dst, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+type ListAccountsResponse struct {
+	// / All accounts of the wallet, including the default account.
+	Accounts             []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{20}
+}
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(dst, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	// / The name of the account to create, which must not be in use yet.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{21}
+}
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(dst, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountRequest.Size(m)
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VerifySeedRequest struct {
+	// / The 24-word aezeed mnemonic to verify.
+	CipherSeedMnemonic []string `protobuf:"bytes,1,rep,name=cipher_seed_mnemonic,json=cipherSeedMnemonic,proto3" json:"cipher_seed_mnemonic,omitempty"`
+	// / The optional passphrase the mnemonic is enciphered with.
+	AezeedPassphrase     []byte   `protobuf:"bytes,2,opt,name=aezeed_passphrase,json=aezeedPassphrase,proto3" json:"aezeed_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySeedRequest) Reset()         { *m = VerifySeedRequest{} }
+func (m *VerifySeedRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifySeedRequest) ProtoMessage()    {}
+func (*VerifySeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{22}
+}
+func (m *VerifySeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySeedRequest.Unmarshal(m, b)
+}
+func (m *VerifySeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySeedRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifySeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySeedRequest.Merge(dst, src)
+}
+func (m *VerifySeedRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifySeedRequest.Size(m)
+}
+func (m *VerifySeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySeedRequest proto.InternalMessageInfo
+
+func (m *VerifySeedRequest) GetCipherSeedMnemonic() []string {
+	if m != nil {
+		return m.CipherSeedMnemonic
+	}
+	return nil
+}
+
+func (m *VerifySeedRequest) GetAezeedPassphrase() []byte {
+	if m != nil {
+		return m.AezeedPassphrase
+	}
+	return nil
+}
+
+type VerifySeedResponse struct {
+	// / The birthday of the seed, as a unix timestamp.
+	BirthdayTimestamp    int64    `protobuf:"varint,1,opt,name=birthday_timestamp,json=birthdayTimestamp,proto3" json:"birthday_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySeedResponse) Reset()         { *m = VerifySeedResponse{} }
+func (m *VerifySeedResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifySeedResponse) ProtoMessage()    {}
+func (*VerifySeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{23}
+}
+func (m *VerifySeedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySeedResponse.Unmarshal(m, b)
+}
+func (m *VerifySeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySeedResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifySeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySeedResponse.Merge(dst, src)
+}
+func (m *VerifySeedResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifySeedResponse.Size(m)
+}
+func (m *VerifySeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySeedResponse proto.InternalMessageInfo
+
+func (m *VerifySeedResponse) GetBirthdayTimestamp() int64 {
+	if m != nil {
+		return m.BirthdayTimestamp
+	}
+	return 0
+}
+
+type ChangeSeedPassphraseRequest struct {
+	// / The 24-word aezeed mnemonic of the wallet.
+	CipherSeedMnemonic []string `protobuf:"bytes,1,rep,name=cipher_seed_mnemonic,json=cipherSeedMnemonic,proto3" json:"cipher_seed_mnemonic,omitempty"`
+	// / The optional passphrase the mnemonic is currently enciphered with.
+	CurrentAezeedPassphrase []byte `protobuf:"bytes,2,opt,name=current_aezeed_passphrase,json=currentAezeedPassphrase,proto3" json:"current_aezeed_passphrase,omitempty"`
+	// / The optional passphrase to encipher the seed with instead.
+	NewAezeedPassphrase  []byte   `protobuf:"bytes,3,opt,name=new_aezeed_passphrase,json=newAezeedPassphrase,proto3" json:"new_aezeed_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeSeedPassphraseRequest) Reset()         { *m = ChangeSeedPassphraseRequest{} }
+func (m *ChangeSeedPassphraseRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeSeedPassphraseRequest) ProtoMessage()    {}
+func (*ChangeSeedPassphraseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{24}
+}
+func (m *ChangeSeedPassphraseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeSeedPassphraseRequest.Unmarshal(m, b)
+}
+func (m *ChangeSeedPassphraseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeSeedPassphraseRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChangeSeedPassphraseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeSeedPassphraseRequest.Merge(dst, src)
+}
+func (m *ChangeSeedPassphraseRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeSeedPassphraseRequest.Size(m)
+}
+func (m *ChangeSeedPassphraseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeSeedPassphraseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeSeedPassphraseRequest proto.InternalMessageInfo
+
+func (m *ChangeSeedPassphraseRequest) GetCipherSeedMnemonic() []string {
+	if m != nil {
+		return m.CipherSeedMnemonic
+	}
+	return nil
+}
+
+func (m *ChangeSeedPassphraseRequest) GetCurrentAezeedPassphrase() []byte {
+	if m != nil {
+		return m.CurrentAezeedPassphrase
+	}
+	return nil
+}
+
+func (m *ChangeSeedPassphraseRequest) GetNewAezeedPassphrase() []byte {
+	if m != nil {
+		return m.NewAezeedPassphrase
+	}
+	return nil
+}
+
+type ChangeSeedPassphraseResponse struct {
+	// / The new 24-word aezeed mnemonic, enciphered with the new passphrase.
+	CipherSeedMnemonic   []string `protobuf:"bytes,1,rep,name=cipher_seed_mnemonic,json=cipherSeedMnemonic,proto3" json:"cipher_seed_mnemonic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeSeedPassphraseResponse) Reset()         { *m = ChangeSeedPassphraseResponse{} }
+func (m *ChangeSeedPassphraseResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeSeedPassphraseResponse) ProtoMessage()    {}
+func (*ChangeSeedPassphraseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{25}
+}
+func (m *ChangeSeedPassphraseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeSeedPassphraseResponse.Unmarshal(m, b)
+}
+func (m *ChangeSeedPassphraseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeSeedPassphraseResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChangeSeedPassphraseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeSeedPassphraseResponse.Merge(dst, src)
+}
+func (m *ChangeSeedPassphraseResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangeSeedPassphraseResponse.Size(m)
+}
+func (m *ChangeSeedPassphraseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeSeedPassphraseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeSeedPassphraseResponse proto.InternalMessageInfo
+
+func (m *ChangeSeedPassphraseResponse) GetCipherSeedMnemonic() []string {
+	if m != nil {
+		return m.CipherSeedMnemonic
+	}
+	return nil
+}
+
+type ExportWatchOnlyWalletRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWatchOnlyWalletRequest) Reset()         { *m = ExportWatchOnlyWalletRequest{} }
+func (m *ExportWatchOnlyWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyWalletRequest) ProtoMessage()    {}
+func (*ExportWatchOnlyWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{26}
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWatchOnlyWalletRequest.Unmarshal(m, b)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWatchOnlyWalletRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportWatchOnlyWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWatchOnlyWalletRequest.Merge(dst, src)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportWatchOnlyWalletRequest.Size(m)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWatchOnlyWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWatchOnlyWalletRequest proto.InternalMessageInfo
+
+type ExportWatchOnlyWalletResponse struct {
+	// *
+	// The serialized wallet database, which only holds the extended public keys
+	// of the accounts. It's encrypted with the default public passphrase.
+	WalletDb             []byte   `protobuf:"bytes,1,opt,name=wallet_db,json=walletDb,proto3" json:"wallet_db,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWatchOnlyWalletResponse) Reset()         { *m = ExportWatchOnlyWalletResponse{} }
+func (m *ExportWatchOnlyWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyWalletResponse) ProtoMessage()    {}
+func (*ExportWatchOnlyWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{27}
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWatchOnlyWalletResponse.Unmarshal(m, b)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWatchOnlyWalletResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportWatchOnlyWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWatchOnlyWalletResponse.Merge(dst, src)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportWatchOnlyWalletResponse.Size(m)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWatchOnlyWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWatchOnlyWalletResponse proto.InternalMessageInfo
+
+func (m *ExportWatchOnlyWalletResponse) GetWalletDb() []byte {
+	if m != nil {
+		return m.WalletDb
+	}
+	return nil
+}
+
+type ImportAccountRequest struct {
+	// / The name of the account, which must not be in use yet.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// *
+	// The extended public key of the account, at the BIP 44 account level
+	// (m/purpose'/coin_type'/account').
+	ExtendedPublicKey string `protobuf:"bytes,2,opt,name=extended_public_key,json=extendedPublicKey,proto3" json:"extended_public_key,omitempty"`
+	// *
+	// The fingerprint of the root key the account is derived from, which is
+	// added to the derivation paths of PSBT inputs, such that a hardware wallet
+	// can recognize its keys. Either empty or four bytes long.
+	MasterKeyFingerprint []byte `protobuf:"bytes,3,opt,name=master_key_fingerprint,json=masterKeyFingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	// / The type of the addresses derived from the account.
+	AddressType AddressType `protobuf:"varint,4,opt,name=address_type,json=addressType,proto3,enum=walletrpc.AddressType" json:"address_type,omitempty"`
+	// *
+	// The height of the block the account was first used in. If set, the chain
+	// is rescanned from this height for outputs paying to the account.
+	BirthdayHeight       uint32   `protobuf:"varint,5,opt,name=birthday_height,json=birthdayHeight,proto3" json:"birthday_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAccountRequest) Reset()         { *m = ImportAccountRequest{} }
+func (m *ImportAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportAccountRequest) ProtoMessage()    {}
+func (*ImportAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{28}
+}
+func (m *ImportAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAccountRequest.Unmarshal(m, b)
+}
+func (m *ImportAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAccountRequest.Merge(dst, src)
+}
+func (m *ImportAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportAccountRequest.Size(m)
+}
+func (m *ImportAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAccountRequest proto.InternalMessageInfo
+
+func (m *ImportAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetExtendedPublicKey() string {
+	if m != nil {
+		return m.ExtendedPublicKey
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetMasterKeyFingerprint() []byte {
+	if m != nil {
+		return m.MasterKeyFingerprint
+	}
+	return nil
+}
+
+func (m *ImportAccountRequest) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_UNKNOWN
+}
+
+func (m *ImportAccountRequest) GetBirthdayHeight() uint32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
+}
+
+type ImportPublicKeyRequest struct {
+	// / The serialized public key to watch.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// / The type of the address the public key is watched as.
+	AddressType AddressType `protobuf:"varint,2,opt,name=address_type,json=addressType,proto3,enum=walletrpc.AddressType" json:"address_type,omitempty"`
+	// *
+	// The height of the block the public key was first used in. If set, the
+	// chain is rescanned from this height for outputs paying to it.
+	BirthdayHeight       uint32   `protobuf:"varint,3,opt,name=birthday_height,json=birthdayHeight,proto3" json:"birthday_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportPublicKeyRequest) Reset()         { *m = ImportPublicKeyRequest{} }
+func (m *ImportPublicKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportPublicKeyRequest) ProtoMessage()    {}
+func (*ImportPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{29}
+}
+func (m *ImportPublicKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPublicKeyRequest.Unmarshal(m, b)
+}
+func (m *ImportPublicKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPublicKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportPublicKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPublicKeyRequest.Merge(dst, src)
+}
+func (m *ImportPublicKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportPublicKeyRequest.Size(m)
+}
+func (m *ImportPublicKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPublicKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportPublicKeyRequest proto.InternalMessageInfo
+
+func (m *ImportPublicKeyRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ImportPublicKeyRequest) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_UNKNOWN
+}
+
+func (m *ImportPublicKeyRequest) GetBirthdayHeight() uint32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
+}
+
+type ImportPublicKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportPublicKeyResponse) Reset()         { *m = ImportPublicKeyResponse{} }
+func (m *ImportPublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportPublicKeyResponse) ProtoMessage()    {}
+func (*ImportPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{30}
+}
+func (m *ImportPublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPublicKeyResponse.Unmarshal(m, b)
+}
+func (m *ImportPublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPublicKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportPublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPublicKeyResponse.Merge(dst, src)
+}
+func (m *ImportPublicKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportPublicKeyResponse.Size(m)
+}
+func (m *ImportPublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPublicKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportPublicKeyResponse proto.InternalMessageInfo
+
+type ImportAddressRequest struct {
+	// / The address to watch, which must be a P2WKH address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// *
+	// The height of the block the address was first used in. If set, the chain
+	// is rescanned from this height for outputs paying to it.
+	BirthdayHeight       uint32   `protobuf:"varint,2,opt,name=birthday_height,json=birthdayHeight,proto3" json:"birthday_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAddressRequest) Reset()         { *m = ImportAddressRequest{} }
+func (m *ImportAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportAddressRequest) ProtoMessage()    {}
+func (*ImportAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{31}
+}
+func (m *ImportAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAddressRequest.Unmarshal(m, b)
+}
+func (m *ImportAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAddressRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAddressRequest.Merge(dst, src)
+}
+func (m *ImportAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportAddressRequest.Size(m)
+}
+func (m *ImportAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAddressRequest proto.InternalMessageInfo
+
+func (m *ImportAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ImportAddressRequest) GetBirthdayHeight() uint32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
+}
+
+type ImportAddressResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAddressResponse) Reset()         { *m = ImportAddressResponse{} }
+func (m *ImportAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportAddressResponse) ProtoMessage()    {}
+func (*ImportAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_83431a21c34a8f77, []int{32}
+}
+func (m *ImportAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAddressResponse.Unmarshal(m, b)
+}
+func (m *ImportAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAddressResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAddressResponse.Merge(dst, src)
+}
+func (m *ImportAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportAddressResponse.Size(m)
+}
+func (m *ImportAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAddressResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
+	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
+	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
+	proto.RegisterType((*Transaction)(nil), "walletrpc.Transaction")
+	proto.RegisterType((*PublishResponse)(nil), "walletrpc.PublishResponse")
+	proto.RegisterType((*SendOutputsRequest)(nil), "walletrpc.SendOutputsRequest")
+	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
+	proto.RegisterType((*OutPoint)(nil), "walletrpc.OutPoint")
+	proto.RegisterType((*FundPsbtRequest)(nil), "walletrpc.FundPsbtRequest")
+	proto.RegisterType((*FundPsbtResponse)(nil), "walletrpc.FundPsbtResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
+	proto.RegisterType((*SignPsbtRequest)(nil), "walletrpc.SignPsbtRequest")
+	proto.RegisterType((*SignPsbtResponse)(nil), "walletrpc.SignPsbtResponse")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "walletrpc.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "walletrpc.FinalizePsbtResponse")
+	proto.RegisterType((*Account)(nil), "walletrpc.Account")
+	proto.RegisterType((*ListAccountsRequest)(nil), "walletrpc.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "walletrpc.ListAccountsResponse")
+	proto.RegisterType((*CreateAccountRequest)(nil), "walletrpc.CreateAccountRequest")
+	proto.RegisterType((*VerifySeedRequest)(nil), "walletrpc.VerifySeedRequest")
+	proto.RegisterType((*VerifySeedResponse)(nil), "walletrpc.VerifySeedResponse")
+	proto.RegisterType((*ChangeSeedPassphraseRequest)(nil), "walletrpc.ChangeSeedPassphraseRequest")
+	proto.RegisterType((*ChangeSeedPassphraseResponse)(nil), "walletrpc.ChangeSeedPassphraseResponse")
+	proto.RegisterType((*ExportWatchOnlyWalletRequest)(nil), "walletrpc.ExportWatchOnlyWalletRequest")
+	proto.RegisterType((*ExportWatchOnlyWalletResponse)(nil), "walletrpc.ExportWatchOnlyWalletResponse")
+	proto.RegisterType((*ImportAccountRequest)(nil), "walletrpc.ImportAccountRequest")
+	proto.RegisterType((*ImportPublicKeyRequest)(nil), "walletrpc.ImportPublicKeyRequest")
+	proto.RegisterType((*ImportPublicKeyResponse)(nil), "walletrpc.ImportPublicKeyResponse")
+	proto.RegisterType((*ImportAddressRequest)(nil), "walletrpc.ImportAddressRequest")
+	proto.RegisterType((*ImportAddressResponse)(nil), "walletrpc.ImportAddressResponse")
+	proto.RegisterEnum("walletrpc.AddressType", AddressType_name, AddressType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletKitClient is the client API for WalletKit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletKitClient interface {
+	// *
+	// DeriveNextKey attempts to derive the *next* key within the key family
+	// (account in BIP43) specified. This method should return the next external
+	// child within this branch.
+	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	// *
+	// DeriveKey attempts to derive an arbitrary key specified by the passed
+	// KeyLocator.
+	DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	// *
+	// NextAddr returns the next unused address within the wallet.
+	NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error)
+	// *
+	// PublishTransaction attempts to publish the passed transaction to the
+	// network. Once this returns without an error, the wallet will continually
+	// attempt to re-broadcast the transaction on start up, until it enters the
+	// chain.
+	PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error)
+	// *
+	// SendOutputs is similar to the existing sendmany call in Bitcoind, and
+	// allows the caller to create a transaction that sends to several outputs at
+	// once. This is ideal when wanting to batch create a set of transactions.
+	SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error)
+	// *
+	// EstimateFee attempts to query the internal fee estimator of the wallet to
+	// determine the fee (in sat/kw) to attach to a transaction in order to
+	// achieve the confirmation target.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// *
+	// FundPsbt selects wallet inputs to fund the outputs of a template PSBT, or
+	// of a set of outputs, at the requested fee rate. A template that already
+	// spends inputs is only funded with the amount they don't cover. A change
+	// output is added if required. The selected inputs are locked, such that
+	// they aren't used by any other transaction until they're released or their
+	// lock expires. The locks are only held in memory and aren't persisted
+	// across restarts.
+	FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error)
+	// *
+	// ReleaseOutput releases an output that was locked by FundPsbt, making it
+	// available to other transactions again.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	// *
+	// SignPsbt signs all inputs of the PSBT that belong to the wallet. All other
+	// inputs are left untouched. The inputs of the wallet are only signed with
+	// SIGHASH_ALL, so the PSBT is rejected if any of them requests another
+	// sighash type.
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error)
+	// *
+	// FinalizePsbt signs all inputs of the PSBT that belong to the wallet, and
+	// finalizes all of its inputs. The fully signed transaction is returned, but
+	// isn't published.
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	// *
+	// ListAccounts returns all accounts of the wallet, along with their balances.
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// *
+	// CreateAccount creates a new named account within the wallet. The funds of
+	// the account are kept apart from those of all other accounts.
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// *
+	// VerifySeed checks that the given aezeed mnemonic and passphrase decipher
+	// to the seed the running wallet was created from. This allows a backup of
+	// the seed to be verified without wiping the wallet.
+	VerifySeed(ctx context.Context, in *VerifySeedRequest, opts ...grpc.CallOption) (*VerifySeedResponse, error)
+	// *
+	// ChangeSeedPassphrase verifies the given aezeed mnemonic against the running
+	// wallet like VerifySeed, and re-enciphers the seed with a new passphrase.
+	// The new mnemonic is returned, while the wallet itself is left untouched.
+	ChangeSeedPassphrase(ctx context.Context, in *ChangeSeedPassphraseRequest, opts ...grpc.CallOption) (*ChangeSeedPassphraseResponse, error)
+	// *
+	// ExportWatchOnlyWallet returns a copy of the wallet database from which all
+	// private key material has been removed, leaving only the extended public
+	// keys of the accounts. It's used to create the on-chain wallet of a
+	// watch-only lnd that uses this node as its remote signer, such that the
+	// watch-only node never holds the seed.
+	ExportWatchOnlyWallet(ctx context.Context, in *ExportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ExportWatchOnlyWalletResponse, error)
+	// *
+	// ImportAccount imports an account from its extended public key, such as one
+	// held by a hardware wallet. The wallet tracks the outputs and balance of the
+	// account, but can't sign for them. Its outputs can only be spent through a
+	// PSBT created with FundPsbt, which is signed by the holder of the keys.
+	ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// *
+	// ImportPublicKey imports a single public key as watch-only. Its outputs are
+	// tracked as part of the "imported" account.
+	ImportPublicKey(ctx context.Context, in *ImportPublicKeyRequest, opts ...grpc.CallOption) (*ImportPublicKeyResponse, error)
+	// *
+	// ImportAddress imports a single address as watch-only. Its outputs are
+	// tracked as part of the "imported" account.
+	ImportAddress(ctx context.Context, in *ImportAddressRequest, opts ...grpc.CallOption) (*ImportAddressResponse, error)
+}
+
+type walletKitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
+	return &walletKitClient{cc}
+}
+
+func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
+	out := new(signrpc.KeyDescriptor)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
+	out := new(signrpc.KeyDescriptor)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error) {
+	out := new(AddrResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/NextAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error) {
+	out := new(SendOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SendOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error) {
+	out := new(FundPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FundPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ReleaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error) {
+	out := new(SignPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SignPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FinalizePsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) VerifySeed(ctx context.Context, in *VerifySeedRequest, opts ...grpc.CallOption) (*VerifySeedResponse, error) {
+	out := new(VerifySeedResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/VerifySeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ChangeSeedPassphrase(ctx context.Context, in *ChangeSeedPassphraseRequest, opts ...grpc.CallOption) (*ChangeSeedPassphraseResponse, error) {
+	out := new(ChangeSeedPassphraseResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ChangeSeedPassphrase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ExportWatchOnlyWallet(ctx context.Context, in *ExportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ExportWatchOnlyWalletResponse, error) {
+	out := new(ExportWatchOnlyWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ExportWatchOnlyWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ImportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ImportPublicKey(ctx context.Context, in *ImportPublicKeyRequest, opts ...grpc.CallOption) (*ImportPublicKeyResponse, error) {
+	out := new(ImportPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ImportPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ImportAddress(ctx context.Context, in *ImportAddressRequest, opts ...grpc.CallOption) (*ImportAddressResponse, error) {
+	out := new(ImportAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ImportAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletKitServer is the server API for WalletKit service.
+type WalletKitServer interface {
+	// *
+	// DeriveNextKey attempts to derive the *next* key within the key family
+	// (account in BIP43) specified. This method should return the next external
+	// child within this branch.
+	DeriveNextKey(context.Context, *KeyReq) (*signrpc.KeyDescriptor, error)
+	// *
+	// DeriveKey attempts to derive an arbitrary key specified by the passed
+	// KeyLocator.
+	DeriveKey(context.Context, *signrpc.KeyLocator) (*signrpc.KeyDescriptor, error)
+	// *
+	// NextAddr returns the next unused address within the wallet.
+	NextAddr(context.Context, *AddrRequest) (*AddrResponse, error)
+	// *
+	// PublishTransaction attempts to publish the passed transaction to the
+	// network. Once this returns without an error, the wallet will continually
+	// attempt to re-broadcast the transaction on start up, until it enters the
+	// chain.
+	PublishTransaction(context.Context, *Transaction) (*PublishResponse, error)
+	// *
+	// SendOutputs is similar to the existing sendmany call in Bitcoind, and
+	// allows the caller to create a transaction that sends to several outputs at
+	// once. This is ideal when wanting to batch create a set of transactions.
+	SendOutputs(context.Context, *SendOutputsRequest) (*SendOutputsResponse, error)
+	// *
+	// EstimateFee attempts to query the internal fee estimator of the wallet to
+	// determine the fee (in sat/kw) to attach to a transaction in order to
+	// achieve the confirmation target.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// *
+	// FundPsbt selects wallet inputs to fund the outputs of a template PSBT, or
+	// of a set of outputs, at the requested fee rate. A template that already
+	// spends inputs is only funded with the amount they don't cover. A change
+	// output is added if required. The selected inputs are locked, such that
+	// they aren't used by any other transaction until they're released or their
+	// lock expires. The locks are only held in memory and aren't persisted
+	// across restarts.
+	FundPsbt(context.Context, *FundPsbtRequest) (*FundPsbtResponse, error)
+	// *
+	// ReleaseOutput releases an output that was locked by FundPsbt, making it
+	// available to other transactions again.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	// *
+	// SignPsbt signs all inputs of the PSBT that belong to the wallet. All other
+	// inputs are left untouched. The inputs of the wallet are only signed with
+	// SIGHASH_ALL, so the PSBT is rejected if any of them requests another
+	// sighash type.
+	SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error)
+	// *
+	// FinalizePsbt signs all inputs of the PSBT that belong to the wallet, and
+	// finalizes all of its inputs. The fully signed transaction is returned, but
+	// isn't published.
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	// *
+	// ListAccounts returns all accounts of the wallet, along with their balances.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// *
+	// CreateAccount creates a new named account within the wallet. The funds of
+	// the account are kept apart from those of all other accounts.
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	// *
+	// VerifySeed checks that the given aezeed mnemonic and passphrase decipher
+	// to the seed the running wallet was created from. This allows a backup of
+	// the seed to be verified without wiping the wallet.
+	VerifySeed(context.Context, *VerifySeedRequest) (*VerifySeedResponse, error)
+	// *
+	// ChangeSeedPassphrase verifies the given aezeed mnemonic against the running
+	// wallet like VerifySeed, and re-enciphers the seed with a new passphrase.
+	// The new mnemonic is returned, while the wallet itself is left untouched.
+	ChangeSeedPassphrase(context.Context, *ChangeSeedPassphraseRequest) (*ChangeSeedPassphraseResponse, error)
+	// *
+	// ExportWatchOnlyWallet returns a copy of the wallet database from which all
+	// private key material has been removed, leaving only the extended public
+	// keys of the accounts. It's used to create the on-chain wallet of a
+	// watch-only lnd that uses this node as its remote signer, such that the
+	// watch-only node never holds the seed.
+	ExportWatchOnlyWallet(context.Context, *ExportWatchOnlyWalletRequest) (*ExportWatchOnlyWalletResponse, error)
+	// *
+	// ImportAccount imports an account from its extended public key, such as one
+	// held by a hardware wallet. The wallet tracks the outputs and balance of the
+	// account, but can't sign for them. Its outputs can only be spent through a
+	// PSBT created with FundPsbt, which is signed by the holder of the keys.
+	ImportAccount(context.Context, *ImportAccountRequest) (*Account, error)
+	// *
+	// ImportPublicKey imports a single public key as watch-only. Its outputs are
+	// tracked as part of the "imported" account.
+	ImportPublicKey(context.Context, *ImportPublicKeyRequest) (*ImportPublicKeyResponse, error)
+	// *
+	// ImportAddress imports a single address as watch-only. Its outputs are
+	// tracked as part of the "imported" account.
+	ImportAddress(context.Context, *ImportAddressRequest) (*ImportAddressResponse, error)
+}
+
+func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
+	s.RegisterService(&_WalletKit_serviceDesc, srv)
+}
+
+func _WalletKit_DeriveNextKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).DeriveNextKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/DeriveNextKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).DeriveNextKey(ctx, req.(*KeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_DeriveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(signrpc.KeyLocator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).DeriveKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/DeriveKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).DeriveKey(ctx, req.(*signrpc.KeyLocator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_NextAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).NextAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/NextAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).NextAddr(ctx, req.(*AddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).PublishTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/PublishTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).PublishTransaction(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_SendOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendOutputsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -663,6 +2104,222 @@ func _WalletKit_EstimateFee_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_FundPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).FundPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/FundPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).FundPsbt(ctx, req.(*FundPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).SignPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/SignPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).SignPsbt(ctx, req.(*SignPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).FinalizePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/FinalizePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_VerifySeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).VerifySeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/VerifySeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).VerifySeed(ctx, req.(*VerifySeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ChangeSeedPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeedPassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ChangeSeedPassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ChangeSeedPassphrase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ChangeSeedPassphrase(ctx, req.(*ChangeSeedPassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ExportWatchOnlyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWatchOnlyWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ExportWatchOnlyWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ExportWatchOnlyWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ExportWatchOnlyWallet(ctx, req.(*ExportWatchOnlyWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ImportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ImportAccount(ctx, req.(*ImportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ImportPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ImportPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ImportPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ImportPublicKey(ctx, req.(*ImportPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ImportAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ImportAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ImportAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ImportAddress(ctx, req.(*ImportAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
@@ -691,48 +2348,161 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateFee",
 			Handler:    _WalletKit_EstimateFee_Handler,
 		},
+		{
+			MethodName: "FundPsbt",
+			Handler:    _WalletKit_FundPsbt_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletKit_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _WalletKit_SignPsbt_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _WalletKit_FinalizePsbt_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _WalletKit_ListAccounts_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _WalletKit_CreateAccount_Handler,
+		},
+		{
+			MethodName: "VerifySeed",
+			Handler:    _WalletKit_VerifySeed_Handler,
+		},
+		{
+			MethodName: "ChangeSeedPassphrase",
+			Handler:    _WalletKit_ChangeSeedPassphrase_Handler,
+		},
+		{
+			MethodName: "ExportWatchOnlyWallet",
+			Handler:    _WalletKit_ExportWatchOnlyWallet_Handler,
+		},
+		{
+			MethodName: "ImportAccount",
+			Handler:    _WalletKit_ImportAccount_Handler,
+		},
+		{
+			MethodName: "ImportPublicKey",
+			Handler:    _WalletKit_ImportPublicKey_Handler,
+		},
+		{
+			MethodName: "ImportAddress",
+			Handler:    _WalletKit_ImportAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
 }
 
 func init() {
-	proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_walletkit_ca4e27c2068154e3)
-}
-
-var fileDescriptor_walletkit_ca4e27c2068154e3 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x5d, 0x6f, 0xd3, 0x30,
-	0x14, 0xd5, 0x56, 0x56, 0xd6, 0xdb, 0x76, 0x80, 0xcb, 0x46, 0x89, 0x18, 0x4c, 0x81, 0x87, 0x3e,
-	0xa0, 0x54, 0x6c, 0x02, 0x21, 0x78, 0x02, 0x6d, 0xd3, 0xa4, 0x4e, 0xac, 0x84, 0x4a, 0x48, 0x08,
-	0x29, 0x72, 0x93, 0xbb, 0xd4, 0x6a, 0x6a, 0x67, 0xce, 0x0d, 0x49, 0xfe, 0x0f, 0x3f, 0x14, 0xe5,
-	0xa3, 0x5d, 0x4a, 0x19, 0x4f, 0x71, 0x8e, 0xcf, 0x3d, 0xbe, 0xd7, 0xe7, 0x18, 0x9e, 0x26, 0x3c,
-	0x08, 0x90, 0x74, 0xe8, 0x0e, 0xcb, 0xd5, 0x5c, 0x90, 0x15, 0x6a, 0x45, 0x8a, 0xb5, 0x56, 0x5b,
-	0xc6, 0xe3, 0x48, 0xf8, 0x32, 0xe7, 0xe4, 0x5f, 0xd4, 0x25, 0xc1, 0xfc, 0x0a, 0xcd, 0x11, 0x66,
-	0x36, 0xde, 0xb0, 0x01, 0x3c, 0x9c, 0x63, 0xe6, 0x5c, 0x0b, 0xe9, 0xa3, 0x76, 0x42, 0x2d, 0x24,
-	0xf5, 0xb7, 0x8e, 0xb6, 0x06, 0x3b, 0xf6, 0xde, 0x1c, 0xb3, 0xf3, 0x02, 0x1e, 0xe7, 0x28, 0x3b,
-	0x04, 0x28, 0x98, 0x7c, 0x21, 0x82, 0xac, 0xbf, 0x5d, 0x70, 0x5a, 0x39, 0xa7, 0x00, 0xcc, 0x2e,
-	0xb4, 0x3f, 0x79, 0x9e, 0xb6, 0xf1, 0x26, 0xc6, 0x88, 0x4c, 0x13, 0x3a, 0xe5, 0x6f, 0x14, 0x2a,
-	0x19, 0x21, 0x63, 0x70, 0x8f, 0x7b, 0x9e, 0x2e, 0xb4, 0x5b, 0x76, 0xb1, 0x36, 0x5f, 0x41, 0x7b,
-	0xa2, 0xb9, 0x8c, 0xb8, 0x4b, 0x42, 0x49, 0xb6, 0x0f, 0x4d, 0x4a, 0x9d, 0x19, 0xa6, 0x05, 0xa9,
-	0x63, 0xef, 0x50, 0x7a, 0x81, 0xa9, 0xf9, 0x0e, 0x1e, 0x8c, 0xe3, 0x69, 0x20, 0xa2, 0xd9, 0x4a,
-	0xec, 0x25, 0x74, 0xc3, 0x12, 0x72, 0x50, 0x6b, 0xb5, 0x54, 0xed, 0x54, 0xe0, 0x59, 0x8e, 0x99,
-	0x3f, 0x81, 0x7d, 0x43, 0xe9, 0x5d, 0xc5, 0x14, 0xc6, 0x14, 0x55, 0x7d, 0xb1, 0x67, 0x00, 0x11,
-	0x27, 0x27, 0x44, 0xed, 0xcc, 0x93, 0xa2, 0xae, 0x61, 0xef, 0x46, 0x9c, 0xc6, 0xa8, 0x47, 0x09,
-	0x1b, 0xc0, 0x7d, 0x55, 0xf2, 0xfb, 0xdb, 0x47, 0x8d, 0x41, 0xfb, 0x78, 0xcf, 0xaa, 0xee, 0xcf,
-	0x9a, 0xa4, 0x57, 0x31, 0xd9, 0xcb, 0x6d, 0xf3, 0x35, 0xf4, 0xd6, 0xd4, 0xab, 0xce, 0xf6, 0xa1,
-	0xa9, 0x79, 0xe2, 0xd0, 0x6a, 0x06, 0xcd, 0x93, 0x49, 0x6a, 0xbe, 0x05, 0x76, 0x16, 0x91, 0x58,
-	0x70, 0xc2, 0x73, 0xc4, 0x65, 0x2f, 0x2f, 0xa0, 0xed, 0x2a, 0x79, 0xed, 0x10, 0xd7, 0x3e, 0x2e,
-	0xaf, 0x1d, 0x72, 0x68, 0x52, 0x20, 0xe6, 0x09, 0xf4, 0xd6, 0xca, 0xaa, 0x43, 0xfe, 0x3b, 0xc3,
-	0xf1, 0xef, 0x06, 0xb4, 0xbe, 0x17, 0xfe, 0x8f, 0x04, 0xb1, 0x0f, 0xd0, 0x3d, 0x45, 0x2d, 0x7e,
-	0xe1, 0x17, 0x4c, 0x69, 0x84, 0x19, 0x7b, 0x64, 0xad, 0xc2, 0x61, 0x95, 0x19, 0x30, 0x0e, 0x56,
-	0x43, 0x8e, 0x30, 0x3b, 0xc5, 0xc8, 0xd5, 0x22, 0x24, 0xa5, 0xd9, 0x7b, 0x68, 0x95, 0xb5, 0x79,
-	0x5d, 0xaf, 0x4e, 0xba, 0x54, 0x2e, 0x27, 0xa5, 0xef, 0xac, 0xfc, 0x08, 0xbb, 0xf9, 0x79, 0x79,
-	0x02, 0xd8, 0x41, 0xed, 0xc0, 0x5a, 0x42, 0x8c, 0x27, 0x1b, 0x78, 0x35, 0xde, 0x05, 0xb0, 0xca,
-	0xf0, 0x7a, 0x3a, 0xea, 0x32, 0x35, 0xdc, 0x30, 0x6a, 0xf8, 0xdf, 0x39, 0xb9, 0x84, 0x76, 0xcd,
-	0x24, 0x76, 0x58, 0xa3, 0x6e, 0x46, 0xc3, 0x78, 0x7e, 0xd7, 0xf6, 0xad, 0x5a, 0xcd, 0x8d, 0x35,
-	0xb5, 0x4d, 0x73, 0xd7, 0xd4, 0xfe, 0x61, 0xe2, 0xe7, 0x37, 0x3f, 0x86, 0xbe, 0xa0, 0x59, 0x3c,
-	0xb5, 0x5c, 0xb5, 0x18, 0x06, 0xc2, 0x9f, 0x91, 0x14, 0xd2, 0x97, 0x48, 0x89, 0xd2, 0xf3, 0x61,
-	0x20, 0xbd, 0x61, 0x20, 0x6f, 0x1f, 0xb7, 0x0e, 0xdd, 0x69, 0xb3, 0x78, 0xbc, 0x27, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x2d, 0xbb, 0xcd, 0x97, 0xfa, 0x03, 0x00, 0x00,
+	proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_walletkit_83431a21c34a8f77)
+}
+
+var fileDescriptor_walletkit_83431a21c34a8f77 = []byte{
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x58, 0xe9, 0x6e, 0xdb, 0x46,
+	0x10, 0xae, 0xec, 0xd8, 0x96, 0x46, 0x92, 0x8f, 0x95, 0x7c, 0x84, 0xb1, 0x73, 0xb0, 0x47, 0x8c,
+	0xb4, 0x95, 0x0b, 0xa7, 0x0d, 0x7a, 0xfd, 0xf1, 0x19, 0x1b, 0x76, 0x6d, 0x95, 0x52, 0xea, 0xa4,
+	0x28, 0x40, 0x50, 0xd4, 0xda, 0x62, 0x2d, 0x91, 0x2c, 0xb9, 0xaa, 0xa5, 0xbc, 0x4a, 0x1f, 0xa6,
+	0x28, 0xd0, 0x07, 0xe9, 0x43, 0xf4, 0x77, 0xd1, 0x59, 0xee, 0x92, 0x22, 0x29, 0x2a, 0x4e, 0xda,
+	0x1f, 0x86, 0xb9, 0xdf, 0xcc, 0xce, 0xce, 0xcc, 0xce, 0xcc, 0x7e, 0x10, 0xdc, 0xbd, 0x31, 0xba,
+	0x5d, 0xca, 0x3c, 0xd7, 0xdc, 0x12, 0x5f, 0xd7, 0x16, 0xab, 0xb9, 0x9e, 0xc3, 0x1c, 0x52, 0x88,
+	0x44, 0x4a, 0xd5, 0xb7, 0xae, 0x6c, 0xae, 0xc3, 0xff, 0x53, 0x4f, 0x28, 0xa8, 0xdf, 0xc3, 0xec,
+	0x09, 0x1d, 0x6a, 0xf4, 0x17, 0xb2, 0x09, 0x8b, 0xd7, 0x74, 0xa8, 0x5f, 0x5a, 0xf6, 0x15, 0xf5,
+	0x74, 0xd7, 0xb3, 0x6c, 0xb6, 0x96, 0x7b, 0x98, 0xdb, 0x9c, 0xd1, 0xe6, 0x11, 0x3f, 0x0c, 0xe0,
+	0x3a, 0x47, 0xc9, 0x06, 0x40, 0xa0, 0x69, 0xf4, 0xac, 0xee, 0x70, 0x6d, 0x2a, 0xd0, 0x29, 0x70,
+	0x9d, 0x00, 0x50, 0xcb, 0x50, 0xdc, 0x69, 0xb7, 0x3d, 0xb4, 0xd9, 0xa7, 0x3e, 0x53, 0x55, 0x28,
+	0x89, 0xa5, 0xef, 0x3a, 0xb6, 0x4f, 0x09, 0x81, 0x3b, 0x06, 0xae, 0x03, 0xdb, 0x05, 0x2d, 0xf8,
+	0x56, 0x3f, 0x80, 0x62, 0xd3, 0x33, 0x6c, 0xdf, 0x30, 0x99, 0xe5, 0xd8, 0x64, 0x19, 0x66, 0xd9,
+	0x40, 0xef, 0xd0, 0x41, 0xa0, 0x54, 0xd2, 0x66, 0xd8, 0xe0, 0x88, 0x0e, 0xd4, 0x67, 0xb0, 0x50,
+	0xef, 0xb7, 0xba, 0x96, 0xdf, 0x89, 0x8c, 0xbd, 0x0f, 0x65, 0x57, 0x40, 0x3a, 0xf5, 0x3c, 0x27,
+	0xb4, 0x5a, 0x92, 0xe0, 0x01, 0xc7, 0xd4, 0x9f, 0x80, 0x34, 0xa8, 0xdd, 0x3e, 0xef, 0x33, 0xb7,
+	0xcf, 0x7c, 0xe9, 0x17, 0x59, 0x07, 0xf0, 0x0d, 0xa6, 0xbb, 0x18, 0xec, 0xf5, 0x4d, 0xb0, 0x6f,
+	0x5a, 0xcb, 0x23, 0x52, 0xa7, 0xde, 0xc9, 0x0d, 0x66, 0x63, 0xce, 0x11, 0xfa, 0x18, 0xe0, 0xf4,
+	0x66, 0x71, 0x7b, 0xbe, 0x26, 0xf3, 0x57, 0x6b, 0x0e, 0xd0, 0x92, 0x16, 0x8a, 0xd5, 0x4f, 0xa0,
+	0x92, 0xb0, 0x2e, 0x3d, 0xc3, 0x18, 0x3c, 0xe3, 0x46, 0x67, 0x51, 0x0c, 0xb8, 0x6a, 0x0e, 0xd4,
+	0x2f, 0x80, 0x1c, 0xf8, 0xcc, 0xea, 0x19, 0x8c, 0x1e, 0x52, 0x1a, 0xfa, 0xf2, 0x00, 0x8a, 0xa6,
+	0x63, 0x5f, 0xea, 0xcc, 0xf0, 0xae, 0x68, 0x98, 0x76, 0xe0, 0x50, 0x33, 0x40, 0xd4, 0xa7, 0x50,
+	0x49, 0x6c, 0x93, 0x87, 0xbc, 0x31, 0x06, 0xf5, 0x14, 0xf2, 0xe8, 0x55, 0xdd, 0x91, 0x77, 0xc6,
+	0x06, 0x56, 0x5b, 0x6f, 0x0d, 0x19, 0xf5, 0xa5, 0x4b, 0x05, 0x8e, 0xec, 0x72, 0x80, 0x3c, 0x82,
+	0x92, 0x88, 0x47, 0xb7, 0xec, 0x36, 0xe6, 0x9d, 0x5f, 0x6a, 0x59, 0x2b, 0x0a, 0xec, 0x98, 0x43,
+	0xea, 0x5f, 0x39, 0x58, 0x38, 0xec, 0xdb, 0xed, 0xba, 0xdf, 0x62, 0xa1, 0xdf, 0x78, 0x97, 0x2e,
+	0x2e, 0xa5, 0xbd, 0xe0, 0xfb, 0xed, 0x33, 0x97, 0x8e, 0x7a, 0x3a, 0x1d, 0x75, 0x2a, 0xbc, 0x3b,
+	0xa9, 0x2b, 0x7a, 0x06, 0xab, 0x5d, 0xc7, 0xbc, 0xd6, 0xe9, 0xc0, 0xb5, 0x3c, 0x83, 0x17, 0x8e,
+	0xee, 0x53, 0xdc, 0xdd, 0xf6, 0xd7, 0x66, 0x50, 0xf5, 0x8e, 0xb6, 0xcc, 0xc5, 0x07, 0x91, 0xb4,
+	0x21, 0x84, 0x64, 0x0d, 0xe6, 0x0c, 0xd3, 0x74, 0xfa, 0x58, 0xdf, 0xb3, 0x41, 0xb5, 0x84, 0x4b,
+	0xf5, 0xcf, 0x1c, 0x2c, 0x8e, 0x42, 0x94, 0x39, 0x46, 0x2f, 0x2f, 0x11, 0xa3, 0x6d, 0x3d, 0x16,
+	0x2a, 0x08, 0x88, 0x2b, 0x92, 0x1a, 0x54, 0xcc, 0x8e, 0x81, 0xed, 0xa1, 0x8f, 0xa5, 0x70, 0x46,
+	0x5b, 0x12, 0xa2, 0xf3, 0x51, 0x22, 0xd1, 0xef, 0x12, 0x77, 0x0c, 0x0d, 0xf6, 0xd9, 0xc0, 0xf1,
+	0x31, 0x6e, 0x9e, 0xa5, 0x4a, 0x2d, 0x6a, 0xd5, 0x5a, 0x78, 0x6b, 0x5a, 0x51, 0x28, 0xbe, 0xe0,
+	0x7a, 0xe4, 0x31, 0x2c, 0xa4, 0xe2, 0x95, 0x29, 0x99, 0x4f, 0xc6, 0xa9, 0x3e, 0x87, 0xaa, 0x46,
+	0xbb, 0xd4, 0xf0, 0xe5, 0xb1, 0xe1, 0x6d, 0x6d, 0x41, 0x9e, 0x7b, 0xe8, 0x84, 0x9d, 0x3d, 0xe1,
+	0xd0, 0x48, 0x49, 0x5d, 0x85, 0xe5, 0x94, 0x21, 0x91, 0x13, 0x75, 0x1b, 0x16, 0x1a, 0x78, 0xa7,
+	0xf1, 0x52, 0xb8, 0x2d, 0x4d, 0xea, 0x4b, 0x58, 0x1c, 0xed, 0x19, 0xe5, 0x36, 0x98, 0x46, 0xc9,
+	0x4d, 0x02, 0x0a, 0x72, 0x8b, 0xfd, 0x2d, 0x15, 0x2c, 0x3b, 0x2a, 0xa9, 0xb2, 0x56, 0x12, 0xe0,
+	0x71, 0x80, 0xe1, 0x5c, 0xa8, 0xe0, 0x78, 0x32, 0xba, 0xd6, 0x6b, 0xfa, 0x4e, 0x1e, 0xbd, 0x82,
+	0x6a, 0x72, 0xdf, 0xdb, 0x7a, 0xf5, 0x10, 0x4a, 0xbc, 0xb7, 0x2f, 0xf9, 0x66, 0xde, 0xe1, 0x53,
+	0x42, 0x03, 0xb1, 0xc0, 0x1e, 0xb6, 0xf9, 0x1f, 0x39, 0x98, 0xdb, 0x11, 0x55, 0xc5, 0x9b, 0xc4,
+	0x36, 0x7a, 0x34, 0x1c, 0x78, 0xfc, 0x9b, 0x7c, 0x08, 0xf3, 0xb2, 0xe8, 0x74, 0xbb, 0xdf, 0x6b,
+	0x51, 0x4f, 0x76, 0x5c, 0x59, 0xa2, 0x67, 0x01, 0x48, 0x3e, 0x86, 0x25, 0xde, 0x0e, 0x96, 0xd7,
+	0x43, 0x67, 0x5a, 0x46, 0xd7, 0xb0, 0x4d, 0x1a, 0xf4, 0xc9, 0xb4, 0xb6, 0x18, 0x09, 0x76, 0x05,
+	0x8e, 0xd7, 0x5b, 0xe9, 0xdb, 0xe3, 0xea, 0xa2, 0x46, 0x48, 0x4c, 0x14, 0x6e, 0xc0, 0x99, 0x70,
+	0x63, 0x30, 0xb3, 0xa3, 0x3b, 0x36, 0xce, 0x71, 0xde, 0x33, 0x79, 0xad, 0x10, 0x20, 0xe7, 0x08,
+	0xa8, 0xcb, 0x50, 0x39, 0xb5, 0x7c, 0x26, 0xc3, 0x08, 0xe7, 0xa6, 0x7a, 0x08, 0xd5, 0x24, 0x2c,
+	0xb3, 0x56, 0x83, 0xbc, 0x74, 0x9e, 0xcf, 0x17, 0x5e, 0xd2, 0x24, 0x56, 0x5d, 0x52, 0x5d, 0x8b,
+	0x74, 0xd4, 0x27, 0x50, 0xdd, 0xf3, 0x28, 0x0e, 0xb4, 0x50, 0x34, 0x9a, 0x29, 0xe9, 0x74, 0xa9,
+	0x1e, 0x2c, 0xfd, 0x40, 0x3d, 0xeb, 0x72, 0xd8, 0xa0, 0xb4, 0x1d, 0x2a, 0x7e, 0x06, 0x55, 0xd3,
+	0x72, 0x3b, 0x38, 0x1c, 0x7c, 0x44, 0xf5, 0x9e, 0x4d, 0x7b, 0x8e, 0x6d, 0x99, 0xc1, 0xe1, 0x05,
+	0x8d, 0x08, 0x19, 0xdf, 0xf0, 0x9d, 0x94, 0xf0, 0x74, 0x1a, 0xf4, 0x35, 0x57, 0x76, 0x0d, 0xdf,
+	0x77, 0x3b, 0x1e, 0x56, 0xb6, 0xbc, 0xbc, 0x45, 0x21, 0xa8, 0x47, 0xb8, 0xba, 0x07, 0x24, 0x7e,
+	0xa6, 0x8c, 0xf2, 0x53, 0x20, 0x2d, 0xcb, 0x63, 0x9d, 0xb6, 0x31, 0xd4, 0x71, 0x1e, 0xa3, 0x1f,
+	0x46, 0xcf, 0x95, 0x93, 0x77, 0x29, 0x94, 0x34, 0x43, 0x81, 0xfa, 0x7b, 0x0e, 0xee, 0xed, 0x05,
+	0x13, 0xa0, 0x91, 0xb0, 0xfe, 0xdf, 0x63, 0xf8, 0x1a, 0xee, 0x9a, 0x7d, 0xcf, 0xa3, 0x58, 0x39,
+	0x93, 0x62, 0x59, 0x95, 0x0a, 0x3b, 0xa9, 0x90, 0xc8, 0x36, 0x2c, 0xdb, 0xf4, 0x26, 0x63, 0xdf,
+	0x74, 0xb0, 0xaf, 0x82, 0xc2, 0xf4, 0x1e, 0xb5, 0x0e, 0xeb, 0xd9, 0x01, 0xc8, 0x84, 0xbc, 0x73,
+	0x04, 0xea, 0x7d, 0x58, 0xc7, 0x61, 0xe5, 0x78, 0xec, 0x22, 0x2c, 0xb5, 0x8b, 0xa0, 0x4c, 0xc2,
+	0x02, 0xfb, 0x16, 0x36, 0x26, 0xc8, 0xe5, 0x91, 0xf7, 0x40, 0xd2, 0x1a, 0xbd, 0xdd, 0x92, 0xdd,
+	0x99, 0x17, 0xc0, 0x7e, 0x4b, 0xfd, 0x3b, 0x07, 0xd5, 0xe3, 0x1e, 0xdf, 0x7e, 0x7b, 0x5d, 0xf1,
+	0xd1, 0x4d, 0x07, 0x8c, 0x8a, 0x21, 0xc1, 0x29, 0x83, 0xa9, 0x23, 0x8f, 0x09, 0xd2, 0x58, 0xd0,
+	0x96, 0x42, 0x51, 0x40, 0x3a, 0x4c, 0xa4, 0x49, 0xe4, 0x73, 0x58, 0xe9, 0x19, 0x3e, 0xe3, 0xef,
+	0x51, 0x44, 0x95, 0x04, 0x53, 0x12, 0x19, 0xac, 0x0a, 0xe9, 0x49, 0xc8, 0x97, 0x02, 0x19, 0xf9,
+	0x0a, 0x4a, 0x9c, 0xe5, 0x50, 0xdf, 0xd7, 0xd9, 0xd0, 0x15, 0x1d, 0x39, 0xbf, 0xbd, 0x12, 0xef,
+	0x0e, 0x21, 0x6e, 0xa2, 0x54, 0x2b, 0x1a, 0xa3, 0x05, 0x9f, 0xf9, 0x51, 0xb9, 0x75, 0xa8, 0x75,
+	0xd5, 0x61, 0x41, 0x9f, 0x96, 0xb5, 0xf9, 0x10, 0x3e, 0x0a, 0x50, 0xf5, 0xb7, 0x1c, 0xac, 0x88,
+	0xb0, 0x23, 0x6f, 0xc3, 0xc0, 0xb1, 0xcd, 0x63, 0xb1, 0xc9, 0xa7, 0xdf, 0x8d, 0x62, 0x4a, 0x7b,
+	0x37, 0xf5, 0xbf, 0xbc, 0x9b, 0xce, 0xf4, 0xee, 0x2e, 0xac, 0x8e, 0x39, 0x27, 0x9f, 0x92, 0x57,
+	0xd1, 0x75, 0x09, 0xc3, 0xa1, 0xd7, 0xfc, 0x95, 0x16, 0x88, 0xbc, 0xb1, 0x70, 0x99, 0x75, 0xea,
+	0x54, 0xe6, 0xa9, 0xf8, 0x7c, 0xa5, 0x4c, 0x8b, 0x33, 0x9f, 0x34, 0x04, 0x43, 0x0d, 0xc3, 0x28,
+	0xc2, 0xdc, 0x8b, 0xb3, 0x93, 0xb3, 0xf3, 0x8b, 0xb3, 0xc5, 0xf7, 0xc8, 0x2a, 0x54, 0x2e, 0x8e,
+	0x9b, 0x67, 0x07, 0x8d, 0x86, 0x5e, 0x7f, 0xb1, 0x7b, 0x72, 0xf0, 0x4a, 0x3f, 0xda, 0x69, 0x1c,
+	0x2d, 0xe6, 0xc8, 0x7d, 0x50, 0x10, 0x6d, 0x1e, 0xec, 0xeb, 0x59, 0xf2, 0xa9, 0xed, 0x7f, 0x00,
+	0x0a, 0xa2, 0x50, 0x4f, 0x2c, 0x86, 0x6d, 0x5a, 0xde, 0xc7, 0xe9, 0xf1, 0x2b, 0x3d, 0xc3, 0x2a,
+	0xe2, 0x69, 0x5e, 0x8a, 0x25, 0x54, 0x5c, 0x8e, 0xb2, 0x12, 0x11, 0x23, 0x04, 0xf6, 0xa9, 0x6f,
+	0x7a, 0x96, 0xcb, 0x1c, 0x8f, 0x7c, 0x09, 0x05, 0xb1, 0x97, 0xef, 0xab, 0xc4, 0x95, 0x4e, 0x1d,
+	0xd3, 0x40, 0x8d, 0x89, 0x3b, 0xbf, 0x81, 0x3c, 0x3f, 0x8f, 0x07, 0x47, 0xd2, 0x37, 0x28, 0x13,
+	0xab, 0xac, 0x8e, 0xe1, 0xb2, 0xad, 0x8e, 0x80, 0x48, 0x7a, 0x1d, 0xe7, 0xe2, 0x71, 0x33, 0x31,
+	0x5c, 0x51, 0x62, 0x78, 0x9a, 0x95, 0x9f, 0x42, 0x31, 0x46, 0x89, 0xc9, 0x46, 0x4c, 0x75, 0x9c,
+	0x88, 0x2b, 0xf7, 0x27, 0x89, 0x47, 0xd6, 0x62, 0xdc, 0x37, 0x61, 0x6d, 0x9c, 0x4a, 0x27, 0xac,
+	0x65, 0x51, 0xe6, 0x3d, 0xc8, 0x87, 0x14, 0x8f, 0xc4, 0x63, 0x48, 0x51, 0x5b, 0xe5, 0x5e, 0xa6,
+	0x4c, 0x1a, 0xd1, 0xa0, 0x9c, 0x20, 0x46, 0xe4, 0x41, 0x4c, 0x3b, 0x8b, 0x7b, 0x29, 0x0f, 0x27,
+	0x2b, 0x8c, 0x1c, 0x0b, 0xf9, 0x51, 0xc2, 0xb1, 0x14, 0xd1, 0x4a, 0x38, 0x36, 0x46, 0xa8, 0xce,
+	0xa1, 0x14, 0xa7, 0x34, 0x24, 0x9e, 0x8d, 0x0c, 0x8e, 0xa4, 0x3c, 0x98, 0x28, 0x1f, 0x19, 0x8c,
+	0xbf, 0xf6, 0x09, 0x83, 0x19, 0xec, 0x20, 0x61, 0x30, 0x93, 0x26, 0xec, 0x43, 0x39, 0xf1, 0xec,
+	0x27, 0x52, 0x97, 0x45, 0x08, 0x94, 0x0c, 0x1a, 0x41, 0x8e, 0x01, 0x46, 0x8f, 0x33, 0x59, 0x8f,
+	0x69, 0x8c, 0xf1, 0x04, 0x65, 0x63, 0x82, 0x54, 0x3a, 0x74, 0x85, 0x3c, 0x24, 0xe3, 0x81, 0x23,
+	0x1f, 0xc5, 0xfd, 0x9a, 0xfc, 0x84, 0x2b, 0x8f, 0x6f, 0xd5, 0x93, 0x07, 0xfd, 0x0c, 0xcb, 0x99,
+	0xef, 0x1a, 0x89, 0x5b, 0x78, 0xd3, 0xcb, 0xa8, 0x6c, 0xde, 0xae, 0x38, 0xca, 0x72, 0xe2, 0x11,
+	0x4c, 0x64, 0x39, 0xeb, 0x79, 0xcc, 0xcc, 0xf2, 0x4b, 0x58, 0x48, 0x8d, 0x6d, 0xf2, 0x68, 0xcc,
+	0x4e, 0xfa, 0xbd, 0x51, 0xd4, 0x37, 0xa9, 0x8c, 0x1a, 0x28, 0x31, 0x9a, 0xb3, 0xfc, 0x4b, 0xbc,
+	0x07, 0x89, 0x06, 0xca, 0x9c, 0xea, 0xbb, 0x4f, 0x7e, 0xdc, 0xbc, 0xb2, 0x58, 0xa7, 0xdf, 0xaa,
+	0x99, 0x4e, 0x6f, 0xeb, 0xc6, 0xb8, 0xb6, 0x86, 0x46, 0xcf, 0x70, 0xb7, 0xba, 0x76, 0x1b, 0xff,
+	0x46, 0xbf, 0x8e, 0xe0, 0x57, 0x6b, 0x36, 0xf8, 0xf5, 0xe3, 0xe9, 0xbf, 0xb1, 0x8d, 0x46, 0x26,
+	0x3b, 0x11, 0x00, 0x00,
 }
@@ -4,16 +4,25 @@ package walletrpc
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/wakiyamap/lnd/aezeed"
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnrpc"
 	"github.com/wakiyamap/lnd/lnrpc/signrpc"
 	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/psbt"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -74,12 +83,65 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/walletrpc.WalletKit/FundPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/SignPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/FinalizePsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ListAccounts": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/CreateAccount": {{
+			Entity: "address",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/VerifySeed": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ChangeSeedPassphrase": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ExportWatchOnlyWallet": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ImportAccount": {{
+			Entity: "address",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ImportPublicKey": {{
+			Entity: "address",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ImportAddress": {{
+			Entity: "address",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
 	// macaroon that we expect to find via a file handle within the main
 	// configuration file in this package.
 	DefaultWalletKitMacFilename = "walletkit.macaroon"
+
+	// ErrSeedMismatch is returned when a mnemonic deciphers to a seed
+	// other than the one the running wallet was created from.
+	ErrSeedMismatch = errors.New("seed doesn't match the seed of the " +
+		"wallet")
 )
 
 // WalletKit is a sub-RPC server that exposes a tool kit which allows clients
@@ -331,3 +393,483 @@ func (w *WalletKit) EstimateFee(ctx context.Context,
 		SatPerKw: int64(satPerKw),
 	}, nil
 }
+
+// FundPsbt selects wallet inputs to fund the outputs of a template PSBT, or of
+// a set of outputs, at the requested fee rate. A change output is added if
+// required. The selected inputs are locked, such that they aren't used by any
+// other transaction until lnd is restarted. The locks are only held in memory
+// and aren't persisted across restarts. If the funding account is watch-only,
+// the PSBT carries the derivation paths of its inputs and change output, such
+// that it can be signed by the holder of the account's keys.
+func (w *WalletKit) FundPsbt(ctx context.Context,
+	req *FundPsbtRequest) (*FundPsbtResponse, error) {
+
+	switch {
+	// Exactly one of the template and the outputs must be specified.
+	case len(req.Psbt) == 0 && len(req.Outputs) == 0:
+		return nil, fmt.Errorf("must specify either a psbt or " +
+			"outputs to fund")
+
+	case len(req.Psbt) != 0 && len(req.Outputs) != 0:
+		return nil, fmt.Errorf("cannot specify both a psbt and " +
+			"outputs to fund")
+
+	// Similarly, we need either a fee rate or a confirmation target to
+	// estimate the fee rate from.
+	case req.ConfTarget != 0 && req.SatPerKw != 0:
+		return nil, fmt.Errorf("cannot specify both a confirmation " +
+			"target and a fee rate")
+
+	case req.ConfTarget == 0 && req.SatPerKw == 0:
+		return nil, fmt.Errorf("must specify either a confirmation " +
+			"target or a fee rate")
+
+	// As with fee estimation, we reject confirmation targets of 1 as
+	// they're unreasonable.
+	case req.ConfTarget == 1 || req.ConfTarget < 0 || req.SatPerKw < 0:
+		return nil, fmt.Errorf("invalid confirmation target or fee " +
+			"rate")
+	}
+
+	feeRate := lnwallet.SatPerKWeight(req.SatPerKw)
+	if req.ConfTarget != 0 {
+		var err error
+		feeRate, err = w.cfg.FeeEstimator.EstimateFeePerKW(
+			uint32(req.ConfTarget),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		packet *psbt.Packet
+		err    error
+	)
+	if len(req.Psbt) != 0 {
+		packet, err = psbt.NewFromRawBytes(
+			bytes.NewReader(req.Psbt), false,
+		)
+	} else {
+		tx := wire.NewMsgTx(2)
+		for _, output := range req.Outputs {
+			tx.AddTxOut(&wire.TxOut{
+				Value:    output.Value,
+				PkScript: output.PkScript,
+			})
+		}
+		packet, err = psbt.NewFromUnsignedTx(tx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse psbt: %v", err)
+	}
+
+	lockDuration := lnwallet.DefaultLockDuration
+	if req.LockExpirationSeconds != 0 {
+		lockDuration = time.Duration(req.LockExpirationSeconds) *
+			time.Second
+	}
+
+	account := req.Account
+	if account == "" {
+		account = lnwallet.DefaultAccountName
+	}
+
+	changeIndex, lockExpiration, err := w.cfg.Wallet.FundPsbt(
+		packet, account, feeRate, 1, lockDuration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		w.cfg.Wallet.ReleasePsbtInputs(packet)
+		return nil, err
+	}
+
+	lockedUtxos := make([]*OutPoint, 0, len(packet.UnsignedTx.TxIn))
+	for _, txIn := range packet.UnsignedTx.TxIn {
+		op := txIn.PreviousOutPoint
+		lockedUtxos = append(lockedUtxos, &OutPoint{
+			TxidBytes:   op.Hash[:],
+			OutputIndex: op.Index,
+		})
+	}
+
+	return &FundPsbtResponse{
+		FundedPsbt:        b.Bytes(),
+		ChangeOutputIndex: changeIndex,
+		LockedUtxos:       lockedUtxos,
+		LockExpiration:    lockExpiration.Unix(),
+	}, nil
+}
+
+// ReleaseOutput releases an output that was locked by FundPsbt, making it
+// available to other transactions again.
+func (w *WalletKit) ReleaseOutput(ctx context.Context,
+	req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+
+	if req.Outpoint == nil {
+		return nil, fmt.Errorf("outpoint must be set")
+	}
+
+	hash, err := chainhash.NewHash(req.Outpoint.TxidBytes)
+	if err != nil {
+		return nil, err
+	}
+	outPoint := wire.OutPoint{
+		Hash:  *hash,
+		Index: req.Outpoint.OutputIndex,
+	}
+
+	if err := w.cfg.Wallet.ReleaseOutput(outPoint); err != nil {
+		return nil, err
+	}
+
+	return &ReleaseOutputResponse{}, nil
+}
+
+// SignPsbt signs all inputs of the PSBT that belong to the wallet. All other
+// inputs are left untouched. The inputs of the wallet are only signed with
+// SIGHASH_ALL.
+func (w *WalletKit) SignPsbt(ctx context.Context,
+	req *SignPsbtRequest) (*SignPsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse psbt: %v", err)
+	}
+
+	signedInputs, err := w.cfg.Wallet.SignPsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		return nil, err
+	}
+
+	return &SignPsbtResponse{
+		SignedPsbt:   b.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// FinalizePsbt signs all inputs of the PSBT that belong to the wallet, and
+// finalizes all of its inputs. The fully signed transaction is returned, but
+// isn't published.
+func (w *WalletKit) FinalizePsbt(ctx context.Context,
+	req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse psbt: %v", err)
+	}
+
+	finalTx, err := w.cfg.Wallet.FinalizePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	var signedPsbt, rawFinalTx bytes.Buffer
+	if err := packet.Serialize(&signedPsbt); err != nil {
+		return nil, err
+	}
+	if err := finalTx.Serialize(&rawFinalTx); err != nil {
+		return nil, err
+	}
+
+	return &FinalizePsbtResponse{
+		SignedPsbt: signedPsbt.Bytes(),
+		RawFinalTx: rawFinalTx.Bytes(),
+	}, nil
+}
+
+// marshallAccount returns the RPC representation of the passed account, along
+// with its balances.
+func (w *WalletKit) marshallAccount(
+	account *lnwallet.WalletAccount) (*Account, error) {
+
+	totalBalance, err := w.cfg.Wallet.AccountBalance(account.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+	confirmedBalance, err := w.cfg.Wallet.AccountBalance(account.Name, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		Name:               account.Name,
+		AccountNumber:      account.Number,
+		ConfirmedBalance:   int64(confirmedBalance),
+		UnconfirmedBalance: int64(totalBalance - confirmedBalance),
+		WatchOnly:          account.WatchOnly,
+	}, nil
+}
+
+// ListAccounts returns all accounts of the wallet, along with their balances.
+func (w *WalletKit) ListAccounts(ctx context.Context,
+	req *ListAccountsRequest) (*ListAccountsResponse, error) {
+
+	accounts, err := w.cfg.Wallet.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListAccountsResponse{
+		Accounts: make([]*Account, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		rpcAccount, err := w.marshallAccount(account)
+		if err != nil {
+			return nil, err
+		}
+		resp.Accounts = append(resp.Accounts, rpcAccount)
+	}
+
+	return resp, nil
+}
+
+// CreateAccount creates a new named account within the wallet. The funds of
+// the account are kept apart from those of all other accounts.
+func (w *WalletKit) CreateAccount(ctx context.Context,
+	req *CreateAccountRequest) (*Account, error) {
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("account name must be specified")
+	}
+
+	account, err := w.cfg.Wallet.CreateAccount(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Created wallet account %q with number %v", account.Name,
+		account.Number)
+
+	return w.marshallAccount(account)
+}
+
+// verifySeed deciphers the passed mnemonic with the given passphrase, and
+// ensures that the resulting seed is the one the running wallet was created
+// from. As the wallet doesn't expose its seed, we do so by comparing the node
+// key derived from the seed to the node key of the wallet.
+func (w *WalletKit) verifySeed(words []string,
+	passphrase []byte) (*aezeed.CipherSeed, error) {
+
+	if len(words) != aezeed.NummnemonicWords {
+		return nil, fmt.Errorf("mnemonic must be exactly %v words, "+
+			"got %v", aezeed.NummnemonicWords, len(words))
+	}
+
+	var mnemonic aezeed.Mnemonic
+	copy(mnemonic[:], words)
+
+	cipherSeed, err := mnemonic.ToCipherSeed(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeKeyLoc := keychain.KeyLocator{
+		Family: keychain.KeyFamilyNodeKey,
+		Index:  0,
+	}
+	nodeKey, err := w.cfg.KeyRing.DeriveKey(nodeKeyLoc)
+	if err != nil {
+		return nil, err
+	}
+	seedNodeKey, err := keychain.DerivePubKeyFromSeed(
+		cipherSeed.Entropy[:], w.cfg.ChainParams, w.cfg.CoinType,
+		nodeKeyLoc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !seedNodeKey.IsEqual(nodeKey.PubKey) {
+		return nil, ErrSeedMismatch
+	}
+
+	return cipherSeed, nil
+}
+
+// VerifySeed checks that the given aezeed mnemonic and passphrase decipher to
+// the seed the running wallet was created from. This allows a backup of the
+// seed to be verified without wiping the wallet.
+func (w *WalletKit) VerifySeed(ctx context.Context,
+	req *VerifySeedRequest) (*VerifySeedResponse, error) {
+
+	cipherSeed, err := w.verifySeed(
+		req.CipherSeedMnemonic, req.AezeedPassphrase,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifySeedResponse{
+		BirthdayTimestamp: cipherSeed.BirthdayTime().Unix(),
+	}, nil
+}
+
+// ChangeSeedPassphrase verifies the given aezeed mnemonic against the running
+// wallet like VerifySeed, and re-enciphers the seed with a new passphrase. The
+// new mnemonic is returned, while the wallet itself is left untouched.
+func (w *WalletKit) ChangeSeedPassphrase(ctx context.Context,
+	req *ChangeSeedPassphraseRequest) (*ChangeSeedPassphraseResponse,
+	error) {
+
+	cipherSeed, err := w.verifySeed(
+		req.CipherSeedMnemonic, req.CurrentAezeedPassphrase,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := cipherSeed.ToMnemonic(req.NewAezeedPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Re-enciphered wallet seed with new passphrase")
+
+	return &ChangeSeedPassphraseResponse{
+		CipherSeedMnemonic: mnemonic[:],
+	}, nil
+}
+
+// ExportWatchOnlyWallet returns a copy of the wallet database from which all
+// private key material has been removed, leaving only the extended public keys
+// of the accounts. It's used to create the on-chain wallet of a watch-only lnd
+// that uses this node as its remote signer.
+func (w *WalletKit) ExportWatchOnlyWallet(ctx context.Context,
+	req *ExportWatchOnlyWalletRequest) (*ExportWatchOnlyWalletResponse,
+	error) {
+
+	walletDB, err := w.cfg.Wallet.ExportWatchOnly()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Exported watch-only wallet")
+
+	return &ExportWatchOnlyWalletResponse{
+		WalletDb: walletDB,
+	}, nil
+}
+
+// parseAddressType maps the RPC address type of an import request to the type
+// used by the wallet.
+func parseAddressType(addrType AddressType) (lnwallet.AddressType, error) {
+	switch addrType {
+	case AddressType_WITNESS_PUBKEY_HASH:
+		return lnwallet.WitnessPubKey, nil
+
+	case AddressType_NESTED_WITNESS_PUBKEY_HASH:
+		return lnwallet.NestedWitnessPubKey, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported address type: %v", addrType)
+	}
+}
+
+// ImportAccount imports an account from its extended public key, such as one
+// held by a hardware wallet. The wallet tracks the outputs and balance of the
+// account, but can't sign for them. Its outputs can only be spent through a
+// PSBT created with FundPsbt, which is signed by the holder of the keys.
+func (w *WalletKit) ImportAccount(ctx context.Context,
+	req *ImportAccountRequest) (*Account, error) {
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("account name must be specified")
+	}
+
+	accountPubKey, err := hdkeychain.NewKeyFromString(
+		req.ExtendedPublicKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended public key: %v", err)
+	}
+
+	// The fingerprint is serialized in the byte order it's displayed in,
+	// which matches the byte order of PSBT derivation paths.
+	var masterKeyFingerprint uint32
+	switch len(req.MasterKeyFingerprint) {
+	case 0:
+	case 4:
+		masterKeyFingerprint = binary.LittleEndian.Uint32(
+			req.MasterKeyFingerprint,
+		)
+	default:
+		return nil, fmt.Errorf("master key fingerprint must be 4 " +
+			"bytes")
+	}
+
+	addrType, err := parseAddressType(req.AddressType)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := w.cfg.Wallet.ImportAccount(
+		req.Name, accountPubKey, masterKeyFingerprint, addrType,
+		req.BirthdayHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported watch-only wallet account %q", account.Name)
+
+	return w.marshallAccount(account)
+}
+
+// ImportPublicKey imports a single public key as watch-only. Its outputs are
+// tracked as part of the "imported" account.
+func (w *WalletKit) ImportPublicKey(ctx context.Context,
+	req *ImportPublicKeyRequest) (*ImportPublicKeyResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.PublicKey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+
+	addrType, err := parseAddressType(req.AddressType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.cfg.Wallet.ImportPublicKey(pubKey, addrType, req.BirthdayHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported watch-only public key %x", req.PublicKey)
+
+	return &ImportPublicKeyResponse{}, nil
+}
+
+// ImportAddress imports a single address as watch-only. Its outputs are
+// tracked as part of the "imported" account.
+func (w *WalletKit) ImportAddress(ctx context.Context,
+	req *ImportAddressRequest) (*ImportAddressResponse, error) {
+
+	addr, err := btcutil.DecodeAddress(req.Address, w.cfg.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %v", err)
+	}
+
+	err = w.cfg.Wallet.ImportAddress(addr, req.BirthdayHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported watch-only address %v", addr)
+
+	return &ImportAddressResponse{}, nil
+}
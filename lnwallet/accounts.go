@@ -0,0 +1,111 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// ErrWatchOnlyAccount is returned when attempting to have the wallet sign for
+// the coins of a watch-only account, which can only be spent through a PSBT
+// that is signed by an external signer.
+var ErrWatchOnlyAccount = errors.New("coins of watch-only accounts can only " +
+	"be spent through a psbt")
+
+// ErrAccountNotFound is returned when an account that doesn't exist is
+// referenced by name.
+type ErrAccountNotFound struct {
+	Name string
+}
+
+// Error returns a human readable string describing the error.
+func (e *ErrAccountNotFound) Error() string {
+	return fmt.Sprintf("account %q not found", e.Name)
+}
+
+// InAccount returns true if the output belongs to the named account.
+func (u *Utxo) InAccount(account string) bool {
+	if u.Account == "" {
+		return account == DefaultAccountName
+	}
+
+	return u.Account == account
+}
+
+// ListAccountUnspentWitness returns all unspent outputs of the named account
+// which are version 0 witness programs, having between minConfs and maxConfs
+// confirmations.
+func (l *LightningWallet) ListAccountUnspentWitness(account string, minConfs,
+	maxConfs int32) ([]*Utxo, error) {
+
+	utxos, err := l.ListAllUnspentWitness(minConfs, maxConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	accountUtxos := make([]*Utxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.InAccount(account) {
+			accountUtxos = append(accountUtxos, utxo)
+		}
+	}
+
+	return accountUtxos, nil
+}
+
+// AccountBalance returns the sum of all unspent witness outputs of the named
+// account that have at least confs confirmations. If confs is set to zero,
+// then unconfirmed outputs are included as well.
+func (l *LightningWallet) AccountBalance(account string,
+	confs int32) (btcutil.Amount, error) {
+
+	utxos, err := l.ListAccountUnspentWitness(
+		account, confs, math.MaxInt32,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var balance btcutil.Amount
+	for _, utxo := range utxos {
+		balance += utxo.Value
+	}
+
+	return balance, nil
+}
+
+// FetchAccount returns the account with the given name. If there's no such
+// account, then ErrAccountNotFound is returned.
+func (l *LightningWallet) FetchAccount(name string) (*WalletAccount, error) {
+	accounts, err := l.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		if account.Name == name {
+			return account, nil
+		}
+	}
+
+	return nil, &ErrAccountNotFound{Name: name}
+}
+
+// AccountUtxoSource restricts the outputs the wallet offers for sweeping to
+// those of a single account.
+type AccountUtxoSource struct {
+	*LightningWallet
+
+	// Account is the name of the account whose outputs are offered.
+	Account string
+}
+
+// ListUnspentWitness returns all unspent witness outputs of the account that
+// have between minConfs and maxConfs confirmations.
+func (s *AccountUtxoSource) ListUnspentWitness(minConfs,
+	maxConfs int32) ([]*Utxo, error) {
+
+	return s.ListAccountUnspentWitness(s.Account, minConfs, maxConfs)
+}
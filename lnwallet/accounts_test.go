@@ -0,0 +1,157 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// mockAccountWallet is a wallet controller that only knows about a fixed set
+// of accounts and outputs.
+type mockAccountWallet struct {
+	WalletController
+
+	accounts       []*WalletAccount
+	utxos          []*Utxo
+	watchOnlyUtxos []*Utxo
+}
+
+func (m *mockAccountWallet) ListAccounts() ([]*WalletAccount, error) {
+	return m.accounts, nil
+}
+
+func filterConfs(utxos []*Utxo, minConfs, maxConfs int32) []*Utxo {
+	var filtered []*Utxo
+	for _, utxo := range utxos {
+		if utxo.Confirmations >= int64(minConfs) &&
+			utxo.Confirmations <= int64(maxConfs) {
+
+			filtered = append(filtered, utxo)
+		}
+	}
+
+	return filtered
+}
+
+func (m *mockAccountWallet) ListUnspentWitness(minConfs,
+	maxConfs int32) ([]*Utxo, error) {
+
+	return filterConfs(m.utxos, minConfs, maxConfs), nil
+}
+
+func (m *mockAccountWallet) ListWatchOnlyUnspent(minConfs,
+	maxConfs int32) ([]*Utxo, error) {
+
+	return filterConfs(m.watchOnlyUtxos, minConfs, maxConfs), nil
+}
+
+// TestAccountBalance asserts that the balance of an account only includes the
+// outputs of that account, and that outputs without an account are counted
+// towards the default account.
+func TestAccountBalance(t *testing.T) {
+	t.Parallel()
+
+	wallet := &LightningWallet{
+		WalletController: &mockAccountWallet{
+			utxos: []*Utxo{
+				{Value: 1000, Confirmations: 1},
+				{Value: 2000, Confirmations: 0},
+				{
+					Value:         3000,
+					Confirmations: 6,
+					Account:       DefaultAccountName,
+				},
+				{
+					Value:         4000,
+					Confirmations: 3,
+					Account:       "savings",
+				},
+				{
+					Value:         5000,
+					Confirmations: 0,
+					Account:       "savings",
+				},
+			},
+			watchOnlyUtxos: []*Utxo{
+				{
+					Value:         6000,
+					Confirmations: 2,
+					Account:       "cold",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		account string
+		confs   int32
+		balance btcutil.Amount
+	}{
+		{account: DefaultAccountName, confs: 0, balance: 6000},
+		{account: DefaultAccountName, confs: 1, balance: 4000},
+		{account: "savings", confs: 0, balance: 9000},
+		{account: "savings", confs: 1, balance: 4000},
+		{account: "cold", confs: 1, balance: 6000},
+		{account: "cold", confs: 3, balance: 0},
+		{account: "unknown", confs: 0, balance: 0},
+	}
+	for _, test := range tests {
+		balance, err := wallet.AccountBalance(test.account, test.confs)
+		if err != nil {
+			t.Fatalf("unable to fetch balance: %v", err)
+		}
+		if balance != test.balance {
+			t.Fatalf("expected balance %v of account %q with %v "+
+				"confs, got %v", test.balance, test.account,
+				test.confs, balance)
+		}
+	}
+
+	// The outputs offered by the utxo source of an account must all belong
+	// to that account.
+	source := &AccountUtxoSource{
+		LightningWallet: wallet,
+		Account:         "savings",
+	}
+	utxos, err := source.ListUnspentWitness(0, 10)
+	if err != nil {
+		t.Fatalf("unable to list outputs: %v", err)
+	}
+	if len(utxos) != 2 {
+		t.Fatalf("expected 2 outputs, got %v", len(utxos))
+	}
+	for _, utxo := range utxos {
+		if utxo.Account != "savings" {
+			t.Fatalf("expected output of account savings, got "+
+				"account %q", utxo.Account)
+		}
+	}
+}
+
+// TestFetchAccount asserts that accounts are looked up by name, and that an
+// ErrAccountNotFound is returned for unknown names.
+func TestFetchAccount(t *testing.T) {
+	t.Parallel()
+
+	wallet := &LightningWallet{
+		WalletController: &mockAccountWallet{
+			accounts: []*WalletAccount{
+				{Name: DefaultAccountName, Number: 0},
+				{Name: "savings", Number: 1},
+			},
+		},
+	}
+
+	account, err := wallet.FetchAccount("savings")
+	if err != nil {
+		t.Fatalf("unable to fetch account: %v", err)
+	}
+	if account.Number != 1 {
+		t.Fatalf("expected account number 1, got %v", account.Number)
+	}
+
+	_, err = wallet.FetchAccount("unknown")
+	if _, ok := err.(*ErrAccountNotFound); !ok {
+		t.Fatalf("expected ErrAccountNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,346 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/wakiyamap/lnd/lnwallet"
+)
+
+var (
+	testPubPass  = []byte("public")
+	testPrivPass = []byte("private")
+)
+
+// mockAccountChain is a chain backend that never delivers any notifications,
+// which is all the base wallet needs to derive new addresses.
+type mockAccountChain struct {
+	chain.Interface
+
+	notifications chan interface{}
+}
+
+func (m *mockAccountChain) Notifications() <-chan interface{} {
+	return m.notifications
+}
+
+func (m *mockAccountChain) NotifyReceived([]btcutil.Address) error {
+	return nil
+}
+
+func (m *mockAccountChain) Stop() {
+	close(m.notifications)
+}
+
+func (m *mockAccountChain) WaitForShutdown() {}
+
+// newTestAccountWallet creates an unlocked wallet that is able to create
+// accounts and derive their addresses.
+func newTestAccountWallet(t *testing.T) (*BtcWallet, func()) {
+	tempDir, err := ioutil.TempDir("", "accounts")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	db, err := walletdb.Create("bdb", filepath.Join(tempDir, "wallet.db"))
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create db: %v", err)
+	}
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+
+	netParams := &chaincfg.RegressionNetParams
+
+	var seed [hdkeychain.RecommendedSeedLen]byte
+	seed[0] = 1
+	err = base.Create(
+		db, testPubPass, testPrivPass, seed[:], netParams, time.Now(),
+	)
+	if err != nil {
+		cleanUp()
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	wallet, err := base.Open(db, testPubPass, nil, netParams, 0)
+	if err != nil {
+		cleanUp()
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+
+	// New accounts can only be created once the private keys of the
+	// wallet are unlocked.
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return wallet.Manager.Unlock(addrmgrNs, testPrivPass)
+	})
+	if err != nil {
+		cleanUp()
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	chainBackend := &mockAccountChain{
+		notifications: make(chan interface{}),
+	}
+	wallet.Start()
+	wallet.SynchronizeRPC(chainBackend)
+
+	w := &BtcWallet{
+		wallet:    wallet,
+		chain:     chainBackend,
+		db:        db,
+		netParams: netParams,
+		quit:      make(chan struct{}),
+	}
+
+	return w, func() {
+		wallet.Stop()
+		wallet.WaitForShutdown()
+		cleanUp()
+	}
+}
+
+// TestCreateAccount asserts that created accounts are listed along with the
+// default account, and that names can't be used twice.
+func TestCreateAccount(t *testing.T) {
+	t.Parallel()
+
+	w, cleanUp := newTestAccountWallet(t)
+	defer cleanUp()
+
+	savings, err := w.CreateAccount("savings")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	spending, err := w.CreateAccount("spending")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	if savings.Number != 1 || spending.Number != 2 {
+		t.Fatalf("expected account numbers 1 and 2, got %v and %v",
+			savings.Number, spending.Number)
+	}
+
+	// The accounts must have been created under the same number within
+	// all key scopes.
+	for _, keyScope := range accountKeyScopes {
+		num, err := w.wallet.AccountNumber(keyScope, "spending")
+		if err != nil {
+			t.Fatalf("unable to find account within key scope "+
+				"%v: %v", keyScope, err)
+		}
+		if num != spending.Number {
+			t.Fatalf("expected account number %v within key scope "+
+				"%v, got %v", spending.Number, keyScope, num)
+		}
+	}
+
+	// Neither the name of an account of the base wallet nor the one of an
+	// imported account can be used again.
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := fetchImportsNamespace(tx)
+		if err != nil {
+			return err
+		}
+
+		var seed [hdkeychain.RecommendedSeedLen]byte
+		key, err := hdkeychain.NewMaster(seed[:], w.netParams)
+		if err != nil {
+			return err
+		}
+		pubKey, err := key.Neuter()
+		if err != nil {
+			return err
+		}
+
+		return putImportedAccount(ns, &importedAccount{
+			name:     "cold",
+			pubKey:   pubKey,
+			addrType: lnwallet.WitnessPubKey,
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to import account: %v", err)
+	}
+	for _, name := range []string{"default", "savings", "cold"} {
+		if _, err := w.CreateAccount(name); err == nil {
+			t.Fatalf("expected account %q to exist already", name)
+		}
+	}
+
+	accounts, err := w.ListAccounts()
+	if err != nil {
+		t.Fatalf("unable to list accounts: %v", err)
+	}
+	expected := []struct {
+		name      string
+		number    uint32
+		watchOnly bool
+	}{
+		{name: "default", number: 0},
+		{name: "savings", number: 1},
+		{name: "spending", number: 2},
+		{name: "cold", watchOnly: true},
+	}
+	if len(accounts) != len(expected) {
+		t.Fatalf("expected %v accounts, got %v", len(expected),
+			len(accounts))
+	}
+	for i, account := range accounts {
+		if account.Name != expected[i].name ||
+			account.WatchOnly != expected[i].watchOnly {
+
+			t.Fatalf("expected account %v to be %q, got %q", i,
+				expected[i].name, account.Name)
+		}
+		if !account.WatchOnly && account.Number != expected[i].number {
+			t.Fatalf("expected account %q to have number %v, "+
+				"got %v", account.Name, expected[i].number,
+				account.Number)
+		}
+	}
+}
+
+// TestNewAccountAddress asserts that addresses are derived from the key
+// scope of their type within the requested account.
+func TestNewAccountAddress(t *testing.T) {
+	t.Parallel()
+
+	w, cleanUp := newTestAccountWallet(t)
+	defer cleanUp()
+
+	account, err := w.CreateAccount("savings")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+
+	tests := []struct {
+		addrType lnwallet.AddressType
+		change   bool
+		keyScope waddrmgr.KeyScope
+	}{
+		{
+			addrType: lnwallet.WitnessPubKey,
+			keyScope: waddrmgr.KeyScopeBIP0084,
+		},
+		{
+			addrType: lnwallet.WitnessPubKey,
+			change:   true,
+			keyScope: waddrmgr.KeyScopeBIP0084,
+		},
+		{
+			addrType: lnwallet.NestedWitnessPubKey,
+			keyScope: waddrmgr.KeyScopeBIP0049Plus,
+		},
+	}
+	for _, test := range tests {
+		addr, err := w.NewAccountAddress(
+			"savings", test.addrType, test.change,
+		)
+		if err != nil {
+			t.Fatalf("unable to derive address: %v", err)
+		}
+
+		info, err := w.wallet.AddressInfo(addr)
+		if err != nil {
+			t.Fatalf("unable to fetch address info: %v", err)
+		}
+		if info.Account() != account.Number {
+			t.Fatalf("expected address of account %v, got %v",
+				account.Number, info.Account())
+		}
+		if info.Internal() != test.change {
+			t.Fatalf("expected internal=%v, got %v", test.change,
+				info.Internal())
+		}
+
+		pubKeyAddr := info.(waddrmgr.ManagedPubKeyAddress)
+		keyScope, path, _ := pubKeyAddr.DerivationInfo()
+		if keyScope != test.keyScope {
+			t.Fatalf("expected key scope %v, got %v",
+				test.keyScope, keyScope)
+		}
+		if path.Account != account.Number {
+			t.Fatalf("expected derivation from account %v, got %v",
+				account.Number, path.Account)
+		}
+	}
+
+	// The addresses of the account must not overlap with the ones of the
+	// default account.
+	defaultAddr, err := w.NewAddress(lnwallet.WitnessPubKey, false)
+	if err != nil {
+		t.Fatalf("unable to derive address: %v", err)
+	}
+	num, err := w.wallet.AccountOfAddress(defaultAddr)
+	if err != nil {
+		t.Fatalf("unable to fetch account of address: %v", err)
+	}
+	if num != defaultAccount {
+		t.Fatalf("expected address of the default account, got "+
+			"account %v", num)
+	}
+
+	if _, err := w.NewAccountAddress(
+		"unknown", lnwallet.WitnessPubKey, false,
+	); err == nil {
+		t.Fatalf("expected address derivation of unknown account to " +
+			"fail")
+	}
+}
+
+// TestExtractBalanceDelta asserts that the balance delta of a transaction is
+// split among the accounts its inputs and outputs belong to.
+func TestExtractBalanceDelta(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(wire.NewTxOut(3000, nil))
+	tx.AddTxOut(wire.NewTxOut(4000, nil))
+	tx.AddTxOut(wire.NewTxOut(5000, nil))
+
+	summary := base.TransactionSummary{
+		MyInputs: []base.TransactionSummaryInput{
+			{PreviousAccount: 0, PreviousAmount: 10000},
+		},
+		MyOutputs: []base.TransactionSummaryOutput{
+			{Index: 0, Account: 0},
+			{Index: 2, Account: 1},
+		},
+	}
+	names := map[uint32]string{0: "default", 1: "savings"}
+
+	delta, accountDeltas, err := extractBalanceDelta(summary, tx, names)
+	if err != nil {
+		t.Fatalf("unable to extract balance delta: %v", err)
+	}
+	if delta != -2000 {
+		t.Fatalf("expected balance delta -2000, got %v", delta)
+	}
+
+	expected := map[string]btcutil.Amount{
+		"default": -7000,
+		"savings": 5000,
+	}
+	if len(accountDeltas) != len(expected) {
+		t.Fatalf("expected %v account deltas, got %v", len(expected),
+			len(accountDeltas))
+	}
+	for name, amt := range expected {
+		if accountDeltas[name] != amt {
+			t.Fatalf("expected delta %v of account %q, got %v",
+				amt, name, accountDeltas[name])
+		}
+	}
+}
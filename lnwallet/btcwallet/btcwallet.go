@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +41,14 @@ var (
 		ExternalAddrType: waddrmgr.WitnessPubKey,
 		InternalAddrType: waddrmgr.WitnessPubKey,
 	}
+
+	// accountKeyScopes are the key scopes of all address types we
+	// support. Every named account is created within all of them, under
+	// the same account number.
+	accountKeyScopes = []waddrmgr.KeyScope{
+		waddrmgr.KeyScopeBIP0084,
+		waddrmgr.KeyScopeBIP0049Plus,
+	}
 )
 
 // BtcWallet is an implementation of the lnwallet.WalletController interface
@@ -64,6 +73,18 @@ type BtcWallet struct {
 	// FetchInputInfo.
 	utxoCache map[wire.OutPoint]*wire.TxOut
 	cacheMtx  sync.RWMutex
+
+	// importMtx serializes the scanning of blocks for outputs of imported
+	// keys with new imports, which may move the point the chain is scanned
+	// from.
+	importMtx sync.Mutex
+
+	// importScanSignal wakes up the scanner of imported keys, once new
+	// keys have been imported.
+	importScanSignal chan struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -101,7 +122,26 @@ func New(cfg Config) (*BtcWallet, error) {
 			return nil, err
 		}
 
-		if !walletExists {
+		switch {
+		// The wallet has never been created, and its watch-only copy
+		// is provided by a remote signer. Its public data is encrypted
+		// with the default public passphrase.
+		case !walletExists && cfg.WatchOnlyWallet != nil:
+			err := createWatchOnlyWallet(
+				netDir, cfg.WatchOnlyWallet,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			wallet, err = loader.OpenExistingWallet(
+				defaultPubPassphrase, false,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+		case !walletExists:
 			// Wallet has never been created, perform initial
 			// set up.
 			wallet, err = loader.CreateNewWallet(
@@ -111,7 +151,8 @@ func New(cfg Config) (*BtcWallet, error) {
 			if err != nil {
 				return nil, err
 			}
-		} else {
+
+		default:
 			// Wallet has been created and been initialized at
 			// this point, open it along with all the required DB
 			// namespaces, and the DB itself.
@@ -130,6 +171,9 @@ func New(cfg Config) (*BtcWallet, error) {
 		netParams:     cfg.NetParams,
 		chainKeyScope: chainKeyScope,
 		utxoCache:     make(map[wire.OutPoint]*wire.TxOut),
+
+		importScanSignal: make(chan struct{}, 1),
+		quit:             make(chan struct{}),
 	}, nil
 }
 
@@ -158,12 +202,16 @@ func (b *BtcWallet) Start() error {
 	// We'll start by unlocking the wallet and ensuring that the KeyScope:
 	// (1017, 1) exists within the internal waddrmgr. We'll need this in
 	// order to properly generate the keys required for signing various
-	// contracts.
-	if err := b.wallet.Unlock(b.cfg.PrivatePass, nil); err != nil {
-		return err
+	// contracts. A watch-only wallet can't be unlocked, and leaves the
+	// derivation of these keys to its remote signer.
+	if !b.IsWatchOnly() {
+		err := b.wallet.Unlock(b.cfg.PrivatePass, nil)
+		if err != nil {
+			return err
+		}
 	}
 	_, err := b.wallet.Manager.FetchScopedKeyManager(b.chainKeyScope)
-	if err != nil {
+	if err != nil && !b.IsWatchOnly() {
 		// If the scope hasn't yet been created (it wouldn't been
 		// loaded by default if it was), then we'll manually create the
 		// scope for the first time ourselves.
@@ -193,6 +241,11 @@ func (b *BtcWallet) Start() error {
 	// current main chain.
 	b.wallet.SynchronizeRPC(b.chain)
 
+	// The outputs of imported keys aren't tracked by the base wallet, so
+	// we scan the chain for them ourselves.
+	b.wg.Add(1)
+	go b.importScanner()
+
 	return nil
 }
 
@@ -201,6 +254,9 @@ func (b *BtcWallet) Start() error {
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) Stop() error {
+	close(b.quit)
+	b.wg.Wait()
+
 	b.wallet.Stop()
 
 	b.wallet.WaitForShutdown()
@@ -256,6 +312,46 @@ func (b *BtcWallet) NewAddress(t lnwallet.AddressType, change bool) (btcutil.Add
 	return b.wallet.NewAddress(defaultAccount, keyScope)
 }
 
+// NewAccountAddress returns the next external or internal address of the
+// named account, dictated by the value of the `change` parameter.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) NewAccountAddress(account string, t lnwallet.AddressType,
+	change bool) (btcutil.Address, error) {
+
+	// The addresses of imported accounts are derived from their extended
+	// public key, which the base wallet doesn't know about.
+	imported, err := b.isImportedAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	if imported {
+		return b.newImportedAddress(account, t, change)
+	}
+
+	var keyScope waddrmgr.KeyScope
+
+	switch t {
+	case lnwallet.WitnessPubKey:
+		keyScope = waddrmgr.KeyScopeBIP0084
+	case lnwallet.NestedWitnessPubKey:
+		keyScope = waddrmgr.KeyScopeBIP0049Plus
+	default:
+		return nil, fmt.Errorf("unknown address type")
+	}
+
+	accountNum, err := b.wallet.AccountNumber(keyScope, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if change {
+		return b.wallet.NewChangeAddress(accountNum, keyScope)
+	}
+
+	return b.wallet.NewAddress(accountNum, keyScope)
+}
+
 // LastUnusedAddress returns the last *unused* address known by the wallet. An
 // address is unused if it hasn't received any payments. This can be useful in
 // UIs in order to continually show the "freshest" address without having to
@@ -306,6 +402,35 @@ func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
 	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
 }
 
+// SendOutputsFromAccount funds, signs, and broadcasts a Bitcoin transaction
+// paying out to the specified outputs, only spending outputs of the named
+// account. Any change is sent back to the account.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) SendOutputsFromAccount(account string,
+	outputs []*wire.TxOut, feeRate lnwallet.SatPerKWeight) (*wire.MsgTx,
+	error) {
+
+	// As accounts share their number across all key scopes, we can look
+	// up the number within any of them.
+	accountNum, err := b.wallet.AccountNumber(
+		waddrmgr.KeyScopeBIP0084, account,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert our fee rate from sat/kw to sat/kb since it's required by
+	// SendOutputs.
+	feeSatPerKB := btcutil.Amount(feeRate.FeePerKVByte())
+
+	// Sanity check outputs.
+	if len(outputs) < 1 {
+		return nil, lnwallet.ErrNoOutputs
+	}
+	return b.wallet.SendOutputs(outputs, accountNum, 1, feeSatPerKB)
+}
+
 // CreateSimpleTx creates a Bitcoin transaction paying to the specified
 // outputs. The transaction is not broadcasted to the network, but a new change
 // address might be created in the wallet database. In the case the wallet has
@@ -411,6 +536,7 @@ func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 					Index: output.Vout,
 				},
 				Confirmations: output.Confirmations,
+				Account:       output.Account,
 			}
 			witnessOutputs = append(witnessOutputs, utxo)
 		}
@@ -472,24 +598,58 @@ func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx) error {
 	return nil
 }
 
+// accountNames returns the names of all accounts of the wallet, keyed by
+// their account number.
+func accountNames(w *base.Wallet) (map[uint32]string, error) {
+	// As accounts share their number across all key scopes, the accounts
+	// of any scope will do.
+	accounts, err := w.Accounts(waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint32]string, len(accounts.Accounts))
+	for _, account := range accounts.Accounts {
+		names[account.AccountNumber] = account.AccountName
+	}
+
+	return names, nil
+}
+
 // extractBalanceDelta extracts the net balance delta from the PoV of the
-// wallet given a TransactionSummary.
+// wallet given a TransactionSummary. The delta of each account the
+// transaction is relevant to is returned as well, keyed by the name of the
+// account.
 func extractBalanceDelta(
 	txSummary base.TransactionSummary,
 	tx *wire.MsgTx,
-) (btcutil.Amount, error) {
+	accountNames map[uint32]string,
+) (btcutil.Amount, map[string]btcutil.Amount, error) {
+	accountName := func(account uint32) string {
+		if name, ok := accountNames[account]; ok {
+			return name
+		}
+		return strconv.FormatUint(uint64(account), 10)
+	}
+
 	// For each input we debit the wallet's outflow for this transaction,
 	// and for each output we credit the wallet's inflow for this
 	// transaction.
 	var balanceDelta btcutil.Amount
+	accountDeltas := make(map[string]btcutil.Amount)
 	for _, input := range txSummary.MyInputs {
 		balanceDelta -= input.PreviousAmount
+		accountDeltas[accountName(input.PreviousAccount)] -=
+			input.PreviousAmount
 	}
 	for _, output := range txSummary.MyOutputs {
-		balanceDelta += btcutil.Amount(tx.TxOut[output.Index].Value)
+		value := btcutil.Amount(tx.TxOut[output.Index].Value)
+
+		balanceDelta += value
+		accountDeltas[accountName(output.Account)] += value
 	}
 
-	return balanceDelta, nil
+	return balanceDelta, accountDeltas, nil
 }
 
 // minedTransactionsToDetails is a helper function which converts a summary
@@ -498,6 +658,7 @@ func minedTransactionsToDetails(
 	currentHeight int32,
 	block base.Block,
 	chainParams *chaincfg.Params,
+	accountNames map[uint32]string,
 ) ([]*lnwallet.TransactionDetail, error) {
 
 	details := make([]*lnwallet.TransactionDetail, 0, len(block.Transactions))
@@ -530,11 +691,14 @@ func minedTransactionsToDetails(
 			DestAddresses:    destAddresses,
 		}
 
-		balanceDelta, err := extractBalanceDelta(tx, wireTx)
+		balanceDelta, accountDeltas, err := extractBalanceDelta(
+			tx, wireTx, accountNames,
+		)
 		if err != nil {
 			return nil, err
 		}
 		txDetail.Value = balanceDelta
+		txDetail.AccountValues = accountDeltas
 
 		details = append(details, txDetail)
 	}
@@ -546,6 +710,7 @@ func minedTransactionsToDetails(
 // for an unconfirmed transaction to a transaction detail.
 func unminedTransactionsToDetail(
 	summary base.TransactionSummary,
+	accountNames map[uint32]string,
 ) (*lnwallet.TransactionDetail, error) {
 	wireTx := &wire.MsgTx{}
 	txReader := bytes.NewReader(summary.Transaction)
@@ -560,11 +725,14 @@ func unminedTransactionsToDetail(
 		Timestamp: summary.Timestamp,
 	}
 
-	balanceDelta, err := extractBalanceDelta(summary, wireTx)
+	balanceDelta, accountDeltas, err := extractBalanceDelta(
+		summary, wireTx, accountNames,
+	)
 	if err != nil {
 		return nil, err
 	}
 	txDetail.Value = balanceDelta
+	txDetail.AccountValues = accountDeltas
 
 	return txDetail, nil
 }
@@ -589,6 +757,11 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		return nil, err
 	}
 
+	names, err := accountNames(b.wallet)
+	if err != nil {
+		return nil, err
+	}
+
 	txDetails := make([]*lnwallet.TransactionDetail, 0,
 		len(txns.MinedTransactions)+len(txns.UnminedTransactions))
 
@@ -596,7 +769,9 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 	// TransactionDetail which re-packages the data returned by the base
 	// wallet.
 	for _, blockPackage := range txns.MinedTransactions {
-		details, err := minedTransactionsToDetails(currentHeight, blockPackage, b.netParams)
+		details, err := minedTransactionsToDetails(
+			currentHeight, blockPackage, b.netParams, names,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -604,7 +779,7 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		txDetails = append(txDetails, details...)
 	}
 	for _, tx := range txns.UnminedTransactions {
-		detail, err := unminedTransactionsToDetail(tx)
+		detail, err := unminedTransactionsToDetail(tx, names)
 		if err != nil {
 			return nil, err
 		}
@@ -615,6 +790,100 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 	return txDetails, nil
 }
 
+// ListAccounts returns all accounts of the wallet, including the default
+// account and the watch-only accounts of imported keys.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListAccounts() ([]*lnwallet.WalletAccount, error) {
+	// As accounts share their number across all key scopes, the accounts
+	// of any scope will do.
+	accounts, err := b.wallet.Accounts(waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		return nil, err
+	}
+
+	walletAccounts := make(
+		[]*lnwallet.WalletAccount, 0, len(accounts.Accounts),
+	)
+	for _, account := range accounts.Accounts {
+		// The account of imported addresses can't derive any
+		// addresses, so we don't expose it.
+		if account.AccountNumber == waddrmgr.ImportedAddrAccount {
+			continue
+		}
+
+		walletAccounts = append(walletAccounts, &lnwallet.WalletAccount{
+			Name:   account.AccountName,
+			Number: account.AccountNumber,
+		})
+	}
+
+	importedAccounts, err := b.importedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(walletAccounts, importedAccounts...), nil
+}
+
+// CreateAccount creates a new account with the given name within the key
+// scopes of all supported address types. The account is created under the
+// same account number within all of them, which is required as btcwallet
+// selects the coins of an account by its number.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) CreateAccount(name string) (*lnwallet.WalletAccount,
+	error) {
+
+	// The base wallet doesn't know about imported accounts, so we need to
+	// make sure the name isn't taken by one of them.
+	imported, err := b.isImportedAccount(name)
+	if err != nil {
+		return nil, err
+	}
+	if imported {
+		return nil, fmt.Errorf("account %q already exists", name)
+	}
+
+	var accountNum uint32
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		for i, keyScope := range accountKeyScopes {
+			scopedMgr, err := b.wallet.Manager.FetchScopedKeyManager(
+				keyScope,
+			)
+			if err != nil {
+				return err
+			}
+
+			num, err := scopedMgr.NewAccount(addrmgrNs, name)
+			if err != nil {
+				return err
+			}
+
+			// If the accounts of the key scopes have diverged, we
+			// can't keep them in sync anymore, so we'll abort.
+			if i > 0 && num != accountNum {
+				return fmt.Errorf("account number %v within "+
+					"key scope %v doesn't match account "+
+					"number %v", num, keyScope, accountNum)
+			}
+			accountNum = num
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwallet.WalletAccount{
+		Name:   name,
+		Number: accountNum,
+	}, nil
+}
+
 // txSubscriptionClient encapsulates the transaction notification client from
 // the base wallet. Notifications received from the client will be proxied over
 // two distinct channels.
@@ -667,11 +936,19 @@ out:
 			// TODO(roasbeef): handle detached blocks
 			currentHeight := t.w.Manager.SyncedTo().Height
 
+			names, err := accountNames(t.w)
+			if err != nil {
+				continue
+			}
+
 			// Launch a goroutine to re-package and send
 			// notifications for any newly confirmed transactions.
 			go func() {
 				for _, block := range txNtfn.AttachedBlocks {
-					details, err := minedTransactionsToDetails(currentHeight, block, t.w.ChainParams())
+					details, err := minedTransactionsToDetails(
+						currentHeight, block,
+						t.w.ChainParams(), names,
+					)
 					if err != nil {
 						continue
 					}
@@ -691,7 +968,9 @@ out:
 			// notifications for any newly unconfirmed transactions.
 			go func() {
 				for _, tx := range txNtfn.UnminedTransactions {
-					detail, err := unminedTransactionsToDetail(tx)
+					detail, err := unminedTransactionsToDetail(
+						tx, names,
+					)
 					if err != nil {
 						continue
 					}
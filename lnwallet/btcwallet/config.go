@@ -64,6 +64,13 @@ type Config struct {
 	// unspecified, a new seed will be generated.
 	HdSeed []byte
 
+	// WatchOnlyWallet is an optional function that returns a watch-only
+	// copy of a wallet, as exported by a remote signer. If it's set and
+	// the wallet doesn't exist yet, the wallet is created from the copy
+	// instead of from a seed, such that it only holds the public keys of
+	// its accounts.
+	WatchOnlyWallet func() ([]byte, error)
+
 	// Birthday specifies the time at which this wallet was initially
 	// created. It is used to bound rescans for used addresses.
 	Birthday time.Time
@@ -0,0 +1,1425 @@
+package btcwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/psbt"
+)
+
+// The base wallet is only able to track the outputs of keys it derives itself,
+// so the keys and addresses imported into watch-only accounts are tracked by
+// scanning the blocks of the chain for outputs paying to them. The state of
+// the imports is kept within its own namespace of the wallet database:
+//
+// imports namespace
+// |-> synced-height: the height and hash of the last scanned block
+// |-> has-imported-keys: set once keys or addresses are imported
+// |-> accounts: name -> imported account
+// |-> scripts: output script -> watched script
+// |-> utxos: outpoint -> tracked output
+// |-> block-hashes: height -> hash of the most recently scanned blocks
+
+const (
+	// importGapLimit is the number of unused addresses that are watched on
+	// each branch of an imported account, beyond the last used one.
+	importGapLimit = 20
+
+	// importReorgSafetyLimit is the number of most recently scanned
+	// blocks of which the hashes are kept to detect reorgs. Spent outputs
+	// are kept for as many blocks, such that they can be restored if the
+	// spending block is reorged out.
+	importReorgSafetyLimit = 100
+
+	// importScanInterval is the interval at which the chain is polled for
+	// new blocks that may contain outputs of imported keys.
+	importScanInterval = 30 * time.Second
+
+	// externalBranch and internalBranch are the BIP 44 branches of the
+	// receiving and change addresses of an account.
+	externalBranch = 0
+	internalBranch = 1
+
+	// accountKeyDepth is the depth of the BIP 44 account-level extended
+	// keys that can be imported.
+	accountKeyDepth = 3
+)
+
+var (
+	// importsNamespaceKey is the namespace key that the state of the
+	// imported keys is stored within the top-level walletdb buckets.
+	importsNamespaceKey = []byte("lnwallet-imports")
+
+	importAccountsBucket    = []byte("accounts")
+	importScriptsBucket     = []byte("scripts")
+	importUtxosBucket       = []byte("utxos")
+	importBlockHashesBucket = []byte("block-hashes")
+
+	importSyncedHeightKey    = []byte("synced-height")
+	importHasImportedKeysKey = []byte("has-imported-keys")
+
+	// errNoImportedAccount is returned when an imported account that
+	// doesn't exist is looked up.
+	errNoImportedAccount = errors.New("imported account not found")
+
+	// errNoWatchedScript is returned when a script that isn't watched is
+	// looked up.
+	errNoWatchedScript = errors.New("script not watched")
+
+	// errNotSynced is returned when the sync point of the imports is
+	// looked up before anything has been imported.
+	errNotSynced = errors.New("imports not synced")
+)
+
+// importedAccount is a watch-only account imported from its BIP 44
+// account-level extended public key.
+type importedAccount struct {
+	name                 string
+	pubKey               *hdkeychain.ExtendedKey
+	masterKeyFingerprint uint32
+	addrType             lnwallet.AddressType
+
+	// number is the BIP 44 account number the extended key was derived
+	// with.
+	number uint32
+
+	// nextIndex is the index of the next unused address of the external
+	// and internal branch.
+	nextIndex [2]uint32
+}
+
+// watchedScript is an output script of an imported key or address.
+type watchedScript struct {
+	account  string
+	addrType lnwallet.AddressType
+
+	// pubKey is the serialized key the script pays to. It's empty for
+	// imported addresses.
+	pubKey []byte
+
+	// derived is true if the key was derived from an imported account,
+	// at the given branch and index.
+	derived bool
+	branch  uint32
+	index   uint32
+}
+
+// trackedUtxo is an output of an imported key or address.
+type trackedUtxo struct {
+	value    int64
+	pkScript []byte
+	height   uint32
+
+	// spendHeight is the height of the block that spent the output, or
+	// zero if the output is unspent.
+	spendHeight uint32
+}
+
+// ImportAccount imports a watch-only account with the given name from its BIP
+// 44 account-level extended public key. The wallet tracks the outputs paying
+// to addresses of the given type derived from the key, starting at the block
+// with the given height. If the height is zero, only blocks connected after
+// the import are scanned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ImportAccount(name string,
+	accountPubKey *hdkeychain.ExtendedKey, masterKeyFingerprint uint32,
+	addrType lnwallet.AddressType,
+	birthdayHeight uint32) (*lnwallet.WalletAccount, error) {
+
+	switch {
+	case name == "":
+		return nil, fmt.Errorf("account name must be specified")
+
+	case accountPubKey.IsPrivate():
+		return nil, fmt.Errorf("only extended public keys can be " +
+			"imported")
+
+	case accountPubKey.Depth() != accountKeyDepth:
+		return nil, fmt.Errorf("extended key must be an account-level "+
+			"key at depth %v, got depth %v", accountKeyDepth,
+			accountPubKey.Depth())
+	}
+
+	if err := checkImportAddrType(addrType); err != nil {
+		return nil, err
+	}
+
+	// The account-level key of BIP 44 is derived with a hardened index,
+	// which is the number of the account.
+	index := extendedKeyIndex(accountPubKey)
+	if index < hdkeychain.HardenedKeyStart {
+		return nil, fmt.Errorf("extended key must be derived with a " +
+			"hardened account index")
+	}
+
+	if name == lnwallet.ImportedAccountName {
+		return nil, fmt.Errorf("account name %q is reserved", name)
+	}
+	accounts, err := b.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.Name == name {
+			return nil, fmt.Errorf("account %q already exists",
+				name)
+		}
+	}
+
+	account := &importedAccount{
+		name:                 name,
+		pubKey:               accountPubKey,
+		masterKeyFingerprint: masterKeyFingerprint,
+		addrType:             addrType,
+		number:               index - hdkeychain.HardenedKeyStart,
+	}
+
+	addScripts := func(ns walletdb.ReadWriteBucket) error {
+		if err := putImportedAccount(ns, account); err != nil {
+			return err
+		}
+
+		return b.extendAccountScripts(ns, account)
+	}
+	if err := b.importScripts(birthdayHeight, addScripts); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported watch-only account %q with number %v", name,
+		account.number)
+
+	return account.walletAccount(), nil
+}
+
+// ImportPublicKey imports a single public key into the watch-only
+// ImportedAccountName account. Outputs paying to the address of the given type
+// derived from the key are tracked starting at the block with the given
+// height, similar to ImportAccount.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ImportPublicKey(pubKey *btcec.PublicKey,
+	addrType lnwallet.AddressType, birthdayHeight uint32) error {
+
+	if err := checkImportAddrType(addrType); err != nil {
+		return err
+	}
+
+	addr, err := b.watchOnlyAddress(pubKey, addrType)
+	if err != nil {
+		return err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	script := &watchedScript{
+		account:  lnwallet.ImportedAccountName,
+		addrType: addrType,
+		pubKey:   pubKey.SerializeCompressed(),
+	}
+
+	addScripts := func(ns walletdb.ReadWriteBucket) error {
+		err := ns.Put(importHasImportedKeysKey, []byte{1})
+		if err != nil {
+			return err
+		}
+
+		return putWatchedScript(ns, pkScript, script)
+	}
+	if err := b.importScripts(birthdayHeight, addScripts); err != nil {
+		return err
+	}
+
+	log.Infof("Imported watch-only public key %x with address %v",
+		script.pubKey, addr)
+
+	return nil
+}
+
+// ImportAddress imports a single address into the watch-only
+// ImportedAccountName account. Outputs paying to the address are tracked
+// starting at the block with the given height, similar to ImportAccount. Only
+// P2WKH addresses can be imported, as the weight of the inputs spending the
+// outputs of an address must be known in order to fund transactions with
+// them.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ImportAddress(addr btcutil.Address,
+	birthdayHeight uint32) error {
+
+	if _, ok := addr.(*btcutil.AddressWitnessPubKeyHash); !ok {
+		return fmt.Errorf("only p2wkh addresses can be imported")
+	}
+	if !addr.IsForNet(b.netParams) {
+		return fmt.Errorf("address %v is not valid for network %v",
+			addr, b.netParams.Name)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	script := &watchedScript{
+		account:  lnwallet.ImportedAccountName,
+		addrType: lnwallet.WitnessPubKey,
+	}
+
+	addScripts := func(ns walletdb.ReadWriteBucket) error {
+		err := ns.Put(importHasImportedKeysKey, []byte{1})
+		if err != nil {
+			return err
+		}
+
+		return putWatchedScript(ns, pkScript, script)
+	}
+	if err := b.importScripts(birthdayHeight, addScripts); err != nil {
+		return err
+	}
+
+	log.Infof("Imported watch-only address %v", addr)
+
+	return nil
+}
+
+// ListWatchOnlyUnspent returns all unspent outputs of the watch-only accounts
+// having between minConfs and maxConfs confirmations. Outputs of watch-only
+// accounts are only tracked once they confirm, and locked outputs are
+// omitted.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListWatchOnlyUnspent(minConfs, maxConfs int32) (
+	[]*lnwallet.Utxo, error) {
+
+	var utxos []*lnwallet.Utxo
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+
+		syncedHeight, _, err := fetchSyncedHeight(ns)
+		if err != nil {
+			return err
+		}
+
+		utxoBucket := ns.NestedReadBucket(importUtxosBucket)
+		return utxoBucket.ForEach(func(k, v []byte) error {
+			var outPoint wire.OutPoint
+			copy(outPoint.Hash[:], k[:chainhash.HashSize])
+			outPoint.Index = binary.BigEndian.Uint32(
+				k[chainhash.HashSize:],
+			)
+
+			utxo, err := deserializeTrackedUtxo(v)
+			if err != nil {
+				return err
+			}
+
+			confs := int64(syncedHeight) - int64(utxo.height) + 1
+			switch {
+			case utxo.spendHeight != 0:
+				return nil
+			case confs < int64(minConfs) || confs > int64(maxConfs):
+				return nil
+			case b.wallet.LockedOutpoint(outPoint):
+				return nil
+			}
+
+			script, err := fetchWatchedScript(ns, utxo.pkScript)
+			if err != nil {
+				return err
+			}
+
+			walletUtxo := &lnwallet.Utxo{
+				AddressType:   script.addrType,
+				Value:         btcutil.Amount(utxo.value),
+				Confirmations: confs,
+				PkScript:      utxo.pkScript,
+				OutPoint:      outPoint,
+				Account:       script.account,
+			}
+
+			// The redeem script of a nested P2WKH output is the
+			// witness program of the key it pays to.
+			if script.addrType == lnwallet.NestedWitnessPubKey {
+				walletUtxo.RedeemScript, err = witnessProgram(
+					script.pubKey,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			utxos = append(utxos, walletUtxo)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return utxos, nil
+}
+
+// WatchOnlyDerivation returns the BIP 32 derivation of the key that the
+// passed output script of a watch-only account pays to. Only the derivation
+// of keys of imported accounts is known, so nil is returned for all other
+// scripts.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) WatchOnlyDerivation(pkScript []byte) (
+	*psbt.Bip32Derivation, error) {
+
+	var derivation *psbt.Bip32Derivation
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+
+		script, err := fetchWatchedScript(ns, pkScript)
+		if err == errNoWatchedScript {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if !script.derived {
+			return nil
+		}
+
+		account, err := fetchImportedAccount(ns, script.account)
+		if err != nil {
+			return err
+		}
+
+		derivation = &psbt.Bip32Derivation{
+			PubKey:               script.pubKey,
+			MasterKeyFingerprint: account.masterKeyFingerprint,
+			Bip32Path: []uint32{
+				account.purpose() + hdkeychain.HardenedKeyStart,
+				b.netParams.HDCoinType +
+					hdkeychain.HardenedKeyStart,
+				account.number + hdkeychain.HardenedKeyStart,
+				script.branch,
+				script.index,
+			},
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return derivation, nil
+}
+
+// importedAccounts returns all accounts imported from their extended public
+// keys, along with the ImportedAccountName account if any keys or addresses
+// have been imported.
+func (b *BtcWallet) importedAccounts() ([]*lnwallet.WalletAccount, error) {
+	var accounts []*lnwallet.WalletAccount
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+
+		accountBucket := ns.NestedReadBucket(importAccountsBucket)
+		err := accountBucket.ForEach(func(k, v []byte) error {
+			account, err := deserializeImportedAccount(k, v)
+			if err != nil {
+				return err
+			}
+
+			accounts = append(accounts, account.walletAccount())
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if ns.Get(importHasImportedKeysKey) != nil {
+			accounts = append(accounts, &lnwallet.WalletAccount{
+				Name:      lnwallet.ImportedAccountName,
+				Number:    waddrmgr.ImportedAddrAccount,
+				WatchOnly: true,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// isImportedAccount returns true if the named account was imported from its
+// extended public key.
+func (b *BtcWallet) isImportedAccount(name string) (bool, error) {
+	var found bool
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+
+		_, err := fetchImportedAccount(ns, name)
+		switch {
+		case err == errNoImportedAccount:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		found = true
+		return nil
+	})
+
+	return found, err
+}
+
+// newImportedAddress returns the next external or internal address of the
+// named imported account, dictated by the value of the `change` parameter.
+// The address type must be the one the account was imported with.
+func (b *BtcWallet) newImportedAddress(name string,
+	addrType lnwallet.AddressType, change bool) (btcutil.Address, error) {
+
+	branch := uint32(externalBranch)
+	if change {
+		branch = internalBranch
+	}
+
+	var addr btcutil.Address
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := fetchImportsNamespace(tx)
+		if err != nil {
+			return err
+		}
+
+		account, err := fetchImportedAccount(ns, name)
+		if err != nil {
+			return err
+		}
+		if addrType != account.addrType {
+			return fmt.Errorf("account %q only derives addresses "+
+				"of type %v", name, account.addrType)
+		}
+
+		index := account.nextIndex[branch]
+		pubKey, err := account.derivePubKey(branch, index)
+		if err != nil {
+			return err
+		}
+		addr, err = b.watchOnlyAddress(pubKey, addrType)
+		if err != nil {
+			return err
+		}
+
+		account.nextIndex[branch]++
+		if err := putImportedAccount(ns, account); err != nil {
+			return err
+		}
+
+		return b.extendAccountScripts(ns, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
+// importScripts runs the passed function, which adds scripts to be watched,
+// within a database transaction. If the scripts may have received outputs
+// before the last scanned block, which is the case if the given birthday
+// height is non-zero and not in the future, then the blocks starting at that
+// height are scanned again.
+func (b *BtcWallet) importScripts(birthdayHeight uint32,
+	addScripts func(walletdb.ReadWriteBucket) error) error {
+
+	// The chain must not be scanned while we move the sync point.
+	b.importMtx.Lock()
+	defer b.importMtx.Unlock()
+
+	bestHash, bestHeight, err := b.chain.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	var rescanHash *chainhash.Hash
+	if birthdayHeight != 0 && int32(birthdayHeight) <= bestHeight {
+		rescanHash, err = b.chain.GetBlockHash(
+			int64(birthdayHeight) - 1,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := fetchImportsNamespace(tx)
+		if err != nil {
+			return err
+		}
+
+		if err := addScripts(ns); err != nil {
+			return err
+		}
+
+		// If nothing has been imported so far, then there's no point
+		// in scanning any blocks before the current one.
+		syncedHeight, _, err := fetchSyncedHeight(ns)
+		switch {
+		case err == errNotSynced:
+			syncedHeight = uint32(bestHeight)
+			err := putSyncedHeight(ns, syncedHeight, bestHash)
+			if err != nil {
+				return err
+			}
+
+		case err != nil:
+			return err
+		}
+
+		if rescanHash == nil || birthdayHeight > syncedHeight {
+			return nil
+		}
+
+		// Scanning blocks again doesn't affect the outputs that are
+		// already tracked, so we only need to move the sync point. As
+		// the hashes of the blocks we scanned before are of no use to
+		// detect reorgs of the older blocks, we'll drop them.
+		log.Infof("Rescanning blocks for imported keys starting at "+
+			"height %v", birthdayHeight)
+
+		err = ns.DeleteNestedBucket(importBlockHashesBucket)
+		if err != nil {
+			return err
+		}
+		_, err = ns.CreateBucket(importBlockHashesBucket)
+		if err != nil {
+			return err
+		}
+
+		return putSyncedHeight(ns, birthdayHeight-1, rescanHash)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Wake up the scanner, such that the new scripts are picked up
+	// without waiting for the next block.
+	select {
+	case b.importScanSignal <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// extendAccountScripts derives the scripts of the passed account that are
+// within the gap limit of both branches, and adds them to the set of watched
+// scripts. The scripts of all keys before the next unused one of a branch
+// have been derived before, as its index only advances within the gap limit.
+func (b *BtcWallet) extendAccountScripts(ns walletdb.ReadWriteBucket,
+	account *importedAccount) error {
+
+	scriptBucket := ns.NestedReadWriteBucket(importScriptsBucket)
+	for _, branch := range []uint32{externalBranch, internalBranch} {
+		start := account.nextIndex[branch]
+		end := start + importGapLimit
+		for index := start; index < end; index++ {
+			pubKey, err := account.derivePubKey(branch, index)
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			addr, err := b.watchOnlyAddress(
+				pubKey, account.addrType,
+			)
+			if err != nil {
+				return err
+			}
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return err
+			}
+
+			if scriptBucket.Get(pkScript) != nil {
+				continue
+			}
+
+			err = putWatchedScript(ns, pkScript, &watchedScript{
+				account:  account.name,
+				addrType: account.addrType,
+				pubKey:   pubKey.SerializeCompressed(),
+				derived:  true,
+				branch:   branch,
+				index:    index,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// importScanner periodically scans the blocks connected to the chain for
+// outputs of imported keys, until the wallet is stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BtcWallet) importScanner() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(importScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.syncImports(); err != nil {
+			log.Errorf("Unable to scan blocks for imported "+
+				"keys: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-b.importScanSignal:
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// syncImports scans all blocks after the last scanned one up to the current
+// best block for outputs of imported keys, one block at a time.
+func (b *BtcWallet) syncImports() error {
+	for {
+		select {
+		case <-b.quit:
+			return nil
+		default:
+		}
+
+		done, err := b.syncNextImportBlock()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// syncNextImportBlock scans the block following the last scanned one for
+// outputs of imported keys. If the last scanned block was reorged out, then
+// the outputs it created are dropped instead. True is returned once the
+// current best block has been scanned.
+func (b *BtcWallet) syncNextImportBlock() (bool, error) {
+	b.importMtx.Lock()
+	defer b.importMtx.Unlock()
+
+	var (
+		syncedHeight uint32
+		syncedHash   *chainhash.Hash
+		haveScripts  bool
+	)
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		if ns == nil {
+			return errNotSynced
+		}
+
+		var err error
+		syncedHeight, syncedHash, err = fetchSyncedHeight(ns)
+		if err != nil {
+			return err
+		}
+
+		scriptBucket := ns.NestedReadBucket(importScriptsBucket)
+		k, _ := scriptBucket.ReadCursor().First()
+		haveScripts = k != nil
+		return nil
+	})
+	switch {
+	// Nothing has been imported yet, so there's nothing to scan for.
+	case err == errNotSynced:
+		return true, nil
+
+	case err != nil:
+		return false, err
+	}
+
+	bestHash, bestHeight, err := b.chain.GetBestBlock()
+	if err != nil {
+		return false, err
+	}
+	if int32(syncedHeight) >= bestHeight {
+		return true, nil
+	}
+
+	// If there are no scripts to watch, we can skip ahead to the best
+	// block, as none of the blocks in between can be relevant.
+	if !haveScripts {
+		skipAhead := func(tx walletdb.ReadWriteTx) error {
+			ns, err := fetchImportsNamespace(tx)
+			if err != nil {
+				return err
+			}
+
+			return putSyncedHeight(ns, uint32(bestHeight), bestHash)
+		}
+		return true, walletdb.Update(b.db, skipAhead)
+	}
+
+	height := syncedHeight + 1
+	hash, err := b.chain.GetBlockHash(int64(height))
+	if err != nil {
+		return false, err
+	}
+	block, err := b.chain.GetBlock(hash)
+	if err != nil {
+		return false, err
+	}
+
+	// If the block doesn't build on the last block we scanned, then the
+	// latter has been reorged out, so we'll rewind by a single block, and
+	// retry from there.
+	if block.Header.PrevBlock != *syncedHash {
+		log.Infof("Block %v at height %v scanned for imported keys "+
+			"was reorged out, rewinding", syncedHash, syncedHeight)
+
+		err := b.rewindImports(syncedHeight)
+		return false, err
+	}
+
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := fetchImportsNamespace(tx)
+		if err != nil {
+			return err
+		}
+
+		if err := b.scanImportBlock(ns, height, block); err != nil {
+			return err
+		}
+
+		return putSyncedHeight(ns, height, hash)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return int32(height) >= bestHeight, nil
+}
+
+// rewindImports drops the outputs created, and restores the outputs spent by
+// the scanned block at the passed height, which was reorged out. The block
+// before it becomes the last scanned block.
+func (b *BtcWallet) rewindImports(height uint32) error {
+	if height == 0 {
+		return fmt.Errorf("unable to rewind past the genesis block")
+	}
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := fetchImportsNamespace(tx)
+		if err != nil {
+			return err
+		}
+
+		var (
+			removed  [][]byte
+			restored = make(map[string]*trackedUtxo)
+		)
+		utxoBucket := ns.NestedReadWriteBucket(importUtxosBucket)
+		err = utxoBucket.ForEach(func(k, v []byte) error {
+			utxo, err := deserializeTrackedUtxo(v)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case utxo.height >= height:
+				k = append([]byte(nil), k...)
+				removed = append(removed, k)
+
+			case utxo.spendHeight >= height:
+				utxo.spendHeight = 0
+				restored[string(k)] = utxo
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range removed {
+			if err := utxoBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		for k, utxo := range restored {
+			v := serializeTrackedUtxo(utxo)
+			if err := utxoBucket.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		// We'll continue from the block before the one that was
+		// reorged out as we saw it. If we didn't keep its hash, as it
+		// was scanned before a rescan for new imports, then we'll have
+		// to assume it's still part of the chain.
+		hashBucket := ns.NestedReadWriteBucket(importBlockHashesBucket)
+		if err := hashBucket.Delete(heightKey(height)); err != nil {
+			return err
+		}
+
+		var prevHash *chainhash.Hash
+		if v := hashBucket.Get(heightKey(height - 1)); v != nil {
+			prevHash, err = chainhash.NewHash(v)
+		} else {
+			prevHash, err = b.chain.GetBlockHash(int64(height) - 1)
+		}
+		if err != nil {
+			return err
+		}
+
+		return putSyncedHeight(ns, height-1, prevHash)
+	})
+}
+
+// scanImportBlock records all outputs of the passed block that pay to a
+// watched script, and marks all tracked outputs it spends as spent. Used
+// addresses of imported accounts push out their gap limit. As the scripts
+// within the extended gap may be paid to anywhere within the same block, the
+// block is scanned again until no new scripts are derived.
+func (b *BtcWallet) scanImportBlock(ns walletdb.ReadWriteBucket, height uint32,
+	block *wire.MsgBlock) error {
+
+	for {
+		extended, err := b.scanImportBlockTxns(ns, height, block)
+		if err != nil {
+			return err
+		}
+		if !extended {
+			break
+		}
+
+		log.Debugf("Gap limit of imported account extended at "+
+			"height %v, scanning block again", height)
+	}
+
+	// Keep the hash of the block to detect it being reorged out, and drop
+	// those that are too old to be reorged out.
+	hashBucket := ns.NestedReadWriteBucket(importBlockHashesBucket)
+	blockHash := block.BlockHash()
+	if err := hashBucket.Put(heightKey(height), blockHash[:]); err != nil {
+		return err
+	}
+	if height <= importReorgSafetyLimit {
+		return nil
+	}
+
+	pruneHeight := height - importReorgSafetyLimit
+	if err := hashBucket.Delete(heightKey(pruneHeight)); err != nil {
+		return err
+	}
+
+	// Outputs that were spent deeper than a reorg is expected to reach
+	// are of no use anymore.
+	utxoBucket := ns.NestedReadWriteBucket(importUtxosBucket)
+	var pruned [][]byte
+	err := utxoBucket.ForEach(func(k, v []byte) error {
+		utxo, err := deserializeTrackedUtxo(v)
+		if err != nil {
+			return err
+		}
+
+		if utxo.spendHeight != 0 && utxo.spendHeight <= pruneHeight {
+			pruned = append(pruned, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range pruned {
+		if err := utxoBucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanImportBlockTxns makes a single pass of scanImportBlock over the
+// transactions of the passed block. True is returned if the gap limit of any
+// imported account was extended, deriving new scripts to watch.
+func (b *BtcWallet) scanImportBlockTxns(ns walletdb.ReadWriteBucket,
+	height uint32, block *wire.MsgBlock) (bool, error) {
+
+	var extended bool
+	utxoBucket := ns.NestedReadWriteBucket(importUtxosBucket)
+	for _, tx := range block.Transactions {
+		for _, txIn := range tx.TxIn {
+			k := outPointKey(&txIn.PreviousOutPoint)
+			v := utxoBucket.Get(k)
+			if v == nil {
+				continue
+			}
+
+			utxo, err := deserializeTrackedUtxo(v)
+			if err != nil {
+				return false, err
+			}
+
+			log.Debugf("Imported output %v spent at height %v",
+				txIn.PreviousOutPoint, height)
+
+			utxo.spendHeight = height
+			err = utxoBucket.Put(k, serializeTrackedUtxo(utxo))
+			if err != nil {
+				return false, err
+			}
+		}
+
+		txHash := tx.TxHash()
+		for i, txOut := range tx.TxOut {
+			script, err := fetchWatchedScript(ns, txOut.PkScript)
+			if err == errNoWatchedScript {
+				continue
+			} else if err != nil {
+				return false, err
+			}
+
+			outPoint := wire.OutPoint{
+				Hash:  txHash,
+				Index: uint32(i),
+			}
+			k := outPointKey(&outPoint)
+
+			// If the block is scanned again, then the output may
+			// already be known, and even spent.
+			utxo := &trackedUtxo{
+				value:    txOut.Value,
+				pkScript: txOut.PkScript,
+				height:   height,
+			}
+			if v := utxoBucket.Get(k); v != nil {
+				known, err := deserializeTrackedUtxo(v)
+				if err != nil {
+					return false, err
+				}
+				utxo.spendHeight = known.spendHeight
+			} else {
+				log.Infof("Found output %v of %v paying to "+
+					"imported account %q at height %v",
+					outPoint, btcutil.Amount(txOut.Value),
+					script.account, height)
+			}
+
+			err = utxoBucket.Put(k, serializeTrackedUtxo(utxo))
+			if err != nil {
+				return false, err
+			}
+
+			if !script.derived {
+				continue
+			}
+
+			account, err := fetchImportedAccount(ns, script.account)
+			if err != nil {
+				return false, err
+			}
+			if script.index < account.nextIndex[script.branch] {
+				continue
+			}
+
+			account.nextIndex[script.branch] = script.index + 1
+			if err := putImportedAccount(ns, account); err != nil {
+				return false, err
+			}
+			err = b.extendAccountScripts(ns, account)
+			if err != nil {
+				return false, err
+			}
+			extended = true
+		}
+	}
+
+	return extended, nil
+}
+
+// watchOnlyAddress returns the address of the given type that pays to the
+// passed public key.
+func (b *BtcWallet) watchOnlyAddress(pubKey *btcec.PublicKey,
+	addrType lnwallet.AddressType) (btcutil.Address, error) {
+
+	pubKeyHash := btcutil.Hash160(pubKey.SerializeCompressed())
+	p2wkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		pubKeyHash, b.netParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch addrType {
+	case lnwallet.WitnessPubKey:
+		return p2wkhAddr, nil
+
+	case lnwallet.NestedWitnessPubKey:
+		witnessProgram, err := txscript.PayToAddrScript(p2wkhAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return btcutil.NewAddressScriptHash(witnessProgram, b.netParams)
+
+	default:
+		return nil, fmt.Errorf("unknown address type")
+	}
+}
+
+// witnessProgram returns the P2WKH witness program of the passed serialized
+// public key.
+func witnessProgram(pubKey []byte) ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(pubKey)).
+		Script()
+}
+
+// checkImportAddrType ensures that keys can be imported for the passed address
+// type.
+func checkImportAddrType(addrType lnwallet.AddressType) error {
+	switch addrType {
+	case lnwallet.WitnessPubKey, lnwallet.NestedWitnessPubKey:
+		return nil
+
+	default:
+		return fmt.Errorf("address type %v not supported for imports",
+			addrType)
+	}
+}
+
+// extendedKeyIndex returns the index the passed extended key was derived with
+// from its parent, which hdkeychain doesn't expose. It's serialized after the
+// four byte version, the depth and the four byte parent fingerprint.
+func extendedKeyIndex(key *hdkeychain.ExtendedKey) uint32 {
+	serialized := base58.Decode(key.String())
+	return binary.BigEndian.Uint32(serialized[9:13])
+}
+
+// purpose returns the BIP 43 purpose of the addresses of the account, which
+// is the first element of the derivation path of its keys.
+func (a *importedAccount) purpose() uint32 {
+	if a.addrType == lnwallet.NestedWitnessPubKey {
+		return waddrmgr.KeyScopeBIP0049Plus.Purpose
+	}
+
+	return waddrmgr.KeyScopeBIP0084.Purpose
+}
+
+// derivePubKey derives the public key of the account at the given branch and
+// index.
+func (a *importedAccount) derivePubKey(branch,
+	index uint32) (*btcec.PublicKey, error) {
+
+	branchKey, err := a.pubKey.Child(branch)
+	if err != nil {
+		return nil, err
+	}
+	key, err := branchKey.Child(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.ECPubKey()
+}
+
+// walletAccount returns the WalletAccount describing the imported account.
+func (a *importedAccount) walletAccount() *lnwallet.WalletAccount {
+	return &lnwallet.WalletAccount{
+		Name:        a.name,
+		Number:      a.number,
+		WatchOnly:   true,
+		AddressType: a.addrType,
+	}
+}
+
+// fetchImportsNamespace returns the namespace of the imports, creating it
+// along with its buckets if it doesn't exist yet.
+func fetchImportsNamespace(
+	tx walletdb.ReadWriteTx) (walletdb.ReadWriteBucket, error) {
+
+	// The namespace can't be created if it already exists.
+	ns := tx.ReadWriteBucket(importsNamespaceKey)
+	if ns == nil {
+		var err error
+		ns, err = tx.CreateTopLevelBucket(importsNamespaceKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	buckets := [][]byte{
+		importAccountsBucket, importScriptsBucket, importUtxosBucket,
+		importBlockHashesBucket,
+	}
+	for _, bucket := range buckets {
+		if _, err := ns.CreateBucketIfNotExists(bucket); err != nil {
+			return nil, err
+		}
+	}
+
+	return ns, nil
+}
+
+// heightKey returns the database key of the block at the given height.
+func heightKey(height uint32) []byte {
+	var k [4]byte
+	binary.BigEndian.PutUint32(k[:], height)
+	return k[:]
+}
+
+// outPointKey returns the database key of the passed outpoint.
+func outPointKey(outPoint *wire.OutPoint) []byte {
+	k := make([]byte, chainhash.HashSize+4)
+	copy(k, outPoint.Hash[:])
+	binary.BigEndian.PutUint32(k[chainhash.HashSize:], outPoint.Index)
+	return k
+}
+
+// fetchSyncedHeight returns the height and hash of the last scanned block.
+func fetchSyncedHeight(ns walletdb.ReadBucket) (uint32, *chainhash.Hash,
+	error) {
+
+	v := ns.Get(importSyncedHeightKey)
+	if v == nil {
+		return 0, nil, errNotSynced
+	}
+	if len(v) != 4+chainhash.HashSize {
+		return 0, nil, fmt.Errorf("invalid synced height")
+	}
+
+	hash, err := chainhash.NewHash(v[4:])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return binary.BigEndian.Uint32(v[:4]), hash, nil
+}
+
+// putSyncedHeight stores the height and hash of the last scanned block.
+func putSyncedHeight(ns walletdb.ReadWriteBucket, height uint32,
+	hash *chainhash.Hash) error {
+
+	v := make([]byte, 4+chainhash.HashSize)
+	binary.BigEndian.PutUint32(v[:4], height)
+	copy(v[4:], hash[:])
+
+	return ns.Put(importSyncedHeightKey, v)
+}
+
+// fetchImportedAccount looks up the imported account with the given name.
+func fetchImportedAccount(ns walletdb.ReadBucket,
+	name string) (*importedAccount, error) {
+
+	accountBucket := ns.NestedReadBucket(importAccountsBucket)
+	v := accountBucket.Get([]byte(name))
+	if v == nil {
+		return nil, errNoImportedAccount
+	}
+
+	return deserializeImportedAccount([]byte(name), v)
+}
+
+// putImportedAccount stores the passed imported account.
+func putImportedAccount(ns walletdb.ReadWriteBucket,
+	account *importedAccount) error {
+
+	var b bytes.Buffer
+	err := wire.WriteVarString(&b, 0, account.pubKey.String())
+	if err != nil {
+		return err
+	}
+
+	var scratch [13]byte
+	binary.BigEndian.PutUint32(scratch[0:4], account.masterKeyFingerprint)
+	scratch[4] = byte(account.addrType)
+	binary.BigEndian.PutUint32(scratch[5:9], account.nextIndex[0])
+	binary.BigEndian.PutUint32(scratch[9:13], account.nextIndex[1])
+	if _, err := b.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	accountBucket := ns.NestedReadWriteBucket(importAccountsBucket)
+	return accountBucket.Put([]byte(account.name), b.Bytes())
+}
+
+// deserializeImportedAccount decodes the imported account stored under the
+// given name.
+func deserializeImportedAccount(name, v []byte) (*importedAccount, error) {
+	r := bytes.NewReader(v)
+
+	encodedKey, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := hdkeychain.NewKeyFromString(encodedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var scratch [13]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+
+	return &importedAccount{
+		name:                 string(name),
+		pubKey:               pubKey,
+		masterKeyFingerprint: binary.BigEndian.Uint32(scratch[0:4]),
+		addrType:             lnwallet.AddressType(scratch[4]),
+		number: extendedKeyIndex(pubKey) -
+			hdkeychain.HardenedKeyStart,
+		nextIndex: [2]uint32{
+			binary.BigEndian.Uint32(scratch[5:9]),
+			binary.BigEndian.Uint32(scratch[9:13]),
+		},
+	}, nil
+}
+
+// fetchWatchedScript looks up the passed output script within the set of
+// watched scripts.
+func fetchWatchedScript(ns walletdb.ReadBucket,
+	pkScript []byte) (*watchedScript, error) {
+
+	scriptBucket := ns.NestedReadBucket(importScriptsBucket)
+	v := scriptBucket.Get(pkScript)
+	if v == nil {
+		return nil, errNoWatchedScript
+	}
+
+	r := bytes.NewReader(v)
+
+	account, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := wire.ReadVarBytes(r, 0, 33, "pubkey")
+	if err != nil {
+		return nil, err
+	}
+
+	var scratch [10]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+
+	return &watchedScript{
+		account:  account,
+		addrType: lnwallet.AddressType(scratch[0]),
+		pubKey:   pubKey,
+		derived:  scratch[1] == 1,
+		branch:   binary.BigEndian.Uint32(scratch[2:6]),
+		index:    binary.BigEndian.Uint32(scratch[6:10]),
+	}, nil
+}
+
+// putWatchedScript adds the passed output script to the set of watched
+// scripts.
+func putWatchedScript(ns walletdb.ReadWriteBucket, pkScript []byte,
+	script *watchedScript) error {
+
+	var b bytes.Buffer
+	if err := wire.WriteVarString(&b, 0, script.account); err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(&b, 0, script.pubKey); err != nil {
+		return err
+	}
+
+	var scratch [10]byte
+	scratch[0] = byte(script.addrType)
+	if script.derived {
+		scratch[1] = 1
+	}
+	binary.BigEndian.PutUint32(scratch[2:6], script.branch)
+	binary.BigEndian.PutUint32(scratch[6:10], script.index)
+	if _, err := b.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	scriptBucket := ns.NestedReadWriteBucket(importScriptsBucket)
+	return scriptBucket.Put(pkScript, b.Bytes())
+}
+
+// serializeTrackedUtxo encodes the passed tracked output.
+func serializeTrackedUtxo(utxo *trackedUtxo) []byte {
+	var b bytes.Buffer
+
+	var scratch [8]byte
+	binary.BigEndian.PutUint64(scratch[:], uint64(utxo.value))
+	b.Write(scratch[:])
+
+	wire.WriteVarBytes(&b, 0, utxo.pkScript)
+
+	binary.BigEndian.PutUint32(scratch[:4], utxo.height)
+	binary.BigEndian.PutUint32(scratch[4:], utxo.spendHeight)
+	b.Write(scratch[:])
+
+	return b.Bytes()
+}
+
+// deserializeTrackedUtxo decodes a tracked output.
+func deserializeTrackedUtxo(v []byte) (*trackedUtxo, error) {
+	r := bytes.NewReader(v)
+
+	var scratch [8]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+	value := int64(binary.BigEndian.Uint64(scratch[:]))
+
+	pkScript, err := wire.ReadVarBytes(
+		r, 0, txscript.MaxScriptSize, "pkscript",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+
+	return &trackedUtxo{
+		value:       value,
+		pkScript:    pkScript,
+		height:      binary.BigEndian.Uint32(scratch[:4]),
+		spendHeight: binary.BigEndian.Uint32(scratch[4:]),
+	}, nil
+}
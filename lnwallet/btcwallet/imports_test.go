@@ -0,0 +1,466 @@
+package btcwallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/walletdb"
+	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
+	"github.com/wakiyamap/lnd/lnwallet"
+)
+
+// mockImportChain is a chain backend whose blocks can be replaced to simulate
+// reorgs. Only the methods used to scan for imported keys are implemented.
+type mockImportChain struct {
+	chain.Interface
+
+	blocks []*wire.MsgBlock
+	nonce  uint32
+}
+
+func (m *mockImportChain) GetBestBlock() (*chainhash.Hash, int32, error) {
+	hash := m.blocks[len(m.blocks)-1].BlockHash()
+	return &hash, int32(len(m.blocks) - 1), nil
+}
+
+func (m *mockImportChain) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	if height < 0 || height >= int64(len(m.blocks)) {
+		return nil, fmt.Errorf("no block at height %v", height)
+	}
+
+	hash := m.blocks[height].BlockHash()
+	return &hash, nil
+}
+
+func (m *mockImportChain) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	for _, block := range m.blocks {
+		if block.BlockHash() == *hash {
+			return block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("block %v not found", hash)
+}
+
+// addBlock connects a new block with the passed transactions to the chain.
+func (m *mockImportChain) addBlock(txns ...*wire.MsgTx) *wire.MsgBlock {
+	m.nonce++
+
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Nonce: m.nonce,
+		},
+		Transactions: txns,
+	}
+	if len(m.blocks) > 0 {
+		block.Header.PrevBlock = m.blocks[len(m.blocks)-1].BlockHash()
+	}
+	m.blocks = append(m.blocks, block)
+
+	return block
+}
+
+// newTestImportWallet creates a wallet scanning the blocks of a mock chain
+// for outputs of imported keys, along with an account imported into it.
+func newTestImportWallet(t *testing.T) (*BtcWallet, *mockImportChain,
+	*importedAccount, func()) {
+
+	tempDir, err := ioutil.TempDir("", "imports")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	db, err := walletdb.Create("bdb", filepath.Join(tempDir, "wallet.db"))
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create db: %v", err)
+	}
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+
+	chainBackend := &mockImportChain{}
+	chainBackend.addBlock()
+
+	w := &BtcWallet{
+		chain:            chainBackend,
+		db:               db,
+		netParams:        &chaincfg.RegressionNetParams,
+		importScanSignal: make(chan struct{}, 1),
+		quit:             make(chan struct{}),
+	}
+
+	// Derive the account-level key of the first BIP 84 account, from
+	// which we'll import the account.
+	var seed [hdkeychain.RecommendedSeedLen]byte
+	seed[0] = 1
+	key, err := hdkeychain.NewMaster(seed[:], w.netParams)
+	if err != nil {
+		cleanUp()
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	path := []uint32{
+		84 + hdkeychain.HardenedKeyStart,
+		w.netParams.HDCoinType + hdkeychain.HardenedKeyStart,
+		hdkeychain.HardenedKeyStart,
+	}
+	for _, index := range path {
+		key, err = key.Child(index)
+		if err != nil {
+			cleanUp()
+			t.Fatalf("unable to derive key: %v", err)
+		}
+	}
+	accountKey, err := key.Neuter()
+	if err != nil {
+		cleanUp()
+		t.Fatalf("unable to neuter key: %v", err)
+	}
+
+	account := &importedAccount{
+		name:     "test",
+		pubKey:   accountKey,
+		addrType: lnwallet.WitnessPubKey,
+	}
+	addScripts := func(ns walletdb.ReadWriteBucket) error {
+		if err := putImportedAccount(ns, account); err != nil {
+			return err
+		}
+
+		return w.extendAccountScripts(ns, account)
+	}
+	if err := w.importScripts(0, addScripts); err != nil {
+		cleanUp()
+		t.Fatalf("unable to import account: %v", err)
+	}
+
+	return w, chainBackend, account, cleanUp
+}
+
+// accountScript returns the output script of the account's address at the
+// given branch and index.
+func accountScript(t *testing.T, w *BtcWallet, account *importedAccount,
+	branch, index uint32) []byte {
+
+	t.Helper()
+
+	pubKey, err := account.derivePubKey(branch, index)
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	addr, err := w.watchOnlyAddress(pubKey, account.addrType)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	return pkScript
+}
+
+// testTx creates a transaction spending the passed outpoints, or an unrelated
+// one if none are passed, with an output paying to each of the passed
+// scripts.
+func testTx(prevOuts []wire.OutPoint, pkScripts ...[]byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	if len(prevOuts) == 0 {
+		prevOuts = []wire.OutPoint{{Index: uint32(len(pkScripts))}}
+	}
+	for _, prevOut := range prevOuts {
+		tx.AddTxIn(wire.NewTxIn(&prevOut, nil, nil))
+	}
+	for _, pkScript := range pkScripts {
+		tx.AddTxOut(wire.NewTxOut(10000, pkScript))
+	}
+
+	return tx
+}
+
+// fetchTrackedUtxo returns the tracked output of an imported key at the
+// passed outpoint, or nil if it isn't tracked.
+func fetchTrackedUtxo(t *testing.T, w *BtcWallet,
+	outPoint wire.OutPoint) *trackedUtxo {
+
+	t.Helper()
+
+	var utxo *trackedUtxo
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		utxoBucket := ns.NestedReadBucket(importUtxosBucket)
+
+		v := utxoBucket.Get(outPointKey(&outPoint))
+		if v == nil {
+			return nil
+		}
+
+		var err error
+		utxo, err = deserializeTrackedUtxo(v)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to fetch tracked output: %v", err)
+	}
+
+	return utxo
+}
+
+// assertTrackedUtxo asserts that the output at the passed outpoint is tracked
+// with the given confirmation and spend height.
+func assertTrackedUtxo(t *testing.T, w *BtcWallet, outPoint wire.OutPoint,
+	height, spendHeight uint32) {
+
+	t.Helper()
+
+	utxo := fetchTrackedUtxo(t, w, outPoint)
+	switch {
+	case utxo == nil:
+		t.Fatalf("output %v not tracked", outPoint)
+
+	case utxo.height != height:
+		t.Fatalf("expected output %v at height %v, got %v", outPoint,
+			height, utxo.height)
+
+	case utxo.spendHeight != spendHeight:
+		t.Fatalf("expected output %v spent at height %v, got %v",
+			outPoint, spendHeight, utxo.spendHeight)
+	}
+}
+
+// assertSyncedHeight asserts that the block at the passed height of the chain
+// is the last scanned one.
+func assertSyncedHeight(t *testing.T, w *BtcWallet, chain *mockImportChain,
+	height uint32) {
+
+	t.Helper()
+
+	var (
+		syncedHeight uint32
+		syncedHash   *chainhash.Hash
+	)
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		syncedHeight, syncedHash, err = fetchSyncedHeight(
+			tx.ReadBucket(importsNamespaceKey),
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to fetch synced height: %v", err)
+	}
+
+	if syncedHeight != height {
+		t.Fatalf("expected synced height %v, got %v", height,
+			syncedHeight)
+	}
+	if *syncedHash != chain.blocks[height].BlockHash() {
+		t.Fatalf("expected synced block %v, got %v",
+			chain.blocks[height].BlockHash(), syncedHash)
+	}
+}
+
+// TestScanImportBlock tests that the outputs paying to the scripts of an
+// imported account are tracked once scanned, while other outputs are ignored.
+func TestScanImportBlock(t *testing.T) {
+	t.Parallel()
+
+	w, chain, account, cleanUp := newTestImportWallet(t)
+	defer cleanUp()
+
+	tx := testTx(
+		nil, accountScript(t, w, account, externalBranch, 0),
+		[]byte{txscript.OP_TRUE},
+		accountScript(t, w, account, internalBranch, 3),
+	)
+	chain.addBlock(tx)
+
+	if err := w.syncImports(); err != nil {
+		t.Fatalf("unable to sync imports: %v", err)
+	}
+	assertSyncedHeight(t, w, chain, 1)
+
+	txHash := tx.TxHash()
+	assertTrackedUtxo(t, w, wire.OutPoint{Hash: txHash, Index: 0}, 1, 0)
+	assertTrackedUtxo(t, w, wire.OutPoint{Hash: txHash, Index: 2}, 1, 0)
+	if fetchTrackedUtxo(t, w, wire.OutPoint{Hash: txHash, Index: 1}) != nil {
+		t.Fatalf("unrelated output tracked")
+	}
+
+	// Scanning the block again must leave the outputs as they are.
+	err := walletdb.Update(w.db, func(dbTx walletdb.ReadWriteTx) error {
+		ns, err := fetchImportsNamespace(dbTx)
+		if err != nil {
+			return err
+		}
+
+		return w.scanImportBlock(ns, 1, chain.blocks[1])
+	})
+	if err != nil {
+		t.Fatalf("unable to scan block: %v", err)
+	}
+	assertTrackedUtxo(t, w, wire.OutPoint{Hash: txHash, Index: 0}, 1, 0)
+}
+
+// TestScanImportBlockSpend tests that tracked outputs are marked as spent by
+// the block spending them, including those created within the same block.
+func TestScanImportBlockSpend(t *testing.T) {
+	t.Parallel()
+
+	w, chain, account, cleanUp := newTestImportWallet(t)
+	defer cleanUp()
+
+	fundingTx := testTx(
+		nil, accountScript(t, w, account, externalBranch, 0),
+	)
+	chain.addBlock(fundingTx)
+	fundingOut := wire.OutPoint{Hash: fundingTx.TxHash()}
+
+	// The next block spends the output of the first block, creating an
+	// output that is spent within the same block.
+	spendTx := testTx(
+		[]wire.OutPoint{fundingOut},
+		accountScript(t, w, account, internalBranch, 0),
+	)
+	spendOut := wire.OutPoint{Hash: spendTx.TxHash()}
+	sweepTx := testTx(
+		[]wire.OutPoint{spendOut},
+		accountScript(t, w, account, internalBranch, 1),
+	)
+	sweepOut := wire.OutPoint{Hash: sweepTx.TxHash()}
+	chain.addBlock(spendTx, sweepTx)
+
+	if err := w.syncImports(); err != nil {
+		t.Fatalf("unable to sync imports: %v", err)
+	}
+	assertSyncedHeight(t, w, chain, 2)
+
+	assertTrackedUtxo(t, w, fundingOut, 1, 2)
+	assertTrackedUtxo(t, w, spendOut, 2, 2)
+	assertTrackedUtxo(t, w, sweepOut, 2, 0)
+}
+
+// TestImportReorgRewind tests that the outputs created by a block that is
+// reorged out are dropped, while those it spent are unspent again, before the
+// blocks of the new chain are scanned.
+func TestImportReorgRewind(t *testing.T) {
+	t.Parallel()
+
+	w, chain, account, cleanUp := newTestImportWallet(t)
+	defer cleanUp()
+
+	fundingTx := testTx(
+		nil, accountScript(t, w, account, externalBranch, 0),
+	)
+	chain.addBlock(fundingTx)
+	fundingOut := wire.OutPoint{Hash: fundingTx.TxHash()}
+
+	spendTx := testTx(
+		[]wire.OutPoint{fundingOut},
+		accountScript(t, w, account, internalBranch, 0),
+	)
+	chain.addBlock(spendTx)
+	spendOut := wire.OutPoint{Hash: spendTx.TxHash()}
+
+	if err := w.syncImports(); err != nil {
+		t.Fatalf("unable to sync imports: %v", err)
+	}
+	assertTrackedUtxo(t, w, fundingOut, 1, 2)
+	assertTrackedUtxo(t, w, spendOut, 2, 0)
+
+	// Reorg out the second block, replacing it with a longer chain whose
+	// first block pays to another address of the account.
+	chain.blocks = chain.blocks[:2]
+	otherTx := testTx(
+		nil, accountScript(t, w, account, externalBranch, 1),
+	)
+	chain.addBlock(otherTx)
+	chain.addBlock()
+	otherOut := wire.OutPoint{Hash: otherTx.TxHash()}
+
+	if err := w.syncImports(); err != nil {
+		t.Fatalf("unable to sync imports: %v", err)
+	}
+	assertSyncedHeight(t, w, chain, 3)
+
+	assertTrackedUtxo(t, w, fundingOut, 1, 0)
+	assertTrackedUtxo(t, w, otherOut, 2, 0)
+	if fetchTrackedUtxo(t, w, spendOut) != nil {
+		t.Fatalf("output of reorged out block still tracked")
+	}
+}
+
+// TestScanImportBlockGapLimit tests that an output paying to an address beyond
+// the gap limit of an imported account is found if a used address pushes out
+// the gap limit far enough, even if it precedes the latter within the block.
+func TestScanImportBlockGapLimit(t *testing.T) {
+	t.Parallel()
+
+	w, chain, account, cleanUp := newTestImportWallet(t)
+	defer cleanUp()
+
+	// The first transaction pays to an address just beyond the gap limit,
+	// which is only watched once the second transaction is seen paying to
+	// the last address within the gap limit. The address paid to last is
+	// beyond even the extended gap limit.
+	beyondTx := testTx(
+		nil, accountScript(
+			t, w, account, externalBranch, importGapLimit+5,
+		),
+	)
+	lastTx := testTx(
+		nil, accountScript(
+			t, w, account, externalBranch, importGapLimit-1,
+		),
+		accountScript(t, w, account, externalBranch, 3*importGapLimit),
+	)
+	chain.addBlock(beyondTx, lastTx)
+
+	if err := w.syncImports(); err != nil {
+		t.Fatalf("unable to sync imports: %v", err)
+	}
+
+	beyondOut := wire.OutPoint{Hash: beyondTx.TxHash()}
+	lastOut := wire.OutPoint{Hash: lastTx.TxHash()}
+	farOut := wire.OutPoint{Hash: lastTx.TxHash(), Index: 1}
+	assertTrackedUtxo(t, w, beyondOut, 1, 0)
+	assertTrackedUtxo(t, w, lastOut, 1, 0)
+	if fetchTrackedUtxo(t, w, farOut) != nil {
+		t.Fatalf("output beyond the gap limit tracked")
+	}
+
+	// The next unused address of the account must follow the one paid to
+	// by the first transaction.
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(importsNamespaceKey)
+		account, err := fetchImportedAccount(ns, account.name)
+		if err != nil {
+			return err
+		}
+
+		nextIndex := account.nextIndex[externalBranch]
+		if nextIndex != importGapLimit+6 {
+			return fmt.Errorf("expected next index %v, got %v",
+				importGapLimit+6, nextIndex)
+		}
+		if account.nextIndex[internalBranch] != 0 {
+			return fmt.Errorf("internal branch advanced")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
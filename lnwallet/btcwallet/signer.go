@@ -61,6 +61,23 @@ func (b *BtcWallet) FetchInputInfo(prevOut *wire.OutPoint) (*wire.TxOut, error)
 	return output, nil
 }
 
+// FetchTx queries for the WalletController's knowledge of the transaction
+// with the passed txid. If the transaction is relevant to the base wallet, then
+// the full transaction is returned. Otherwise, a non-nil error value of
+// ErrNotMine is returned instead.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) FetchTx(txid chainhash.Hash) (*wire.MsgTx, error) {
+	txDetail, err := base.UnstableAPI(b.wallet).TxDetails(&txid)
+	if err != nil {
+		return nil, err
+	} else if txDetail == nil {
+		return nil, lnwallet.ErrNotMine
+	}
+
+	return &txDetail.TxRecord.MsgTx, nil
+}
+
 // fetchOutputAddr attempts to fetch the managed address corresponding to the
 // passed output script. This function is used to look up the proper key which
 // should be used to sign a specified input.
@@ -0,0 +1,154 @@
+package btcwallet
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+)
+
+const (
+	// baseWalletDbName is the name of the database file the btcwallet
+	// loader expects the wallet within the network directory at.
+	baseWalletDbName = "wallet.db"
+)
+
+var (
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+)
+
+// IsWatchOnly returns true if the wallet only holds the public keys of its
+// accounts.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) IsWatchOnly() bool {
+	return b.wallet.Manager.WatchOnly()
+}
+
+// ExportWatchOnly returns a serialized copy of the wallet database from which
+// all private key material has been removed, leaving only the extended public
+// keys of the accounts. The public data of the copy is encrypted with the
+// default public passphrase, such that it can be opened without knowing the
+// passphrase of this wallet.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ExportWatchOnly() ([]byte, error) {
+	tempDir, err := ioutil.TempDir("", "lnwallet-watchonly")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	dbPath := filepath.Join(tempDir, baseWalletDbName)
+	db, err := walletdb.Create("bdb", dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The wallet is copied, and its private keys are removed, within a
+	// single transaction. This ensures the private keys are never written
+	// to the copy, as bolt doesn't wipe the pages it frees.
+	err = walletdb.View(b.db, func(srcTx walletdb.ReadTx) error {
+		return walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+			return b.copyWatchOnly(srcTx, tx)
+		})
+	})
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadFile(dbPath)
+}
+
+// copyWatchOnly copies the address and transaction manager namespaces of the
+// wallet into the passed transaction, and then converts the copied address
+// manager into a watch-only one.
+func (b *BtcWallet) copyWatchOnly(srcTx walletdb.ReadTx,
+	tx walletdb.ReadWriteTx) error {
+
+	for _, ns := range [][]byte{waddrmgrNamespaceKey, wtxmgrNamespaceKey} {
+		src := srcTx.ReadBucket(ns)
+		if src == nil {
+			return fmt.Errorf("wallet namespace %s not found", ns)
+		}
+
+		dst, err := tx.CreateTopLevelBucket(ns)
+		if err != nil {
+			return err
+		}
+		if err := copyBucket(dst, src); err != nil {
+			return err
+		}
+	}
+
+	pubPass := b.cfg.PublicPass
+	if pubPass == nil {
+		pubPass = defaultPubPassphrase
+	}
+
+	addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+	addrMgr, err := waddrmgr.Open(addrmgrNs, pubPass, b.netParams)
+	if err != nil {
+		return err
+	}
+	defer addrMgr.Close()
+
+	if !bytes.Equal(pubPass, defaultPubPassphrase) {
+		err := addrMgr.ChangePassphrase(
+			addrmgrNs, pubPass, defaultPubPassphrase, false,
+			&waddrmgr.DefaultScryptOptions,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return addrMgr.ConvertToWatchingOnly(addrmgrNs)
+}
+
+// copyBucket recursively copies all keys and nested buckets of the source
+// bucket into the destination bucket.
+func copyBucket(dst walletdb.ReadWriteBucket, src walletdb.ReadBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		// Nested buckets are reported with a nil value.
+		if v != nil {
+			return dst.Put(k, v)
+		}
+
+		nestedDst, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+
+		return copyBucket(nestedDst, src.NestedReadBucket(k))
+	})
+}
+
+// createWatchOnlyWallet writes the watch-only copy of a wallet returned by the
+// passed function to the location the btcwallet loader expects the wallet
+// database within the network directory at.
+func createWatchOnlyWallet(netDir string,
+	fetchWallet func() ([]byte, error)) error {
+
+	walletDB, err := fetchWallet()
+	if err != nil {
+		return fmt.Errorf("unable to fetch watch-only wallet: %v", err)
+	}
+
+	if err := os.MkdirAll(netDir, 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(
+		filepath.Join(netDir, baseWalletDbName), walletDB, 0600,
+	)
+}
@@ -522,6 +522,13 @@ type commitment struct {
 	// view.
 	outgoingHTLCIndex map[int32]*PaymentDescriptor
 	incomingHTLCIndex map[int32]*PaymentDescriptor
+
+	// spliceCommits holds the variant of this commitment spending the
+	// funding output created by each pending splice of the channel, keyed
+	// by the txid of its splice transaction. Each variant carries the same
+	// HTLCs, while the balances of both parties are adjusted by their
+	// contributions to the splice.
+	spliceCommits map[chainhash.Hash]*commitment
 }
 
 // locateOutputIndex is a small helper function to locate the output index of a
@@ -1651,6 +1658,15 @@ func (lc *LightningChannel) restoreCommitState(
 	}
 	lc.remoteCommitChain.addCommitment(remoteCommit)
 
+	// If the channel has pending splices, we'll also restore the variants
+	// of both commitments spending their funding outputs.
+	err = lc.restoreSpliceCommits(
+		localCommit, remoteCommit, lc.channelState.PendingSplices(),
+	)
+	if err != nil {
+		return err
+	}
+
 	walletLog.Debugf("ChannelPoint(%v), starting remote commitment: %v",
 		lc.channelState.FundingOutpoint, newLogClosure(func() string {
 			return spew.Sdump(lc.remoteCommitChain.tail())
@@ -1684,6 +1700,15 @@ func (lc *LightningChannel) restoreCommitState(
 		if err != nil {
 			return err
 		}
+		for _, spliceDiff := range pendingRemoteCommitDiff.SpliceDiffs {
+			err := lc.attachSpliceCommit(
+				pendingRemoteCommit, spliceDiff.SpliceTxid,
+				&spliceDiff.Commitment, nil, pendingCommitPoint,
+			)
+			if err != nil {
+				return err
+			}
+		}
 		lc.remoteCommitChain.addCommitment(pendingRemoteCommit)
 
 		walletLog.Debugf("ChannelPoint(%v), pending remote "+
@@ -2330,11 +2355,29 @@ func (lc *LightningChannel) fetchCommitmentView(remoteChain bool,
 		return nil, err
 	}
 
+	// As long as the channel has pending splices, any of their splice
+	// transactions may confirm, so we'll also create the variant of the
+	// commitment spending the funding output of each of them.
+	for _, splice := range lc.channelState.PendingSplices() {
+		spliceCommit, err := lc.newSpliceCommitment(
+			c, splice, filteredHTLCView, keyRing,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if c.spliceCommits == nil {
+			c.spliceCommits = make(map[chainhash.Hash]*commitment)
+		}
+		c.spliceCommits[splice.SpliceTx.TxHash()] = spliceCommit
+	}
+
 	return c, nil
 }
 
 func (lc *LightningChannel) fundingTxIn() wire.TxIn {
-	return *wire.NewTxIn(&lc.channelState.FundingOutpoint, nil, nil)
+	fundingOutpoint := lc.channelState.CurrentFundingOutpoint()
+	return *wire.NewTxIn(&fundingOutpoint, nil, nil)
 }
 
 // createCommitmentTx generates the unsigned commitment transaction for a
@@ -2394,6 +2437,30 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 		theirBalance -= commitFeeMSat
 	}
 
+	commitTx, err := lc.assembleCommitTx(
+		c, lc.fundingTxIn(), lc.channelState.Capacity, ourBalance,
+		theirBalance, filteredHTLCView, keyRing,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.txn = commitTx
+	c.fee = commitFee
+	c.ourBalance = ourBalance
+	c.theirBalance = theirBalance
+	return nil
+}
+
+// assembleCommitTx creates the commitment transaction of the passed commitment
+// view, which spends the passed funding input of the given capacity. The
+// passed balances are already net of the commitment fee.
+func (lc *LightningChannel) assembleCommitTx(c *commitment,
+	fundingTxIn wire.TxIn, capacity btcutil.Amount,
+	ourBalance, theirBalance lnwire.MilliSatoshi,
+	filteredHTLCView *htlcView,
+	keyRing *CommitmentKeyRing) (*wire.MsgTx, error) {
+
 	var (
 		delay                      uint32
 		delayBalance, p2wkhBalance btcutil.Amount
@@ -2410,10 +2477,10 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 
 	// Generate a new commitment transaction with all the latest
 	// unsettled/un-timed out HTLCs.
-	commitTx, err := CreateCommitTx(lc.fundingTxIn(), keyRing, delay,
+	commitTx, err := CreateCommitTx(fundingTxIn, keyRing, delay,
 		delayBalance, p2wkhBalance, c.dustLimit)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// We'll now add all the HTLC outputs to the commitment transaction.
@@ -2428,7 +2495,7 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 
 		err := lc.addHTLC(commitTx, c.isOurs, false, htlc, keyRing)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	for _, htlc := range filteredHTLCView.theirUpdates {
@@ -2439,7 +2506,7 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 
 		err := lc.addHTLC(commitTx, c.isOurs, true, htlc, keyRing)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -2448,7 +2515,7 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	// uncooperative broadcast.
 	err = SetStateNumHint(commitTx, c.height, lc.stateHintObfuscator)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Sort the transactions according to the agreed upon canonical
@@ -2460,7 +2527,7 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	// transaction which would be invalid by consensus.
 	uTx := btcutil.NewTx(commitTx)
 	if err := blockchain.CheckTransactionSanity(uTx); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Finally, we'll assert that were not attempting to draw more out of
@@ -2469,18 +2536,14 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	for _, txOut := range commitTx.TxOut {
 		totalOut += btcutil.Amount(txOut.Value)
 	}
-	if totalOut > lc.channelState.Capacity {
-		return fmt.Errorf("height=%v, for ChannelPoint(%v) attempts "+
-			"to consume %v while channel capacity is %v",
+	if totalOut > capacity {
+		return nil, fmt.Errorf("height=%v, for ChannelPoint(%v) "+
+			"attempts to consume %v while channel capacity is %v",
 			c.height, lc.channelState.FundingOutpoint,
-			totalOut, lc.channelState.Capacity)
+			totalOut, capacity)
 	}
 
-	c.txn = commitTx
-	c.fee = commitFee
-	c.ourBalance = ourBalance
-	c.theirBalance = theirBalance
-	return nil
+	return commitTx, nil
 }
 
 // evaluateHTLCView processes all update entries in both HTLC update logs,
@@ -2894,8 +2957,8 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 // new commitment to the remote party. The commit diff returned contains all
 // information necessary for retransmission.
 func (lc *LightningChannel) createCommitDiff(
-	newCommit *commitment, commitSig lnwire.Sig,
-	htlcSigs []lnwire.Sig) (*channeldb.CommitDiff, error) {
+	newCommit *commitment, commitSig lnwire.Sig, htlcSigs []lnwire.Sig,
+	spliceSigs []*lnwire.SpliceCommitSig) (*channeldb.CommitDiff, error) {
 
 	// First, we need to convert the funding outpoint into the ID that's
 	// used on the wire to identify this channel. We'll use this shortly
@@ -3017,6 +3080,18 @@ func (lc *LightningChannel) createCommitDiff(
 	// disk.
 	diskCommit := newCommit.toDiskCommit(false)
 
+	// The variants of the new commitment spending the funding outputs of
+	// pending splices are recorded as well, along with their signatures.
+	var spliceDiffs []channeldb.SpliceCommitDiff
+	for _, spliceSig := range spliceSigs {
+		spliceCommit := newCommit.spliceCommits[spliceSig.SpliceTxid]
+		spliceDiffs = append(spliceDiffs, channeldb.SpliceCommitDiff{
+			SpliceTxid: spliceSig.SpliceTxid,
+			Commitment: *spliceCommit.toDiskCommit(false),
+			CommitSig:  spliceSig,
+		})
+	}
+
 	return &channeldb.CommitDiff{
 		Commitment: *diskCommit,
 		CommitSig: &lnwire.CommitSig{
@@ -3031,6 +3106,7 @@ func (lc *LightningChannel) createCommitDiff(
 		ClosedCircuitKeys: closedCircuitKeys,
 		AddAcks:           ackAddRefs,
 		SettleFailAcks:    settleFailRefs,
+		SpliceDiffs:       spliceDiffs,
 	}, nil
 }
 
@@ -3048,6 +3124,35 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 	lc.Lock()
 	defer lc.Unlock()
 
+	// If the channel has pending splices, the variants of the new
+	// commitment spending their funding outputs must be signed as well.
+	if len(lc.channelState.PendingSplices()) != 0 {
+		return lnwire.Sig{}, nil, ErrPendingSplices
+	}
+
+	sig, htlcSigs, _, err := lc.signNextCommitment()
+	return sig, htlcSigs, err
+}
+
+// SignNextCommitmentWithSplices is identical to SignNextCommitment, but also
+// signs the variant of the new commitment spending the funding output created
+// by each pending splice of the channel. The returned SpliceCommitSig messages
+// are to be sent to the remote party before the CommitSig carrying the other
+// signatures.
+func (lc *LightningChannel) SignNextCommitmentWithSplices() (lnwire.Sig,
+	[]lnwire.Sig, []*lnwire.SpliceCommitSig, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	return lc.signNextCommitment()
+}
+
+// signNextCommitment is the private, non mutexed version of
+// SignNextCommitmentWithSplices.
+func (lc *LightningChannel) signNextCommitment() (lnwire.Sig, []lnwire.Sig,
+	[]*lnwire.SpliceCommitSig, error) {
+
 	var (
 		sig      lnwire.Sig
 		htlcSigs []lnwire.Sig
@@ -3060,7 +3165,7 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 	commitPoint := lc.channelState.RemoteNextRevocation
 	if lc.remoteCommitChain.hasUnackedCommitment() || commitPoint == nil {
 
-		return sig, htlcSigs, ErrNoWindow
+		return sig, htlcSigs, nil, ErrNoWindow
 	}
 
 	// Determine the last update on the remote log that has been locked in.
@@ -3075,7 +3180,7 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 		remoteACKedIndex, lc.localUpdateLog.logIndex, true, nil,
 	)
 	if err != nil {
-		return sig, htlcSigs, err
+		return sig, htlcSigs, nil, err
 	}
 
 	// Grab the next commitment point for the remote party. This will be
@@ -3097,7 +3202,7 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 		remoteACKedIndex, remoteHtlcIndex, keyRing,
 	)
 	if err != nil {
-		return sig, htlcSigs, err
+		return sig, htlcSigs, nil, err
 	}
 
 	walletLog.Tracef("ChannelPoint(%v): extending remote chain to height %v, "+
@@ -3114,12 +3219,81 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 		}),
 	)
 
-	// With the commitment view constructed, if there are any HTLC's, we'll
-	// need to generate signatures of each of them for the remote party's
-	// commitment state. We do so in two phases: first we generate and
-	// submit the set of signature jobs to the worker pool.
+	// With the commitment view constructed, we'll sign it along with each
+	// of its HTLCs.
+	sig, htlcSigs, err = lc.signCommitment(
+		newCommitView, keyRing, lc.signDesc,
+	)
+	if err != nil {
+		return sig, htlcSigs, nil, err
+	}
+
+	// We'll do the same for the variant of the commitment spending the
+	// funding output of each pending splice.
+	chanID := lnwire.NewChanIDFromOutPoint(&lc.channelState.FundingOutpoint)
+	var spliceSigs []*lnwire.SpliceCommitSig
+	for _, splice := range lc.channelState.PendingSplices() {
+		spliceTxid := splice.SpliceTx.TxHash()
+		spliceSig, spliceHtlcSigs, err := lc.signCommitment(
+			newCommitView.spliceCommits[spliceTxid], keyRing,
+			lc.spliceSignDesc(splice),
+		)
+		if err != nil {
+			return sig, htlcSigs, nil, err
+		}
+
+		spliceSigs = append(spliceSigs, &lnwire.SpliceCommitSig{
+			ChannelID:  chanID,
+			SpliceTxid: spliceTxid,
+			CommitSig:  spliceSig,
+			HtlcSigs:   spliceHtlcSigs,
+		})
+	}
+
+	// As we're about to proposer a new commitment state for the remote
+	// party, we'll write this pending state to disk before we exit, so we
+	// can retransmit it if necessary.
+	commitDiff, err := lc.createCommitDiff(
+		newCommitView, sig, htlcSigs, spliceSigs,
+	)
+	if err != nil {
+		return sig, htlcSigs, nil, err
+	}
+	err = lc.channelState.AppendRemoteCommitChain(commitDiff)
+	if err != nil {
+		return sig, htlcSigs, nil, err
+	}
+
+	// TODO(roasbeef): check that one eclair bug
+	//  * need to retransmit on first state still?
+	//  * after initial reconnect
+
+	// Extend the remote commitment chain by one with the addition of our
+	// latest commitment update.
+	lc.remoteCommitChain.addCommitment(newCommitView)
+
+	return sig, htlcSigs, spliceSigs, nil
+}
+
+// signCommitment generates our signature for the passed commitment view of the
+// remote party using the passed sign descriptor, along with our signatures for
+// each of its HTLCs. The HTLC signatures are sorted according to the BIP 69
+// order of the HTLC's on the commitment transaction.
+func (lc *LightningChannel) signCommitment(commitView *commitment,
+	keyRing *CommitmentKeyRing,
+	signDesc *input.SignDescriptor) (lnwire.Sig, []lnwire.Sig, error) {
+
+	var (
+		sig      lnwire.Sig
+		htlcSigs []lnwire.Sig
+	)
+
+	// If there are any HTLC's, we'll need to generate signatures of each
+	// of them for the remote party's commitment state. We do so in two
+	// phases: first we generate and submit the set of signature jobs to
+	// the worker pool.
 	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(keyRing,
-		lc.localChanCfg, lc.remoteChanCfg, newCommitView,
+		lc.localChanCfg, lc.remoteChanCfg, commitView,
 	)
 	if err != nil {
 		return sig, htlcSigs, err
@@ -3129,8 +3303,8 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 	// While the jobs are being carried out, we'll Sign their version of
 	// the new commitment transaction while we're waiting for the rest of
 	// the HTLC signatures to be processed.
-	lc.signDesc.SigHashes = txscript.NewTxSigHashes(newCommitView.txn)
-	rawSig, err := lc.Signer.SignOutputRaw(newCommitView.txn, lc.signDesc)
+	signDesc.SigHashes = txscript.NewTxSigHashes(commitView.txn)
+	rawSig, err := lc.Signer.SignOutputRaw(commitView.txn, signDesc)
 	if err != nil {
 		close(cancelChan)
 		return sig, htlcSigs, err
@@ -3158,32 +3332,12 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 		// jobs.
 		if jobResp.Err != nil {
 			close(cancelChan)
-			return sig, htlcSigs, err
+			return sig, htlcSigs, jobResp.Err
 		}
 
 		htlcSigs = append(htlcSigs, jobResp.Sig)
 	}
 
-	// As we're about to proposer a new commitment state for the remote
-	// party, we'll write this pending state to disk before we exit, so we
-	// can retransmit it if necessary.
-	commitDiff, err := lc.createCommitDiff(newCommitView, sig, htlcSigs)
-	if err != nil {
-		return sig, htlcSigs, err
-	}
-	err = lc.channelState.AppendRemoteCommitChain(commitDiff)
-	if err != nil {
-		return sig, htlcSigs, err
-	}
-
-	// TODO(roasbeef): check that one eclair bug
-	//  * need to retransmit on first state still?
-	//  * after initial reconnect
-
-	// Extend the remote commitment chain by one with the addition of our
-	// latest commitment update.
-	lc.remoteCommitChain.addCommitment(newCommitView)
-
 	return sig, htlcSigs, nil
 }
 
@@ -3360,12 +3514,18 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 		// revocation, but also initiate a state transition to re-sync
 		// them.
 		if !lc.FullySynced() {
-			commitSig, htlcSigs, err := lc.SignNextCommitment()
+			commitSig, htlcSigs, spliceSigs, err :=
+				lc.SignNextCommitmentWithSplices()
 			switch {
 
 			// If we signed this state, then we'll accumulate
-			// another update to send over.
+			// another update to send over, preceded by the
+			// signatures for the commitments spending the funding
+			// outputs of pending splices.
 			case err == nil:
+				for _, spliceSig := range spliceSigs {
+					updates = append(updates, spliceSig)
+				}
 				updates = append(updates, &lnwire.CommitSig{
 					ChanID: lnwire.NewChanIDFromOutPoint(
 						&lc.channelState.FundingOutpoint,
@@ -3463,7 +3623,12 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 
 		// With the batch of updates accumulated, we'll now re-send the
 		// original CommitSig message required to re-sync their remote
-		// commitment chain with our local version of their chain.
+		// commitment chain with our local version of their chain,
+		// preceded by the signatures for the commitments spending the
+		// funding outputs of pending splices.
+		for _, spliceDiff := range commitDiff.SpliceDiffs {
+			updates = append(updates, spliceDiff.CommitSig)
+		}
 		updates = append(updates, commitDiff.CommitSig)
 
 		openedCircuits = commitDiff.OpenedCircuitKeys
@@ -3740,6 +3905,21 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 		return ErrBelowChanReserve
 	}
 
+	// The same holds for the commitments spending the funding output of
+	// each pending splice, whose balances are adjusted by the
+	// contributions of both parties to the splice.
+	for _, splice := range lc.channelState.PendingSplices() {
+		localDelta := int64(splice.LocalDelta) * 1000
+		remoteDelta := int64(splice.RemoteDelta) * 1000
+
+		switch {
+		case int64(ourBalance)+localDelta < 0:
+			return ErrBelowChanReserve
+		case int64(theirBalance)+remoteDelta < 0:
+			return ErrBelowChanReserve
+		}
+	}
+
 	// If the added HTLCs will decrease the balance, make sure they won't
 	// dip the local and remote balances below the channel reserves.
 	if ourBalance < ourInitialBalance &&
@@ -4050,6 +4230,21 @@ var _ error = (*InvalidCommitSigError)(nil)
 func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	htlcSigs []lnwire.Sig) error {
 
+	return lc.ReceiveNewCommitmentWithSplices(commitSig, htlcSigs, nil)
+}
+
+// ReceiveNewCommitmentWithSplices is identical to ReceiveNewCommitment, but
+// also processes the remote party's signatures for the variant of the new
+// commitment spending the funding output created by each pending splice of the
+// channel. A signature is required for each pending splice, while signatures
+// for unknown splices are ignored. If the channel has no pending splices, but
+// one of the signatures covers the current funding output, then the remote
+// party signed the new commitment before locking in the splice that created
+// it, so that signature is used instead of the passed commitment signature.
+func (lc *LightningChannel) ReceiveNewCommitmentWithSplices(
+	commitSig lnwire.Sig, htlcSigs []lnwire.Sig,
+	spliceSigs []*lnwire.SpliceCommitSig) error {
+
 	lc.Lock()
 	defer lc.Unlock()
 
@@ -4107,6 +4302,69 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 		}),
 	)
 
+	// If the remote party hasn't locked in the splice that created our
+	// current funding output yet, their signatures for the commitment
+	// spending it are found among the splice signatures.
+	pendingSplices := lc.channelState.PendingSplices()
+	if len(pendingSplices) == 0 {
+		fundingTxid := lc.channelState.CurrentFundingOutpoint().Hash
+		for _, spliceSig := range spliceSigs {
+			if spliceSig.SpliceTxid == fundingTxid {
+				commitSig = spliceSig.CommitSig
+				htlcSigs = spliceSig.HtlcSigs
+			}
+		}
+	}
+
+	err = lc.verifyCommitSigs(
+		localCommitmentView, keyRing, lc.channelState.Capacity,
+		commitSig, htlcSigs,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The variant of the commitment spending the funding output of each
+	// pending splice must be signed as well.
+	for _, splice := range pendingSplices {
+		spliceTxid := splice.SpliceTx.TxHash()
+
+		var spliceSig *lnwire.SpliceCommitSig
+		for _, sig := range spliceSigs {
+			if sig.SpliceTxid == spliceTxid {
+				spliceSig = sig
+				break
+			}
+		}
+		if spliceSig == nil {
+			return fmt.Errorf("no signature for commitment "+
+				"spending splice %v", spliceTxid)
+		}
+
+		err := lc.verifyCommitSigs(
+			localCommitmentView.spliceCommits[spliceTxid], keyRing,
+			splice.Capacity, spliceSig.CommitSig, spliceSig.HtlcSigs,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The signatures check out, so we can now add the new commitment to
+	// our local commitment chain.
+	lc.localCommitChain.addCommitment(localCommitmentView)
+
+	return nil
+}
+
+// verifyCommitSigs verifies the remote party's signatures for the passed
+// commitment view of ours, which spends a funding output of the given
+// capacity, as well as for each of its HTLCs. If valid, the commitment
+// signature is stored within the commitment view.
+func (lc *LightningChannel) verifyCommitSigs(localCommitmentView *commitment,
+	keyRing *CommitmentKeyRing, capacity btcutil.Amount,
+	commitSig lnwire.Sig, htlcSigs []lnwire.Sig) error {
+
 	// Construct the sighash of the commitment transaction corresponding to
 	// this newly proposed state update.
 	localCommitTx := localCommitmentView.txn
@@ -4114,7 +4372,7 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	hashCache := txscript.NewTxSigHashes(localCommitTx)
 	sigHash, err := txscript.CalcWitnessSigHash(
 		multiSigScript, hashCache, txscript.SigHashAll,
-		localCommitTx, 0, int64(lc.channelState.Capacity),
+		localCommitTx, 0, int64(capacity),
 	)
 	if err != nil {
 		// TODO(roasbeef): fetchview has already mutated the HTLCs...
@@ -4158,7 +4416,7 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 		var txBytes bytes.Buffer
 		localCommitTx.Serialize(&txBytes)
 		return &InvalidCommitSigError{
-			commitHeight: nextHeight,
+			commitHeight: localCommitmentView.height,
 			commitSig:    commitSig.ToSignatureBytes(),
 			sigHash:      sigHash,
 			commitTx:     txBytes.Bytes(),
@@ -4188,7 +4446,7 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 			var txBytes bytes.Buffer
 			localCommitTx.Serialize(&txBytes)
 			return &InvalidHtlcSigError{
-				commitHeight: nextHeight,
+				commitHeight: localCommitmentView.height,
 				htlcSig:      sig.ToSignatureBytes(),
 				htlcIndex:    htlcErr.HtlcIndex,
 				sigHash:      sigHash,
@@ -4197,10 +4455,7 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 		}
 	}
 
-	// The signature checks out, so we can now add the new commitment to
-	// our local commitment chain.
 	localCommitmentView.sig = commitSig.ToSignatureBytes()
-	lc.localCommitChain.addCommitment(localCommitmentView)
 
 	return nil
 }
@@ -4254,7 +4509,11 @@ func (lc *LightningChannel) RevokeCurrentCommitment() (*lnwire.RevokeAndAck, []c
 	// persistent storage.
 	chainTail := lc.localCommitChain.tail()
 	newCommitment := chainTail.toDiskCommit(true)
-	err = lc.channelState.UpdateCommitment(newCommitment)
+	spliceCommitments := make(map[chainhash.Hash]*channeldb.ChannelCommitment)
+	for spliceTxid, spliceCommit := range chainTail.spliceCommits {
+		spliceCommitments[spliceTxid] = spliceCommit.toDiskCommit(true)
+	}
+	err = lc.channelState.UpdateCommitment(newCommitment, spliceCommitments)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -6594,3 +6595,364 @@ func TestForceCloseBorkedState(t *testing.T) {
 		t.Fatalf("append remove chain tail should have failed")
 	}
 }
+
+// TestSpliceChannel tests that both parties of a quiescent channel derive the
+// same commitments spending the funding output of a splice, that they're able
+// to sign for them and the splice transaction itself, and that the channel
+// continues to operate once the splice has been locked in.
+func TestSpliceChannel(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice will splice one BTC into the channel from one of her wallet
+	// inputs.
+	spliceAmt := btcutil.Amount(btcutil.SatoshiPerBitcoin)
+	walletInput := wire.OutPoint{
+		Hash:  testHdSeed,
+		Index: 1,
+	}
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChanPoint, nil, nil))
+	spliceTx.AddTxIn(wire.NewTxIn(&walletInput, nil, nil))
+	spliceTx.AddTxOut(&wire.TxOut{
+		PkScript: aliceChannel.signDesc.Output.PkScript,
+		Value:    int64(aliceChannel.Capacity + spliceAmt),
+	})
+
+	// Bob shouldn't accept a funding output that doesn't match the
+	// contribution of Alice.
+	_, err = bobChannel.NewSplice(spliceTx, 0, spliceAmt/2)
+	if err == nil {
+		t.Fatalf("expected splice with wrong capacity to fail")
+	}
+
+	aliceSplice, err := aliceChannel.NewSplice(spliceTx, spliceAmt, 0)
+	if err != nil {
+		t.Fatalf("unable to create alice's splice: %v", err)
+	}
+	bobSplice, err := bobChannel.NewSplice(spliceTx, 0, spliceAmt)
+	if err != nil {
+		t.Fatalf("unable to create bob's splice: %v", err)
+	}
+
+	// Both parties should agree on each other's commitments.
+	if aliceSplice.LocalCommitment.CommitTx.TxHash() !=
+		bobSplice.RemoteCommitment.CommitTx.TxHash() {
+
+		t.Fatalf("alice's commitment doesn't match")
+	}
+	if bobSplice.LocalCommitment.CommitTx.TxHash() !=
+		aliceSplice.RemoteCommitment.CommitTx.TxHash() {
+
+		t.Fatalf("bob's commitment doesn't match")
+	}
+
+	// Exchange signatures for the new commitments.
+	bobCommitSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	if err != nil {
+		t.Fatalf("bob unable to sign commitment: %v", err)
+	}
+	err = aliceChannel.ReceiveSpliceCommitment(aliceSplice, bobCommitSig)
+	if err != nil {
+		t.Fatalf("alice unable to verify commitment sig: %v", err)
+	}
+	aliceCommitSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	if err != nil {
+		t.Fatalf("alice unable to sign commitment: %v", err)
+	}
+	err = bobChannel.ReceiveSpliceCommitment(bobSplice, aliceCommitSig)
+	if err != nil {
+		t.Fatalf("bob unable to verify commitment sig: %v", err)
+	}
+
+	// A signature for the wrong commitment should be rejected.
+	err = bobChannel.ReceiveSpliceCommitment(bobSplice, bobCommitSig)
+	if _, ok := err.(*InvalidCommitSigError); !ok {
+		t.Fatalf("expected InvalidCommitSigError, got %v", err)
+	}
+
+	// With the commitments signed, both parties sign the input spending
+	// the current funding output, allowing Bob to complete it.
+	aliceInputSig, err := aliceChannel.SignSpliceInput(spliceTx)
+	if err != nil {
+		t.Fatalf("alice unable to sign splice input: %v", err)
+	}
+	bobInputSig, err := bobChannel.SignSpliceInput(spliceTx)
+	if err != nil {
+		t.Fatalf("bob unable to sign splice input: %v", err)
+	}
+	err = bobChannel.CompleteSpliceInput(
+		spliceTx, bobInputSig, aliceInputSig,
+	)
+	if err != nil {
+		t.Fatalf("bob unable to complete splice input: %v", err)
+	}
+
+	// Once both parties have signed, the splice is added to the channel,
+	// which keeps operating across both funding outputs.
+	spliceTxid := spliceTx.TxHash()
+	if err := aliceChannel.AddPendingSplice(aliceSplice); err != nil {
+		t.Fatalf("alice unable to add pending splice: %v", err)
+	}
+	if err := bobChannel.AddPendingSplice(bobSplice); err != nil {
+		t.Fatalf("bob unable to add pending splice: %v", err)
+	}
+
+	// A commitment can no longer be signed without also signing its
+	// variant spending the funding output of the splice.
+	htlcAmt := lnwire.NewMSatFromSatoshis(spliceAmt / 2)
+	htlc, preimage := createHTLC(0, htlcAmt)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("bob unable to recv htlc: %v", err)
+	}
+	if _, _, err := aliceChannel.SignNextCommitment(); err != ErrPendingSplices {
+		t.Fatalf("expected ErrPendingSplices, got %v", err)
+	}
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+
+	// Now that the channel has an HTLC in flight, it can no longer be
+	// spliced.
+	_, err = aliceChannel.NewSplice(spliceTx, spliceAmt, 0)
+	if err != ErrSpliceNotQuiescent {
+		t.Fatalf("expected ErrSpliceNotQuiescent, got %v", err)
+	}
+
+	// Both parties should have persisted the variants of their new
+	// commitments, so they're restored once the channels are restarted.
+	restartChannel := func(channel *LightningChannel) *LightningChannel {
+		newChannel, err := NewLightningChannel(
+			channel.Signer, channel.pCache, channel.channelState,
+			channel.sigPool,
+		)
+		if err != nil {
+			t.Fatalf("unable to create channel: %v", err)
+		}
+
+		return newChannel
+	}
+	aliceChannel = restartChannel(aliceChannel)
+	bobChannel = restartChannel(bobChannel)
+
+	for _, channel := range []*LightningChannel{aliceChannel, bobChannel} {
+		splices := channel.channelState.PendingSplices()
+		if len(splices) != 1 {
+			t.Fatalf("expected 1 pending splice, got %v",
+				len(splices))
+		}
+		localCommit := splices[0].LocalCommitment
+		if len(localCommit.Htlcs) != 1 {
+			t.Fatalf("expected splice commitment with 1 htlc, "+
+				"got %v", len(localCommit.Htlcs))
+		}
+		tail := channel.localCommitChain.tail()
+		if _, ok := tail.spliceCommits[spliceTxid]; !ok {
+			t.Fatalf("splice commitment not restored")
+		}
+	}
+
+	// Once the splice transaction has confirmed, both parties lock in the
+	// splice. The channel should then be funded by the splice, with the
+	// HTLC carried over.
+	if err := aliceChannel.LockInSplice(spliceTxid); err != nil {
+		t.Fatalf("alice unable to lock in splice: %v", err)
+	}
+	if err := bobChannel.LockInSplice(spliceTxid); err != nil {
+		t.Fatalf("bob unable to lock in splice: %v", err)
+	}
+
+	if aliceChannel.fundingTxIn().PreviousOutPoint !=
+		aliceSplice.FundingOutpoint {
+
+		t.Fatalf("alice's channel isn't funded by the splice")
+	}
+	if aliceChannel.Capacity != aliceSplice.Capacity {
+		t.Fatalf("expected capacity %v, got %v", aliceSplice.Capacity,
+			aliceChannel.Capacity)
+	}
+	aliceCommitTx := aliceChannel.localCommitChain.tip().txn
+	if aliceCommitTx.TxIn[0].PreviousOutPoint != aliceSplice.FundingOutpoint {
+		t.Fatalf("alice's commitment doesn't spend the splice")
+	}
+
+	if err := bobChannel.SettleHTLC(preimage, 0, nil, nil, nil); err != nil {
+		t.Fatalf("bob unable to settle htlc: %v", err)
+	}
+	if err := aliceChannel.ReceiveHTLCSettle(preimage, 0); err != nil {
+		t.Fatalf("alice unable to recv settle: %v", err)
+	}
+	if err := ForceStateTransition(bobChannel, aliceChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+	if !aliceChannel.IsQuiescent() || !bobChannel.IsQuiescent() {
+		t.Fatalf("expected channel to be quiescent")
+	}
+}
+
+// TestReplaceAndAbandonSplice tests that multiple splices replacing each other
+// may be pending at once, that the commitments of the channel are signed for
+// each of them, and that a pending splice can be abandoned, after which the
+// channel continues to operate across the remaining funding outputs.
+func TestReplaceAndAbandonSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice will splice one BTC into the channel, then replace the splice
+	// transaction by one spending a different wallet input.
+	spliceAmt := btcutil.Amount(btcutil.SatoshiPerBitcoin)
+	newSplice := func(inputIndex uint32) chainhash.Hash {
+		spliceTx := wire.NewMsgTx(2)
+		spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChanPoint, nil, nil))
+		spliceTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+			Hash:  testHdSeed,
+			Index: inputIndex,
+		}, nil, nil))
+		spliceTx.AddTxOut(&wire.TxOut{
+			PkScript: aliceChannel.signDesc.Output.PkScript,
+			Value:    int64(aliceChannel.Capacity + spliceAmt),
+		})
+
+		aliceSplice, err := aliceChannel.NewSplice(spliceTx, spliceAmt, 0)
+		if err != nil {
+			t.Fatalf("unable to create alice's splice: %v", err)
+		}
+		bobSplice, err := bobChannel.NewSplice(spliceTx, 0, spliceAmt)
+		if err != nil {
+			t.Fatalf("unable to create bob's splice: %v", err)
+		}
+
+		bobCommitSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+		if err != nil {
+			t.Fatalf("bob unable to sign commitment: %v", err)
+		}
+		err = aliceChannel.ReceiveSpliceCommitment(
+			aliceSplice, bobCommitSig,
+		)
+		if err != nil {
+			t.Fatalf("alice unable to verify commitment sig: %v",
+				err)
+		}
+		aliceCommitSig, err := aliceChannel.SignSpliceCommitment(
+			aliceSplice,
+		)
+		if err != nil {
+			t.Fatalf("alice unable to sign commitment: %v", err)
+		}
+		err = bobChannel.ReceiveSpliceCommitment(
+			bobSplice, aliceCommitSig,
+		)
+		if err != nil {
+			t.Fatalf("bob unable to verify commitment sig: %v", err)
+		}
+
+		if err := aliceChannel.AddPendingSplice(aliceSplice); err != nil {
+			t.Fatalf("alice unable to add pending splice: %v", err)
+		}
+		if err := bobChannel.AddPendingSplice(bobSplice); err != nil {
+			t.Fatalf("bob unable to add pending splice: %v", err)
+		}
+
+		return spliceTx.TxHash()
+	}
+	firstTxid := newSplice(1)
+	secondTxid := newSplice(2)
+
+	// Both splices should now be pending, and every new commitment should
+	// have a variant for each of them.
+	htlc, preimage := createHTLC(0, lnwire.NewMSatFromSatoshis(10000))
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("bob unable to recv htlc: %v", err)
+	}
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+	for _, channel := range []*LightningChannel{aliceChannel, bobChannel} {
+		if len(channel.channelState.PendingSplices()) != 2 {
+			t.Fatalf("expected 2 pending splices")
+		}
+		tip := channel.localCommitChain.tip()
+		if len(tip.spliceCommits) != 2 {
+			t.Fatalf("expected 2 splice commitments, got %v",
+				len(tip.spliceCommits))
+		}
+	}
+
+	// Once the replacement has confirmed, the first splice can no longer
+	// confirm, so both parties abandon it.
+	if err := aliceChannel.AbandonSplice(firstTxid); err != nil {
+		t.Fatalf("alice unable to abandon splice: %v", err)
+	}
+	if err := bobChannel.AbandonSplice(firstTxid); err != nil {
+		t.Fatalf("bob unable to abandon splice: %v", err)
+	}
+	if err := aliceChannel.AbandonSplice(firstTxid); err !=
+		channeldb.ErrSpliceNotFound {
+
+		t.Fatalf("expected ErrSpliceNotFound, got %v", err)
+	}
+
+	// The channel should keep operating with only the remaining splice
+	// pending.
+	if err := bobChannel.SettleHTLC(preimage, 0, nil, nil, nil); err != nil {
+		t.Fatalf("bob unable to settle htlc: %v", err)
+	}
+	if err := aliceChannel.ReceiveHTLCSettle(preimage, 0); err != nil {
+		t.Fatalf("alice unable to recv settle: %v", err)
+	}
+	if err := ForceStateTransition(bobChannel, aliceChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+	for _, channel := range []*LightningChannel{aliceChannel, bobChannel} {
+		tip := channel.localCommitChain.tip()
+		if len(tip.spliceCommits) != 1 {
+			t.Fatalf("expected 1 splice commitment, got %v",
+				len(tip.spliceCommits))
+		}
+		if _, ok := tip.spliceCommits[secondTxid]; !ok {
+			t.Fatalf("expected commitment spending replacement")
+		}
+	}
+
+	// Finally, locking in the replacement should fund the channel by its
+	// funding output, carrying over the settled balances.
+	if err := aliceChannel.LockInSplice(secondTxid); err != nil {
+		t.Fatalf("alice unable to lock in splice: %v", err)
+	}
+	if err := bobChannel.LockInSplice(secondTxid); err != nil {
+		t.Fatalf("bob unable to lock in splice: %v", err)
+	}
+	if aliceChannel.fundingTxIn().PreviousOutPoint.Hash != secondTxid {
+		t.Fatalf("alice's channel isn't funded by the replacement")
+	}
+	if len(aliceChannel.channelState.PendingSplices()) != 0 {
+		t.Fatalf("expected no pending splices")
+	}
+
+	htlc, _ = createHTLC(1, lnwire.NewMSatFromSatoshis(10000))
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("bob unable to recv htlc: %v", err)
+	}
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+}
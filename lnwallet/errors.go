@@ -132,6 +132,15 @@ func ErrNumConfsTooLarge(numConfs, maxNumConfs uint32) error {
 	}
 }
 
+// ErrInvalidUpfrontShutdownScript returns an error indicating that the upfront
+// shutdown script the remote party committed to isn't one of the standard
+// script types permitted within a Shutdown message.
+func ErrInvalidUpfrontShutdownScript(script []byte) ReservationError {
+	return ReservationError{
+		fmt.Errorf("invalid upfront shutdown script: %x", script),
+	}
+}
+
 // ErrChanTooSmall returns an error indicating that an incoming channel request
 // was too small. We'll reject any incoming channels if they're below our
 // configured value for the min channel size we'll accept.
@@ -142,6 +151,31 @@ func ErrChanTooSmall(chanSize, minChanSize btcutil.Amount) ReservationError {
 	}
 }
 
+// ErrUnsupportedChannelType returns an error indicating that the initiator
+// proposed a channel type we don't support.
+func ErrUnsupportedChannelType() ReservationError {
+	return ReservationError{errors.New("unsupported channel type")}
+}
+
+// ErrZeroConfChanPublic returns an error indicating that the initiator
+// proposed a zero-conf channel that is to be announced. Zero-conf channels are
+// identified by an alias until they confirm, which can't be announced.
+func ErrZeroConfChanPublic() ReservationError {
+	return ReservationError{
+		errors.New("zero-conf channels must be private"),
+	}
+}
+
+// ErrZeroConfUntrusted returns an error indicating that the initiator
+// proposed a zero-conf channel, but isn't trusted to not double spend its
+// funding transaction.
+func ErrZeroConfUntrusted() ReservationError {
+	return ReservationError{
+		errors.New("zero-conf channels are only accepted from " +
+			"trusted peers"),
+	}
+}
+
 // ErrHtlcIndexAlreadyFailed is returned when the HTLC index has already been
 // failed, but has not been committed by our commitment state.
 type ErrHtlcIndexAlreadyFailed uint64
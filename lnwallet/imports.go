@@ -0,0 +1,44 @@
+package lnwallet
+
+import (
+	"math"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// ListAllUnspentWitness returns all unspent outputs of all accounts which are
+// version 0 witness programs, having between minConfs and maxConfs
+// confirmations. Unlike ListUnspentWitness, the outputs of watch-only accounts
+// are included.
+func (l *LightningWallet) ListAllUnspentWitness(minConfs,
+	maxConfs int32) ([]*Utxo, error) {
+
+	utxos, err := l.ListUnspentWitness(minConfs, maxConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	watchOnlyUtxos, err := l.ListWatchOnlyUnspent(minConfs, maxConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(utxos, watchOnlyUtxos...), nil
+}
+
+// TotalBalance returns the sum of all unspent witness outputs of all accounts,
+// including the watch-only ones, that have at least confs confirmations. If
+// confs is set to zero, then unconfirmed outputs are included as well.
+func (l *LightningWallet) TotalBalance(confs int32) (btcutil.Amount, error) {
+	utxos, err := l.ListAllUnspentWitness(confs, math.MaxInt32)
+	if err != nil {
+		return 0, err
+	}
+
+	var balance btcutil.Amount
+	for _, utxo := range utxos {
+		balance += utxo.Value
+	}
+
+	return balance, nil
+}
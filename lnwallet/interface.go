@@ -9,8 +9,10 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 	"github.com/wakiyamap/lnd/lntypes"
+	"github.com/wakiyamap/lnd/psbt"
 )
 
 // AddressType is an enum-like type which denotes the possible address types
@@ -30,6 +32,15 @@ const (
 	UnknownAddressType
 )
 
+// DefaultAccountName is the name of the default account of the wallet. All
+// funds the wallet manages on its own behalf, such as those used to fund
+// channels, belong to this account.
+const DefaultAccountName = "default"
+
+// ImportedAccountName is the name of the watch-only account that all imported
+// public keys and addresses belong to.
+const ImportedAccountName = "imported"
+
 var (
 	// DefaultPublicPassphrase is the default public passphrase used for the
 	// wallet.
@@ -63,6 +74,31 @@ type Utxo struct {
 	RedeemScript  []byte
 	WitnessScript []byte
 	wire.OutPoint
+
+	// Account is the name of the account the output belongs to. An empty
+	// name denotes the default account.
+	Account string
+}
+
+// WalletAccount is a named account of the wallet. The funds of each account
+// are kept apart, as every account derives its own addresses, and only spends
+// its own outputs.
+type WalletAccount struct {
+	// Name is the unique name of the account.
+	Name string
+
+	// Number is the BIP 44 account number of the account, which is the
+	// same for all address types.
+	Number uint32
+
+	// WatchOnly is true if the account was imported from its public keys,
+	// in which case the inputs spending its outputs must be signed by an
+	// external signer.
+	WatchOnly bool
+
+	// AddressType is the type of the addresses of a watch-only account.
+	// All other accounts derive addresses of all supported types.
+	AddressType AddressType
 }
 
 // TransactionDetail describes a transaction with either inputs which belong to
@@ -101,6 +137,11 @@ type TransactionDetail struct {
 
 	// DestAddresses are the destinations for a transaction
 	DestAddresses []btcutil.Address
+
+	// AccountValues is the net value of this transaction for each of the
+	// accounts of the wallet it is relevant to, keyed by the name of the
+	// account. The values sum up to Value.
+	AccountValues map[string]btcutil.Amount
 }
 
 // TransactionSubscription is an interface which describes an object capable of
@@ -137,6 +178,13 @@ type WalletController interface {
 	// a non-nil error value of ErrNotMine should be returned instead.
 	FetchInputInfo(prevOut *wire.OutPoint) (*wire.TxOut, error)
 
+	// FetchTx queries for the WalletController's knowledge of the
+	// transaction with the passed txid. If the transaction is relevant to
+	// the wallet, such as one paying to one of its addresses, then the
+	// full transaction should be returned. Otherwise, a non-nil error
+	// value of ErrNotMine should be returned instead.
+	FetchTx(txid chainhash.Hash) (*wire.MsgTx, error)
+
 	// ConfirmedBalance returns the sum of all the wallet's unspent outputs
 	// that have at least confs confirmations. If confs is set to zero,
 	// then all unspent outputs, including those currently in the mempool
@@ -155,6 +203,11 @@ type WalletController interface {
 	// p2wsh, etc.
 	NewAddress(addrType AddressType, change bool) (btcutil.Address, error)
 
+	// NewAccountAddress returns the next external or internal address of
+	// the named account, similar to NewAddress.
+	NewAccountAddress(account string, addrType AddressType,
+		change bool) (btcutil.Address, error)
+
 	// LastUnusedAddress returns the last *unused* address known by the
 	// wallet. An address is unused if it hasn't received any payments.
 	// This can be useful in UIs in order to continually show the
@@ -174,6 +227,13 @@ type WalletController interface {
 	SendOutputs(outputs []*wire.TxOut,
 		feeRate SatPerKWeight) (*wire.MsgTx, error)
 
+	// SendOutputsFromAccount funds, signs, and broadcasts a Bitcoin
+	// transaction paying out to the specified outputs, similar to
+	// SendOutputs. Only outputs of the named account are spent, and any
+	// change is sent back to the account.
+	SendOutputsFromAccount(account string, outputs []*wire.TxOut,
+		feeRate SatPerKWeight) (*wire.MsgTx, error)
+
 	// CreateSimpleTx creates a Bitcoin transaction paying to the specified
 	// outputs. The transaction is not broadcasted to the network. In the
 	// case the wallet has insufficient funds, or the outputs are
@@ -200,6 +260,59 @@ type WalletController interface {
 	// relevant to the wallet.
 	ListTransactionDetails() ([]*TransactionDetail, error)
 
+	// ListAccounts returns all accounts of the wallet, including the
+	// default account.
+	ListAccounts() ([]*WalletAccount, error)
+
+	// CreateAccount creates a new account with the given name, which must
+	// not be in use by any other account yet. Addresses of all supported
+	// address types can be derived for the new account.
+	CreateAccount(name string) (*WalletAccount, error)
+
+	// IsWatchOnly returns true if the wallet only holds the public keys of
+	// its accounts, in which case the inputs it funds transactions with
+	// must be signed by a remote signer.
+	IsWatchOnly() bool
+
+	// ExportWatchOnly returns a serialized copy of the wallet from which
+	// all private key material has been removed, such that it only holds
+	// the public keys of its accounts. The copy can be used to create a
+	// watch-only wallet that tracks the same accounts.
+	ExportWatchOnly() ([]byte, error)
+
+	// ImportAccount imports a watch-only account with the given name from
+	// its BIP 44 account-level extended public key. The wallet tracks the
+	// outputs paying to addresses of the given type derived from the key,
+	// starting at the block with the given height. If the height is zero,
+	// only blocks connected after the import are scanned.
+	ImportAccount(name string, accountPubKey *hdkeychain.ExtendedKey,
+		masterKeyFingerprint uint32, addrType AddressType,
+		birthdayHeight uint32) (*WalletAccount, error)
+
+	// ImportPublicKey imports a single public key into the watch-only
+	// ImportedAccountName account. Outputs paying to the address of the
+	// given type derived from the key are tracked starting at the block
+	// with the given height, similar to ImportAccount.
+	ImportPublicKey(pubKey *btcec.PublicKey, addrType AddressType,
+		birthdayHeight uint32) error
+
+	// ImportAddress imports a single address into the watch-only
+	// ImportedAccountName account. Outputs paying to the address are
+	// tracked starting at the block with the given height, similar to
+	// ImportAccount.
+	ImportAddress(addr btcutil.Address, birthdayHeight uint32) error
+
+	// ListWatchOnlyUnspent returns all unspent outputs of the watch-only
+	// accounts, having between minconfirms and maxconfirms confirmations.
+	// Outputs of watch-only accounts are only tracked once they confirm.
+	ListWatchOnlyUnspent(minconfirms, maxconfirms int32) ([]*Utxo, error)
+
+	// WatchOnlyDerivation returns the BIP 32 derivation of the key that
+	// the passed output script of a watch-only account pays to, which
+	// allows an external signer to find its private key. If the
+	// derivation isn't known, nil is returned.
+	WatchOnlyDerivation(pkScript []byte) (*psbt.Bip32Derivation, error)
+
 	// LockOutpoint marks an outpoint as locked meaning it will no longer
 	// be deemed as eligible for coin selection. Locking outputs are
 	// utilized in order to avoid race conditions when selecting inputs for
@@ -0,0 +1,564 @@
+package lnwallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/psbt"
+)
+
+const (
+	// DefaultLockDuration is the default duration for which the coins
+	// selected to fund a packet are locked.
+	DefaultLockDuration = 10 * time.Minute
+)
+
+var (
+	// ErrPsbtNoOutputs is returned when attempting to fund a packet
+	// without any outputs.
+	ErrPsbtNoOutputs = errors.New("psbt to fund must have at least one " +
+		"output")
+
+	// ErrPsbtSigHashType is returned when an input of a packet to sign
+	// requests a sighash type other than SIGHASH_ALL. Any other type would
+	// allow the inputs or outputs of the transaction to be changed after
+	// our wallet signed it.
+	ErrPsbtSigHashType = errors.New("psbt inputs may only be signed " +
+		"with SIGHASH_ALL")
+
+	// ErrOutputNotLocked is returned when attempting to release an output
+	// that wasn't locked to fund a packet.
+	ErrOutputNotLocked = errors.New("output is not locked by a psbt")
+)
+
+// FundPsbt performs coin selection in order to fund the outputs of the passed
+// template packet at the given fee rate, only selecting coins of the named
+// account. The template may already spend some inputs, in which case only the
+// amount they don't cover is funded. The selected coins are added as inputs to
+// the packet, and a change output paying to the account is added if the
+// remaining amount isn't dust. The index of the change output is returned,
+// which is -1 if no change output was added.
+//
+// The selected coins, as well as any inputs of the template that belong to
+// our wallet, are locked for the passed duration, or until they're either
+// spent, or released using ReleasePsbtInputs or ReleaseOutput. The time at
+// which the locks expire is returned. As the locks are only held in memory,
+// they're also released once the wallet is restarted.
+//
+// If the account is watch-only, then the BIP 32 derivations of the keys of
+// the selected coins and the change output are added to the packet, such that
+// an external signer is able to sign its inputs.
+func (l *LightningWallet) FundPsbt(packet *psbt.Packet, account string,
+	feeRate SatPerKWeight, minConfs int32,
+	lockDuration time.Duration) (int32, time.Time, error) {
+
+	if len(packet.UnsignedTx.TxOut) == 0 {
+		return 0, time.Time{}, ErrPsbtNoOutputs
+	}
+	if err := packet.SanityCheck(); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	walletAccount, err := l.FetchAccount(account)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	var (
+		amt      btcutil.Amount
+		txWeight input.TxWeightEstimator
+	)
+	for _, txOut := range packet.UnsignedTx.TxOut {
+		amt += btcutil.Amount(txOut.Value)
+		txWeight.AddTxOutput(txOut)
+	}
+
+	// The inputs the template already spends cover part of the amount,
+	// so we only need to select coins for the remainder. Their weight
+	// must be accounted for when estimating the fee though.
+	templateInputs := make(map[wire.OutPoint]struct{})
+	var ownInputs []wire.OutPoint
+	for i, txIn := range packet.UnsignedTx.TxIn {
+		utxo, owned, err := l.psbtInputUtxo(packet, i)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(utxo.PkScript):
+			txWeight.AddP2WKHInput()
+
+		// Our wallet only creates nested P2WKH outputs, so we know
+		// the redeem script of any P2SH output it owns.
+		case owned && txscript.IsPayToScriptHash(utxo.PkScript):
+			txWeight.AddNestedP2WKHInput()
+
+		default:
+			return 0, time.Time{}, fmt.Errorf("unable to estimate "+
+				"the weight of input %v spending %v", i,
+				txIn.PreviousOutPoint)
+		}
+
+		amt -= btcutil.Amount(utxo.Value)
+		templateInputs[txIn.PreviousOutPoint] = struct{}{}
+		if owned {
+			ownInputs = append(ownInputs, txIn.PreviousOutPoint)
+			if packet.Inputs[i].WitnessUtxo == nil {
+				packet.Inputs[i].WitnessUtxo = utxo
+			}
+		}
+	}
+
+	// We hold the coin select mutex while querying for outputs, and
+	// performing coin selection in order to avoid inadvertent double
+	// spends across funding transactions.
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	l.releaseExpiredLocks()
+
+	walletLog.Infof("Performing psbt coin selection using %v sat/kw as "+
+		"fee rate", int64(feeRate))
+
+	coins, err := l.ListAccountUnspentWitness(
+		account, minConfs, math.MaxInt32,
+	)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	// The coins the template already spends mustn't be selected again.
+	availableCoins := make([]*Utxo, 0, len(coins))
+	for _, coin := range coins {
+		if _, ok := templateInputs[coin.OutPoint]; ok {
+			continue
+		}
+		availableCoins = append(availableCoins, coin)
+	}
+
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, availableCoins, txWeight,
+	)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	// Create the change output before locking any coins, such that we
+	// don't need to release them if we fail to do so. Watch-only accounts
+	// only derive addresses of a single type.
+	var (
+		changeOutput  *wire.TxOut
+		changePOutput psbt.POutput
+	)
+	if changeAmt != 0 && changeAmt > DefaultDustLimit() {
+		changeAddrType := WitnessPubKey
+		if walletAccount.WatchOnly {
+			changeAddrType = walletAccount.AddressType
+		}
+
+		changeAddr, err := l.NewAccountAddress(
+			account, changeAddrType, true,
+		)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		changeScript, err := txscript.PayToAddrScript(changeAddr)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+
+		changeOutput = &wire.TxOut{
+			Value:    int64(changeAmt),
+			PkScript: changeScript,
+		}
+
+		if walletAccount.WatchOnly {
+			derivation, err := l.WatchOnlyDerivation(changeScript)
+			if err != nil {
+				return 0, time.Time{}, err
+			}
+			if derivation != nil {
+				changePOutput.Bip32Derivation = append(
+					changePOutput.Bip32Derivation,
+					derivation,
+				)
+			}
+		}
+	}
+
+	// The external signer of a watch-only account needs to know the keys
+	// of the selected coins, and the redeem scripts of nested ones.
+	pInputs := make([]psbt.PInput, 0, len(selectedCoins))
+	for _, coin := range selectedCoins {
+		pInput := psbt.PInput{
+			WitnessUtxo: &wire.TxOut{
+				Value:    int64(coin.Value),
+				PkScript: coin.PkScript,
+			},
+		}
+
+		if walletAccount.WatchOnly {
+			pInput.RedeemScript = coin.RedeemScript
+
+			derivation, err := l.WatchOnlyDerivation(coin.PkScript)
+			if err != nil {
+				return 0, time.Time{}, err
+			}
+			if derivation != nil {
+				pInput.Bip32Derivation = append(
+					pInput.Bip32Derivation, derivation,
+				)
+			}
+		}
+
+		pInputs = append(pInputs, pInput)
+	}
+
+	// Lock our inputs of the template along with the selected coins, and
+	// add the latter to the packet, along with the outputs they spend,
+	// which are required to sign the inputs.
+	expiry := time.Now().Add(lockDuration)
+	for _, outPoint := range ownInputs {
+		l.lockPsbtOutput(outPoint, expiry)
+	}
+	for i, coin := range selectedCoins {
+		l.lockPsbtOutput(coin.OutPoint, expiry)
+
+		packet.UnsignedTx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+		packet.Inputs = append(packet.Inputs, pInputs[i])
+	}
+
+	if changeOutput == nil {
+		return -1, expiry, nil
+	}
+
+	packet.UnsignedTx.AddTxOut(changeOutput)
+	packet.Outputs = append(packet.Outputs, changePOutput)
+
+	return int32(len(packet.UnsignedTx.TxOut) - 1), expiry, nil
+}
+
+// psbtInputUtxo returns the output spent by the input at the given index of
+// the packet, and whether it belongs to our wallet. The outputs of inputs that
+// don't belong to our wallet must be described by the packet.
+func (l *LightningWallet) psbtInputUtxo(packet *psbt.Packet,
+	index int) (*wire.TxOut, bool, error) {
+
+	outPoint := packet.UnsignedTx.TxIn[index].PreviousOutPoint
+	pInput := &packet.Inputs[index]
+
+	info, err := l.FetchInputInfo(&outPoint)
+	switch {
+	// If the packet describes one of our outputs, it must match our own
+	// view of it, as we'd otherwise sign off on an amount we didn't
+	// intend to.
+	case err == nil:
+		if pInput.WitnessUtxo != nil &&
+			(pInput.WitnessUtxo.Value != info.Value ||
+				!bytes.Equal(pInput.WitnessUtxo.PkScript,
+					info.PkScript)) {
+
+			return nil, false, fmt.Errorf("witness utxo of input "+
+				"%v doesn't match %v", index, outPoint)
+		}
+
+		return info, true, nil
+
+	case err != ErrNotMine:
+		return nil, false, err
+
+	case pInput.WitnessUtxo != nil:
+		return pInput.WitnessUtxo, false, nil
+
+	// The sanity check of the packet ensures that the transaction matches
+	// the outpoint.
+	case pInput.NonWitnessUtxo != nil:
+		return pInput.NonWitnessUtxo.TxOut[outPoint.Index], false, nil
+
+	default:
+		return nil, false, fmt.Errorf("output spent by input %v is "+
+			"unknown", index)
+	}
+}
+
+// lockPsbtOutput locks the passed output of our wallet until the given expiry.
+//
+// NOTE: The coin select mutex MUST be held when calling this method.
+func (l *LightningWallet) lockPsbtOutput(outPoint wire.OutPoint,
+	expiry time.Time) {
+
+	l.lockedOutPoints[outPoint] = struct{}{}
+	l.psbtLocks[outPoint] = expiry
+	l.LockOutpoint(outPoint)
+}
+
+// releasePsbtOutput unlocks the passed output, making it available to future
+// coin selection.
+//
+// NOTE: The coin select mutex MUST be held when calling this method.
+func (l *LightningWallet) releasePsbtOutput(outPoint wire.OutPoint) {
+	delete(l.lockedOutPoints, outPoint)
+	delete(l.psbtLocks, outPoint)
+	l.UnlockOutpoint(outPoint)
+}
+
+// releaseExpiredLocks unlocks all outputs locked to fund a packet, of which
+// the lock has expired.
+//
+// NOTE: The coin select mutex MUST be held when calling this method.
+func (l *LightningWallet) releaseExpiredLocks() {
+	now := time.Now()
+	for outPoint, expiry := range l.psbtLocks {
+		if now.Before(expiry) {
+			continue
+		}
+
+		walletLog.Debugf("Lock of psbt input %v expired", outPoint)
+
+		l.releasePsbtOutput(outPoint)
+	}
+}
+
+// ReleasePsbtInputs unlocks the inputs of the passed packet previously locked
+// by FundPsbt, making them available to future coin selection.
+func (l *LightningWallet) ReleasePsbtInputs(packet *psbt.Packet) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, txIn := range packet.UnsignedTx.TxIn {
+		if _, ok := l.psbtLocks[txIn.PreviousOutPoint]; !ok {
+			continue
+		}
+		l.releasePsbtOutput(txIn.PreviousOutPoint)
+	}
+}
+
+// ReleaseOutput unlocks the passed output, which must have been locked by
+// FundPsbt, making it available to future coin selection.
+func (l *LightningWallet) ReleaseOutput(outPoint wire.OutPoint) error {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	if _, ok := l.psbtLocks[outPoint]; !ok {
+		return ErrOutputNotLocked
+	}
+	l.releasePsbtOutput(outPoint)
+
+	return nil
+}
+
+// SignPsbt signs all inputs of the passed packet that belong to our wallet,
+// adding the signatures to the packet as partial signatures. Inputs that
+// don't belong to our wallet, or that are already finalized, are left
+// untouched. Our inputs are only signed with SIGHASH_ALL, so the packet is
+// rejected if any of them requests another sighash type. The indexes of the
+// signed inputs are returned.
+func (l *LightningWallet) SignPsbt(packet *psbt.Packet) ([]uint32, error) {
+	if err := packet.SanityCheck(); err != nil {
+		return nil, err
+	}
+
+	tx := packet.UnsignedTx
+	sigHashes := txscript.NewTxSigHashes(tx)
+
+	var signedInputs []uint32
+	for i, txIn := range tx.TxIn {
+		pInput := &packet.Inputs[i]
+		if pInput.IsFinalized() {
+			continue
+		}
+
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err == ErrNotMine {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		// If the packet already describes the output that is spent, it
+		// must match our own view of it, as we'd otherwise sign off on
+		// an amount we didn't intend to.
+		if pInput.WitnessUtxo != nil &&
+			(pInput.WitnessUtxo.Value != info.Value ||
+				!bytes.Equal(pInput.WitnessUtxo.PkScript,
+					info.PkScript)) {
+
+			return nil, fmt.Errorf("witness utxo of input %v "+
+				"doesn't match %v", i, txIn.PreviousOutPoint)
+		}
+
+		// An unset sighash type defaults to SIGHASH_ALL, which is the
+		// only type we sign with.
+		if pInput.SighashType != 0 &&
+			pInput.SighashType != txscript.SigHashAll {
+
+			return nil, ErrPsbtSigHashType
+		}
+
+		signDesc := &input.SignDescriptor{
+			Output:     info,
+			HashType:   txscript.SigHashAll,
+			SigHashes:  sigHashes,
+			InputIndex: i,
+		}
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			tx, signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The witness of the inputs our wallet is able to sign consists
+		// of the signature, followed by the public key.
+		if len(inputScript.Witness) != 2 {
+			return nil, fmt.Errorf("unexpected witness for input "+
+				"%v", i)
+		}
+		sig := &psbt.PartialSig{
+			PubKey:    inputScript.Witness[1],
+			Signature: inputScript.Witness[0],
+		}
+		pInput.PartialSigs = addPartialSig(pInput.PartialSigs, sig)
+
+		// For nested P2WKH inputs, the signature script pushes the
+		// witness program, which is the redeem script of the input.
+		if len(inputScript.SigScript) != 0 {
+			pushes, err := txscript.PushedData(inputScript.SigScript)
+			if err != nil {
+				return nil, err
+			}
+			if len(pushes) != 1 {
+				return nil, fmt.Errorf("unexpected signature "+
+					"script for input %v", i)
+			}
+			pInput.RedeemScript = pushes[0]
+		}
+
+		if pInput.WitnessUtxo == nil {
+			pInput.WitnessUtxo = info
+		}
+
+		signedInputs = append(signedInputs, uint32(i))
+	}
+
+	return signedInputs, nil
+}
+
+// addPartialSig adds the passed signature to the partial signatures of an
+// input, replacing any signature for the same public key, as an input may
+// only carry a single signature per key.
+func addPartialSig(sigs []*psbt.PartialSig,
+	sig *psbt.PartialSig) []*psbt.PartialSig {
+
+	for i, existing := range sigs {
+		if bytes.Equal(existing.PubKey, sig.PubKey) {
+			sigs[i] = sig
+			return sigs
+		}
+	}
+
+	return append(sigs, sig)
+}
+
+// FinalizePsbt signs all inputs of the passed packet that belong to our
+// wallet, and finalizes all of its inputs. If this succeeds, the fully signed
+// transaction is returned. All inputs that don't belong to our wallet must
+// carry the signatures required to finalize them.
+func (l *LightningWallet) FinalizePsbt(packet *psbt.Packet) (*wire.MsgTx,
+	error) {
+
+	if _, err := l.SignPsbt(packet); err != nil {
+		return nil, err
+	}
+
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		return nil, err
+	}
+
+	return psbt.Extract(packet)
+}
+
+// SendOutputs funds, signs, and broadcasts a transaction paying out to the
+// specified outputs. A watch-only wallet can't sign for its own outputs, so
+// the transaction is funded as a PSBT, whose inputs are signed by the signer
+// of the LightningWallet. Otherwise, this is left to the WalletController.
+func (l *LightningWallet) SendOutputs(outputs []*wire.TxOut,
+	feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	if !l.IsWatchOnly() {
+		return l.WalletController.SendOutputs(outputs, feeRate)
+	}
+
+	return l.sendOutputsWatchOnly(DefaultAccountName, outputs, feeRate)
+}
+
+// SendOutputsFromAccount funds, signs, and broadcasts a transaction paying out
+// to the specified outputs like SendOutputs, only spending outputs of the
+// named account.
+func (l *LightningWallet) SendOutputsFromAccount(account string,
+	outputs []*wire.TxOut, feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	// The wallet can't sign for the coins of watch-only accounts, so they
+	// can only be spent through a PSBT.
+	walletAccount, err := l.FetchAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	if walletAccount.WatchOnly {
+		return nil, ErrWatchOnlyAccount
+	}
+
+	if !l.IsWatchOnly() {
+		return l.WalletController.SendOutputsFromAccount(
+			account, outputs, feeRate,
+		)
+	}
+
+	return l.sendOutputsWatchOnly(account, outputs, feeRate)
+}
+
+// sendOutputsWatchOnly funds a transaction paying out to the specified
+// outputs with coins of the named account, signs it with the signer of the
+// LightningWallet, and broadcasts it.
+func (l *LightningWallet) sendOutputsWatchOnly(account string,
+	outputs []*wire.TxOut, feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	if len(outputs) < 1 {
+		return nil, ErrNoOutputs
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, output := range outputs {
+		tx.AddTxOut(output)
+	}
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = l.FundPsbt(
+		packet, account, feeRate, 1, DefaultLockDuration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := l.FinalizePsbt(packet)
+	if err == nil {
+		err = l.PublishTransaction(signedTx)
+	}
+	if err != nil {
+		l.ReleasePsbtInputs(packet)
+		return nil, err
+	}
+
+	return signedTx, nil
+}
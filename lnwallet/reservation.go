@@ -1,6 +1,7 @@
 package lnwallet
 
 import (
+	"fmt"
 	"net"
 	"sync"
 
@@ -32,6 +33,12 @@ type ChannelContribution struct {
 	// channel capacity.
 	ChangeOutputs []*wire.TxOut
 
+	// PrevOutputs maps each of the inputs above to the output it spends.
+	// This is only populated for a remote party's contribution to a dual
+	// funded channel, in which case it's used to verify their input
+	// signatures rather than querying the chain backend.
+	PrevOutputs map[wire.OutPoint]*wire.TxOut
+
 	// FirstCommitmentPoint is the first commitment point that will be used
 	// to create the revocation key in the first commitment transaction we
 	// send to the remote party.
@@ -113,6 +120,10 @@ type ChannelReservation struct {
 	// commitment state.
 	pushMSat lnwire.MilliSatoshi
 
+	// remoteInitiated is true if we contributed funds to a dual funded
+	// channel initiated by the remote party.
+	remoteInitiated bool
+
 	// chanOpen houses a struct containing the channel and additional
 	// confirmation details will be sent on once the channel is considered
 	// 'open'. A channel is open once the funding transaction has reached a
@@ -269,6 +280,25 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetOurUpfrontShutdown sets the upfront shutdown address on our side of the
+// channel. Once set, any cooperative close of the channel must pay out to
+// this script.
+func (r *ChannelReservation) SetOurUpfrontShutdown(shutdown lnwire.DeliveryAddress) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.LocalShutdownScript = shutdown
+}
+
+// SetTheirUpfrontShutdown sets the upfront shutdown address that the remote
+// party committed to when the channel was negotiated.
+func (r *ChannelReservation) SetTheirUpfrontShutdown(shutdown lnwire.DeliveryAddress) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.RemoteShutdownScript = shutdown
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
@@ -393,6 +423,69 @@ func (r *ChannelReservation) ProcessSingleContribution(theirContribution *Channe
 	return <-errChan
 }
 
+// SetRemoteFunding converts a pending single funder reservation that we
+// initiated into a dual funded one, to which the remote party contributes amt.
+// As the initiator, we continue to pay the fee of the commitment transaction
+// in full, so the remote party's contribution is credited to their balance in
+// its entirety.
+//
+// NOTE: This MUST be called before the remote party's contribution is
+// processed via .ProcessContribution().
+func (r *ChannelReservation) SetRemoteFunding(amt btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.partialState.IsInitiator || r.pushMSat != 0 {
+		return fmt.Errorf("only reservations we initiated without a " +
+			"push amount can be dual funded")
+	}
+
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+	r.partialState.ChanType = channeldb.DualFunder
+	r.partialState.Capacity += amt
+	r.partialState.LocalCommitment.RemoteBalance += amtMSat
+	r.partialState.RemoteCommitment.RemoteBalance += amtMSat
+	r.theirContribution.FundingAmount = amt
+
+	return nil
+}
+
+// ContributeFunding converts a pending single funder reservation initiated by
+// the remote party into a dual funded one, to which we contribute amt. Coin
+// selection is performed at the given fee rate to obtain the inputs for our
+// contribution, which will be released again if the reservation is cancelled.
+//
+// NOTE: This MUST be called before the initiator's contribution is processed
+// via .ProcessContribution().
+func (r *ChannelReservation) ContributeFunding(amt btcutil.Amount,
+	feeRate SatPerKWeight, minConfs int32) error {
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.partialState.IsInitiator || r.pushMSat != 0 {
+		return fmt.Errorf("only reservations initiated by the remote " +
+			"party without a push amount can be dual funded")
+	}
+
+	err := r.wallet.selectCoinsAndChange(
+		feeRate, amt, minConfs, r.ourContribution,
+	)
+	if err != nil {
+		return err
+	}
+
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+	r.partialState.ChanType = channeldb.DualFunder
+	r.partialState.Capacity += amt
+	r.partialState.LocalCommitment.LocalBalance += amtMSat
+	r.partialState.RemoteCommitment.LocalBalance += amtMSat
+	r.ourContribution.FundingAmount = amt
+	r.remoteInitiated = true
+
+	return nil
+}
+
 // TheirContribution returns the counterparty's pending contribution to the
 // payment channel. See 'ChannelContribution' for further details regarding the
 // contents of a contribution. This attribute will ONLY be available after a
@@ -476,6 +569,32 @@ func (r *ChannelReservation) CompleteReservationSingle(fundingPoint *wire.OutPoi
 	return <-completeChan, <-errChan
 }
 
+// FinalizeFundingTx verifies the counterparty's signatures for all their inputs
+// to the funding transaction of a dual funded channel and attaches them,
+// returning the fully signed transaction. Signatures are expected to be passed
+// in the order the counterparty's inputs appear within the transaction. This
+// allows the commitment signatures to be exchanged, and the channel persisted
+// via .CompleteReservation(), before either party releases the signatures for
+// their inputs.
+func (r *ChannelReservation) FinalizeFundingTx(
+	fundingInputScripts []*input.Script) (*wire.MsgTx, error) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.fundingTx == nil {
+		return nil, fmt.Errorf("funding transaction not yet constructed")
+	}
+
+	err := r.wallet.verifyFundingInputs(r, fundingInputScripts)
+	if err != nil {
+		return nil, err
+	}
+	r.theirFundingInputScripts = fundingInputScripts
+
+	return r.fundingTx, nil
+}
+
 // TheirSignatures returns the counterparty's signatures to all inputs to the
 // funding transaction belonging to them, as well as their signature for the
 // wallet's version of the commitment transaction. This methods is provided for
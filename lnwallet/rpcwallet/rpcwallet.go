@@ -0,0 +1,428 @@
+package rpcwallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lncfg"
+	"github.com/wakiyamap/lnd/lnrpc/signrpc"
+	"github.com/wakiyamap/lnd/lnrpc/walletrpc"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"gopkg.in/macaroon.v2"
+)
+
+// ErrRemoteSigningPrivKey is returned when a private key is requested from a
+// key ring backed by a remote signer, which never exports its private keys.
+var ErrRemoteSigningPrivKey = errors.New("private keys are held by the " +
+	"remote signer and can't be derived")
+
+// RPCKeyRing is a key ring, signer and message signer that holds no key
+// material itself. Instead, every request that requires a private key is
+// carried out by a remote signer, which is an lnd that serves the signrpc and
+// walletrpc sub-servers. This allows the node that is exposed to the network
+// to run without access to the private keys of its channels.
+type RPCKeyRing struct {
+	signerClient signrpc.SignerClient
+	walletClient walletrpc.WalletKitClient
+
+	// timeout is the timeout of a single request to the remote signer.
+	timeout time.Duration
+}
+
+// A compile time check to ensure that RPCKeyRing fully implements the
+// interfaces that are backed by the remote signer.
+var _ keychain.SecretKeyRing = (*RPCKeyRing)(nil)
+var _ input.Signer = (*RPCKeyRing)(nil)
+var _ lnwallet.MessageSigner = (*RPCKeyRing)(nil)
+
+// New connects to the remote signer described by the passed config, and
+// returns a key ring that forwards all requests to it.
+func New(cfg *lncfg.RemoteSigner) (*RPCKeyRing, error) {
+	conn, err := connectRPC(
+		cfg.RPCHost, cfg.TLSCertPath, cfg.MacaroonPath, cfg.Timeout,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer: "+
+			"%v", err)
+	}
+
+	return &RPCKeyRing{
+		signerClient: signrpc.NewSignerClient(conn),
+		walletClient: walletrpc.NewWalletKitClient(conn),
+		timeout:      cfg.Timeout,
+	}, nil
+}
+
+// DeriveNextKey attempts to derive the *next* key within the key family
+// (account in BIP43) specified. The index of the derived key is tracked by the
+// remote signer.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (r *RPCKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.walletClient.DeriveNextKey(ctx, &walletrpc.KeyReq{
+		KeyFamily: int32(keyFam),
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, fmt.Errorf("error deriving "+
+			"next key on remote signer: %v", err)
+	}
+
+	return unmarshallKeyDescriptor(resp)
+}
+
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (r *RPCKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.walletClient.DeriveKey(ctx, &signrpc.KeyLocator{
+		KeyFamily: int32(keyLoc.Family),
+		KeyIndex:  int32(keyLoc.Index),
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, fmt.Errorf("error deriving "+
+			"key on remote signer: %v", err)
+	}
+
+	return unmarshallKeyDescriptor(resp)
+}
+
+// DerivePrivKey always fails, as private keys never leave the remote signer.
+// All operations requiring them are carried out by the remote signer instead.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (r *RPCKeyRing) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	return nil, ErrRemoteSigningPrivKey
+}
+
+// ExportWatchOnlyWallet fetches a copy of the wallet of the remote signer from
+// which all private key material has been removed. It only holds the extended
+// public keys of the accounts, and is used to create the on-chain wallet of the
+// watch-only node.
+func (r *RPCKeyRing) ExportWatchOnlyWallet() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.walletClient.ExportWatchOnlyWallet(
+		ctx, &walletrpc.ExportWatchOnlyWalletRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting watch-only wallet "+
+			"from remote signer: %v", err)
+	}
+
+	return resp.WalletDb, nil
+}
+
+// ScalarMult performs a scalar multiplication (ECDH-like operation) between
+// the target key descriptor and remote public key. The output returned will
+// be the sha256 of the resulting shared point serialized in compressed format.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (r *RPCKeyRing) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pubKey *btcec.PublicKey) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.signerClient.DeriveSharedKey(
+		ctx, &signrpc.SharedKeyRequest{
+			EphemeralPubkey: pubKey.SerializeCompressed(),
+			KeyDesc:         marshallKeyDescriptor(keyDesc),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving shared key on remote "+
+			"signer: %v", err)
+	}
+
+	return resp.SharedKey, nil
+}
+
+// SignOutputRaw generates a signature for the passed transaction according to
+// the data within the passed SignDescriptor.
+//
+// NOTE: This is part of the input.Signer interface.
+func (r *RPCKeyRing) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) ([]byte, error) {
+
+	signReq, err := marshallSignReq(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.signerClient.SignOutputRaw(ctx, signReq)
+	if err != nil {
+		return nil, fmt.Errorf("error signing on remote signer: %v",
+			err)
+	}
+	if len(resp.RawSigs) != 1 {
+		return nil, fmt.Errorf("expected 1 signature from remote "+
+			"signer, got %v", len(resp.RawSigs))
+	}
+
+	return resp.RawSigs[0], nil
+}
+
+// ComputeInputScript generates a complete InputScript for the passed
+// transaction with the signature as defined within the passed SignDescriptor.
+//
+// NOTE: This is part of the input.Signer interface.
+func (r *RPCKeyRing) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) (*input.Script, error) {
+
+	signReq, err := marshallSignReq(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.signerClient.ComputeInputScript(ctx, signReq)
+	if err != nil {
+		return nil, fmt.Errorf("error computing input script on "+
+			"remote signer: %v", err)
+	}
+	if len(resp.InputScripts) != 1 {
+		return nil, fmt.Errorf("expected 1 input script from remote "+
+			"signer, got %v", len(resp.InputScripts))
+	}
+
+	return &input.Script{
+		Witness:   resp.InputScripts[0].Witness,
+		SigScript: resp.InputScripts[0].SigScript,
+	}, nil
+}
+
+// SignMessage attempts to sign a target message with the private key that
+// corresponds to the passed public key. The actual digest signed is the
+// double SHA-256 of the passed message.
+//
+// NOTE: This is part of the lnwallet.MessageSigner interface.
+func (r *RPCKeyRing) SignMessage(pubKey *btcec.PublicKey,
+	msg []byte) (*btcec.Signature, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.signerClient.SignMessage(ctx, &signrpc.SignMessageReq{
+		Msg: msg,
+		KeyDesc: &signrpc.KeyDescriptor{
+			RawKeyBytes: pubKey.SerializeCompressed(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error signing message on remote "+
+			"signer: %v", err)
+	}
+
+	return btcec.ParseDERSignature(resp.Signature, btcec.S256())
+}
+
+// KeySigner returns a keychain.SingleKeyMessageSigner that signs messages with
+// the key of the passed key descriptor on the remote signer. The public key of
+// the key descriptor must be set.
+func (r *RPCKeyRing) KeySigner(
+	keyDesc keychain.KeyDescriptor) keychain.SingleKeyMessageSigner {
+
+	return &keySigner{
+		keyDesc: keyDesc,
+		rpc:     r,
+	}
+}
+
+// keySigner is an implementation of the keychain.SingleKeyMessageSigner
+// interface that signs messages with a key of the remote signer.
+type keySigner struct {
+	keyDesc keychain.KeyDescriptor
+	rpc     *RPCKeyRing
+}
+
+// A compile time check to ensure that keySigner implements the
+// keychain.SingleKeyMessageSigner interface.
+var _ keychain.SingleKeyMessageSigner = (*keySigner)(nil)
+
+// PubKey returns the public key of the key the messages are signed with.
+//
+// NOTE: This is part of the keychain.SingleKeyMessageSigner interface.
+func (k *keySigner) PubKey() *btcec.PublicKey {
+	return k.keyDesc.PubKey
+}
+
+// SignMessage signs the given message, single or double SHA256 hashing it
+// first, with the key on the remote signer.
+//
+// NOTE: This is part of the keychain.SingleKeyMessageSigner interface.
+func (k *keySigner) SignMessage(msg []byte,
+	doubleHash bool) (*btcec.Signature, error) {
+
+	sig, err := k.rpc.signMessage(k.keyDesc, msg, doubleHash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return btcec.ParseDERSignature(sig, btcec.S256())
+}
+
+// SignMessageCompact signs the given message, single or double SHA256 hashing
+// it first, with the key on the remote signer and returns the signature in the
+// compact, public key recoverable format.
+//
+// NOTE: This is part of the keychain.SingleKeyMessageSigner interface.
+func (k *keySigner) SignMessageCompact(msg []byte,
+	doubleHash bool) ([]byte, error) {
+
+	return k.rpc.signMessage(k.keyDesc, msg, doubleHash, true)
+}
+
+// signMessage signs the message with the key of the key descriptor on the
+// remote signer, returning the raw signature.
+func (r *RPCKeyRing) signMessage(keyDesc keychain.KeyDescriptor, msg []byte,
+	doubleHash, compact bool) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.signerClient.SignMessage(ctx, &signrpc.SignMessageReq{
+		Msg:        msg,
+		KeyDesc:    marshallKeyDescriptor(keyDesc),
+		SingleHash: !doubleHash,
+		CompactSig: compact,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error signing message on remote "+
+			"signer: %v", err)
+	}
+
+	return resp.Signature, nil
+}
+
+// marshallKeyDescriptor converts the passed key descriptor into its RPC
+// counterpart. The key locator is always included, as it allows the remote
+// signer to derive the key directly.
+func marshallKeyDescriptor(keyDesc keychain.KeyDescriptor) *signrpc.KeyDescriptor {
+	rpcDesc := &signrpc.KeyDescriptor{
+		KeyLoc: &signrpc.KeyLocator{
+			KeyFamily: int32(keyDesc.Family),
+			KeyIndex:  int32(keyDesc.Index),
+		},
+	}
+	if keyDesc.PubKey != nil {
+		rpcDesc.RawKeyBytes = keyDesc.PubKey.SerializeCompressed()
+	}
+
+	return rpcDesc
+}
+
+// unmarshallKeyDescriptor converts a key descriptor returned by the remote
+// signer into its keychain counterpart.
+func unmarshallKeyDescriptor(
+	rpcDesc *signrpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	var keyDesc keychain.KeyDescriptor
+	if rpcDesc.KeyLoc == nil {
+		return keyDesc, fmt.Errorf("remote signer returned no key " +
+			"locator")
+	}
+
+	pubKey, err := btcec.ParsePubKey(rpcDesc.RawKeyBytes, btcec.S256())
+	if err != nil {
+		return keyDesc, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	keyDesc.PubKey = pubKey
+	keyDesc.KeyLocator = keychain.KeyLocator{
+		Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
+		Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
+	}
+
+	return keyDesc, nil
+}
+
+// marshallSignReq creates a request to sign a single input of the passed
+// transaction as described by the sign descriptor.
+func marshallSignReq(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) (*signrpc.SignReq, error) {
+
+	var rawTx bytes.Buffer
+	if err := tx.Serialize(&rawTx); err != nil {
+		return nil, err
+	}
+
+	var doubleTweak []byte
+	if signDesc.DoubleTweak != nil {
+		doubleTweak = signDesc.DoubleTweak.Serialize()
+	}
+
+	return &signrpc.SignReq{
+		RawTxBytes: rawTx.Bytes(),
+		SignDescs: []*signrpc.SignDescriptor{{
+			KeyDesc:       marshallKeyDescriptor(signDesc.KeyDesc),
+			SingleTweak:   signDesc.SingleTweak,
+			DoubleTweak:   doubleTweak,
+			WitnessScript: signDesc.WitnessScript,
+			Output: &signrpc.TxOut{
+				Value:    signDesc.Output.Value,
+				PkScript: signDesc.Output.PkScript,
+			},
+			Sighash:    uint32(signDesc.HashType),
+			InputIndex: int32(signDesc.InputIndex),
+		}},
+	}, nil
+}
+
+// connectRPC establishes an authenticated gRPC connection to the remote
+// signer.
+func connectRPC(hostPort, tlsCertPath, macaroonPath string,
+	timeout time.Duration) (*grpc.ClientConn, error) {
+
+	tlsCreds, err := credentials.NewClientTLSFromFile(tlsCertPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read TLS cert: %v", err)
+	}
+
+	macBytes, err := ioutil.ReadFile(macaroonPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read macaroon: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithTimeout(timeout),
+		grpc.WithTransportCredentials(tlsCreds),
+		grpc.WithPerRPCCredentials(macaroons.NewMacaroonCredential(mac)),
+	}
+
+	return grpc.Dial(hostPort, opts...)
+}
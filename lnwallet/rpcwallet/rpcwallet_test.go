@@ -0,0 +1,395 @@
+package rpcwallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lnrpc/signrpc"
+	"github.com/wakiyamap/lnd/lnrpc/walletrpc"
+	"google.golang.org/grpc"
+)
+
+var (
+	// testKeyLoc is the locator of the only key held by the mock remote
+	// signer.
+	testKeyLoc = keychain.KeyLocator{
+		Family: keychain.KeyFamilyMultiSig,
+		Index:  7,
+	}
+
+	// testWalletDB is the watch-only wallet exported by the mock remote
+	// signer.
+	testWalletDB = []byte("watch-only wallet")
+)
+
+// mockSigner is a signrpc.SignerServer that holds a single private key, which
+// is identified by testKeyLoc. Only the methods used by the RPCKeyRing are
+// implemented.
+type mockSigner struct {
+	signrpc.SignerServer
+
+	privKey *btcec.PrivateKey
+}
+
+// checkKeyDesc ensures that the passed key descriptor refers to the key of the
+// mock signer.
+func (m *mockSigner) checkKeyDesc(keyDesc *signrpc.KeyDescriptor) error {
+	if keyDesc == nil || keyDesc.KeyLoc == nil {
+		return fmt.Errorf("no key locator")
+	}
+	if keychain.KeyFamily(keyDesc.KeyLoc.KeyFamily) != testKeyLoc.Family ||
+		uint32(keyDesc.KeyLoc.KeyIndex) != testKeyLoc.Index {
+
+		return fmt.Errorf("unknown key locator %v", keyDesc.KeyLoc)
+	}
+
+	return nil
+}
+
+// parseSignReq decodes the transaction of a sign request, and returns it along
+// with its only sign descriptor.
+func (m *mockSigner) parseSignReq(req *signrpc.SignReq) (*wire.MsgTx,
+	*signrpc.SignDescriptor, error) {
+
+	if len(req.SignDescs) != 1 {
+		return nil, nil, fmt.Errorf("expected 1 sign descriptor, "+
+			"got %v", len(req.SignDescs))
+	}
+	signDesc := req.SignDescs[0]
+	if err := m.checkKeyDesc(signDesc.KeyDesc); err != nil {
+		return nil, nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	if err := tx.Deserialize(bytes.NewReader(req.RawTxBytes)); err != nil {
+		return nil, nil, err
+	}
+
+	return tx, signDesc, nil
+}
+
+func (m *mockSigner) SignOutputRaw(_ context.Context,
+	req *signrpc.SignReq) (*signrpc.SignResp, error) {
+
+	tx, signDesc, err := m.parseSignReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := txscript.RawTxInWitnessSignature(
+		tx, txscript.NewTxSigHashes(tx), int(signDesc.InputIndex),
+		signDesc.Output.Value, signDesc.WitnessScript,
+		txscript.SigHashType(signDesc.Sighash), m.privKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// As done by the signer sub-server, the sighash flag is stripped.
+	return &signrpc.SignResp{
+		RawSigs: [][]byte{sig[:len(sig)-1]},
+	}, nil
+}
+
+func (m *mockSigner) ComputeInputScript(_ context.Context,
+	req *signrpc.SignReq) (*signrpc.InputScriptResp, error) {
+
+	tx, signDesc, err := m.parseSignReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	witness, err := txscript.WitnessSignature(
+		tx, txscript.NewTxSigHashes(tx), int(signDesc.InputIndex),
+		signDesc.Output.Value, signDesc.Output.PkScript,
+		txscript.SigHashType(signDesc.Sighash), m.privKey, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signrpc.InputScriptResp{
+		InputScripts: []*signrpc.InputScript{{Witness: witness}},
+	}, nil
+}
+
+func (m *mockSigner) DeriveSharedKey(_ context.Context,
+	req *signrpc.SharedKeyRequest) (*signrpc.SharedKeyResponse, error) {
+
+	if err := m.checkKeyDesc(req.KeyDesc); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.EphemeralPubkey, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	ecdh := &keychain.PrivKeyECDH{PrivKey: m.privKey}
+	sharedKey, err := ecdh.ECDH(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signrpc.SharedKeyResponse{SharedKey: sharedKey[:]}, nil
+}
+
+// mockWalletKit is a walletrpc.WalletKitServer that only implements the
+// export of the watch-only wallet.
+type mockWalletKit struct {
+	walletrpc.WalletKitServer
+}
+
+func (m *mockWalletKit) ExportWatchOnlyWallet(_ context.Context,
+	_ *walletrpc.ExportWatchOnlyWalletRequest) (
+	*walletrpc.ExportWatchOnlyWalletResponse, error) {
+
+	return &walletrpc.ExportWatchOnlyWalletResponse{
+		WalletDb: testWalletDB,
+	}, nil
+}
+
+// newTestKeyRing starts a mock remote signer, and returns an RPCKeyRing that's
+// connected to it, along with the private key held by the signer.
+func newTestKeyRing(t *testing.T) (*RPCKeyRing, *btcec.PrivateKey, func()) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+
+	server := grpc.NewServer()
+	signrpc.RegisterSignerServer(server, &mockSigner{privKey: privKey})
+	walletrpc.RegisterWalletKitServer(server, &mockWalletKit{})
+	go server.Serve(lis)
+
+	conn, err := grpc.Dial(
+		lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithTimeout(5*time.Second),
+	)
+	if err != nil {
+		server.Stop()
+		t.Fatalf("unable to connect to mock signer: %v", err)
+	}
+
+	keyRing := &RPCKeyRing{
+		signerClient: signrpc.NewSignerClient(conn),
+		walletClient: walletrpc.NewWalletKitClient(conn),
+		timeout:      5 * time.Second,
+	}
+	cleanUp := func() {
+		conn.Close()
+		server.Stop()
+	}
+
+	return keyRing, privKey, cleanUp
+}
+
+// newTestTx creates a transaction spending a P2WKH output paying to the passed
+// public key, and returns it along with the spent output.
+func newTestTx(t *testing.T, pubKey *btcec.PublicKey) (*wire.MsgTx,
+	*wire.TxOut) {
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(pubKey.SerializeCompressed()),
+		&chaincfg.RegressionNetParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	prevOut := wire.NewTxOut(100000, pkScript)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  chainhash.Hash{1},
+		Index: 2,
+	}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, pkScript))
+
+	return tx, prevOut
+}
+
+// TestRPCKeyRingScalarMult asserts that ECDH operations with a key of the
+// remote signer are forwarded to it, and yield the same shared secret as the
+// counterparty computes.
+func TestRPCKeyRingScalarMult(t *testing.T) {
+	t.Parallel()
+
+	keyRing, privKey, cleanUp := newTestKeyRing(t)
+	defer cleanUp()
+
+	ephemeralKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	keyDesc := keychain.KeyDescriptor{
+		KeyLocator: testKeyLoc,
+		PubKey:     privKey.PubKey(),
+	}
+	sharedKey, err := keychain.NewPubKeyECDH(keyDesc, keyRing).ECDH(
+		ephemeralKey.PubKey(),
+	)
+	if err != nil {
+		t.Fatalf("unable to derive shared key: %v", err)
+	}
+
+	ecdh := &keychain.PrivKeyECDH{PrivKey: ephemeralKey}
+	expectedKey, err := ecdh.ECDH(privKey.PubKey())
+	if err != nil {
+		t.Fatalf("unable to derive shared key: %v", err)
+	}
+	if sharedKey != expectedKey {
+		t.Fatalf("shared key mismatch: expected %x, got %x",
+			expectedKey, sharedKey)
+	}
+
+	// A key unknown to the remote signer must result in an error.
+	_, err = keyRing.ScalarMult(keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{Family: testKeyLoc.Family},
+	}, ephemeralKey.PubKey())
+	if err == nil {
+		t.Fatalf("expected shared key derivation to fail")
+	}
+}
+
+// TestRPCKeyRingSignOutputRaw asserts that a signature created by the remote
+// signer is valid for the input described by the sign descriptor.
+func TestRPCKeyRingSignOutputRaw(t *testing.T) {
+	t.Parallel()
+
+	keyRing, privKey, cleanUp := newTestKeyRing(t)
+	defer cleanUp()
+
+	tx, prevOut := newTestTx(t, privKey.PubKey())
+	witnessScript, err := input.GenMultiSigScript(
+		privKey.PubKey().SerializeCompressed(),
+		privKey.PubKey().SerializeCompressed(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	signDesc := &input.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: testKeyLoc,
+			PubKey:     privKey.PubKey(),
+		},
+		WitnessScript: witnessScript,
+		Output:        prevOut,
+		HashType:      txscript.SigHashAll,
+		SigHashes:     txscript.NewTxSigHashes(tx),
+		InputIndex:    0,
+	}
+	rawSig, err := keyRing.SignOutputRaw(tx, signDesc)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+
+	sig, err := btcec.ParseDERSignature(rawSig, btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, signDesc.SigHashes, signDesc.HashType, tx, 0,
+		prevOut.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to calculate sighash: %v", err)
+	}
+	if !sig.Verify(sigHash, privKey.PubKey()) {
+		t.Fatalf("invalid signature")
+	}
+}
+
+// TestRPCKeyRingComputeInputScript asserts that the input script computed by
+// the remote signer validly spends the output of the sign descriptor.
+func TestRPCKeyRingComputeInputScript(t *testing.T) {
+	t.Parallel()
+
+	keyRing, privKey, cleanUp := newTestKeyRing(t)
+	defer cleanUp()
+
+	tx, prevOut := newTestTx(t, privKey.PubKey())
+	signDesc := &input.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: testKeyLoc,
+		},
+		Output:     prevOut,
+		HashType:   txscript.SigHashAll,
+		SigHashes:  txscript.NewTxSigHashes(tx),
+		InputIndex: 0,
+	}
+	inputScript, err := keyRing.ComputeInputScript(tx, signDesc)
+	if err != nil {
+		t.Fatalf("unable to compute input script: %v", err)
+	}
+	tx.TxIn[0].Witness = inputScript.Witness
+	tx.TxIn[0].SignatureScript = inputScript.SigScript
+
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, tx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(tx), prevOut.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("invalid input script: %v", err)
+	}
+}
+
+// TestRPCKeyRingExportWatchOnlyWallet asserts that the watch-only wallet of
+// the remote signer is returned as is.
+func TestRPCKeyRingExportWatchOnlyWallet(t *testing.T) {
+	t.Parallel()
+
+	keyRing, _, cleanUp := newTestKeyRing(t)
+	defer cleanUp()
+
+	walletDB, err := keyRing.ExportWatchOnlyWallet()
+	if err != nil {
+		t.Fatalf("unable to export wallet: %v", err)
+	}
+	if !bytes.Equal(walletDB, testWalletDB) {
+		t.Fatalf("expected wallet %x, got %x", testWalletDB, walletDB)
+	}
+}
+
+// TestRPCKeyRingDerivePrivKey asserts that private keys can't be derived from
+// a key ring backed by a remote signer.
+func TestRPCKeyRingDerivePrivKey(t *testing.T) {
+	t.Parallel()
+
+	keyRing, privKey, cleanUp := newTestKeyRing(t)
+	defer cleanUp()
+
+	_, err := keyRing.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: testKeyLoc,
+		PubKey:     privKey.PubKey(),
+	})
+	if err != ErrRemoteSigningPrivKey {
+		t.Fatalf("expected error %v, got %v", ErrRemoteSigningPrivKey,
+			err)
+	}
+}
@@ -0,0 +1,618 @@
+package lnwallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/txsort"
+
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/lnwire"
+)
+
+var (
+	// ErrSpliceNotQuiescent is returned when attempting to splice a
+	// channel that still has HTLCs or unsettled updates outstanding.
+	ErrSpliceNotQuiescent = errors.New("channel must be fully synced " +
+		"without any active HTLCs to be spliced")
+
+	// ErrSpliceBelowReserve is returned when a splice out would leave the
+	// balance of a party below its channel reserve.
+	ErrSpliceBelowReserve = errors.New("splice would dip balance below " +
+		"chan reserve")
+
+	// ErrPendingSplices is returned when attempting to sign a new
+	// commitment of a channel with pending splices, without also signing
+	// the commitments spending their funding outputs.
+	ErrPendingSplices = errors.New("commitments spending the funding " +
+		"outputs of pending splices must be signed as well")
+)
+
+// IsQuiescent returns true if the channel has no HTLCs and no updates that
+// haven't been irrevocably committed by both parties. Only such a channel may
+// be spliced.
+func (lc *LightningChannel) IsQuiescent() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.isQuiescent()
+}
+
+// isQuiescent is the private, non mutexed version of IsQuiescent.
+func (lc *LightningChannel) isQuiescent() bool {
+	localCommit := lc.localCommitChain.tip()
+	if localCommit != lc.localCommitChain.tail() ||
+		lc.remoteCommitChain.hasUnackedCommitment() {
+
+		return false
+	}
+
+	if lc.localUpdateLog.logIndex != localCommit.ourMessageIndex ||
+		lc.remoteUpdateLog.logIndex != localCommit.theirMessageIndex {
+
+		return false
+	}
+
+	return len(lc.channelState.LocalCommitment.Htlcs) == 0 &&
+		len(lc.channelState.RemoteCommitment.Htlcs) == 0
+}
+
+// FundingPkScript returns the pkScript of the funding output of the channel.
+// As splices keep the multi-sig keys of the channel, this is also the pkScript
+// of the funding output created by any splice.
+func (lc *LightningChannel) FundingPkScript() []byte {
+	return lc.signDesc.Output.PkScript
+}
+
+// NewSplice validates the passed unsigned splice transaction, and creates the
+// commitments of both parties spending the funding output it creates. The
+// splice transaction must spend the current funding output of the channel,
+// and create a single new funding output locked to the same multi-sig keys,
+// whose value is the current capacity adjusted by the contributions of both
+// parties. The returned pending splice carries the unsigned commitments, which
+// are at the same height as the current ones.
+//
+// NOTE: The channel must be quiescent, as only the settled balances of both
+// parties are carried over to the new commitments.
+func (lc *LightningChannel) NewSplice(spliceTx *wire.MsgTx,
+	localDelta, remoteDelta btcutil.Amount) (*channeldb.PendingSplice, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if !lc.isQuiescent() {
+		return nil, ErrSpliceNotQuiescent
+	}
+
+	// Ensure that the transaction doesn't violate any consensus rules,
+	// and that it spends our current funding output.
+	if err := blockchain.CheckTransactionSanity(
+		btcutil.NewTx(spliceTx),
+	); err != nil {
+		return nil, err
+	}
+	if _, err := lc.sharedInputIndex(spliceTx); err != nil {
+		return nil, err
+	}
+
+	// Next, locate the new funding output, which must carry the capacity
+	// of the channel adjusted by the contributions of both parties.
+	capacity := lc.channelState.Capacity + localDelta + remoteDelta
+	fundingPkScript := lc.signDesc.Output.PkScript
+	fundingOutputIndex := -1
+	for i, txOut := range spliceTx.TxOut {
+		if !bytes.Equal(txOut.PkScript, fundingPkScript) {
+			continue
+		}
+		if fundingOutputIndex != -1 {
+			return nil, fmt.Errorf("splice transaction has " +
+				"multiple funding outputs")
+		}
+		fundingOutputIndex = i
+	}
+	if fundingOutputIndex == -1 {
+		return nil, fmt.Errorf("splice transaction has no funding " +
+			"output")
+	}
+	if btcutil.Amount(spliceTx.TxOut[fundingOutputIndex].Value) != capacity {
+		return nil, fmt.Errorf("splice funding output has value %v, "+
+			"expected %v", spliceTx.TxOut[fundingOutputIndex].Value,
+			capacity)
+	}
+
+	// The settled balances are carried over from our current commitment,
+	// as the commitment fee is left unchanged by the splice.
+	localCommit := lc.channelState.LocalCommitment
+	remoteCommit := lc.channelState.RemoteCommitment
+	ourBalance := localCommit.LocalBalance.ToSatoshis() + localDelta
+	theirBalance := localCommit.RemoteBalance.ToSatoshis() + remoteDelta
+	if ourBalance < 0 || theirBalance < 0 {
+		return nil, fmt.Errorf("splice would result in negative " +
+			"balance")
+	}
+	if localDelta < 0 && ourBalance < lc.localChanCfg.ChanReserve {
+		return nil, ErrSpliceBelowReserve
+	}
+	if remoteDelta < 0 && theirBalance < lc.remoteChanCfg.ChanReserve {
+		return nil, ErrSpliceBelowReserve
+	}
+
+	fundingOutpoint := wire.OutPoint{
+		Hash:  spliceTx.TxHash(),
+		Index: uint32(fundingOutputIndex),
+	}
+	fundingTxIn := *wire.NewTxIn(&fundingOutpoint, nil, nil)
+
+	// With the balances known, we'll create both commitments using the
+	// commitment points of the current commitments.
+	ourRevPreImage, err := lc.channelState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localKeyRing := deriveCommitmentKeys(
+		input.ComputeCommitmentPoint(ourRevPreImage[:]), true,
+		lc.localChanCfg, lc.remoteChanCfg,
+	)
+	localCommitTx, err := lc.createSpliceCommitTx(
+		fundingTxIn, localKeyRing, localCommit.CommitHeight, true,
+		ourBalance, theirBalance,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteKeyRing := deriveCommitmentKeys(
+		lc.channelState.RemoteCurrentRevocation, false,
+		lc.localChanCfg, lc.remoteChanCfg,
+	)
+	remoteCommitTx, err := lc.createSpliceCommitTx(
+		fundingTxIn, remoteKeyRing, remoteCommit.CommitHeight, false,
+		ourBalance, theirBalance,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	localCommit.LocalBalance = lnwire.NewMSatFromSatoshis(ourBalance)
+	localCommit.RemoteBalance = lnwire.NewMSatFromSatoshis(theirBalance)
+	localCommit.CommitTx = localCommitTx
+	localCommit.CommitSig = nil
+	remoteCommit.LocalBalance = lnwire.NewMSatFromSatoshis(ourBalance)
+	remoteCommit.RemoteBalance = lnwire.NewMSatFromSatoshis(theirBalance)
+	remoteCommit.CommitTx = remoteCommitTx
+	remoteCommit.CommitSig = nil
+
+	return &channeldb.PendingSplice{
+		SpliceTx:         spliceTx,
+		FundingOutpoint:  fundingOutpoint,
+		Capacity:         capacity,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+		LocalDelta:       localDelta,
+		RemoteDelta:      remoteDelta,
+	}, nil
+}
+
+// AddPendingSplice persists the passed splice of the channel, once it has been
+// signed by both parties. From then on, every new commitment of the channel
+// has a variant spending the funding output created by the splice, until the
+// splice is either locked in or abandoned.
+func (lc *LightningChannel) AddPendingSplice(
+	splice *channeldb.PendingSplice) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	if !lc.isQuiescent() {
+		return ErrSpliceNotQuiescent
+	}
+
+	// The splice transaction must still spend the current funding output,
+	// which may have changed since the splice was created.
+	if _, err := lc.sharedInputIndex(splice.SpliceTx); err != nil {
+		return err
+	}
+
+	if err := lc.channelState.AddPendingSplice(splice); err != nil {
+		return err
+	}
+
+	return lc.restoreSpliceCommits(
+		lc.localCommitChain.tail(), lc.remoteCommitChain.tail(),
+		[]*channeldb.PendingSplice{splice},
+	)
+}
+
+// LockInSplice locks in the pending splice with the given splice transaction,
+// once that transaction has confirmed and both parties have sent SpliceLocked.
+// Every commitment within both commitment chains is replaced by its variant
+// spending the funding output created by the splice, which funds the channel
+// from then on.
+func (lc *LightningChannel) LockInSplice(spliceTxid chainhash.Hash) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	// Before promoting the splice, we'll ensure each commitment has a
+	// variant to be replaced by.
+	commitChains := []*commitmentChain{
+		lc.localCommitChain, lc.remoteCommitChain,
+	}
+	for _, commitChain := range commitChains {
+		commits := commitChain.commitments
+		for e := commits.Front(); e != nil; e = e.Next() {
+			commit := e.Value.(*commitment)
+			if _, ok := commit.spliceCommits[spliceTxid]; !ok {
+				return fmt.Errorf("commitment at height %v "+
+					"has no variant spending splice %v",
+					commit.height, spliceTxid)
+			}
+		}
+	}
+
+	if err := lc.channelState.LockInSplice(spliceTxid); err != nil {
+		return err
+	}
+
+	for _, commitChain := range commitChains {
+		commits := commitChain.commitments
+		for e := commits.Front(); e != nil; e = e.Next() {
+			commit := e.Value.(*commitment)
+			e.Value = commit.spliceCommits[spliceTxid]
+		}
+	}
+
+	lc.Capacity = lc.channelState.Capacity
+	lc.signDesc.Output.Value = int64(lc.channelState.Capacity)
+
+	return nil
+}
+
+// AbandonSplice discards the pending splice with the given splice transaction,
+// which can no longer confirm. The variants of the commitments within both
+// commitment chains spending its funding output are discarded as well.
+func (lc *LightningChannel) AbandonSplice(spliceTxid chainhash.Hash) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if err := lc.channelState.AbandonSplice(spliceTxid); err != nil {
+		return err
+	}
+
+	commitChains := []*commitmentChain{
+		lc.localCommitChain, lc.remoteCommitChain,
+	}
+	for _, commitChain := range commitChains {
+		commits := commitChain.commitments
+		for e := commits.Front(); e != nil; e = e.Next() {
+			commit := e.Value.(*commitment)
+			delete(commit.spliceCommits, spliceTxid)
+		}
+	}
+
+	return nil
+}
+
+// newSpliceCommitment creates the variant of the passed commitment view
+// spending the funding output created by the passed pending splice. The
+// variant carries the same HTLCs and commitment fee, while the balances of
+// both parties are adjusted by their contributions to the splice.
+func (lc *LightningChannel) newSpliceCommitment(c *commitment,
+	splice *channeldb.PendingSplice, filteredHTLCView *htlcView,
+	keyRing *CommitmentKeyRing) (*commitment, error) {
+
+	ourBalance := int64(c.ourBalance) + int64(splice.LocalDelta)*1000
+	theirBalance := int64(c.theirBalance) + int64(splice.RemoteDelta)*1000
+	if ourBalance < 0 || theirBalance < 0 {
+		return nil, fmt.Errorf("commitment spending splice %v would "+
+			"result in negative balance", splice.SpliceTx.TxHash())
+	}
+
+	spliceCommit := *c
+	spliceCommit.ourBalance = lnwire.MilliSatoshi(ourBalance)
+	spliceCommit.theirBalance = lnwire.MilliSatoshi(theirBalance)
+	spliceCommit.sig = nil
+	spliceCommit.spliceCommits = nil
+
+	fundingTxIn := *wire.NewTxIn(&splice.FundingOutpoint, nil, nil)
+	commitTx, err := lc.assembleCommitTx(
+		&spliceCommit, fundingTxIn, splice.Capacity,
+		spliceCommit.ourBalance, spliceCommit.theirBalance,
+		filteredHTLCView, keyRing,
+	)
+	if err != nil {
+		return nil, err
+	}
+	spliceCommit.txn = commitTx
+
+	// The HTLCs are copied, as their output indexes within the variant may
+	// differ from those within the commitment view.
+	spliceCommit.outgoingHTLCs = append(
+		[]PaymentDescriptor(nil), c.outgoingHTLCs...,
+	)
+	spliceCommit.incomingHTLCs = append(
+		[]PaymentDescriptor(nil), c.incomingHTLCs...,
+	)
+	if err := spliceCommit.populateHtlcIndexes(); err != nil {
+		return nil, err
+	}
+
+	return &spliceCommit, nil
+}
+
+// restoreSpliceCommits attaches the variants of the passed current commitments
+// of both parties spending the funding outputs of the passed pending splices,
+// which are read from the splices.
+func (lc *LightningChannel) restoreSpliceCommits(localCommit,
+	remoteCommit *commitment, splices []*channeldb.PendingSplice) error {
+
+	if len(splices) == 0 {
+		return nil
+	}
+
+	ourRevPreImage, err := lc.channelState.RevocationProducer.AtIndex(
+		lc.currentHeight,
+	)
+	if err != nil {
+		return err
+	}
+	localCommitPoint := input.ComputeCommitmentPoint(ourRevPreImage[:])
+	remoteCommitPoint := lc.channelState.RemoteCurrentRevocation
+
+	for _, splice := range splices {
+		spliceTxid := splice.SpliceTx.TxHash()
+
+		err := lc.attachSpliceCommit(
+			localCommit, spliceTxid, &splice.LocalCommitment,
+			localCommitPoint, remoteCommitPoint,
+		)
+		if err != nil {
+			return err
+		}
+		err = lc.attachSpliceCommit(
+			remoteCommit, spliceTxid, &splice.RemoteCommitment,
+			localCommitPoint, remoteCommitPoint,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachSpliceCommit converts the passed on-disk variant of a commitment
+// spending the funding output of a pending splice into our in-memory format,
+// and attaches it to that commitment.
+func (lc *LightningChannel) attachSpliceCommit(c *commitment,
+	spliceTxid chainhash.Hash, diskCommit *channeldb.ChannelCommitment,
+	localCommitPoint, remoteCommitPoint *btcec.PublicKey) error {
+
+	spliceCommit, err := lc.diskCommitToMemCommit(
+		c.isOurs, diskCommit, localCommitPoint, remoteCommitPoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	if c.spliceCommits == nil {
+		c.spliceCommits = make(map[chainhash.Hash]*commitment)
+	}
+	c.spliceCommits[spliceTxid] = spliceCommit
+
+	return nil
+}
+
+// createSpliceCommitTx creates a commitment transaction at the given height
+// spending the funding output of a splice. The passed balances are already
+// net of the commitment fee.
+func (lc *LightningChannel) createSpliceCommitTx(fundingTxIn wire.TxIn,
+	keyRing *CommitmentKeyRing, height uint64, isOurs bool,
+	ourBalance, theirBalance btcutil.Amount) (*wire.MsgTx, error) {
+
+	var (
+		delay                      uint32
+		delayBalance, p2wkhBalance btcutil.Amount
+		dustLimit                  btcutil.Amount
+	)
+	if isOurs {
+		delay = uint32(lc.localChanCfg.CsvDelay)
+		delayBalance = ourBalance
+		p2wkhBalance = theirBalance
+		dustLimit = lc.localChanCfg.DustLimit
+	} else {
+		delay = uint32(lc.remoteChanCfg.CsvDelay)
+		delayBalance = theirBalance
+		p2wkhBalance = ourBalance
+		dustLimit = lc.remoteChanCfg.DustLimit
+	}
+
+	commitTx, err := CreateCommitTx(
+		fundingTxIn, keyRing, delay, delayBalance, p2wkhBalance,
+		dustLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SetStateNumHint(commitTx, height, lc.stateHintObfuscator)
+	if err != nil {
+		return nil, err
+	}
+	txsort.InPlaceSort(commitTx)
+
+	return commitTx, nil
+}
+
+// spliceSignDesc returns the sign descriptor used to sign commitments that
+// spend the funding output created by the passed splice.
+func (lc *LightningChannel) spliceSignDesc(
+	splice *channeldb.PendingSplice) *input.SignDescriptor {
+
+	signDesc := *lc.signDesc
+	signDesc.Output = &wire.TxOut{
+		PkScript: lc.signDesc.Output.PkScript,
+		Value:    int64(splice.Capacity),
+	}
+	signDesc.InputIndex = 0
+
+	return &signDesc
+}
+
+// SignSpliceCommitment generates our signature for the remote party's
+// commitment spending the funding output created by the passed splice.
+func (lc *LightningChannel) SignSpliceCommitment(
+	splice *channeldb.PendingSplice) (lnwire.Sig, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitTx := splice.RemoteCommitment.CommitTx
+	signDesc := lc.spliceSignDesc(splice)
+	signDesc.SigHashes = txscript.NewTxSigHashes(commitTx)
+
+	rawSig, err := lc.Signer.SignOutputRaw(commitTx, signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+	sig, err := lnwire.NewSigFromRawSignature(rawSig)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	splice.RemoteCommitment.CommitSig = sig.ToSignatureBytes()
+
+	return sig, nil
+}
+
+// ReceiveSpliceCommitment verifies the remote party's signature for our
+// commitment spending the funding output created by the passed splice. If
+// valid, the signature is stored within the splice so our commitment can be
+// broadcast should the splice confirm.
+func (lc *LightningChannel) ReceiveSpliceCommitment(
+	splice *channeldb.PendingSplice, commitSig lnwire.Sig) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitTx := splice.LocalCommitment.CommitTx
+	sigHash, err := txscript.CalcWitnessSigHash(
+		lc.signDesc.WitnessScript, txscript.NewTxSigHashes(commitTx),
+		txscript.SigHashAll, commitTx, 0, int64(splice.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	verifyKey := btcec.PublicKey{
+		X:     lc.remoteChanCfg.MultiSigKey.PubKey.X,
+		Y:     lc.remoteChanCfg.MultiSigKey.PubKey.Y,
+		Curve: btcec.S256(),
+	}
+	cSig, err := commitSig.ToSignature()
+	if err != nil {
+		return err
+	}
+	if !cSig.Verify(sigHash, &verifyKey) {
+		var txBytes bytes.Buffer
+		commitTx.Serialize(&txBytes)
+		return &InvalidCommitSigError{
+			commitHeight: splice.LocalCommitment.CommitHeight,
+			commitSig:    commitSig.ToSignatureBytes(),
+			sigHash:      sigHash,
+			commitTx:     txBytes.Bytes(),
+		}
+	}
+
+	splice.LocalCommitment.CommitSig = commitSig.ToSignatureBytes()
+
+	return nil
+}
+
+// sharedInputIndex returns the index of the input of the splice transaction
+// spending our current funding output.
+func (lc *LightningChannel) sharedInputIndex(spliceTx *wire.MsgTx) (int,
+	error) {
+
+	fundingOutpoint := lc.channelState.CurrentFundingOutpoint()
+	for i, txIn := range spliceTx.TxIn {
+		if txIn.PreviousOutPoint == fundingOutpoint {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("splice transaction doesn't spend funding "+
+		"output %v", fundingOutpoint)
+}
+
+// SignSpliceInput generates our signature for the input of the splice
+// transaction spending our current funding output.
+func (lc *LightningChannel) SignSpliceInput(
+	spliceTx *wire.MsgTx) (lnwire.Sig, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	inputIndex, err := lc.sharedInputIndex(spliceTx)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	signDesc := *lc.signDesc
+	signDesc.SigHashes = txscript.NewTxSigHashes(spliceTx)
+	signDesc.InputIndex = inputIndex
+
+	rawSig, err := lc.Signer.SignOutputRaw(spliceTx, &signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromRawSignature(rawSig)
+}
+
+// CompleteSpliceInput assembles the witness for the input of the splice
+// transaction spending our current funding output using both our signature
+// and the remote party's. The resulting witness is validated before being set
+// on the splice transaction.
+func (lc *LightningChannel) CompleteSpliceInput(spliceTx *wire.MsgTx,
+	localSig, remoteSig lnwire.Sig) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	inputIndex, err := lc.sharedInputIndex(spliceTx)
+	if err != nil {
+		return err
+	}
+
+	ourKey := lc.localChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+	theirKey := lc.remoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+	ourSig := append(localSig.ToSignatureBytes(), byte(txscript.SigHashAll))
+	theirSig := append(
+		remoteSig.ToSignatureBytes(), byte(txscript.SigHashAll),
+	)
+	spliceTx.TxIn[inputIndex].Witness = input.SpendMultiSig(
+		lc.signDesc.WitnessScript, ourKey, ourSig, theirKey, theirSig,
+	)
+
+	// Validate the witness to ensure the remote party supplied a valid
+	// signature.
+	prevOut := lc.signDesc.Output
+	hashCache := txscript.NewTxSigHashes(spliceTx)
+	vm, err := txscript.NewEngine(prevOut.PkScript, spliceTx, inputIndex,
+		txscript.StandardVerifyFlags, nil, hashCache, prevOut.Value)
+	if err != nil {
+		return err
+	}
+
+	return vm.Execute()
+}
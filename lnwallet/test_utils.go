@@ -498,11 +498,15 @@ func calcStaticFee(numHTLCs int) btcutil.Amount {
 // pending updates. This method is useful when testing interactions between two
 // live state machines.
 func ForceStateTransition(chanA, chanB *LightningChannel) error {
-	aliceSig, aliceHtlcSigs, err := chanA.SignNextCommitment()
+	aliceSig, aliceHtlcSigs, aliceSpliceSigs, err :=
+		chanA.SignNextCommitmentWithSplices()
 	if err != nil {
 		return err
 	}
-	if err = chanB.ReceiveNewCommitment(aliceSig, aliceHtlcSigs); err != nil {
+	err = chanB.ReceiveNewCommitmentWithSplices(
+		aliceSig, aliceHtlcSigs, aliceSpliceSigs,
+	)
+	if err != nil {
 		return err
 	}
 
@@ -510,7 +514,8 @@ func ForceStateTransition(chanA, chanB *LightningChannel) error {
 	if err != nil {
 		return err
 	}
-	bobSig, bobHtlcSigs, err := chanB.SignNextCommitment()
+	bobSig, bobHtlcSigs, bobSpliceSigs, err :=
+		chanB.SignNextCommitmentWithSplices()
 	if err != nil {
 		return err
 	}
@@ -518,7 +523,10 @@ func ForceStateTransition(chanA, chanB *LightningChannel) error {
 	if _, _, _, err := chanA.ReceiveRevocation(bobRevocation); err != nil {
 		return err
 	}
-	if err := chanA.ReceiveNewCommitment(bobSig, bobHtlcSigs); err != nil {
+	err = chanA.ReceiveNewCommitmentWithSplices(
+		bobSig, bobHtlcSigs, bobSpliceSigs,
+	)
+	if err != nil {
 		return err
 	}
 
@@ -9,6 +9,7 @@ import (
 	"net"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
@@ -271,6 +272,11 @@ type LightningWallet struct {
 	// the currently locked outpoints.
 	lockedOutPoints map[wire.OutPoint]struct{}
 
+	// psbtLocks tracks the outputs locked to fund a packet, along with the
+	// time at which their lock expires. These outputs are also part of
+	// lockedOutPoints.
+	psbtLocks map[wire.OutPoint]time.Time
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -291,6 +297,7 @@ func NewLightningWallet(Cfg Config) (*LightningWallet, error) {
 		nextFundingID:    0,
 		fundingLimbo:     make(map[uint64]*ChannelReservation),
 		lockedOutPoints:  make(map[wire.OutPoint]struct{}),
+		psbtLocks:        make(map[wire.OutPoint]time.Time),
 		quit:             make(chan struct{}),
 	}, nil
 }
@@ -352,6 +359,7 @@ func (l *LightningWallet) ResetReservations() {
 		l.UnlockOutpoint(outpoint)
 	}
 	l.lockedOutPoints = make(map[wire.OutPoint]struct{})
+	l.psbtLocks = make(map[wire.OutPoint]time.Time)
 }
 
 // ActiveReservations returns a slice of all the currently active
@@ -532,7 +540,10 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	}
 
 	// With the above keys created, we'll also need to initialization our
-	// initial revocation tree state.
+	// initial revocation tree state. When our keys live on a remote
+	// signer, the root is derived through ECDH between the next key of
+	// the revocation root family and our multi-sig key, such that the
+	// signer never has to export a private key.
 	nextRevocationKeyDesc, err := l.DeriveNextKey(
 		keychain.KeyFamilyRevocationRoot,
 	)
@@ -541,16 +552,36 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		req.resp <- nil
 		return
 	}
-	revocationRoot, err := l.DerivePrivKey(nextRevocationKeyDesc)
-	if err != nil {
-		req.err <- err
-		req.resp <- nil
-		return
+
+	var revocationRoot []byte
+	if l.IsWatchOnly() {
+		revocationRoot, err = l.ScalarMult(
+			nextRevocationKeyDesc,
+			reservation.ourContribution.MultiSigKey.PubKey,
+		)
+		if err != nil {
+			req.err <- err
+			req.resp <- nil
+			return
+		}
+
+		// Record where the root came from, as it can't be recovered
+		// from the public key alone when restoring the channel.
+		reservation.partialState.RevocationKeyLocator =
+			nextRevocationKeyDesc.KeyLocator
+	} else {
+		revocationRootKey, err := l.DerivePrivKey(nextRevocationKeyDesc)
+		if err != nil {
+			req.err <- err
+			req.resp <- nil
+			return
+		}
+		revocationRoot = revocationRootKey.Serialize()
 	}
 
 	// Once we have the root, we can then generate our shachain producer
 	// and from that generate the per-commitment point.
-	revRoot, err := chainhash.NewHash(revocationRoot.Serialize())
+	revRoot, err := chainhash.NewHash(revocationRoot)
 	if err != nil {
 		req.err <- err
 		req.resp <- nil
@@ -813,13 +844,26 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
+	// The obfuscator is derived from the initiator's payment base point
+	// followed by the responder's, matching the derivation used once the
+	// channel is active.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType == channeldb.SingleFunder {
+	switch {
+	case chanState.ChanType == channeldb.SingleFunder ||
+		chanState.IsInitiator:
+
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
 		)
-	} else {
+
+	case pendingReservation.remoteInitiated:
+		stateObfuscator = DeriveStateHintObfuscator(
+			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
+		)
+
+	default:
 		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
 		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
 		switch bytes.Compare(ourSer, theirSer) {
@@ -952,56 +996,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	// Now we can complete the funding transaction by adding their
 	// signatures to their inputs.
 	res.theirFundingInputScripts = msg.theirFundingInputScripts
-	inputScripts := msg.theirFundingInputScripts
-	fundingTx := res.fundingTx
-	sigIndex := 0
-	fundingHashCache := txscript.NewTxSigHashes(fundingTx)
-	for i, txin := range fundingTx.TxIn {
-		if len(inputScripts) != 0 && len(txin.Witness) == 0 {
-			// Attach the input scripts so we can verify it below.
-			txin.Witness = inputScripts[sigIndex].Witness
-			txin.SignatureScript = inputScripts[sigIndex].SigScript
-
-			// Fetch the alleged previous output along with the
-			// pkscript referenced by this input.
-			//
-			// TODO(roasbeef): when dual funder pass actual
-			// height-hint
-			pkScript, err := input.WitnessScriptHash(
-				txin.Witness[len(txin.Witness)-1],
-			)
-			if err != nil {
-			}
-			output, err := l.Cfg.ChainIO.GetUtxo(
-				&txin.PreviousOutPoint,
-				pkScript, 0,
-			)
-			if output == nil {
-				msg.err <- fmt.Errorf("input to funding tx "+
-					"does not exist: %v", err)
-				msg.completeChan <- nil
-				return
-			}
-
-			// Ensure that the witness+sigScript combo is valid.
-			vm, err := txscript.NewEngine(output.PkScript,
-				fundingTx, i, txscript.StandardVerifyFlags, nil,
-				fundingHashCache, output.Value)
-			if err != nil {
-				msg.err <- fmt.Errorf("cannot create script "+
-					"engine: %s", err)
-				msg.completeChan <- nil
-				return
-			}
-			if err = vm.Execute(); err != nil {
-				msg.err <- fmt.Errorf("cannot validate "+
-					"transaction: %s", err)
-				msg.completeChan <- nil
-				return
-			}
-
-			sigIndex++
-		}
+	err := l.verifyFundingInputs(res, msg.theirFundingInputScripts)
+	if err != nil {
+		msg.err <- err
+		msg.completeChan <- nil
+		return
 	}
 
 	// At this point, we can also record and verify their signature for our
@@ -1077,7 +1076,7 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 
 	// We'll also record the finalized funding txn, which will allow us to
 	// rebroadcast on startup in case we fail.
-	res.partialState.FundingTxn = fundingTx
+	res.partialState.FundingTxn = res.fundingTx
 
 	// Add the complete funding transaction to the DB, in its open bucket
 	// which will be used for the lifetime of this channel.
@@ -1093,6 +1092,91 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	msg.err <- nil
 }
 
+// verifyFundingInputs attaches the counterparty's input scripts to their
+// inputs of the reservation's funding transaction, and verifies that each of
+// them validly spends the referenced output. The input scripts are expected in
+// the order the counterparty's inputs appear within the transaction. If no
+// input scripts are passed, then verification is deferred.
+//
+// NOTE: The reservation's mutex MUST be held by the caller.
+func (l *LightningWallet) verifyFundingInputs(res *ChannelReservation,
+	inputScripts []*input.Script) error {
+
+	if len(inputScripts) == 0 {
+		return nil
+	}
+
+	fundingTx := res.fundingTx
+	sigIndex := 0
+	fundingHashCache := txscript.NewTxSigHashes(fundingTx)
+	for i, txin := range fundingTx.TxIn {
+		// Our own inputs have already been signed, so any input
+		// lacking a witness belongs to the counterparty.
+		if len(txin.Witness) != 0 {
+			continue
+		}
+		if sigIndex >= len(inputScripts) {
+			return fmt.Errorf("missing input script for funding "+
+				"input %v", txin.PreviousOutPoint)
+		}
+
+		// Attach the input scripts so we can verify it below.
+		inputScript := inputScripts[sigIndex]
+		if len(inputScript.Witness) == 0 {
+			return fmt.Errorf("empty witness for funding input %v",
+				txin.PreviousOutPoint)
+		}
+		txin.Witness = inputScript.Witness
+		txin.SignatureScript = inputScript.SigScript
+
+		// If the counterparty told us which output their input spends,
+		// then we'll use that directly. Otherwise, we'll fetch the
+		// alleged previous output along with the pkscript referenced
+		// by this input.
+		//
+		// TODO(roasbeef): when dual funder pass actual
+		// height-hint
+		output := res.theirContribution.PrevOutputs[txin.PreviousOutPoint]
+		if output == nil {
+			pkScript, err := input.WitnessScriptHash(
+				txin.Witness[len(txin.Witness)-1],
+			)
+			if err != nil {
+				return err
+			}
+			output, err = l.Cfg.ChainIO.GetUtxo(
+				&txin.PreviousOutPoint, pkScript, 0,
+			)
+			if output == nil {
+				return fmt.Errorf("input to funding tx does "+
+					"not exist: %v", err)
+			}
+		}
+
+		// Ensure that the witness+sigScript combo is valid.
+		vm, err := txscript.NewEngine(output.PkScript,
+			fundingTx, i, txscript.StandardVerifyFlags, nil,
+			fundingHashCache, output.Value)
+		if err != nil {
+			return fmt.Errorf("cannot create script engine: %s",
+				err)
+		}
+		if err = vm.Execute(); err != nil {
+			return fmt.Errorf("cannot validate transaction: %s",
+				err)
+		}
+
+		sigIndex++
+	}
+
+	if sigIndex != len(inputScripts) {
+		return fmt.Errorf("received %v input scripts for %v funding "+
+			"inputs", len(inputScripts), sigIndex)
+	}
+
+	return nil
+}
+
 // handleSingleFunderSigs is called once the remote peer who initiated the
 // single funder workflow has assembled the funding transaction, and generated
 // a signature for our version of the commitment transaction. This method
@@ -1264,6 +1348,81 @@ func (l *LightningWallet) WithCoinSelectLock(f func() error) error {
 	return f()
 }
 
+// FundSplice performs coin selection in order to splice the given amount into
+// a channel. On top of the amount, the selected coins cover the fee for the
+// splice transaction at the passed fee rate, including the input spending the
+// current funding output of the channel. The selected coins are locked until
+// they're either spent, or released using ReleaseSpliceInputs.
+func (l *LightningWallet) FundSplice(amt btcutil.Amount,
+	feeRate SatPerKWeight) (*ChannelContribution, error) {
+
+	sharedInputWeight := int64(
+		input.InputSize*blockchain.WitnessScaleFactor +
+			input.WitnessSize,
+	)
+	sharedInputFee := feeRate.FeeForWeight(sharedInputWeight)
+
+	contribution := &ChannelContribution{}
+	err := l.selectCoinsAndChange(
+		feeRate, amt+sharedInputFee, 1, contribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return contribution, nil
+}
+
+// ReleaseSpliceInputs unlocks the passed inputs previously selected by
+// FundSplice, making them available to future coin selection.
+func (l *LightningWallet) ReleaseSpliceInputs(inputs []*wire.TxIn) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, txIn := range inputs {
+		delete(l.lockedOutPoints, txIn.PreviousOutPoint)
+		l.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
+// SignSpliceInputs signs all inputs of the passed splice transaction that
+// belong to our wallet, setting their witnesses on the transaction. The
+// witnesses are returned in the order the inputs appear within the
+// transaction.
+func (l *LightningWallet) SignSpliceInputs(
+	spliceTx *wire.MsgTx) ([]wire.TxWitness, error) {
+
+	var witnesses []wire.TxWitness
+	signDesc := input.SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(spliceTx),
+	}
+	for i, txIn := range spliceTx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err == ErrNotMine {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		signDesc.Output = info
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			spliceTx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.SigScript
+		txIn.Witness = inputScript.Witness
+		witnesses = append(witnesses, inputScript.Witness)
+	}
+
+	return witnesses, nil
+}
+
 // selectCoinsAndChange performs coin selection in order to obtain witness
 // outputs which sum to at least 'numCoins' amount of satoshis. If coin
 // selection is successful/possible, then the selected coins are available
@@ -1279,12 +1438,19 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	l.coinSelectMtx.Lock()
 	defer l.coinSelectMtx.Unlock()
 
+	// Outputs locked to fund a packet that was abandoned become
+	// available again once their lock expires.
+	l.releaseExpiredLocks()
+
 	walletLog.Infof("Performing funding tx coin selection using %v "+
 		"sat/kw as fee rate", int64(feeRate))
 
 	// Find all unlocked unspent witness outputs that satisfy the minimum
-	// number of confirmations required.
-	coins, err := l.ListUnspentWitness(minConfs, math.MaxInt32)
+	// number of confirmations required. Only the funds of the default
+	// account are used, as those of named accounts are kept apart.
+	coins, err := l.ListAccountUnspentWitness(
+		DefaultAccountName, minConfs, math.MaxInt32,
+	)
 	if err != nil {
 		return err
 	}
@@ -1292,7 +1458,13 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// Perform coin selection over our available, unlocked unspent outputs
 	// in order to find enough coins to meet the funding amount
 	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
+	// The channel funding multisig output is P2WSH.
+	var outputWeight input.TxWeightEstimator
+	outputWeight.AddP2WSHOutput()
+
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, coins, outputWeight,
+	)
 	if err != nil {
 		return err
 	}
@@ -1376,6 +1548,12 @@ func initStateHints(commit1, commit2 *wire.MsgTx,
 // selected coins are returned in order for the caller to properly handle
 // change+fees.
 func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, error) {
+	// Nothing needs to be selected if the amount is already covered, such
+	// as by the inputs of a partially funded transaction.
+	if amt <= 0 {
+		return 0, nil, nil
+	}
+
 	satSelected := btcutil.Amount(0)
 	for i, coin := range coins {
 		satSelected += coin.Value
@@ -1389,9 +1567,11 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 // coinSelect attempts to select a sufficient amount of coins, including a
 // change output to fund amt satoshis, adhering to the specified fee rate. The
 // specified fee rate should be expressed in sat/kw for coin selection to
-// function properly.
-func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
-	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
+// function properly. The passed weight estimate must account for all inputs
+// and outputs of the transaction other than the selected coins and the change
+// output.
+func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount, coins []*Utxo,
+	outputWeight input.TxWeightEstimator) ([]*Utxo, btcutil.Amount, error) {
 
 	amtNeeded := amt
 	for {
@@ -1402,7 +1582,7 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			return nil, 0, err
 		}
 
-		var weightEstimate input.TxWeightEstimator
+		weightEstimate := outputWeight
 
 		for _, utxo := range selectedUtxos {
 			switch utxo.AddressType {
@@ -1416,9 +1596,6 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			}
 		}
 
-		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
-
 		// Assume that change output is a P2WKH output.
 		//
 		// TODO: Handle wallets that generate non-witness change
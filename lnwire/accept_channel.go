@@ -1,6 +1,7 @@
 package lnwire
 
 import (
+	"bytes"
 	"io"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -86,8 +87,33 @@ type AcceptChannel struct {
 	// base point in order to derive the revocation keys that are placed
 	// within the commitment transaction of the sender.
 	FirstCommitmentPoint *btcec.PublicKey
+
+	// UpfrontShutdownScript is the script to which the channel funds
+	// should be paid when mutually closing the channel. It is always
+	// written to the wire, as a zero length script if the sender hasn't
+	// committed to one. Once committed to, any later Shutdown message sent
+	// by this party must pay out to this same script.
+	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the channel type proposed by the initiator, which the
+	// responder echoes to accept it. It's an optional TLV record, which is
+	// only set if the initiator proposed a channel type.
+	ChannelType *RawFeatureVector
+
+	// FundingAmount is the amount the responder contributes to a dual
+	// funded channel. This field is an optional TLV record, and will only
+	// be written to the wire if non-zero. A responder that leaves it
+	// unset, or an older node that doesn't know of it, declines to
+	// contribute, in which case the single funder workflow continues.
+	FundingAmount btcutil.Amount
 }
 
+// fundingAmountRecordType is the type of the TLV record within the
+// AcceptChannel message that carries the FundingAmount of the responder. As
+// dual funding isn't part of the specification, the type lies beyond the range
+// of types assigned by it, and is odd so that other nodes will ignore it.
+const fundingAmountRecordType TLVType = 65537
+
 // A compile time check to ensure AcceptChannel implements the lnwire.Message
 // interface.
 var _ Message = (*AcceptChannel)(nil)
@@ -98,7 +124,7 @@ var _ Message = (*AcceptChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		a.PendingChannelID[:],
 		a.DustLimit,
 		a.MaxValueInFlight,
@@ -114,6 +140,36 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 		a.HtlcPoint,
 		a.FirstCommitmentPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	// As we always signal option_upfront_shutdown_script, we'll always
+	// write out the upfront shutdown script, which is zero length if we
+	// haven't committed to a script.
+	if err := WriteElement(w, a.UpfrontShutdownScript); err != nil {
+		return err
+	}
+
+	// The channel type and funding amount follow as part of the TLV
+	// stream, if set.
+	records := make(tlvRecords)
+	if a.ChannelType != nil {
+		chanType, err := encodeFeatureRecord(a.ChannelType)
+		if err != nil {
+			return err
+		}
+		records[channelTypeRecordType] = chanType
+	}
+	if a.FundingAmount != 0 {
+		var b bytes.Buffer
+		if err := WriteElement(&b, a.FundingAmount); err != nil {
+			return err
+		}
+		records[fundingAmountRecordType] = b.Bytes()
+	}
+
+	return writeTLVStream(w, records)
 }
 
 // Decode deserializes the serialized AcceptChannel stored in the passed
@@ -122,7 +178,7 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		a.PendingChannelID[:],
 		&a.DustLimit,
 		&a.MaxValueInFlight,
@@ -138,6 +194,45 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 		&a.HtlcPoint,
 		&a.FirstCommitmentPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Check for the optional upfront shutdown script field. As older
+	// nodes won't send this field, we'll silence the EOF error if it isn't
+	// present.
+	err = ReadElement(r, &a.UpfrontShutdownScript)
+	switch {
+	case err == io.EOF:
+		return nil
+	case err != nil:
+		return err
+	}
+	if len(a.UpfrontShutdownScript) == 0 {
+		a.UpfrontShutdownScript = nil
+	}
+
+	// Any remaining bytes make up the TLV stream.
+	records, err := readTLVStream(
+		r, channelTypeRecordType, fundingAmountRecordType,
+	)
+	if err != nil {
+		return err
+	}
+	if chanType, ok := records[channelTypeRecordType]; ok {
+		a.ChannelType, err = decodeFeatureRecord(chanType)
+		if err != nil {
+			return err
+		}
+	}
+	if amt, ok := records[fundingAmountRecordType]; ok {
+		err := ReadElement(bytes.NewReader(amt), &a.FundingAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -153,6 +248,7 @@ func (a *AcceptChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) MaxPayloadLength(uint32) uint32 {
-	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6)
-	return 270
+	// As the message ends with a TLV stream that may contain records
+	// unknown to us, it's only bounded by the max message payload.
+	return MaxMessagePayload
 }
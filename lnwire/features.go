@@ -35,6 +35,18 @@ const (
 	// connection is established.
 	InitialRoutingSync FeatureBit = 3
 
+	// UpfrontShutdownScriptRequired is a feature bit which indicates that
+	// the sending peer *requires* that the remote party commit to a
+	// delivery script for cooperative closes at channel opening time. Any
+	// later Shutdown message must pay out to this same script.
+	UpfrontShutdownScriptRequired FeatureBit = 4
+
+	// UpfrontShutdownScriptOptional is an optional feature bit which
+	// indicates that the sending peer understands upfront shutdown
+	// scripts, allowing a delivery script to be committed to when the
+	// channel is opened.
+	UpfrontShutdownScriptOptional FeatureBit = 5
+
 	// GossipQueriesRequired is a feature bit that indicates that the
 	// receiving peer MUST know of the set of features that allows nodes to
 	// more efficiently query the network view of peers on the network for
@@ -46,6 +58,74 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// ChannelTypeRequired is a required feature bit that signals that the
+	// sending peer requires the channel type to be negotiated explicitly,
+	// using the channel_type record of the OpenChannel and AcceptChannel
+	// messages.
+	ChannelTypeRequired FeatureBit = 44
+
+	// ChannelTypeOptional is an optional feature bit that signals that the
+	// sending peer understands the channel_type record of the OpenChannel
+	// and AcceptChannel messages.
+	ChannelTypeOptional FeatureBit = 45
+
+	// ScidAliasRequired is a required feature bit that signals that the
+	// sending peer requires the use of alias short channel IDs for
+	// channels that aren't confirmed yet.
+	ScidAliasRequired FeatureBit = 46
+
+	// ScidAliasOptional is an optional feature bit that signals that the
+	// sending peer understands alias short channel IDs, which are used to
+	// refer to channels that aren't confirmed yet.
+	ScidAliasOptional FeatureBit = 47
+
+	// ZeroConfRequired is a required feature bit that signals that the
+	// sending peer requires support for channels that may be used before
+	// their funding transaction confirms.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// sending peer understands zero-conf channels, which may be used before
+	// their funding transaction confirms. Whether a zero-conf channel is
+	// accepted is still up to the responder of the funding flow.
+	ZeroConfOptional FeatureBit = 51
+
+	// DualFundRequired is a required feature bit that signals that the
+	// sending peer requires channels to be opened using the interactive
+	// dual funding workflow. As the workflow differs from the one being
+	// specified, the feature lies within the experimental range, offset
+	// by 100 from the bits assigned to it by the specification.
+	DualFundRequired FeatureBit = 128
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sending peer understands the interactive dual funding workflow, in
+	// which both parties may contribute inputs to the funding transaction.
+	DualFundOptional FeatureBit = 129
+
+	// QuiescenceRequired is a required feature bit that signals that the
+	// sending peer requires support for bringing a channel into a quiescent
+	// state using the Stfu message. As the feature isn't final yet, it lies
+	// within the experimental range, offset by 100 from the bits assigned
+	// to it by the specification.
+	QuiescenceRequired FeatureBit = 134
+
+	// QuiescenceOptional is an optional feature bit that signals that the
+	// sending peer understands the Stfu message, which is used to bring a
+	// channel into a quiescent state.
+	QuiescenceOptional FeatureBit = 135
+
+	// SpliceRequired is a required feature bit that signals that the
+	// sending peer requires support for splicing funds into and out of
+	// existing channels. As our splicing workflow differs from the one
+	// being specified, the feature lies within the experimental range,
+	// offset by 100 from the bits assigned to it by the specification.
+	SpliceRequired FeatureBit = 162
+
+	// SpliceOptional is an optional feature bit that signals that the
+	// sending peer understands splicing, which allows funds to be moved
+	// into or out of a channel without closing it.
+	SpliceOptional FeatureBit = 163
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -65,11 +145,25 @@ const (
 // not advertised to the entire network. A full description of these feature
 // bits is provided in the BOLT-09 specification.
 var LocalFeatures = map[FeatureBit]string{
-	DataLossProtectRequired: "data-loss-protect",
-	DataLossProtectOptional: "data-loss-protect",
-	InitialRoutingSync:      "initial-routing-sync",
-	GossipQueriesRequired:   "gossip-queries",
-	GossipQueriesOptional:   "gossip-queries",
+	DataLossProtectRequired:       "data-loss-protect",
+	DataLossProtectOptional:       "data-loss-protect",
+	InitialRoutingSync:            "initial-routing-sync",
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script",
+	GossipQueriesRequired:         "gossip-queries",
+	GossipQueriesOptional:         "gossip-queries",
+	ChannelTypeRequired:           "channel-type",
+	ChannelTypeOptional:           "channel-type",
+	ScidAliasRequired:             "scid-alias",
+	ScidAliasOptional:             "scid-alias",
+	ZeroConfRequired:              "zero-conf",
+	ZeroConfOptional:              "zero-conf",
+	DualFundRequired:              "dual-fund",
+	DualFundOptional:              "dual-fund",
+	QuiescenceRequired:            "quiescence",
+	QuiescenceOptional:            "quiescence",
+	SpliceRequired:                "splice",
+	SpliceOptional:                "splice",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
@@ -107,6 +201,22 @@ func (fv *RawFeatureVector) Set(feature FeatureBit) {
 	fv.features[feature] = true
 }
 
+// Equals returns true if both feature vectors have the same set of feature bits
+// enabled.
+func (fv *RawFeatureVector) Equals(other *RawFeatureVector) bool {
+	if len(fv.features) != len(other.features) {
+		return false
+	}
+
+	for feature := range fv.features {
+		if !other.IsSet(feature) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Unset marks a feature as disabled in the vector.
 func (fv *RawFeatureVector) Unset(feature FeatureBit) {
 	delete(fv.features, feature)
@@ -1,6 +1,7 @@
 package lnwire
 
 import (
+	"bytes"
 	"io"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -19,8 +20,18 @@ type FundingLocked struct {
 	// NextPerCommitmentPoint is the secret that can be used to revoke the
 	// next commitment transaction for the channel.
 	NextPerCommitmentPoint *btcec.PublicKey
+
+	// AliasScid is an optional alias short channel ID, which the sender
+	// recognizes for forwarding over this channel. The receiver uses it
+	// to refer to the channel in route hints, and in the channel updates
+	// it sends to the sender, as long as the channel isn't confirmed.
+	AliasScid *ShortChannelID
 }
 
+// aliasScidRecordType is the type of the TLV record within the FundingLocked
+// message that carries the alias short channel ID.
+const aliasScidRecordType TLVType = 1
+
 // NewFundingLocked creates a new FundingLocked message, populating it with the
 // necessary IDs and revocation secret.
 func NewFundingLocked(cid ChannelID, npcp *btcec.PublicKey) *FundingLocked {
@@ -40,9 +51,28 @@ var _ Message = (*FundingLocked)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.NextPerCommitmentPoint)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes make up the TLV stream.
+	records, err := readTLVStream(r, aliasScidRecordType)
+	if err != nil {
+		return err
+	}
+	if alias, ok := records[aliasScidRecordType]; ok {
+		var aliasScid ShortChannelID
+		err := ReadElement(bytes.NewReader(alias), &aliasScid)
+		if err != nil {
+			return err
+		}
+		c.AliasScid = &aliasScid
+	}
+
+	return nil
 }
 
 // Encode serializes the target FundingLocked message into the passed io.Writer
@@ -51,9 +81,23 @@ func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		c.ChanID,
 		c.NextPerCommitmentPoint)
+	if err != nil {
+		return err
+	}
+
+	records := make(tlvRecords)
+	if c.AliasScid != nil {
+		var b bytes.Buffer
+		if err := WriteElement(&b, *c.AliasScid); err != nil {
+			return err
+		}
+		records[aliasScidRecordType] = b.Bytes()
+	}
+
+	return writeTLVStream(w, records)
 }
 
 // MsgType returns the uint32 code which uniquely identifies this message as a
@@ -70,14 +114,7 @@ func (c *FundingLocked) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) MaxPayloadLength(uint32) uint32 {
-	var length uint32
-
-	// ChanID - 32 bytes
-	length += 32
-
-	// NextPerCommitmentPoint - 33 bytes
-	length += 33
-
-	// 65 bytes
-	return length
+	// As the message ends with a TLV stream that may contain records
+	// unknown to us, it's only bounded by the max message payload.
+	return MaxMessagePayload
 }
@@ -235,6 +235,29 @@ func WriteElement(w io.Writer, element interface{}) error {
 		if err := wire.WriteVarBytes(w, 0, e); err != nil {
 			return err
 		}
+	case *wire.MsgTx:
+		if e == nil {
+			return fmt.Errorf("cannot write nil transaction")
+		}
+
+		var b bytes.Buffer
+		if err := e.Serialize(&b); err != nil {
+			return err
+		}
+		if b.Len() > MaxSliceLength {
+			return fmt.Errorf("transaction of %v bytes is too "+
+				"large", b.Len())
+		}
+
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(b.Len()))
+		if _, err := w.Write(l[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(b.Bytes()); err != nil {
+			return err
+		}
 	case *RawFeatureVector:
 		if e == nil {
 			return fmt.Errorf("cannot write nil feature vector")
@@ -637,6 +660,23 @@ func ReadElement(r io.Reader, element interface{}) error {
 			return err
 		}
 		*e = pkScript
+	case **wire.MsgTx:
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return err
+		}
+		txLen := binary.BigEndian.Uint16(l[:])
+
+		txBytes := make([]byte, txLen)
+		if _, err := io.ReadFull(r, txBytes); err != nil {
+			return err
+		}
+
+		tx := &wire.MsgTx{}
+		if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+			return err
+		}
+		*e = tx
 	case *wire.OutPoint:
 		var h [32]byte
 		if _, err = io.ReadFull(r, h[:]); err != nil {
@@ -74,6 +74,15 @@ func randRawKey() ([33]byte, error) {
 	return n, nil
 }
 
+func randDeliveryAddress(r *rand.Rand) DeliveryAddress {
+	// Generate a non-empty script of at most 34 bytes, the size of the
+	// largest standard witness program.
+	addr := make(DeliveryAddress, r.Intn(34)+1)
+	r.Read(addr)
+
+	return addr
+}
+
 func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	featureVec := NewRawFeatureVector()
 	for i := 0; i < 10000; i++ {
@@ -360,6 +369,22 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// 1/2 chance of committing to an upfront shutdown
+			// script.
+			if r.Int31()%2 == 0 {
+				req.UpfrontShutdownScript = randDeliveryAddress(r)
+			}
+
+			// 1/2 chance of proposing a channel type.
+			if r.Int31()%2 == 0 {
+				req.ChannelType = NewRawFeatureVector(
+					ScidAliasRequired, ZeroConfRequired,
+				)
+			}
+
+			// 1/2 chance of proposing a dual funded channel.
+			req.DualFund = r.Int31()%2 == 0
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgAcceptChannel: func(v []reflect.Value, r *rand.Rand) {
@@ -410,6 +435,26 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// 1/2 chance of committing to an upfront shutdown
+			// script.
+			if r.Int31()%2 == 0 {
+				req.UpfrontShutdownScript = randDeliveryAddress(r)
+			}
+
+			// 1/2 chance of accepting a channel type.
+			if r.Int31()%2 == 0 {
+				req.ChannelType = NewRawFeatureVector(
+					ScidAliasRequired, ZeroConfRequired,
+				)
+			}
+
+			// 1/2 chance of contributing to a dual funded channel.
+			if r.Int31()%2 == 0 {
+				req.FundingAmount = btcutil.Amount(r.Int63n(
+					btcutil.SatoshiPerBitcoin,
+				) + 1)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
@@ -454,6 +499,203 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			prevTx := wire.NewMsgTx(2)
+			prevOutPoint := wire.OutPoint{Index: r.Uint32()}
+			_, err := r.Read(prevOutPoint.Hash[:])
+			if err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+			prevTx.AddTxIn(wire.NewTxIn(
+				&prevOutPoint, randDeliveryAddress(r), nil,
+			))
+			prevTx.AddTxOut(wire.NewTxOut(
+				r.Int63(), randDeliveryAddress(r),
+			))
+
+			req := TxAddInput{
+				SerialID:   uint64(r.Int63()),
+				PrevTx:     prevTx,
+				PrevTxVout: 0,
+				Sequence:   r.Uint32(),
+			}
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddOutput{
+				SerialID: uint64(r.Int63()),
+				Amount:   btcutil.Amount(r.Int63()),
+				PkScript: PkScript(randDeliveryAddress(r)),
+			}
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			var req TxSignatures
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+			if _, err := r.Read(req.TxHash[:]); err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+
+			req.Witnesses = make([]wire.TxWitness, r.Intn(5))
+			for i := range req.Witnesses {
+				witness := make(wire.TxWitness, r.Intn(4))
+				for j := range witness {
+					witness[j] = make([]byte, r.Intn(73))
+					r.Read(witness[j])
+				}
+				req.Witnesses[i] = witness
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceInit: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceInit{
+				FundingContribution: btcutil.Amount(r.Int63()),
+				FundingFeePerKw:     r.Uint32(),
+				SpliceTx:            wire.NewMsgTx(2),
+			}
+			if r.Intn(2) == 0 {
+				req.FundingContribution = -req.FundingContribution
+			}
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			// Each input is given a non-empty signature script, as
+			// a decoded script is never nil.
+			numInputs := r.Intn(4) + 1
+			for i := 0; i < numInputs; i++ {
+				var prevOut wire.OutPoint
+				if _, err := r.Read(prevOut.Hash[:]); err != nil {
+					t.Fatalf("unable to generate hash: %v", err)
+					return
+				}
+				prevOut.Index = r.Uint32()
+
+				sigScript := make([]byte, r.Intn(20)+1)
+				r.Read(sigScript)
+				req.SpliceTx.AddTxIn(
+					wire.NewTxIn(&prevOut, sigScript, nil),
+				)
+			}
+			numOutputs := r.Intn(4) + 1
+			for i := 0; i < numOutputs; i++ {
+				pkScript := []byte(randDeliveryAddress(r))
+				req.SpliceTx.AddTxOut(
+					wire.NewTxOut(r.Int63(), pkScript),
+				)
+			}
+
+			// The transactions spent by the initiator's inputs are
+			// given a single input with a non-empty signature
+			// script for the same reason.
+			numPrevTxs := r.Intn(3)
+			for i := 0; i < numPrevTxs; i++ {
+				prevTx := wire.NewMsgTx(2)
+
+				var prevOut wire.OutPoint
+				if _, err := r.Read(prevOut.Hash[:]); err != nil {
+					t.Fatalf("unable to generate hash: %v", err)
+					return
+				}
+				sigScript := make([]byte, r.Intn(20)+1)
+				r.Read(sigScript)
+				prevTx.AddTxIn(
+					wire.NewTxIn(&prevOut, sigScript, nil),
+				)
+
+				pkScript := []byte(randDeliveryAddress(r))
+				prevTx.AddTxOut(
+					wire.NewTxOut(r.Int63(), pkScript),
+				)
+
+				req.PrevTxs = append(req.PrevTxs, prevTx)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceSigned: func(v []reflect.Value, r *rand.Rand) {
+			var req SpliceSigned
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			var err error
+			req.CommitSig, err = NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+			req.SharedInputSig, err = NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+
+			req.Witnesses = make([]wire.TxWitness, r.Intn(5))
+			for i := range req.Witnesses {
+				witness := make(wire.TxWitness, r.Intn(4))
+				for j := range witness {
+					witness[j] = make([]byte, r.Intn(73))
+					r.Read(witness[j])
+				}
+				req.Witnesses[i] = witness
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceCommitSig: func(v []reflect.Value, r *rand.Rand) {
+			var req SpliceCommitSig
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+			if _, err := r.Read(req.SpliceTxid[:]); err != nil {
+				t.Fatalf("unable to generate txid: %v", err)
+				return
+			}
+
+			var err error
+			req.CommitSig, err = NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+
+			// Only create the slice if there will be any signatures
+			// in it to prevent false positive test failures due to
+			// an empty slice versus a nil slice.
+			numSigs := uint16(r.Int31n(1020))
+			if numSigs > 0 {
+				req.HtlcSigs = make([]Sig, numSigs)
+			}
+			for i := 0; i < int(numSigs); i++ {
+				req.HtlcSigs[i], err = NewSigFromSignature(testSig)
+				if err != nil {
+					t.Fatalf("unable to parse sig: %v", err)
+					return
+				}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgFundingLocked: func(v []reflect.Value, r *rand.Rand) {
 
 			var c [32]byte
@@ -470,6 +712,15 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			req := NewFundingLocked(ChannelID(c), pubKey)
 
+			// 1/2 chance of including an alias.
+			if r.Int31()%2 == 0 {
+				req.AliasScid = &ShortChannelID{
+					BlockHeight: AliasStartBlockHeight,
+					TxIndex:     uint32(r.Int31n(1 << 24)),
+					TxPosition:  uint16(r.Int31n(1 << 16)),
+				}
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgClosingSigned: func(v []reflect.Value, r *rand.Rand) {
@@ -877,6 +1128,72 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgStfu,
+			scenario: func(m Stfu) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAck,
+			scenario: func(m SpliceAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceSigned,
+			scenario: func(m SpliceSigned) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceLocked,
+			scenario: func(m SpliceLocked) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceCommitSig,
+			scenario: func(m SpliceCommitSig) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAbandon,
+			scenario: func(m SpliceAbandon) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgShutdown,
 			scenario: func(m Shutdown) bool {
@@ -54,6 +54,25 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+
+	// The messages used to interactively construct the funding transaction
+	// of dual funded channels lie within the experimental range, as they
+	// differ from the ones being specified. Their types are offset by
+	// 32768 from the ones assigned by the specification.
+	MsgTxAddInput   = 32834
+	MsgTxAddOutput  = 32835
+	MsgTxComplete   = 32838
+	MsgTxSignatures = 32839
+
+	// The messages used to bring channels into a quiescent state and to
+	// splice them are also experimental, using the same offset.
+	MsgStfu            = 32770
+	MsgSpliceAbandon   = 32842
+	MsgSpliceLocked    = 32845
+	MsgSpliceInit      = 32848
+	MsgSpliceAck       = 32849
+	MsgSpliceSigned    = 32850
+	MsgSpliceCommitSig = 32900
 )
 
 // String return the string representation of message type.
@@ -71,6 +90,28 @@ func (t MessageType) String() string {
 		return "MsgFundingSigned"
 	case MsgFundingLocked:
 		return "FundingLocked"
+	case MsgTxAddInput:
+		return "TxAddInput"
+	case MsgTxAddOutput:
+		return "TxAddOutput"
+	case MsgTxComplete:
+		return "TxComplete"
+	case MsgTxSignatures:
+		return "TxSignatures"
+	case MsgStfu:
+		return "Stfu"
+	case MsgSpliceInit:
+		return "SpliceInit"
+	case MsgSpliceAck:
+		return "SpliceAck"
+	case MsgSpliceSigned:
+		return "SpliceSigned"
+	case MsgSpliceLocked:
+		return "SpliceLocked"
+	case MsgSpliceCommitSig:
+		return "SpliceCommitSig"
+	case MsgSpliceAbandon:
+		return "SpliceAbandon"
 	case MsgShutdown:
 		return "Shutdown"
 	case MsgClosingSigned:
@@ -172,6 +213,28 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &FundingSigned{}
 	case MsgFundingLocked:
 		msg = &FundingLocked{}
+	case MsgTxAddInput:
+		msg = &TxAddInput{}
+	case MsgTxAddOutput:
+		msg = &TxAddOutput{}
+	case MsgTxComplete:
+		msg = &TxComplete{}
+	case MsgTxSignatures:
+		msg = &TxSignatures{}
+	case MsgStfu:
+		msg = &Stfu{}
+	case MsgSpliceInit:
+		msg = &SpliceInit{}
+	case MsgSpliceAck:
+		msg = &SpliceAck{}
+	case MsgSpliceSigned:
+		msg = &SpliceSigned{}
+	case MsgSpliceLocked:
+		msg = &SpliceLocked{}
+	case MsgSpliceCommitSig:
+		msg = &SpliceCommitSig{}
+	case MsgSpliceAbandon:
+		msg = &SpliceAbandon{}
 	case MsgShutdown:
 		msg = &Shutdown{}
 	case MsgClosingSigned:
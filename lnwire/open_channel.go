@@ -19,6 +19,18 @@ const (
 	FFAnnounceChannel FundingFlag = 1 << iota
 )
 
+// channelTypeRecordType is the type of the TLV record within the OpenChannel
+// and AcceptChannel messages that carries the explicitly negotiated channel
+// type.
+const channelTypeRecordType TLVType = 1
+
+// dualFundRecordType is the type of the TLV record within the OpenChannel
+// message that signals the initiator is willing to construct the funding
+// transaction interactively. As dual funding isn't part of the specification,
+// the type lies beyond the range of types assigned by it. It's even, as the
+// responder must understand the record to continue the funding workflow.
+const dualFundRecordType TLVType = 65536
+
 // OpenChannel is the message Alice sends to Bob if we should like to create a
 // channel with Bob where she's the sole provider of funds to the channel.
 // Single funder channels simplify the initial funding workflow, are supported
@@ -122,6 +134,26 @@ type OpenChannel struct {
 	// Currently, the least significant bit of this bit field indicates the
 	// initiator of the channel wishes to advertise this channel publicly.
 	ChannelFlags FundingFlag
+
+	// UpfrontShutdownScript is the script to which the channel funds
+	// should be paid when mutually closing the channel. It is always
+	// written to the wire, as a zero length script if the sender hasn't
+	// committed to one. Once committed to, any later Shutdown message sent
+	// by this party must pay out to this same script.
+	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the channel type the initiator wishes to open,
+	// expressed as a set of even feature bits. It's an optional TLV
+	// record, which may only be set if both parties signalled the
+	// channel type feature bit. The responder must either accept the
+	// channel type by echoing it, or fail the funding flow.
+	ChannelType *RawFeatureVector
+
+	// DualFund signals that the initiator is willing to construct the
+	// funding transaction interactively, allowing the responder to
+	// contribute funds of its own. It's an optional TLV record, which may
+	// only be set if both parties signalled the dual-fund feature bit.
+	DualFund bool
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -134,7 +166,7 @@ var _ Message = (*OpenChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		o.FundingAmount,
@@ -154,6 +186,33 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	// As we always signal option_upfront_shutdown_script, we'll always
+	// write out the upfront shutdown script, which is zero length if we
+	// haven't committed to a script. Peers that don't understand the
+	// field will ignore the trailing bytes.
+	if err := WriteElement(w, o.UpfrontShutdownScript); err != nil {
+		return err
+	}
+
+	// Finally, the channel type and the dual funding signal follow as part
+	// of the TLV stream, if set.
+	records := make(tlvRecords)
+	if o.ChannelType != nil {
+		chanType, err := encodeFeatureRecord(o.ChannelType)
+		if err != nil {
+			return err
+		}
+		records[channelTypeRecordType] = chanType
+	}
+	if o.DualFund {
+		records[dualFundRecordType] = []byte{}
+	}
+
+	return writeTLVStream(w, records)
 }
 
 // Decode deserializes the serialized OpenChannel stored in the passed
@@ -162,7 +221,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		&o.FundingAmount,
@@ -182,6 +241,43 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		&o.FirstCommitmentPoint,
 		&o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Check for the optional upfront shutdown script field. As older
+	// nodes won't send this field, we'll silence the EOF error if it isn't
+	// present.
+	err = ReadElement(r, &o.UpfrontShutdownScript)
+	switch {
+	case err == io.EOF:
+		return nil
+	case err != nil:
+		return err
+	}
+
+	// A zero length script signals that the sender didn't commit to an
+	// upfront shutdown script.
+	if len(o.UpfrontShutdownScript) == 0 {
+		o.UpfrontShutdownScript = nil
+	}
+
+	// Any remaining bytes make up the TLV stream.
+	records, err := readTLVStream(
+		r, channelTypeRecordType, dualFundRecordType,
+	)
+	if err != nil {
+		return err
+	}
+	if chanType, ok := records[channelTypeRecordType]; ok {
+		o.ChannelType, err = decodeFeatureRecord(chanType)
+		if err != nil {
+			return err
+		}
+	}
+	_, o.DualFund = records[dualFundRecordType]
+
+	return nil
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -197,6 +293,7 @@ func (o *OpenChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
-	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1
-	return 319
+	// As the message ends with a TLV stream that may contain records
+	// unknown to us, it's only bounded by the max message payload.
+	return MaxMessagePayload
 }
@@ -1,9 +1,23 @@
 package lnwire
 
 import (
+	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 )
 
+const (
+	// AliasStartBlockHeight is the first block height of the range of
+	// short channel IDs that are reserved for aliases. The range lies far
+	// beyond the height of the chain, so aliases can never collide with
+	// the short channel ID of a confirmed channel.
+	AliasStartBlockHeight uint32 = 16000000
+
+	// AliasEndBlockHeight is the first block height beyond the range of
+	// short channel IDs that are reserved for aliases.
+	AliasEndBlockHeight uint32 = 16250000
+)
+
 // ShortChannelID represents the set of data which is needed to retrieve all
 // necessary data to validate the channel existence.
 type ShortChannelID struct {
@@ -34,6 +48,32 @@ func NewShortChanIDFromInt(chanID uint64) ShortChannelID {
 	}
 }
 
+// NewAliasShortChanID returns a random alias short channel ID. Aliases are
+// used to refer to channels whose funding transaction isn't confirmed yet.
+// Each party picks its own alias for a channel, and tells the other party
+// about it in the FundingLocked message.
+func NewAliasShortChanID() (ShortChannelID, error) {
+	var b [10]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ShortChannelID{}, err
+	}
+
+	aliasRange := AliasEndBlockHeight - AliasStartBlockHeight
+	return ShortChannelID{
+		BlockHeight: AliasStartBlockHeight +
+			binary.BigEndian.Uint32(b[0:4])%aliasRange,
+		TxIndex:    binary.BigEndian.Uint32(b[4:8]) & 0xFFFFFF,
+		TxPosition: binary.BigEndian.Uint16(b[8:10]),
+	}, nil
+}
+
+// IsAlias returns true if the short channel ID lies within the range that is
+// reserved for aliases.
+func (c ShortChannelID) IsAlias() bool {
+	return c.BlockHeight >= AliasStartBlockHeight &&
+		c.BlockHeight < AliasEndBlockHeight
+}
+
 // ToUint64 converts the ShortChannelID into a compact format encoded within a
 // uint64 (8 bytes).
 func (c ShortChannelID) ToUint64() uint64 {
@@ -39,3 +39,35 @@ func TestShortChannelIDEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestAliasShortChanID asserts that alias short channel IDs are random, and
+// lie within the alias range.
+func TestAliasShortChanID(t *testing.T) {
+	t.Parallel()
+
+	alias1, err := NewAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to create alias: %v", err)
+	}
+	if !alias1.IsAlias() {
+		t.Fatalf("expected %v to be an alias", alias1)
+	}
+	alias2, err := NewAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to create alias: %v", err)
+	}
+	if alias1 == alias2 {
+		t.Fatalf("expected distinct aliases")
+	}
+
+	// Short channel IDs of confirmed channels shouldn't be considered an
+	// alias.
+	confirmed := ShortChannelID{
+		BlockHeight: 600000,
+		TxIndex:     1,
+		TxPosition:  0,
+	}
+	if confirmed.IsAlias() {
+		t.Fatalf("expected %v not to be an alias", confirmed)
+	}
+}
@@ -0,0 +1,67 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SpliceAbandon is sent by either party of a quiescent channel to abandon one
+// of its pending splices, whose splice transaction can no longer confirm. The
+// receiver replies with its own SpliceAbandon once it has abandoned the splice
+// as well, after which neither party signs commitments spending the funding
+// output of the splice anymore.
+type SpliceAbandon struct {
+	// ChannelID is the channel whose splice is abandoned.
+	ChannelID ChannelID
+
+	// SpliceTxid is the txid of the abandoned splice transaction.
+	SpliceTxid chainhash.Hash
+}
+
+// NewSpliceAbandon creates a new SpliceAbandon message.
+func NewSpliceAbandon(cid ChannelID, txid chainhash.Hash) *SpliceAbandon {
+	return &SpliceAbandon{
+		ChannelID:  cid,
+		SpliceTxid: txid,
+	}
+}
+
+// A compile time check to ensure SpliceAbandon implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAbandon)(nil)
+
+// Encode serializes the target SpliceAbandon into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbandon) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w, s.ChannelID, s.SpliceTxid[:])
+}
+
+// Decode deserializes the serialized SpliceAbandon stored in the passed
+// io.Reader into the target SpliceAbandon using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbandon) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &s.ChannelID, s.SpliceTxid[:])
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceAbandon on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbandon) MsgType() MessageType {
+	return MsgSpliceAbandon
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceAbandon message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbandon) MaxPayloadLength(uint32) uint32 {
+	// 32 + 32
+	return 64
+}
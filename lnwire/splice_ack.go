@@ -0,0 +1,55 @@
+package lnwire
+
+import "io"
+
+// SpliceAck is sent by the responder of a splice once it has validated the
+// splice transaction proposed within SpliceInit. It carries the responder's
+// signature for the initiator's new commitment transaction, which spends the
+// funding output created by the splice transaction.
+type SpliceAck struct {
+	// ChannelID is the channel being spliced.
+	ChannelID ChannelID
+
+	// CommitSig is the responder's signature for the initiator's new
+	// commitment transaction.
+	CommitSig Sig
+}
+
+// A compile time check to ensure SpliceAck implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAck)(nil)
+
+// Encode serializes the target SpliceAck into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w, s.ChannelID, s.CommitSig)
+}
+
+// Decode deserializes the serialized SpliceAck stored in the passed io.Reader
+// into the target SpliceAck using the deserialization rules defined by the
+// passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &s.ChannelID, &s.CommitSig)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceAck on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) MsgType() MessageType {
+	return MsgSpliceAck
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a SpliceAck
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) MaxPayloadLength(uint32) uint32 {
+	// 32 + 64
+	return 96
+}
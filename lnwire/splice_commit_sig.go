@@ -0,0 +1,77 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SpliceCommitSig is sent along with each CommitSig of a channel that has
+// pending splices, once for each of them, right before the CommitSig itself.
+// It carries the signatures for the version of the new commitment that spends
+// the funding output created by the splice transaction, so the channel can be
+// used while the splice transaction confirms.
+type SpliceCommitSig struct {
+	// ChannelID is the channel the signatures apply to.
+	ChannelID ChannelID
+
+	// SpliceTxid is the txid of the splice transaction whose funding
+	// output is spent by the signed commitment.
+	SpliceTxid chainhash.Hash
+
+	// CommitSig is the signature for the receiver's new commitment
+	// transaction spending the funding output of the splice.
+	CommitSig Sig
+
+	// HtlcSigs is a signature for each HTLC output within the signed
+	// commitment, in the same order as within CommitSig.
+	HtlcSigs []Sig
+}
+
+// A compile time check to ensure SpliceCommitSig implements the
+// lnwire.Message interface.
+var _ Message = (*SpliceCommitSig)(nil)
+
+// Encode serializes the target SpliceCommitSig into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceCommitSig) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w,
+		s.ChannelID,
+		s.SpliceTxid[:],
+		s.CommitSig,
+		s.HtlcSigs,
+	)
+}
+
+// Decode deserializes the serialized SpliceCommitSig stored in the passed
+// io.Reader into the target SpliceCommitSig using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceCommitSig) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&s.ChannelID,
+		s.SpliceTxid[:],
+		&s.CommitSig,
+		&s.HtlcSigs,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceCommitSig on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceCommitSig) MsgType() MessageType {
+	return MsgSpliceCommitSig
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceCommitSig message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceCommitSig) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
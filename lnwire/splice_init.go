@@ -0,0 +1,153 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// SpliceInit is sent by the initiator of a splice once the channel is
+// quiescent. It carries the unsigned splice transaction, which spends the
+// current funding output of the channel along with any of the initiator's
+// wallet inputs, and creates the new funding output of the channel.
+type SpliceInit struct {
+	// ChannelID is the channel being spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount by which the initiator's balance
+	// within the channel changes as a result of the splice. A positive
+	// value denotes a splice in, while a negative value denotes a splice
+	// out.
+	FundingContribution btcutil.Amount
+
+	// FundingFeePerKw is the fee rate, in sat/kw, the initiator used to
+	// pay for the splice transaction. The fee is paid entirely by the
+	// initiator's wallet inputs, or by its channel balance for a splice
+	// out.
+	FundingFeePerKw uint32
+
+	// SpliceTx is the unsigned splice transaction.
+	SpliceTx *wire.MsgTx
+
+	// PrevTxs are the transactions containing the outputs spent by the
+	// initiator's wallet inputs, in the order the inputs appear within
+	// SpliceTx, excluding the current funding output of the channel. As
+	// with TxAddInput, the whole transactions are included, allowing the
+	// responder to verify the value and script of each input spent
+	// without trusting the initiator.
+	PrevTxs []*wire.MsgTx
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w io.Writer, pver uint32) error {
+	err := WriteElements(w,
+		s.ChannelID,
+		s.FundingContribution,
+		s.FundingFeePerKw,
+	)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := s.SpliceTx.Serialize(&b); err != nil {
+		return err
+	}
+	if b.Len() > 0xffff {
+		return fmt.Errorf("splice transaction too large: %v", b.Len())
+	}
+
+	err = WriteElements(w, uint16(b.Len()), b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if len(s.PrevTxs) > 0xffff {
+		return fmt.Errorf("too many previous transactions: %v",
+			len(s.PrevTxs))
+	}
+	if err := WriteElement(w, uint16(len(s.PrevTxs))); err != nil {
+		return err
+	}
+	for _, prevTx := range s.PrevTxs {
+		if err := WriteElement(w, prevTx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode deserializes the serialized SpliceInit stored in the passed
+// io.Reader into the target SpliceInit using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, pver uint32) error {
+	var txLen uint16
+	err := ReadElements(r,
+		&s.ChannelID,
+		&s.FundingContribution,
+		&s.FundingFeePerKw,
+		&txLen,
+	)
+	if err != nil {
+		return err
+	}
+
+	txBytes := make([]byte, txLen)
+	if err := ReadElement(r, txBytes); err != nil {
+		return err
+	}
+
+	s.SpliceTx = wire.NewMsgTx(2)
+	err = s.SpliceTx.Deserialize(bytes.NewReader(txBytes))
+	if err != nil {
+		return err
+	}
+
+	var numPrevTxs uint16
+	if err := ReadElement(r, &numPrevTxs); err != nil {
+		return err
+	}
+	if numPrevTxs == 0 {
+		s.PrevTxs = nil
+		return nil
+	}
+
+	s.PrevTxs = make([]*wire.MsgTx, numPrevTxs)
+	for i := range s.PrevTxs {
+		if err := ReadElement(r, &s.PrevTxs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceInit on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceInit message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,65 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SpliceLocked is sent by both parties once the splice transaction of a
+// channel has confirmed. After both parties have sent SpliceLocked, the
+// channel leaves its quiescent state and may be used to forward HTLCs again.
+type SpliceLocked struct {
+	// ChannelID is the channel that was spliced.
+	ChannelID ChannelID
+
+	// SpliceTxid is the txid of the confirmed splice transaction.
+	SpliceTxid chainhash.Hash
+}
+
+// NewSpliceLocked creates a new SpliceLocked message.
+func NewSpliceLocked(cid ChannelID, txid chainhash.Hash) *SpliceLocked {
+	return &SpliceLocked{
+		ChannelID:  cid,
+		SpliceTxid: txid,
+	}
+}
+
+// A compile time check to ensure SpliceLocked implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceLocked)(nil)
+
+// Encode serializes the target SpliceLocked into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w, s.ChannelID, s.SpliceTxid[:])
+}
+
+// Decode deserializes the serialized SpliceLocked stored in the passed
+// io.Reader into the target SpliceLocked using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &s.ChannelID, s.SpliceTxid[:])
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceLocked on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) MsgType() MessageType {
+	return MsgSpliceLocked
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceLocked message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) MaxPayloadLength(uint32) uint32 {
+	// 32 + 32
+	return 64
+}
@@ -0,0 +1,79 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// SpliceSigned is sent by the initiator of a splice in response to SpliceAck.
+// It carries the initiator's signature for the responder's new commitment
+// transaction, along with the initiator's signatures for the splice
+// transaction itself. With this message the responder holds everything
+// needed to assemble and broadcast the fully signed splice transaction.
+type SpliceSigned struct {
+	// ChannelID is the channel being spliced.
+	ChannelID ChannelID
+
+	// CommitSig is the initiator's signature for the responder's new
+	// commitment transaction.
+	CommitSig Sig
+
+	// SharedInputSig is the initiator's signature for the input of the
+	// splice transaction that spends the current funding output.
+	SharedInputSig Sig
+
+	// Witnesses are the witnesses for each of the initiator's wallet
+	// inputs, in the order the inputs appear within the splice
+	// transaction.
+	Witnesses []wire.TxWitness
+}
+
+// A compile time check to ensure SpliceSigned implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceSigned)(nil)
+
+// Encode serializes the target SpliceSigned into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) Encode(w io.Writer, pver uint32) error {
+	err := WriteElements(w, s.ChannelID, s.CommitSig, s.SharedInputSig)
+	if err != nil {
+		return err
+	}
+
+	return writeWitnesses(w, s.Witnesses)
+}
+
+// Decode deserializes the serialized SpliceSigned stored in the passed
+// io.Reader into the target SpliceSigned using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r, &s.ChannelID, &s.CommitSig, &s.SharedInputSig)
+	if err != nil {
+		return err
+	}
+
+	s.Witnesses, err = readWitnesses(r)
+	return err
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceSigned on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) MsgType() MessageType {
+	return MsgSpliceSigned
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceSigned message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,64 @@
+package lnwire
+
+import "io"
+
+// Stfu is sent by either party in order to bring a channel into a quiescent
+// state. Once both parties have sent Stfu, neither side will propose any new
+// updates to the channel until the operation that required quiescence, such
+// as a splice, has completed.
+type Stfu struct {
+	// ChannelID is the channel that should be made quiescent.
+	ChannelID ChannelID
+
+	// Initiator is true if the sender is the party requesting quiescence,
+	// and false if the message is sent in reply to the remote party's
+	// Stfu.
+	Initiator bool
+}
+
+// NewStfu creates a new Stfu message.
+func NewStfu(cid ChannelID, initiator bool) *Stfu {
+	return &Stfu{
+		ChannelID: cid,
+		Initiator: initiator,
+	}
+}
+
+// A compile time check to ensure Stfu implements the lnwire.Message
+// interface.
+var _ Message = (*Stfu)(nil)
+
+// Encode serializes the target Stfu into the passed io.Writer implementation.
+// Serialization will observe the rules defined by the passed protocol
+// version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w, s.ChannelID, s.Initiator)
+}
+
+// Decode deserializes the serialized Stfu stored in the passed io.Reader into
+// the target Stfu using the deserialization rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &s.ChannelID, &s.Initiator)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// Stfu on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MsgType() MessageType {
+	return MsgStfu
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a Stfu
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MaxPayloadLength(uint32) uint32 {
+	// 32 + 1
+	return 33
+}
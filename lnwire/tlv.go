@@ -0,0 +1,226 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// TLVType is the type of a record within a TLV stream. Following the "it's OK
+// to be odd" rule, records of an unknown even type must be rejected, while
+// records of an unknown odd type are ignored.
+type TLVType uint64
+
+// tlvRecords maps the type of each record within a TLV stream to its value.
+type tlvRecords map[TLVType][]byte
+
+// writeBigSize writes the passed integer in the variable length BigSize
+// encoding defined in BOLT-01.
+func writeBigSize(w io.Writer, val uint64) error {
+	var b [9]byte
+
+	var n int
+	switch {
+	case val < 0xfd:
+		b[0] = uint8(val)
+		n = 1
+
+	case val <= 0xffff:
+		b[0] = 0xfd
+		binary.BigEndian.PutUint16(b[1:3], uint16(val))
+		n = 3
+
+	case val <= 0xffffffff:
+		b[0] = 0xfe
+		binary.BigEndian.PutUint32(b[1:5], uint32(val))
+		n = 5
+
+	default:
+		b[0] = 0xff
+		binary.BigEndian.PutUint64(b[1:9], val)
+		n = 9
+	}
+
+	_, err := w.Write(b[:n])
+	return err
+}
+
+// readBigSize reads an integer in the variable length BigSize encoding
+// defined in BOLT-01. Integers that aren't minimally encoded are rejected.
+func readBigSize(r io.Reader) (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[:1]); err != nil {
+		return 0, err
+	}
+
+	var (
+		val uint64
+		min uint64
+	)
+	switch b[0] {
+	case 0xfd:
+		if _, err := io.ReadFull(r, b[:2]); err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		val = uint64(binary.BigEndian.Uint16(b[:2]))
+		min = 0xfd
+
+	case 0xfe:
+		if _, err := io.ReadFull(r, b[:4]); err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		val = uint64(binary.BigEndian.Uint32(b[:4]))
+		min = 0x10000
+
+	case 0xff:
+		if _, err := io.ReadFull(r, b[:8]); err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		val = binary.BigEndian.Uint64(b[:8])
+		min = 0x100000000
+
+	default:
+		return uint64(b[0]), nil
+	}
+
+	if val < min {
+		return 0, fmt.Errorf("non-canonical BigSize encoding of %v",
+			val)
+	}
+
+	return val, nil
+}
+
+// unexpectedEOF converts an io.EOF into an io.ErrUnexpectedEOF, as running out
+// of data in the middle of an element means the element is truncated.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// writeTLVStream writes the passed records as a TLV stream, ordered by their
+// type as required by BOLT-01.
+func writeTLVStream(w io.Writer, records tlvRecords) error {
+	types := make([]TLVType, 0, len(records))
+	for recordType := range records {
+		types = append(types, recordType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	for _, recordType := range types {
+		value := records[recordType]
+
+		if err := writeBigSize(w, uint64(recordType)); err != nil {
+			return err
+		}
+		if err := writeBigSize(w, uint64(len(value))); err != nil {
+			return err
+		}
+		if _, err := w.Write(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readTLVStream reads a TLV stream until the passed reader is exhausted. The
+// records must be strictly ordered by their type, and any record of an even
+// type not within knownTypes is rejected. Records of unknown odd types are
+// skipped.
+func readTLVStream(r io.Reader, knownTypes ...TLVType) (tlvRecords, error) {
+	known := make(map[TLVType]struct{}, len(knownTypes))
+	for _, recordType := range knownTypes {
+		known[recordType] = struct{}{}
+	}
+
+	records := make(tlvRecords)
+
+	var prevType *TLVType
+	for {
+		t, err := readBigSize(r)
+		switch {
+		// Running out of data in between records marks the end of the
+		// stream.
+		case err == io.EOF:
+			return records, nil
+
+		case err != nil:
+			return nil, err
+		}
+		recordType := TLVType(t)
+
+		if prevType != nil && recordType <= *prevType {
+			return nil, fmt.Errorf("TLV record of type %v isn't "+
+				"ordered after type %v", recordType, *prevType)
+		}
+		prevType = &recordType
+
+		length, err := readBigSize(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if length > MaxMessagePayload {
+			return nil, fmt.Errorf("TLV record of type %v has "+
+				"length %v exceeding the max payload",
+				recordType, length)
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+
+		if _, ok := known[recordType]; !ok {
+			if recordType%2 == 0 {
+				return nil, fmt.Errorf("unknown even TLV "+
+					"record of type %v", recordType)
+			}
+
+			continue
+		}
+
+		records[recordType] = value
+	}
+}
+
+// encodeFeatureRecord serializes the passed feature vector as the value of a
+// TLV record. Unlike the serialization within the init message, the value
+// isn't prefixed with its length, as it's already part of the record.
+func encodeFeatureRecord(fv *RawFeatureVector) ([]byte, error) {
+	var b bytes.Buffer
+	if err := fv.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes()[2:], nil
+}
+
+// decodeFeatureRecord parses the value of a TLV record holding a feature
+// vector.
+func decodeFeatureRecord(value []byte) (*RawFeatureVector, error) {
+	if len(value) > maxAllowedSize {
+		return nil, fmt.Errorf("feature vector of %v bytes exceeds "+
+			"the max size", len(value))
+	}
+
+	var b bytes.Buffer
+	var l [2]byte
+	binary.BigEndian.PutUint16(l[:], uint16(len(value)))
+	b.Write(l[:])
+	b.Write(value)
+
+	fv := NewRawFeatureVector()
+	if err := fv.Decode(&b); err != nil {
+		return nil, err
+	}
+
+	return fv, nil
+}
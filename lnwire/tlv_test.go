@@ -0,0 +1,115 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestBigSizeEncoding asserts that integers are encoded using the least
+// number of bytes, and that non-canonical encodings are rejected.
+func TestBigSizeEncoding(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val     uint64
+		encoded []byte
+	}{
+		{0, []byte{0x00}},
+		{0xfc, []byte{0xfc}},
+		{0xfd, []byte{0xfd, 0x00, 0xfd}},
+		{0xffff, []byte{0xfd, 0xff, 0xff}},
+		{0x10000, []byte{0xfe, 0x00, 0x01, 0x00, 0x00}},
+		{0xffffffff, []byte{0xfe, 0xff, 0xff, 0xff, 0xff}},
+		{
+			0x100000000,
+			[]byte{0xff, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
+				0x00},
+		},
+	}
+
+	for _, test := range testCases {
+		var b bytes.Buffer
+		if err := writeBigSize(&b, test.val); err != nil {
+			t.Fatalf("unable to encode %v: %v", test.val, err)
+		}
+		if !bytes.Equal(b.Bytes(), test.encoded) {
+			t.Fatalf("expected %v to be encoded as %x, got %x",
+				test.val, test.encoded, b.Bytes())
+		}
+
+		val, err := readBigSize(&b)
+		if err != nil {
+			t.Fatalf("unable to decode %x: %v", test.encoded, err)
+		}
+		if val != test.val {
+			t.Fatalf("expected %v, got %v", test.val, val)
+		}
+	}
+
+	// A value that fits into a single byte mustn't be encoded using the
+	// two byte form.
+	_, err := readBigSize(bytes.NewReader([]byte{0xfd, 0x00, 0xfc}))
+	if err == nil {
+		t.Fatalf("expected non-canonical encoding to be rejected")
+	}
+}
+
+// TestTLVStream asserts that records are written ordered by their type, and
+// that the "it's OK to be odd" rule is applied to unknown records when reading
+// a stream.
+func TestTLVStream(t *testing.T) {
+	t.Parallel()
+
+	records := tlvRecords{
+		3: []byte{0x03},
+		1: []byte{0x01, 0x01},
+	}
+
+	var b bytes.Buffer
+	if err := writeTLVStream(&b, records); err != nil {
+		t.Fatalf("unable to write stream: %v", err)
+	}
+	expected := []byte{0x01, 0x02, 0x01, 0x01, 0x03, 0x01, 0x03}
+	if !bytes.Equal(b.Bytes(), expected) {
+		t.Fatalf("expected stream %x, got %x", expected, b.Bytes())
+	}
+
+	// Records of an unknown odd type are skipped.
+	decoded, err := readTLVStream(bytes.NewReader(expected), 1)
+	if err != nil {
+		t.Fatalf("unable to read stream: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, tlvRecords{1: []byte{0x01, 0x01}}) {
+		t.Fatalf("unexpected records: %v", decoded)
+	}
+
+	testCases := []struct {
+		name   string
+		stream []byte
+	}{
+		{
+			name:   "unknown even type",
+			stream: []byte{0x02, 0x00},
+		},
+		{
+			name:   "unordered types",
+			stream: []byte{0x03, 0x00, 0x01, 0x00},
+		},
+		{
+			name:   "duplicate types",
+			stream: []byte{0x01, 0x00, 0x01, 0x00},
+		},
+		{
+			name:   "truncated value",
+			stream: []byte{0x01, 0x02, 0x00},
+		},
+	}
+
+	for _, test := range testCases {
+		_, err := readTLVStream(bytes.NewReader(test.stream), 1)
+		if err == nil {
+			t.Fatalf("%v: expected stream to be rejected", test.name)
+		}
+	}
+}
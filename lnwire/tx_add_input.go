@@ -0,0 +1,93 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TxAddInput is sent by either party during the interactive construction of
+// the funding transaction of a dual funded channel in order to contribute one
+// of their inputs. The whole transaction containing the output being spent is
+// included, as the value and script of the output are only committed to by
+// its txid. This allows the receiver to account for the sender's contribution
+// without trusting the sender, and to later verify the witness the sender
+// provides for the input within TxSignatures.
+type TxAddInput struct {
+	// ChannelID is the pending channel ID of the channel the funding
+	// transaction is being constructed for.
+	ChannelID ChannelID
+
+	// SerialID uniquely identifies this input amongst all inputs and
+	// outputs contributed by both parties.
+	SerialID uint64
+
+	// PrevTx is the transaction containing the output being spent.
+	PrevTx *wire.MsgTx
+
+	// PrevTxVout is the index of the output being spent within PrevTx.
+	PrevTxVout uint32
+
+	// Sequence is the sequence number to use for this input.
+	Sequence uint32
+}
+
+// PrevOutPoint returns the outpoint being spent by this input.
+func (t *TxAddInput) PrevOutPoint() wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  t.PrevTx.TxHash(),
+		Index: t.PrevTxVout,
+	}
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w,
+		t.ChannelID,
+		t.SerialID,
+		t.PrevTx,
+		t.PrevTxVout,
+		t.Sequence,
+	)
+}
+
+// Decode deserializes the serialized TxAddInput stored in the passed
+// io.Reader into the target TxAddInput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&t.ChannelID,
+		&t.SerialID,
+		&t.PrevTx,
+		&t.PrevTxVout,
+		&t.Sequence,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxAddInput message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MaxPayloadLength(uint32) uint32 {
+	// As the previous transaction may be arbitrarily large, the message
+	// is only bounded by the max message payload.
+	return MaxMessagePayload
+}
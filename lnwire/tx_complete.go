@@ -0,0 +1,52 @@
+package lnwire
+
+import "io"
+
+// TxComplete is sent by either party during the interactive construction of
+// the funding transaction of a dual funded channel to signal that they've
+// added all of their inputs and outputs. Once both parties have sent
+// TxComplete, the funding transaction is fully specified.
+type TxComplete struct {
+	// ChannelID is the pending channel ID of the channel the funding
+	// transaction is being constructed for.
+	ChannelID ChannelID
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.Message
+// interface.
+var _ Message = (*TxComplete)(nil)
+
+// Encode serializes the target TxComplete into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Encode(w io.Writer, pver uint32) error {
+	return WriteElement(w, t.ChannelID)
+}
+
+// Decode deserializes the serialized TxComplete stored in the passed
+// io.Reader into the target TxComplete using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Decode(r io.Reader, pver uint32) error {
+	return ReadElement(r, &t.ChannelID)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxComplete on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MsgType() MessageType {
+	return MsgTxComplete
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxComplete message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MaxPayloadLength(uint32) uint32 {
+	// 32
+	return 32
+}
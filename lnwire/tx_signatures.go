@@ -0,0 +1,140 @@
+package lnwire
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TxSignatures is sent by both parties once the commitment signatures for a
+// dual funded channel have been exchanged. It carries the witnesses for all
+// of the sender's inputs to the funding transaction, allowing the receiver to
+// assemble the fully signed transaction.
+type TxSignatures struct {
+	// ChannelID is the pending channel ID of the channel the funding
+	// transaction was constructed for.
+	ChannelID ChannelID
+
+	// TxHash is the txid of the funding transaction being signed.
+	TxHash chainhash.Hash
+
+	// Witnesses are the witnesses for each of the sender's inputs, in the
+	// order the inputs appear within the funding transaction.
+	Witnesses []wire.TxWitness
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w io.Writer, pver uint32) error {
+	if err := WriteElements(w, t.ChannelID, t.TxHash[:]); err != nil {
+		return err
+	}
+
+	return writeWitnesses(w, t.Witnesses)
+}
+
+// Decode deserializes the serialized TxSignatures stored in the passed
+// io.Reader into the target TxSignatures using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r, &t.ChannelID, t.TxHash[:])
+	if err != nil {
+		return err
+	}
+
+	t.Witnesses, err = readWitnesses(r)
+	return err
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxSignatures message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
+
+// writeWitnesses serializes the passed set of witnesses, prefixing both the
+// set itself and each witness element with its length.
+func writeWitnesses(w io.Writer, witnesses []wire.TxWitness) error {
+	if len(witnesses) > 0xffff {
+		return fmt.Errorf("too many witnesses: %v", len(witnesses))
+	}
+	if err := WriteElement(w, uint16(len(witnesses))); err != nil {
+		return err
+	}
+	for _, witness := range witnesses {
+		if len(witness) > 0xffff {
+			return fmt.Errorf("too many witness elements: %v",
+				len(witness))
+		}
+		err := WriteElement(w, uint16(len(witness)))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range witness {
+			if len(item) > 0xffff {
+				return fmt.Errorf("witness element too "+
+					"long: %v", len(item))
+			}
+			err := WriteElements(w, uint16(len(item)), item)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readWitnesses deserializes a set of witnesses written by writeWitnesses.
+func readWitnesses(r io.Reader) ([]wire.TxWitness, error) {
+	var numWitnesses uint16
+	if err := ReadElement(r, &numWitnesses); err != nil {
+		return nil, err
+	}
+
+	witnesses := make([]wire.TxWitness, numWitnesses)
+	for i := range witnesses {
+		var numItems uint16
+		if err := ReadElement(r, &numItems); err != nil {
+			return nil, err
+		}
+
+		witness := make(wire.TxWitness, numItems)
+		for j := range witness {
+			var itemLen uint16
+			if err := ReadElement(r, &itemLen); err != nil {
+				return nil, err
+			}
+
+			witness[j] = make([]byte, itemLen)
+			if err := ReadElement(r, witness[j]); err != nil {
+				return nil, err
+			}
+		}
+		witnesses[i] = witness
+	}
+
+	return witnesses, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/wakiyamap/lnd/chanbackup"
 	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/channelnotifier"
+	"github.com/wakiyamap/lnd/cluster"
 	"github.com/wakiyamap/lnd/contractcourt"
 	"github.com/wakiyamap/lnd/discovery"
 	"github.com/wakiyamap/lnd/htlcswitch"
@@ -26,11 +27,14 @@ import (
 	"github.com/wakiyamap/lnd/lnrpc/invoicesrpc"
 	"github.com/wakiyamap/lnd/lnrpc/routerrpc"
 	"github.com/wakiyamap/lnd/lnrpc/signrpc"
+	"github.com/wakiyamap/lnd/lnrpc/swaprpc"
 	"github.com/wakiyamap/lnd/lnrpc/walletrpc"
 	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/lnwallet/btcwallet"
 	"github.com/wakiyamap/lnd/netann"
 	"github.com/wakiyamap/lnd/routing"
 	"github.com/wakiyamap/lnd/signal"
+	"github.com/wakiyamap/lnd/swap"
 	"github.com/wakiyamap/lnd/sweep"
 	"github.com/wakiyamap/lnd/watchtower"
 )
@@ -66,7 +70,6 @@ var (
 	chdbLog = build.NewSubLogger("CHDB", backendLog.Logger)
 	fndgLog = build.NewSubLogger("FNDG", backendLog.Logger)
 	hswcLog = build.NewSubLogger("HSWC", backendLog.Logger)
-	utxnLog = build.NewSubLogger("UTXN", backendLog.Logger)
 	brarLog = build.NewSubLogger("BRAR", backendLog.Logger)
 	cmgrLog = build.NewSubLogger("CMGR", backendLog.Logger)
 	crtrLog = build.NewSubLogger("CRTR", backendLog.Logger)
@@ -90,6 +93,7 @@ var (
 // Initialize package-global logger variables.
 func init() {
 	lnwallet.UseLogger(lnwlLog)
+	btcwallet.UseLogger(lnwlLog)
 	discovery.UseLogger(discLog)
 	chainntnfs.UseLogger(ntfnLog)
 	channeldb.UseLogger(chdbLog)
@@ -114,6 +118,9 @@ func init() {
 	chanbackup.UseLogger(chbuLog)
 
 	addSubLogger(routerrpc.Subsystem, routerrpc.UseLogger)
+	addSubLogger(cluster.Subsystem, cluster.UseLogger)
+	addSubLogger(swap.Subsystem, swap.UseLogger)
+	addSubLogger(swaprpc.Subsystem, swaprpc.UseLogger)
 }
 
 // addSubLogger is a helper method to conveniently register the logger of a sub
@@ -136,7 +143,6 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"CHDB": chdbLog,
 	"FNDG": fndgLog,
 	"HSWC": hswcLog,
-	"UTXN": utxnLog,
 	"BRAR": brarLog,
 	"CMGR": cmgrLog,
 	"CRTR": crtrLog,
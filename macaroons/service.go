@@ -6,7 +6,7 @@ import (
 	"os"
 	"path"
 
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
@@ -49,9 +49,7 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 
 	// Open the database that we'll use to store the primary macaroon key,
 	// and all generated macaroons+caveats.
-	macaroonDB, err := bbolt.Open(
-		path.Join(dir, DBFilename), 0600, bbolt.DefaultOptions,
-	)
+	macaroonDB, err := kvdb.OpenBolt(path.Join(dir, DBFilename))
 	if err != nil {
 		return nil, err
 	}
@@ -198,3 +196,9 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// GenerateNewRootKey calls the underlying root key store's GenerateNewRootKey
+// and returns the result.
+func (svc *Service) GenerateNewRootKey() error {
+	return svc.rks.GenerateNewRootKey()
+}
@@ -7,7 +7,7 @@ import (
 
 	"golang.org/x/net/context"
 
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 
 	"github.com/btcsuite/btcwallet/snacl"
 )
@@ -46,16 +46,16 @@ var (
 
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
 type RootKeyStorage struct {
-	*bbolt.DB
+	kvdb.Backend
 
 	encKey *snacl.SecretKey
 }
 
 // NewRootKeyStorage creates a RootKeyStorage instance.
 // TODO(aakselrod): Add support for encryption of data with passphrase.
-func NewRootKeyStorage(db *bbolt.DB) (*RootKeyStorage, error) {
+func NewRootKeyStorage(db kvdb.Backend) (*RootKeyStorage, error) {
 	// If the store's bucket doesn't exist, create it.
-	err := db.Update(func(tx *bbolt.Tx) error {
+	err := db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(rootKeyBucketName)
 		return err
 	})
@@ -80,7 +80,7 @@ func (r *RootKeyStorage) CreateUnlock(password *[]byte) error {
 		return ErrPasswordRequired
 	}
 
-	return r.Update(func(tx *bbolt.Tx) error {
+	return r.Update(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(rootKeyBucketName)
 		dbKey := bucket.Get(encryptedKeyID)
 		if len(dbKey) > 0 {
@@ -124,7 +124,7 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 		return nil, ErrStoreLocked
 	}
 	var rootKey []byte
-	err := r.View(func(tx *bbolt.Tx) error {
+	err := r.View(func(tx kvdb.Tx) error {
 		dbKey := tx.Bucket(rootKeyBucketName).Get(id)
 		if len(dbKey) == 0 {
 			return fmt.Errorf("root key with id %s doesn't exist",
@@ -156,7 +156,7 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	}
 	var rootKey []byte
 	id := defaultRootKeyID
-	err := r.Update(func(tx *bbolt.Tx) error {
+	err := r.Update(func(tx kvdb.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
 
@@ -193,11 +193,35 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// GenerateNewRootKey replaces the default root key with a freshly generated
+// one. As all macaroons are baked with the default root key, this invalidates
+// every macaroon that was created so far.
+func (r *RootKeyStorage) GenerateNewRootKey() error {
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	rootKey := make([]byte, RootKeyLen)
+	if _, err := io.ReadFull(rand.Reader, rootKey[:]); err != nil {
+		return err
+	}
+
+	encKey, err := r.encKey.Encrypt(rootKey)
+	if err != nil {
+		return err
+	}
+
+	return r.Update(func(tx kvdb.Tx) error {
+		ns := tx.Bucket(rootKeyBucketName)
+		return ns.Put(defaultRootKeyID, encKey)
+	})
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
 	if r.encKey != nil {
 		r.encKey.Zero()
 	}
-	return r.DB.Close()
+	return r.Backend.Close()
 }
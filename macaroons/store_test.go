@@ -7,7 +7,7 @@ import (
 	"path"
 	"testing"
 
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 
 	"github.com/wakiyamap/lnd/macaroons"
 
@@ -21,8 +21,7 @@ func TestStore(t *testing.T) {
 	}
 	defer os.RemoveAll(tempDir)
 
-	db, err := bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
-		bbolt.DefaultOptions)
+	db, err := kvdb.OpenBolt(path.Join(tempDir, "weks.db"))
 	if err != nil {
 		t.Fatalf("Error opening store DB: %v", err)
 	}
@@ -75,8 +74,7 @@ func TestStore(t *testing.T) {
 	// Between here and the re-opening of the store, it's possible to get
 	// a double-close, but that's not such a big deal since the tests will
 	// fail anyway in that case.
-	db, err = bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
-		bbolt.DefaultOptions)
+	db, err = kvdb.OpenBolt(path.Join(tempDir, "weks.db"))
 	if err != nil {
 		t.Fatalf("Error opening store DB: %v", err)
 	}
@@ -135,3 +133,68 @@ func TestStore(t *testing.T) {
 			rootID, id)
 	}
 }
+
+// TestStoreGenerateNewRootKey tests that generating a new root key replaces
+// the default root key.
+func TestStoreGenerateNewRootKey(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := kvdb.OpenBolt(path.Join(tempDir, "weks.db"))
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+	defer store.Close()
+
+	err = store.GenerateNewRootKey()
+	if err != macaroons.ErrStoreLocked {
+		t.Fatalf("Received %v instead of ErrStoreLocked", err)
+	}
+
+	pw := []byte("weks")
+	err = store.CreateUnlock(&pw)
+	if err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	oldKey, oldID, err := store.RootKey(nil)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	err = store.GenerateNewRootKey()
+	if err != nil {
+		t.Fatalf("Error generating new root key: %v", err)
+	}
+
+	newKey, newID, err := store.RootKey(nil)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(oldID, newID) {
+		t.Fatalf("Root ID doesn't match: expected %v, got %v",
+			oldID, newID)
+	}
+	if bytes.Equal(oldKey, newKey) {
+		t.Fatalf("Root key wasn't replaced: %v", newKey)
+	}
+
+	key, err := store.Get(nil, newID)
+	if err != nil {
+		t.Fatalf("Error getting key with ID %s: %v", string(newID),
+			err)
+	}
+	if !bytes.Equal(key, newKey) {
+		t.Fatalf("Root key doesn't match: expected %v, got %v",
+			newKey, key)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 
 	"github.com/wakiyamap/lnd/chainntnfs"
@@ -18,6 +19,8 @@ import (
 	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lntypes"
 	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/lnwallet/btcwallet"
+	"github.com/wakiyamap/lnd/psbt"
 )
 
 // The block height returned by the mock BlockChainIO's GetBestBlock.
@@ -87,6 +90,7 @@ func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
 
 type mockNotfier struct {
 	confChannel chan *chainntnfs.TxConfirmation
+	epochChan   chan *chainntnfs.BlockEpoch
 }
 
 func (m *mockNotfier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
@@ -97,8 +101,14 @@ func (m *mockNotfier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 }
 func (m *mockNotfier) RegisterBlockEpochNtfn(
 	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	epochChan := m.epochChan
+	if epochChan == nil {
+		epochChan = make(chan *chainntnfs.BlockEpoch)
+	}
+
 	return &chainntnfs.BlockEpochEvent{
-		Epochs: make(chan *chainntnfs.BlockEpoch),
+		Epochs: epochChan,
 		Cancel: func() {},
 	}, nil
 }
@@ -131,6 +141,7 @@ func makeMockSpendNotifier() *mockSpendNotifier {
 	return &mockSpendNotifier{
 		mockNotfier: &mockNotfier{
 			confChannel: make(chan *chainntnfs.TxConfirmation),
+			epochChan:   make(chan *chainntnfs.BlockEpoch),
 		},
 		spendMap: make(map[wire.OutPoint][]chan *chainntnfs.SpendDetail),
 		spends:   make(map[wire.OutPoint]*chainntnfs.SpendDetail),
@@ -203,14 +214,21 @@ func (m *mockSpendNotifier) Spend(outpoint *wire.OutPoint, height int32,
 
 type mockChainIO struct {
 	bestHeight int32
+
+	// spentOutpoints are the outputs GetUtxo reports as spent.
+	spentOutpoints map[wire.OutPoint]struct{}
 }
 
 func (m *mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
 	return activeNetParams.GenesisHash, m.bestHeight, nil
 }
 
-func (*mockChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
+func (m *mockChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
 	heightHint uint32) (*wire.TxOut, error) {
+
+	if _, ok := m.spentOutpoints[*op]; ok {
+		return nil, btcwallet.ErrOutputSpent
+	}
 	return nil, nil
 }
 
@@ -229,6 +247,24 @@ type mockWalletController struct {
 	prevAddres            btcutil.Address
 	publishedTransactions chan *wire.MsgTx
 	index                 uint32
+
+	// utxoHash is spent by the transactions creating the outputs returned
+	// by ListUnspentWitness, allowing multiple wallets to hand out
+	// distinct outputs.
+	utxoHash chainhash.Hash
+
+	utxosMtx sync.Mutex
+	utxos    map[wire.OutPoint]*wire.TxOut
+	txs      map[chainhash.Hash]*wire.MsgTx
+}
+
+// witnessScript returns the P2WKH script of the wallet's root key, which all
+// of the wallet's outputs pay to.
+func (m *mockWalletController) witnessScript() []byte {
+	pubKeyHash := btcutil.Hash160(m.rootKey.PubKey().SerializeCompressed())
+	script, _ := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pubKeyHash).Script()
+	return script
 }
 
 // BackEnd returns "mock" to signify a mock wallet controller.
@@ -237,15 +273,35 @@ func (*mockWalletController) BackEnd() string {
 }
 
 // FetchInputInfo will be called to get info about the inputs to the funding
-// transaction.
-func (*mockWalletController) FetchInputInfo(
+// transaction. Only outputs previously returned by ListUnspentWitness belong
+// to the wallet.
+func (m *mockWalletController) FetchInputInfo(
 	prevOut *wire.OutPoint) (*wire.TxOut, error) {
-	txOut := &wire.TxOut{
-		Value:    int64(10 * btcutil.SatoshiPerBitcoin),
-		PkScript: []byte("dummy"),
+
+	m.utxosMtx.Lock()
+	defer m.utxosMtx.Unlock()
+
+	txOut, ok := m.utxos[*prevOut]
+	if !ok {
+		return nil, lnwallet.ErrNotMine
 	}
 	return txOut, nil
 }
+
+// FetchTx returns the transactions creating the outputs previously returned by
+// ListUnspentWitness.
+func (m *mockWalletController) FetchTx(txid chainhash.Hash) (*wire.MsgTx,
+	error) {
+
+	m.utxosMtx.Lock()
+	defer m.utxosMtx.Unlock()
+
+	tx, ok := m.txs[txid]
+	if !ok {
+		return nil, lnwallet.ErrNotMine
+	}
+	return tx, nil
+}
 func (*mockWalletController) ConfirmedBalance(confs int32) (btcutil.Amount, error) {
 	return 0, nil
 }
@@ -253,10 +309,17 @@ func (*mockWalletController) ConfirmedBalance(confs int32) (btcutil.Amount, erro
 // NewAddress is called to get new addresses for delivery, change etc.
 func (m *mockWalletController) NewAddress(addrType lnwallet.AddressType,
 	change bool) (btcutil.Address, error) {
-	addr, _ := btcutil.NewAddressPubKey(
-		m.rootKey.PubKey().SerializeCompressed(), &chaincfg.MainNetParams)
+	addr, _ := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(m.rootKey.PubKey().SerializeCompressed()),
+		&chaincfg.MainNetParams,
+	)
 	return addr, nil
 }
+func (m *mockWalletController) NewAccountAddress(account string,
+	addrType lnwallet.AddressType, change bool) (btcutil.Address, error) {
+
+	return m.NewAddress(addrType, change)
+}
 func (*mockWalletController) LastUnusedAddress(addrType lnwallet.AddressType) (
 	btcutil.Address, error) {
 	return nil, nil
@@ -272,6 +335,12 @@ func (*mockWalletController) SendOutputs(outputs []*wire.TxOut,
 	return nil, nil
 }
 
+func (*mockWalletController) SendOutputsFromAccount(account string,
+	outputs []*wire.TxOut, _ lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+	return nil, nil
+}
+
 func (*mockWalletController) CreateSimpleTx(outputs []*wire.TxOut,
 	_ lnwallet.SatPerKWeight, _ bool) (*txauthor.AuthoredTx, error) {
 
@@ -282,16 +351,39 @@ func (*mockWalletController) CreateSimpleTx(outputs []*wire.TxOut,
 // need one unspent for the funding transaction.
 func (m *mockWalletController) ListUnspentWitness(minconfirms,
 	maxconfirms int32) ([]*lnwallet.Utxo, error) {
+
+	// Each output is created by a distinct transaction, which is made
+	// unique by the outpoint it spends.
+	prevOut := wire.OutPoint{
+		Hash:  m.utxoHash,
+		Index: atomic.AddUint32(&m.index, 1) - 1,
+	}
+	txOut := &wire.TxOut{
+		Value:    10 * btcutil.SatoshiPerBitcoin,
+		PkScript: m.witnessScript(),
+	}
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&prevOut, nil, nil))
+	tx.AddTxOut(txOut)
+
 	utxo := &lnwallet.Utxo{
 		AddressType: lnwallet.WitnessPubKey,
-		Value:       btcutil.Amount(10 * btcutil.SatoshiPerBitcoin),
-		PkScript:    make([]byte, 22),
+		Value:       btcutil.Amount(txOut.Value),
+		PkScript:    txOut.PkScript,
 		OutPoint: wire.OutPoint{
-			Hash:  chainhash.Hash{},
-			Index: m.index,
+			Hash:  tx.TxHash(),
+			Index: 0,
 		},
 	}
-	atomic.AddUint32(&m.index, 1)
+
+	m.utxosMtx.Lock()
+	if m.utxos == nil {
+		m.utxos = make(map[wire.OutPoint]*wire.TxOut)
+		m.txs = make(map[chainhash.Hash]*wire.MsgTx)
+	}
+	m.utxos[utxo.OutPoint] = txOut
+	m.txs[utxo.OutPoint.Hash] = tx
+	m.utxosMtx.Unlock()
 	var ret []*lnwallet.Utxo
 	ret = append(ret, utxo)
 	return ret, nil
@@ -299,6 +391,48 @@ func (m *mockWalletController) ListUnspentWitness(minconfirms,
 func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDetail, error) {
 	return nil, nil
 }
+func (*mockWalletController) ListAccounts() ([]*lnwallet.WalletAccount,
+	error) {
+
+	return []*lnwallet.WalletAccount{
+		{Name: lnwallet.DefaultAccountName},
+	}, nil
+}
+func (*mockWalletController) CreateAccount(
+	name string) (*lnwallet.WalletAccount, error) {
+
+	return nil, nil
+}
+func (*mockWalletController) IsWatchOnly() bool {
+	return false
+}
+func (*mockWalletController) ExportWatchOnly() ([]byte, error) {
+	return nil, nil
+}
+func (*mockWalletController) ImportAccount(name string,
+	_ *hdkeychain.ExtendedKey, _ uint32, _ lnwallet.AddressType,
+	_ uint32) (*lnwallet.WalletAccount, error) {
+
+	return nil, nil
+}
+func (*mockWalletController) ImportPublicKey(_ *btcec.PublicKey,
+	_ lnwallet.AddressType, _ uint32) error {
+
+	return nil
+}
+func (*mockWalletController) ImportAddress(_ btcutil.Address, _ uint32) error {
+	return nil
+}
+func (*mockWalletController) ListWatchOnlyUnspent(minconfirms,
+	maxconfirms int32) ([]*lnwallet.Utxo, error) {
+
+	return nil, nil
+}
+func (*mockWalletController) WatchOnlyDerivation(
+	pkScript []byte) (*psbt.Bip32Derivation, error) {
+
+	return nil, nil
+}
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
 func (*mockWalletController) UnlockOutpoint(o wire.OutPoint) {}
 func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/netann"
 )
@@ -317,13 +318,16 @@ func newManagerCfg(t *testing.T, numChannels int,
 		t, numChannels, startEnabled, startEnabled, privKey.PubKey(),
 	)
 	htlcSwitch := newMockSwitch()
+	nodeSigner := netann.NewNodeSigner(
+		keychain.NewPrivKeyMessageSigner(privKey),
+	)
 
 	cfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: 50 * time.Millisecond,
 		ChanEnableTimeout:        500 * time.Millisecond,
 		ChanDisableTimeout:       time.Second,
 		OurPubKey:                privKey.PubKey(),
-		MessageSigner:            netann.NewNodeSigner(privKey),
+		MessageSigner:            nodeSigner,
 		IsChannelActive:          htlcSwitch.HasActiveLink,
 		ApplyChannelUpdate:       graph.ApplyChannelUpdate,
 		DB:                       graph,
@@ -48,6 +48,10 @@ const (
 	// peer.
 	readMessageTimeout = 5 * time.Second
 
+	// maxWriteBatchSize is the maximum number of queued messages that are
+	// written to the wire in a single batch.
+	maxWriteBatchSize = 64
+
 	// handshakeTimeout is the timeout used when waiting for peer init message.
 	handshakeTimeout = 15 * time.Second
 
@@ -75,13 +79,29 @@ type newChannelMsg struct {
 }
 
 // closeMsgs is a wrapper struct around any wire messages that deal with the
-// cooperative channel closure negotiation process. This struct includes the
-// raw channel ID targeted along with the original message.
+// cooperative channel closure or splice negotiation process. This struct
+// includes the raw channel ID targeted along with the original message.
 type closeMsg struct {
 	cid lnwire.ChannelID
 	msg lnwire.Message
 }
 
+// spliceLock tracks which parties have sent SpliceLocked for a channel with
+// pending splices. Once both have done so for the same splice, it's locked in.
+type spliceLock struct {
+	// localTxid is the splice transaction that has confirmed, which we've
+	// sent SpliceLocked for.
+	localTxid *chainhash.Hash
+
+	// remoteTxid is the splice transaction the remote party has sent
+	// SpliceLocked for.
+	remoteTxid *chainhash.Hash
+
+	// quit is closed once the channel no longer has pending splices to
+	// lock in.
+	quit chan struct{}
+}
+
 // chanSnapshotReq is a message sent by outside subsystems to a peer in order
 // to gain a snapshot of the peer's currently active channels.
 type chanSnapshotReq struct {
@@ -185,6 +205,32 @@ type peer struct {
 	// well as lnwire.ClosingSigned messages.
 	chanCloseMsgs chan *closeMsg
 
+	// spliceMtx protects access to the activeSplices and spliceLocks
+	// maps.
+	spliceMtx sync.Mutex
+
+	// activeSplices is a map that keeps track of all the active splice
+	// negotiations. Any splice related messages are directed to one of
+	// these state machines. Once the splice has been signed, the state
+	// machine will be deleted from the map.
+	activeSplices map[lnwire.ChannelID]*channelSplicer
+
+	// spliceLocks tracks the channels with a signed splice that has yet to
+	// be locked in by both parties. The links of these channels will only
+	// be added back to the switch once both parties have sent
+	// SpliceLocked.
+	spliceLocks map[lnwire.ChannelID]*spliceLock
+
+	// localSpliceReqs is a channel in which any local requests to splice
+	// a particular channel are sent over.
+	localSpliceReqs chan *spliceRequest
+
+	// chanSpliceMsgs is a channel that any message related to the splice
+	// negotiation are sent over. This includes lnwire.Stfu,
+	// lnwire.SpliceInit, lnwire.SpliceAck and lnwire.SpliceSigned
+	// messages.
+	chanSpliceMsgs chan *closeMsg
+
 	// chanActiveTimeout specifies the duration the peer will wait to
 	// request a channel reenable, beginning from the time the peer was
 	// started.
@@ -274,6 +320,11 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		chanCloseMsgs:      make(chan *closeMsg),
 		failedChannels:     make(map[lnwire.ChannelID]struct{}),
 
+		activeSplices:   make(map[lnwire.ChannelID]*channelSplicer),
+		spliceLocks:     make(map[lnwire.ChannelID]*spliceLock),
+		localSpliceReqs: make(chan *spliceRequest),
+		chanSpliceMsgs:  make(chan *closeMsg),
+
 		chanActiveTimeout: chanActiveTimeout,
 
 		writePool: server.writePool,
@@ -364,7 +415,8 @@ func (p *peer) Start() error {
 	peerLog.Debugf("Loaded %v active channels from database with "+
 		"NodeKey(%x)", len(activeChans), p.PubKey())
 
-	if err := p.loadActiveChannels(activeChans); err != nil {
+	spliceLockedMsgs, err := p.loadActiveChannels(activeChans)
+	if err != nil {
 		return fmt.Errorf("unable to load channels: %v", err)
 	}
 
@@ -373,6 +425,14 @@ func (p *peer) Start() error {
 	p.wg.Add(5)
 	go p.queueHandler()
 	go p.writeHandler()
+
+	// We'll resend our SpliceLocked messages before reading any messages
+	// of the remote party, so it's able to lock in the splices before
+	// resyncing the channels.
+	for _, msg := range spliceLockedMsgs {
+		p.queueMsg(msg, nil)
+	}
+
 	go p.readHandler()
 	go p.channelManager()
 	go p.pingHandler()
@@ -424,16 +484,29 @@ func (p *peer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
 
+// RemoteLocalFeatures returns the set of connection-local features that the
+// remote peer advertised within its init message.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return p.remoteLocalFeatures
+}
+
 // loadActiveChannels creates indexes within the peer for tracking all active
-// channels returned by the database.
-func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
+// channels returned by the database. The SpliceLocked messages for the latest
+// splices of the channels are returned, which are to be resent once the peer
+// is able to send messages.
+func (p *peer) loadActiveChannels(
+	chans []*channeldb.OpenChannel) ([]lnwire.Message, error) {
+
+	var spliceLockedMsgs []lnwire.Message
 	for _, dbChan := range chans {
 		lnChan, err := lnwallet.NewLightningChannel(
 			p.server.cc.signer, p.server.witnessBeacon, dbChan,
 			p.server.sigPool,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		chanPoint := &dbChan.FundingOutpoint
@@ -464,58 +537,28 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 			continue
 		}
 
-		_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
-		if err != nil {
-			return err
+		// If the channel has been spliced, the remote party may have
+		// missed our SpliceLocked for the latest splice, so we'll
+		// resend it before any of the link's messages.
+		fundingOutpoint := dbChan.CurrentFundingOutpoint()
+		if fundingOutpoint != *chanPoint {
+			spliceLockedMsgs = append(
+				spliceLockedMsgs, lnwire.NewSpliceLocked(
+					chanID, fundingOutpoint.Hash,
+				),
+			)
 		}
 
-		// Before we register this new link with the HTLC Switch, we'll
-		// need to fetch its current link-layer forwarding policy from
-		// the database.
-		graph := p.server.chanDB.ChannelGraph()
-		info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
-		if err != nil && err != channeldb.ErrEdgeNotFound {
-			return err
+		_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
+		if err != nil {
+			return nil, err
 		}
 
-		// We'll filter out our policy from the directional channel
-		// edges based whom the edge connects to. If it doesn't connect
-		// to us, then we know that we were the one that advertised the
-		// policy.
-		//
-		// TODO(roasbeef): can add helper method to get policy for
-		// particular channel.
-		var selfPolicy *channeldb.ChannelEdgePolicy
-		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.server.identityPriv.PubKey().SerializeCompressed()) {
-
-			selfPolicy = p1
-		} else {
-			selfPolicy = p2
-		}
-
-		// If we don't yet have an advertised routing policy, then
-		// we'll use the current default, otherwise we'll translate the
-		// routing policy into a forwarding policy.
-		var forwardingPolicy *htlcswitch.ForwardingPolicy
-		if selfPolicy != nil {
-			forwardingPolicy = &htlcswitch.ForwardingPolicy{
-				MinHTLC:       selfPolicy.MinHTLC,
-				MaxHTLC:       selfPolicy.MaxHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
-			}
-		} else {
-			peerLog.Warnf("Unable to find our forwarding policy "+
-				"for channel %v, using default values",
-				chanPoint)
-			forwardingPolicy = &p.server.cc.routingPolicy
+		forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+		if err != nil {
+			return nil, err
 		}
 
-		peerLog.Tracef("Using link policy of: %v",
-			spew.Sdump(forwardingPolicy))
-
 		// Register this new channel link with the HTLC Switch. This is
 		// necessary to properly route multi-hop payments, and forward
 		// new payments triggered by RPC clients.
@@ -523,7 +566,7 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 			*chanPoint,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Create the link and add it to the switch.
@@ -532,16 +575,75 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 			currentHeight, true,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to add link %v to switch: %v",
-				chanPoint, err)
+			return nil, fmt.Errorf("unable to add link %v to "+
+				"switch: %v", chanPoint, err)
 		}
 
 		p.activeChanMtx.Lock()
 		p.activeChannels[chanID] = lnChan
 		p.activeChanMtx.Unlock()
+
+		// If the channel has pending splices, we'll wait for one of
+		// them to lock in.
+		if len(dbChan.PendingSplices()) != 0 {
+			p.waitForSpliceLock(*chanPoint)
+		}
 	}
 
-	return nil
+	return spliceLockedMsgs, nil
+}
+
+// fetchForwardingPolicy fetches the current link-layer forwarding policy of the
+// target channel from the database. This is needed before its link can be
+// registered with the HTLC Switch.
+func (p *peer) fetchForwardingPolicy(
+	chanPoint *wire.OutPoint) (*htlcswitch.ForwardingPolicy, error) {
+
+	graph := p.server.chanDB.ChannelGraph()
+	info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return nil, err
+	}
+
+	// We'll filter out our policy from the directional channel
+	// edges based whom the edge connects to. If it doesn't connect
+	// to us, then we know that we were the one that advertised the
+	// policy.
+	//
+	// TODO(roasbeef): can add helper method to get policy for
+	// particular channel.
+	var selfPolicy *channeldb.ChannelEdgePolicy
+	if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
+		p.server.identityECDH.PubKey().SerializeCompressed()) {
+
+		selfPolicy = p1
+	} else {
+		selfPolicy = p2
+	}
+
+	// If we don't yet have an advertised routing policy, then
+	// we'll use the current default, otherwise we'll translate the
+	// routing policy into a forwarding policy.
+	var forwardingPolicy *htlcswitch.ForwardingPolicy
+	if selfPolicy != nil {
+		forwardingPolicy = &htlcswitch.ForwardingPolicy{
+			MinHTLC:       selfPolicy.MinHTLC,
+			MaxHTLC:       selfPolicy.MaxHTLC,
+			BaseFee:       selfPolicy.FeeBaseMSat,
+			FeeRate:       selfPolicy.FeeProportionalMillionths,
+			TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+		}
+	} else {
+		peerLog.Warnf("Unable to find our forwarding policy "+
+			"for channel %v, using default values",
+			chanPoint)
+		forwardingPolicy = &p.server.cc.routingPolicy
+	}
+
+	peerLog.Tracef("Using link policy of: %v",
+		spew.Sdump(forwardingPolicy))
+
+	return forwardingPolicy, nil
 }
 
 // addLink creates and adds a new link from the specified channel.
@@ -875,13 +977,10 @@ func (ms *msgStream) AddMsg(msg lnwire.Message) {
 // newChanMsgStream is used to create a msgStream between the peer and
 // particular channel link in the htlcswitch.  We utilize additional
 // synchronization with the fundingManager to ensure we don't attempt to
-// dispatch a message to a channel before it is fully active. A reference to the
-// channel this stream forwards to his held in scope to prevent unnecessary
-// lookups.
+// dispatch a message to a channel before it is fully active. The link this
+// stream forwards to is looked up for each message, as it's replaced whenever
+// the channel is spliced.
 func newChanMsgStream(p *peer, cid lnwire.ChannelID) *msgStream {
-
-	var chanLink htlcswitch.ChannelLink
-
 	return newMsgStream(p,
 		fmt.Sprintf("Update stream for ChannelID(%x) created", cid[:]),
 		fmt.Sprintf("Update stream for ChannelID(%x) exiting", cid[:]),
@@ -922,42 +1021,41 @@ func newChanMsgStream(p *peer, cid lnwire.ChannelID) *msgStream {
 			}
 
 			// Dispatch the commitment update message to the proper
-			// active goroutine dedicated to this channel.
-			if chanLink == nil {
-				link, err := p.server.htlcSwitch.GetLink(cid)
-				switch {
-
-				// If we failed to find the link in question,
-				// and the message received was a channel sync
-				// message, then this might be a peer trying to
-				// resync closed channel. In this case we'll
-				// try to resend our last channel sync message,
-				// such that the peer can recover funds from
-				// the closed channel.
-				case err != nil && isChanSyncMsg:
-					peerLog.Debugf("Unable to find "+
-						"link(%v) to handle channel "+
-						"sync, attempting to resend "+
-						"last ChanSync message", cid)
-
-					err := p.resendChanSyncMsg(cid)
-					if err != nil {
-						// TODO(halseth): send error to
-						// peer?
-						peerLog.Errorf(
-							"resend failed: %v",
-							err,
-						)
-					}
-					return
+			// active goroutine dedicated to this channel. We'll
+			// look up the link for every message, as the link of a
+			// channel is replaced while it's being spliced.
+			chanLink, err := p.server.htlcSwitch.GetLink(cid)
+			switch {
 
-				case err != nil:
-					peerLog.Errorf("recv'd update for "+
-						"unknown channel %v from %v: "+
-						"%v", cid, p, err)
-					return
+			// If we failed to find the link in question,
+			// and the message received was a channel sync
+			// message, then this might be a peer trying to
+			// resync closed channel. In this case we'll
+			// try to resend our last channel sync message,
+			// such that the peer can recover funds from
+			// the closed channel.
+			case err != nil && isChanSyncMsg:
+				peerLog.Debugf("Unable to find "+
+					"link(%v) to handle channel "+
+					"sync, attempting to resend "+
+					"last ChanSync message", cid)
+
+				err := p.resendChanSyncMsg(cid)
+				if err != nil {
+					// TODO(halseth): send error to
+					// peer?
+					peerLog.Errorf(
+						"resend failed: %v",
+						err,
+					)
 				}
-				chanLink = link
+				return
+
+			case err != nil:
+				peerLog.Errorf("recv'd update for "+
+					"unknown channel %v from %v: "+
+					"%v", cid, p, err)
+				return
 			}
 
 			// In order to avoid unnecessarily delivering message
@@ -1091,6 +1189,11 @@ out:
 		case *lnwire.FundingLocked:
 			p.server.fundingMgr.processFundingLocked(msg, p)
 
+		case *lnwire.TxAddInput, *lnwire.TxAddOutput,
+			*lnwire.TxComplete, *lnwire.TxSignatures:
+
+			p.server.fundingMgr.processInteractiveTxMsg(msg, p)
+
 		case *lnwire.Shutdown:
 			select {
 			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
@@ -1104,6 +1207,60 @@ out:
 				break out
 			}
 
+		case *lnwire.Stfu:
+			select {
+			case p.chanSpliceMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceInit:
+			select {
+			case p.chanSpliceMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceAck:
+			select {
+			case p.chanSpliceMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		// The final messages of a splice negotiation add the link of
+		// the channel back to the switch, so we'll wait for the
+		// negotiation to complete before delivering any of the remote
+		// party's subsequent updates.
+		case *lnwire.SpliceSigned:
+			done := p.spliceDone(msg.ChannelID)
+			select {
+			case p.chanSpliceMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+			select {
+			case <-done:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceAbandon:
+			done := p.spliceDone(msg.ChannelID)
+			select {
+			case p.chanSpliceMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+			select {
+			case <-done:
+			case <-p.quit:
+				break out
+			}
+
+		// SpliceLocked is handled synchronously, as the splice must be
+		// locked in before we process any of the remote party's
+		// subsequent updates, which spend the new funding output.
+		case *lnwire.SpliceLocked:
+			p.handleRemoteSpliceLocked(msg)
+
 		case *lnwire.Error:
 			key := p.addr.IdentityKey
 
@@ -1126,6 +1283,17 @@ out:
 			case p.server.fundingMgr.IsPendingChannel(msg.ChanID, key):
 				p.server.fundingMgr.processFundingError(msg, key)
 
+			// If the channel is being spliced, then the error is
+			// meant for the splice negotiation, which will be
+			// aborted.
+			case p.isSplicing(msg.ChanID):
+				spliceMsg := &closeMsg{msg.ChanID, msg}
+				select {
+				case p.chanSpliceMsgs <- spliceMsg:
+				case <-p.quit:
+					break out
+				}
+
 			// If not we hand the error to the channel link for
 			// this channel.
 			default:
@@ -1157,6 +1325,9 @@ out:
 		case *lnwire.CommitSig:
 			isChanUpdate = true
 			targetChan = msg.ChanID
+		case *lnwire.SpliceCommitSig:
+			isChanUpdate = true
+			targetChan = msg.ChannelID
 		case *lnwire.UpdateFee:
 			isChanUpdate = true
 			targetChan = msg.ChanID
@@ -1240,6 +1411,22 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, next_point=%x",
 			msg.ChanID, msg.NextPerCommitmentPoint.SerializeCompressed())
 
+	case *lnwire.TxAddInput:
+		return fmt.Sprintf("temp_chan_id=%x, serial_id=%v, "+
+			"prev_out=%v", msg.ChannelID[:], msg.SerialID,
+			msg.PrevOutPoint())
+
+	case *lnwire.TxAddOutput:
+		return fmt.Sprintf("temp_chan_id=%x, serial_id=%v, amt=%v",
+			msg.ChannelID[:], msg.SerialID, msg.Amount)
+
+	case *lnwire.TxComplete:
+		return fmt.Sprintf("temp_chan_id=%x", msg.ChannelID[:])
+
+	case *lnwire.TxSignatures:
+		return fmt.Sprintf("temp_chan_id=%x, txid=%v, num_witnesses=%v",
+			msg.ChannelID[:], msg.TxHash, len(msg.Witnesses))
+
 	case *lnwire.Shutdown:
 		return fmt.Sprintf("chan_id=%v, script=%x", msg.ChannelID,
 			msg.Address[:])
@@ -1248,6 +1435,35 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, fee_sat=%v", msg.ChannelID,
 			msg.FeeSatoshis)
 
+	case *lnwire.Stfu:
+		return fmt.Sprintf("chan_id=%v, initiator=%v", msg.ChannelID,
+			msg.Initiator)
+
+	case *lnwire.SpliceInit:
+		return fmt.Sprintf("chan_id=%v, contribution=%v, "+
+			"fee_per_kw=%v, txid=%v", msg.ChannelID,
+			msg.FundingContribution, msg.FundingFeePerKw,
+			msg.SpliceTx.TxHash())
+
+	case *lnwire.SpliceAck:
+		return fmt.Sprintf("chan_id=%v", msg.ChannelID)
+
+	case *lnwire.SpliceSigned:
+		return fmt.Sprintf("chan_id=%v, num_witnesses=%v",
+			msg.ChannelID, len(msg.Witnesses))
+
+	case *lnwire.SpliceLocked:
+		return fmt.Sprintf("chan_id=%v, txid=%v", msg.ChannelID,
+			msg.SpliceTxid)
+
+	case *lnwire.SpliceAbandon:
+		return fmt.Sprintf("chan_id=%v, txid=%v", msg.ChannelID,
+			msg.SpliceTxid)
+
+	case *lnwire.SpliceCommitSig:
+		return fmt.Sprintf("chan_id=%v, txid=%v, num_htlcs=%v",
+			msg.ChannelID, msg.SpliceTxid, len(msg.HtlcSigs))
+
 	case *lnwire.UpdateAddHTLC:
 		return fmt.Sprintf("chan_id=%v, id=%v, amt=%v, expiry=%v, hash=%x",
 			msg.ChanID, msg.ID, msg.Amount, msg.Expiry, msg.PaymentHash[:])
@@ -1400,37 +1616,83 @@ func (p *peer) logWireMessage(msg lnwire.Message, read bool) {
 	}))
 }
 
-// writeMessage writes and flushes the target lnwire.Message to the remote peer.
-// If the passed message is nil, this method will only try to flush an existing
-// message buffered on the connection. It is safe to recall this method with a
-// nil message iff a timeout error is returned. This will continue to flush the
-// pending message to the wire.
-func (p *peer) writeMessage(msg lnwire.Message) error {
+// writeMessages writes the target messages to the remote peer. The messages
+// are serialized and encrypted one after the other, and written to the wire
+// together, such that a batch of messages only requires a single write in most
+// cases.
+func (p *peer) writeMessages(msgs ...lnwire.Message) error {
 	// Simply exit if we're shutting down.
 	if atomic.LoadInt32(&p.disconnect) != 0 {
 		return lnpeer.ErrPeerExiting
 	}
 
-	// Only log the message on the first attempt.
-	if msg != nil {
-		p.logWireMessage(msg, false)
-	}
-
 	noiseConn, ok := p.conn.(*brontide.Conn)
 	if !ok {
 		return fmt.Errorf("brontide.Conn required to write messages")
 	}
 
-	flushMsg := func() error {
+	for i := 0; i < len(msgs); {
+		msg := msgs[i]
+
+		// We'll acquire a write buffer to serialize the message and
+		// buffer the ciphertext on the underlying connection.
+		err := p.writePool.Submit(func(buf *bytes.Buffer) error {
+			// Using a buffer allocated by the write pool, encode
+			// the message directly into the buffer.
+			_, writeErr := lnwire.WriteMessage(buf, msg, 0)
+			if writeErr != nil {
+				return writeErr
+			}
+
+			// Finally, write the message itself in a single swoop.
+			// This will buffer the ciphertext on the underlying
+			// connection. We will defer flushing the message until
+			// the write pool has been released.
+			return noiseConn.WriteMessage(buf.Bytes())
+		})
+		switch {
+		// If the connection can't buffer any more messages, we'll
+		// flush the ones buffered so far and try again.
+		case err == brontide.ErrMessageNotFlushed:
+			if err := p.flushMessages(noiseConn); err != nil {
+				return err
+			}
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		p.logWireMessage(msg, false)
+		i++
+	}
+
+	return p.flushMessages(noiseConn)
+}
+
+// flushMessages writes all messages buffered on the connection to the wire. If
+// a timeout error is encountered, we will retry after backing off in case the
+// remote peer is just slow to process messages from the wire, picking up where
+// the prior attempt left off.
+func (p *peer) flushMessages(noiseConn *brontide.Conn) error {
+	// Record the time at which we first attempt to flush the messages.
+	startTime := time.Now()
+
+	for {
+		// Simply exit if we're shutting down.
+		if atomic.LoadInt32(&p.disconnect) != 0 {
+			return lnpeer.ErrPeerExiting
+		}
+
 		// Ensure the write deadline is set before we attempt to send
-		// the message.
+		// the messages.
 		writeDeadline := time.Now().Add(writeMessageTimeout)
 		err := noiseConn.SetWriteDeadline(writeDeadline)
 		if err != nil {
 			return err
 		}
 
-		// Flush the pending message to the wire. If an error is
+		// Flush the pending messages to the wire. If an error is
 		// encountered, e.g. write timeout, the number of bytes written
 		// so far will be returned.
 		n, err := noiseConn.Flush()
@@ -1440,43 +1702,22 @@ func (p *peer) writeMessage(msg lnwire.Message) error {
 			atomic.AddUint64(&p.bytesSent, uint64(n))
 		}
 
-		return err
-	}
-
-	// If the current message has already been serialized, encrypted, and
-	// buffered on the underlying connection we will skip straight to
-	// flushing it to the wire.
-	if msg == nil {
-		return flushMsg()
-	}
-
-	// Otherwise, this is a new message. We'll acquire a write buffer to
-	// serialize the message and buffer the ciphertext on the connection.
-	err := p.writePool.Submit(func(buf *bytes.Buffer) error {
-		// Using a buffer allocated by the write pool, encode the
-		// message directly into the buffer.
-		_, writeErr := lnwire.WriteMessage(buf, msg, 0)
-		if writeErr != nil {
-			return writeErr
+		if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
+			peerLog.Debugf("Write timeout detected for peer %s, "+
+				"first flush attempted %v ago", p,
+				time.Since(startTime))
+			continue
 		}
 
-		// Finally, write the message itself in a single swoop. This
-		// will buffer the ciphertext on the underlying connection. We
-		// will defer flushing the message until the write pool has been
-		// released.
-		return noiseConn.WriteMessage(buf.Bytes())
-	})
-	if err != nil {
 		return err
 	}
-
-	return flushMsg()
 }
 
 // writeHandler is a goroutine dedicated to reading messages off of an incoming
 // queue, and writing them out to the wire. This goroutine coordinates with the
 // queueHandler in order to ensure the incoming message queue is quickly
-// drained.
+// drained. Messages that are queued while a write is in progress are written
+// out together in a single batch.
 //
 // NOTE: This method MUST be run as a goroutine.
 func (p *peer) writeHandler() {
@@ -1488,51 +1729,51 @@ func (p *peer) writeHandler() {
 		p.Disconnect(err)
 	})
 
-	var exitErr error
+	var (
+		exitErr error
+		batch   = make([]outgoingMsg, 0, maxWriteBatchSize)
+		msgs    = make([]lnwire.Message, 0, maxWriteBatchSize)
+	)
 
 out:
 	for {
 		select {
 		case outMsg := <-p.sendQueue:
-			// If we're about to send a ping message, then log the
-			// exact time in which we send the message so we can
-			// use the delay as a rough estimate of latency to the
-			// remote peer.
-			if _, ok := outMsg.msg.(*lnwire.Ping); ok {
-				// TODO(roasbeef): do this before the write?
-				// possibly account for processing within func?
-				now := time.Now().UnixNano()
-				atomic.StoreInt64(&p.pingLastSend, now)
+			// Collect any further messages that are ready to be
+			// sent, such that they can be written out along with
+			// this one.
+			batch = append(batch[:0], outMsg)
+		collect:
+			for len(batch) < maxWriteBatchSize {
+				select {
+				case outMsg := <-p.sendQueue:
+					batch = append(batch, outMsg)
+				default:
+					break collect
+				}
 			}
 
-			// Record the time at which we first attempt to send the
-			// message.
-			startTime := time.Now()
-
-		retry:
-			// Write out the message to the socket. If a timeout
-			// error is encountered, we will catch this and retry
-			// after backing off in case the remote peer is just
-			// slow to process messages from the wire.
-			err := p.writeMessage(outMsg.msg)
-			if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
-				peerLog.Debugf("Write timeout detected for "+
-					"peer %s, first write for message "+
-					"attempted %v ago", p,
-					time.Since(startTime))
-
-				// If we received a timeout error, this implies
-				// that the message was buffered on the
-				// connection successfully and that a flush was
-				// attempted. We'll set the message to nil so
-				// that on a subsequent pass we only try to
-				// flush the buffered message, and forgo
-				// reserializing or reencrypting it.
-				outMsg.msg = nil
-
-				goto retry
+			msgs = msgs[:0]
+			for _, outMsg := range batch {
+				// If we're about to send a ping message, then
+				// log the exact time in which we send the
+				// message so we can use the delay as a rough
+				// estimate of latency to the remote peer.
+				if _, ok := outMsg.msg.(*lnwire.Ping); ok {
+					// TODO(roasbeef): do this before the
+					// write? possibly account for
+					// processing within func?
+					now := time.Now().UnixNano()
+					atomic.StoreInt64(&p.pingLastSend, now)
+				}
+
+				msgs = append(msgs, outMsg.msg)
 			}
 
+			// Write out the messages to the socket. Write timeouts
+			// are retried within, so any error returned is fatal.
+			err := p.writeMessages(msgs...)
+
 			// The write succeeded, reset the idle timer to prevent
 			// us from disconnecting the peer.
 			if !idleTimer.Stop() {
@@ -1545,8 +1786,10 @@ out:
 
 			// If the peer requested a synchronous write, respond
 			// with the error.
-			if outMsg.errChan != nil {
-				outMsg.errChan <- err
+			for _, outMsg := range batch {
+				if outMsg.errChan != nil {
+					outMsg.errChan <- err
+				}
 			}
 
 			if err != nil {
@@ -1874,6 +2117,17 @@ out:
 		case req := <-p.localCloseChanReqs:
 			p.handleLocalCloseReq(req)
 
+		// We've just received a local request to splice an active
+		// channel, which will kick off the splice negotiation.
+		case req := <-p.localSpliceReqs:
+			p.handleLocalSpliceReq(req)
+
+		// We've received a new splice related message from the remote
+		// peer, we'll use this message to advance the channel splicer
+		// state machine.
+		case spliceMsg := <-p.chanSpliceMsgs:
+			p.handleSpliceMsg(spliceMsg)
+
 		// We've received a link failure from a link that was added to
 		// the switch. This will initiate the teardown of the link, and
 		// initiate any on-chain closures if necessary.
@@ -1976,6 +2230,14 @@ out:
 			}
 			p.activeChanMtx.Unlock()
 
+			// Any splice negotiations that haven't completed are
+			// abandoned, so we'll release their wallet inputs.
+			p.spliceMtx.Lock()
+			for _, splicer := range p.activeSplices {
+				splicer.Abort()
+			}
+			p.spliceMtx.Unlock()
+
 			break out
 		}
 	}
@@ -2050,14 +2312,27 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 			return nil, fmt.Errorf("cannot co-op close " +
 				"channel w/ active htlcs")
 		}
+		if p.isSplicing(chanID) ||
+			len(channel.State().PendingSplices()) != 0 {
+
+			return nil, ErrChanAlreadySplicing
+		}
 
 		// We'll create a valid closing state machine in order to
-		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf("unable to gen delivery script: %v", err)
+		// respond to the initiated cooperative channel closure. If we
+		// committed to an upfront shutdown script when the channel
+		// was opened, then we must use that script. Otherwise, we'll
+		// generate a fresh one.
+		deliveryAddr := []byte(channel.State().LocalShutdownScript)
+		if len(deliveryAddr) == 0 {
+			var err error
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf("unable to gen delivery script: "+
+					"%v", err)
 
-			return nil, fmt.Errorf("close addr unavailable")
+				return nil, fmt.Errorf("close addr unavailable")
+			}
 		}
 
 		// In order to begin fee negotiations, we'll first compute our
@@ -2086,6 +2361,7 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 			},
 			deliveryAddr,
 			feePerKw,
+			0,
 			uint32(startingHeight),
 			nil,
 		)
@@ -2117,16 +2393,48 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
-		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf(err.Error())
-			req.Err <- err
+		// A channel can't be closed cooperatively while it's being
+		// spliced.
+		if p.isSplicing(chanID) ||
+			len(channel.State().PendingSplices()) != 0 {
+
+			peerLog.Errorf(ErrChanAlreadySplicing.Error())
+			req.Err <- ErrChanAlreadySplicing
 			return
 		}
 
+		// If we committed to an upfront shutdown script when the
+		// channel was opened, then we must close out to it, so we'll
+		// reject any different delivery script the caller specified.
+		deliveryAddr := []byte(req.DeliveryScript)
+		upfrontScript := channel.State().LocalShutdownScript
+		if len(upfrontScript) != 0 {
+			if len(deliveryAddr) != 0 &&
+				!bytes.Equal(deliveryAddr, upfrontScript) {
+
+				err := ErrUpfrontShutdownScriptMismatch
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
+
+			deliveryAddr = upfrontScript
+		}
+
+		// If the caller specified a delivery script, then we'll use
+		// that. Otherwise, we'll fetch a fresh delivery address that
+		// we'll use to send the funds to in the case of a successful
+		// negotiation.
+		if len(deliveryAddr) == 0 {
+			var err error
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
+		}
+
 		// Next, we'll create a new channel closer state machine to
 		// handle the close negotiation.
 		_, startingHeight, err := p.server.cc.chainIO.GetBestBlock()
@@ -2146,6 +2454,7 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			},
 			deliveryAddr,
 			req.TargetFeePerKw,
+			req.MaxFeePerKw,
 			uint32(startingHeight),
 			req,
 		)
@@ -2355,6 +2664,499 @@ func waitForChanToClose(bestHeight uint32, notifier chainntnfs.ChainNotifier,
 	cb()
 }
 
+// isSplicing returns true if the target channel has an active splice
+// negotiation.
+func (p *peer) isSplicing(chanID lnwire.ChannelID) bool {
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	_, ok := p.activeSplices[chanID]
+	return ok
+}
+
+// HandleLocalSpliceReq delivers a local request to splice a channel with this
+// peer to the channelManager.
+func (p *peer) HandleLocalSpliceReq(req *spliceRequest) {
+	select {
+	case p.localSpliceReqs <- req:
+	case <-p.quit:
+		req.err <- lnpeer.ErrPeerExiting
+	}
+}
+
+// newChanSplicer creates and registers a channel splicer state machine for the
+// target channel, after ensuring that the channel can be spliced. The passed
+// request should only be non-nil if we're the initiator of the splice.
+func (p *peer) newChanSplicer(channel *lnwallet.LightningChannel,
+	req *spliceRequest) (*channelSplicer, error) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(channel.ChannelPoint())
+
+	switch {
+	case !p.remoteLocalFeatures.HasFeature(lnwire.SpliceOptional):
+		return nil, fmt.Errorf("peer %x doesn't support splicing",
+			p.PubKey())
+
+	case channel.State().ChannelFlags&lnwire.FFAnnounceChannel != 0:
+		return nil, ErrSplicePublicChannel
+	}
+
+	if _, ok := p.activeChanCloses[chanID]; ok {
+		return nil, ErrChanAlreadyClosing
+	}
+
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	if _, ok := p.activeSplices[chanID]; ok {
+		return nil, ErrChanAlreadySplicing
+	}
+
+	splicer := newChannelSplicer(
+		chanSpliceCfg{
+			channel:           channel,
+			unregisterChannel: p.server.htlcSwitch.RemoveLink,
+			fundSplice:        p.server.cc.wallet.FundSplice,
+			releaseInputs:     p.server.cc.wallet.ReleaseSpliceInputs,
+			signInputs:        p.server.cc.wallet.SignSpliceInputs,
+			broadcastTx:       p.server.cc.wallet.PublishTransaction,
+			fetchTx:           p.server.cc.wallet.FetchTx,
+			chainIO:           p.server.cc.chainIO,
+		},
+		req,
+	)
+	p.activeSplices[chanID] = splicer
+
+	return splicer, nil
+}
+
+// handleLocalSpliceReq kicks-off the splice negotiation of a channel initiated
+// by a local subsystem.
+func (p *peer) handleLocalSpliceReq(req *spliceRequest) {
+	chanID := lnwire.NewChanIDFromOutPoint(&req.chanPoint)
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+	if !ok {
+		err := fmt.Errorf("unable to splice channel, ChannelID(%v) is "+
+			"unknown", chanID)
+		peerLog.Errorf(err.Error())
+		req.err <- err
+		return
+	}
+
+	// A splice can only be abandoned if it's pending.
+	if req.abandonTxid != nil {
+		var found bool
+		for _, splice := range channel.State().PendingSplices() {
+			if splice.SpliceTx.TxHash() == *req.abandonTxid {
+				found = true
+				break
+			}
+		}
+		if !found {
+			err := channeldb.ErrSpliceNotFound
+			peerLog.Errorf(err.Error())
+			req.err <- err
+			return
+		}
+	}
+
+	splicer, err := p.newChanSplicer(channel, req)
+	if err != nil {
+		peerLog.Errorf(err.Error())
+		req.err <- err
+		return
+	}
+
+	// With the state machine created, we'll quiesce the channel, and send
+	// the Stfu message to the remote party to kick things off.
+	stfu, err := splicer.InitSplice()
+	if err != nil {
+		p.failSplice(splicer, err, false)
+		return
+	}
+
+	p.queueMsg(stfu, nil)
+}
+
+// handleSpliceMsg advances the splice negotiation of the channel targeted by
+// the passed message. If there's no active negotiation for the channel, then
+// the remote party is initiating a splice.
+func (p *peer) handleSpliceMsg(spliceMsg *closeMsg) {
+	p.spliceMtx.Lock()
+	splicer, ok := p.activeSplices[spliceMsg.cid]
+	p.spliceMtx.Unlock()
+
+	if !ok {
+		// Only Stfu can start a new splice negotiation, so we'll
+		// ignore any other message.
+		if _, ok := spliceMsg.msg.(*lnwire.Stfu); !ok {
+			peerLog.Warnf("Ignoring %v for ChannelID(%v) without "+
+				"active splice", spliceMsg.msg.MsgType(),
+				spliceMsg.cid)
+			return
+		}
+
+		// If the channel is not known to us, we'll simply ignore this
+		// message.
+		p.activeChanMtx.RLock()
+		channel, ok := p.activeChannels[spliceMsg.cid]
+		p.activeChanMtx.RUnlock()
+		if !ok {
+			return
+		}
+
+		var err error
+		splicer, err = p.newChanSplicer(channel, nil)
+		if err != nil {
+			peerLog.Errorf("Unable to respond to remote splice "+
+				"msg: %v", err)
+
+			errMsg := &lnwire.Error{
+				ChanID: spliceMsg.cid,
+				Data:   lnwire.ErrorData(err.Error()),
+			}
+			p.queueMsg(errMsg, nil)
+			return
+		}
+	}
+
+	// If the remote party sent us an error, then they've aborted the
+	// negotiation.
+	if errMsg, ok := spliceMsg.msg.(*lnwire.Error); ok {
+		err := fmt.Errorf("splice rejected by remote party: %v",
+			string(errMsg.Data))
+		p.failSplice(splicer, err, false)
+		return
+	}
+
+	// Next, we'll process the next message using the target state
+	// machine. We'll either continue negotiation, or halt.
+	msgs, spliceFin, err := splicer.ProcessSpliceMsg(spliceMsg.msg)
+	if err != nil {
+		err := fmt.Errorf("unable to process splice msg: %v", err)
+		p.failSplice(splicer, err, true)
+		return
+	}
+
+	// If the splice has been signed or abandoned, we'll add the link of
+	// the channel back to the switch before sending our final messages,
+	// so we're ready to handle the remote party's subsequent updates.
+	if spliceFin {
+		p.finalizeSplice(splicer)
+	}
+
+	for _, msg := range msgs {
+		p.queueMsg(msg, nil)
+	}
+}
+
+// finalizeSplice performs the final steps once the splice has been signed or
+// abandoned by both parties. The link of the channel is added back to the
+// switch, which keeps operating the channel across the funding outputs of all
+// pending splices until one of them locks in.
+func (p *peer) finalizeSplice(splicer *channelSplicer) {
+	defer splicer.Finish()
+
+	p.spliceMtx.Lock()
+	delete(p.activeSplices, splicer.cid)
+	p.spliceMtx.Unlock()
+
+	channel := splicer.cfg.channel
+	switch {
+	// If the last pending splice has been abandoned, there's nothing left
+	// to lock in.
+	case splicer.Abandoned():
+		if len(channel.State().PendingSplices()) == 0 {
+			p.stopSpliceLock(splicer.cid)
+		}
+
+	default:
+		p.waitForSpliceLock(splicer.chanPoint)
+	}
+
+	err := p.restartLink(&splicer.chanPoint, channel, false)
+	if err != nil {
+		peerLog.Errorf("Unable to add link for ChannelPoint(%v): %v",
+			splicer.chanPoint, err)
+	}
+
+	if req := splicer.SpliceRequest(); req != nil {
+		spliceTxid := splicer.PendingSplice().SpliceTx.TxHash()
+		req.txid <- &spliceTxid
+	}
+}
+
+// failSplice aborts a failed splice negotiation, and adds the link of the
+// channel back to the switch. If the splice has already been persisted, its
+// transaction may still confirm, so we'll wait for it to lock in as usual.
+func (p *peer) failSplice(splicer *channelSplicer, err error,
+	notifyRemote bool) {
+
+	defer splicer.Finish()
+
+	peerLog.Errorf("ChannelPoint(%v): splice failed: %v",
+		splicer.chanPoint, err)
+
+	if notifyRemote {
+		p.queueMsg(&lnwire.Error{
+			ChanID: splicer.cid,
+			Data:   lnwire.ErrorData(err.Error()),
+		}, nil)
+	}
+
+	if req := splicer.SpliceRequest(); req != nil {
+		req.err <- err
+	}
+
+	p.spliceMtx.Lock()
+	delete(p.activeSplices, splicer.cid)
+	p.spliceMtx.Unlock()
+
+	if splicer.Persisted() {
+		p.waitForSpliceLock(splicer.chanPoint)
+	} else {
+		splicer.Abort()
+	}
+
+	err = p.restartLink(&splicer.chanPoint, splicer.cfg.channel, false)
+	if err != nil {
+		peerLog.Errorf("Unable to add link for ChannelPoint(%v): %v",
+			splicer.chanPoint, err)
+	}
+}
+
+// spliceDone returns a channel that's closed once the active splice
+// negotiation of the target channel has either completed or failed. If there's
+// no active negotiation, a closed channel is returned.
+func (p *peer) spliceDone(chanID lnwire.ChannelID) <-chan struct{} {
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	if splicer, ok := p.activeSplices[chanID]; ok {
+		return splicer.Done()
+	}
+
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+
+// restartLink adds the link of a channel that had been removed from the switch
+// back to the switch.
+func (p *peer) restartLink(chanPoint *wire.OutPoint,
+	lnChan *lnwallet.LightningChannel, syncStates bool) error {
+
+	_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	chainEvents, err := p.server.chainArb.SubscribeChannelEvents(
+		*chanPoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	return p.addLink(
+		chanPoint, lnChan, forwardingPolicy, chainEvents,
+		currentHeight, syncStates,
+	)
+}
+
+// waitForSpliceLock registers the target channel as awaiting the lock in of
+// one of its pending splices, and launches a goroutine that waits for a splice
+// transaction to confirm.
+func (p *peer) waitForSpliceLock(chanPoint wire.OutPoint) {
+	chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	if _, ok := p.spliceLocks[chanID]; ok {
+		return
+	}
+	lock := &spliceLock{
+		quit: make(chan struct{}),
+	}
+	p.spliceLocks[chanID] = lock
+
+	p.wg.Add(1)
+	go p.spliceConfWatcher(chanPoint, lock)
+}
+
+// stopSpliceLock stops waiting for a pending splice of the target channel to
+// lock in, as none are left.
+func (p *peer) stopSpliceLock(chanID lnwire.ChannelID) {
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	if lock, ok := p.spliceLocks[chanID]; ok {
+		close(lock.quit)
+		delete(p.spliceLocks, chanID)
+	}
+}
+
+// spliceConfWatcher waits for the transaction of a pending splice of the
+// target channel to confirm, after which we'll send SpliceLocked to the remote
+// party.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (p *peer) spliceConfWatcher(chanPoint wire.OutPoint, lock *spliceLock) {
+	defer p.wg.Done()
+
+	chainEvents, err := p.server.chainArb.SubscribeChannelEvents(chanPoint)
+	if err != nil {
+		peerLog.Errorf("Unable to subscribe to chain events of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+	defer chainEvents.Cancel()
+
+	// As the splice transaction may have confirmed before we subscribed,
+	// we'll check the database before waiting for the notification.
+	dbChan, err := p.server.chanDB.FetchChannel(chanPoint)
+	if err != nil {
+		peerLog.Errorf("Unable to fetch ChannelPoint(%v): %v",
+			chanPoint, err)
+		return
+	}
+
+	var spliceTxid chainhash.Hash
+	if confirmedTxid := dbChan.ConfirmedSplice(); confirmedTxid != nil {
+		spliceTxid = *confirmedTxid
+	} else {
+		select {
+		case splice := <-chainEvents.SpliceConfirmed:
+			spliceTxid = splice.SpliceTx.TxHash()
+
+		case <-lock.quit:
+			return
+
+		case <-p.quit:
+			return
+		}
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	if p.spliceLocks[chanID] != lock {
+		return
+	}
+	lock.localTxid = &spliceTxid
+
+	peerLog.Infof("ChannelPoint(%v): splice tx %v confirmed", chanPoint,
+		spliceTxid)
+
+	p.queueMsg(lnwire.NewSpliceLocked(chanID, spliceTxid), nil)
+	p.maybeLockInSplice(chanID, lock)
+}
+
+// handleRemoteSpliceLocked processes the remote party's SpliceLocked. If we've
+// sent our own SpliceLocked for the same splice, it's locked in.
+func (p *peer) handleRemoteSpliceLocked(msg *lnwire.SpliceLocked) {
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[msg.ChannelID]
+	p.activeChanMtx.RUnlock()
+	if !ok {
+		peerLog.Warnf("Received SpliceLocked for unknown "+
+			"ChannelID(%v)", msg.ChannelID)
+		return
+	}
+	chanPoint := *channel.ChannelPoint()
+
+	// The remote party resends its SpliceLocked for the latest splice
+	// whenever it reconnects, which we've already locked in.
+	state := channel.State()
+	if state.CurrentFundingOutpoint().Hash == msg.SpliceTxid {
+		return
+	}
+
+	p.spliceMtx.Lock()
+	defer p.spliceMtx.Unlock()
+
+	lock, ok := p.spliceLocks[msg.ChannelID]
+	if !ok {
+		peerLog.Warnf("Received SpliceLocked for unknown splice %v "+
+			"of ChannelPoint(%v)", msg.SpliceTxid, chanPoint)
+		return
+	}
+	lock.remoteTxid = &msg.SpliceTxid
+
+	p.maybeLockInSplice(msg.ChannelID, lock)
+}
+
+// maybeLockInSplice locks in the splice of the target channel once both
+// parties have sent SpliceLocked for it. The channel is funded by the funding
+// output created by the splice from then on, while all other pending splices
+// are discarded.
+//
+// NOTE: This method MUST be called with the spliceMtx held.
+func (p *peer) maybeLockInSplice(chanID lnwire.ChannelID, lock *spliceLock) {
+	if lock.localTxid == nil || lock.remoteTxid == nil {
+		return
+	}
+
+	spliceTxid := *lock.localTxid
+	if *lock.remoteTxid != spliceTxid {
+		peerLog.Warnf("ChannelID(%v): remote party locked splice %v, "+
+			"while splice %v confirmed", chanID, *lock.remoteTxid,
+			spliceTxid)
+		return
+	}
+
+	close(lock.quit)
+	delete(p.spliceLocks, chanID)
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+	if !ok {
+		return
+	}
+
+	// The inputs of the discarded splices are released, as any of them
+	// that belong to our wallet and aren't spent by the splice may be
+	// spent again.
+	var discardedInputs []*wire.TxIn
+	for _, splice := range channel.State().PendingSplices() {
+		if splice.SpliceTx.TxHash() != spliceTxid {
+			discardedInputs = append(
+				discardedInputs, splice.SpliceTx.TxIn...,
+			)
+		}
+	}
+
+	if err := channel.LockInSplice(spliceTxid); err != nil {
+		peerLog.Errorf("ChannelID(%v): unable to lock in splice %v: %v",
+			chanID, spliceTxid, err)
+		return
+	}
+
+	p.server.cc.wallet.ReleaseSpliceInputs(discardedInputs)
+
+	peerLog.Infof("ChannelPoint(%v): splice %v locked in, new funding "+
+		"outpoint: %v", channel.ChannelPoint(), spliceTxid,
+		channel.State().CurrentFundingOutpoint())
+
+	// With the splice locked in, the static backup of the channel must be
+	// refreshed to carry its new funding output and capacity.
+	p.server.channelNotifier.NotifySplicedChannelEvent(
+		*channel.ChannelPoint(),
+	)
+}
+
 // WipeChannel removes the passed channel point from all indexes associated with
 // the peer, and the switch.
 func (p *peer) WipeChannel(chanPoint *wire.OutPoint) error {
@@ -2414,7 +3216,7 @@ func (p *peer) sendInitMsg() error {
 		p.localFeatures,
 	)
 
-	return p.writeMessage(msg)
+	return p.writeMessages(msg)
 }
 
 // resendChanSyncMsg will attempt to find a channel sync message for the closed
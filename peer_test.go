@@ -3,12 +3,16 @@
 package lnd
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/htlcswitch"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
@@ -588,3 +592,418 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerChannelClosureMaxFeeInitiator tests that the shutdown initiator
+// uses the delivery script specified within the close request, and that it
+// never proposes a fee above the requested maximum fee rate, even when the
+// responder insists on a much higher fee.
+func TestPeerChannelClosureMaxFeeInitiator(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, responderChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We make the initiator send a shutdown request, specifying both a
+	// custom delivery script and a fee ceiling only slightly above the
+	// starting fee rate.
+	const (
+		targetFeePerKw = lnwallet.SatPerKWeight(12500)
+		maxFeePerKw    = lnwallet.SatPerKWeight(13000)
+	)
+	deliveryScript := append([]byte{}, bobsPrivKey[:]...)
+	updateChan := make(chan interface{}, 1)
+	errChan := make(chan error, 1)
+	closeCommand := &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        updateChan,
+		TargetFeePerKw: targetFeePerKw,
+		MaxFeePerKw:    maxFeePerKw,
+		DeliveryScript: deliveryScript,
+		Err:            errChan,
+	}
+	initiator.localCloseChanReqs <- closeCommand
+
+	// We should now be getting the shutdown request, which should pay out
+	// to the delivery script we specified.
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+	if !bytes.Equal(shutdownMsg.Address, deliveryScript) {
+		t.Fatalf("expected delivery script %x, got %x",
+			deliveryScript, shutdownMsg.Address)
+	}
+
+	// We'll answer the shutdown message with our own Shutdown, and then a
+	// ClosingSigned message proposing a fee far above the initiator's
+	// maximum.
+	chanID := shutdownMsg.ChannelID
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	idealFee := responderChan.CalcFee(targetFeePerKw)
+	maxFee := responderChan.CalcFee(maxFeePerKw)
+	increasedFee := btcutil.Amount(float64(idealFee) * 2.5)
+	closeSig, _, _, err := responderChan.CreateCloseProposal(
+		increasedFee, dummyDeliveryScript, deliveryScript,
+	)
+	if err != nil {
+		t.Fatalf("unable to create close proposal: %v", err)
+	}
+	parsedSig, err := lnwire.NewSigFromRawSignature(closeSig)
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewClosingSigned(chanID, increasedFee, parsedSig),
+	}
+
+	// The first closing signed message should be the ideal fee, and the
+	// second should be the compromise fee, which must've been clamped
+	// down to our maximum.
+	expectedFees := []btcutil.Amount{idealFee, maxFee}
+	for _, expectedFee := range expectedFees {
+		select {
+		case outMsg := <-initiator.outgoingQueue:
+			msg = outMsg.msg
+		case <-time.After(time.Second * 5):
+			t.Fatalf("did not receive closing signed")
+		}
+		closingSignedMsg, ok := msg.(*lnwire.ClosingSigned)
+		if !ok {
+			t.Fatalf("expected ClosingSigned message, got %T", msg)
+		}
+		if closingSignedMsg.FeeSatoshis != expectedFee {
+			t.Fatalf("expected ClosingSigned fee to be %v, "+
+				"instead got %v", expectedFee,
+				closingSignedMsg.FeeSatoshis)
+		}
+	}
+
+	// We'll now accept the maximum fee, which should cause the initiator
+	// to broadcast the closing transaction paying to our delivery script.
+	closeSig, _, _, err = responderChan.CreateCloseProposal(
+		maxFee, dummyDeliveryScript, deliveryScript,
+	)
+	if err != nil {
+		t.Fatalf("unable to create close proposal: %v", err)
+	}
+	parsedSig, err = lnwire.NewSigFromRawSignature(closeSig)
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewClosingSigned(chanID, maxFee, parsedSig),
+	}
+
+	var closeTx *wire.MsgTx
+	select {
+	case closeTx = <-broadcastTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("closing tx not broadcast")
+	}
+
+	var foundScript bool
+	for _, txOut := range closeTx.TxOut {
+		if bytes.Equal(txOut.PkScript, deliveryScript) {
+			foundScript = true
+		}
+	}
+	if !foundScript {
+		t.Fatalf("closing tx doesn't pay to delivery script")
+	}
+}
+
+// TestSpliceInitValidation tests that the responder of a splice rejects
+// splice transactions spending inputs other than native witness programs, or
+// paying an insane fee rate.
+func TestSpliceInitValidation(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	_, _, responderChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	splicer := newChannelSplicer(
+		chanSpliceCfg{channel: responderChan}, nil,
+	)
+
+	var pkHash [20]byte
+	p2wkhScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).AddData(pkHash[:]).Script()
+	if err != nil {
+		t.Fatalf("unable to create p2wkh script: %v", err)
+	}
+	p2pkhScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+		AddData(pkHash[:]).AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	if err != nil {
+		t.Fatalf("unable to create p2pkh script: %v", err)
+	}
+
+	const (
+		spliceAmt = btcutil.Amount(btcutil.SatoshiPerBitcoin)
+		fee       = btcutil.Amount(10000)
+		feeRate   = lnwallet.SatPerKWeight(10000)
+	)
+
+	// newSpliceInit returns a splice in of spliceAmt, funded by an input
+	// with the passed value and script.
+	newSpliceInit := func(value btcutil.Amount,
+		pkScript []byte) *lnwire.SpliceInit {
+
+		prevTx := wire.NewMsgTx(2)
+		prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+		prevTx.AddTxOut(wire.NewTxOut(int64(value), pkScript))
+
+		state := responderChan.State()
+		fundingOutpoint := state.CurrentFundingOutpoint()
+		capacity := state.Capacity
+		spliceTx := wire.NewMsgTx(2)
+		spliceTx.AddTxIn(wire.NewTxIn(&fundingOutpoint, nil, nil))
+		spliceTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+			Hash:  prevTx.TxHash(),
+			Index: 0,
+		}, nil, nil))
+		spliceTx.AddTxOut(wire.NewTxOut(
+			int64(capacity+spliceAmt),
+			responderChan.FundingPkScript(),
+		))
+
+		return &lnwire.SpliceInit{
+			FundingContribution: spliceAmt,
+			FundingFeePerKw:     uint32(feeRate),
+			SpliceTx:            spliceTx,
+			PrevTxs:             []*wire.MsgTx{prevTx},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		mutate func(*lnwire.SpliceInit)
+		valid  bool
+	}{
+		{
+			name:   "valid splice in",
+			mutate: func(*lnwire.SpliceInit) {},
+			valid:  true,
+		},
+		{
+			name: "non-witness input",
+			mutate: func(m *lnwire.SpliceInit) {
+				*m = *newSpliceInit(spliceAmt+fee, p2pkhScript)
+			},
+		},
+		{
+			name: "missing previous tx",
+			mutate: func(m *lnwire.SpliceInit) {
+				m.PrevTxs = nil
+			},
+		},
+		{
+			name: "mismatched previous tx",
+			mutate: func(m *lnwire.SpliceInit) {
+				m.PrevTxs[0].LockTime++
+			},
+		},
+		{
+			name: "unknown previous output",
+			mutate: func(m *lnwire.SpliceInit) {
+				m.SpliceTx.TxIn[1].PreviousOutPoint.Index = 1
+			},
+		},
+		{
+			name: "fee rate below floor",
+			mutate: func(m *lnwire.SpliceInit) {
+				feeRate := lnwallet.FeePerKwFloor - 1
+				m.FundingFeePerKw = uint32(feeRate)
+			},
+		},
+		{
+			name: "fee rate above max",
+			mutate: func(m *lnwire.SpliceInit) {
+				m.FundingFeePerKw = uint32(maxSpliceFeeRate + 1)
+			},
+		},
+		{
+			name: "excessive fee paid",
+			mutate: func(m *lnwire.SpliceInit) {
+				*m = *newSpliceInit(spliceAmt*2, p2wkhScript)
+			},
+		},
+		{
+			name: "insufficient inputs",
+			mutate: func(m *lnwire.SpliceInit) {
+				*m = *newSpliceInit(spliceAmt-1, p2wkhScript)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		spliceInit := newSpliceInit(spliceAmt+fee, p2wkhScript)
+		test.mutate(spliceInit)
+
+		_, err := splicer.validateSpliceInit(spliceInit)
+		switch {
+		case test.valid && err != nil:
+			t.Fatalf("%v: unable to validate splice init: %v",
+				test.name, err)
+
+		case !test.valid && err == nil:
+			t.Fatalf("%v: expected splice init to be rejected",
+				test.name)
+		}
+	}
+
+	// Once a splice is pending, a new splice replaces it, so it must pay
+	// a higher fee rate.
+	spliceInit := newSpliceInit(spliceAmt+fee, p2wkhScript)
+	splice, err := responderChan.NewSplice(
+		spliceInit.SpliceTx, 0, spliceAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to create splice: %v", err)
+	}
+	splice.FeePerKw = btcutil.Amount(feeRate)
+	if err := responderChan.State().AddPendingSplice(splice); err != nil {
+		t.Fatalf("unable to add pending splice: %v", err)
+	}
+
+	if _, err := splicer.validateSpliceInit(spliceInit); err == nil {
+		t.Fatalf("expected splice init not replacing pending splice " +
+			"to be rejected")
+	}
+
+	spliceInit.FundingFeePerKw = uint32(feeRate + lnwallet.FeePerKwFloor)
+	prevOutputs, err := splicer.validateSpliceInit(spliceInit)
+	if err != nil {
+		t.Fatalf("unable to validate replacing splice init: %v", err)
+	}
+	if len(prevOutputs) != 1 ||
+		prevOutputs[0] != spliceInit.PrevTxs[0].TxOut[0] {
+
+		t.Fatalf("unexpected previous outputs: %v", prevOutputs)
+	}
+}
+
+// TestSpliceAbandonValidation tests that a pending splice may only be
+// abandoned once one of its inputs other than the funding output has been
+// spent, while the funding output itself remains unspent.
+func TestSpliceAbandonValidation(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	_, _, channel, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	chainIO := &mockChainIO{
+		spentOutpoints: make(map[wire.OutPoint]struct{}),
+	}
+	splicer := newChannelSplicer(
+		chanSpliceCfg{
+			channel:       channel,
+			releaseInputs: func([]*wire.TxIn) {},
+			chainIO:       chainIO,
+		}, nil,
+	)
+
+	// The pending splice is funded by a single wallet input of the remote
+	// party.
+	const spliceAmt = btcutil.Amount(btcutil.SatoshiPerBitcoin)
+	fundingOutpoint := channel.State().CurrentFundingOutpoint()
+	walletInput := wire.OutPoint{Index: 1}
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&fundingOutpoint, nil, nil))
+	spliceTx.AddTxIn(wire.NewTxIn(&walletInput, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(
+		int64(channel.State().Capacity+spliceAmt),
+		channel.FundingPkScript(),
+	))
+	spliceTxid := spliceTx.TxHash()
+
+	splice, err := channel.NewSplice(spliceTx, 0, spliceAmt)
+	if err != nil {
+		t.Fatalf("unable to create splice: %v", err)
+	}
+	splice.PrevOutputs = []*wire.TxOut{
+		wire.NewTxOut(int64(spliceAmt*2), channel.FundingPkScript()),
+	}
+	if err := channel.State().AddPendingSplice(splice); err != nil {
+		t.Fatalf("unable to add pending splice: %v", err)
+	}
+
+	// An unknown splice can't be abandoned.
+	_, err = splicer.validateSpliceAbandon(chainhash.Hash{})
+	if err != channeldb.ErrSpliceNotFound {
+		t.Fatalf("expected ErrSpliceNotFound, got %v", err)
+	}
+
+	// As long as the wallet input is unspent, the splice may still
+	// confirm.
+	_, err = splicer.validateSpliceAbandon(spliceTxid)
+	if err != ErrSpliceStillValid {
+		t.Fatalf("expected ErrSpliceStillValid, got %v", err)
+	}
+
+	// If the funding output has been spent, it may have been spent by the
+	// splice transaction itself.
+	chainIO.spentOutpoints[walletInput] = struct{}{}
+	chainIO.spentOutpoints[fundingOutpoint] = struct{}{}
+	if _, err := splicer.validateSpliceAbandon(spliceTxid); err == nil {
+		t.Fatalf("expected abandoning splice with spent funding " +
+			"output to fail")
+	}
+
+	// Once only the wallet input has been spent, the splice can be
+	// abandoned.
+	delete(chainIO.spentOutpoints, fundingOutpoint)
+	abandoned, err := splicer.validateSpliceAbandon(spliceTxid)
+	if err != nil {
+		t.Fatalf("unable to validate splice abandonment: %v", err)
+	}
+	if abandoned.SpliceTx.TxHash() != spliceTxid {
+		t.Fatalf("expected splice %v, got %v", spliceTxid,
+			abandoned.SpliceTx.TxHash())
+	}
+}
@@ -0,0 +1,251 @@
+package peeraccess
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/routing/route"
+)
+
+var (
+	// ErrDenied is returned when a peer matches a rule of the deny list.
+	ErrDenied = errors.New("peer is on the deny list")
+
+	// ErrNotAllowed is returned when inbound connections are restricted,
+	// and a peer neither matches a rule of the allow list, nor has any
+	// channels with us.
+	ErrNotAllowed = errors.New("inbound connections are restricted to " +
+		"allowed peers and peers with channels")
+)
+
+// List identifies one of the two rule lists maintained by the Manager.
+type List uint8
+
+const (
+	// Allow is the list of peers that are always accepted, even if
+	// inbound connections are restricted.
+	Allow List = iota
+
+	// Deny is the list of peers that are never accepted.
+	Deny
+)
+
+// String returns a human readable name of the list.
+func (l List) String() string {
+	switch l {
+	case Allow:
+		return "allow"
+	case Deny:
+		return "deny"
+	default:
+		return fmt.Sprintf("unknown list %d", l)
+	}
+}
+
+// Rule matches peers either by their node public key, or by the IP address
+// they connect from.
+type Rule struct {
+	// pubKey is the public key matched by the rule, if it's a node rule.
+	pubKey *route.Vertex
+
+	// ipNet is the network matched by the rule, if it's an address rule.
+	// Rules for a single IP address are stored as a network of the full
+	// address length.
+	ipNet *net.IPNet
+}
+
+// ParseRule parses a rule, which is either a hex encoded compressed node
+// public key, an IP address, or a network in CIDR notation.
+func ParseRule(rule string) (Rule, error) {
+	rule = strings.TrimSpace(rule)
+
+	if _, ipNet, err := net.ParseCIDR(rule); err == nil {
+		return Rule{ipNet: ipNet}, nil
+	}
+
+	if ip := net.ParseIP(rule); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = 8 * net.IPv4len
+		}
+
+		ipNet := &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+
+		return Rule{ipNet: ipNet}, nil
+	}
+
+	pubKeyBytes, err := hex.DecodeString(rule)
+	if err != nil {
+		return Rule{}, fmt.Errorf("rule %q is neither a public key, "+
+			"an IP address nor a network", rule)
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return Rule{}, fmt.Errorf("invalid public key in rule %q: %v",
+			rule, err)
+	}
+	vertex := route.NewVertex(pubKey)
+
+	return Rule{pubKey: &vertex}, nil
+}
+
+// String returns the canonical representation of the rule, which can be
+// parsed again using ParseRule.
+func (r Rule) String() string {
+	if r.pubKey != nil {
+		return r.pubKey.String()
+	}
+
+	// A rule for a single address is shown without its prefix length.
+	ones, bits := r.ipNet.Mask.Size()
+	if ones == bits {
+		return r.ipNet.IP.String()
+	}
+
+	return r.ipNet.String()
+}
+
+// ruleSet is a set of rules, indexed by their canonical representation.
+type ruleSet map[string]Rule
+
+// matches returns true if any rule of the set matches the passed public key,
+// or the IP address, if known.
+func (s ruleSet) matches(pubKey *route.Vertex, ip net.IP) bool {
+	for _, rule := range s {
+		switch {
+		case rule.pubKey != nil && pubKey != nil:
+			if *rule.pubKey == *pubKey {
+				return true
+			}
+
+		case rule.ipNet != nil && ip != nil:
+			if rule.ipNet.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Manager maintains the allow and deny lists that control which peers may
+// connect to us. Rules can be added and removed at runtime, and the Manager
+// is safe for concurrent use.
+type Manager struct {
+	mu    sync.RWMutex
+	lists map[List]ruleSet
+}
+
+// NewManager creates a new Manager with empty allow and deny lists.
+func NewManager() *Manager {
+	return &Manager{
+		lists: map[List]ruleSet{
+			Allow: make(ruleSet),
+			Deny:  make(ruleSet),
+		},
+	}
+}
+
+// AddRule adds the rule to the given list. Adding a rule that is already on
+// the list is a NOP.
+func (m *Manager) AddRule(list List, rule Rule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rules, ok := m.lists[list]
+	if !ok {
+		return fmt.Errorf("invalid list: %v", list)
+	}
+	rules[rule.String()] = rule
+
+	return nil
+}
+
+// RemoveRule removes the rule from the given list. An error is returned if
+// the rule isn't on the list.
+func (m *Manager) RemoveRule(list List, rule Rule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rules, ok := m.lists[list]
+	if !ok {
+		return fmt.Errorf("invalid list: %v", list)
+	}
+
+	key := rule.String()
+	if _, ok := rules[key]; !ok {
+		return fmt.Errorf("rule %v not found on %v list", key, list)
+	}
+	delete(rules, key)
+
+	return nil
+}
+
+// Rules returns the rules of the given list, sorted by their canonical
+// representation.
+func (m *Manager) Rules(list List) []Rule {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	rules := make([]Rule, 0, len(m.lists[list]))
+	for _, rule := range m.lists[list] {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].String() < rules[j].String()
+	})
+
+	return rules
+}
+
+// AddrDenied returns true if the passed address matches an address rule of
+// the deny list. This allows connections to be rejected before the peer's
+// public key is known.
+func (m *Manager) AddrDenied(addr net.Addr) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lists[Deny].matches(nil, addrIP(addr))
+}
+
+// CheckPeer checks whether the peer with the given public key connecting from
+// the passed address is allowed to connect. Rules of the deny list take
+// precedence over those of the allow list. If restricted is true, the peer
+// must either match a rule of the allow list, or hasChannels must be true.
+func (m *Manager) CheckPeer(pubKey *btcec.PublicKey, addr net.Addr,
+	restricted, hasChannels bool) error {
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	vertex := route.NewVertex(pubKey)
+	ip := addrIP(addr)
+
+	if m.lists[Deny].matches(&vertex, ip) {
+		return ErrDenied
+	}
+
+	if !restricted || hasChannels || m.lists[Allow].matches(&vertex, ip) {
+		return nil
+	}
+
+	return ErrNotAllowed
+}
+
+// addrIP returns the IP address of the passed address, or nil if it isn't a
+// TCP address, such as for connections made over Tor.
+func addrIP(addr net.Addr) net.IP {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return nil
+	}
+
+	return tcpAddr.IP
+}
@@ -0,0 +1,218 @@
+package peeraccess_test
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/peeraccess"
+)
+
+// newTestKey returns a fresh public key along with its hex encoding.
+func newTestKey(t *testing.T) (*btcec.PublicKey, string) {
+	t.Helper()
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	pub := priv.PubKey()
+
+	return pub, hex.EncodeToString(pub.SerializeCompressed())
+}
+
+// mustParseRule parses the rule, failing the test if it's invalid.
+func mustParseRule(t *testing.T, rule string) peeraccess.Rule {
+	t.Helper()
+
+	r, err := peeraccess.ParseRule(rule)
+	if err != nil {
+		t.Fatalf("unable to parse rule %v: %v", rule, err)
+	}
+
+	return r
+}
+
+// TestParseRule asserts that public keys, IP addresses and networks are
+// parsed into their canonical representation, and invalid rules are rejected.
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	_, pubKeyHex := newTestKey(t)
+
+	tests := []struct {
+		rule      string
+		canonical string
+		valid     bool
+	}{
+		{
+			rule:      pubKeyHex,
+			canonical: pubKeyHex,
+			valid:     true,
+		},
+		{
+			rule:      "10.0.0.1",
+			canonical: "10.0.0.1",
+			valid:     true,
+		},
+		{
+			rule:      "10.0.0.1/32",
+			canonical: "10.0.0.1",
+			valid:     true,
+		},
+		{
+			rule:      "10.1.2.3/16",
+			canonical: "10.1.0.0/16",
+			valid:     true,
+		},
+		{
+			rule:      "2001:db8::/32",
+			canonical: "2001:db8::/32",
+			valid:     true,
+		},
+		{
+			rule: "02deadbeef",
+		},
+		{
+			rule: "not a rule",
+		},
+	}
+
+	for _, test := range tests {
+		rule, err := peeraccess.ParseRule(test.rule)
+		switch {
+		case test.valid && err != nil:
+			t.Fatalf("valid rule %v was invalid: %v", test.rule,
+				err)
+
+		case !test.valid && err == nil:
+			t.Fatalf("invalid rule %v was valid", test.rule)
+
+		case test.valid && rule.String() != test.canonical:
+			t.Fatalf("expected rule %v, got %v", test.canonical,
+				rule)
+		}
+	}
+}
+
+// TestCheckPeer asserts that peers on the deny list are always rejected, and
+// that restricted inbound connections are only accepted from peers on the
+// allow list, or with channels.
+func TestCheckPeer(t *testing.T) {
+	t.Parallel()
+
+	allowedKey, allowedHex := newTestKey(t)
+	deniedKey, deniedHex := newTestKey(t)
+	otherKey, _ := newTestKey(t)
+
+	m := peeraccess.NewManager()
+	rules := []struct {
+		list peeraccess.List
+		rule string
+	}{
+		{peeraccess.Allow, allowedHex},
+		{peeraccess.Allow, "192.168.0.0/16"},
+		{peeraccess.Deny, deniedHex},
+		{peeraccess.Deny, "10.0.0.0/8"},
+	}
+	for _, r := range rules {
+		err := m.AddRule(r.list, mustParseRule(t, r.rule))
+		if err != nil {
+			t.Fatalf("unable to add rule: %v", err)
+		}
+	}
+
+	var (
+		publicAddr  = &net.TCPAddr{IP: net.ParseIP("1.2.3.4")}
+		allowedAddr = &net.TCPAddr{IP: net.ParseIP("192.168.1.1")}
+		deniedAddr  = &net.TCPAddr{IP: net.ParseIP("10.1.1.1")}
+	)
+
+	tests := []struct {
+		name        string
+		pubKey      *btcec.PublicKey
+		addr        net.Addr
+		restricted  bool
+		hasChannels bool
+		expErr      error
+	}{
+		{
+			name:   "unrestricted",
+			pubKey: otherKey,
+			addr:   publicAddr,
+		},
+		{
+			name:        "denied key with channels",
+			pubKey:      deniedKey,
+			addr:        publicAddr,
+			hasChannels: true,
+			expErr:      peeraccess.ErrDenied,
+		},
+		{
+			name:   "allowed key from denied address",
+			pubKey: allowedKey,
+			addr:   deniedAddr,
+			expErr: peeraccess.ErrDenied,
+		},
+		{
+			name:       "restricted unknown peer",
+			pubKey:     otherKey,
+			addr:       publicAddr,
+			restricted: true,
+			expErr:     peeraccess.ErrNotAllowed,
+		},
+		{
+			name:        "restricted peer with channels",
+			pubKey:      otherKey,
+			addr:        publicAddr,
+			restricted:  true,
+			hasChannels: true,
+		},
+		{
+			name:       "restricted allowed key",
+			pubKey:     allowedKey,
+			addr:       publicAddr,
+			restricted: true,
+		},
+		{
+			name:       "restricted allowed address",
+			pubKey:     otherKey,
+			addr:       allowedAddr,
+			restricted: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := m.CheckPeer(
+			test.pubKey, test.addr, test.restricted,
+			test.hasChannels,
+		)
+		if err != test.expErr {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.expErr, err)
+		}
+	}
+
+	if !m.AddrDenied(deniedAddr) || m.AddrDenied(publicAddr) {
+		t.Fatalf("address deny list not applied")
+	}
+
+	// Once the address rule is removed from the deny list, the address is
+	// no longer denied.
+	err := m.RemoveRule(peeraccess.Deny, mustParseRule(t, "10.0.0.0/8"))
+	if err != nil {
+		t.Fatalf("unable to remove rule: %v", err)
+	}
+	if m.AddrDenied(deniedAddr) {
+		t.Fatalf("removed rule still applied")
+	}
+	if len(m.Rules(peeraccess.Deny)) != 1 {
+		t.Fatalf("expected a single rule on the deny list")
+	}
+
+	err = m.RemoveRule(peeraccess.Deny, mustParseRule(t, "10.0.0.0/8"))
+	if err == nil {
+		t.Fatalf("expected removing an unknown rule to fail")
+	}
+}
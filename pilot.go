@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/wakiyamap/lnd/autopilot"
+	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/tor"
 )
@@ -162,7 +163,7 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.ManagerCfg, er
 
 	// With the heuristic itself created, we can now populate the remainder
 	// of the items that the autopilot agent needs to perform its duties.
-	self := svr.identityPriv.PubKey()
+	self := svr.identityECDH.PubKey()
 	pilotCfg := autopilot.Config{
 		Self:      self,
 		Heuristic: weightedAttachment,
@@ -171,8 +172,12 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.ManagerCfg, er
 			private:  cfg.Private,
 			minConfs: cfg.MinConfs,
 		},
+		// Channels are only funded from the default account, so we'll
+		// only consider its balance.
 		WalletBalance: func() (btcutil.Amount, error) {
-			return svr.cc.wallet.ConfirmedBalance(cfg.MinConfs)
+			return svr.cc.wallet.AccountBalance(
+				lnwallet.DefaultAccountName, cfg.MinConfs,
+			)
 		},
 		Graph:       autopilot.ChannelGraphFromDatabase(svr.chanDB.ChannelGraph()),
 		Constraints: atplConstraints,
@@ -0,0 +1,121 @@
+package psbt
+
+import (
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Finalize finalizes the input at the passed index of the packet, by
+// constructing its final signature script and witness from its partial
+// signature. Inputs spending P2PKH, P2WKH and P2SH nested P2WKH outputs are
+// supported, all of which require a single signature. Once finalized, the
+// information that was only required to sign the input is removed.
+func Finalize(p *Packet, inIndex int) error {
+	if inIndex < 0 || inIndex >= len(p.Inputs) {
+		return ErrInvalidPsbtFormat
+	}
+
+	pInput := &p.Inputs[inIndex]
+	if pInput.IsFinalized() {
+		return nil
+	}
+
+	prevOut := p.UnsignedTx.TxIn[inIndex].PreviousOutPoint
+	txOut := pInput.prevOutput(prevOut)
+	if txOut == nil || len(pInput.PartialSigs) != 1 {
+		return ErrNotFinalizable
+	}
+	sig := pInput.PartialSigs[0]
+
+	var (
+		sigScript []byte
+		witness   wire.TxWitness
+		err       error
+	)
+	switch txscript.GetScriptClass(txOut.PkScript) {
+	case txscript.PubKeyHashTy:
+		sigScript, err = txscript.NewScriptBuilder().
+			AddData(sig.Signature).
+			AddData(sig.PubKey).
+			Script()
+		if err != nil {
+			return err
+		}
+
+	case txscript.WitnessV0PubKeyHashTy:
+		witness = wire.TxWitness{sig.Signature, sig.PubKey}
+
+	// A P2SH output can only be finalized if it nests a P2WKH output.
+	case txscript.ScriptHashTy:
+		if txscript.GetScriptClass(pInput.RedeemScript) !=
+			txscript.WitnessV0PubKeyHashTy {
+
+			return ErrUnsupportedScriptType
+		}
+
+		sigScript, err = txscript.NewScriptBuilder().
+			AddData(pInput.RedeemScript).
+			Script()
+		if err != nil {
+			return err
+		}
+		witness = wire.TxWitness{sig.Signature, sig.PubKey}
+
+	default:
+		return ErrUnsupportedScriptType
+	}
+
+	if witness != nil {
+		pInput.FinalScriptWitness, err = serializeTxWitness(witness)
+		if err != nil {
+			return err
+		}
+	}
+	if sigScript != nil {
+		pInput.FinalScriptSig = sigScript
+	}
+
+	pInput.PartialSigs = nil
+	pInput.SighashType = 0
+	pInput.RedeemScript = nil
+	pInput.WitnessScript = nil
+	pInput.Bip32Derivation = nil
+
+	return nil
+}
+
+// MaybeFinalizeAll attempts to finalize all inputs of the packet that aren't
+// finalized yet. An error is returned if any of them can't be finalized.
+func MaybeFinalizeAll(p *Packet) error {
+	for i := range p.Inputs {
+		if err := Finalize(p, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Extract returns the signed transaction of a complete packet, which carries
+// the final signature scripts and witnesses of its inputs.
+func Extract(p *Packet) (*wire.MsgTx, error) {
+	if !p.IsComplete() {
+		return nil, ErrIncompletePSBT
+	}
+
+	finalTx := p.UnsignedTx.Copy()
+	for i, txIn := range finalTx.TxIn {
+		pInput := p.Inputs[i]
+
+		txIn.SignatureScript = pInput.FinalScriptSig
+		if pInput.FinalScriptWitness != nil {
+			witness, err := readTxWitness(pInput.FinalScriptWitness)
+			if err != nil {
+				return nil, err
+			}
+			txIn.Witness = witness
+		}
+	}
+
+	return finalTx, nil
+}
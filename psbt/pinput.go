@@ -0,0 +1,324 @@
+package psbt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// PartialSig is a signature for an input of a packet, together with the
+// public key it is valid for.
+type PartialSig struct {
+	// PubKey is the serialized public key the signature is valid for.
+	PubKey []byte
+
+	// Signature is the DER encoded signature, followed by its sighash
+	// flag.
+	Signature []byte
+}
+
+// Bip32Derivation describes how a public key was derived from a master key,
+// which allows a signer to find out whether it holds the private key.
+type Bip32Derivation struct {
+	// PubKey is the serialized public key that was derived.
+	PubKey []byte
+
+	// MasterKeyFingerprint is the fingerprint of the master key the public
+	// key was derived from.
+	MasterKeyFingerprint uint32
+
+	// Bip32Path is the derivation path of the public key.
+	Bip32Path []uint32
+}
+
+// PInput holds the information that is known about an input of a packet.
+type PInput struct {
+	// NonWitnessUtxo is the full transaction that created the output
+	// spent by the input.
+	NonWitnessUtxo *wire.MsgTx
+
+	// WitnessUtxo is the output spent by a segwit input.
+	WitnessUtxo *wire.TxOut
+
+	// PartialSigs are the signatures that were created for the input.
+	PartialSigs []*PartialSig
+
+	// SighashType is the sighash flag the input is to be signed with. It
+	// is zero if no flag was specified.
+	SighashType txscript.SigHashType
+
+	// RedeemScript is the redeem script of a P2SH input.
+	RedeemScript []byte
+
+	// WitnessScript is the witness script of a P2WSH input.
+	WitnessScript []byte
+
+	// Bip32Derivation describes the derivation of the public keys
+	// required to sign the input.
+	Bip32Derivation []*Bip32Derivation
+
+	// FinalScriptSig is the final signature script of a finalized input.
+	FinalScriptSig []byte
+
+	// FinalScriptWitness is the serialized final witness of a finalized
+	// input.
+	FinalScriptWitness []byte
+
+	// Unknowns are the key-value pairs of the input of types we don't know
+	// about.
+	Unknowns []*Unknown
+}
+
+// IsFinalized returns true if the final signature script or witness of the
+// input is known.
+func (pi *PInput) IsFinalized() bool {
+	return pi.FinalScriptSig != nil || pi.FinalScriptWitness != nil
+}
+
+// deserialize reads the map of the input from r.
+func (pi *PInput) deserialize(r io.Reader) error {
+	seen := make(map[string]struct{})
+	for {
+		pair, err := readKVPair(r)
+		if err != nil {
+			return err
+		}
+		if pair == nil {
+			return nil
+		}
+
+		if err := checkDuplicate(seen, pair); err != nil {
+			return err
+		}
+
+		switch InputType(pair.keyType) {
+		case NonWitnessUtxoType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+
+			tx := wire.NewMsgTx(wire.TxVersion)
+			err := tx.Deserialize(bytes.NewReader(pair.value))
+			if err != nil {
+				return ErrInvalidPsbtFormat
+			}
+			pi.NonWitnessUtxo = tx
+
+		case WitnessUtxoType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+
+			txOut, err := readTxOut(pair.value)
+			if err != nil {
+				return err
+			}
+			pi.WitnessUtxo = txOut
+
+		case PartialSigType:
+			if err := validatePubKey(pair.keyData); err != nil {
+				return err
+			}
+			if err := validateSignature(pair.value); err != nil {
+				return err
+			}
+
+			pi.PartialSigs = append(pi.PartialSigs, &PartialSig{
+				PubKey:    pair.keyData,
+				Signature: pair.value,
+			})
+
+		case SighashType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			if len(pair.value) != 4 {
+				return ErrInvalidPsbtFormat
+			}
+
+			pi.SighashType = txscript.SigHashType(
+				binary.LittleEndian.Uint32(pair.value),
+			)
+
+		case RedeemScriptInputType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			pi.RedeemScript = pair.value
+
+		case WitnessScriptInputType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			pi.WitnessScript = pair.value
+
+		case Bip32DerivationInputType:
+			if err := validatePubKey(pair.keyData); err != nil {
+				return err
+			}
+
+			fingerprint, path, err := readBip32Derivation(
+				pair.value,
+			)
+			if err != nil {
+				return err
+			}
+
+			pi.Bip32Derivation = append(
+				pi.Bip32Derivation, &Bip32Derivation{
+					PubKey:               pair.keyData,
+					MasterKeyFingerprint: fingerprint,
+					Bip32Path:            path,
+				},
+			)
+
+		case FinalScriptSigType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			pi.FinalScriptSig = pair.value
+
+		case FinalScriptWitnessType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			if _, err := readTxWitness(pair.value); err != nil {
+				return err
+			}
+			pi.FinalScriptWitness = pair.value
+
+		default:
+			pi.Unknowns = append(pi.Unknowns, pair.unknown())
+		}
+	}
+}
+
+// serialize writes the map of the input to w.
+func (pi *PInput) serialize(w io.Writer) error {
+	if pi.NonWitnessUtxo != nil {
+		var tx bytes.Buffer
+		if err := pi.NonWitnessUtxo.Serialize(&tx); err != nil {
+			return err
+		}
+
+		err := serializeKVPair(
+			w, uint8(NonWitnessUtxoType), nil, tx.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.WitnessUtxo != nil {
+		txOut, err := serializeTxOut(pi.WitnessUtxo)
+		if err != nil {
+			return err
+		}
+
+		err = serializeKVPair(w, uint8(WitnessUtxoType), nil, txOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, sig := range pi.PartialSigs {
+		err := serializeKVPair(
+			w, uint8(PartialSigType), sig.PubKey,
+			sig.Signature,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.SighashType != 0 {
+		var sighash [4]byte
+		binary.LittleEndian.PutUint32(
+			sighash[:], uint32(pi.SighashType),
+		)
+
+		err := serializeKVPair(
+			w, uint8(SighashType), nil, sighash[:],
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.RedeemScript != nil {
+		err := serializeKVPair(
+			w, uint8(RedeemScriptInputType), nil,
+			pi.RedeemScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.WitnessScript != nil {
+		err := serializeKVPair(
+			w, uint8(WitnessScriptInputType), nil,
+			pi.WitnessScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, derivation := range pi.Bip32Derivation {
+		err := serializeKVPair(
+			w, uint8(Bip32DerivationInputType),
+			derivation.PubKey, serializeBip32Derivation(
+				derivation.MasterKeyFingerprint,
+				derivation.Bip32Path,
+			),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.FinalScriptSig != nil {
+		err := serializeKVPair(
+			w, uint8(FinalScriptSigType), nil, pi.FinalScriptSig,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.FinalScriptWitness != nil {
+		err := serializeKVPair(
+			w, uint8(FinalScriptWitnessType), nil,
+			pi.FinalScriptWitness,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := serializeUnknowns(w, pi.Unknowns); err != nil {
+		return err
+	}
+
+	return writeSeparator(w)
+}
+
+// prevOutput returns the output spent by the input, which is spending the
+// passed outpoint. Nil is returned if the output isn't known.
+func (pi *PInput) prevOutput(prevOut wire.OutPoint) *wire.TxOut {
+	switch {
+	case pi.WitnessUtxo != nil:
+		return pi.WitnessUtxo
+
+	case pi.NonWitnessUtxo != nil &&
+		prevOut.Index < uint32(len(pi.NonWitnessUtxo.TxOut)):
+
+		return pi.NonWitnessUtxo.TxOut[prevOut.Index]
+	}
+
+	return nil
+}
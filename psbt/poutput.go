@@ -0,0 +1,118 @@
+package psbt
+
+import (
+	"io"
+)
+
+// POutput holds the information that is known about an output of a packet.
+type POutput struct {
+	// RedeemScript is the redeem script of a P2SH output.
+	RedeemScript []byte
+
+	// WitnessScript is the witness script of a P2WSH output.
+	WitnessScript []byte
+
+	// Bip32Derivation describes the derivation of the public keys of the
+	// output, which allows a signer to recognize its change.
+	Bip32Derivation []*Bip32Derivation
+
+	// Unknowns are the key-value pairs of the output of types we don't
+	// know about.
+	Unknowns []*Unknown
+}
+
+// deserialize reads the map of the output from r.
+func (po *POutput) deserialize(r io.Reader) error {
+	seen := make(map[string]struct{})
+	for {
+		pair, err := readKVPair(r)
+		if err != nil {
+			return err
+		}
+		if pair == nil {
+			return nil
+		}
+
+		if err := checkDuplicate(seen, pair); err != nil {
+			return err
+		}
+
+		switch OutputType(pair.keyType) {
+		case RedeemScriptOutputType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			po.RedeemScript = pair.value
+
+		case WitnessScriptOutputType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return err
+			}
+			po.WitnessScript = pair.value
+
+		case Bip32DerivationOutputType:
+			if err := validatePubKey(pair.keyData); err != nil {
+				return err
+			}
+
+			fingerprint, path, err := readBip32Derivation(
+				pair.value,
+			)
+			if err != nil {
+				return err
+			}
+
+			po.Bip32Derivation = append(
+				po.Bip32Derivation, &Bip32Derivation{
+					PubKey:               pair.keyData,
+					MasterKeyFingerprint: fingerprint,
+					Bip32Path:            path,
+				},
+			)
+
+		default:
+			po.Unknowns = append(po.Unknowns, pair.unknown())
+		}
+	}
+}
+
+// serialize writes the map of the output to w.
+func (po *POutput) serialize(w io.Writer) error {
+	if po.RedeemScript != nil {
+		err := serializeKVPair(
+			w, uint8(RedeemScriptOutputType), nil, po.RedeemScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if po.WitnessScript != nil {
+		err := serializeKVPair(
+			w, uint8(WitnessScriptOutputType), nil,
+			po.WitnessScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, derivation := range po.Bip32Derivation {
+		err := serializeKVPair(
+			w, uint8(Bip32DerivationOutputType), derivation.PubKey,
+			serializeBip32Derivation(
+				derivation.MasterKeyFingerprint,
+				derivation.Bip32Path,
+			),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := serializeUnknowns(w, po.Unknowns); err != nil {
+		return err
+	}
+
+	return writeSeparator(w)
+}
@@ -0,0 +1,277 @@
+// Package psbt implements the Partially Signed Bitcoin Transaction format
+// defined in BIP 174. A packet carries an unsigned transaction, along with the
+// information each signer requires to sign its inputs, such that several
+// parties are able to collaboratively construct and sign a transaction.
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// psbtMagic is the magic that every serialized packet starts with: "psbt"
+// followed by 0xff.
+var psbtMagic = [5]byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+var (
+	// ErrInvalidMagicBytes is returned if a packet doesn't start with the
+	// psbt magic.
+	ErrInvalidMagicBytes = errors.New("invalid psbt magic bytes")
+
+	// ErrInvalidPsbtFormat is returned if a packet can't be parsed, or
+	// doesn't carry the fields required by BIP 174.
+	ErrInvalidPsbtFormat = errors.New("invalid psbt format")
+
+	// ErrDuplicateKey is returned if a key occurs more than once within
+	// the same map of a packet.
+	ErrDuplicateKey = errors.New("duplicate key within psbt map")
+
+	// ErrInvalidKeyData is returned if the key data of a key-value pair is
+	// invalid for its type.
+	ErrInvalidKeyData = errors.New("invalid key data")
+
+	// ErrInvalidSignature is returned if a partial signature isn't a DER
+	// encoded signature followed by a sighash flag.
+	ErrInvalidSignature = errors.New("invalid partial signature")
+
+	// ErrNotFinalizable is returned if an input lacks the information
+	// required to finalize it.
+	ErrNotFinalizable = errors.New("input can't be finalized")
+
+	// ErrUnsupportedScriptType is returned if an input spends an output
+	// of a type that can't be finalized by this package.
+	ErrUnsupportedScriptType = errors.New("unsupported script type")
+
+	// ErrIncompletePSBT is returned if a transaction is extracted from a
+	// packet of which not all inputs are finalized.
+	ErrIncompletePSBT = errors.New("psbt isn't complete")
+)
+
+// Packet is a partially signed transaction. Its inputs and outputs carry the
+// information that is known about the inputs and outputs of the unsigned
+// transaction at the same index.
+type Packet struct {
+	// UnsignedTx is the transaction that is being signed. Its inputs
+	// MUST NOT carry signature scripts or witnesses.
+	UnsignedTx *wire.MsgTx
+
+	// Inputs holds the information that is known about each input of the
+	// unsigned transaction.
+	Inputs []PInput
+
+	// Outputs holds the information that is known about each output of
+	// the unsigned transaction.
+	Outputs []POutput
+
+	// Unknowns are the global key-value pairs of types we don't know
+	// about.
+	Unknowns []*Unknown
+}
+
+// NewFromUnsignedTx creates a new packet for the passed unsigned transaction,
+// without any information about its inputs and outputs.
+func NewFromUnsignedTx(tx *wire.MsgTx) (*Packet, error) {
+	if err := checkUnsignedTx(tx); err != nil {
+		return nil, err
+	}
+
+	return &Packet{
+		UnsignedTx: tx,
+		Inputs:     make([]PInput, len(tx.TxIn)),
+		Outputs:    make([]POutput, len(tx.TxOut)),
+	}, nil
+}
+
+// NewFromRawBytes parses a serialized packet from r. If b64 is true, the
+// packet is expected to be base64 encoded, which is its usual encoding when
+// passed around as text.
+func NewFromRawBytes(r io.Reader, b64 bool) (*Packet, error) {
+	if b64 {
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+
+	var magic [5]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, ErrInvalidMagicBytes
+	}
+	if magic != psbtMagic {
+		return nil, ErrInvalidMagicBytes
+	}
+
+	// First, we'll read the global map, which holds the unsigned
+	// transaction.
+	packet := &Packet{}
+	seen := make(map[string]struct{})
+	for {
+		pair, err := readKVPair(r)
+		if err != nil {
+			return nil, err
+		}
+		if pair == nil {
+			break
+		}
+
+		if err := checkDuplicate(seen, pair); err != nil {
+			return nil, err
+		}
+
+		switch GlobalType(pair.keyType) {
+		case UnsignedTxType:
+			if err := checkEmptyKeyData(pair); err != nil {
+				return nil, err
+			}
+
+			tx := wire.NewMsgTx(wire.TxVersion)
+			err := tx.DeserializeNoWitness(
+				bytes.NewReader(pair.value),
+			)
+			if err != nil {
+				return nil, ErrInvalidPsbtFormat
+			}
+			if err := checkUnsignedTx(tx); err != nil {
+				return nil, err
+			}
+
+			packet.UnsignedTx = tx
+
+		default:
+			packet.Unknowns = append(
+				packet.Unknowns, pair.unknown(),
+			)
+		}
+	}
+
+	if packet.UnsignedTx == nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	// The global map is followed by one map for each input, and one map
+	// for each output of the unsigned transaction.
+	packet.Inputs = make([]PInput, len(packet.UnsignedTx.TxIn))
+	for i := range packet.Inputs {
+		if err := packet.Inputs[i].deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+
+	packet.Outputs = make([]POutput, len(packet.UnsignedTx.TxOut))
+	for i := range packet.Outputs {
+		if err := packet.Outputs[i].deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := packet.SanityCheck(); err != nil {
+		return nil, err
+	}
+
+	return packet, nil
+}
+
+// Serialize writes the binary serialization of the packet to w.
+func (p *Packet) Serialize(w io.Writer) error {
+	if _, err := w.Write(psbtMagic[:]); err != nil {
+		return err
+	}
+
+	var tx bytes.Buffer
+	if err := p.UnsignedTx.SerializeNoWitness(&tx); err != nil {
+		return err
+	}
+	err := serializeKVPair(w, uint8(UnsignedTxType), nil, tx.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := serializeUnknowns(w, p.Unknowns); err != nil {
+		return err
+	}
+	if err := writeSeparator(w); err != nil {
+		return err
+	}
+
+	for _, pInput := range p.Inputs {
+		if err := pInput.serialize(w); err != nil {
+			return err
+		}
+	}
+
+	for _, pOutput := range p.Outputs {
+		if err := pOutput.serialize(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// B64Encode returns the base64 encoding of the serialized packet.
+func (p *Packet) B64Encode() (string, error) {
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// IsComplete returns true if all inputs of the packet are finalized, in which
+// case the signed transaction can be extracted.
+func (p *Packet) IsComplete() bool {
+	for _, pInput := range p.Inputs {
+		if !pInput.IsFinalized() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SanityCheck checks that the packet is consistent with its unsigned
+// transaction.
+func (p *Packet) SanityCheck() error {
+	if p.UnsignedTx == nil {
+		return ErrInvalidPsbtFormat
+	}
+	if err := checkUnsignedTx(p.UnsignedTx); err != nil {
+		return err
+	}
+
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) ||
+		len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+
+		return ErrInvalidPsbtFormat
+	}
+
+	// If the full transaction that created the spent output is known, it
+	// must match the outpoint that is spent.
+	for i, pInput := range p.Inputs {
+		if pInput.NonWitnessUtxo == nil {
+			continue
+		}
+
+		prevOut := p.UnsignedTx.TxIn[i].PreviousOutPoint
+		if pInput.NonWitnessUtxo.TxHash() != prevOut.Hash ||
+			prevOut.Index >= uint32(len(pInput.NonWitnessUtxo.TxOut)) {
+
+			return ErrInvalidPsbtFormat
+		}
+	}
+
+	return nil
+}
+
+// checkUnsignedTx returns an error if any input of the passed transaction
+// carries a signature script or witness.
+func checkUnsignedTx(tx *wire.MsgTx) error {
+	for _, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 0 {
+			return ErrInvalidPsbtFormat
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,415 @@
+package psbt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+)
+
+var (
+	testPrivKey, testPubKey = btcec.PrivKeyFromBytes(
+		btcec.S256(), bytes.Repeat([]byte{0x01}, 32),
+	)
+
+	testPrevHash = chainhash.Hash{0x02}
+)
+
+// p2wkhScript returns the P2WKH output script paying to the passed key.
+func p2wkhScript(t *testing.T, pubKey *btcec.PublicKey) []byte {
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(pubKey.SerializeCompressed())).
+		Script()
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	return script
+}
+
+// p2shScript returns the P2SH output script paying to the passed redeem
+// script.
+func p2shScript(t *testing.T, redeemScript []byte) []byte {
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(redeemScript)).
+		AddOp(txscript.OP_EQUAL).
+		Script()
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	return script
+}
+
+// p2pkhScript returns the P2PKH output script paying to the passed key.
+func p2pkhScript(t *testing.T, pubKey *btcec.PublicKey) []byte {
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(pubKey.SerializeCompressed())).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	return script
+}
+
+// newTestPacket creates a packet of which every field is populated.
+func newTestPacket(t *testing.T) *Packet {
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{0x03}},
+		SignatureScript:  []byte{0x01, 0x02},
+		Witness:          wire.TxWitness{{0x03}},
+	})
+	prevTx.AddTxOut(wire.NewTxOut(1000, p2pkhScript(t, testPubKey)))
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: prevTx.TxHash()}, nil, nil))
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: testPrevHash}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(900, p2wkhScript(t, testPubKey)))
+	tx.LockTime = 100
+
+	packet, err := NewFromUnsignedTx(tx)
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+
+	sig, err := testPrivKey.Sign(chainhash.DoubleHashB([]byte("test")))
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	pubKey := testPubKey.SerializeCompressed()
+	derivation := &Bip32Derivation{
+		PubKey:               pubKey,
+		MasterKeyFingerprint: 0xdeadbeef,
+		Bip32Path:            []uint32{0x80000054, 0x80000000, 0, 1},
+	}
+
+	packet.Unknowns = []*Unknown{{Key: []byte{0xf0}, Value: []byte{1}}}
+	packet.Inputs[0] = PInput{
+		NonWitnessUtxo: prevTx,
+		PartialSigs: []*PartialSig{{
+			PubKey: pubKey,
+			Signature: append(
+				sig.Serialize(), byte(txscript.SigHashAll),
+			),
+		}},
+		SighashType:     txscript.SigHashAll,
+		RedeemScript:    []byte{0x04},
+		WitnessScript:   []byte{0x05},
+		Bip32Derivation: []*Bip32Derivation{derivation},
+		Unknowns: []*Unknown{
+			{Key: []byte{0xf1, 0x01}, Value: []byte{2}},
+		},
+	}
+	packet.Inputs[1] = PInput{
+		WitnessUtxo: wire.NewTxOut(
+			2000, p2wkhScript(t, testPubKey),
+		),
+		FinalScriptSig:     []byte{0x06},
+		FinalScriptWitness: []byte{0x01, 0x01, 0x07},
+	}
+	packet.Outputs[0] = POutput{
+		RedeemScript:    []byte{0x08},
+		WitnessScript:   []byte{0x09},
+		Bip32Derivation: []*Bip32Derivation{derivation},
+		Unknowns: []*Unknown{
+			{Key: []byte{0xf2}, Value: []byte{3}},
+		},
+	}
+
+	return packet
+}
+
+// TestPacketRoundTrip asserts that a packet is unchanged by serializing and
+// parsing it again, both in its binary and base64 encoding.
+func TestPacketRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	packet := newTestPacket(t)
+
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	serialized := b.Bytes()
+
+	parsed, err := NewFromRawBytes(bytes.NewReader(serialized), false)
+	if err != nil {
+		t.Fatalf("unable to parse packet: %v", err)
+	}
+
+	var b2 bytes.Buffer
+	if err := parsed.Serialize(&b2); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	if !bytes.Equal(serialized, b2.Bytes()) {
+		t.Fatalf("serialization mismatch: expected %x, got %x",
+			serialized, b2.Bytes())
+	}
+
+	if parsed.UnsignedTx.TxHash() != packet.UnsignedTx.TxHash() {
+		t.Fatalf("unsigned tx mismatch")
+	}
+	if parsed.Inputs[0].NonWitnessUtxo.WitnessHash() !=
+		packet.Inputs[0].NonWitnessUtxo.WitnessHash() {
+
+		t.Fatalf("non-witness utxo mismatch")
+	}
+
+	// Apart from the transactions, which we compared by their hashes, all
+	// fields should be equal.
+	parsed.Inputs[0].NonWitnessUtxo = packet.Inputs[0].NonWitnessUtxo
+	parsed.UnsignedTx = packet.UnsignedTx
+	if !reflect.DeepEqual(parsed, packet) {
+		t.Fatalf("packet mismatch: expected %v, got %v",
+			spew.Sdump(packet), spew.Sdump(parsed))
+	}
+
+	// The same packet should be returned when encoded as base64.
+	b64, err := packet.B64Encode()
+	if err != nil {
+		t.Fatalf("unable to encode packet: %v", err)
+	}
+	parsed, err = NewFromRawBytes(bytes.NewReader([]byte(b64)), true)
+	if err != nil {
+		t.Fatalf("unable to parse base64 packet: %v", err)
+	}
+	b2.Reset()
+	if err := parsed.Serialize(&b2); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	if !bytes.Equal(serialized, b2.Bytes()) {
+		t.Fatalf("base64 serialization mismatch")
+	}
+}
+
+// TestPacketInvalid asserts that packets violating BIP 174 are rejected.
+func TestPacketInvalid(t *testing.T) {
+	t.Parallel()
+
+	var unsignedTx bytes.Buffer
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: testPrevHash}, nil, nil))
+	if err := tx.SerializeNoWitness(&unsignedTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+
+	var signedTx bytes.Buffer
+	tx.TxIn[0].SignatureScript = []byte{0x01}
+	if err := tx.SerializeNoWitness(&signedTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+
+	// packetBytes serializes the passed key-value pairs of the global map,
+	// followed by the separators of the other maps.
+	packetBytes := func(pairs ...*kvPair) []byte {
+		var b bytes.Buffer
+		b.Write(psbtMagic[:])
+		for _, pair := range pairs {
+			err := serializeKVPair(
+				&b, pair.keyType, pair.keyData, pair.value,
+			)
+			if err != nil {
+				t.Fatalf("unable to serialize pair: %v", err)
+			}
+		}
+		b.Write([]byte{0x00, 0x00})
+		return b.Bytes()
+	}
+	txPair := &kvPair{
+		keyType: uint8(UnsignedTxType),
+		value:   unsignedTx.Bytes(),
+	}
+
+	tests := []struct {
+		name   string
+		packet []byte
+		err    error
+	}{
+		{
+			name:   "invalid magic",
+			packet: []byte{0x70, 0x73, 0x62, 0x74, 0x00, 0x00},
+			err:    ErrInvalidMagicBytes,
+		},
+		{
+			name:   "missing unsigned tx",
+			packet: packetBytes(),
+			err:    ErrInvalidPsbtFormat,
+		},
+		{
+			name:   "duplicate key",
+			packet: packetBytes(txPair, txPair),
+			err:    ErrDuplicateKey,
+		},
+		{
+			name: "unsigned tx with key data",
+			packet: packetBytes(&kvPair{
+				keyType: uint8(UnsignedTxType),
+				keyData: []byte{0x01},
+				value:   unsignedTx.Bytes(),
+			}),
+			err: ErrInvalidKeyData,
+		},
+		{
+			name: "signed tx",
+			packet: packetBytes(&kvPair{
+				keyType: uint8(UnsignedTxType),
+				value:   signedTx.Bytes(),
+			}),
+			err: ErrInvalidPsbtFormat,
+		},
+		{
+			name:   "truncated",
+			packet: packetBytes(txPair)[:20],
+			err:    ErrInvalidPsbtFormat,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := NewFromRawBytes(bytes.NewReader(test.packet), false)
+		if err != test.err {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.err, err)
+		}
+	}
+
+	// A partial signature must be keyed by a valid public key.
+	var b bytes.Buffer
+	withoutInputMap := packetBytes(txPair)
+	b.Write(withoutInputMap[:len(withoutInputMap)-1])
+	err := serializeKVPair(
+		&b, uint8(PartialSigType), []byte{0x02, 0x03}, []byte{0x01},
+	)
+	if err != nil {
+		t.Fatalf("unable to serialize pair: %v", err)
+	}
+	b.Write([]byte{0x00})
+
+	_, err = NewFromRawBytes(&b, false)
+	if err != ErrInvalidKeyData {
+		t.Fatalf("expected error %v, got %v", ErrInvalidKeyData, err)
+	}
+}
+
+// TestFinalizeExtract asserts that signed inputs of all supported types are
+// finalized into a valid transaction.
+func TestFinalizeExtract(t *testing.T) {
+	t.Parallel()
+
+	pubKey := testPubKey.SerializeCompressed()
+	witnessProgram := p2wkhScript(t, testPubKey)
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: testPrevHash}, nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(1000, p2pkhScript(t, testPubKey)))
+	prevTx.AddTxOut(wire.NewTxOut(2000, witnessProgram))
+	prevTx.AddTxOut(wire.NewTxOut(3000, p2shScript(t, witnessProgram)))
+	prevHash := prevTx.TxHash()
+
+	tx := wire.NewMsgTx(2)
+	for i := range prevTx.TxOut {
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+			Hash:  prevHash,
+			Index: uint32(i),
+		}, nil, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(5000, witnessProgram))
+
+	packet, err := NewFromUnsignedTx(tx)
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+
+	// Without signatures, none of the inputs can be finalized.
+	packet.Inputs[0].NonWitnessUtxo = prevTx
+	packet.Inputs[1].WitnessUtxo = prevTx.TxOut[1]
+	packet.Inputs[2].WitnessUtxo = prevTx.TxOut[2]
+	packet.Inputs[2].RedeemScript = witnessProgram
+	if err := MaybeFinalizeAll(packet); err != ErrNotFinalizable {
+		t.Fatalf("expected error %v, got %v", ErrNotFinalizable, err)
+	}
+	if _, err := Extract(packet); err != ErrIncompletePSBT {
+		t.Fatalf("expected error %v, got %v", ErrIncompletePSBT, err)
+	}
+
+	sig, err := txscript.RawTxInSignature(
+		tx, 0, prevTx.TxOut[0].PkScript, txscript.SigHashAll,
+		testPrivKey,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	packet.Inputs[0].PartialSigs = []*PartialSig{
+		{PubKey: pubKey, Signature: sig},
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i := 1; i < 3; i++ {
+		sig, err := txscript.RawTxInWitnessSignature(
+			tx, sigHashes, i, prevTx.TxOut[i].Value,
+			witnessProgram, txscript.SigHashAll, testPrivKey,
+		)
+		if err != nil {
+			t.Fatalf("unable to sign: %v", err)
+		}
+		packet.Inputs[i].PartialSigs = []*PartialSig{
+			{PubKey: pubKey, Signature: sig},
+		}
+	}
+
+	if err := MaybeFinalizeAll(packet); err != nil {
+		t.Fatalf("unable to finalize: %v", err)
+	}
+	if !packet.IsComplete() {
+		t.Fatalf("expected packet to be complete")
+	}
+	for i, pInput := range packet.Inputs {
+		if pInput.PartialSigs != nil || pInput.RedeemScript != nil {
+			t.Fatalf("input %v wasn't cleared", i)
+		}
+	}
+
+	finalTx, err := Extract(packet)
+	if err != nil {
+		t.Fatalf("unable to extract tx: %v", err)
+	}
+
+	// The P2PKH and nested P2SH inputs carry a sigScript, so the txid
+	// changes. Stripping the scripts again must yield the unsigned tx.
+	strippedTx := finalTx.Copy()
+	for _, txIn := range strippedTx.TxIn {
+		txIn.SignatureScript = nil
+		txIn.Witness = nil
+	}
+	if strippedTx.TxHash() != tx.TxHash() {
+		t.Fatalf("final tx doesn't match unsigned tx")
+	}
+
+	finalSigHashes := txscript.NewTxSigHashes(finalTx)
+	for i, txOut := range prevTx.TxOut {
+		vm, err := txscript.NewEngine(
+			txOut.PkScript, finalTx, i,
+			txscript.StandardVerifyFlags, nil, finalSigHashes,
+			txOut.Value,
+		)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Fatalf("input %v invalid: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package psbt
+
+// GlobalType is the set of types that are used at the global scope of a
+// packet.
+type GlobalType uint8
+
+const (
+	// UnsignedTxType is the global type of the unsigned transaction. It is
+	// the only field that is required within a packet.
+	UnsignedTxType GlobalType = 0
+)
+
+// InputType is the set of types that are defined for each input of a packet.
+type InputType uint8
+
+const (
+	// NonWitnessUtxoType is the type of the full transaction that created
+	// the output spent by the input.
+	NonWitnessUtxoType InputType = 0
+
+	// WitnessUtxoType is the type of the output spent by a segwit input.
+	WitnessUtxoType InputType = 1
+
+	// PartialSigType is the type of a signature for the input. The key
+	// data is the public key the signature is valid for.
+	PartialSigType InputType = 2
+
+	// SighashType is the type of the sighash flag the input is to be
+	// signed with.
+	SighashType InputType = 3
+
+	// RedeemScriptInputType is the type of the redeem script of a P2SH
+	// input.
+	RedeemScriptInputType InputType = 4
+
+	// WitnessScriptInputType is the type of the witness script of a P2WSH
+	// input.
+	WitnessScriptInputType InputType = 5
+
+	// Bip32DerivationInputType is the type of the BIP32 derivation path of
+	// a public key required to sign the input. The key data is the public
+	// key.
+	Bip32DerivationInputType InputType = 6
+
+	// FinalScriptSigType is the type of the final signature script of a
+	// finalized input.
+	FinalScriptSigType InputType = 7
+
+	// FinalScriptWitnessType is the type of the final witness of a
+	// finalized input.
+	FinalScriptWitnessType InputType = 8
+)
+
+// OutputType is the set of types that are defined for each output of a
+// packet.
+type OutputType uint8
+
+const (
+	// RedeemScriptOutputType is the type of the redeem script of a P2SH
+	// output.
+	RedeemScriptOutputType OutputType = 0
+
+	// WitnessScriptOutputType is the type of the witness script of a P2WSH
+	// output.
+	WitnessScriptOutputType OutputType = 1
+
+	// Bip32DerivationOutputType is the type of the BIP32 derivation path of
+	// a public key of the output. The key data is the public key.
+	Bip32DerivationOutputType OutputType = 2
+)
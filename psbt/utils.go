@@ -0,0 +1,277 @@
+package psbt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// MaxPsbtKeyLength is the maximum length of a key within a packet.
+	MaxPsbtKeyLength = 10000
+
+	// MaxPsbtValueLength is the maximum length of a value within a packet.
+	// It matches the maximum size of a block, as no value is able to be
+	// larger than the transaction it describes.
+	MaxPsbtValueLength = 4000000
+)
+
+// kvPair is a single entry of one of the key-value maps a packet consists of.
+// The first byte of the key is its type, the remainder is the key data.
+type kvPair struct {
+	keyType uint8
+	keyData []byte
+	value   []byte
+}
+
+// key returns the full serialized key of the pair.
+func (p *kvPair) key() []byte {
+	return append([]byte{p.keyType}, p.keyData...)
+}
+
+// unknown returns the pair as an entry of a type we don't know about, so
+// that it can be passed through unchanged.
+func (p *kvPair) unknown() *Unknown {
+	return &Unknown{
+		Key:   p.key(),
+		Value: p.value,
+	}
+}
+
+// Unknown is a key-value pair of a type we don't know about. These are kept
+// as is, such that they're retained when the packet is serialized again.
+type Unknown struct {
+	// Key is the full key of the pair, including its type.
+	Key []byte
+
+	// Value is the value of the pair.
+	Value []byte
+}
+
+// readKVPair reads the next key-value pair of a map from r. If the separator
+// that terminates the map is read instead, a nil pair is returned.
+func readKVPair(r io.Reader) (*kvPair, error) {
+	keyLen, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	// A key of length zero is the separator that terminates the map.
+	if keyLen == 0 {
+		return nil, nil
+	}
+	if keyLen > MaxPsbtKeyLength {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	key := make([]byte, keyLen)
+	if _, err := io.ReadFull(r, key); err != nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	value, err := wire.ReadVarBytes(r, 0, MaxPsbtValueLength, "psbt value")
+	if err != nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	return &kvPair{
+		keyType: key[0],
+		keyData: key[1:],
+		value:   value,
+	}, nil
+}
+
+// serializeKVPair writes a key-value pair with the given key type and key data
+// to w.
+func serializeKVPair(w io.Writer, keyType uint8, keyData,
+	value []byte) error {
+
+	key := append([]byte{keyType}, keyData...)
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+// serializeUnknowns writes the passed unknown key-value pairs to w.
+func serializeUnknowns(w io.Writer, unknowns []*Unknown) error {
+	for _, kv := range unknowns {
+		if err := wire.WriteVarBytes(w, 0, kv.Key); err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeSeparator writes the separator that terminates a map to w.
+func writeSeparator(w io.Writer) error {
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// checkDuplicate returns an error if the key of the passed pair has already
+// been seen within the current map, and records it otherwise.
+func checkDuplicate(seen map[string]struct{}, pair *kvPair) error {
+	key := string(pair.key())
+	if _, ok := seen[key]; ok {
+		return ErrDuplicateKey
+	}
+	seen[key] = struct{}{}
+
+	return nil
+}
+
+// checkEmptyKeyData returns an error if the passed pair carries key data,
+// which is only allowed for the types that are keyed by a public key.
+func checkEmptyKeyData(pair *kvPair) error {
+	if len(pair.keyData) != 0 {
+		return ErrInvalidKeyData
+	}
+
+	return nil
+}
+
+// validatePubKey returns an error if the passed key data isn't a valid
+// serialized public key.
+func validatePubKey(pubKey []byte) error {
+	if len(pubKey) != btcec.PubKeyBytesLenCompressed &&
+		len(pubKey) != btcec.PubKeyBytesLenUncompressed {
+
+		return ErrInvalidKeyData
+	}
+
+	if _, err := btcec.ParsePubKey(pubKey, btcec.S256()); err != nil {
+		return ErrInvalidKeyData
+	}
+
+	return nil
+}
+
+// validateSignature returns an error if the passed value isn't a DER encoded
+// signature followed by a sighash flag.
+func validateSignature(sig []byte) error {
+	if len(sig) < 2 {
+		return ErrInvalidSignature
+	}
+
+	_, err := btcec.ParseDERSignature(sig[:len(sig)-1], btcec.S256())
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// readTxOut parses the serialized output of a witness UTXO.
+func readTxOut(value []byte) (*wire.TxOut, error) {
+	if len(value) < 9 {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	r := bytes.NewReader(value[8:])
+	pkScript, err := wire.ReadVarBytes(
+		r, 0, MaxPsbtValueLength, "pkScript",
+	)
+	if err != nil || r.Len() != 0 {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	return &wire.TxOut{
+		Value:    int64(binary.LittleEndian.Uint64(value[:8])),
+		PkScript: pkScript,
+	}, nil
+}
+
+// serializeTxOut serializes the output of a witness UTXO.
+func serializeTxOut(txOut *wire.TxOut) ([]byte, error) {
+	var b bytes.Buffer
+	var value [8]byte
+	binary.LittleEndian.PutUint64(value[:], uint64(txOut.Value))
+	if _, err := b.Write(value[:]); err != nil {
+		return nil, err
+	}
+	if err := wire.WriteVarBytes(&b, 0, txOut.PkScript); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// readBip32Derivation parses the master key fingerprint and derivation path
+// of a BIP32 derivation value.
+func readBip32Derivation(value []byte) (uint32, []uint32, error) {
+	if len(value) < 4 || len(value)%4 != 0 {
+		return 0, nil, ErrInvalidPsbtFormat
+	}
+
+	fingerprint := binary.LittleEndian.Uint32(value[:4])
+
+	var path []uint32
+	for i := 4; i < len(value); i += 4 {
+		path = append(path, binary.LittleEndian.Uint32(value[i:i+4]))
+	}
+
+	return fingerprint, path, nil
+}
+
+// serializeBip32Derivation serializes the master key fingerprint and
+// derivation path of a BIP32 derivation value.
+func serializeBip32Derivation(fingerprint uint32, path []uint32) []byte {
+	value := make([]byte, 4*(len(path)+1))
+	binary.LittleEndian.PutUint32(value[:4], fingerprint)
+	for i, child := range path {
+		binary.LittleEndian.PutUint32(value[4*(i+1):], child)
+	}
+
+	return value
+}
+
+// readTxWitness parses a serialized witness stack.
+func readTxWitness(value []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(value)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+	if count > uint64(len(value)) {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, MaxPsbtValueLength, "witness item",
+		)
+		if err != nil {
+			return nil, ErrInvalidPsbtFormat
+		}
+	}
+	if r.Len() != 0 {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	return witness, nil
+}
+
+// serializeTxWitness serializes a witness stack.
+func serializeTxWitness(witness wire.TxWitness) ([]byte, error) {
+	var b bytes.Buffer
+	if err := wire.WriteVarInt(&b, 0, uint64(len(witness))); err != nil {
+		return nil, err
+	}
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
@@ -4,7 +4,7 @@ import (
 	"container/heap"
 	"math"
 
-	"github.com/coreos/bbolt"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 
 	"github.com/wakiyamap/lnd/channeldb"
 	"github.com/wakiyamap/lnd/lnwire"
@@ -205,7 +205,7 @@ func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
 type graphParams struct {
 	// tx can be set to an existing db transaction. If not set, a new
 	// transaction will be started.
-	tx *bbolt.Tx
+	tx kvdb.Tx
 
 	// graph is the ChannelGraph to be used during path finding.
 	graph *channeldb.ChannelGraph
@@ -248,6 +248,15 @@ type RestrictParams struct {
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
 	CltvLimit *uint32
+
+	// LastHop is the node that needs to be the last hop before the
+	// target. If nil, any node may be used. It must be set when the
+	// target is the source, as is the case for circular routes.
+	LastHop *route.Vertex
+
+	// LastChannelID is the channel that needs to be taken from the last
+	// hop to the target. If nil, any channel may be used.
+	LastChannelID *uint64
 }
 
 // findPath attempts to find a path from the source node within the
@@ -274,6 +283,19 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 		defer tx.Rollback()
 	}
 
+	// A circular route back to ourselves can't be found by the backwards
+	// search below, as it would reach the source straight away. Instead,
+	// we'll search for a path to the last hop and close the circle with
+	// one of its channels to us.
+	if source == target {
+		return findCircularPath(&graphParams{
+			tx:              tx,
+			graph:           g.graph,
+			additionalEdges: g.additionalEdges,
+			bandwidthHints:  g.bandwidthHints,
+		}, r, source, amt)
+	}
+
 	// First we'll initialize an empty heap which'll help us to quickly
 	// locate the next edge we should visit next during our graph
 	// traversal.
@@ -284,7 +306,7 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 	// also returns the source node, so there is no need to add the source
 	// node explicitly.
 	distance := make(map[route.Vertex]nodeWithDist)
-	if err := g.graph.ForEachNode(tx, func(_ *bbolt.Tx,
+	if err := g.graph.ForEachNode(tx, func(_ kvdb.Tx,
 		node *channeldb.LightningNode) error {
 		// TODO(roasbeef): with larger graph can just use disk seeks
 		// with a visited map
@@ -380,6 +402,22 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 			return
 		}
 
+		// If we have a last hop restriction and this edge doesn't
+		// lead from the specified node to the target, skip it.
+		if toNode == target && r.LastHop != nil &&
+			*r.LastHop != fromVertex {
+
+			return
+		}
+
+		// If we have a last channel restriction and this edge leads to
+		// the target through another channel, skip it.
+		if toNode == target && r.LastChannelID != nil &&
+			*r.LastChannelID != edge.ChannelID {
+
+			return
+		}
+
 		// If this vertex or edge has been black listed, then we'll
 		// skip exploring this edge.
 		if _, ok := ignoredNodes[fromVertex]; ok {
@@ -520,7 +558,7 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 		// examine all the incoming edges (channels) from this node to
 		// further our graph traversal.
 		pivot := route.Vertex(bestNode.PubKeyBytes)
-		err := bestNode.ForEachChannel(tx, func(tx *bbolt.Tx,
+		err := bestNode.ForEachChannel(tx, func(tx kvdb.Tx,
 			edgeInfo *channeldb.ChannelEdgeInfo,
 			_, inEdge *channeldb.ChannelEdgePolicy) error {
 
@@ -617,6 +655,147 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 	return pathEdges, nil
 }
 
+// findCircularPath finds a path from the source node back to itself that
+// arrives through a channel of the last hop specified in the restrictions, or
+// through the last channel if one is specified as well. Each channel of the
+// last hop to the source is considered as the final edge
+// of the path, and the path to the last hop is found through findPath with
+// the restrictions reduced by the fee and time lock of that final edge. The
+// cheapest of the resulting paths is returned.
+func findCircularPath(g *graphParams, r *RestrictParams, source route.Vertex,
+	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error) {
+
+	if r.LastHop == nil {
+		return nil, newErr(ErrNoPathFound, "circular route requires a "+
+			"last hop")
+	}
+	lastHop := *r.LastHop
+
+	if lastHop == source {
+		return nil, newErr(ErrNoPathFound, "last hop cannot be the "+
+			"source")
+	}
+	if _, ok := r.IgnoredNodes[lastHop]; ok {
+		return nil, newErrf(ErrNoPathFound, "last hop %x is ignored",
+			lastHop[:])
+	}
+
+	var (
+		bestPath []*channeldb.ChannelEdgePolicy
+		bestFee  lnwire.MilliSatoshi
+	)
+
+	sourceNode := &channeldb.LightningNode{PubKeyBytes: source}
+	err := sourceNode.ForEachChannel(g.tx, func(_ kvdb.Tx,
+		edgeInfo *channeldb.ChannelEdgeInfo,
+		outEdge, inEdge *channeldb.ChannelEdgePolicy) error {
+
+		// Only the channels of the last hop for which we know its
+		// policy towards us can close the circle.
+		if inEdge == nil || (edgeInfo.NodeKey1Bytes != lastHop &&
+			edgeInfo.NodeKey2Bytes != lastHop) {
+
+			return nil
+		}
+
+		if r.LastChannelID != nil &&
+			*r.LastChannelID != edgeInfo.ChannelID {
+
+			return nil
+		}
+
+		if inEdge.ChannelFlags&lnwire.ChanUpdateDisabled != 0 {
+			return nil
+		}
+		if _, ok := r.IgnoredEdges[*newEdgeLocator(inEdge)]; ok {
+			return nil
+		}
+
+		// We can't rely on our bandwidth hints for this channel, as
+		// they only apply to the direction away from us.
+		bandwidth := inEdge.MaxHTLC
+		if bandwidth == 0 {
+			bandwidth = lnwire.NewMSatFromSatoshis(
+				edgeInfo.Capacity,
+			)
+		}
+		if bandwidth < amt || amt < inEdge.MinHTLC {
+			return nil
+		}
+
+		fee := computeFee(amt, inEdge)
+		if fee > r.FeeLimit {
+			return nil
+		}
+
+		restrictions := &RestrictParams{
+			IgnoredNodes:      r.IgnoredNodes,
+			IgnoredEdges:      make(map[EdgeLocator]struct{}),
+			FeeLimit:          r.FeeLimit - fee,
+			OutgoingChannelID: r.OutgoingChannelID,
+		}
+		for edge := range r.IgnoredEdges {
+			restrictions.IgnoredEdges[edge] = struct{}{}
+		}
+
+		// Sending out over the same channel we're going to receive
+		// through doesn't make for a useful circle.
+		if outEdge != nil {
+			restrictions.IgnoredEdges[*newEdgeLocator(outEdge)] =
+				struct{}{}
+		}
+
+		if r.CltvLimit != nil {
+			if uint32(inEdge.TimeLockDelta) > *r.CltvLimit {
+				return nil
+			}
+
+			cltvLimit := *r.CltvLimit - uint32(inEdge.TimeLockDelta)
+			restrictions.CltvLimit = &cltvLimit
+		}
+
+		path, err := findPath(
+			g, restrictions, source, lastHop, amt+fee,
+		)
+		if IsError(err, ErrNoPathFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Accumulate the fees charged along the path, which excludes
+		// the first hop as we don't pay ourselves a fee.
+		amtToSend := amt + fee
+		for i := len(path) - 1; i > 0; i-- {
+			amtToSend += computeFee(amtToSend, path[i])
+		}
+
+		totalFee := amtToSend - amt
+		if bestPath == nil || totalFee < bestFee {
+			bestPath = append(path, inEdge)
+			bestFee = totalFee
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if bestPath == nil {
+		return nil, newErrf(ErrNoPathFound, "unable to find a circular "+
+			"path through last hop %x", lastHop[:])
+	}
+
+	if len(bestPath) > HopLimit {
+		return nil, newErr(ErrMaxHopsExceeded, "potential path has "+
+			"too many hops")
+	}
+
+	return bestPath, nil
+}
+
 // findPaths implements a k-shortest paths algorithm to find all the reachable
 // paths between the passed source and target. The algorithm will continue to
 // traverse the graph until all possible candidate paths have been depleted.
@@ -628,7 +807,7 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 // make our inner path finding algorithm aware of our k-shortest paths
 // algorithm, rather than attempting to use an unmodified path finding
 // algorithm in a block box manner.
-func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
+func findPaths(tx kvdb.Tx, graph *channeldb.ChannelGraph,
 	source, target route.Vertex, amt lnwire.MilliSatoshi,
 	restrictions *RestrictParams, numPaths uint32,
 	bandwidthHints map[uint64]lnwire.MilliSatoshi) (
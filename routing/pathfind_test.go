@@ -820,9 +820,15 @@ func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstanc
 	for i := 0; i < len(expectedHops)-1; i++ {
 		var expectedHop [8]byte
 		binary.BigEndian.PutUint64(expectedHop[:], route.Hops[i+1].ChannelID)
-		if !bytes.Equal(sphinxPath[i].HopData.NextAddress[:], expectedHop[:]) {
+
+		hopData, err := sphinxPath[i].HopPayload.HopData()
+		if err != nil {
+			t.Fatalf("unable to make hop data: %v", err)
+		}
+
+		if !bytes.Equal(hopData.NextAddress[:], expectedHop[:]) {
 			t.Fatalf("first hop has incorrect next hop: expected %x, got %x",
-				expectedHop[:], sphinxPath[i].HopData.NextAddress)
+				expectedHop[:], hopData.NextAddress)
 		}
 	}
 
@@ -830,9 +836,15 @@ func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstanc
 	// to indicate it's the exit hop.
 	var exitHop [8]byte
 	lastHopIndex := len(expectedHops) - 1
-	if !bytes.Equal(sphinxPath[lastHopIndex].HopData.NextAddress[:], exitHop[:]) {
+
+	hopData, err := sphinxPath[lastHopIndex].HopPayload.HopData()
+	if err != nil {
+		t.Fatalf("unable to create hop data: %v", err)
+	}
+
+	if !bytes.Equal(hopData.NextAddress[:], exitHop[:]) {
 		t.Fatalf("first hop has incorrect next hop: expected %x, got %x",
-			exitHop[:], sphinxPath[lastHopIndex].HopData.NextAddress)
+			exitHop[:], hopData.NextAddress)
 	}
 
 	var expectedTotalFee lnwire.MilliSatoshi
@@ -2041,6 +2053,155 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	}
 }
 
+// TestCircularPath asserts that a path from the source back to itself can be
+// found when the first and last hops are restricted, and that the cheapest
+// channel of the last hop is used to close the circle.
+func TestCircularPath(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which the source has two channels to b. The
+	// circle is expected to leave through a and return through the
+	// cheaper channel of b.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 100,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 200,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 1
+	)
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	lastHop := testGraphInstance.aliasMap["b"]
+	outgoingChannelID := uint64(1)
+
+	// Without a last hop, no circular path can be found.
+	_, err = findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			OutgoingChannelID: &outgoingChannelID,
+		},
+		sourceVertex, sourceVertex, paymentAmt,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path found, got: %v", err)
+	}
+
+	path, err := findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			OutgoingChannelID: &outgoingChannelID,
+			LastHop:           &lastHop,
+		},
+		sourceVertex, sourceVertex, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	route, err := newRoute(
+		paymentAmt, sourceVertex, path, startingHeight,
+		finalHopCLTV,
+	)
+	if err != nil {
+		t.Fatalf("unable to create path: %v", err)
+	}
+
+	// Assert that the route leaves through channel 1, and returns to the
+	// source through channel 4 as it is cheaper than channel 2.
+	expectedChannels := []uint64{1, 3, 4}
+	if len(route.Hops) != len(expectedChannels) {
+		t.Fatalf("expected %v hops, got %v", len(expectedChannels),
+			len(route.Hops))
+	}
+	for i, hop := range route.Hops {
+		if hop.ChannelID != expectedChannels[i] {
+			t.Fatalf("expected hop %v to use channel %v, got %v",
+				i, expectedChannels[i], hop.ChannelID)
+		}
+	}
+	if route.Hops[2].PubKeyBytes != sourceVertex {
+		t.Fatalf("expected route to end at the source")
+	}
+
+	// Restricting the last channel makes the route return to the source
+	// through channel 2 instead, even though it's more expensive.
+	lastChannelID := uint64(2)
+	lastChannelPath, err := findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			OutgoingChannelID: &outgoingChannelID,
+			LastHop:           &lastHop,
+			LastChannelID:     &lastChannelID,
+		},
+		sourceVertex, sourceVertex, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if len(lastChannelPath) != 3 ||
+		lastChannelPath[2].ChannelID != lastChannelID {
+
+		t.Fatalf("expected route to return through channel %v",
+			lastChannelID)
+	}
+
+	// A fee limit that only covers the fee of the last hop can't be met.
+	_, err = findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          computeFee(paymentAmt, path[2]),
+			OutgoingChannelID: &outgoingChannelID,
+			LastHop:           &lastHop,
+		},
+		sourceVertex, sourceVertex, paymentAmt,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path found, got: %v", err)
+	}
+}
+
 // TestCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func TestCltvLimit(t *testing.T) {
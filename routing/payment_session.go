@@ -166,6 +166,8 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 			FeeLimit:          payment.FeeLimit,
 			OutgoingChannelID: payment.OutgoingChannelID,
 			CltvLimit:         cltvLimit,
+			LastHop:           payment.LastHop,
+			LastChannelID:     payment.LastChannelID,
 		},
 		p.mc.selfNode.PubKeyBytes, payment.Target,
 		payment.Amount,
@@ -0,0 +1,446 @@
+package routing
+
+import (
+	"crypto/rand"
+	"fmt"
+	prand "math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/htlcswitch"
+	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/routing/route"
+	"github.com/wakiyamap/lnd/zpay32"
+)
+
+const (
+	// maxProbeSearchSteps is the maximum number of probes sent to narrow
+	// down the largest amount that is deliverable along a route that
+	// failed due to insufficient capacity. Each step halves the search
+	// interval, which leaves an uncertainty of less than one percent of
+	// the probed amount.
+	maxProbeSearchSteps = 7
+
+	// DefaultProbeInterval is the default minimum time between two probes
+	// of the background prober.
+	DefaultProbeInterval = time.Minute
+)
+
+// ProbeResult describes the outcome of probing a destination.
+type ProbeResult struct {
+	// Route is the route along which MaxAmount is deliverable.
+	Route *route.Route
+
+	// MaxAmount is the largest amount, up to the probed amount, that was
+	// found to be deliverable to the destination.
+	MaxAmount lnwire.MilliSatoshi
+
+	// Attempts is the number of probe HTLCs that were sent.
+	Attempts int
+}
+
+// ProbePayment determines whether the passed payment would succeed, without
+// actually paying the destination. HTLCs with a random payment hash are sent
+// along the candidate routes, such that the destination is bound to fail them
+// as it doesn't know the payment hash. This failure is taken as proof that
+// the route is able to carry the payment. All other failures are fed into
+// mission control like those of a regular payment.
+//
+// If the full amount can't be delivered, but a route only failed due to
+// insufficient capacity, the largest amount deliverable along that route is
+// searched for. The payment hash of the passed payment is ignored.
+func (r *ChannelRouter) ProbePayment(payment *LightningPayment) (*ProbeResult,
+	error) {
+
+	var probeHash [32]byte
+	if _, err := rand.Read(probeHash[:]); err != nil {
+		return nil, err
+	}
+
+	// The switch records the status of each payment hash it sends to.
+	// As the probe hash is never used again, we'll remove its status once
+	// we're done probing, so probes don't fill up the database.
+	defer func() {
+		err := r.cfg.Graph.Database().DeleteGroundedPaymentStatus(
+			probeHash,
+		)
+		if err != nil {
+			log.Errorf("Unable to delete status of probe %x: %v",
+				probeHash, err)
+		}
+	}()
+
+	paySession, err := r.missionControl.NewPaymentSession(
+		payment.RouteHints, payment.Target,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	finalCLTVDelta := uint16(zpay32.DefaultFinalCLTVDelta)
+	if payment.FinalCLTVDelta != nil {
+		finalCLTVDelta = *payment.FinalCLTVDelta
+	}
+
+	payAttemptTimeout := payment.PayAttemptTimeout
+	if payAttemptTimeout == 0 {
+		payAttemptTimeout = defaultPayAttemptTimeout
+	}
+	timeoutChan := time.After(payAttemptTimeout)
+
+	var (
+		attempts int
+		lastErr  error
+
+		// capacityFailure is the first route that failed due to
+		// insufficient capacity of one of its channels.
+		capacityFailure *route.Route
+	)
+
+	// sendProbe sends a probe along the passed route, and returns whether
+	// it reached the destination.
+	sendProbe := func(rt *route.Route) (bool, error) {
+		attempts++
+
+		_, err := r.sendToSwitch(rt, probeHash)
+		switch {
+		case isProbeSuccess(err, payment.Target):
+			return true, nil
+
+		// As the payment hash is random, the destination should
+		// never be able to settle the probe.
+		case err == nil:
+			return false, fmt.Errorf("probe to %x was settled",
+				payment.Target[:])
+		}
+
+		log.Debugf("Probe %x failed: %v", probeHash, err)
+
+		return false, err
+	}
+
+probeLoop:
+	for {
+		select {
+		case <-timeoutChan:
+			lastErr = newErrf(ErrPaymentAttemptTimeout, "probe not "+
+				"completed before timeout of %v",
+				payAttemptTimeout)
+			break probeLoop
+
+		case <-r.quit:
+			return nil, ErrRouterShuttingDown
+
+		default:
+		}
+
+		rt, err := paySession.RequestRoute(
+			payment, uint32(currentHeight), finalCLTVDelta,
+		)
+		if err != nil {
+			if lastErr == nil {
+				lastErr = err
+			}
+			break
+		}
+
+		success, err := sendProbe(rt)
+		if success {
+			return &ProbeResult{
+				Route:     rt,
+				MaxAmount: payment.Amount,
+				Attempts:  attempts,
+			}, nil
+		}
+		lastErr = err
+
+		if capacityFailure == nil && isCapacityFailure(err) {
+			capacityFailure = rt
+		}
+
+		if r.processSendError(paySession, rt, err) {
+			break
+		}
+	}
+
+	if capacityFailure == nil {
+		return nil, fmt.Errorf("unable to probe destination: %v",
+			lastErr)
+	}
+
+	// None of the routes was able to carry the full amount, so we'll
+	// search for the largest amount that the first route that ran out of
+	// capacity is able to carry.
+	path, err := r.routeEdges(capacityFailure)
+	if err != nil {
+		return nil, fmt.Errorf("unable to probe destination: %v",
+			lastErr)
+	}
+
+	var (
+		best     *route.Route
+		low      lnwire.MilliSatoshi
+		high     = payment.Amount
+		sourceID = capacityFailure.SourcePubKey
+	)
+	for i := 0; i < maxProbeSearchSteps; i++ {
+		amt := low + (high-low)/2
+
+		rt, err := newRoute(
+			amt, sourceID, path, uint32(currentHeight),
+			finalCLTVDelta,
+		)
+		if err != nil {
+			break
+		}
+
+		success, err := sendProbe(rt)
+		if success {
+			best = rt
+			low = amt
+			continue
+		}
+
+		r.processSendError(paySession, rt, err)
+		if !isCapacityFailure(err) {
+			break
+		}
+		high = amt
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("unable to probe destination: %v",
+			lastErr)
+	}
+
+	return &ProbeResult{
+		Route:     best,
+		MaxAmount: low,
+		Attempts:  attempts,
+	}, nil
+}
+
+// isProbeSuccess returns true if the passed error is the failure the target
+// returns for a probe, as it doesn't know the payment hash.
+func isProbeSuccess(err error, target route.Vertex) bool {
+	fErr, ok := err.(*htlcswitch.ForwardingError)
+	if !ok {
+		return false
+	}
+
+	if route.NewVertex(fErr.ErrorSource) != target {
+		return false
+	}
+
+	_, ok = fErr.FailureMessage.(*lnwire.FailUnknownPaymentHash)
+	return ok
+}
+
+// isCapacityFailure returns true if the passed error indicates that one of
+// the channels of the route didn't have enough capacity to forward the HTLC.
+func isCapacityFailure(err error) bool {
+	fErr, ok := err.(*htlcswitch.ForwardingError)
+	if !ok {
+		return false
+	}
+
+	_, ok = fErr.FailureMessage.(*lnwire.FailTemporaryChannelFailure)
+	return ok
+}
+
+// routeEdges looks up the policies of the channels the passed route traverses
+// within the graph, which allows the route to be rebuilt for another amount.
+func (r *ChannelRouter) routeEdges(rt *route.Route) (
+	[]*channeldb.ChannelEdgePolicy, error) {
+
+	edges := make([]*channeldb.ChannelEdgePolicy, 0, len(rt.Hops))
+	prevNode := rt.SourcePubKey
+	for _, hop := range rt.Hops {
+		info, policy1, policy2, err := r.cfg.Graph.FetchChannelEdgesByID(
+			hop.ChannelID,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		policy := policy1
+		if info.NodeKey2Bytes == prevNode {
+			policy = policy2
+		}
+		if policy == nil {
+			return nil, fmt.Errorf("policy of channel %v is unknown",
+				hop.ChannelID)
+		}
+
+		edges = append(edges, policy)
+		prevNode = hop.PubKeyBytes
+	}
+
+	return edges, nil
+}
+
+// ProberConfig houses the configuration of the background prober.
+type ProberConfig struct {
+	// Graph is the channel graph the probe destinations are selected
+	// from.
+	Graph *channeldb.ChannelGraph
+
+	// ProbePayment probes the passed payment.
+	ProbePayment func(*LightningPayment) (*ProbeResult, error)
+
+	// Amount is the amount that is probed.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum fee of the probed routes.
+	FeeLimit lnwire.MilliSatoshi
+
+	// Interval is the minimum time between two probes, which limits the
+	// rate at which probe HTLCs are sent into the network.
+	Interval time.Duration
+}
+
+// Prober periodically probes random destinations within the channel graph in
+// the background. The outcome of the probes isn't used directly, but mission
+// control learns about the liquidity in the network, which benefits the
+// payments that follow.
+type Prober struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *ProberConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewProber creates a new background prober. The probe interval must be
+// positive.
+func NewProber(cfg *ProberConfig) (*Prober, error) {
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("probe interval must be positive, "+
+			"got %v", cfg.Interval)
+	}
+
+	return &Prober{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}, nil
+}
+
+// Start starts probing in the background.
+func (p *Prober) Start() error {
+	if !atomic.CompareAndSwapUint32(&p.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting background prober, probing %v every %v",
+		p.cfg.Amount, p.cfg.Interval)
+
+	p.wg.Add(1)
+	go p.probeLoop()
+
+	return nil
+}
+
+// Stop stops probing and waits for the current probe to finish.
+func (p *Prober) Stop() error {
+	if !atomic.CompareAndSwapUint32(&p.stopped, 0, 1) {
+		return nil
+	}
+
+	close(p.quit)
+	p.wg.Wait()
+
+	return nil
+}
+
+// probeLoop probes a random destination each interval. As the probes are
+// sent one at a time, a probe that takes longer than the interval delays the
+// next one.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Prober) probeLoop() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.probeRandomNode()
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// probeRandomNode probes a destination selected at random from the graph.
+func (p *Prober) probeRandomNode() {
+	target, err := p.selectTarget()
+	if err != nil {
+		log.Errorf("Unable to select probe destination: %v", err)
+		return
+	}
+
+	result, err := p.cfg.ProbePayment(&LightningPayment{
+		Target:   target,
+		Amount:   p.cfg.Amount,
+		FeeLimit: p.cfg.FeeLimit,
+	})
+	if err != nil {
+		log.Debugf("Background probe to %x failed: %v", target[:], err)
+		return
+	}
+
+	log.Debugf("Background probe to %x delivered %v using %v attempts",
+		target[:], result.MaxAmount, result.Attempts)
+}
+
+// selectTarget selects a node other than ourselves uniformly at random from
+// the graph.
+func (p *Prober) selectTarget() (route.Vertex, error) {
+	sourceNode, err := p.cfg.Graph.SourceNode()
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	var (
+		target route.Vertex
+		seen   int
+	)
+	err = p.cfg.Graph.ForEachNode(nil, func(_ kvdb.Tx,
+		node *channeldb.LightningNode) error {
+
+		if node.PubKeyBytes == sourceNode.PubKeyBytes {
+			return nil
+		}
+
+		// Reservoir sampling gives each node the same chance of being
+		// selected, without holding all of them in memory.
+		seen++
+		if prand.Intn(seen) == 0 {
+			target = node.PubKeyBytes
+		}
+
+		return nil
+	})
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	if seen == 0 {
+		return route.Vertex{}, fmt.Errorf("no nodes to probe")
+	}
+
+	return target, nil
+}
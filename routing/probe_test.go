@@ -0,0 +1,130 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/wakiyamap/lnd/htlcswitch"
+	"github.com/wakiyamap/lnd/lnwire"
+)
+
+// TestProbePayment asserts that a probe is considered successful once the
+// destination fails it for an unknown payment hash, and that the largest
+// deliverable amount is searched for if all routes lack capacity.
+func TestProbePayment(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	target := ctx.aliases["luoji"]
+	targetKey, err := btcec.ParsePubKey(target[:], btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse target key: %v", err)
+	}
+	sourceKey, err := ctx.router.selfNode.PubKey()
+	if err != nil {
+		t.Fatalf("unable to fetch source key: %v", err)
+	}
+
+	// The network is only able to carry HTLCs up to maxHtlcAmt, larger
+	// HTLCs fail at the first hop.
+	maxHtlcAmt := lnwire.NewMSatFromSatoshis(600)
+	var probeHashes [][32]byte
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte,
+		error) {
+
+		probeHashes = append(probeHashes, htlcAdd.PaymentHash)
+
+		if htlcAdd.Amount > maxHtlcAmt {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    sourceKey,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    targetKey,
+			FailureMessage: &lnwire.FailUnknownPaymentHash{},
+		}
+	}
+
+	// An amount the network is able to carry should be deliverable in
+	// full with a single probe.
+	amt := lnwire.NewMSatFromSatoshis(100)
+	result, err := ctx.router.ProbePayment(&LightningPayment{
+		Target:   target,
+		Amount:   amt,
+		FeeLimit: noFeeLimit,
+	})
+	if err != nil {
+		t.Fatalf("unable to probe: %v", err)
+	}
+	if result.MaxAmount != amt {
+		t.Fatalf("expected max amount %v, got %v", amt,
+			result.MaxAmount)
+	}
+	if result.Attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %v", result.Attempts)
+	}
+	hops := result.Route.Hops
+	if hops[len(hops)-1].PubKeyBytes != target {
+		t.Fatalf("expected route to end at the target")
+	}
+	if probeHashes[0] == [32]byte{} {
+		t.Fatalf("expected a random payment hash")
+	}
+
+	// An amount beyond the capacity of the network should be narrowed
+	// down to the largest deliverable amount.
+	amt = lnwire.NewMSatFromSatoshis(1000)
+	result, err = ctx.router.ProbePayment(&LightningPayment{
+		Target:   target,
+		Amount:   amt,
+		FeeLimit: noFeeLimit,
+	})
+	if err != nil {
+		t.Fatalf("unable to probe: %v", err)
+	}
+	if result.Route.TotalAmount > maxHtlcAmt {
+		t.Fatalf("route amount %v exceeds capacity %v",
+			result.Route.TotalAmount, maxHtlcAmt)
+	}
+	minAmt := lnwire.NewMSatFromSatoshis(500)
+	if result.MaxAmount < minAmt || result.MaxAmount >= amt {
+		t.Fatalf("expected max amount between %v and %v, got %v",
+			minAmt, amt, result.MaxAmount)
+	}
+}
+
+// TestNewProberInterval asserts that a prober can't be created with an
+// interval that isn't positive, as it would stall or panic the probe loop.
+func TestNewProberInterval(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		_, err := NewProber(&ProberConfig{
+			Interval: interval,
+		})
+		if err == nil {
+			t.Fatalf("expected interval %v to be rejected",
+				interval)
+		}
+	}
+
+	_, err := NewProber(&ProberConfig{
+		Interval: DefaultProbeInterval,
+	})
+	if err != nil {
+		t.Fatalf("unable to create prober: %v", err)
+	}
+}
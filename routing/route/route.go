@@ -147,15 +147,12 @@ func (r *Route) ToSphinxPath() (*sphinx.PaymentPath, error) {
 			return nil, err
 		}
 
-		path[i] = sphinx.OnionHop{
-			NodePub: *pub,
-			HopData: sphinx.HopData{
-				// TODO(roasbeef): properly set realm, make
-				// sphinx type an enum actually?
-				Realm:         [1]byte{0},
-				ForwardAmount: uint64(hop.AmtToForward),
-				OutgoingCltv:  hop.OutgoingTimeLock,
-			},
+		hopData := sphinx.HopData{
+			// TODO(roasbeef): properly set realm, make sphinx type
+			// an enum actually?
+			Realm:         [1]byte{0},
+			ForwardAmount: uint64(hop.AmtToForward),
+			OutgoingCltv:  hop.OutgoingTimeLock,
 		}
 
 		// As a base case, the next hop is set to all zeroes in order
@@ -168,9 +165,17 @@ func (r *Route) ToSphinxPath() (*sphinx.PaymentPath, error) {
 			nextHop = r.Hops[i+1].ChannelID
 		}
 
-		binary.BigEndian.PutUint64(
-			path[i].HopData.NextAddress[:], nextHop,
-		)
+		binary.BigEndian.PutUint64(hopData.NextAddress[:], nextHop)
+
+		hopPayload, err := sphinx.NewHopPayload(&hopData, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		path[i] = sphinx.OnionHop{
+			NodePub:    *pub,
+			HopPayload: hopPayload,
+		}
 	}
 
 	return &path, nil
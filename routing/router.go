@@ -13,9 +13,9 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/wakiyamap/lnd/channeldb"
@@ -1059,8 +1059,15 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		// If AssumeChannelValid is present, then we are unable to
 		// perform any of the expensive checks below, so we'll
 		// short-circuit our path straight to adding the edge to our
-		// graph.
-		if r.cfg.AssumeChannelValid {
+		// graph. The same applies to our own zero-conf channels, which
+		// are identified by an alias that can't be located on-chain.
+		// Aliases of channels between other nodes are never accepted,
+		// as nothing would back them.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		isOwnAlias := channelID.IsAlias() &&
+			(msg.NodeKey1Bytes == r.selfNode.PubKeyBytes ||
+				msg.NodeKey2Bytes == r.selfNode.PubKeyBytes)
+		if r.cfg.AssumeChannelValid || isOwnAlias {
 			if err := r.cfg.Graph.AddChannelEdge(msg); err != nil {
 				return fmt.Errorf("unable to add edge: %v", err)
 			}
@@ -1074,7 +1081,6 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingPoint, _, err := r.fetchChanPoint(&channelID)
 		if err != nil {
 			r.rejectMtx.Lock()
@@ -1495,6 +1501,7 @@ func generateSphinxPacket(rt *route.Route, paymentHash []byte) ([]byte,
 	// privacy preserving source routing across the network.
 	sphinxPacket, err := sphinx.NewOnionPacket(
 		sphinxPath, sessionKey, paymentHash,
+		sphinx.DeterministicPacketFiller,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1572,6 +1579,15 @@ type LightningPayment struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// LastHop is the node that needs to be the last hop before the
+	// target. If nil, any node may be used. It must be set for circular
+	// payments back to ourselves.
+	LastHop *route.Vertex
+
+	// LastChannelID is the channel that needs to be taken from the last
+	// hop to the target. If nil, any channel may be used.
+	LastChannelID *uint64
+
 	// TODO(roasbeef): add e2e message?
 }
 
@@ -2187,7 +2203,7 @@ func (r *ChannelRouter) FetchLightningNode(node route.Vertex) (*channeldb.Lightn
 //
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) ForEachNode(cb func(*channeldb.LightningNode) error) error {
-	return r.cfg.Graph.ForEachNode(nil, func(_ *bbolt.Tx, n *channeldb.LightningNode) error {
+	return r.cfg.Graph.ForEachNode(nil, func(_ kvdb.Tx, n *channeldb.LightningNode) error {
 		return cb(n)
 	})
 }
@@ -2199,7 +2215,7 @@ func (r *ChannelRouter) ForEachNode(cb func(*channeldb.LightningNode) error) err
 func (r *ChannelRouter) ForAllOutgoingChannels(cb func(*channeldb.ChannelEdgeInfo,
 	*channeldb.ChannelEdgePolicy) error) error {
 
-	return r.selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, c *channeldb.ChannelEdgeInfo,
+	return r.selfNode.ForEachChannel(nil, func(_ kvdb.Tx, c *channeldb.ChannelEdgeInfo,
 		e, _ *channeldb.ChannelEdgePolicy) error {
 
 		if e == nil {
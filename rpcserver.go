@@ -10,6 +10,7 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -27,7 +28,6 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/tv42/zbase32"
@@ -35,7 +35,9 @@ import (
 	"github.com/wakiyamap/lnd/build"
 	"github.com/wakiyamap/lnd/chanbackup"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 	"github.com/wakiyamap/lnd/channelnotifier"
+	"github.com/wakiyamap/lnd/contractcourt"
 	"github.com/wakiyamap/lnd/discovery"
 	"github.com/wakiyamap/lnd/htlcswitch"
 	"github.com/wakiyamap/lnd/input"
@@ -47,6 +49,7 @@ import (
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/macaroons"
+	"github.com/wakiyamap/lnd/peeraccess"
 	"github.com/wakiyamap/lnd/routing"
 	"github.com/wakiyamap/lnd/signal"
 	"github.com/wakiyamap/lnd/sweep"
@@ -228,6 +231,27 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SpliceIn": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SpliceOut": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/AbandonSplice": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -260,10 +284,18 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribeBreachEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ClosedChannels": {{
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetForceCloseReport": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -280,6 +312,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListRebalances": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/AddInvoice": {{
 			Entity: "invoices",
 			Action: "write",
@@ -328,6 +368,22 @@ var (
 			Entity: "info",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/RotateMacaroonRootKey": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/AddPeerAccessRule": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/RemovePeerAccessRule": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListPeerAccessRules": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SubscribeChannelGraph": {{
 			Entity: "info",
 			Action: "read",
@@ -425,6 +481,10 @@ type rpcServer struct {
 	// rpc sub server.
 	routerBackend *routerrpc.RouterBackend
 
+	// macService is the macaroon service that authenticates all requests,
+	// which is nil if macaroons are disabled.
+	macService *macaroons.Service
+
 	quit chan struct{}
 }
 
@@ -432,13 +492,28 @@ type rpcServer struct {
 // LightningServer gRPC service.
 var _ lnrpc.LightningServer = (*rpcServer)(nil)
 
+// subServerMacaroonService returns the macaroon service to hand to the
+// sub-servers. The sub-servers only use it to write their macaroon files to
+// disk, which must not happen if the user requested a stateless
+// initialization, so no service is returned in that case.
+func subServerMacaroonService(macService *macaroons.Service,
+	statelessInit bool) *macaroons.Service {
+
+	if statelessInit {
+		return nil
+	}
+
+	return macService
+}
+
 // newRPCServer creates and returns a new instance of the rpcServer. The
 // rpcServer will handle creating all listening sockets needed by it, and any
 // of the sub-servers that it maintains. The set of serverOpts should be the
 // base level options passed to the grPC server. This typically includes things
 // like requiring TLS, etc.
 func newRPCServer(s *server, macService *macaroons.Service,
-	subServerCgs *subRPCServerConfigs, serverOpts []grpc.ServerOption,
+	statelessInit bool, subServerCgs *subRPCServerConfigs,
+	serverOpts []grpc.ServerOption,
 	restDialOpts []grpc.DialOption, restProxyDest string,
 	atpl *autopilot.Manager, invoiceRegistry *invoices.InvoiceRegistry,
 	tlsCfg *tls.Config) (*rpcServer, error) {
@@ -470,13 +545,18 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		subServerPerms []lnrpc.MacaroonPerms
 	)
 
+	subServerMacService := subServerMacaroonService(
+		macService, statelessInit,
+	)
+
 	// Before we create any of the sub-servers, we need to ensure that all
 	// the dependencies they need are properly populated within each sub
 	// server configuration struct.
 	err = subServerCgs.PopulateDependencies(
-		s.cc, networkDir, macService, atpl, invoiceRegistry,
-		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
-		routerBackend, s.nodeSigner, s.chanDB,
+		s.cc, networkDir, subServerMacService, atpl, invoiceRegistry,
+		s.htlcSwitch, activeNetParams.Params, activeNetParams.CoinType,
+		s.chanRouter,
+		routerBackend, s.nodeSigner, s.chanDB, s.sweeper,
 	)
 	if err != nil {
 		return nil, err
@@ -542,6 +622,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		grpcServer:    grpcServer,
 		server:        s,
 		routerBackend: routerBackend,
+		macService:    macService,
 		quit:          make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
@@ -696,18 +777,44 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 	return outputs, nil
 }
 
+// parseDeliveryAddress decodes the passed address, ensures that it's valid for
+// the active network, and returns the script that pays to it. This is used to
+// parse user supplied cooperative close addresses.
+func parseDeliveryAddress(address string) (lnwire.DeliveryAddress, error) {
+	addr, err := btcutil.DecodeAddress(address, activeNetParams.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addr.IsForNet(activeNetParams.Params) {
+		return nil, fmt.Errorf("address: %v is not valid for this "+
+			"network: %v", addr.String(), activeNetParams.Params.Name)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
+// address to a specified output value to be sent to that address. If an
+// account is given, then only coins of that account are spent.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate lnwallet.SatPerKWeight, account string) (*chainhash.Hash,
+	error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	var tx *wire.MsgTx
+	if account == "" {
+		tx, err = r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	} else {
+		tx, err = r.server.cc.wallet.SendOutputsFromAccount(
+			account, outputs, feeRate,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -743,8 +850,22 @@ func (r *rpcServer) ListUnspent(ctx context.Context,
 	}
 
 	// With our arguments validated, we'll query the internal wallet for
-	// the set of UTXOs that match our query.
-	utxos, err := r.server.cc.wallet.ListUnspentWitness(minConfs, maxConfs)
+	// the set of UTXOs that match our query. If an account was specified,
+	// only the UTXOs of that account are returned, otherwise the outputs
+	// of the imported watch-only accounts are included as well.
+	var (
+		utxos []*lnwallet.Utxo
+		err   error
+	)
+	if in.Account == "" {
+		utxos, err = r.server.cc.wallet.ListAllUnspentWitness(
+			minConfs, maxConfs,
+		)
+	} else {
+		utxos, err = r.server.cc.wallet.ListAccountUnspentWitness(
+			in.Account, minConfs, maxConfs,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -783,12 +904,18 @@ func (r *rpcServer) ListUnspent(ctx context.Context,
 			OutputIndex: utxo.OutPoint.Index,
 		}
 
+		account := utxo.Account
+		if account == "" {
+			account = lnwallet.DefaultAccountName
+		}
+
 		utxoResp := lnrpc.Utxo{
 			Type:          addrType,
 			AmountSat:     int64(utxo.Value),
 			PkScript:      hex.EncodeToString(utxo.PkScript),
 			Outpoint:      outpoint,
 			Confirmations: utxo.Confirmations,
+			Account:       account,
 		}
 
 		// Finally, we'll attempt to extract the raw address from the
@@ -896,9 +1023,9 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v, sweep_all=%v",
-		in.Addr, btcutil.Amount(in.Amount), int64(feePerKw),
-		in.SendAll)
+	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v, sweep_all=%v, "+
+		"account=%v", in.Addr, btcutil.Amount(in.Amount),
+		int64(feePerKw), in.SendAll, in.Account)
 
 	// Decode the address receiving the coins, we need to check whether the
 	// address is valid for this network.
@@ -927,6 +1054,19 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 
 	wallet := r.server.cc.wallet
 
+	// If an account was specified, we'll make sure it exists before
+	// spending any of its coins. We don't hold the keys of watch-only
+	// accounts, so their coins can only be spent through a PSBT.
+	if in.Account != "" {
+		account, err := wallet.FetchAccount(in.Account)
+		if err != nil {
+			return nil, err
+		}
+		if account.WatchOnly {
+			return nil, lnwallet.ErrWatchOnlyAccount
+		}
+	}
+
 	// If the send all flag is active, then we'll attempt to sweep all the
 	// coins in the wallet in a single transaction (if possible),
 	// otherwise, we'll respect the amount, and attempt a regular 2-output
@@ -944,13 +1084,24 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 			return nil, err
 		}
 
+		// Only the outputs of the requested account are swept, which
+		// is the default account if none was specified.
+		account := in.Account
+		if account == "" {
+			account = lnwallet.DefaultAccountName
+		}
+		utxoSource := &lnwallet.AccountUtxoSource{
+			LightningWallet: wallet,
+			Account:         account,
+		}
+
 		// With the sweeper instance created, we can now generate a
-		// transaction that will sweep ALL outputs from the wallet in a
-		// single transaction. This will be generated in a concurrent
+		// transaction that will sweep ALL outputs from the account in
+		// a single transaction. This will be generated in a concurrent
 		// safe manner, so no need to worry about locking.
 		sweepTxPkg, err := sweep.CraftSweepAllTx(
 			feePerKw, uint32(bestHeight), targetAddr, wallet,
-			wallet.WalletController, wallet.WalletController,
+			utxoSource, wallet.WalletController,
 			r.server.cc.feeEstimator, r.server.cc.signer,
 		)
 		if err != nil {
@@ -981,7 +1132,9 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		// while we instruct the wallet to send this transaction.
 		paymentMap := map[string]int64{targetAddr.String(): in.Amount}
 		err := wallet.WithCoinSelectLock(func() error {
-			newTXID, err := r.sendCoinsOnChain(paymentMap, feePerKw)
+			newTXID, err := r.sendCoinsOnChain(
+				paymentMap, feePerKw, in.Account,
+			)
 			if err != nil {
 				return err
 			}
@@ -1029,7 +1182,7 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	wallet := r.server.cc.wallet
 	err = wallet.WithCoinSelectLock(func() error {
 		sendManyTXID, err := r.sendCoinsOnChain(
-			in.AddrToAmount, feePerKw,
+			in.AddrToAmount, feePerKw, "",
 		)
 		if err != nil {
 			return err
@@ -1052,6 +1205,34 @@ func (r *rpcServer) SendMany(ctx context.Context,
 func (r *rpcServer) NewAddress(ctx context.Context,
 	in *lnrpc.NewAddressRequest) (*lnrpc.NewAddressResponse, error) {
 
+	// If an account was specified, then we'll derive the next address of
+	// that account. Only fresh addresses can be requested for accounts.
+	if in.Account != "" {
+		var addrType lnwallet.AddressType
+		switch in.Type {
+		case lnrpc.AddressType_WITNESS_PUBKEY_HASH:
+			addrType = lnwallet.WitnessPubKey
+
+		case lnrpc.AddressType_NESTED_PUBKEY_HASH:
+			addrType = lnwallet.NestedWitnessPubKey
+
+		default:
+			return nil, fmt.Errorf("address type %v not supported "+
+				"for accounts", in.Type)
+		}
+
+		addr, err := r.server.cc.wallet.NewAccountAddress(
+			in.Account, addrType, false,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Debugf("[newaddress] type=%v account=%v addr=%v",
+			in.Type, in.Account, addr.String())
+		return &lnrpc.NewAddressResponse{Address: addr.String()}, nil
+	}
+
 	// Translate the gRPC proto address type to the wallet controller's
 	// available address types.
 	var (
@@ -1197,7 +1378,7 @@ func (r *rpcServer) ConnectPeer(ctx context.Context,
 	}
 
 	// Connections to ourselves are disallowed for obvious reasons.
-	if pubKey.IsEqual(r.server.identityPriv.PubKey()) {
+	if pubKey.IsEqual(r.server.identityECDH.PubKey()) {
 		return nil, fmt.Errorf("cannot make connection to self")
 	}
 
@@ -1274,6 +1455,95 @@ func (r *rpcServer) DisconnectPeer(ctx context.Context,
 	return &lnrpc.DisconnectPeerResponse{}, nil
 }
 
+// unmarshallPeerAccessRule parses the list and rule of a peer access rule
+// request.
+func unmarshallPeerAccessRule(in *lnrpc.PeerAccessRuleRequest) (
+	peeraccess.List, peeraccess.Rule, error) {
+
+	var list peeraccess.List
+	switch in.List {
+	case lnrpc.PeerAccessList_ALLOW:
+		list = peeraccess.Allow
+
+	case lnrpc.PeerAccessList_DENY:
+		list = peeraccess.Deny
+
+	default:
+		return 0, peeraccess.Rule{}, fmt.Errorf("unknown peer access "+
+			"list: %v", in.List)
+	}
+
+	rule, err := peeraccess.ParseRule(in.Rule)
+	if err != nil {
+		return 0, peeraccess.Rule{}, err
+	}
+
+	return list, rule, nil
+}
+
+// AddPeerAccessRule adds a rule to the allow or deny list of peers. Rules
+// added over RPC only apply to new inbound connections, and aren't persisted
+// across restarts.
+func (r *rpcServer) AddPeerAccessRule(ctx context.Context,
+	in *lnrpc.PeerAccessRuleRequest) (*lnrpc.PeerAccessRuleResponse, error) {
+
+	list, rule, err := unmarshallPeerAccessRule(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.server.peerAccess.AddRule(list, rule); err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[peeraccess] added %v to %v list", rule, list)
+
+	return &lnrpc.PeerAccessRuleResponse{}, nil
+}
+
+// RemovePeerAccessRule removes a rule from the allow or deny list of peers.
+func (r *rpcServer) RemovePeerAccessRule(ctx context.Context,
+	in *lnrpc.PeerAccessRuleRequest) (*lnrpc.PeerAccessRuleResponse, error) {
+
+	list, rule, err := unmarshallPeerAccessRule(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.server.peerAccess.RemoveRule(list, rule); err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[peeraccess] removed %v from %v list", rule, list)
+
+	return &lnrpc.PeerAccessRuleResponse{}, nil
+}
+
+// ListPeerAccessRules returns the rules of the allow and deny lists of peers.
+func (r *rpcServer) ListPeerAccessRules(ctx context.Context,
+	_ *lnrpc.ListPeerAccessRulesRequest) (
+	*lnrpc.ListPeerAccessRulesResponse, error) {
+
+	rulesToStrings := func(rules []peeraccess.Rule) []string {
+		strs := make([]string, 0, len(rules))
+		for _, rule := range rules {
+			strs = append(strs, rule.String())
+		}
+
+		return strs
+	}
+
+	return &lnrpc.ListPeerAccessRulesResponse{
+		AllowRules: rulesToStrings(
+			r.server.peerAccess.Rules(peeraccess.Allow),
+		),
+		DenyRules: rulesToStrings(
+			r.server.peerAccess.Rules(peeraccess.Deny),
+		),
+		RestrictInbound: cfg.PeerAccess.RestrictInbound,
+	}, nil
+}
+
 // extractOpenChannelMinConfs extracts the minimum number of confirmations from
 // the OpenChannelRequest that each output used to fund the channel's funding
 // transaction should satisfy.
@@ -1384,7 +1654,7 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 
 	// Making a channel to ourselves wouldn't be of any use, so we
 	// explicitly disallow them.
-	if nodePubKey.IsEqual(r.server.identityPriv.PubKey()) {
+	if nodePubKey.IsEqual(r.server.identityECDH.PubKey()) {
 		return fmt.Errorf("cannot open channel to self")
 	}
 
@@ -1406,6 +1676,17 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	// If the user specified an upfront shutdown address, then we'll
+	// commit to paying out to it when the channel is cooperatively
+	// closed.
+	var shutdownScript lnwire.DeliveryAddress
+	if in.CloseAddress != "" {
+		shutdownScript, err = parseDeliveryAddress(in.CloseAddress)
+		if err != nil {
+			return fmt.Errorf("invalid close address: %v", err)
+		}
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -1419,6 +1700,9 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  shutdownScript,
+		zeroConf:        in.ZeroConf,
+		dualFund:        in.DualFund,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1552,6 +1836,17 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	rpcsLog.Tracef("[openchannel] target sat/kw for funding tx: %v",
 		int64(feeRate))
 
+	// If the user specified an upfront shutdown address, then we'll
+	// commit to paying out to it when the channel is cooperatively
+	// closed.
+	var shutdownScript lnwire.DeliveryAddress
+	if in.CloseAddress != "" {
+		shutdownScript, err = parseDeliveryAddress(in.CloseAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid close address: %v", err)
+		}
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       *activeNetParams.GenesisHash,
@@ -1562,6 +1857,9 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  shutdownScript,
+		zeroConf:        in.ZeroConf,
+		dualFund:        in.DualFund,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1661,6 +1959,14 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	// transaction here rather than going to the switch as we don't require
 	// interaction from the peer.
 	if force {
+		// A force close doesn't involve any negotiation, so the fee
+		// and delivery preferences can't be honored.
+		if in.DeliveryAddress != "" || in.MaxSatPerByte != 0 {
+			return fmt.Errorf("delivery_address and " +
+				"max_sat_per_byte cannot be set for force " +
+				"closures")
+		}
+
 		_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
 		if err != nil {
 			return err
@@ -1742,6 +2048,31 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		rpcsLog.Debugf("Target sat/kw for closing transaction: %v",
 			int64(feeRate))
 
+		// If the caller specified a ceiling for the fee rate, then
+		// we'll pass it along to the channel closer. Should our
+		// starting fee rate be above it, the closer will clamp our
+		// initial proposal down to the ceiling.
+		var maxFeeRate lnwallet.SatPerKWeight
+		if in.MaxSatPerByte != 0 {
+			maxFeeRate = lnwallet.SatPerKVByte(
+				in.MaxSatPerByte * 1000,
+			).FeePerKWeight()
+		}
+
+		// If the caller specified a delivery address, then we'll
+		// ensure it's valid for the current network before converting
+		// it to the script that our funds will be paid out to.
+		var deliveryScript lnwire.DeliveryAddress
+		if in.DeliveryAddress != "" {
+			deliveryScript, err = parseDeliveryAddress(
+				in.DeliveryAddress,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid delivery address: "+
+					"%v", err)
+			}
+		}
+
 		// Before we attempt the cooperative channel closure, we'll
 		// examine the channel to ensure that it doesn't have a
 		// lingering HTLC.
@@ -1756,6 +2087,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// broadcast details.
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
 			chanPoint, htlcswitch.CloseRegular, feeRate,
+			maxFeeRate, deliveryScript,
 		)
 	}
 out:
@@ -1887,6 +2219,142 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 	return &lnrpc.AbandonChannelResponse{}, nil
 }
 
+// SpliceIn adds funds from the wallet to an existing private channel, without
+// closing it.
+func (r *rpcServer) SpliceIn(ctx context.Context,
+	in *lnrpc.SpliceInRequest) (*lnrpc.SpliceResponse, error) {
+
+	rpcsLog.Tracef("[splicein] request for ChannelPoint(%v), amt=%v",
+		in.GetChannelPoint(), in.Amount)
+
+	return r.spliceChannel(
+		ctx, in.GetChannelPoint(), btcutil.Amount(in.Amount), nil,
+		in.TargetConf, in.SatPerByte,
+	)
+}
+
+// SpliceOut moves funds from our balance of an existing private channel to an
+// on-chain address, without closing the channel.
+func (r *rpcServer) SpliceOut(ctx context.Context,
+	in *lnrpc.SpliceOutRequest) (*lnrpc.SpliceResponse, error) {
+
+	rpcsLog.Tracef("[spliceout] request for ChannelPoint(%v), amt=%v, "+
+		"addr=%v", in.GetChannelPoint(), in.Amount, in.Addr)
+
+	deliveryScript, err := parseDeliveryAddress(in.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %v", err)
+	}
+
+	return r.spliceChannel(
+		ctx, in.GetChannelPoint(), btcutil.Amount(in.Amount),
+		deliveryScript, in.TargetConf, in.SatPerByte,
+	)
+}
+
+// spliceChannel splices the passed amount into the target channel, or out of
+// it to the passed delivery script if set, and waits for the splice
+// transaction to be signed by both parties.
+func (r *rpcServer) spliceChannel(ctx context.Context,
+	rpcChanPoint *lnrpc.ChannelPoint, amt btcutil.Amount,
+	deliveryScript []byte, targetConf int32,
+	satPerByte int64) (*lnrpc.SpliceResponse, error) {
+
+	if rpcChanPoint == nil {
+		return nil, fmt.Errorf("must specify channel point")
+	}
+	if amt <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	txid, err := getChanPointFundingTxid(rpcChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, rpcChanPoint.OutputIndex)
+
+	// Based on the passed fee related parameters, we'll determine an
+	// appropriate fee rate for the splice transaction.
+	satPerKw := lnwallet.SatPerKVByte(satPerByte * 1000).FeePerKWeight()
+	feeRate, err := sweep.DetermineFeePerKw(
+		r.server.cc.feeEstimator, sweep.FeePreference{
+			ConfTarget: uint32(targetConf),
+			FeeRate:    satPerKw,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("Target sat/kw for splice transaction: %v",
+		int64(feeRate))
+
+	txidChan, errChan := r.server.SpliceChannel(&spliceRequest{
+		chanPoint:      *chanPoint,
+		amt:            amt,
+		deliveryScript: deliveryScript,
+		feeRate:        feeRate,
+	})
+
+	return r.waitForSplice(ctx, chanPoint, txidChan, errChan)
+}
+
+// AbandonSplice discards a pending splice of an existing private channel whose
+// splice transaction can no longer confirm.
+func (r *rpcServer) AbandonSplice(ctx context.Context,
+	in *lnrpc.AbandonSpliceRequest) (*lnrpc.SpliceResponse, error) {
+
+	rpcsLog.Tracef("[abandonsplice] request for ChannelPoint(%v), "+
+		"splice_txid=%v", in.GetChannelPoint(), in.SpliceTxid)
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("must specify channel point")
+	}
+
+	txid, err := getChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	spliceTxid, err := chainhash.NewHashFromStr(in.SpliceTxid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid splice txid: %v", err)
+	}
+
+	txidChan, errChan := r.server.SpliceChannel(&spliceRequest{
+		chanPoint:   *chanPoint,
+		abandonTxid: spliceTxid,
+	})
+
+	return r.waitForSplice(ctx, chanPoint, txidChan, errChan)
+}
+
+// waitForSplice waits for the outcome of a splice request sent to the peer of
+// the target channel.
+func (r *rpcServer) waitForSplice(ctx context.Context,
+	chanPoint *wire.OutPoint, txidChan chan *chainhash.Hash,
+	errChan chan error) (*lnrpc.SpliceResponse, error) {
+
+	select {
+	case spliceTxid := <-txidChan:
+		return &lnrpc.SpliceResponse{
+			SpliceTxid: spliceTxid.String(),
+		}, nil
+
+	case err := <-errChan:
+		rpcsLog.Errorf("[splice] unable to splice ChannelPoint(%v): %v",
+			chanPoint, err)
+		return nil, err
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, fmt.Errorf("rpc server shutting down")
+	}
+}
+
 // fetchActiveChannel attempts to locate a channel identified by its channel
 // point from the database's set of all currently opened channels and
 // return it as a fully populated state machine
@@ -1936,7 +2404,7 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 	}
 	nPendingChannels := uint32(len(pendingChannels))
 
-	idPub := r.server.identityPriv.PubKey().SerializeCompressed()
+	idPub := r.server.identityECDH.PubKey().SerializeCompressed()
 	encodedIDPub := hex.EncodeToString(idPub)
 
 	bestHash, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
@@ -2076,14 +2544,29 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 func (r *rpcServer) WalletBalance(ctx context.Context,
 	in *lnrpc.WalletBalanceRequest) (*lnrpc.WalletBalanceResponse, error) {
 
+	// If an account was specified, then only the outputs of that account
+	// are taken into account, otherwise the balance also includes the
+	// imported watch-only accounts.
+	wallet := r.server.cc.wallet
+	balance := wallet.TotalBalance
+	if in.Account != "" {
+		if _, err := wallet.FetchAccount(in.Account); err != nil {
+			return nil, err
+		}
+
+		balance = func(confs int32) (btcutil.Amount, error) {
+			return wallet.AccountBalance(in.Account, confs)
+		}
+	}
+
 	// Get total balance, from txs that have >= 0 confirmations.
-	totalBal, err := r.server.cc.wallet.ConfirmedBalance(0)
+	totalBal, err := balance(0)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get confirmed balance, from txs that have >= 1 confirmations.
-	confirmedBal, err := r.server.cc.wallet.ConfirmedBalance(1)
+	confirmedBal, err := balance(1)
 	if err != nil {
 		return nil, err
 	}
@@ -2228,7 +2711,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			resp.TotalLimboBalance += channel.LocalBalance
 
 		// If the channel was force closed, then we'll need to query
-		// the utxoNursery for additional information.
+		// the channel arbitrator for additional information.
 		// TODO(halseth): distinguish remote and local case?
 		case channeldb.LocalForceClose, channeldb.RemoteForceClose:
 			forceClose := &lnrpc.PendingChannelsResponse_ForceClosedChannel{
@@ -2236,19 +2719,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				ClosingTxid: closeTXID,
 			}
 
-			// Fetch reports from both nursery and resolvers. At the
-			// moment this is not an atomic snapshot. This is
-			// planned to be resolved when the nursery is removed
-			// and channel arbitrator will be the single source for
-			// these kind of reports.
-			err := r.nurseryPopulateForceCloseResp(
-				&chanPoint, currentHeight, forceClose,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			err = r.arbitratorPopulateForceCloseResp(
+			err := r.arbitratorPopulateForceCloseResp(
 				&chanPoint, currentHeight, forceClose,
 			)
 			if err != nil {
@@ -2314,82 +2785,49 @@ func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
 	reports := arbitrator.Report()
 
 	for _, report := range reports {
-		htlc := &lnrpc.PendingHTLC{
-			Incoming:       report.Incoming,
-			Amount:         int64(report.Amount),
-			Outpoint:       report.Outpoint.String(),
-			MaturityHeight: report.MaturityHeight,
-			Stage:          report.Stage,
-		}
-
-		if htlc.MaturityHeight != 0 {
-			htlc.BlocksTilMaturity =
-				int32(htlc.MaturityHeight) - currentHeight
-		}
-
 		forceClose.LimboBalance += int64(report.LimboBalance)
 		forceClose.RecoveredBalance += int64(report.RecoveredBalance)
 
-		forceClose.PendingHtlcs = append(forceClose.PendingHtlcs, htlc)
-	}
+		switch report.Type {
+
+		// The commitment output paying to us determines the maturity
+		// height of the channel itself. If the commitment transaction
+		// hasn't confirmed yet, the maturity height isn't known.
+		case contractcourt.ReportOutputUnencumbered:
+			forceClose.MaturityHeight = report.MaturityHeight
+			if forceClose.MaturityHeight != 0 {
+				forceClose.BlocksTilMaturity =
+					int32(forceClose.MaturityHeight) -
+						currentHeight
+			}
 
-	return nil
-}
+		case contractcourt.ReportOutputIncomingHtlc,
+			contractcourt.ReportOutputOutgoingHtlc:
 
-// nurseryPopulateForceCloseResp populates the pending channels response
-// message with contract resolution information from utxonursery.
-func (r *rpcServer) nurseryPopulateForceCloseResp(chanPoint *wire.OutPoint,
-	currentHeight int32,
-	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel) error {
+			incoming := report.Type ==
+				contractcourt.ReportOutputIncomingHtlc
 
-	// Query for the maturity state for this force closed channel. If we
-	// didn't have any time-locked outputs, then the nursery may not know of
-	// the contract.
-	nurseryInfo, err := r.server.utxoNursery.NurseryReport(chanPoint)
-	if err == ErrContractNotFound {
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("unable to obtain "+
-			"nursery report for ChannelPoint(%v): %v",
-			chanPoint, err)
-	}
-
-	// If the nursery knows of this channel, then we can populate
-	// information detailing exactly how much funds are time locked and also
-	// the height in which we can ultimately sweep the funds into the
-	// wallet.
-	forceClose.LimboBalance = int64(nurseryInfo.limboBalance)
-	forceClose.RecoveredBalance = int64(nurseryInfo.recoveredBalance)
-	forceClose.MaturityHeight = nurseryInfo.maturityHeight
+			htlc := &lnrpc.PendingHTLC{
+				Incoming:       incoming,
+				Amount:         int64(report.Amount),
+				Outpoint:       report.Outpoint.String(),
+				MaturityHeight: report.MaturityHeight,
+				Stage:          report.Stage,
+			}
 
-	// If the transaction has been confirmed, then we can compute how many
-	// blocks it has left.
-	if forceClose.MaturityHeight != 0 {
-		forceClose.BlocksTilMaturity =
-			int32(forceClose.MaturityHeight) -
-				currentHeight
-	}
+			if htlc.MaturityHeight != 0 {
+				htlc.BlocksTilMaturity =
+					int32(htlc.MaturityHeight) - currentHeight
+			}
 
-	for _, htlcReport := range nurseryInfo.htlcs {
-		// TODO(conner) set incoming flag appropriately after handling
-		// incoming incubation
-		htlc := &lnrpc.PendingHTLC{
-			Incoming:       false,
-			Amount:         int64(htlcReport.amount),
-			Outpoint:       htlcReport.outpoint.String(),
-			MaturityHeight: htlcReport.maturityHeight,
-			Stage:          htlcReport.stage,
-		}
+			forceClose.PendingHtlcs = append(
+				forceClose.PendingHtlcs, htlc,
+			)
 
-		if htlc.MaturityHeight != 0 {
-			htlc.BlocksTilMaturity =
-				int32(htlc.MaturityHeight) -
-					currentHeight
+		default:
+			return fmt.Errorf("unknown report output type: %v",
+				report.Type)
 		}
-
-		forceClose.PendingHtlcs = append(forceClose.PendingHtlcs,
-			htlc)
 	}
 
 	return nil
@@ -2453,12 +2891,180 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 		}
 
 		channel := createRPCClosedChannel(dbChannel)
+
+		// For force closed channels, we'll also include the
+		// resolutions of the channel's outputs.
+		switch dbChannel.CloseType {
+		case channeldb.LocalForceClose, channeldb.RemoteForceClose:
+			resolutions, err := r.fetchRPCResolutions(
+				dbChannel.ChainHash, &dbChannel.ChanPoint,
+			)
+			if err != nil {
+				return nil, err
+			}
+			channel.Resolutions = resolutions
+		}
+
 		resp.Channels = append(resp.Channels, channel)
 	}
 
 	return resp, nil
 }
 
+// GetForceCloseReport returns a report on the resolution of the outputs of a
+// force closed channel. The resolutions persisted by the contract resolvers
+// are returned, along with the outputs that are still being resolved if the
+// channel arbitrator is still active.
+func (r *rpcServer) GetForceCloseReport(ctx context.Context,
+	in *lnrpc.ForceCloseReportRequest) (*lnrpc.ForceCloseReportResponse,
+	error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel point must be specified")
+	}
+
+	txid, err := getChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: in.ChannelPoint.OutputIndex,
+	}
+
+	// The close summary of the channel is written as soon as it's force
+	// closed, and tells us which chain the reports were stored under.
+	channel, err := r.server.chanDB.FetchClosedChannel(&chanPoint)
+	if err == channeldb.ErrClosedChannelNotFound {
+		return nil, fmt.Errorf("no force close report found for "+
+			"channel %v", chanPoint)
+	} else if err != nil {
+		return nil, err
+	}
+
+	resolutions, err := r.fetchRPCResolutions(
+		channel.ChainHash, &chanPoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ForceCloseReportResponse{
+		Resolutions: resolutions,
+	}
+
+	// If the channel is still being resolved, its arbitrator is still
+	// active, and we'll add the outputs that are pending resolution.
+	arbitrator, err := r.server.chainArb.GetChannelArbitrator(chanPoint)
+	if err != nil {
+		if len(resolutions) == 0 {
+			return nil, fmt.Errorf("no force close report found "+
+				"for channel %v", chanPoint)
+		}
+
+		return resp, nil
+	}
+
+	for _, report := range arbitrator.Report() {
+		var resolutionType lnrpc.ResolutionType
+		switch report.Type {
+		case contractcourt.ReportOutputUnencumbered:
+			resolutionType = lnrpc.ResolutionType_COMMIT
+		case contractcourt.ReportOutputIncomingHtlc:
+			resolutionType = lnrpc.ResolutionType_INCOMING_HTLC
+		case contractcourt.ReportOutputOutgoingHtlc:
+			resolutionType = lnrpc.ResolutionType_OUTGOING_HTLC
+		default:
+			return nil, fmt.Errorf("unknown report output type: %v",
+				report.Type)
+		}
+
+		resp.PendingResolutions = append(
+			resp.PendingResolutions, &lnrpc.PendingResolution{
+				ResolutionType: resolutionType,
+				Outpoint: &lnrpc.OutPoint{
+					TxidBytes:   report.Outpoint.Hash[:],
+					TxidStr:     report.Outpoint.Hash.String(),
+					OutputIndex: report.Outpoint.Index,
+				},
+				AmountSat:       uint64(report.Amount),
+				LimboBalanceSat: uint64(report.LimboBalance),
+				MaturityHeight:  report.MaturityHeight,
+				Stage:           report.Stage,
+				DeadlineHeight:  report.DeadlineHeight,
+				DeadlineMissed:  report.DeadlineMissed,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// fetchRPCResolutions fetches the resolver reports that were persisted for the
+// passed channel, and converts them to their rpc representation.
+func (r *rpcServer) fetchRPCResolutions(chainHash chainhash.Hash,
+	chanPoint *wire.OutPoint) ([]*lnrpc.Resolution, error) {
+
+	reports, err := r.server.chanDB.FetchChannelReports(
+		chainHash, chanPoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resolutions := make([]*lnrpc.Resolution, 0, len(reports))
+	for _, report := range reports {
+		var resolutionType lnrpc.ResolutionType
+		switch report.ResolverType {
+		case channeldb.ResolverTypeCommit:
+			resolutionType = lnrpc.ResolutionType_COMMIT
+		case channeldb.ResolverTypeIncomingHtlc:
+			resolutionType = lnrpc.ResolutionType_INCOMING_HTLC
+		case channeldb.ResolverTypeOutgoingHtlc:
+			resolutionType = lnrpc.ResolutionType_OUTGOING_HTLC
+		default:
+			return nil, fmt.Errorf("unknown resolver type: %v",
+				report.ResolverType)
+		}
+
+		var outcome lnrpc.ResolutionOutcome
+		switch report.ResolverOutcome {
+		case channeldb.ResolverOutcomeClaimed:
+			outcome = lnrpc.ResolutionOutcome_CLAIMED
+		case channeldb.ResolverOutcomeUnclaimed:
+			outcome = lnrpc.ResolutionOutcome_UNCLAIMED
+		case channeldb.ResolverOutcomeAbandoned:
+			outcome = lnrpc.ResolutionOutcome_ABANDONED
+		case channeldb.ResolverOutcomeFirstStage:
+			outcome = lnrpc.ResolutionOutcome_FIRST_STAGE
+		case channeldb.ResolverOutcomeTimeout:
+			outcome = lnrpc.ResolutionOutcome_TIMEOUT
+		default:
+			return nil, fmt.Errorf("unknown resolver outcome: %v",
+				report.ResolverOutcome)
+		}
+
+		resolution := &lnrpc.Resolution{
+			ResolutionType: resolutionType,
+			Outcome:        outcome,
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   report.OutPoint.Hash[:],
+				TxidStr:     report.OutPoint.Hash.String(),
+				OutputIndex: report.OutPoint.Index,
+			},
+			AmountSat:  uint64(report.Amount),
+			WaitHeight: report.WaitHeight,
+		}
+		if report.SpendTxID != nil {
+			resolution.SweepTxid = report.SpendTxID.String()
+		}
+
+		resolutions = append(resolutions, resolution)
+	}
+
+	return resolutions, nil
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -2584,6 +3190,7 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
 		Initiator:             dbChannel.IsInitiator,
 		ChanStatusFlags:       dbChannel.ChanStatus().String(),
+		ConfirmedChanId:       dbChannel.ConfirmedShortChanID().ToUint64(),
 	}
 
 	for i, htlc := range localCommit.Htlcs {
@@ -2706,6 +3313,12 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 						},
 					},
 				}
+
+			// Splices only change the funding output of a channel,
+			// which isn't part of the streamed updates.
+			case channelnotifier.SplicedChannelEvent:
+				continue
+
 			default:
 				return fmt.Errorf("unexpected channel event update: %v", event)
 			}
@@ -2719,6 +3332,73 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 	}
 }
 
+// SubscribeBreachEvents returns a uni-directional stream (server -> client)
+// for notifying the client of the progress of the retribution of breached
+// channels.
+func (r *rpcServer) SubscribeBreachEvents(req *lnrpc.BreachEventSubscription,
+	updateStream lnrpc.Lightning_SubscribeBreachEventsServer) error {
+
+	breachEventSub, err := r.server.breachArbiter.SubscribeBreachEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer breachEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the breach arbiter, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-breachEventSub.Updates():
+			var update *lnrpc.BreachEvent
+			switch event := e.(type) {
+			case BreachDetectedEvent:
+				update = &lnrpc.BreachEvent{
+					Type:         lnrpc.BreachEventType_BREACH_DETECTED,
+					ChannelPoint: event.ChanPoint.String(),
+					Txid:         event.BreachTxID.String(),
+				}
+			case JusticeTxBroadcastEvent:
+				update = &lnrpc.BreachEvent{
+					Type:         lnrpc.BreachEventType_JUSTICE_TX_BROADCAST,
+					ChannelPoint: event.ChanPoint.String(),
+					Txid:         event.JusticeTxID.String(),
+					NumInputs:    uint32(event.NumInputs),
+					SatPerKw:     int64(event.FeeRate),
+				}
+			case BreachedOutputSpentEvent:
+				update = &lnrpc.BreachEvent{
+					Type:         lnrpc.BreachEventType_OUTPUT_SPENT,
+					ChannelPoint: event.ChanPoint.String(),
+					Txid:         event.SpendTxID.String(),
+					Outpoint:     event.OutPoint.String(),
+					Claimed:      event.Claimed,
+					SecondLevel:  event.SecondLevel,
+				}
+			case BreachResolvedEvent:
+				update = &lnrpc.BreachEvent{
+					Type:            lnrpc.BreachEventType_BREACH_RESOLVED,
+					ChannelPoint:    event.ChanPoint.String(),
+					RevokedFundsSat: int64(event.RevokedFunds),
+					TotalFundsSat:   int64(event.TotalFunds),
+				}
+			default:
+				return fmt.Errorf("unexpected breach event: %v",
+					event)
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // savePayment saves a successfully completed payment to the database for
 // historical record keeping.
 func (r *rpcServer) savePayment(route *route.Route,
@@ -3383,6 +4063,215 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 	}, nil
 }
 
+// Rebalance moves funds between two of our channels by paying an internal
+// invoice through a circular route that leaves through the outgoing channel
+// and arrives back from the specified last hop. The outcome is recorded as a
+// rebalance rather than as a regular payment.
+func (r *rpcServer) Rebalance(ctx context.Context,
+	req *lnrpc.RebalanceRequest) (*lnrpc.RebalanceResponse, error) {
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	switch {
+	case req.OutgoingChanId == 0:
+		return nil, fmt.Errorf("outgoing channel must be specified")
+
+	case req.IncomingChanId == 0 && len(req.LastHopPubkey) == 0:
+		return nil, fmt.Errorf("either incoming channel or last hop " +
+			"must be specified")
+
+	case req.IncomingChanId != 0 && len(req.LastHopPubkey) != 0:
+		return nil, fmt.Errorf("incoming channel and last hop cannot " +
+			"both be specified")
+
+	case req.OutgoingChanId == req.IncomingChanId:
+		return nil, fmt.Errorf("outgoing and incoming channel must " +
+			"differ")
+
+	case req.Amt <= 0:
+		return nil, fmt.Errorf("amount must be positive")
+
+	case req.MaxFee < 0:
+		return nil, fmt.Errorf("max fee cannot be negative")
+	}
+
+	selfVertex := route.NewVertex(r.server.identityECDH.PubKey())
+
+	// Determine the last hop, which is the peer of the incoming channel
+	// if one was specified.
+	var lastHop route.Vertex
+	if req.IncomingChanId != 0 {
+		graph := r.server.chanDB.ChannelGraph()
+		info, _, _, err := graph.FetchChannelEdgesByID(
+			req.IncomingChanId,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch incoming "+
+				"channel %v: %v", req.IncomingChanId, err)
+		}
+
+		switch selfVertex {
+		case info.NodeKey1Bytes:
+			lastHop = info.NodeKey2Bytes
+		case info.NodeKey2Bytes:
+			lastHop = info.NodeKey1Bytes
+		default:
+			return nil, fmt.Errorf("incoming channel %v is not "+
+				"one of our channels", req.IncomingChanId)
+		}
+	} else {
+		pubKey, err := btcec.ParsePubKey(req.LastHopPubkey, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid last hop: %v", err)
+		}
+		lastHop = route.NewVertex(pubKey)
+
+		if lastHop == selfVertex {
+			return nil, fmt.Errorf("last hop cannot be ourselves")
+		}
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.Amt))
+	if amt > maxPaymentMSat {
+		return nil, fmt.Errorf("amount of %v exceeds maximum of %v",
+			amt, maxPaymentMSat)
+	}
+
+	defaultDelta := cfg.Bitcoin.TimeLockDelta
+	if registeredChains.PrimaryChain() == monacoinChain {
+		defaultDelta = cfg.Monacoin.TimeLockDelta
+	}
+
+	// The circular payment pays an internal invoice of ours, for which
+	// we'll generate a fresh preimage.
+	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        r.server.invoices.AddInvoice,
+		IsChannelActive:   r.server.htlcSwitch.HasActiveLink,
+		ChainParams:       activeNetParams.Params,
+		NodeSigner:        r.server.nodeSigner,
+		MaxPaymentMSat:    maxPaymentMSat,
+		DefaultCLTVExpiry: defaultDelta,
+		ChanDB:            r.server.chanDB,
+	}
+	hash, _, err := invoicesrpc.AddInvoice(
+		ctx, addInvoiceCfg, &invoicesrpc.AddInvoiceData{
+			Memo:       "rebalance",
+			Value:      btcutil.Amount(req.Amt),
+			CltvExpiry: uint64(defaultDelta),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the invoice added, we'll send the payment around the circle.
+	// The router retries along other routes, taking into account the
+	// failures reported to mission control, until one succeeds or no
+	// route within the fee budget remains.
+	outgoingChanID := req.OutgoingChanId
+	finalCLTVDelta := uint16(defaultDelta)
+	feeLimit := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.MaxFee))
+	payment := &routing.LightningPayment{
+		Target:            selfVertex,
+		Amount:            amt,
+		FeeLimit:          feeLimit,
+		PaymentHash:       *hash,
+		FinalCLTVDelta:    &finalCLTVDelta,
+		OutgoingChannelID: &outgoingChanID,
+		LastHop:           &lastHop,
+	}
+
+	// If the incoming channel was specified, then the payment must
+	// arrive back through exactly that channel, rather than through any
+	// channel with its peer.
+	if req.IncomingChanId != 0 {
+		incomingChanID := req.IncomingChanId
+		payment.LastChannelID = &incomingChanID
+	}
+
+	rebalance := &channeldb.Rebalance{
+		PaymentHash:    *hash,
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(outgoingChanID),
+		LastHop:        lastHop,
+		Amount:         amt,
+	}
+
+	_, rt, routerErr := r.server.chanRouter.SendPayment(payment)
+	if routerErr != nil {
+		rpcsLog.Errorf("Rebalance from channel %v through %x failed: "+
+			"%v", outgoingChanID, lastHop[:], routerErr)
+
+		// The invoice can't be paid anymore, so we'll cancel it.
+		if err := r.server.invoices.CancelInvoice(*hash); err != nil {
+			rpcsLog.Errorf("Unable to cancel rebalance invoice %v: %v",
+				hash, err)
+		}
+
+		rebalance.FailureReason = routerErr.Error()
+	} else {
+		lastRouteHop := rt.Hops[len(rt.Hops)-1]
+		rebalance.IncomingChanID = lnwire.NewShortChanIDFromInt(
+			lastRouteHop.ChannelID,
+		)
+		rebalance.Fee = rt.TotalFees
+		rebalance.Succeeded = true
+	}
+	rebalance.Timestamp = time.Now()
+
+	if err := r.server.chanDB.AddRebalance(rebalance); err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.RebalanceResponse{
+		Rebalance: marshallRebalance(rebalance),
+	}
+	if rt != nil {
+		resp.Route = r.routerBackend.MarshallRoute(rt)
+	}
+
+	return resp, nil
+}
+
+// ListRebalances returns the outcome of all rebalances that were attempted.
+func (r *rpcServer) ListRebalances(ctx context.Context,
+	req *lnrpc.ListRebalancesRequest) (*lnrpc.ListRebalancesResponse,
+	error) {
+
+	rebalances, err := r.server.chanDB.FetchRebalances()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListRebalancesResponse{
+		Rebalances: make([]*lnrpc.Rebalance, 0, len(rebalances)),
+	}
+	for _, rebalance := range rebalances {
+		resp.Rebalances = append(
+			resp.Rebalances, marshallRebalance(rebalance),
+		)
+	}
+
+	return resp, nil
+}
+
+// marshallRebalance converts a rebalance into its rpc representation.
+func marshallRebalance(rebalance *channeldb.Rebalance) *lnrpc.Rebalance {
+	return &lnrpc.Rebalance{
+		PaymentHash:    rebalance.PaymentHash[:],
+		OutgoingChanId: rebalance.OutgoingChanID.ToUint64(),
+		IncomingChanId: rebalance.IncomingChanID.ToUint64(),
+		LastHopPubkey:  hex.EncodeToString(rebalance.LastHop[:]),
+		AmtMsat:        int64(rebalance.Amount),
+		FeeMsat:        int64(rebalance.Fee),
+		Succeeded:      rebalance.Succeeded,
+		FailureReason:  rebalance.FailureReason,
+		Timestamp:      rebalance.Timestamp.Unix(),
+	}
+}
+
 // AddInvoice attempts to add a new invoice to the invoice database. Any
 // duplicated invoices are rejected, therefore all invoices *must* have a
 // unique payment preimage.
@@ -3596,6 +4485,7 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 				BlockHash:        tx.BlockHash.String(),
 				TimeStamp:        tx.Timestamp,
 				TotalFees:        tx.TotalFees,
+				AccountAmounts:   marshallAccountAmounts(tx),
 			}
 			if err := updateStream.Send(detail); err != nil {
 				return err
@@ -3603,10 +4493,11 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 
 		case tx := <-txClient.UnconfirmedTransactions():
 			detail := &lnrpc.Transaction{
-				TxHash:    tx.Hash.String(),
-				Amount:    int64(tx.Value),
-				TimeStamp: tx.Timestamp,
-				TotalFees: tx.TotalFees,
+				TxHash:         tx.Hash.String(),
+				Amount:         int64(tx.Value),
+				TimeStamp:      tx.Timestamp,
+				TotalFees:      tx.TotalFees,
+				AccountAmounts: marshallAccountAmounts(tx),
 			}
 			if err := updateStream.Send(detail); err != nil {
 				return err
@@ -3654,12 +4545,35 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			TimeStamp:        tx.Timestamp,
 			TotalFees:        tx.TotalFees,
 			DestAddresses:    destAddresses,
+			AccountAmounts:   marshallAccountAmounts(tx),
 		}
 	}
 
 	return txDetails, nil
 }
 
+// marshallAccountAmounts converts the per account values of a transaction into
+// their RPC counterparts, sorted by the name of the account.
+func marshallAccountAmounts(
+	tx *lnwallet.TransactionDetail) []*lnrpc.AccountAmount {
+
+	accounts := make([]string, 0, len(tx.AccountValues))
+	for account := range tx.AccountValues {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	amounts := make([]*lnrpc.AccountAmount, 0, len(accounts))
+	for _, account := range accounts {
+		amounts = append(amounts, &lnrpc.AccountAmount{
+			Account: account,
+			Amount:  int64(tx.AccountValues[account]),
+		})
+	}
+
+	return amounts
+}
+
 // DescribeGraph returns a description of the latest graph state from the PoV
 // of the node. The graph information is partitioned into two components: all
 // the nodes/vertexes, and all the edges that connect the vertexes themselves.
@@ -3680,7 +4594,7 @@ func (r *rpcServer) DescribeGraph(ctx context.Context,
 	// First iterate through all the known nodes (connected or unconnected
 	// within the graph), collating their current state into the RPC
 	// response.
-	err := graph.ForEachNode(nil, func(_ *bbolt.Tx, node *channeldb.LightningNode) error {
+	err := graph.ForEachNode(nil, func(_ kvdb.Tx, node *channeldb.LightningNode) error {
 		nodeAddrs := make([]*lnrpc.NodeAddress, 0)
 		for _, addr := range node.Addresses {
 			nodeAddr := &lnrpc.NodeAddress{
@@ -3834,7 +4748,7 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 		numChannels   uint32
 		totalCapacity btcutil.Amount
 	)
-	if err := node.ForEachChannel(nil, func(_ *bbolt.Tx, edge *channeldb.ChannelEdgeInfo,
+	if err := node.ForEachChannel(nil, func(_ kvdb.Tx, edge *channeldb.ChannelEdgeInfo,
 		_, _ *channeldb.ChannelEdgePolicy) error {
 
 		numChannels++
@@ -4019,7 +4933,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 	// network, tallying up the total number of nodes, and also gathering
 	// each node so we can measure the graph diameter and degree stats
 	// below.
-	if err := graph.ForEachNode(nil, func(tx *bbolt.Tx, node *channeldb.LightningNode) error {
+	if err := graph.ForEachNode(nil, func(tx kvdb.Tx, node *channeldb.LightningNode) error {
 		// Increment the total number of nodes with each iteration.
 		numNodes++
 
@@ -4029,7 +4943,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		// through the db transaction from the outer view so we can
 		// re-use it within this inner view.
 		var outDegree uint32
-		if err := node.ForEachChannel(tx, func(_ *bbolt.Tx,
+		if err := node.ForEachChannel(tx, func(_ kvdb.Tx,
 			edge *channeldb.ChannelEdgeInfo, _, _ *channeldb.ChannelEdgePolicy) error {
 
 			// Bump up the out degree for this node for each
@@ -4122,6 +5036,46 @@ func (r *rpcServer) StopDaemon(ctx context.Context,
 	return &lnrpc.StopResponse{}, nil
 }
 
+// RotateMacaroonRootKey deletes all macaroon files from the file system of the
+// daemon, and replaces the root key of all macaroons with a new one. This
+// invalidates every macaroon that was created so far. A new admin macaroon is
+// only returned in the response, and isn't written to disk.
+func (r *rpcServer) RotateMacaroonRootKey(ctx context.Context,
+	_ *lnrpc.RotateMacaroonRootKeyRequest) (
+	*lnrpc.RotateMacaroonRootKeyResponse, error) {
+
+	if r.macService == nil {
+		return nil, fmt.Errorf("macaroons are disabled")
+	}
+
+	// Rotating the root key invalidates every macaroon baked so far,
+	// including the ones stored on disk. Their files are deleted
+	// afterwards, as they no longer grant any access.
+	if err := r.macService.GenerateNewRootKey(); err != nil {
+		return nil, fmt.Errorf("unable to rotate macaroon root key: "+
+			"%v", err)
+	}
+
+	for _, file := range macaroonFiles() {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	adminMac, err := bakeMacaroon(ctx, r.macService, adminPermissions())
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[rotatemacaroonkey] rotated macaroon root key, all " +
+		"prior macaroons are invalidated")
+
+	return &lnrpc.RotateMacaroonRootKeyResponse{
+		AdminMacaroon: adminMac,
+	}, nil
+}
+
 // SubscribeChannelGraph launches a streaming RPC that allows the caller to
 // receive notifications upon any changes the channel graph topology from the
 // review of the responding node. Events notified include: new nodes coming
@@ -4405,7 +5359,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	}
 
 	var feeReports []*lnrpc.ChannelFeeReport
-	err = selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, chanInfo *channeldb.ChannelEdgeInfo,
+	err = selfNode.ForEachChannel(nil, func(_ kvdb.Tx, chanInfo *channeldb.ChannelEdgeInfo,
 		edgePolicy, _ *channeldb.ChannelEdgePolicy) error {
 
 		// Self node should always have policies for its channels.
@@ -20,10 +20,11 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/ticker"
@@ -37,6 +38,7 @@ import (
 	"github.com/wakiyamap/lnd/htlcswitch"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/invoices"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lncfg"
 	"github.com/wakiyamap/lnd/lnpeer"
 	"github.com/wakiyamap/lnd/lnrpc"
@@ -44,6 +46,7 @@ import (
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/nat"
 	"github.com/wakiyamap/lnd/netann"
+	"github.com/wakiyamap/lnd/peeraccess"
 	"github.com/wakiyamap/lnd/pool"
 	"github.com/wakiyamap/lnd/routing"
 	"github.com/wakiyamap/lnd/routing/route"
@@ -115,12 +118,12 @@ type server struct {
 	start sync.Once
 	stop  sync.Once
 
-	// identityPriv is the private key used to authenticate any incoming
-	// connections.
-	identityPriv *btcec.PrivateKey
+	// identityECDH is the ECDH abstraction of the identity key used to
+	// authenticate any incoming connections.
+	identityECDH keychain.SingleKeyECDH
 
 	// nodeSigner is an implementation of the MessageSigner implementation
-	// that's backed by the identity private key of the running lnd node.
+	// that's backed by the identity key of the running lnd node.
 	nodeSigner *netann.NodeSigner
 
 	chanStatusMgr *netann.ChanStatusManager
@@ -172,6 +175,10 @@ type server struct {
 	// intended to replace it.
 	scheduledPeerConnection map[string]func()
 
+	// peerAccess maintains the allow and deny lists that control which
+	// peers may connect to us.
+	peerAccess *peeraccess.Manager
+
 	cc *chainControl
 
 	fundingMgr *fundingManager
@@ -192,8 +199,6 @@ type server struct {
 
 	authGossiper *discovery.AuthenticatedGossiper
 
-	utxoNursery *utxoNursery
-
 	sweeper *sweep.UtxoSweeper
 
 	chainArb *contractcourt.ChainArbitrator
@@ -208,6 +213,11 @@ type server struct {
 
 	readPool *pool.Read
 
+	// writeBufferPool and readBufferPool are the pools the buffers used by
+	// the brontide connections of our peers are taken from.
+	writeBufferPool *pool.WriteBuffer
+	readBufferPool  *pool.ReadBuffer
+
 	// globalFeatures feature vector which affects HTLCs and thus are also
 	// advertised to other nodes.
 	globalFeatures *lnwire.FeatureVector
@@ -269,28 +279,83 @@ func parseAddr(address string) (net.Addr, error) {
 
 // noiseDial is a factory function which creates a connmgr compliant dialing
 // function by returning a closure which includes the server's identity key.
-func noiseDial(idPriv *btcec.PrivateKey) func(net.Addr) (net.Conn, error) {
+func noiseDial(idKey keychain.SingleKeyECDH) func(net.Addr) (net.Conn, error) {
 	return func(a net.Addr) (net.Conn, error) {
 		lnAddr := a.(*lnwire.NetAddress)
-		return brontide.Dial(idPriv, lnAddr, cfg.net.Dial)
+		return brontide.Dial(idKey, lnAddr, cfg.net.Dial)
 	}
 }
 
 // newServer creates a new instance of the server which is to listen using the
 // passed listener address.
 func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
-	privKey *btcec.PrivateKey,
 	chansToRestore walletunlocker.ChannelsToRecover) (*server, error) {
 
 	var err error
 
+	// Our node identity key is only accessed through these abstractions,
+	// such that it may be held by a remote signer.
+	nodeKeyECDH := cc.nodeKeyECDH
+	nodeKeySigner := cc.nodeKeySigner
+
+	// Populate the allow and deny lists with the rules from our config,
+	// such that they're in place before we accept any connections.
+	peerAccess := peeraccess.NewManager()
+	addRules := func(list peeraccess.List, rules []string) error {
+		for _, r := range rules {
+			rule, err := peeraccess.ParseRule(r)
+			if err != nil {
+				return fmt.Errorf("invalid %v rule: %v", list,
+					err)
+			}
+			if err := peerAccess.AddRule(list, rule); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+	if err := addRules(peeraccess.Allow, cfg.PeerAccess.Allow); err != nil {
+		return nil, err
+	}
+	if err := addRules(peeraccess.Deny, cfg.PeerAccess.Deny); err != nil {
+		return nil, err
+	}
+
+	// Connections from denied addresses are rejected by the listeners
+	// before any handshake is attempted.
+	connFilter := func(addr net.Addr) error {
+		if peerAccess.AddrDenied(addr) {
+			return peeraccess.ErrDenied
+		}
+
+		return nil
+	}
+
+	// Inbound connections over Tor are relayed by the local Tor daemon,
+	// so they all originate from a loopback address. These are exempt
+	// from the per IP handshake limit, as it would otherwise apply to all
+	// of them together.
+	isLoopback := func(addr net.Addr) bool {
+		tcpAddr, ok := addr.(*net.TCPAddr)
+		return ok && tcpAddr.IP.IsLoopback()
+	}
+
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
 		// Note: though brontide.NewListener uses ResolveTCPAddr, it
 		// doesn't need to call the general lndResolveTCP function
 		// since we are resolving a local address.
 		listeners[i], err = brontide.NewListener(
-			privKey, listenAddr.String(),
+			nodeKeyECDH, listenAddr.String(),
+			brontide.MaxPendingHandshakes(
+				cfg.PeerAccess.MaxPendingHandshakes,
+			),
+			brontide.MaxPendingHandshakesPerHost(
+				cfg.PeerAccess.MaxPendingHandshakesPerIP,
+			),
+			brontide.HostLimitExempt(isLoopback),
+			brontide.ConnFilter(connFilter),
 		)
 		if err != nil {
 			return nil, err
@@ -300,14 +365,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	globalFeatures := lnwire.NewRawFeatureVector()
 
 	var serializedPubKey [33]byte
-	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
+	copy(serializedPubKey[:], nodeKeyECDH.PubKey().SerializeCompressed())
 
 	// Initialize the sphinx router, placing it's persistent replay log in
 	// the same directory as the channel graph database.
-	graphDir := chanDB.Path()
-	sharedSecretPath := filepath.Join(graphDir, "sphinxreplay.db")
-	replayLog := htlcswitch.NewDecayedLog(sharedSecretPath, cc.chainNotifier)
-	sphinxRouter := sphinx.NewRouter(privKey, activeNetParams.Params, replayLog)
+	replayLog := htlcswitch.NewDecayedLog(
+		sphinxReplayLogPath(chanDB), cc.chainNotifier,
+	)
+	sphinxRouter := sphinx.NewRouter(
+		nodeKeyECDH, activeNetParams.Params, replayLog,
+	)
 
 	writeBufferPool := pool.NewWriteBuffer(
 		pool.DefaultWriteBufferGCInterval,
@@ -342,13 +409,17 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		writePool:      writePool,
 		readPool:       readPool,
 		chansToRestore: chansToRestore,
+		peerAccess:     peerAccess,
+
+		writeBufferPool: writeBufferPool,
+		readBufferPool:  readBufferPool,
 
 		invoices: invoices.NewRegistry(chanDB, decodeFinalCltvExpiry),
 
 		channelNotifier: channelnotifier.New(chanDB),
 
-		identityPriv: privKey,
-		nodeSigner:   netann.NewNodeSigner(privKey),
+		identityECDH: nodeKeyECDH,
+		nodeSigner:   netann.NewNodeSigner(nodeKeySigner),
 
 		listenAddrs: listenAddrs,
 
@@ -397,7 +468,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB:      chanDB,
-		SelfKey: s.identityPriv.PubKey(),
+		SelfKey: s.identityECDH.PubKey(),
 		LocalChannelClose: func(pubKey []byte,
 			request *htlcswitch.ChanClose) {
 
@@ -440,7 +511,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
 		ChanEnableTimeout:        cfg.ChanEnableTimeout,
 		ChanDisableTimeout:       cfg.ChanDisableTimeout,
-		OurPubKey:                privKey.PubKey(),
+		OurPubKey:                nodeKeyECDH.PubKey(),
 		MessageSigner:            s.nodeSigner,
 		IsChannelActive:          s.htlcSwitch.HasActiveLink,
 		ApplyChannelUpdate:       s.applyChannelUpdate,
@@ -574,7 +645,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		Features:             s.globalFeatures,
 		Color:                color,
 	}
-	copy(selfNode.PubKeyBytes[:], privKey.PubKey().SerializeCompressed())
+	copy(
+		selfNode.PubKeyBytes[:],
+		nodeKeyECDH.PubKey().SerializeCompressed(),
+	)
 
 	// Based on the disk representation of the node announcement generated
 	// above, we'll generate a node announcement that can go out on the
@@ -587,7 +661,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	// With the announcement generated, we'll sign it to properly
 	// authenticate the message on the network.
 	authSig, err := discovery.SignAnnouncement(
-		s.nodeSigner, s.identityPriv.PubKey(), nodeAnn,
+		s.nodeSigner, s.identityECDH.PubKey(), nodeAnn,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate signature for "+
@@ -691,22 +765,24 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		RotateTicker:         ticker.New(discovery.DefaultSyncerRotationInterval),
 		HistoricalSyncTicker: ticker.New(cfg.HistoricalSyncInterval),
 		NumActiveSyncers:     cfg.NumGraphSyncPeers,
+		FetchRemoteAlias: func(chanPoint wire.OutPoint) (
+			lnwire.ShortChannelID, error) {
+
+			channel, err := chanDB.FetchChannel(chanPoint)
+			if err != nil {
+				return lnwire.ShortChannelID{}, err
+			}
+
+			return channel.RemoteAlias(), nil
+		},
 	},
-		s.identityPriv.PubKey(),
+		s.identityECDH.PubKey(),
 	)
 
-	utxnStore, err := newNurseryStore(activeNetParams.GenesisHash, chanDB)
-	if err != nil {
-		srvrLog.Errorf("unable to create nursery store: %v", err)
-		return nil, err
-	}
-
 	srvrLog.Tracef("Sweeper batch window duration: %v",
 		sweep.DefaultBatchWindowDuration)
 
-	sweeperStore, err := sweep.NewSweeperStore(
-		chanDB, activeNetParams.GenesisHash,
-	)
+	sweeperStore, err := sweep.NewSweeperStore(chanDB)
 	if err != nil {
 		srvrLog.Errorf("unable to create sweeper store: %v", err)
 		return nil, err
@@ -722,24 +798,15 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		NewBatchTimer: func() <-chan time.Time {
 			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
 		},
-		SweepTxConfTarget:    6,
-		Notifier:             cc.chainNotifier,
-		ChainIO:              cc.chainIO,
-		Store:                sweeperStore,
-		MaxInputsPerTx:       sweep.DefaultMaxInputsPerTx,
-		MaxSweepAttempts:     sweep.DefaultMaxSweepAttempts,
-		NextAttemptDeltaFunc: sweep.DefaultNextAttemptDeltaFunc,
-	})
-
-	s.utxoNursery = newUtxoNursery(&NurseryConfig{
-		ChainIO:             cc.chainIO,
-		ConfDepth:           1,
-		FetchClosedChannels: chanDB.FetchClosedChannels,
-		FetchClosedChannel:  chanDB.FetchClosedChannel,
-		Notifier:            cc.chainNotifier,
-		PublishTransaction:  cc.wallet.PublishTransaction,
-		Store:               utxnStore,
-		SweepInput:          s.sweeper.SweepInput,
+		SweepTxConfTarget:     6,
+		Notifier:              cc.chainNotifier,
+		ChainIO:               cc.chainIO,
+		Store:                 sweeperStore,
+		MaxInputsPerTx:        sweep.DefaultMaxInputsPerTx,
+		MaxSweepAttempts:      sweep.DefaultMaxSweepAttempts,
+		NextAttemptDeltaFunc:  sweep.DefaultNextAttemptDeltaFunc,
+		MaxFeeRate:            sweep.DefaultMaxFeeRate,
+		DeadlineClusterWindow: sweep.DefaultDeadlineClusterWindow,
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -747,7 +814,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
@@ -771,28 +838,6 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			}
 			return nil
 		},
-		IncubateOutputs: func(chanPoint wire.OutPoint,
-			commitRes *lnwallet.CommitOutputResolution,
-			outHtlcRes *lnwallet.OutgoingHtlcResolution,
-			inHtlcRes *lnwallet.IncomingHtlcResolution,
-			broadcastHeight uint32) error {
-
-			var (
-				inRes  []lnwallet.IncomingHtlcResolution
-				outRes []lnwallet.OutgoingHtlcResolution
-			)
-			if inHtlcRes != nil {
-				inRes = append(inRes, *inHtlcRes)
-			}
-			if outHtlcRes != nil {
-				outRes = append(outRes, *outHtlcRes)
-			}
-
-			return s.utxoNursery.IncubateOutputs(
-				chanPoint, commitRes, outRes, inRes,
-				broadcastHeight,
-			)
-		},
 		PreimageDB:   s.witnessBeacon,
 		Notifier:     cc.chainNotifier,
 		Signer:       cc.wallet.Cfg.Signer,
@@ -845,6 +890,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ContractBreaches:   contractBreaches,
 		Signer:             cc.wallet.Cfg.Signer,
 		Store:              newRetributionStore(chanDB),
+		MaxFeeRate: lnwallet.SatPerKVByte(
+			cfg.MaxJusticeFeeRate * 1000,
+		).FeePerKWeight(),
 	})
 
 	// Select the configuration and furnding parameters for Bitcoin or
@@ -863,8 +911,42 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	if _, err := rand.Read(chanIDSeed[:]); err != nil {
 		return nil, err
 	}
+
+	// If the user configured a default upfront shutdown address, then
+	// we'll convert it into the script we'll commit to for all new
+	// channels with peers that support the feature.
+	var upfrontShutdownScript lnwire.DeliveryAddress
+	if cfg.UpfrontShutdownAddr != "" {
+		upfrontShutdownScript, err = parseDeliveryAddress(
+			cfg.UpfrontShutdownAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upfront shutdown "+
+				"address: %v", err)
+		}
+	}
+
+	// Parse the set of peers we trust to open zero-conf channels with us,
+	// as they could double spend the funding transaction before it
+	// confirms.
+	zeroConfPeers := make(map[route.Vertex]struct{})
+	for _, peer := range cfg.ZeroConfPeers {
+		pubKeyBytes, err := hex.DecodeString(peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zero-conf peer %v: %v",
+				peer, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid zero-conf peer %v: %v",
+				peer, err)
+		}
+
+		zeroConfPeers[route.NewVertex(pubKey)] = struct{}{}
+	}
+
 	s.fundingMgr, err = newFundingManager(fundingConfig{
-		IDKey:              privKey.PubKey(),
+		IDKey:              nodeKeyECDH.PubKey(),
 		Wallet:             cc.wallet,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		Notifier:           cc.chainNotifier,
@@ -872,7 +954,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		SignMessage: func(pubKey *btcec.PublicKey,
 			msg []byte) (*btcec.Signature, error) {
 
-			if pubKey.IsEqual(privKey.PubKey()) {
+			if pubKey.IsEqual(nodeKeyECDH.PubKey()) {
 				return s.nodeSigner.SignMessage(pubKey, msg)
 			}
 
@@ -885,7 +967,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			optionalFields ...discovery.OptionalMsgField) chan error {
 
 			return s.authGossiper.ProcessLocalAnnouncement(
-				msg, privKey.PubKey(), optionalFields...,
+				msg, nodeKeyECDH.PubKey(), optionalFields...,
 			)
 		},
 		NotifyWhenOnline: s.NotifyWhenOnline,
@@ -991,6 +1073,21 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		AcceptZeroConf: func(peer *btcec.PublicKey) bool {
+			_, ok := zeroConfPeers[route.NewVertex(peer)]
+			return ok
+		},
+		DualFundContribution: func(_ *btcec.PublicKey,
+			remoteAmt btcutil.Amount) btcutil.Amount {
+
+			// We'll match the initiator's contribution, up to our
+			// configured maximum.
+			maxAmt := btcutil.Amount(cfg.DualFundMaxContribution)
+			if remoteAmt > maxAmt {
+				return maxAmt
+			}
+			return remoteAmt
+		},
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -1022,6 +1119,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ReservationTimeout:     10 * time.Minute,
 		MinChanSize:            btcutil.Amount(cfg.MinChanSize),
 		NotifyOpenChannelEvent: s.channelNotifier.NotifyOpenChannelEvent,
+		UpfrontShutdownScript:  upfrontShutdownScript,
 	})
 	if err != nil {
 		return nil, err
@@ -1053,7 +1151,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		OnAccept:       s.InboundPeerConnected,
 		RetryDuration:  time.Second * 5,
 		TargetOutbound: 100,
-		Dial:           noiseDial(s.identityPriv),
+		Dial:           noiseDial(s.identityECDH),
 		OnConnection:   s.OutboundPeerConnected,
 	})
 	if err != nil {
@@ -1125,10 +1223,6 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
-		if err := s.utxoNursery.Start(); err != nil {
-			startErr = err
-			return
-		}
 		if err := s.chainArb.Start(); err != nil {
 			startErr = err
 			return
@@ -1259,7 +1353,6 @@ func (s *server) Stop() error {
 		s.chanRouter.Stop()
 		s.htlcSwitch.Stop()
 		s.sphinx.Stop()
-		s.utxoNursery.Stop()
 		s.breachArbiter.Stop()
 		s.authGossiper.Stop()
 		s.chainArb.Stop()
@@ -1789,7 +1882,10 @@ func (s *server) initTorController() error {
 		Color:        newNodeAnn.RGBColor,
 		AuthSigBytes: newNodeAnn.Signature.ToSignatureBytes(),
 	}
-	copy(selfNode.PubKeyBytes[:], s.identityPriv.PubKey().SerializeCompressed())
+	copy(
+		selfNode.PubKeyBytes[:],
+		s.identityECDH.PubKey().SerializeCompressed(),
+	)
 	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
 		return fmt.Errorf("can't set self node: %v", err)
 	}
@@ -1831,7 +1927,7 @@ func (s *server) genNodeAnnouncement(refresh bool,
 	// signature over the announcement to ensure nodes on the network
 	// accepted the new authenticated announcement.
 	sig, err := discovery.SignAnnouncement(
-		s.nodeSigner, s.identityPriv.PubKey(), s.currentNodeAnn,
+		s.nodeSigner, s.identityECDH.PubKey(), s.currentNodeAnn,
 	)
 	if err != nil {
 		return lnwire.NodeAnnouncement{}, err
@@ -1886,9 +1982,9 @@ func (s *server) establishPersistentConnections() error {
 
 	// TODO(roasbeef): instead iterate over link nodes and query graph for
 	// each of the nodes.
-	selfPub := s.identityPriv.PubKey().SerializeCompressed()
+	selfPub := s.identityECDH.PubKey().SerializeCompressed()
 	err = sourceNode.ForEachChannel(nil, func(
-		tx *bbolt.Tx,
+		tx kvdb.Tx,
 		chanInfo *channeldb.ChannelEdgeInfo,
 		policy, _ *channeldb.ChannelEdgePolicy) error {
 
@@ -2285,6 +2381,31 @@ func (s *server) InboundPeerConnected(conn net.Conn) {
 	nodePub := conn.(*brontide.Conn).RemotePub()
 	pubStr := string(nodePub.SerializeCompressed())
 
+	// Before proceeding, make sure the peer is allowed to connect to us.
+	// If inbound connections are restricted, peers we have channels with
+	// are always accepted, such that they can reestablish them.
+	restricted := cfg.PeerAccess.RestrictInbound
+	var hasChannels bool
+	if restricted {
+		channels, err := s.chanDB.FetchOpenChannels(nodePub)
+		if err != nil {
+			srvrLog.Errorf("Unable to fetch channels with %x: %v",
+				nodePub.SerializeCompressed(), err)
+			conn.Close()
+			return
+		}
+		hasChannels = len(channels) > 0
+	}
+	err := s.peerAccess.CheckPeer(
+		nodePub, conn.RemoteAddr(), restricted, hasChannels,
+	)
+	if err != nil {
+		srvrLog.Infof("Rejecting inbound connection from %x@%v: %v",
+			nodePub.SerializeCompressed(), conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -2329,7 +2450,7 @@ func (s *server) InboundPeerConnected(conn net.Conn) {
 		// not of the same type of the new connection (inbound), then
 		// we'll close out the new connection s.t there's only a single
 		// connection between us.
-		localPub := s.identityPriv.PubKey()
+		localPub := s.identityECDH.PubKey()
 		if !connectedPeer.inbound &&
 			!shouldDropLocalConnection(localPub, nodePub) {
 
@@ -2440,7 +2561,7 @@ func (s *server) OutboundPeerConnected(connReq *connmgr.ConnReq, conn net.Conn)
 		// not of the same type of the new connection (outbound), then
 		// we'll close out the new connection s.t there's only a single
 		// connection between us.
-		localPub := s.identityPriv.PubKey()
+		localPub := s.identityECDH.PubKey()
 		if connectedPeer.inbound &&
 			shouldDropLocalConnection(localPub, nodePub) {
 
@@ -2531,6 +2652,10 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	addr := conn.RemoteAddr()
 	pubKey := brontideConn.RemotePub()
 
+	// The connection takes the buffers it writes and reads messages with
+	// from the same pools as the read and write workers.
+	brontideConn.SetBufferPools(s.writeBufferPool, s.readBufferPool)
+
 	srvrLog.Infof("Finalizing connection to %x@%s, inbound=%v",
 		pubKey.SerializeCompressed(), addr, inbound)
 
@@ -2548,6 +2673,23 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	// and also that we support the new gossip query features.
 	localFeatures.Set(lnwire.DataLossProtectRequired)
 	localFeatures.Set(lnwire.GossipQueriesOptional)
+	localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+
+	// We also understand zero-conf channels, which are negotiated using an
+	// explicit channel type, and the aliases that are used to identify
+	// them until their funding transaction confirms.
+	localFeatures.Set(lnwire.ChannelTypeOptional)
+	localFeatures.Set(lnwire.ScidAliasOptional)
+	localFeatures.Set(lnwire.ZeroConfOptional)
+
+	// We'll also signal that we're able to construct the funding
+	// transaction of dual funded channels interactively.
+	localFeatures.Set(lnwire.DualFundOptional)
+
+	// Finally, we'll signal that we're able to quiesce our channels in
+	// order to splice them.
+	localFeatures.Set(lnwire.QuiescenceOptional)
+	localFeatures.Set(lnwire.SpliceOptional)
 
 	// Now that we've established a connection, create a peer, and it to the
 	// set of currently active peers. Configure the peer with the incoming
@@ -2882,6 +3024,19 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// shutdownScript is an optional upfront shutdown script for the
+	// channel. If set, then any cooperative close of the channel must pay
+	// out to this script.
+	shutdownScript lnwire.DeliveryAddress
+
+	// zeroConf indicates whether the channel should be usable before its
+	// funding transaction confirms. Zero-conf channels must be private.
+	zeroConf bool
+
+	// dualFund indicates whether the remote peer may contribute funds of
+	// their own to the channel.
+	dualFund bool
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -2964,7 +3119,7 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 // notify the caller if the connection attempt has failed. Otherwise, it will be
 // closed.
 func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error) {
-	conn, err := brontide.Dial(s.identityPriv, addr, cfg.net.Dial)
+	conn, err := brontide.Dial(s.identityECDH, addr, cfg.net.Dial)
 	if err != nil {
 		srvrLog.Errorf("Unable to connect to %v: %v", addr, err)
 		select {
@@ -3064,6 +3219,48 @@ func (s *server) OpenChannel(
 	return req.updates, req.err
 }
 
+// SpliceChannel sends a request to the peer of the target channel to splice
+// funds into or out of it. The txid of the splice transaction is sent over the
+// returned channel once it has been signed by both parties.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SpliceChannel(
+	req *spliceRequest) (chan *chainhash.Hash, chan error) {
+
+	req.txid = make(chan *chainhash.Hash, 1)
+	req.err = make(chan error, 1)
+
+	// First, we'll locate the channel to splice, which must be with a
+	// peer that is currently online.
+	channel, err := s.chanDB.FetchChannel(req.chanPoint)
+	if err != nil {
+		req.err <- err
+		return req.txid, req.err
+	}
+
+	peer, err := s.FindPeer(channel.IdentityPub)
+	if err != nil {
+		req.err <- fmt.Errorf("peer %x is not online",
+			channel.IdentityPub.SerializeCompressed())
+		return req.txid, req.err
+	}
+
+	// If the fee rate wasn't specified, then we'll use a default
+	// confirmation target. Requests to abandon a splice don't need one.
+	if req.feeRate == 0 && req.abandonTxid == nil {
+		feeRate, err := s.cc.feeEstimator.EstimateFeePerKW(6)
+		if err != nil {
+			req.err <- err
+			return req.txid, req.err
+		}
+		req.feeRate = feeRate
+	}
+
+	peer.HandleLocalSpliceReq(req)
+
+	return req.txid, req.err
+}
+
 // Peers returns a slice of all active peers.
 //
 // NOTE: This function is safe for concurrent access.
@@ -3079,6 +3276,12 @@ func (s *server) Peers() []*peer {
 	return peers
 }
 
+// sphinxReplayLogPath returns the path of the sphinx router's persistent replay
+// log, which is kept in the local directory of the channel database.
+func sphinxReplayLogPath(chanDB *channeldb.DB) string {
+	return filepath.Join(chanDB.Path(), "sphinxreplay.db")
+}
+
 // parseHexColor takes a hex string representation of a color in the
 // form "#RRGGBB", parses the hex color values, and returns a color.RGBA
 // struct of the same color.
@@ -3146,7 +3349,7 @@ func (s *server) fetchNodeAdvertisedAddr(pub *btcec.PublicKey) (net.Addr, error)
 func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	*lnwire.ChannelUpdate, error) {
 
-	ourPubKey := s.identityPriv.PubKey().SerializeCompressed()
+	ourPubKey := s.identityECDH.PubKey().SerializeCompressed()
 	return func(cid lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
 		info, edge1, edge2, err := s.chanRouter.GetChannelByID(cid)
 		if err != nil {
@@ -3162,7 +3365,7 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 // applyChannelUpdate applies the channel update to the different sub-systems of
 // the server.
 func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
-	pubKey := s.identityPriv.PubKey()
+	pubKey := s.identityECDH.PubKey()
 	errChan := s.authGossiper.ProcessLocalAnnouncement(update, pubKey)
 	select {
 	case err := <-errChan:
@@ -3171,3 +3374,16 @@ func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
 		return ErrServerShuttingDown
 	}
 }
+
+// newSweepPkScript creates a new public key script which should be used to
+// sweep any time-locked, or contested channel funds into the wallet.
+// Specifically, the script generated is a version 0, pay-to-witness-pubkey-hash
+// (p2wkh) output.
+func newSweepPkScript(wallet lnwallet.WalletController) ([]byte, error) {
+	sweepAddr, err := wallet.NewAddress(lnwallet.WitnessPubKey, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToAddrScript(sweepAddr)
+}
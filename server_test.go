@@ -2,7 +2,15 @@
 
 package lnd
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+)
 
 func TestParseHexColor(t *testing.T) {
 	var colorTestCases = []struct {
@@ -41,3 +49,30 @@ func TestParseHexColor(t *testing.T) {
 		}
 	}
 }
+
+// TestSphinxReplayLogPath asserts that the sphinx replay log is kept within
+// the graph directory, when the channel database is created from an already
+// opened backend.
+func TestSphinxReplayLogPath(t *testing.T) {
+	graphDir, err := ioutil.TempDir("", "graph")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(graphDir)
+
+	backend, err := kvdb.OpenBolt(filepath.Join(graphDir, "channel.db"))
+	if err != nil {
+		t.Fatalf("unable to open backend: %v", err)
+	}
+	chanDB, err := channeldb.CreateWithBackend(backend, graphDir)
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+	defer chanDB.Close()
+
+	expectedPath := filepath.Join(graphDir, "sphinxreplay.db")
+	if path := sphinxReplayLogPath(chanDB); path != expectedPath {
+		t.Fatalf("expected replay log at %v, got %v", expectedPath,
+			path)
+	}
+}
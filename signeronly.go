@@ -0,0 +1,188 @@
+package lnd
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/lncfg"
+	"github.com/wakiyamap/lnd/lnrpc"
+	"github.com/wakiyamap/lnd/macaroons"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// signerOnlySubServers are the names of the sub-servers that are served by an
+// lnd running in signer-only mode. Together, they make up the interface a
+// watch-only node delegates all operations requiring private keys to.
+var signerOnlySubServers = []string{"SignRPC", "WalletKitRPC"}
+
+// signerRPCServer is the gRPC server of an lnd running in signer-only mode.
+// Unlike the rpcServer, it only serves the sign and wallet kit sub-servers,
+// which are backed by the wallet of the chain control, so neither the
+// peer-to-peer nor the channel subsystems of the server need to be started.
+type signerRPCServer struct {
+	started  int32 // To be used atomically.
+	shutdown int32 // To be used atomically.
+
+	// subServers are the sub-servers that make up the remote signer
+	// interface.
+	subServers []lnrpc.SubServer
+
+	// grpcServer is the gRPC server that all the sub-servers register
+	// themselves with.
+	grpcServer *grpc.Server
+
+	// listenerCleanUp are a set of closures functions that will allow this
+	// RPC server to clean up all the listening socket created for the
+	// server.
+	listenerCleanUp []func()
+}
+
+// newSignerRPCServer creates a new signerRPCServer, serving the signing and
+// wallet operations of the passed chain control. If lnd was built without the
+// signrpc or walletrpc sub-servers, an error is returned.
+func newSignerRPCServer(cc *chainControl, chanDB *channeldb.DB,
+	macService *macaroons.Service, statelessInit bool,
+	subServerCgs *subRPCServerConfigs,
+	serverOpts []grpc.ServerOption) (*signerRPCServer, error) {
+
+	subServerMacService := subServerMacaroonService(
+		macService, statelessInit,
+	)
+
+	// Only the sign and wallet kit sub-servers will be created, so their
+	// dependencies are the only ones we need to provide. The remaining
+	// sub-server configs are populated with the zero values of the
+	// subsystems that aren't started in this mode.
+	err := subServerCgs.PopulateDependencies(
+		cc, networkDir, subServerMacService, nil, nil, nil,
+		activeNetParams.Params, activeNetParams.CoinType, nil, nil,
+		nil, chanDB, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	drivers := make(map[string]*lnrpc.SubServerDriver)
+	for _, driver := range lnrpc.RegisteredSubServers() {
+		drivers[driver.SubServerName] = driver
+	}
+
+	var (
+		subServers  []lnrpc.SubServer
+		permissions = make(map[string][]bakery.Op)
+	)
+	for _, name := range signerOnlySubServers {
+		driver, ok := drivers[name]
+		if !ok {
+			return nil, fmt.Errorf("signer-only mode requires the "+
+				"%v sub-server, lnd must be built with the "+
+				"signrpc and walletrpc tags", name)
+		}
+
+		subServer, macPerms, err := driver.New(subServerCgs)
+		if err != nil {
+			return nil, err
+		}
+		subServers = append(subServers, subServer)
+
+		for method, ops := range macPerms {
+			permissions[method] = ops
+		}
+	}
+
+	// If macaroons aren't disabled, then all requests must be
+	// authenticated with a macaroon granting the permissions of the
+	// sub-servers.
+	if macService != nil {
+		unaryInterceptor := grpc.UnaryInterceptor(
+			macService.UnaryServerInterceptor(permissions),
+		)
+		streamInterceptor := grpc.StreamInterceptor(
+			macService.StreamServerInterceptor(permissions),
+		)
+
+		serverOpts = append(serverOpts,
+			unaryInterceptor, streamInterceptor,
+		)
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	for _, subServer := range subServers {
+		err := subServer.RegisterWithRootServer(grpcServer)
+		if err != nil {
+			return nil, fmt.Errorf("unable to register "+
+				"sub-server %v with root: %v",
+				subServer.Name(), err)
+		}
+	}
+
+	return &signerRPCServer{
+		subServers: subServers,
+		grpcServer: grpcServer,
+	}, nil
+}
+
+// Start starts the sub-servers, and listens for requests on the RPC listeners.
+func (s *signerRPCServer) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return nil
+	}
+
+	for _, subServer := range s.subServers {
+		rpcsLog.Debugf("Starting sub RPC server: %v", subServer.Name())
+
+		if err := subServer.Start(); err != nil {
+			return err
+		}
+	}
+
+	for _, listener := range cfg.RPCListeners {
+		lis, err := lncfg.ListenOnAddress(listener)
+		if err != nil {
+			ltndLog.Errorf(
+				"RPC server unable to listen on %s", listener,
+			)
+			return err
+		}
+
+		s.listenerCleanUp = append(s.listenerCleanUp, func() {
+			lis.Close()
+		})
+
+		go func() {
+			rpcsLog.Infof("Signer RPC server listening on %s",
+				lis.Addr())
+			s.grpcServer.Serve(lis)
+		}()
+	}
+
+	return nil
+}
+
+// Stop stops the sub-servers, and closes all listening sockets.
+func (s *signerRPCServer) Stop() error {
+	if atomic.AddInt32(&s.shutdown, 1) != 1 {
+		return nil
+	}
+
+	rpcsLog.Infof("Stopping Signer RPC Server")
+
+	for _, subServer := range s.subServers {
+		rpcsLog.Infof("Stopping %v Sub-RPC Server",
+			subServer.Name())
+
+		if err := subServer.Stop(); err != nil {
+			rpcsLog.Errorf("unable to stop sub-server %v: %v",
+				subServer.Name(), err)
+			continue
+		}
+	}
+
+	for _, cleanUp := range s.listenerCleanUp {
+		cleanUp()
+	}
+
+	return nil
+}
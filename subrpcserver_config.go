@@ -14,10 +14,12 @@ import (
 	"github.com/wakiyamap/lnd/lnrpc/invoicesrpc"
 	"github.com/wakiyamap/lnd/lnrpc/routerrpc"
 	"github.com/wakiyamap/lnd/lnrpc/signrpc"
+	"github.com/wakiyamap/lnd/lnrpc/swaprpc"
 	"github.com/wakiyamap/lnd/lnrpc/walletrpc"
 	"github.com/wakiyamap/lnd/macaroons"
 	"github.com/wakiyamap/lnd/netann"
 	"github.com/wakiyamap/lnd/routing"
+	"github.com/wakiyamap/lnd/sweep"
 )
 
 // subRPCServerConfigs is special sub-config in the main configuration that
@@ -55,6 +57,11 @@ type subRPCServerConfigs struct {
 	// payment related queries such as requests for estimates of off-chain
 	// fees.
 	RouterRPC *routerrpc.Config `group:"routerrpc" namespace:"routerrpc"`
+
+	// SwapRPC is a sub-RPC server that exposes a submarine swap client,
+	// moving funds between our channels and the on-chain wallet through a
+	// remote swap server.
+	SwapRPC *swaprpc.Config `group:"swaprpc" namespace:"swaprpc"`
 }
 
 // PopulateDependencies attempts to iterate through all the sub-server configs
@@ -68,11 +75,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	atpl *autopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry,
 	htlcSwitch *htlcswitch.Switch,
-	activeNetParams *chaincfg.Params,
+	activeNetParams *chaincfg.Params, coinType uint32,
 	chanRouter *routing.ChannelRouter,
 	routerBackend *routerrpc.RouterBackend,
 	nodeSigner *netann.NodeSigner,
-	chanDB *channeldb.DB) error {
+	chanDB *channeldb.DB,
+	sweeper *sweep.UtxoSweeper) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -110,6 +118,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Signer").Set(
 				reflect.ValueOf(cc.signer),
 			)
+			subCfgValue.FieldByName("KeyRing").Set(
+				reflect.ValueOf(cc.keyRing),
+			)
+			subCfgValue.FieldByName("MessageSigner").Set(
+				reflect.ValueOf(cc.msgSigner),
+			)
 
 		case *walletrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -129,6 +143,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("KeyRing").Set(
 				reflect.ValueOf(cc.keyRing),
 			)
+			subCfgValue.FieldByName("ChainParams").Set(
+				reflect.ValueOf(activeNetParams),
+			)
+			subCfgValue.FieldByName("CoinType").Set(
+				reflect.ValueOf(coinType),
+			)
 
 		case *autopilotrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -200,10 +220,69 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Router").Set(
 				reflect.ValueOf(chanRouter),
 			)
+			subCfgValue.FieldByName("ChanGraph").Set(
+				reflect.ValueOf(chanDB.ChannelGraph()),
+			)
 			subCfgValue.FieldByName("RouterBackend").Set(
 				reflect.ValueOf(routerBackend),
 			)
 
+		case *swaprpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			subCfgValue.FieldByName("NetworkDir").Set(
+				reflect.ValueOf(networkDir),
+			)
+			subCfgValue.FieldByName("MacService").Set(
+				reflect.ValueOf(macService),
+			)
+			subCfgValue.FieldByName("ChainParams").Set(
+				reflect.ValueOf(activeNetParams),
+			)
+			subCfgValue.FieldByName("ChainNotifier").Set(
+				reflect.ValueOf(cc.chainNotifier),
+			)
+			subCfgValue.FieldByName("ChainIO").Set(
+				reflect.ValueOf(cc.chainIO),
+			)
+			subCfgValue.FieldByName("Wallet").Set(
+				reflect.ValueOf(cc.wallet),
+			)
+			subCfgValue.FieldByName("KeyRing").Set(
+				reflect.ValueOf(cc.keyRing),
+			)
+			subCfgValue.FieldByName("FeeEstimator").Set(
+				reflect.ValueOf(cc.feeEstimator),
+			)
+			subCfgValue.FieldByName("Sweeper").Set(
+				reflect.ValueOf(sweeper),
+			)
+			subCfgValue.FieldByName("Router").Set(
+				reflect.ValueOf(chanRouter),
+			)
+			subCfgValue.FieldByName("InvoiceRegistry").Set(
+				reflect.ValueOf(invoiceRegistry),
+			)
+			subCfgValue.FieldByName("IsChannelActive").Set(
+				reflect.ValueOf(htlcSwitch.HasActiveLink),
+			)
+			subCfgValue.FieldByName("NodeSigner").Set(
+				reflect.ValueOf(nodeSigner),
+			)
+			subCfgValue.FieldByName("MaxPaymentMSat").Set(
+				reflect.ValueOf(maxPaymentMSat),
+			)
+			defaultDelta := cfg.Bitcoin.TimeLockDelta
+			if registeredChains.PrimaryChain() == monacoinChain {
+				defaultDelta = cfg.Monacoin.TimeLockDelta
+			}
+			subCfgValue.FieldByName("DefaultCLTVExpiry").Set(
+				reflect.ValueOf(defaultDelta),
+			)
+			subCfgValue.FieldByName("ChanDB").Set(
+				reflect.ValueOf(chanDB),
+			)
+
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
 				cfg)
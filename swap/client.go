@@ -0,0 +1,352 @@
+package swap
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lntypes"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/sweep"
+)
+
+const (
+	// DefaultHtlcConfTarget is the default confirmation target used to
+	// fund the on-chain HTLC of loop in swaps.
+	DefaultHtlcConfTarget = 6
+
+	// MinCltvDelta is the minimum number of blocks we require between the
+	// current height and the expiry of the on-chain HTLC proposed by the
+	// swap server. This leaves enough time for the HTLC to confirm and
+	// for the receiver to sweep it.
+	MinCltvDelta = 20
+
+	// htlcConfirmations is the number of confirmations the on-chain HTLC
+	// needs before we act upon it.
+	htlcConfirmations = 1
+)
+
+var (
+	// ErrAmountOutOfRange is returned when the requested swap amount is
+	// outside of the range the swap server accepts.
+	ErrAmountOutOfRange = errors.New("swap amount out of range")
+
+	// ErrSwapFeeTooHigh is returned when the swap server charges more than
+	// the maximum swap fee we're willing to pay.
+	ErrSwapFeeTooHigh = errors.New("swap fee too high")
+
+	// ErrExpiryTooSoon is returned when the swap server proposes an HTLC
+	// expiry that doesn't leave enough time to complete the swap.
+	ErrExpiryTooSoon = errors.New("swap htlc expiry too soon")
+
+	// ErrClientShuttingDown is returned when a swap is requested while the
+	// client is shutting down.
+	ErrClientShuttingDown = errors.New("swap client shutting down")
+)
+
+// Config houses the dependencies of the swap client.
+type Config struct {
+	// Store is where all swaps are persisted.
+	Store *Store
+
+	// Server is the swap server we negotiate new swaps with.
+	Server Server
+
+	// ChainParams are the parameters of the chain the swaps are executed
+	// on.
+	ChainParams *chaincfg.Params
+
+	// Notifier is used to watch for the confirmation of on-chain HTLCs,
+	// and to be notified of new blocks.
+	Notifier chainntnfs.ChainNotifier
+
+	// KeyRing is used to derive our keys within the on-chain HTLCs.
+	KeyRing keychain.KeyRing
+
+	// FeeEstimator is used to determine the fee rate of the transaction
+	// funding the on-chain HTLC of loop in swaps.
+	FeeEstimator lnwallet.FeeEstimator
+
+	// BestHeight returns the height of the current best block.
+	BestHeight func() (int32, error)
+
+	// SendPayment pays the given payment request, limiting the routing fee
+	// to maxFee. The call blocks until the payment either succeeded or
+	// failed.
+	SendPayment func(payReq string, maxFee btcutil.Amount) error
+
+	// AddHoldInvoice adds a hold invoice for the given swap hash and
+	// returns its payment request.
+	AddHoldInvoice func(hash lntypes.Hash, amt btcutil.Amount,
+		memo string) (string, error)
+
+	// SubscribeInvoice subscribes to state changes of the invoice with the
+	// given hash. The current state of the invoice is sent immediately.
+	// The returned closure cancels the subscription.
+	SubscribeInvoice func(hash lntypes.Hash) (<-chan *channeldb.Invoice,
+		func(), error)
+
+	// SettleInvoice settles an accepted hold invoice.
+	SettleInvoice func(preimage lntypes.Preimage) error
+
+	// CancelInvoice cancels a hold invoice.
+	CancelInvoice func(hash lntypes.Hash) error
+
+	// FundHtlc creates and signs, without broadcasting, a transaction from
+	// the wallet that funds the given output.
+	FundHtlc func(output *wire.TxOut,
+		feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error)
+
+	// PublishTransaction broadcasts the given transaction.
+	PublishTransaction func(tx *wire.MsgTx) error
+
+	// SweepInput hands an on-chain HTLC to the sweeper, which sweeps it
+	// back into the wallet.
+	SweepInput func(input.Input) (chan sweep.Result, error)
+}
+
+// LoopOutRequest describes a new loop out swap.
+type LoopOutRequest struct {
+	// Amount is the amount to swap out to the wallet.
+	Amount btcutil.Amount
+
+	// MaxSwapFee is the maximum fee we're willing to pay to the server.
+	MaxSwapFee btcutil.Amount
+
+	// MaxPaymentFee is the maximum routing fee of the off-chain payment.
+	MaxPaymentFee btcutil.Amount
+}
+
+// LoopInRequest describes a new loop in swap.
+type LoopInRequest struct {
+	// Amount is the amount to swap in to our channels.
+	Amount btcutil.Amount
+
+	// MaxSwapFee is the maximum fee we're willing to pay to the server.
+	MaxSwapFee btcutil.Amount
+
+	// HtlcConfTarget is the confirmation target used to fund the on-chain
+	// HTLC. If zero, DefaultHtlcConfTarget is used.
+	HtlcConfTarget uint32
+}
+
+// Client executes submarine swaps against a swap server. Swaps are persisted
+// and resumed when the client is restarted.
+type Client struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *Config
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new swap client from the given config.
+func New(cfg *Config) *Client {
+	return &Client{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start resumes all swaps that haven't reached a final state yet.
+func (c *Client) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	swaps, err := c.cfg.Store.FetchSwaps()
+	if err != nil {
+		return err
+	}
+
+	for _, swap := range swaps {
+		if swap.State().IsFinal() {
+			continue
+		}
+
+		log.Infof("Resuming %v swap %v in state %v", swap.Type,
+			swap.Hash(), swap.State())
+
+		c.wg.Add(1)
+		switch swap.Type {
+		case TypeLoopOut:
+			go c.runLoopOut(swap, false)
+
+		case TypeLoopIn:
+			go c.runLoopIn(swap, DefaultHtlcConfTarget)
+
+		default:
+			c.wg.Done()
+			return fmt.Errorf("unknown swap type: %v", swap.Type)
+		}
+	}
+
+	return nil
+}
+
+// Stop signals all active swaps to exit and waits for them to do so. Their
+// progress is persisted, so they'll be resumed on the next start.
+func (c *Client) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	close(c.quit)
+	c.wg.Wait()
+
+	return nil
+}
+
+// ListSwaps returns all swaps known to the client.
+func (c *Client) ListSwaps() ([]*Swap, error) {
+	return c.cfg.Store.FetchSwaps()
+}
+
+// FetchSwap returns the swap with the given hash.
+func (c *Client) FetchSwap(hash lntypes.Hash) (*Swap, error) {
+	return c.cfg.Store.FetchSwap(hash)
+}
+
+// newContract assembles the common part of a new swap contract, generating a
+// fresh preimage and deriving our own key within the on-chain HTLC.
+func (c *Client) newContract(swapType Type, amt,
+	maxSwapFee btcutil.Amount) (*Contract, keychain.KeyDescriptor, error) {
+
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return nil, keychain.KeyDescriptor{}, err
+	}
+
+	keyDesc, err := c.cfg.KeyRing.DeriveNextKey(keychain.KeyFamilySwap)
+	if err != nil {
+		return nil, keychain.KeyDescriptor{}, err
+	}
+
+	height, err := c.cfg.BestHeight()
+	if err != nil {
+		return nil, keychain.KeyDescriptor{}, err
+	}
+
+	return &Contract{
+		Type:             swapType,
+		Preimage:         preimage,
+		Amount:           amt,
+		MaxSwapFee:       maxSwapFee,
+		KeyLocator:       keyDesc.KeyLocator,
+		InitiationHeight: height,
+		InitiationTime:   time.Now(),
+	}, keyDesc, nil
+}
+
+// checkTerms ensures the requested swap amount falls within the server's
+// terms.
+func checkTerms(terms *Terms, amt btcutil.Amount) error {
+	if amt < terms.MinSwapAmount || amt > terms.MaxSwapAmount {
+		return fmt.Errorf("%v: %v not within [%v, %v]",
+			ErrAmountOutOfRange, amt, terms.MinSwapAmount,
+			terms.MaxSwapAmount)
+	}
+
+	return nil
+}
+
+// checkExpiry ensures the HTLC expiry proposed by the server leaves enough
+// time to complete the swap.
+func checkExpiry(c *Contract) error {
+	if c.CltvExpiry-c.InitiationHeight < MinCltvDelta {
+		return fmt.Errorf("%v: expiry %v at height %v",
+			ErrExpiryTooSoon, c.CltvExpiry, c.InitiationHeight)
+	}
+
+	return nil
+}
+
+// launch persists a new swap and starts executing it.
+func (c *Client) launch(contract *Contract, run func(*Swap)) (*Swap, error) {
+	select {
+	case <-c.quit:
+		return nil, ErrClientShuttingDown
+	default:
+	}
+
+	if err := c.cfg.Store.CreateSwap(contract); err != nil {
+		return nil, err
+	}
+
+	swap := &Swap{Contract: *contract}
+	snapshot := swap.snapshot()
+
+	log.Infof("Initiated %v swap %v of %v", contract.Type,
+		contract.Hash(), contract.Amount)
+
+	c.wg.Add(1)
+	go run(swap)
+
+	return snapshot, nil
+}
+
+// swapKit bundles the state shared by the execution of both swap types.
+type swapKit struct {
+	cfg  *Config
+	swap *Swap
+	htlc *Htlc
+}
+
+// newSwapKit creates the swap kit for the given swap.
+func newSwapKit(cfg *Config, swap *Swap) (*swapKit, error) {
+	htlc, err := NewHtlc(&swap.Contract, cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &swapKit{
+		cfg:  cfg,
+		swap: swap,
+		htlc: htlc,
+	}, nil
+}
+
+// updateState persists a state transition of the swap.
+func (k *swapKit) updateState(state State, htlcTxHash *chainhash.Hash) error {
+	event := &Event{
+		State:      state,
+		Time:       time.Now(),
+		HtlcTxHash: htlcTxHash,
+	}
+
+	hash := k.swap.Hash()
+	if err := k.cfg.Store.UpdateSwap(hash, event); err != nil {
+		return err
+	}
+	k.swap.Events = append(k.swap.Events, event)
+
+	log.Infof("%v swap %v transitioned to state %v", k.swap.Type, hash,
+		state)
+
+	return nil
+}
+
+// sweepHtlc hands the on-chain HTLC to the sweeper.
+func (k *swapKit) sweepHtlc(inp input.Input) chan sweep.Result {
+	resultChan, err := k.cfg.SweepInput(inp)
+	if err != nil {
+		log.Errorf("Unable to sweep htlc %v of swap %v: %v",
+			inp.OutPoint(), k.swap.Hash(), err)
+		return nil
+	}
+
+	return resultChan
+}
@@ -0,0 +1,629 @@
+package swap
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/chainntnfs"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lntypes"
+	"github.com/wakiyamap/lnd/lnwallet"
+	"github.com/wakiyamap/lnd/sweep"
+)
+
+const (
+	testHeight = 600
+
+	defaultTestTimeout = 5 * time.Second
+)
+
+var (
+	testParams = &chaincfg.RegressionNetParams
+
+	testTerms = Terms{
+		MinSwapAmount: 10000,
+		MaxSwapAmount: 1000000,
+		SwapFeeBase:   100,
+		SwapFeeRate:   1000,
+		CltvDelta:     40,
+	}
+)
+
+// mockNotifier hands out confirmation and block epoch registrations to the
+// test, which decides when to dispatch them.
+type mockNotifier struct {
+	confRegs  chan *chainntnfs.ConfirmationEvent
+	epochRegs chan chan *chainntnfs.BlockEpoch
+}
+
+func newMockNotifier() *mockNotifier {
+	return &mockNotifier{
+		confRegs:  make(chan *chainntnfs.ConfirmationEvent, 10),
+		epochRegs: make(chan chan *chainntnfs.BlockEpoch, 10),
+	}
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte, numConfs, heightHint uint32) (
+	*chainntnfs.ConfirmationEvent, error) {
+
+	event := chainntnfs.NewConfirmationEvent(numConfs, func() {})
+	m.confRegs <- event
+
+	return event, nil
+}
+
+func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	return &chainntnfs.SpendEvent{
+		Spend:  make(chan *chainntnfs.SpendDetail, 1),
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	epochs := make(chan *chainntnfs.BlockEpoch, 10)
+	m.epochRegs <- epochs
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: epochs,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) Start() error {
+	return nil
+}
+
+func (m *mockNotifier) Stop() error {
+	return nil
+}
+
+// mockKeyRing derives fresh random keys.
+type mockKeyRing struct {
+	mu    sync.Mutex
+	index uint32
+}
+
+func (m *mockKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.index++
+
+	return m.DeriveKey(keychain.KeyLocator{
+		Family: keyFam,
+		Index:  m.index,
+	})
+}
+
+func (m *mockKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     privKey.PubKey(),
+	}, nil
+}
+
+// sweepRequest is an input handed to the mock sweeper along with the channel
+// on which its result is delivered.
+type sweepRequest struct {
+	input  input.Input
+	result chan sweep.Result
+}
+
+// clientTestContext houses a swap client along with its mocked dependencies.
+type clientTestContext struct {
+	t *testing.T
+
+	client   *Client
+	server   *MockServer
+	store    *Store
+	notifier *mockNotifier
+
+	payments       chan string
+	paymentResults chan error
+	invoiceUpdates chan *channeldb.Invoice
+	settled        chan lntypes.Preimage
+	canceled       chan lntypes.Hash
+	published      chan *wire.MsgTx
+	sweeps         chan *sweepRequest
+
+	cleanUp func()
+}
+
+func newClientTestContext(t *testing.T) *clientTestContext {
+	store, _, cleanUpStore := makeTestStore(t)
+
+	bestHeight := func() (int32, error) {
+		return testHeight, nil
+	}
+
+	server, err := NewMockServer(testParams, testTerms, bestHeight)
+	if err != nil {
+		cleanUpStore()
+		t.Fatalf("unable to create mock server: %v", err)
+	}
+
+	ctx := &clientTestContext{
+		t:              t,
+		server:         server,
+		store:          store,
+		notifier:       newMockNotifier(),
+		payments:       make(chan string, 1),
+		paymentResults: make(chan error),
+		invoiceUpdates: make(chan *channeldb.Invoice, 10),
+		settled:        make(chan lntypes.Preimage, 1),
+		canceled:       make(chan lntypes.Hash, 1),
+		published:      make(chan *wire.MsgTx, 1),
+		sweeps:         make(chan *sweepRequest, 1),
+	}
+
+	ctx.client = New(&Config{
+		Store:       store,
+		Server:      server,
+		ChainParams: testParams,
+		Notifier:    ctx.notifier,
+		KeyRing:     &mockKeyRing{},
+		FeeEstimator: lnwallet.NewStaticFeeEstimator(
+			lnwallet.FeePerKwFloor, 0,
+		),
+		BestHeight: bestHeight,
+		SendPayment: func(payReq string, _ btcutil.Amount) error {
+			ctx.payments <- payReq
+			return <-ctx.paymentResults
+		},
+		AddHoldInvoice: func(hash lntypes.Hash, _ btcutil.Amount,
+			_ string) (string, error) {
+
+			return "lnbcrt1holdinvoice", nil
+		},
+		SubscribeInvoice: func(hash lntypes.Hash) (
+			<-chan *channeldb.Invoice, func(), error) {
+
+			return ctx.invoiceUpdates, func() {}, nil
+		},
+		SettleInvoice: func(preimage lntypes.Preimage) error {
+			ctx.settled <- preimage
+			return nil
+		},
+		CancelInvoice: func(hash lntypes.Hash) error {
+			ctx.canceled <- hash
+			return nil
+		},
+		FundHtlc: func(output *wire.TxOut,
+			_ lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+			tx := wire.NewMsgTx(2)
+			tx.AddTxIn(&wire.TxIn{})
+			tx.AddTxOut(output)
+			return tx, nil
+		},
+		PublishTransaction: func(tx *wire.MsgTx) error {
+			ctx.published <- tx
+			return nil
+		},
+		SweepInput: func(inp input.Input) (chan sweep.Result, error) {
+			result := make(chan sweep.Result, 1)
+			ctx.sweeps <- &sweepRequest{
+				input:  inp,
+				result: result,
+			}
+			return result, nil
+		},
+	})
+	if err := ctx.client.Start(); err != nil {
+		cleanUpStore()
+		t.Fatalf("unable to start client: %v", err)
+	}
+
+	ctx.cleanUp = func() {
+		close(ctx.paymentResults)
+		ctx.client.Stop()
+		cleanUpStore()
+	}
+
+	return ctx
+}
+
+// receiveConfRegistration waits for the swap to register for the
+// confirmation of its HTLC.
+func (ctx *clientTestContext) receiveConfRegistration() *chainntnfs.ConfirmationEvent {
+	ctx.t.Helper()
+
+	select {
+	case event := <-ctx.notifier.confRegs:
+		return event
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatalf("no confirmation registration")
+	}
+
+	return nil
+}
+
+// receiveEpochRegistration waits for the swap to register for new blocks.
+func (ctx *clientTestContext) receiveEpochRegistration() chan *chainntnfs.BlockEpoch {
+	ctx.t.Helper()
+
+	select {
+	case epochs := <-ctx.notifier.epochRegs:
+		return epochs
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatalf("no block epoch registration")
+	}
+
+	return nil
+}
+
+// receiveSweep waits for the swap to offer its HTLC to the sweeper.
+func (ctx *clientTestContext) receiveSweep(
+	witnessType input.WitnessType) *sweepRequest {
+
+	ctx.t.Helper()
+
+	select {
+	case req := <-ctx.sweeps:
+		if req.input.WitnessType() != witnessType {
+			ctx.t.Fatalf("expected witness type %v, got %v",
+				witnessType, req.input.WitnessType())
+		}
+		return req
+
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatalf("htlc not swept")
+	}
+
+	return nil
+}
+
+// assertStates waits for the swap with the given hash to reach the expected
+// final state and asserts the states it went through.
+func (ctx *clientTestContext) assertStates(hash lntypes.Hash,
+	states ...State) {
+
+	ctx.t.Helper()
+
+	final := states[len(states)-1]
+
+	var swap *Swap
+	deadline := time.After(defaultTestTimeout)
+	for {
+		var err error
+		swap, err = ctx.store.FetchSwap(hash)
+		if err != nil {
+			ctx.t.Fatalf("unable to fetch swap: %v", err)
+		}
+		if swap.State() == final {
+			break
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			ctx.t.Fatalf("expected state %v, got %v", final,
+				swap.State())
+		}
+	}
+
+	if len(swap.Events) != len(states) {
+		ctx.t.Fatalf("expected %v events, got %v", len(states),
+			len(swap.Events))
+	}
+	for i, event := range swap.Events {
+		if event.State != states[i] {
+			ctx.t.Fatalf("expected state %v at event %v, got %v",
+				states[i], i, event.State)
+		}
+	}
+}
+
+// htlcTx returns a transaction that pays the given value to the on-chain HTLC
+// of the swap.
+func (ctx *clientTestContext) htlcTx(swap *Swap,
+	value btcutil.Amount) *wire.MsgTx {
+
+	ctx.t.Helper()
+
+	htlc, err := NewHtlc(&swap.Contract, testParams)
+	if err != nil {
+		ctx.t.Fatalf("unable to create htlc: %v", err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{
+		PkScript: htlc.PkScript,
+		Value:    int64(value),
+	})
+
+	return tx
+}
+
+// TestLoopOutSuccess tests the happy flow of a loop out swap, in which the
+// server's HTLC is swept using the preimage.
+func TestLoopOutSuccess(t *testing.T) {
+	t.Parallel()
+
+	ctx := newClientTestContext(t)
+	defer ctx.cleanUp()
+
+	const amt = 100000
+	swap, err := ctx.client.LoopOut(context.Background(), &LoopOutRequest{
+		Amount:        amt,
+		MaxSwapFee:    testTerms.SwapFee(amt),
+		MaxPaymentFee: 100,
+	})
+	if err != nil {
+		t.Fatalf("unable to initiate loop out: %v", err)
+	}
+	hash := swap.Hash()
+
+	serverSwap, ok := ctx.server.FetchSwap(hash)
+	if !ok {
+		t.Fatalf("swap unknown to server")
+	}
+	if serverSwap.ClientKey != swap.ReceiverKey {
+		t.Fatalf("server has wrong receiver key")
+	}
+
+	select {
+	case payReq := <-ctx.payments:
+		if payReq != swap.SwapInvoice {
+			t.Fatalf("unexpected payment request %v", payReq)
+		}
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("swap invoice not paid")
+	}
+
+	confEvent := ctx.receiveConfRegistration()
+	ctx.receiveEpochRegistration()
+
+	// Confirm the server's HTLC, which should be swept by revealing the
+	// preimage.
+	confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: testHeight + 1,
+		Tx:          ctx.htlcTx(swap, amt),
+	}
+
+	req := ctx.receiveSweep(input.SwapHtlcSuccess)
+	req.result <- sweep.Result{
+		Tx: wire.NewMsgTx(2),
+	}
+
+	ctx.assertStates(
+		hash, StateHtlcPublished, StatePreimageRevealed, StateSuccess,
+	)
+}
+
+// TestLoopOutInsufficientValue asserts that we don't reveal the preimage if
+// the server's HTLC doesn't lock up the full swap amount.
+func TestLoopOutInsufficientValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := newClientTestContext(t)
+	defer ctx.cleanUp()
+
+	const amt = 100000
+	swap, err := ctx.client.LoopOut(context.Background(), &LoopOutRequest{
+		Amount:     amt,
+		MaxSwapFee: testTerms.SwapFee(amt),
+	})
+	if err != nil {
+		t.Fatalf("unable to initiate loop out: %v", err)
+	}
+
+	confEvent := ctx.receiveConfRegistration()
+	ctx.receiveEpochRegistration()
+
+	confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: testHeight + 1,
+		Tx:          ctx.htlcTx(swap, amt-1),
+	}
+
+	ctx.assertStates(swap.Hash(), StateFailInsufficientValue)
+
+	select {
+	case <-ctx.sweeps:
+		t.Fatalf("htlc unexpectedly swept")
+	default:
+	}
+}
+
+// TestLoopOutHtlcConfirmedTooLate asserts that we don't reveal the preimage
+// if the server's HTLC confirms too close to its expiry to safely sweep it.
+func TestLoopOutHtlcConfirmedTooLate(t *testing.T) {
+	t.Parallel()
+
+	ctx := newClientTestContext(t)
+	defer ctx.cleanUp()
+
+	const amt = 100000
+	swap, err := ctx.client.LoopOut(context.Background(), &LoopOutRequest{
+		Amount:     amt,
+		MaxSwapFee: testTerms.SwapFee(amt),
+	})
+	if err != nil {
+		t.Fatalf("unable to initiate loop out: %v", err)
+	}
+
+	confEvent := ctx.receiveConfRegistration()
+	ctx.receiveEpochRegistration()
+
+	confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: uint32(swap.CltvExpiry - MinCltvDelta + 1),
+		Tx:          ctx.htlcTx(swap, amt),
+	}
+
+	ctx.assertStates(swap.Hash(), StateFailTimeout)
+
+	select {
+	case <-ctx.sweeps:
+		t.Fatalf("htlc unexpectedly swept")
+	default:
+	}
+}
+
+// TestLoopOutSwapFeeTooHigh asserts that a loop out swap is rejected if the
+// server's invoice exceeds our maximum swap fee.
+func TestLoopOutSwapFeeTooHigh(t *testing.T) {
+	t.Parallel()
+
+	ctx := newClientTestContext(t)
+	defer ctx.cleanUp()
+
+	const amt = 100000
+	_, err := ctx.client.LoopOut(context.Background(), &LoopOutRequest{
+		Amount:     amt,
+		MaxSwapFee: testTerms.SwapFee(amt) - 1,
+	})
+	if err == nil {
+		t.Fatalf("expected loop out to be rejected")
+	}
+
+	swaps, err := ctx.client.ListSwaps()
+	if err != nil {
+		t.Fatalf("unable to list swaps: %v", err)
+	}
+	if len(swaps) != 0 {
+		t.Fatalf("expected no swaps, got %v", len(swaps))
+	}
+}
+
+// TestLoopInSuccess tests the happy flow of a loop in swap, in which we
+// settle the server's payment once our HTLC has confirmed.
+func TestLoopInSuccess(t *testing.T) {
+	t.Parallel()
+
+	ctx := newClientTestContext(t)
+	defer ctx.cleanUp()
+
+	const amt = 200000
+	swap, err := ctx.client.LoopIn(context.Background(), &LoopInRequest{
+		Amount:     amt,
+		MaxSwapFee: testTerms.SwapFee(amt),
+	})
+	if err != nil {
+		t.Fatalf("unable to initiate loop in: %v", err)
+	}
+	hash := swap.Hash()
+
+	var htlcTx *wire.MsgTx
+	select {
+	case htlcTx = <-ctx.published:
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("htlc not published")
+	}
+
+	confEvent := ctx.receiveConfRegistration()
+	ctx.receiveEpochRegistration()
+
+	// The server's payment is accepted before the HTLC confirms. We must
+	// not settle it yet.
+	ctx.invoiceUpdates <- &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			State: channeldb.ContractAccepted,
+		},
+	}
+
+	select {
+	case <-ctx.settled:
+		t.Fatalf("invoice settled before htlc confirmation")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: testHeight + 1,
+		Tx:          htlcTx,
+	}
+
+	select {
+	case preimage := <-ctx.settled:
+		if preimage != swap.Preimage {
+			t.Fatalf("settled with wrong preimage")
+		}
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("invoice not settled")
+	}
+
+	ctx.invoiceUpdates <- &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			State: channeldb.ContractSettled,
+		},
+	}
+
+	ctx.assertStates(hash, StateHtlcPublished, StateSuccess)
+}
+
+// TestLoopInTimeout asserts that we cancel our invoice and reclaim the HTLC
+// if the server doesn't pay before the HTLC expires.
+func TestLoopInTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := newClientTestContext(t)
+	defer ctx.cleanUp()
+
+	const amt = 200000
+	swap, err := ctx.client.LoopIn(context.Background(), &LoopInRequest{
+		Amount:     amt,
+		MaxSwapFee: testTerms.SwapFee(amt),
+	})
+	if err != nil {
+		t.Fatalf("unable to initiate loop in: %v", err)
+	}
+	hash := swap.Hash()
+
+	var htlcTx *wire.MsgTx
+	select {
+	case htlcTx = <-ctx.published:
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("htlc not published")
+	}
+
+	confEvent := ctx.receiveConfRegistration()
+	epochs := ctx.receiveEpochRegistration()
+
+	confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: testHeight + 1,
+		Tx:          htlcTx,
+	}
+
+	epochs <- &chainntnfs.BlockEpoch{
+		Height: swap.CltvExpiry,
+	}
+
+	select {
+	case canceledHash := <-ctx.canceled:
+		if canceledHash != hash {
+			t.Fatalf("canceled wrong invoice")
+		}
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("invoice not canceled")
+	}
+
+	req := ctx.receiveSweep(input.SwapHtlcTimeout)
+	req.result <- sweep.Result{
+		Tx: wire.NewMsgTx(2),
+	}
+
+	ctx.assertStates(hash, StateHtlcPublished, StateFailTimeout)
+}
@@ -0,0 +1,130 @@
+package swap
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+)
+
+var (
+	// ErrHtlcOutputNotFound is returned when a transaction doesn't contain
+	// the on-chain HTLC of a swap.
+	ErrHtlcOutputNotFound = errors.New("htlc output not found")
+)
+
+// Htlc describes the on-chain HTLC of a swap.
+type Htlc struct {
+	// WitnessScript is the script that needs to be satisfied in order to
+	// spend the HTLC.
+	WitnessScript []byte
+
+	// PkScript is the P2WSH output script of the HTLC.
+	PkScript []byte
+
+	// Address is the address that the HTLC is paid to.
+	Address btcutil.Address
+}
+
+// NewHtlc derives the on-chain HTLC of the given swap contract.
+func NewHtlc(c *Contract, params *chaincfg.Params) (*Htlc, error) {
+	senderKey, err := btcec.ParsePubKey(c.SenderKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	receiverKey, err := btcec.ParsePubKey(c.ReceiverKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	hash := c.Hash()
+	witnessScript, err := input.SwapHtlcScript(
+		uint32(c.CltvExpiry), senderKey, receiverKey, hash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := input.WitnessScriptHash(witnessScript)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptHash := sha256.Sum256(witnessScript)
+	address, err := btcutil.NewAddressWitnessScriptHash(
+		scriptHash[:], params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Htlc{
+		WitnessScript: witnessScript,
+		PkScript:      pkScript,
+		Address:       address,
+	}, nil
+}
+
+// findOutput locates the HTLC output within the given transaction.
+func (h *Htlc) findOutput(tx *wire.MsgTx) (*wire.OutPoint, *wire.TxOut,
+	error) {
+
+	found, index := input.FindScriptOutputIndex(tx, h.PkScript)
+	if !found {
+		return nil, nil, ErrHtlcOutputNotFound
+	}
+
+	outpoint := &wire.OutPoint{
+		Hash:  tx.TxHash(),
+		Index: index,
+	}
+
+	return outpoint, tx.TxOut[index], nil
+}
+
+// signDesc returns the sign descriptor we'll use to spend the HTLC output
+// using our own key.
+func (h *Htlc) signDesc(c *Contract, output *wire.TxOut) (
+	*input.SignDescriptor, error) {
+
+	ourKey := c.ReceiverKey
+	if c.Type == TypeLoopIn {
+		ourKey = c.SenderKey
+	}
+
+	pubKey, err := btcec.ParsePubKey(ourKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return &input.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: c.KeyLocator,
+			PubKey:     pubKey,
+		},
+		WitnessScript: h.WitnessScript,
+		Output:        output,
+		HashType:      txscript.SigHashAll,
+	}, nil
+}
+
+// spentWithPreimage returns true if the given transaction spends the HTLC
+// outpoint through its success clause, revealing the swap preimage.
+func spentWithPreimage(tx *wire.MsgTx, outpoint *wire.OutPoint) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint != *outpoint {
+			continue
+		}
+
+		witness := txIn.Witness
+		return len(witness) == 3 && len(witness[1]) == 32
+	}
+
+	return false
+}
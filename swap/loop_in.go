@@ -0,0 +1,332 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/sweep"
+)
+
+// LoopInTerms returns the terms under which the swap server executes loop in
+// swaps.
+func (c *Client) LoopInTerms(ctx context.Context) (*Terms, error) {
+	return c.cfg.Server.LoopInTerms(ctx)
+}
+
+// LoopIn initiates a new loop in swap. The returned swap is a snapshot of its
+// initial state, while the swap itself continues to execute in the
+// background.
+func (c *Client) LoopIn(ctx context.Context, req *LoopInRequest) (*Swap,
+	error) {
+
+	terms, err := c.cfg.Server.LoopInTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTerms(terms, req.Amount); err != nil {
+		return nil, err
+	}
+
+	swapFee := terms.SwapFee(req.Amount)
+	if swapFee > req.MaxSwapFee {
+		return nil, fmt.Errorf("%v: %v exceeds maximum of %v",
+			ErrSwapFeeTooHigh, swapFee, req.MaxSwapFee)
+	}
+
+	contract, keyDesc, err := c.newContract(
+		TypeLoopIn, req.Amount, req.MaxSwapFee,
+	)
+	if err != nil {
+		return nil, err
+	}
+	copy(contract.SenderKey[:], keyDesc.PubKey.SerializeCompressed())
+
+	// The server pays us the swapped amount minus its fee off-chain. We
+	// only settle that payment once it has been accepted, which in turn
+	// allows the server to claim the on-chain HTLC.
+	hash := contract.Hash()
+	contract.SwapInvoice, err = c.cfg.AddHoldInvoice(
+		hash, req.Amount-swapFee, fmt.Sprintf("loop in %v", hash),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.cfg.Server.NewLoopInSwap(
+		ctx, hash, req.Amount, contract.SenderKey, contract.SwapInvoice,
+	)
+	if err != nil {
+		c.cancelInvoice(contract)
+		return nil, err
+	}
+	contract.ReceiverKey = resp.ReceiverKey
+	contract.CltvExpiry = resp.CltvExpiry
+
+	if err := checkExpiry(contract); err != nil {
+		c.cancelInvoice(contract)
+		return nil, err
+	}
+
+	confTarget := req.HtlcConfTarget
+	if confTarget == 0 {
+		confTarget = DefaultHtlcConfTarget
+	}
+
+	return c.launch(contract, func(swap *Swap) {
+		c.runLoopIn(swap, confTarget)
+	})
+}
+
+// cancelInvoice cancels the hold invoice of a loop in swap that didn't get off
+// the ground.
+func (c *Client) cancelInvoice(contract *Contract) {
+	hash := contract.Hash()
+	if err := c.cfg.CancelInvoice(hash); err != nil {
+		log.Errorf("Unable to cancel invoice of swap %v: %v", hash, err)
+	}
+}
+
+// runLoopIn executes a loop in swap until it reaches a final state or the
+// client shuts down.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) runLoopIn(swap *Swap, confTarget uint32) {
+	defer c.wg.Done()
+
+	k, err := newSwapKit(c.cfg, swap)
+	if err != nil {
+		log.Errorf("Unable to resume loop in swap %v: %v",
+			swap.Hash(), err)
+		return
+	}
+
+	if err := k.executeLoopIn(confTarget, c.quit); err != nil {
+		log.Errorf("Loop in swap %v failed: %v", swap.Hash(), err)
+	}
+}
+
+// publishHtlc funds the on-chain HTLC of a loop in swap from the wallet and
+// broadcasts it.
+func (k *swapKit) publishHtlc(confTarget uint32) error {
+	feeRate, err := k.cfg.FeeEstimator.EstimateFeePerKW(confTarget)
+	if err != nil {
+		return err
+	}
+
+	tx, err := k.cfg.FundHtlc(&wire.TxOut{
+		PkScript: k.htlc.PkScript,
+		Value:    int64(k.swap.Amount),
+	}, feeRate)
+	if err != nil {
+		return err
+	}
+
+	// Persist the HTLC transaction before broadcasting it, so we never
+	// lose track of funds locked into the HTLC.
+	txHash := tx.TxHash()
+	if err := k.updateState(StateHtlcPublished, &txHash); err != nil {
+		return err
+	}
+
+	// A failed broadcast isn't fatal, as we'll reclaim our inputs through
+	// the HTLC timeout path should it confirm after all.
+	if err := k.cfg.PublishTransaction(tx); err != nil {
+		log.Errorf("Unable to publish htlc %v of swap %v: %v", txHash,
+			k.swap.Hash(), err)
+	}
+
+	return nil
+}
+
+// executeLoopIn publishes the on-chain HTLC, settles the server's off-chain
+// payment once the HTLC has confirmed, and reclaims the HTLC should the server
+// fail to pay before it expires.
+func (k *swapKit) executeLoopIn(confTarget uint32, quit chan struct{}) error {
+	hash := k.swap.Hash()
+
+	if k.swap.State() == StateInitiated {
+		if err := k.publishHtlc(confTarget); err != nil {
+			log.Errorf("Unable to fund htlc of swap %v: %v", hash,
+				err)
+
+			if err := k.cfg.CancelInvoice(hash); err != nil {
+				log.Errorf("Unable to cancel invoice of swap "+
+					"%v: %v", hash, err)
+			}
+
+			return k.updateState(StateFailHtlcPublication, nil)
+		}
+	}
+
+	invoiceUpdates, cancelSubscription, err := k.cfg.SubscribeInvoice(hash)
+	if err != nil {
+		return err
+	}
+	defer cancelSubscription()
+
+	confNtfn, err := k.cfg.Notifier.RegisterConfirmationsNtfn(
+		k.swap.HtlcTxHash(), k.htlc.PkScript, htlcConfirmations,
+		uint32(k.swap.InitiationHeight),
+	)
+	if err != nil {
+		return err
+	}
+	defer confNtfn.Cancel()
+
+	blockEpochs, err := k.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
+
+	var (
+		htlcOutpoint *wire.OutPoint
+		htlcOutput   *wire.TxOut
+		htlcHeight   uint32
+		accepted     bool
+		canceled     bool
+		expired      bool
+		sweepInput   input.Input
+		sweepResult  chan sweep.Result
+	)
+
+	// settle settles the hold invoice once the server's payment has been
+	// accepted and the HTLC it is going to claim has confirmed.
+	settle := func() {
+		if !accepted || htlcOutpoint == nil || canceled {
+			return
+		}
+
+		if err := k.cfg.SettleInvoice(k.swap.Preimage); err != nil {
+			log.Errorf("Unable to settle invoice of swap %v: %v",
+				hash, err)
+		}
+	}
+
+	// reclaim offers the HTLC to the sweeper through its timeout path once
+	// it has both confirmed and expired.
+	reclaim := func() error {
+		if !expired || htlcOutpoint == nil || sweepResult != nil {
+			return nil
+		}
+
+		if sweepInput == nil {
+			signDesc, err := k.htlc.signDesc(
+				&k.swap.Contract, htlcOutput,
+			)
+			if err != nil {
+				return err
+			}
+
+			sweepInput = input.NewBaseInput(
+				htlcOutpoint, input.SwapHtlcTimeout, signDesc,
+				htlcHeight,
+			)
+		}
+
+		sweepResult = k.sweepHtlc(sweepInput)
+		return nil
+	}
+
+	for {
+		select {
+		case conf, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return errors.New("htlc confirmation " +
+					"notification cancelled")
+			}
+
+			htlcOutpoint, htlcOutput, err = k.htlc.findOutput(conf.Tx)
+			if err != nil {
+				return err
+			}
+			htlcHeight = conf.BlockHeight
+
+			log.Infof("Htlc %v of swap %v confirmed at height %v",
+				htlcOutpoint, hash, htlcHeight)
+
+			settle()
+			if err := reclaim(); err != nil {
+				return err
+			}
+
+		case invoice, ok := <-invoiceUpdates:
+			if !ok {
+				return errors.New("invoice subscription " +
+					"cancelled")
+			}
+
+			switch invoice.Terms.State {
+			case channeldb.ContractAccepted:
+				accepted = true
+				settle()
+
+			case channeldb.ContractSettled:
+				return k.updateState(StateSuccess, nil)
+
+			case channeldb.ContractCanceled:
+				canceled = true
+			}
+
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return errors.New("block epoch notification " +
+					"cancelled")
+			}
+
+			if epoch.Height < k.swap.CltvExpiry {
+				continue
+			}
+
+			// The HTLC has expired, so the server's payment must no
+			// longer be accepted. Should the invoice have been
+			// settled in the meantime, we'll learn about it
+			// through the subscription.
+			expired = true
+			if !canceled {
+				canceled = true
+				if err := k.cfg.CancelInvoice(hash); err != nil {
+					log.Errorf("Unable to cancel invoice "+
+						"of swap %v: %v", hash, err)
+				}
+			}
+
+			// If the HTLC hasn't confirmed yet, it'll be reclaimed
+			// once it does. Otherwise this re-offers the HTLC if
+			// a previous sweep attempt gave up.
+			if err := reclaim(); err != nil {
+				return err
+			}
+
+		case result := <-sweepResult:
+			sweepResult = nil
+
+			switch {
+			case result.Err == nil:
+				return k.updateState(StateFailTimeout, nil)
+
+			// The server swept the HTLC using the preimage, which
+			// it could only have learned from our settled
+			// invoice.
+			case result.Err == sweep.ErrRemoteSpend &&
+				spentWithPreimage(result.Tx, htlcOutpoint):
+
+				return k.updateState(StateSuccess, nil)
+
+			case result.Err == sweep.ErrRemoteSpend:
+				return k.updateState(StateFailTimeout, nil)
+
+			default:
+				log.Errorf("Unable to sweep htlc of swap %v, "+
+					"retrying: %v", hash, result.Err)
+			}
+
+		case <-quit:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/sweep"
+	"github.com/wakiyamap/lnd/zpay32"
+)
+
+// LoopOutTerms returns the terms under which the swap server executes loop out
+// swaps.
+func (c *Client) LoopOutTerms(ctx context.Context) (*Terms, error) {
+	return c.cfg.Server.LoopOutTerms(ctx)
+}
+
+// LoopOut initiates a new loop out swap. The returned swap is a snapshot of
+// its initial state, while the swap itself continues to execute in the
+// background.
+func (c *Client) LoopOut(ctx context.Context, req *LoopOutRequest) (*Swap,
+	error) {
+
+	terms, err := c.cfg.Server.LoopOutTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTerms(terms, req.Amount); err != nil {
+		return nil, err
+	}
+
+	contract, keyDesc, err := c.newContract(
+		TypeLoopOut, req.Amount, req.MaxSwapFee,
+	)
+	if err != nil {
+		return nil, err
+	}
+	contract.MaxPaymentFee = req.MaxPaymentFee
+	copy(contract.ReceiverKey[:], keyDesc.PubKey.SerializeCompressed())
+
+	resp, err := c.cfg.Server.NewLoopOutSwap(
+		ctx, contract.Hash(), req.Amount, contract.ReceiverKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	contract.SenderKey = resp.SenderKey
+	contract.CltvExpiry = resp.CltvExpiry
+	contract.SwapInvoice = resp.SwapInvoice
+
+	if err := c.checkSwapInvoice(contract); err != nil {
+		return nil, err
+	}
+	if err := checkExpiry(contract); err != nil {
+		return nil, err
+	}
+
+	return c.launch(contract, func(swap *Swap) {
+		c.runLoopOut(swap, true)
+	})
+}
+
+// checkSwapInvoice ensures the invoice of a loop out swap pays to the swap hash
+// and that the server doesn't charge more than our maximum swap fee.
+func (c *Client) checkSwapInvoice(contract *Contract) error {
+	invoice, err := zpay32.Decode(contract.SwapInvoice, c.cfg.ChainParams)
+	if err != nil {
+		return fmt.Errorf("invalid swap invoice: %v", err)
+	}
+
+	hash := contract.Hash()
+	if invoice.PaymentHash == nil || *invoice.PaymentHash != hash {
+		return errors.New("swap invoice hash mismatch")
+	}
+	if invoice.MilliSat == nil {
+		return errors.New("swap invoice without amount")
+	}
+
+	swapFee := invoice.MilliSat.ToSatoshis() - contract.Amount
+	if swapFee > contract.MaxSwapFee {
+		return fmt.Errorf("%v: %v exceeds maximum of %v",
+			ErrSwapFeeTooHigh, swapFee, contract.MaxSwapFee)
+	}
+
+	return nil
+}
+
+// runLoopOut executes a loop out swap until it reaches a final state or the
+// client shuts down. The off-chain payment is only sent if pay is true, as a
+// resumed swap already attempted it before.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) runLoopOut(swap *Swap, pay bool) {
+	defer c.wg.Done()
+
+	k, err := newSwapKit(c.cfg, swap)
+	if err != nil {
+		log.Errorf("Unable to resume loop out swap %v: %v",
+			swap.Hash(), err)
+		return
+	}
+
+	if err := k.executeLoopOut(pay, c.quit); err != nil {
+		log.Errorf("Loop out swap %v failed: %v", swap.Hash(), err)
+	}
+}
+
+// executeLoopOut pays the swap invoice, waits for the server's on-chain HTLC to
+// confirm and sweeps it into the wallet by revealing the preimage.
+func (k *swapKit) executeLoopOut(pay bool, quit chan struct{}) error {
+	hash := k.swap.Hash()
+
+	// The payment is held by the server until we reveal the preimage on
+	// chain, so it only returns once the swap completes. We therefore
+	// dispatch it in the background.
+	var payResult chan error
+	if pay {
+		payResult = make(chan error, 1)
+		go func() {
+			payResult <- k.cfg.SendPayment(
+				k.swap.SwapInvoice, k.swap.MaxPaymentFee,
+			)
+		}()
+	}
+
+	confNtfn, err := k.cfg.Notifier.RegisterConfirmationsNtfn(
+		nil, k.htlc.PkScript, htlcConfirmations,
+		uint32(k.swap.InitiationHeight),
+	)
+	if err != nil {
+		return err
+	}
+	defer confNtfn.Cancel()
+
+	blockEpochs, err := k.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
+
+	var (
+		sweepInput  input.Input
+		sweepResult chan sweep.Result
+	)
+	for {
+		select {
+		case conf, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return errors.New("htlc confirmation " +
+					"notification cancelled")
+			}
+
+			outpoint, output, err := k.htlc.findOutput(conf.Tx)
+			if err != nil {
+				return err
+			}
+
+			// Never reveal the preimage if the server didn't lock
+			// up the agreed upon amount.
+			if btcutil.Amount(output.Value) < k.swap.Amount {
+				log.Warnf("Htlc %v of swap %v has value %v, "+
+					"expected %v", outpoint, hash,
+					btcutil.Amount(output.Value),
+					k.swap.Amount)
+
+				return k.updateState(
+					StateFailInsufficientValue, nil,
+				)
+			}
+
+			// The HTLC must confirm early enough for our sweep to
+			// confirm before the server is able to time it out.
+			// Once the preimage was revealed, sweeping is the only
+			// option left though.
+			if k.swap.State() != StatePreimageRevealed &&
+				!k.sweepable(int32(conf.BlockHeight)) {
+
+				log.Warnf("Htlc %v of swap %v confirmed at "+
+					"height %v, too close to its expiry "+
+					"%v", outpoint, hash, conf.BlockHeight,
+					k.swap.CltvExpiry)
+
+				return k.updateState(StateFailTimeout, nil)
+			}
+
+			if k.swap.State() == StateInitiated {
+				err := k.updateState(
+					StateHtlcPublished, &outpoint.Hash,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Persist that the preimage is about to be revealed
+			// before handing the HTLC to the sweeper.
+			if k.swap.State() != StatePreimageRevealed {
+				err := k.updateState(StatePreimageRevealed, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			signDesc, err := k.htlc.signDesc(&k.swap.Contract, output)
+			if err != nil {
+				return err
+			}
+
+			inp := input.MakeSwapHtlcSuccessInput(
+				outpoint, signDesc, k.swap.Preimage[:],
+				conf.BlockHeight,
+			)
+			inp.SetDeadlineHeight(uint32(k.swap.CltvExpiry))
+
+			sweepInput = &inp
+			sweepResult = k.sweepHtlc(sweepInput)
+
+		case err := <-payResult:
+			payResult = nil
+			if err == nil {
+				log.Debugf("Off-chain payment of swap %v "+
+					"settled", hash)
+				continue
+			}
+
+			// Once the HTLC confirmed we're committed to sweeping
+			// it, regardless of the payment outcome.
+			if k.swap.State() != StateInitiated {
+				log.Warnf("Off-chain payment of swap %v "+
+					"failed after htlc confirmation: %v",
+					hash, err)
+				continue
+			}
+
+			log.Warnf("Off-chain payment of swap %v failed: %v",
+				hash, err)
+
+			return k.updateState(StateFailOffchainPayment, nil)
+
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return errors.New("block epoch notification " +
+					"cancelled")
+			}
+
+			// Without a confirmed HTLC that leaves enough time to
+			// sweep it before its expiry, the server may be able to
+			// time it out, so it's no longer safe to reveal the
+			// preimage. A resumed swap that already revealed it
+			// still waits for the confirmation to sweep though.
+			if sweepInput == nil {
+				if k.swap.State() != StatePreimageRevealed &&
+					!k.sweepable(epoch.Height) {
+
+					return k.updateState(
+						StateFailTimeout, nil,
+					)
+				}
+				continue
+			}
+
+			// Re-offer the HTLC if the previous sweep attempt
+			// gave up.
+			if sweepResult == nil {
+				sweepResult = k.sweepHtlc(sweepInput)
+			}
+
+		case result := <-sweepResult:
+			sweepResult = nil
+
+			switch {
+			case result.Err == nil:
+				return k.updateState(StateSuccess, nil)
+
+			case result.Err == sweep.ErrRemoteSpend:
+				outpoint := sweepInput.OutPoint()
+				if spentWithPreimage(result.Tx, outpoint) {
+					return k.updateState(StateSuccess, nil)
+				}
+
+				return k.updateState(StateFailTimeout, nil)
+
+			default:
+				log.Errorf("Unable to sweep htlc of swap %v, "+
+					"retrying: %v", hash, result.Err)
+			}
+
+		case <-quit:
+			return nil
+		}
+	}
+}
+
+// sweepable returns whether an HTLC confirmed at the given height leaves
+// enough blocks until its expiry to safely sweep it.
+func (k *swapKit) sweepable(height int32) bool {
+	return k.swap.CltvExpiry-height >= MinCltvDelta
+}
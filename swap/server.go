@@ -0,0 +1,86 @@
+package swap
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/lntypes"
+)
+
+// Terms are the conditions under which a swap server is willing to execute
+// swaps.
+type Terms struct {
+	// MinSwapAmount is the minimum amount of a single swap.
+	MinSwapAmount btcutil.Amount
+
+	// MaxSwapAmount is the maximum amount of a single swap.
+	MaxSwapAmount btcutil.Amount
+
+	// SwapFeeBase is the base fee charged for every swap.
+	SwapFeeBase btcutil.Amount
+
+	// SwapFeeRate is the proportional fee charged for every swap,
+	// expressed in parts per million of the swap amount.
+	SwapFeeRate int64
+
+	// CltvDelta is the number of blocks from the current height at which
+	// the server lets the on-chain HTLC of new swaps expire.
+	CltvDelta int32
+}
+
+// SwapFee returns the fee the server charges to swap the given amount.
+func (t *Terms) SwapFee(amt btcutil.Amount) btcutil.Amount {
+	return t.SwapFeeBase + amt*btcutil.Amount(t.SwapFeeRate)/1000000
+}
+
+// NewLoopOutResponse is the reply of the swap server to a new loop out swap.
+type NewLoopOutResponse struct {
+	// SwapInvoice is the invoice we need to pay to the server. Its payment
+	// hash must match the swap hash.
+	SwapInvoice string
+
+	// SenderKey is the server's key that is able to reclaim the on-chain
+	// HTLC after it times out.
+	SenderKey [33]byte
+
+	// CltvExpiry is the absolute height at which the on-chain HTLC times
+	// out.
+	CltvExpiry int32
+}
+
+// NewLoopInResponse is the reply of the swap server to a new loop in swap.
+type NewLoopInResponse struct {
+	// ReceiverKey is the server's key that is able to claim the on-chain
+	// HTLC once it learns the preimage.
+	ReceiverKey [33]byte
+
+	// CltvExpiry is the absolute height at which the on-chain HTLC times
+	// out.
+	CltvExpiry int32
+}
+
+// Server is the interface through which the client negotiates new swaps with
+// a swap server.
+type Server interface {
+	// LoopOutTerms returns the terms under which the server executes loop
+	// out swaps.
+	LoopOutTerms(ctx context.Context) (*Terms, error)
+
+	// NewLoopOutSwap requests a new loop out swap of the given amount.
+	// The server will publish an on-chain HTLC paying to our receiver key
+	// once we've paid the returned invoice.
+	NewLoopOutSwap(ctx context.Context, swapHash lntypes.Hash,
+		amt btcutil.Amount, receiverKey [33]byte) (*NewLoopOutResponse,
+		error)
+
+	// LoopInTerms returns the terms under which the server executes loop
+	// in swaps.
+	LoopInTerms(ctx context.Context) (*Terms, error)
+
+	// NewLoopInSwap requests a new loop in swap of the given amount. The
+	// server will pay our swap invoice once the on-chain HTLC paying to
+	// its receiver key has confirmed.
+	NewLoopInSwap(ctx context.Context, swapHash lntypes.Hash,
+		amt btcutil.Amount, senderKey [33]byte,
+		swapInvoice string) (*NewLoopInResponse, error)
+}
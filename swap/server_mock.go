@@ -0,0 +1,193 @@
+package swap
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/lntypes"
+	"github.com/wakiyamap/lnd/lnwire"
+	"github.com/wakiyamap/lnd/zpay32"
+)
+
+// MockServerSwap is a swap as recorded by the mock swap server.
+type MockServerSwap struct {
+	// Type is the direction of the swap.
+	Type Type
+
+	// Amount is the amount locked into the on-chain HTLC.
+	Amount btcutil.Amount
+
+	// ClientKey is the client's key within the on-chain HTLC.
+	ClientKey [33]byte
+
+	// CltvExpiry is the expiry of the on-chain HTLC.
+	CltvExpiry int32
+
+	// SwapInvoice is the invoice created by the server for loop out swaps,
+	// or the client's invoice for loop in swaps.
+	SwapInvoice string
+}
+
+// MockServer is an in-process swap server that accepts any swap within its
+// terms. It signs real invoices, but leaves the execution of swaps to the
+// caller.
+type MockServer struct {
+	mu sync.Mutex
+
+	params     *chaincfg.Params
+	terms      Terms
+	bestHeight func() (int32, error)
+
+	nodeKey *btcec.PrivateKey
+	swapKey *btcec.PrivateKey
+
+	swaps map[lntypes.Hash]*MockServerSwap
+}
+
+// A compile-time constraint to ensure MockServer implements Server.
+var _ Server = (*MockServer)(nil)
+
+// NewMockServer creates a mock swap server that offers the given terms for
+// both swap types.
+func NewMockServer(params *chaincfg.Params, terms Terms,
+	bestHeight func() (int32, error)) (*MockServer, error) {
+
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	swapKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return &MockServer{
+		params:     params,
+		terms:      terms,
+		bestHeight: bestHeight,
+		nodeKey:    nodeKey,
+		swapKey:    swapKey,
+		swaps:      make(map[lntypes.Hash]*MockServerSwap),
+	}, nil
+}
+
+// LoopOutTerms returns the terms under which the server executes loop out
+// swaps.
+func (m *MockServer) LoopOutTerms(ctx context.Context) (*Terms, error) {
+	terms := m.terms
+	return &terms, nil
+}
+
+// NewLoopOutSwap records a new loop out swap and returns an invoice covering
+// the swap amount plus the swap fee.
+func (m *MockServer) NewLoopOutSwap(ctx context.Context, swapHash lntypes.Hash,
+	amt btcutil.Amount, receiverKey [33]byte) (*NewLoopOutResponse, error) {
+
+	expiry, err := m.cltvExpiry()
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceAmt := lnwire.NewMSatFromSatoshis(amt + m.terms.SwapFee(amt))
+	invoice, err := zpay32.NewInvoice(
+		m.params, swapHash, time.Now(), zpay32.Amount(invoiceAmt),
+		zpay32.Description("swap"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return btcec.SignCompact(
+				btcec.S256(), m.nodeKey, chainhash.HashB(msg),
+				true,
+			)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.addSwap(swapHash, &MockServerSwap{
+		Type:        TypeLoopOut,
+		Amount:      amt,
+		ClientKey:   receiverKey,
+		CltvExpiry:  expiry,
+		SwapInvoice: payReq,
+	})
+
+	resp := &NewLoopOutResponse{
+		SwapInvoice: payReq,
+		CltvExpiry:  expiry,
+	}
+	copy(resp.SenderKey[:], m.swapKey.PubKey().SerializeCompressed())
+
+	return resp, nil
+}
+
+// LoopInTerms returns the terms under which the server executes loop in
+// swaps.
+func (m *MockServer) LoopInTerms(ctx context.Context) (*Terms, error) {
+	terms := m.terms
+	return &terms, nil
+}
+
+// NewLoopInSwap records a new loop in swap.
+func (m *MockServer) NewLoopInSwap(ctx context.Context, swapHash lntypes.Hash,
+	amt btcutil.Amount, senderKey [33]byte,
+	swapInvoice string) (*NewLoopInResponse, error) {
+
+	expiry, err := m.cltvExpiry()
+	if err != nil {
+		return nil, err
+	}
+
+	m.addSwap(swapHash, &MockServerSwap{
+		Type:        TypeLoopIn,
+		Amount:      amt,
+		ClientKey:   senderKey,
+		CltvExpiry:  expiry,
+		SwapInvoice: swapInvoice,
+	})
+
+	resp := &NewLoopInResponse{
+		CltvExpiry: expiry,
+	}
+	copy(resp.ReceiverKey[:], m.swapKey.PubKey().SerializeCompressed())
+
+	return resp, nil
+}
+
+// FetchSwap returns the swap with the given hash as recorded by the server.
+func (m *MockServer) FetchSwap(hash lntypes.Hash) (*MockServerSwap, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	swap, ok := m.swaps[hash]
+	return swap, ok
+}
+
+// cltvExpiry returns the HTLC expiry of a swap initiated at the current
+// height.
+func (m *MockServer) cltvExpiry() (int32, error) {
+	height, err := m.bestHeight()
+	if err != nil {
+		return 0, err
+	}
+
+	return height + m.terms.CltvDelta, nil
+}
+
+// addSwap records a new swap.
+func (m *MockServer) addSwap(hash lntypes.Hash, swap *MockServerSwap) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.swaps[hash] = swap
+}
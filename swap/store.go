@@ -0,0 +1,338 @@
+package swap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lntypes"
+)
+
+var (
+	// swapsBucketKey is the top level bucket that houses all swaps, each
+	// within a sub-bucket keyed by its swap hash.
+	//
+	// maps: swapHash -> swapBucket
+	swapsBucketKey = []byte("swaps")
+
+	// contractKey is the key within a swap bucket under which the
+	// serialized swap contract is stored.
+	contractKey = []byte("contract")
+
+	// eventsBucketKey is the key of the sub-bucket within a swap bucket
+	// that stores all state transitions of the swap.
+	//
+	// maps: sequenceNumber -> serializedEvent
+	eventsBucketKey = []byte("events")
+
+	// byteOrder is the byte order used to serialize integers.
+	byteOrder = binary.BigEndian
+
+	// ErrSwapExists is returned when attempting to create a swap with a
+	// hash that is already known.
+	ErrSwapExists = errors.New("swap already exists")
+
+	// ErrSwapNotFound is returned when a swap can't be found.
+	ErrSwapNotFound = errors.New("swap not found")
+)
+
+// maxInvoiceLength is the maximum length of a swap invoice that we'll read from
+// disk.
+const maxInvoiceLength = 7089
+
+// Store persists swap contracts along with their state transitions.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore returns a swap store backed by the given database, creating the
+// top level bucket if needed.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := db.Update(func(tx kvdb.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(swapsBucketKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// CreateSwap persists a newly initiated swap.
+func (s *Store) CreateSwap(c *Contract) error {
+	var b bytes.Buffer
+	if err := serializeContract(&b, c); err != nil {
+		return err
+	}
+
+	hash := c.Hash()
+
+	return s.db.Update(func(tx kvdb.Tx) error {
+		swaps := tx.Bucket(swapsBucketKey)
+		if swaps == nil {
+			return ErrSwapNotFound
+		}
+
+		if swaps.Bucket(hash[:]) != nil {
+			return ErrSwapExists
+		}
+
+		swapBucket, err := swaps.CreateBucket(hash[:])
+		if err != nil {
+			return err
+		}
+
+		if _, err := swapBucket.CreateBucket(eventsBucketKey); err != nil {
+			return err
+		}
+
+		return swapBucket.Put(contractKey, b.Bytes())
+	})
+}
+
+// UpdateSwap appends a new state transition to the swap with the given hash.
+func (s *Store) UpdateSwap(hash lntypes.Hash, event *Event) error {
+	var b bytes.Buffer
+	if err := serializeEvent(&b, event); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx kvdb.Tx) error {
+		swaps := tx.Bucket(swapsBucketKey)
+		if swaps == nil {
+			return ErrSwapNotFound
+		}
+
+		swapBucket := swaps.Bucket(hash[:])
+		if swapBucket == nil {
+			return ErrSwapNotFound
+		}
+
+		events := swapBucket.Bucket(eventsBucketKey)
+		if events == nil {
+			return ErrSwapNotFound
+		}
+
+		seq, err := events.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var k [8]byte
+		byteOrder.PutUint64(k[:], seq)
+
+		return events.Put(k[:], b.Bytes())
+	})
+}
+
+// FetchSwap returns the swap with the given hash.
+func (s *Store) FetchSwap(hash lntypes.Hash) (*Swap, error) {
+	var swap *Swap
+	err := s.db.View(func(tx kvdb.Tx) error {
+		swaps := tx.Bucket(swapsBucketKey)
+		if swaps == nil {
+			return ErrSwapNotFound
+		}
+
+		swapBucket := swaps.Bucket(hash[:])
+		if swapBucket == nil {
+			return ErrSwapNotFound
+		}
+
+		var err error
+		swap, err = fetchSwap(swapBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
+// FetchSwaps returns all swaps known to the store.
+func (s *Store) FetchSwaps() ([]*Swap, error) {
+	var swaps []*Swap
+	err := s.db.View(func(tx kvdb.Tx) error {
+		swapsBucket := tx.Bucket(swapsBucketKey)
+		if swapsBucket == nil {
+			return nil
+		}
+
+		return swapsBucket.ForEach(func(k, _ []byte) error {
+			swapBucket := swapsBucket.Bucket(k)
+			if swapBucket == nil {
+				return nil
+			}
+
+			swap, err := fetchSwap(swapBucket)
+			if err != nil {
+				return err
+			}
+
+			swaps = append(swaps, swap)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swaps, nil
+}
+
+// fetchSwap reads the contract and all state transitions from a swap bucket.
+func fetchSwap(swapBucket kvdb.Bucket) (*Swap, error) {
+	contractBytes := swapBucket.Get(contractKey)
+	if contractBytes == nil {
+		return nil, ErrSwapNotFound
+	}
+
+	swap := &Swap{}
+	err := deserializeContract(
+		bytes.NewReader(contractBytes), &swap.Contract,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := swapBucket.Bucket(eventsBucketKey)
+	if events == nil {
+		return swap, nil
+	}
+
+	err = events.ForEach(func(_, v []byte) error {
+		event, err := deserializeEvent(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+
+		swap.Events = append(swap.Events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
+// serializeContract writes the swap contract to the given writer.
+func serializeContract(w io.Writer, c *Contract) error {
+	err := writeElements(
+		w, uint8(c.Type), c.Preimage, int64(c.Amount),
+		int64(c.MaxSwapFee), int64(c.MaxPaymentFee), c.SenderKey,
+		c.ReceiverKey, uint32(c.KeyLocator.Family),
+		c.KeyLocator.Index, c.CltvExpiry, c.InitiationHeight,
+		c.InitiationTime.UnixNano(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return wire.WriteVarString(w, 0, c.SwapInvoice)
+}
+
+// deserializeContract reads a swap contract from the given reader.
+func deserializeContract(r io.Reader, c *Contract) error {
+	var (
+		swapType                       uint8
+		amt, maxSwapFee, maxPaymentFee int64
+		keyFamily                      uint32
+		initiationTime                 int64
+	)
+	err := readElements(
+		r, &swapType, &c.Preimage, &amt, &maxSwapFee, &maxPaymentFee,
+		&c.SenderKey, &c.ReceiverKey, &keyFamily, &c.KeyLocator.Index,
+		&c.CltvExpiry, &c.InitiationHeight, &initiationTime,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.Type = Type(swapType)
+	c.Amount = btcutil.Amount(amt)
+	c.MaxSwapFee = btcutil.Amount(maxSwapFee)
+	c.MaxPaymentFee = btcutil.Amount(maxPaymentFee)
+	c.KeyLocator.Family = keychain.KeyFamily(keyFamily)
+	c.InitiationTime = time.Unix(0, initiationTime)
+
+	invoice, err := wire.ReadVarBytes(
+		r, 0, maxInvoiceLength, "swap invoice",
+	)
+	if err != nil {
+		return err
+	}
+	c.SwapInvoice = string(invoice)
+
+	return nil
+}
+
+// serializeEvent writes a swap state transition to the given writer.
+func serializeEvent(w io.Writer, e *Event) error {
+	var htlcTxHash chainhash.Hash
+	if e.HtlcTxHash != nil {
+		htlcTxHash = *e.HtlcTxHash
+	}
+
+	return writeElements(
+		w, uint8(e.State), e.Time.UnixNano(), e.HtlcTxHash != nil,
+		htlcTxHash,
+	)
+}
+
+// deserializeEvent reads a swap state transition from the given reader.
+func deserializeEvent(r io.Reader) (*Event, error) {
+	var (
+		state      uint8
+		eventTime  int64
+		hasTxHash  bool
+		htlcTxHash chainhash.Hash
+	)
+	err := readElements(r, &state, &eventTime, &hasTxHash, &htlcTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		State: State(state),
+		Time:  time.Unix(0, eventTime),
+	}
+	if hasTxHash {
+		event.HtlcTxHash = &htlcTxHash
+	}
+
+	return event, nil
+}
+
+// writeElements writes each of the fixed size elements to the given writer.
+func writeElements(w io.Writer, elements ...interface{}) error {
+	for _, element := range elements {
+		if err := binary.Write(w, byteOrder, element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readElements reads each of the fixed size elements from the given reader.
+func readElements(r io.Reader, elements ...interface{}) error {
+	for _, element := range elements {
+		if err := binary.Read(r, byteOrder, element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
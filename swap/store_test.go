@@ -0,0 +1,148 @@
+package swap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
+	"github.com/wakiyamap/lnd/keychain"
+)
+
+// makeTestStore opens a swap store within a temporary directory. The returned
+// closure cleans up all resources.
+func makeTestStore(t *testing.T) (*Store, string, func()) {
+	t.Helper()
+
+	tempDir, err := ioutil.TempDir("", "swapstore")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	db, err := kvdb.OpenBolt(filepath.Join(tempDir, "swap.db"))
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	store, err := NewStore(db)
+	if err != nil {
+		db.Close()
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create store: %v", err)
+	}
+
+	return store, tempDir, func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// TestStore asserts that swaps along with their state transitions survive a
+// round trip through the store, including a reopen of the database.
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	store, tempDir, cleanUp := makeTestStore(t)
+	defer cleanUp()
+
+	contract := &Contract{
+		Type:          TypeLoopIn,
+		Amount:        500000,
+		MaxSwapFee:    1000,
+		MaxPaymentFee: 200,
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilySwap,
+			Index:  7,
+		},
+		CltvExpiry:       700,
+		SwapInvoice:      "lnbcrt1invoice",
+		InitiationHeight: 600,
+		InitiationTime:   time.Unix(0, 1234567890),
+	}
+	contract.Preimage[0] = 1
+	contract.SenderKey[0] = 2
+	contract.ReceiverKey[0] = 3
+
+	if err := store.CreateSwap(contract); err != nil {
+		t.Fatalf("unable to create swap: %v", err)
+	}
+	if err := store.CreateSwap(contract); err != ErrSwapExists {
+		t.Fatalf("expected ErrSwapExists, got %v", err)
+	}
+
+	hash := contract.Hash()
+	txHash := chainhash.Hash{9}
+	events := []*Event{
+		{
+			State:      StateHtlcPublished,
+			Time:       time.Unix(0, 1234567891),
+			HtlcTxHash: &txHash,
+		},
+		{
+			State: StateSuccess,
+			Time:  time.Unix(0, 1234567892),
+		},
+	}
+	for _, event := range events {
+		if err := store.UpdateSwap(hash, event); err != nil {
+			t.Fatalf("unable to update swap: %v", err)
+		}
+	}
+
+	var unknownHash [32]byte
+	err := store.UpdateSwap(unknownHash, events[0])
+	if err != ErrSwapNotFound {
+		t.Fatalf("expected ErrSwapNotFound, got %v", err)
+	}
+	if _, err := store.FetchSwap(unknownHash); err != ErrSwapNotFound {
+		t.Fatalf("expected ErrSwapNotFound, got %v", err)
+	}
+
+	expected := &Swap{
+		Contract: *contract,
+		Events:   events,
+	}
+	assertSwap := func(store *Store) {
+		t.Helper()
+
+		swap, err := store.FetchSwap(hash)
+		if err != nil {
+			t.Fatalf("unable to fetch swap: %v", err)
+		}
+		if !reflect.DeepEqual(swap, expected) {
+			t.Fatalf("swap mismatch: expected %v, got %v",
+				spew.Sdump(expected), spew.Sdump(swap))
+		}
+
+		swaps, err := store.FetchSwaps()
+		if err != nil {
+			t.Fatalf("unable to fetch swaps: %v", err)
+		}
+		if len(swaps) != 1 || !reflect.DeepEqual(swaps[0], expected) {
+			t.Fatalf("unexpected swaps: %v", spew.Sdump(swaps))
+		}
+	}
+	assertSwap(store)
+
+	// Reopen the database and assert the swap is still intact.
+	if err := store.db.Close(); err != nil {
+		t.Fatalf("unable to close db: %v", err)
+	}
+	db, err := kvdb.OpenBolt(filepath.Join(tempDir, "swap.db"))
+	if err != nil {
+		t.Fatalf("unable to reopen db: %v", err)
+	}
+	store, err = NewStore(db)
+	if err != nil {
+		t.Fatalf("unable to create store: %v", err)
+	}
+	defer db.Close()
+
+	assertSwap(store)
+}
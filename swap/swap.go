@@ -0,0 +1,244 @@
+package swap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lntypes"
+)
+
+// Type denotes the direction in which a swap moves funds.
+type Type uint8
+
+const (
+	// TypeLoopOut is a swap that moves off-chain funds into the on-chain
+	// wallet. We pay the swap server's invoice off-chain, and in return
+	// claim the on-chain HTLC it publishes by revealing the preimage.
+	TypeLoopOut Type = 0
+
+	// TypeLoopIn is a swap that moves on-chain funds into our channels.
+	// We publish an on-chain HTLC paying to the swap server, which can
+	// only be claimed once we've settled the server's off-chain payment
+	// to our hold invoice.
+	TypeLoopIn Type = 1
+)
+
+// String returns a human readable version of the swap type.
+func (t Type) String() string {
+	switch t {
+	case TypeLoopOut:
+		return "LoopOut"
+
+	case TypeLoopIn:
+		return "LoopIn"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(t))
+	}
+}
+
+// State is the state of a swap as it progresses through its lifecycle.
+type State uint8
+
+const (
+	// StateInitiated is the initial state of a swap. The contract has been
+	// agreed upon with the swap server, but the on-chain HTLC hasn't been
+	// published yet.
+	StateInitiated State = 0
+
+	// StateHtlcPublished indicates that the on-chain HTLC of the swap has
+	// been published. For loop out swaps, this is only reached once the
+	// HTLC confirms, as the server is the one publishing it.
+	StateHtlcPublished State = 1
+
+	// StatePreimageRevealed indicates that we've attempted to sweep the
+	// on-chain HTLC of a loop out swap, which reveals the preimage to the
+	// swap server. From here on, the swap can no longer be abandoned.
+	StatePreimageRevealed State = 2
+
+	// StateSuccess is the final state of a swap that completed
+	// successfully.
+	StateSuccess State = 3
+
+	// StateFailOffchainPayment is the final state of a loop out swap for
+	// which the off-chain payment to the server failed.
+	StateFailOffchainPayment State = 4
+
+	// StateFailTimeout is the final state of a swap which reached, or
+	// came too close to, the expiry of its on-chain HTLC without
+	// completing.
+	StateFailTimeout State = 5
+
+	// StateFailInsufficientValue is the final state of a loop out swap for
+	// which the server published an HTLC of a lower value than agreed
+	// upon. We won't reveal the preimage in this case.
+	StateFailInsufficientValue State = 6
+
+	// StateFailHtlcPublication is the final state of a loop in swap for
+	// which we were unable to fund the on-chain HTLC.
+	StateFailHtlcPublication State = 7
+)
+
+// String returns a human readable version of the swap state.
+func (s State) String() string {
+	switch s {
+	case StateInitiated:
+		return "Initiated"
+
+	case StateHtlcPublished:
+		return "HtlcPublished"
+
+	case StatePreimageRevealed:
+		return "PreimageRevealed"
+
+	case StateSuccess:
+		return "Success"
+
+	case StateFailOffchainPayment:
+		return "FailOffchainPayment"
+
+	case StateFailTimeout:
+		return "FailTimeout"
+
+	case StateFailInsufficientValue:
+		return "FailInsufficientValue"
+
+	case StateFailHtlcPublication:
+		return "FailHtlcPublication"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(s))
+	}
+}
+
+// IsFinal returns true if the swap can no longer progress from this state.
+func (s State) IsFinal() bool {
+	switch s {
+	case StateInitiated, StateHtlcPublished, StatePreimageRevealed:
+		return false
+
+	default:
+		return true
+	}
+}
+
+// Contract houses the immutable terms of a swap that were agreed upon with
+// the swap server when the swap was initiated.
+type Contract struct {
+	// Type is the direction of the swap.
+	Type Type
+
+	// Preimage is the secret that unlocks both the off-chain payment and
+	// the on-chain HTLC of the swap. We always generate it ourselves.
+	Preimage lntypes.Preimage
+
+	// Amount is the value that is locked into the on-chain HTLC.
+	Amount btcutil.Amount
+
+	// MaxSwapFee is the maximum fee we agreed to pay to the swap server.
+	MaxSwapFee btcutil.Amount
+
+	// MaxPaymentFee is the maximum routing fee we're willing to pay for
+	// the off-chain payment of a loop out swap.
+	MaxPaymentFee btcutil.Amount
+
+	// SenderKey is the key that is able to reclaim the on-chain HTLC once
+	// it has timed out.
+	SenderKey [33]byte
+
+	// ReceiverKey is the key that is able to claim the on-chain HTLC using
+	// the preimage.
+	ReceiverKey [33]byte
+
+	// KeyLocator locates our own key within the HTLC, which is the
+	// receiver key for loop out swaps and the sender key for loop in
+	// swaps.
+	KeyLocator keychain.KeyLocator
+
+	// CltvExpiry is the absolute height at which the on-chain HTLC times
+	// out.
+	CltvExpiry int32
+
+	// SwapInvoice is the off-chain leg of the swap. For loop out swaps this
+	// is the server's invoice we pay, while for loop in swaps it is our
+	// hold invoice that the server pays.
+	SwapInvoice string
+
+	// InitiationHeight is the best block height at the time the swap was
+	// initiated.
+	InitiationHeight int32
+
+	// InitiationTime is the time at which the swap was initiated.
+	InitiationTime time.Time
+}
+
+// Hash returns the swap hash, which uniquely identifies the swap.
+func (c *Contract) Hash() lntypes.Hash {
+	return c.Preimage.Hash()
+}
+
+// Event is a single state transition of a swap.
+type Event struct {
+	// State is the state the swap transitioned into.
+	State State
+
+	// Time is the time at which the transition happened.
+	Time time.Time
+
+	// HtlcTxHash is the hash of the transaction containing the on-chain
+	// HTLC, if it became known with this transition.
+	HtlcTxHash *chainhash.Hash
+}
+
+// Swap is a swap contract along with all state transitions it went through.
+type Swap struct {
+	Contract
+
+	// Events is the ordered list of state transitions of the swap.
+	Events []*Event
+}
+
+// State returns the current state of the swap.
+func (s *Swap) State() State {
+	if len(s.Events) == 0 {
+		return StateInitiated
+	}
+
+	return s.Events[len(s.Events)-1].State
+}
+
+// LastUpdateTime returns the time of the latest state transition of the swap.
+func (s *Swap) LastUpdateTime() time.Time {
+	if len(s.Events) == 0 {
+		return s.InitiationTime
+	}
+
+	return s.Events[len(s.Events)-1].Time
+}
+
+// HtlcTxHash returns the hash of the transaction containing the on-chain HTLC
+// of the swap, or nil if it isn't known yet.
+func (s *Swap) HtlcTxHash() *chainhash.Hash {
+	for i := len(s.Events) - 1; i >= 0; i-- {
+		if s.Events[i].HtlcTxHash != nil {
+			return s.Events[i].HtlcTxHash
+		}
+	}
+
+	return nil
+}
+
+// snapshot returns a copy of the swap that can safely be handed out while the
+// original continues to be updated.
+func (s *Swap) snapshot() *Swap {
+	events := make([]*Event, len(s.Events))
+	copy(events, s.Events)
+
+	return &Swap{
+		Contract: s.Contract,
+		Events:   events,
+	}
+}
@@ -0,0 +1,160 @@
+package sweep
+
+import (
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/wakiyamap/lnd/lnwallet"
+)
+
+var (
+	// DefaultMaxFeeRate is the default maximum fee rate that the sweeper
+	// is willing to pay to sweep inputs that are about to miss their
+	// deadline. It corresponds to 100 sat/vbyte.
+	DefaultMaxFeeRate = lnwallet.SatPerKVByte(100 * 1000).FeePerKWeight()
+
+	// DefaultDeadlineClusterWindow is the default number of blocks that
+	// the deadlines of inputs may differ while still being swept in the
+	// same cluster.
+	DefaultDeadlineClusterWindow int32 = 6
+)
+
+// inputCluster is a group of pending inputs that share a sweep fee rate.
+// Inputs without a deadline are all grouped in a single cluster swept at the
+// estimated fee rate. Inputs with a deadline are clustered with inputs that
+// have a similar deadline, and are swept at a fee rate that increases as the
+// deadline nears.
+type inputCluster struct {
+	// deadlineHeight is the earliest deadline of all inputs in the
+	// cluster. It is zero for the cluster of inputs without a deadline.
+	deadlineHeight int32
+
+	// sweepFeeRate is the fee rate at which the inputs of the cluster
+	// are swept.
+	sweepFeeRate lnwallet.SatPerKWeight
+
+	// inputs are the pending inputs that are part of the cluster.
+	inputs map[wire.OutPoint]*pendingInput
+}
+
+// deadlineFeeRate is the fee function used for inputs with a deadline. It
+// linearly increases the fee rate from the current estimated fee rate at the
+// height the input was offered, to the maximum fee rate one block before the
+// deadline. The last block before the deadline is the final opportunity for
+// the sweep tx to confirm in time, so from that height on the full budget is
+// used.
+func deadlineFeeRate(startHeight, deadlineHeight, currentHeight int32,
+	minFeeRate, maxFeeRate lnwallet.SatPerKWeight) lnwallet.SatPerKWeight {
+
+	// If the current fee rate already exceeds our budget, there is no
+	// point in bidding any lower than that.
+	if minFeeRate >= maxFeeRate {
+		return minFeeRate
+	}
+
+	lastHeight := deadlineHeight - 1
+	if currentHeight >= lastHeight || startHeight >= lastHeight {
+		return maxFeeRate
+	}
+
+	if currentHeight <= startHeight {
+		return minFeeRate
+	}
+
+	elapsed := lnwallet.SatPerKWeight(currentHeight - startHeight)
+	total := lnwallet.SatPerKWeight(lastHeight - startHeight)
+
+	return minFeeRate + (maxFeeRate-minFeeRate)*elapsed/total
+}
+
+// clusterInputs groups all pending inputs that may be published at the
+// current height into clusters. Inputs without a deadline end up in a single
+// cluster that is swept at the passed fee rate. Inputs with a deadline are
+// grouped with inputs that have a deadline within the configured window of
+// each other. Each of those clusters is swept at the highest fee rate that the
+// fee function yields for any of its inputs.
+func (s *UtxoSweeper) clusterInputs(currentHeight int32,
+	feeRate lnwallet.SatPerKWeight) []inputCluster {
+
+	noDeadline := inputCluster{
+		sweepFeeRate: feeRate,
+		inputs:       make(map[wire.OutPoint]*pendingInput),
+	}
+
+	var deadlineInputs []*pendingInput
+	for op, pi := range s.pendingInputs {
+		// Skip inputs that have a minimum publish height that is not
+		// yet reached.
+		if pi.minPublishHeight > currentHeight {
+			continue
+		}
+
+		deadline, ok := pi.input.DeadlineHeight()
+		if !ok {
+			noDeadline.inputs[op] = pi
+			continue
+		}
+
+		if currentHeight >= int32(deadline) {
+			log.Warnf("Input %v missed its deadline at height %v "+
+				"(current height %v)", op, deadline,
+				currentHeight)
+		}
+
+		deadlineInputs = append(deadlineInputs, pi)
+	}
+
+	// Sort the inputs with a deadline so that the most urgent inputs come
+	// first.
+	sort.Slice(deadlineInputs, func(i, j int) bool {
+		di, _ := deadlineInputs[i].input.DeadlineHeight()
+		dj, _ := deadlineInputs[j].input.DeadlineHeight()
+		return di < dj
+	})
+
+	var clusters []inputCluster
+	if len(noDeadline.inputs) > 0 {
+		clusters = append(clusters, noDeadline)
+	}
+
+	for len(deadlineInputs) > 0 {
+		first, _ := deadlineInputs[0].input.DeadlineHeight()
+
+		cluster := inputCluster{
+			deadlineHeight: int32(first),
+			sweepFeeRate:   feeRate,
+			inputs:         make(map[wire.OutPoint]*pendingInput),
+		}
+
+		// Add all inputs that have a deadline within the window of
+		// the most urgent deadline in this cluster. The cluster fee
+		// rate is determined by the input that needs it the most.
+		var count int
+		for _, pi := range deadlineInputs {
+			deadline, _ := pi.input.DeadlineHeight()
+			if int32(deadline) > int32(first)+s.cfg.DeadlineClusterWindow {
+				break
+			}
+
+			inputFeeRate := deadlineFeeRate(
+				pi.startHeight, int32(deadline), currentHeight,
+				feeRate, s.cfg.MaxFeeRate,
+			)
+			if inputFeeRate > cluster.sweepFeeRate {
+				cluster.sweepFeeRate = inputFeeRate
+			}
+
+			cluster.inputs[*pi.input.OutPoint()] = pi
+			count++
+		}
+
+		log.Debugf("Deadline cluster at height=%v: deadline=%v, "+
+			"num_inputs=%v, fee_rate=%v", currentHeight,
+			cluster.deadlineHeight, count, cluster.sweepFeeRate)
+
+		clusters = append(clusters, cluster)
+		deadlineInputs = deadlineInputs[count:]
+	}
+
+	return clusters
+}
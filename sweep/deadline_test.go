@@ -0,0 +1,87 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/wakiyamap/lnd/lnwallet"
+)
+
+// TestDeadlineFeeRate tests the fee function that is used for inputs with a
+// deadline.
+func TestDeadlineFeeRate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minFeeRate = lnwallet.SatPerKWeight(1000)
+		maxFeeRate = lnwallet.SatPerKWeight(5000)
+	)
+
+	tests := []struct {
+		name           string
+		startHeight    int32
+		deadlineHeight int32
+		currentHeight  int32
+		minFeeRate     lnwallet.SatPerKWeight
+		expected       lnwallet.SatPerKWeight
+	}{
+		{
+			name:           "start of fee function",
+			startHeight:    100,
+			deadlineHeight: 105,
+			currentHeight:  100,
+			minFeeRate:     minFeeRate,
+			expected:       minFeeRate,
+		},
+		{
+			name:           "half way",
+			startHeight:    100,
+			deadlineHeight: 105,
+			currentHeight:  102,
+			minFeeRate:     minFeeRate,
+			expected:       3000,
+		},
+		{
+			name:           "last block before deadline",
+			startHeight:    100,
+			deadlineHeight: 105,
+			currentHeight:  104,
+			minFeeRate:     minFeeRate,
+			expected:       maxFeeRate,
+		},
+		{
+			name:           "deadline passed",
+			startHeight:    100,
+			deadlineHeight: 105,
+			currentHeight:  110,
+			minFeeRate:     minFeeRate,
+			expected:       maxFeeRate,
+		},
+		{
+			name:           "offered after deadline",
+			startHeight:    110,
+			deadlineHeight: 105,
+			currentHeight:  110,
+			minFeeRate:     minFeeRate,
+			expected:       maxFeeRate,
+		},
+		{
+			name:           "estimate exceeds budget",
+			startHeight:    100,
+			deadlineHeight: 105,
+			currentHeight:  104,
+			minFeeRate:     6000,
+			expected:       6000,
+		},
+	}
+
+	for _, test := range tests {
+		feeRate := deadlineFeeRate(
+			test.startHeight, test.deadlineHeight,
+			test.currentHeight, test.minFeeRate, maxFeeRate,
+		)
+		if feeRate != test.expected {
+			t.Fatalf("%v: expected fee rate %v, got %v",
+				test.name, test.expected, feeRate)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package sweep
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/lnwallet"
+)
+
+var (
+	// byteOrder is the byte order of the integers within the serialized
+	// nursery outputs.
+	byteOrder = binary.BigEndian
+
+	// nurseryCribPrefix is the state prefix of nursery outputs of which
+	// the presigned htlc timeout txn still needs to be broadcast once the
+	// HTLC expires.
+	nurseryCribPrefix = []byte("crib")
+
+	// nurseryPsclPrefix is the state prefix of nursery outputs of which
+	// the commitment or htlc success txn hasn't confirmed yet.
+	nurseryPsclPrefix = []byte("pscl")
+
+	// nurseryKndrPrefix is the state prefix of nursery outputs of which
+	// the txn has confirmed, and which are waiting for their time lock to
+	// expire.
+	nurseryKndrPrefix = []byte("kndr")
+
+	// nurseryStatePrefixLen is the length of the state prefixes of the
+	// nursery outputs.
+	nurseryStatePrefixLen = 4
+)
+
+// NurseryOutput is a time locked output that was still incubating in the
+// utxo nursery when its state was migrated to the sweeper. The sweeper
+// resumes its incubation, and sweeps it back into the wallet once it has
+// matured.
+type NurseryOutput struct {
+	// OutPoint is the time locked output to be swept.
+	OutPoint wire.OutPoint
+
+	// OriginChanPoint is the channel point of the channel the output
+	// originates from.
+	OriginChanPoint wire.OutPoint
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// WitnessType is the witness type used to sweep the output.
+	WitnessType input.WitnessType
+
+	// SignDesc is the sign descriptor used to sweep the output.
+	SignDesc input.SignDescriptor
+
+	// BlocksToMaturity is the relative time lock of the output, that
+	// expires once this number of blocks has been built on top of the
+	// confirmation of the output.
+	BlocksToMaturity uint32
+
+	// AbsoluteMaturity is the absolute height at which the output can be
+	// swept. It is only set for outgoing HTLCs on the commitment
+	// transaction of the remote party.
+	AbsoluteMaturity uint32
+
+	// ConfHeight is the height at which the output confirmed. It is zero
+	// as long as the output hasn't confirmed.
+	ConfHeight uint32
+
+	// TimeoutTx is the presigned htlc timeout txn that creates the output
+	// once it's broadcast after Expiry. It is nil if the output doesn't
+	// stem from an outgoing HTLC on our commitment transaction, or if the
+	// timeout txn has already confirmed.
+	TimeoutTx *wire.MsgTx
+
+	// Expiry is the height at which TimeoutTx can be broadcast.
+	Expiry uint32
+
+	// HeightHint is the height from which to watch for the confirmation
+	// of the output.
+	HeightHint uint32
+
+	// key is the key the output is stored under within the sweeper store.
+	key []byte
+}
+
+// decodeNurseryOutput decodes a nursery output from its key and serialized
+// form within the sweeper store. The key consists of the state prefix of the
+// output within the nursery, followed by its outpoint.
+func decodeNurseryOutput(k, v []byte) (*NurseryOutput, error) {
+	if len(k) < nurseryStatePrefixLen {
+		return nil, fmt.Errorf("invalid nursery output key %x", k)
+	}
+	statePrefix := k[:nurseryStatePrefixLen]
+
+	output := &NurseryOutput{
+		key: append([]byte(nil), k...),
+	}
+
+	r := bytes.NewReader(v)
+	switch {
+	// Outputs in the crib are preceded by the expiry of the HTLC and the
+	// presigned timeout txn.
+	case bytes.Equal(statePrefix, nurseryCribPrefix):
+		var scratch [4]byte
+		if _, err := io.ReadFull(r, scratch[:]); err != nil {
+			return nil, err
+		}
+		output.Expiry = byteOrder.Uint32(scratch[:])
+
+		output.TimeoutTx = &wire.MsgTx{}
+		if err := output.TimeoutTx.Deserialize(r); err != nil {
+			return nil, err
+		}
+
+	case bytes.Equal(statePrefix, nurseryPsclPrefix):
+	case bytes.Equal(statePrefix, nurseryKndrPrefix):
+
+	default:
+		return nil, fmt.Errorf("unknown nursery output state %q",
+			statePrefix)
+	}
+
+	if err := output.decodeKidOutput(r); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// decodeKidOutput decodes the time locked output in the serialization format
+// of the utxo nursery.
+func (o *NurseryOutput) decodeKidOutput(r io.Reader) error {
+	var scratch [8]byte
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return err
+	}
+	o.Amount = btcutil.Amount(byteOrder.Uint64(scratch[:]))
+
+	if err := readOutpoint(r, &o.OutPoint); err != nil {
+		return err
+	}
+	if err := readOutpoint(r, &o.OriginChanPoint); err != nil {
+		return err
+	}
+
+	// Whether the output is an HTLC is only used for reporting, which the
+	// contract resolvers take care of now.
+	var isHtlc bool
+	if err := binary.Read(r, byteOrder, &isHtlc); err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, scratch[:4]); err != nil {
+		return err
+	}
+	o.BlocksToMaturity = byteOrder.Uint32(scratch[:4])
+
+	if _, err := io.ReadFull(r, scratch[:4]); err != nil {
+		return err
+	}
+	o.AbsoluteMaturity = byteOrder.Uint32(scratch[:4])
+
+	if _, err := io.ReadFull(r, scratch[:4]); err != nil {
+		return err
+	}
+	o.ConfHeight = byteOrder.Uint32(scratch[:4])
+
+	if _, err := io.ReadFull(r, scratch[:2]); err != nil {
+		return err
+	}
+	o.WitnessType = input.WitnessType(byteOrder.Uint16(scratch[:2]))
+
+	return input.ReadSignDescriptor(r, &o.SignDesc)
+}
+
+// readOutpoint reads an outpoint in the serialization format of the utxo
+// nursery.
+func readOutpoint(r io.Reader, o *wire.OutPoint) error {
+	txid, err := wire.ReadVarBytes(r, 0, 32, "prevout")
+	if err != nil {
+		return err
+	}
+	copy(o.Hash[:], txid)
+
+	var scratch [4]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return err
+	}
+	o.Index = byteOrder.Uint32(scratch[:])
+
+	return nil
+}
+
+// resumeNurseryOutputs resumes the incubation of all outputs that were taken
+// over from the utxo nursery. The outputs of channels that were still being
+// resolved by the contract resolvers weren't taken over, as the resolvers
+// sweep them by themselves, so no output is swept by both of them.
+func (s *UtxoSweeper) resumeNurseryOutputs() error {
+	outputs, err := s.cfg.Store.FetchNurseryOutputs()
+	if err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		log.Infof("Resuming incubation of nursery output %v",
+			output.OutPoint)
+
+		s.wg.Add(1)
+		go func(output *NurseryOutput) {
+			defer s.wg.Done()
+
+			err := s.sweepNurseryOutput(output)
+			if err != nil {
+				log.Errorf("Unable to sweep nursery output "+
+					"%v: %v", output.OutPoint, err)
+			}
+		}(output)
+	}
+
+	return nil
+}
+
+// sweepNurseryOutput waits for a nursery output to confirm and mature, after
+// which it is swept. Once swept, the output is removed from the store.
+func (s *UtxoSweeper) sweepNurseryOutput(output *NurseryOutput) error {
+	confHeight := output.ConfHeight
+
+	// If the output stems from an outgoing HTLC on our commitment
+	// transaction, we'll first need to broadcast its timeout txn.
+	if output.TimeoutTx != nil && confHeight == 0 {
+		var err error
+		confHeight, err = s.publishNurseryTimeoutTx(output)
+		switch {
+		// If the HTLC was swept by the remote party, there's nothing
+		// left to sweep for us.
+		case err == ErrRemoteSpend:
+			log.Infof("HTLC of nursery output %v was spent by "+
+				"remote party", output.OutPoint)
+
+			return s.cfg.Store.RemoveNurseryOutput(output)
+
+		case err != nil:
+			return err
+		}
+	}
+
+	// Otherwise, if the output hasn't confirmed yet, we'll wait for the
+	// commitment or htlc success txn creating it to confirm.
+	if confHeight == 0 {
+		var err error
+		confHeight, err = s.waitForNurseryConf(output)
+		if err != nil {
+			return err
+		}
+	}
+
+	maturityHeight := confHeight + output.BlocksToMaturity
+	if output.AbsoluteMaturity > maturityHeight {
+		maturityHeight = output.AbsoluteMaturity
+	}
+
+	log.Infof("Waiting for nursery output %v to mature at height %v",
+		output.OutPoint, maturityHeight)
+
+	if err := s.waitForHeight(maturityHeight); err != nil {
+		return err
+	}
+
+	inp := input.NewCsvInput(
+		&output.OutPoint, output.WitnessType, &output.SignDesc,
+		confHeight, output.BlocksToMaturity,
+	)
+	resultChan, err := s.SweepInput(inp)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case result := <-resultChan:
+		// In case of a remote spend, there's nothing left to sweep
+		// either.
+		if result.Err != nil && result.Err != ErrRemoteSpend {
+			return result.Err
+		}
+
+	case <-s.quit:
+		return fmt.Errorf("sweeper shutting down")
+	}
+
+	log.Infof("Nursery output %v swept", output.OutPoint)
+
+	return s.cfg.Store.RemoveNurseryOutput(output)
+}
+
+// publishNurseryTimeoutTx broadcasts the timeout txn of a nursery output once
+// its HTLC has expired, and returns the height at which the timeout txn
+// confirmed. If the HTLC is spent by the remote party instead, ErrRemoteSpend
+// is returned.
+func (s *UtxoSweeper) publishNurseryTimeoutTx(output *NurseryOutput) (uint32,
+	error) {
+
+	if err := s.waitForHeight(output.Expiry); err != nil {
+		return 0, err
+	}
+
+	timeoutTx := output.TimeoutTx
+	timeoutTxHash := timeoutTx.TxHash()
+
+	log.Infof("Publishing timeout tx %v of nursery output %v",
+		timeoutTxHash, output.OutPoint)
+
+	err := s.cfg.PublishTransaction(timeoutTx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		return 0, err
+	}
+
+	// As the remote party may still sweep the HTLC using the preimage,
+	// we'll watch for the spend of the HTLC, rather than the confirmation
+	// of our timeout txn. The script of the HTLC is the witness script
+	// within the witness of the timeout txn.
+	htlcInput := timeoutTx.TxIn[0]
+	witness := htlcInput.Witness
+	if len(witness) == 0 {
+		return 0, fmt.Errorf("timeout tx %v has no witness",
+			timeoutTxHash)
+	}
+	htlcPkScript, err := input.WitnessScriptHash(witness[len(witness)-1])
+	if err != nil {
+		return 0, err
+	}
+
+	spendEvent, err := s.cfg.Notifier.RegisterSpendNtfn(
+		&htlcInput.PreviousOutPoint, htlcPkScript, output.HeightHint,
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer spendEvent.Cancel()
+
+	select {
+	case spend, ok := <-spendEvent.Spend:
+		if !ok {
+			return 0, fmt.Errorf("spend ntfn chan closed")
+		}
+
+		if *spend.SpenderTxHash != timeoutTxHash {
+			return 0, ErrRemoteSpend
+		}
+
+		return uint32(spend.SpendingHeight), nil
+
+	case <-s.quit:
+		return 0, fmt.Errorf("sweeper shutting down")
+	}
+}
+
+// waitForNurseryConf waits for the txn that creates a nursery output to
+// confirm, and returns its confirmation height.
+func (s *UtxoSweeper) waitForNurseryConf(output *NurseryOutput) (uint32,
+	error) {
+
+	confEvent, err := s.cfg.Notifier.RegisterConfirmationsNtfn(
+		&output.OutPoint.Hash, output.SignDesc.Output.PkScript, 1,
+		output.HeightHint,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case conf, ok := <-confEvent.Confirmed:
+		if !ok {
+			return 0, fmt.Errorf("conf ntfn chan closed")
+		}
+
+		return conf.BlockHeight, nil
+
+	case <-s.quit:
+		return 0, fmt.Errorf("sweeper shutting down")
+	}
+}
+
+// waitForHeight blocks until the tip of the main chain has reached the passed
+// height, or the sweeper is shut down.
+func (s *UtxoSweeper) waitForHeight(height uint32) error {
+	blockEpochs, err := s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
+
+	// We'll only receive notifications for new blocks, so we'll return
+	// right away if the height has already been reached.
+	_, bestHeight, err := s.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	if uint32(bestHeight) >= height {
+		return nil
+	}
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return fmt.Errorf("block epoch chan closed")
+			}
+
+			if uint32(epoch.Height) >= height {
+				return nil
+			}
+
+		case <-s.quit:
+			return fmt.Errorf("sweeper shutting down")
+		}
+	}
+}
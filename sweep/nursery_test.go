@@ -0,0 +1,153 @@
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
+)
+
+// assertNurseryOutputsSwept asserts that all nursery outputs are removed from
+// the store of the sweeper.
+func (ctx *sweeperTestContext) assertNurseryOutputsSwept() {
+	ctx.t.Helper()
+
+	timeout := time.After(defaultTestTimeout)
+	for {
+		outputs, err := ctx.store.FetchNurseryOutputs()
+		if err != nil {
+			ctx.t.Fatal(err)
+		}
+		if len(outputs) == 0 {
+			return
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			ctx.t.Fatalf("%v nursery outputs not swept",
+				len(outputs))
+		}
+	}
+}
+
+// TestNurseryOutputConf asserts that a nursery output of which the txn hasn't
+// confirmed yet, is swept once the txn has confirmed and the output has
+// matured.
+func TestNurseryOutputConf(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// The output matures two blocks after its confirmation, which is the
+	// current height of the chain.
+	output := &NurseryOutput{
+		OutPoint: wire.OutPoint{
+			Hash: chainhash.Hash{1},
+		},
+		WitnessType: input.CommitmentTimeLock,
+		SignDesc: input.SignDescriptor{
+			Output: &wire.TxOut{
+				Value: 10000,
+			},
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: testPubKey,
+			},
+		},
+		BlocksToMaturity: 2,
+	}
+	ctx.store.AddNurseryOutput(output)
+
+	if err := ctx.sweeper.resumeNurseryOutputs(); err != nil {
+		t.Fatal(err)
+	}
+
+	err := ctx.notifier.ConfirmTx(
+		&output.OutPoint.Hash, uint32(mockChainIOHeight-2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+	if len(sweepTx.TxIn) != 1 ||
+		sweepTx.TxIn[0].PreviousOutPoint != output.OutPoint {
+
+		t.Fatalf("expected nursery output to be swept")
+	}
+
+	ctx.backend.mine()
+
+	ctx.assertNurseryOutputsSwept()
+
+	ctx.finish(1)
+}
+
+// TestNurseryOutputTimeoutRemoteSpend asserts that a nursery output of which
+// the HTLC was swept by the remote party before our timeout txn confirmed, is
+// removed from the store.
+func TestNurseryOutputTimeoutRemoteSpend(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	htlcOutPoint := wire.OutPoint{
+		Hash: chainhash.Hash{2},
+	}
+	timeoutTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: htlcOutPoint,
+				Witness: wire.TxWitness{
+					nil, []byte("sig"), []byte("sig"), nil,
+					[]byte("htlc script"),
+				},
+			},
+		},
+		TxOut: []*wire.TxOut{
+			{Value: 10000},
+		},
+	}
+
+	output := &NurseryOutput{
+		OutPoint: wire.OutPoint{
+			Hash: timeoutTx.TxHash(),
+		},
+		WitnessType: input.HtlcOfferedTimeoutSecondLevel,
+		SignDesc: input.SignDescriptor{
+			Output: timeoutTx.TxOut[0],
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: testPubKey,
+			},
+		},
+		BlocksToMaturity: 2,
+		TimeoutTx:        timeoutTx,
+		Expiry:           uint32(mockChainIOHeight),
+	}
+	ctx.store.AddNurseryOutput(output)
+
+	// The remote party already swept the HTLC with the preimage.
+	ctx.notifier.SpendOutpoint(htlcOutPoint, wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: htlcOutPoint},
+		},
+	})
+
+	if err := ctx.sweeper.resumeNurseryOutputs(); err != nil {
+		t.Fatal(err)
+	}
+
+	// As the HTLC has expired, we'll still publish our timeout txn, which
+	// won't confirm.
+	publishedTx := ctx.receiveTx()
+	if publishedTx.TxHash() != timeoutTx.TxHash() {
+		t.Fatalf("expected timeout tx to be published")
+	}
+	ctx.backend.deleteUnconfirmed(timeoutTx.TxHash())
+
+	ctx.assertNurseryOutputsSwept()
+
+	ctx.finish(1)
+}
@@ -2,14 +2,13 @@ package sweep
 
 import (
 	"bytes"
-	"encoding/binary"
 	"errors"
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 )
 
 var (
@@ -28,18 +27,12 @@ var (
 	// maps: txHash -> empty slice
 	txHashesBucketKey = []byte("sweeper-tx-hashes")
 
-	// utxnChainPrefix is the bucket prefix for nursery buckets.
-	utxnChainPrefix = []byte("utxn")
-
-	// utxnHeightIndexKey is the sub bucket where the nursery stores the
-	// height index.
-	utxnHeightIndexKey = []byte("height-index")
-
-	// utxnFinalizedKndrTxnKey is a static key that can be used to locate
-	// the nursery finalized kindergarten sweep txn.
-	utxnFinalizedKndrTxnKey = []byte("finalized-kndr-txn")
-
-	byteOrder = binary.BigEndian
+	// nurseryOutputsBucketKey is the key that points to a bucket
+	// containing the outputs that were still incubating in the utxo
+	// nursery when its state was migrated to the sweeper.
+	//
+	// maps: statePrefix || outpoint -> serialized nursery output
+	nurseryOutputsBucketKey = []byte("sweeper-nursery-outputs")
 )
 
 // SweeperStore stores published txes.
@@ -54,6 +47,14 @@ type SweeperStore interface {
 	// GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 	// for.
 	GetLastPublishedTx() (*wire.MsgTx, error)
+
+	// FetchNurseryOutputs returns the outputs that were taken over from
+	// the utxo nursery and haven't been swept yet.
+	FetchNurseryOutputs() ([]*NurseryOutput, error)
+
+	// RemoveNurseryOutput removes an output taken over from the utxo
+	// nursery once it has been swept.
+	RemoveNurseryOutput(output *NurseryOutput) error
 }
 
 type sweeperStore struct {
@@ -61,10 +62,9 @@ type sweeperStore struct {
 }
 
 // NewSweeperStore returns a new store instance.
-func NewSweeperStore(db *channeldb.DB, chainHash *chainhash.Hash) (
-	SweeperStore, error) {
+func NewSweeperStore(db *channeldb.DB) (SweeperStore, error) {
 
-	err := db.Update(func(tx *bbolt.Tx) error {
+	err := db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(
 			lastTxBucketKey,
 		)
@@ -72,19 +72,12 @@ func NewSweeperStore(db *channeldb.DB, chainHash *chainhash.Hash) (
 			return err
 		}
 
-		if tx.Bucket(txHashesBucketKey) != nil {
-			return nil
-		}
-
-		txHashesBucket, err := tx.CreateBucket(txHashesBucketKey)
+		_, err = tx.CreateBucketIfNotExists(txHashesBucketKey)
 		if err != nil {
 			return err
 		}
 
-		// Use non-existence of tx hashes bucket as a signal to migrate
-		// nursery finalized txes.
-		err = migrateTxHashes(tx, txHashesBucket, chainHash)
-
+		_, err = tx.CreateBucketIfNotExists(nurseryOutputsBucketKey)
 		return err
 	})
 	if err != nil {
@@ -96,75 +89,9 @@ func NewSweeperStore(db *channeldb.DB, chainHash *chainhash.Hash) (
 	}, nil
 }
 
-// migrateTxHashes migrates nursery finalized txes to the tx hashes bucket. This
-// is not implemented as a database migration, to keep the downgrade path open.
-func migrateTxHashes(tx *bbolt.Tx, txHashesBucket *bbolt.Bucket,
-	chainHash *chainhash.Hash) error {
-
-	log.Infof("Migrating UTXO nursery finalized TXIDs")
-
-	// Compose chain bucket key.
-	var b bytes.Buffer
-	if _, err := b.Write(utxnChainPrefix); err != nil {
-		return err
-	}
-
-	if _, err := b.Write(chainHash[:]); err != nil {
-		return err
-	}
-
-	// Get chain bucket if exists.
-	chainBucket := tx.Bucket(b.Bytes())
-	if chainBucket == nil {
-		return nil
-	}
-
-	// Retrieve the existing height index.
-	hghtIndex := chainBucket.Bucket(utxnHeightIndexKey)
-	if hghtIndex == nil {
-		return nil
-	}
-
-	// Retrieve all heights.
-	err := hghtIndex.ForEach(func(k, v []byte) error {
-		heightBucket := hghtIndex.Bucket(k)
-		if heightBucket == nil {
-			return nil
-		}
-
-		// Get finalized tx for height.
-		txBytes := heightBucket.Get(utxnFinalizedKndrTxnKey)
-		if txBytes == nil {
-			return nil
-		}
-
-		// Deserialize and skip tx if it cannot be deserialized.
-		tx := &wire.MsgTx{}
-		err := tx.Deserialize(bytes.NewReader(txBytes))
-		if err != nil {
-			log.Warnf("Cannot deserialize utxn tx")
-			return nil
-		}
-
-		// Calculate hash.
-		hash := tx.TxHash()
-
-		// Insert utxn tx hash in hashes bucket.
-		log.Debugf("Inserting nursery tx %v in hash list "+
-			"(height=%v)", hash, byteOrder.Uint32(k))
-
-		return txHashesBucket.Put(hash[:], []byte{})
-	})
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // NotifyPublishTx signals that we are about to publish a tx.
 func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
-	return s.db.Update(func(tx *bbolt.Tx) error {
+	return s.db.Update(func(tx kvdb.Tx) error {
 		lastTxBucket := tx.Bucket(lastTxBucketKey)
 		if lastTxBucket == nil {
 			return errors.New("last tx bucket does not exist")
@@ -195,7 +122,7 @@ func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
 func (s *sweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
 	var sweepTx *wire.MsgTx
 
-	err := s.db.View(func(tx *bbolt.Tx) error {
+	err := s.db.View(func(tx kvdb.Tx) error {
 		lastTxBucket := tx.Bucket(lastTxBucketKey)
 		if lastTxBucket == nil {
 			return errors.New("last tx bucket does not exist")
@@ -226,7 +153,7 @@ func (s *sweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
 func (s *sweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
 	var ours bool
 
-	err := s.db.View(func(tx *bbolt.Tx) error {
+	err := s.db.View(func(tx kvdb.Tx) error {
 		txHashesBucket := tx.Bucket(txHashesBucketKey)
 		if txHashesBucket == nil {
 			return errors.New("tx hashes bucket does not exist")
@@ -243,5 +170,67 @@ func (s *sweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
 	return ours, nil
 }
 
+// FetchNurseryOutputs returns the outputs that were taken over from the utxo
+// nursery and haven't been swept yet.
+func (s *sweeperStore) FetchNurseryOutputs() ([]*NurseryOutput, error) {
+	var outputs []*NurseryOutput
+
+	err := s.db.View(func(tx kvdb.Tx) error {
+		nurseryOutputs := tx.Bucket(nurseryOutputsBucketKey)
+		if nurseryOutputs == nil {
+			return errors.New("nursery outputs bucket does not " +
+				"exist")
+		}
+
+		return nurseryOutputs.ForEach(func(k, v []byte) error {
+			output, err := decodeNurseryOutput(k, v)
+			if err != nil {
+				return err
+			}
+
+			outputs = append(outputs, output)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The nursery only recorded the confirmation height of outputs that
+	// have confirmed. For the others, we'll use the close height of their
+	// channel as height hint to watch for their confirmation.
+	for _, output := range outputs {
+		closeSummary, err := s.db.FetchClosedChannel(
+			&output.OriginChanPoint,
+		)
+		switch {
+		case err == channeldb.ErrClosedChannelNotFound:
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		output.HeightHint = closeSummary.CloseHeight
+	}
+
+	return outputs, nil
+}
+
+// RemoveNurseryOutput removes an output taken over from the utxo nursery once
+// it has been swept.
+func (s *sweeperStore) RemoveNurseryOutput(output *NurseryOutput) error {
+	return s.db.Update(func(tx kvdb.Tx) error {
+		nurseryOutputs := tx.Bucket(nurseryOutputsBucketKey)
+		if nurseryOutputs == nil {
+			return errors.New("nursery outputs bucket does not " +
+				"exist")
+		}
+
+		return nurseryOutputs.Delete(output.key)
+	})
+}
+
 // Compile-time constraint to ensure sweeperStore implements SweeperStore.
 var _ SweeperStore = (*sweeperStore)(nil)
@@ -1,21 +1,26 @@
 package sweep
 
 import (
+	"sync"
+
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
 
-// MockSweeperStore is a mock implementation of sweeper store. This type is
-// exported, because it is currently used in nursery tests too.
+// MockSweeperStore is a mock implementation of sweeper store.
 type MockSweeperStore struct {
 	lastTx  *wire.MsgTx
 	ourTxes map[chainhash.Hash]struct{}
+
+	nurseryOutputs map[wire.OutPoint]*NurseryOutput
+	nurseryMtx     sync.Mutex
 }
 
 // NewMockSweeperStore returns a new instance.
 func NewMockSweeperStore() *MockSweeperStore {
 	return &MockSweeperStore{
-		ourTxes: make(map[chainhash.Hash]struct{}),
+		ourTxes:        make(map[chainhash.Hash]struct{}),
+		nurseryOutputs: make(map[wire.OutPoint]*NurseryOutput),
 	}
 }
 
@@ -41,5 +46,37 @@ func (s *MockSweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
 	return s.lastTx, nil
 }
 
+// AddNurseryOutput adds an output that was taken over from the utxo nursery.
+func (s *MockSweeperStore) AddNurseryOutput(output *NurseryOutput) {
+	s.nurseryMtx.Lock()
+	defer s.nurseryMtx.Unlock()
+
+	s.nurseryOutputs[output.OutPoint] = output
+}
+
+// FetchNurseryOutputs returns the outputs that were taken over from the utxo
+// nursery and haven't been swept yet.
+func (s *MockSweeperStore) FetchNurseryOutputs() ([]*NurseryOutput, error) {
+	s.nurseryMtx.Lock()
+	defer s.nurseryMtx.Unlock()
+
+	outputs := make([]*NurseryOutput, 0, len(s.nurseryOutputs))
+	for _, output := range s.nurseryOutputs {
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+// RemoveNurseryOutput removes an output taken over from the utxo nursery once
+// it has been swept.
+func (s *MockSweeperStore) RemoveNurseryOutput(output *NurseryOutput) error {
+	s.nurseryMtx.Lock()
+	defer s.nurseryMtx.Unlock()
+
+	delete(s.nurseryOutputs, output.OutPoint)
+	return nil
+}
+
 // Compile-time constraint to ensure MockSweeperStore implements SweeperStore.
 var _ SweeperStore = (*MockSweeperStore)(nil)
@@ -52,8 +52,7 @@ func TestStore(t *testing.T) {
 		}
 
 		testStore(t, func() (SweeperStore, error) {
-			var chain chainhash.Hash
-			return NewSweeperStore(cdb, &chain)
+			return NewSweeperStore(cdb)
 		})
 	})
 	t.Run("mock", func(t *testing.T) {
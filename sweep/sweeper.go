@@ -53,6 +53,11 @@ type pendingInput struct {
 	// publishAttempts records the number of attempts that have already been
 	// made to sweep this tx.
 	publishAttempts int
+
+	// startHeight is the block height at which the input was first
+	// offered to the sweeper. For inputs with a deadline, it marks the
+	// start of the fee function.
+	startHeight int32
 }
 
 // UtxoSweeper is responsible for sweeping outputs back into the wallet
@@ -131,6 +136,16 @@ type UtxoSweeperConfig struct {
 	// NextAttemptDeltaFunc returns given the number of already attempted
 	// sweeps, how many blocks to wait before retrying to sweep.
 	NextAttemptDeltaFunc func(int) int32
+
+	// MaxFeeRate is the maximum fee rate that the sweeper is willing to
+	// pay for inputs with a deadline. As the deadline of an input nears,
+	// the fee rate at which it is swept is raised from the estimated fee
+	// rate up to this value.
+	MaxFeeRate lnwallet.SatPerKWeight
+
+	// DeadlineClusterWindow is the maximum number of blocks that the
+	// deadlines of inputs may differ to be swept together in a cluster.
+	DeadlineClusterWindow int32
 }
 
 // Result is the struct that is pushed through the result channel. Callers can
@@ -231,6 +246,12 @@ func (s *UtxoSweeper) Start() error {
 		}
 	}()
 
+	// Now that the main loop is running, we can resume sweeping the
+	// outputs that were taken over from the utxo nursery.
+	if err := s.resumeNurseryOutputs(); err != nil {
+		return fmt.Errorf("resume nursery outputs: %v", err)
+	}
+
 	return nil
 }
 
@@ -262,9 +283,11 @@ func (s *UtxoSweeper) SweepInput(input input.Input) (chan Result, error) {
 		return nil, errors.New("nil input received")
 	}
 
+	deadline, _ := input.DeadlineHeight()
+
 	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
-		"time_lock=%v, size=%v", input.OutPoint(), input.WitnessType(),
-		input.BlocksToMaturity(),
+		"time_lock=%v, deadline=%v, size=%v", input.OutPoint(),
+		input.WitnessType(), input.BlocksToMaturity(), deadline,
 		btcutil.Amount(input.SignDesc().Output.Value))
 
 	sweeperInput := &sweepInputMessage{
@@ -315,6 +338,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 				listeners:        []chan Result{input.resultChan},
 				input:            input.input,
 				minPublishHeight: bestHeight,
+				startHeight:      bestHeight,
 			}
 			s.pendingInputs[outpoint] = pendInput
 
@@ -417,18 +441,27 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 			}
 
 			// Examine pending inputs and try to construct lists of
-			// inputs.
-			inputLists, err := s.getInputLists(bestHeight, satPerKW)
-			if err != nil {
-				log.Errorf("get input lists: %v", err)
-				continue
-			}
-
-			// Sweep selected inputs.
-			for _, inputs := range inputLists {
-				err := s.sweep(inputs, satPerKW, bestHeight)
+			// inputs for every cluster. Each cluster is swept at
+			// its own fee rate.
+			clusters := s.clusterInputs(bestHeight, satPerKW)
+			for _, cluster := range clusters {
+				inputLists, err := s.getInputLists(
+					cluster, bestHeight,
+				)
 				if err != nil {
-					log.Errorf("sweep: %v", err)
+					log.Errorf("get input lists: %v", err)
+					continue
+				}
+
+				// Sweep selected inputs.
+				for _, inputs := range inputLists {
+					err := s.sweep(
+						inputs, cluster.sweepFeeRate,
+						bestHeight,
+					)
+					if err != nil {
+						log.Errorf("sweep: %v", err)
+					}
 				}
 			}
 
@@ -473,18 +506,24 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 		return fmt.Errorf("estimate fee: %v", err)
 	}
 
-	// Examine pending inputs and try to construct lists of inputs.
-	inputLists, err := s.getInputLists(currentHeight, satPerKW)
-	if err != nil {
-		return fmt.Errorf("get input lists: %v", err)
+	// Examine pending inputs and try to construct lists of inputs for
+	// every cluster.
+	var numLists int
+	for _, cluster := range s.clusterInputs(currentHeight, satPerKW) {
+		inputLists, err := s.getInputLists(cluster, currentHeight)
+		if err != nil {
+			return fmt.Errorf("get input lists: %v", err)
+		}
+
+		numLists += len(inputLists)
 	}
 
 	log.Infof("Sweep candidates at height=%v, yield %v distinct txns",
-		currentHeight, len(inputLists))
+		currentHeight, numLists)
 
 	// If there are no input sets, there is nothing sweepable and we can
 	// return without starting the timer.
-	if len(inputLists) == 0 {
+	if numLists == 0 {
 		return nil
 	}
 
@@ -533,13 +572,15 @@ func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
 	delete(s.pendingInputs, *outpoint)
 }
 
-// getInputLists goes through all pending inputs and constructs sweep lists,
-// each up to the configured maximum number of inputs. Negative yield inputs are
-// skipped. Transactions with an output below the dust limit are not published.
-// Those inputs remain pending and will be bundled with future inputs if
-// possible.
-func (s *UtxoSweeper) getInputLists(currentHeight int32,
-	satPerKW lnwallet.SatPerKWeight) ([]inputSet, error) {
+// getInputLists goes through all inputs of the cluster and constructs sweep
+// lists, each up to the configured maximum number of inputs. Negative yield
+// inputs are skipped. Transactions with an output below the dust limit are not
+// published. Those inputs remain pending and will be bundled with future
+// inputs if possible.
+func (s *UtxoSweeper) getInputLists(cluster inputCluster,
+	currentHeight int32) ([]inputSet, error) {
+
+	satPerKW := cluster.sweepFeeRate
 
 	// Filter for inputs that need to be swept. Create two lists: all
 	// sweepable inputs and a list containing only the new, never tried
@@ -552,13 +593,7 @@ func (s *UtxoSweeper) getInputLists(currentHeight int32,
 	// consisting of only new inputs to the list, to make sure that new
 	// inputs are given a good, isolated chance of being published.
 	var newInputs, retryInputs []input.Input
-	for _, input := range s.pendingInputs {
-		// Skip inputs that have a minimum publish height that is not
-		// yet reached.
-		if input.minPublishHeight > currentHeight {
-			continue
-		}
-
+	for _, input := range cluster.inputs {
 		// Add input to the either one of the lists.
 		if input.publishAttempts == 0 {
 			newInputs = append(newInputs, input.input)
@@ -678,6 +713,15 @@ func (s *UtxoSweeper) sweep(inputs inputSet,
 			pi.publishAttempts,
 		)
 
+		// Inputs with a deadline are retried every block, so that the
+		// fee rate can be bumped as the deadline nears. We won't give
+		// up on them as long as the deadline hasn't passed.
+		deadline, hasDeadline := pi.input.DeadlineHeight()
+		beforeDeadline := hasDeadline && currentHeight < int32(deadline)
+		if hasDeadline {
+			nextAttemptDelta = 1
+		}
+
 		pi.minPublishHeight = currentHeight + nextAttemptDelta
 
 		log.Debugf("Rescheduling input %v after %v attempts at "+
@@ -685,7 +729,8 @@ func (s *UtxoSweeper) sweep(inputs inputSet,
 			pi.publishAttempts, pi.minPublishHeight,
 			nextAttemptDelta)
 
-		if pi.publishAttempts >= s.cfg.MaxSweepAttempts {
+		if pi.publishAttempts >= s.cfg.MaxSweepAttempts &&
+			!beforeDeadline {
 			// Signal result channels sweep result.
 			s.signalAndRemove(&input.PreviousOutPoint, Result{
 				Err: ErrTooManyAttempts,
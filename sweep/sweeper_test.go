@@ -14,6 +14,7 @@ import (
 	"github.com/wakiyamap/lnd/build"
 	"github.com/wakiyamap/lnd/input"
 	"github.com/wakiyamap/lnd/keychain"
+	"github.com/wakiyamap/lnd/lnwallet"
 )
 
 var (
@@ -22,6 +23,10 @@ var (
 	testMaxSweepAttempts = 3
 
 	testMaxInputsPerTx = 3
+
+	testMaxFeeRate = lnwallet.SatPerKWeight(30000)
+
+	testDeadlineClusterWindow int32 = 2
 )
 
 type sweeperTestContext struct {
@@ -143,6 +148,8 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			// Use delta func without random factor.
 			return 1 << uint(attempts-1)
 		},
+		MaxFeeRate:            testMaxFeeRate,
+		DeadlineClusterWindow: testDeadlineClusterWindow,
 	})
 
 	ctx.sweeper.Start()
@@ -902,3 +909,129 @@ func TestGiveUp(t *testing.T) {
 
 	ctx.finish(1)
 }
+
+// TestDeadlineFeeBump asserts that an input with a deadline is retried every
+// block at an increasing fee rate, and that the sweeper doesn't give up on it
+// before the deadline has passed.
+func TestDeadlineFeeBump(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Create an input that needs to be swept before height 104. The fee
+	// function reaches the maximum fee rate at height 103.
+	deadlineInput := createTestInput(100000, input.CommitmentTimeLock)
+	deadlineInput.SetDeadlineHeight(104)
+
+	resultChan, err := ctx.sweeper.SweepInput(&deadlineInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first sweep is published at height 100 (mockChainIOHeight) at
+	// the estimated fee rate.
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	prevValue := sweepTx.TxOut[0].Value
+
+	// Each following block, the input is expected to be swept again with
+	// a higher fee. Even though this exceeds the maximum number of sweep
+	// attempts, the deadline hasn't passed yet so no result is expected.
+	for _, height := range []int32{101, 102, 103} {
+		ctx.notifier.NotifyEpoch(height)
+		ctx.tick()
+
+		bumpTx := ctx.receiveTx()
+		if bumpTx.TxOut[0].Value >= prevValue {
+			t.Fatalf("expected fee bump at height %v: output "+
+				"value %v, previous value %v", height,
+				bumpTx.TxOut[0].Value, prevValue)
+		}
+		prevValue = bumpTx.TxOut[0].Value
+	}
+
+	// At the last height before the deadline, the maximum fee rate must
+	// be used. The size of the sweep tx doesn't change, so the fee scales
+	// with the fee rate, which started out at a third of the maximum.
+	initialFee := 100000 - sweepTx.TxOut[0].Value
+	if fee := 100000 - prevValue; fee != 3*initialFee {
+		t.Fatalf("expected fee %v at max fee rate, got %v",
+			3*initialFee, fee)
+	}
+
+	select {
+	case result := <-resultChan:
+		t.Fatalf("unexpected result before deadline: %v", result.Err)
+	default:
+	}
+
+	ctx.backend.mine()
+
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish(1)
+}
+
+// TestDeadlineClusters asserts that inputs are clustered by their deadlines
+// and that every cluster is swept in a separate tx.
+func TestDeadlineClusters(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Create an input without deadline, two inputs with deadlines within
+	// the cluster window and one input with a deadline far beyond.
+	noDeadlineInput := createTestInput(100000, input.CommitmentTimeLock)
+
+	earlyInput1 := createTestInput(100000, input.CommitmentTimeLock)
+	earlyInput1.SetDeadlineHeight(110)
+
+	earlyInput2 := createTestInput(100000, input.CommitmentTimeLock)
+	earlyInput2.SetDeadlineHeight(110 + uint32(testDeadlineClusterWindow))
+
+	lateInput := createTestInput(100000, input.CommitmentTimeLock)
+	lateInput.SetDeadlineHeight(130)
+
+	var resultChans []chan Result
+	for _, inp := range []*input.BaseInput{
+		&noDeadlineInput, &earlyInput1, &earlyInput2, &lateInput,
+	} {
+		resultChan, err := ctx.sweeper.SweepInput(inp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resultChans = append(resultChans, resultChan)
+	}
+
+	ctx.tick()
+
+	// The cluster without a deadline is swept first, followed by the
+	// deadline clusters ordered by urgency.
+	expectedInputs := [][]*input.BaseInput{
+		{&noDeadlineInput},
+		{&earlyInput1, &earlyInput2},
+		{&lateInput},
+	}
+	for i, expected := range expectedInputs {
+		sweepTx := ctx.receiveTx()
+		if len(sweepTx.TxIn) != len(expected) {
+			t.Fatalf("tx %v: expected %v inputs, got %v", i,
+				len(expected), len(sweepTx.TxIn))
+		}
+
+		spent := make(map[wire.OutPoint]struct{})
+		for _, txIn := range sweepTx.TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+		for _, inp := range expected {
+			if _, ok := spent[*inp.OutPoint()]; !ok {
+				t.Fatalf("tx %v: expected input %v to be "+
+					"swept", i, inp.OutPoint())
+			}
+		}
+	}
+
+	ctx.backend.mine()
+
+	for _, resultChan := range resultChans {
+		ctx.expectResult(resultChan, nil)
+	}
+
+	ctx.finish(1)
+}
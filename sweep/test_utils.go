@@ -32,8 +32,7 @@ func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
 	return &input.Script{}, nil
 }
 
-// MockNotifier simulates the chain notifier for test purposes. This type is
-// exported because it is used in nursery tests.
+// MockNotifier simulates the chain notifier for test purposes.
 type MockNotifier struct {
 	confChannel map[chainhash.Hash]chan *chainntnfs.TxConfirmation
 	epochChan   map[chan *chainntnfs.BlockEpoch]int32
@@ -291,6 +291,16 @@ func getInputWitnessSizeUpperBound(inp input.Input) (int, bool, error) {
 	case input.HtlcAcceptedRemoteSuccess:
 		return input.OfferedHtlcSuccessWitnessSize, false, nil
 
+	// The on-chain HTLC of a submarine swap, claimed using the swap
+	// preimage.
+	case input.SwapHtlcSuccess:
+		return input.SwapHtlcSuccessWitnessSize, false, nil
+
+	// The on-chain HTLC of a submarine swap, reclaimed by its sender after
+	// the timeout.
+	case input.SwapHtlcTimeout:
+		return input.SwapHtlcTimeoutWitnessSize, false, nil
+
 	// A nested P2SH input that has a p2wkh witness script. We'll mark this
 	// as nested P2SH so the caller can estimate the weight properly
 	// including the sigScript.
@@ -351,7 +361,8 @@ func getWeightEstimate(inputs []input.Input) ([]input.Input, int64, int, int) {
 			input.HtlcOfferedTimeoutSecondLevel,
 			input.HtlcAcceptedSuccessSecondLevel:
 			csvCount++
-		case input.HtlcOfferedRemoteTimeout:
+		case input.HtlcOfferedRemoteTimeout,
+			input.SwapHtlcTimeout:
 			cltvCount++
 		}
 		sweepInputs = append(sweepInputs, inp)
@@ -374,7 +374,9 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 	}
 	s.htlcSwitch = htlcSwitch
 
-	nodeSignerAlice := netann.NewNodeSigner(aliceKeyPriv)
+	nodeSignerAlice := netann.NewNodeSigner(
+		keychain.NewPrivKeyMessageSigner(aliceKeyPriv),
+	)
 
 	const chanActiveTimeout = time.Minute
 
@@ -414,6 +416,11 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		chanCloseMsgs:      make(chan *closeMsg),
 
+		activeSplices:   make(map[lnwire.ChannelID]*channelSplicer),
+		spliceLocks:     make(map[lnwire.ChannelID]*spliceLock),
+		localSpliceReqs: make(chan *spliceRequest),
+		chanSpliceMsgs:  make(chan *closeMsg),
+
 		chanActiveTimeout: chanActiveTimeout,
 
 		queueQuit: make(chan struct{}),
@@ -18,6 +18,14 @@ import (
 	"golang.org/x/net/context"
 )
 
+// ErrMacaroonTimeout is returned if the request to initialize or unlock the
+// wallet stateless is cancelled before the daemon handed over the admin
+// macaroon. The wallet itself is still usable, a new admin macaroon can be
+// obtained by restarting the daemon and unlocking the wallet stateless.
+var ErrMacaroonTimeout = errors.New("request cancelled before the admin " +
+	"macaroon was received, restart lnd and unlock the wallet with " +
+	"stateless_init to obtain a new one")
+
 // ChannelsToRecover wraps any set of packed (serialized+encrypted) channel
 // back ups together. These can be passed in when unlocking the wallet, or
 // creating a new wallet for the first time with an existing seed.
@@ -54,6 +62,12 @@ type WalletInitMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been initialized.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to be
+	// initialized stateless, which means no macaroon files should be
+	// created on disk. The admin macaroon must then be sent over the
+	// MacResponseChan of the UnlockerService instead.
+	StatelessInit bool
 }
 
 // WalletUnlockMsg is a message sent by the UnlockerService when a user wishes
@@ -81,6 +95,12 @@ type WalletUnlockMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been unlocked.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to be
+	// initialized stateless, which means no macaroon files should be
+	// created on disk. A new admin macaroon must then be sent over the
+	// MacResponseChan of the UnlockerService.
+	StatelessInit bool
 }
 
 // UnlockerService implements the WalletUnlocker service used to provide lnd
@@ -96,6 +116,11 @@ type UnlockerService struct {
 	// sent.
 	UnlockMsgs chan *WalletUnlockMsg
 
+	// MacResponseChan is the channel over which the daemon sends the
+	// admin macaroon once the wallet was initialized stateless, such that
+	// it can be returned to the caller of InitWallet.
+	MacResponseChan chan []byte
+
 	chainDir      string
 	netParams     *chaincfg.Params
 	macaroonFiles []string
@@ -106,11 +131,12 @@ func New(chainDir string, params *chaincfg.Params,
 	macaroonFiles []string) *UnlockerService {
 
 	return &UnlockerService{
-		InitMsgs:      make(chan *WalletInitMsg, 1),
-		UnlockMsgs:    make(chan *WalletUnlockMsg, 1),
-		chainDir:      chainDir,
-		netParams:     params,
-		macaroonFiles: macaroonFiles,
+		InitMsgs:        make(chan *WalletInitMsg, 1),
+		UnlockMsgs:      make(chan *WalletUnlockMsg, 1),
+		MacResponseChan: make(chan []byte, 1),
+		chainDir:        chainDir,
+		netParams:       params,
+		macaroonFiles:   macaroonFiles,
 	}
 }
 
@@ -290,6 +316,7 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		Passphrase:     password,
 		WalletSeed:     cipherSeed,
 		RecoveryWindow: uint32(recoveryWindow),
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -301,7 +328,34 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 
 	u.InitMsgs <- initMsg
 
-	return &lnrpc.InitWalletResponse{}, nil
+	if !in.StatelessInit {
+		return &lnrpc.InitWalletResponse{}, nil
+	}
+
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.InitWalletResponse{
+		AdminMacaroon: adminMac,
+	}, nil
+}
+
+// waitForAdminMacaroon waits for the daemon to hand over the admin macaroon it
+// baked after a stateless init or unlock. As no macaroon files are created in
+// stateless mode, this is the only way for the caller to ever access the
+// daemon.
+func (u *UnlockerService) waitForAdminMacaroon(
+	ctx context.Context) ([]byte, error) {
+
+	select {
+	case adminMac := <-u.MacResponseChan:
+		return adminMac, nil
+
+	case <-ctx.Done():
+		return nil, ErrMacaroonTimeout
+	}
 }
 
 // UnlockWallet sends the password provided by the incoming UnlockWalletRequest
@@ -341,6 +395,7 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 		Passphrase:     password,
 		RecoveryWindow: recoveryWindow,
 		Wallet:         unlockedWallet,
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -355,7 +410,22 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 	// channel, such that it can be used by lnd to open the wallet.
 	u.UnlockMsgs <- walletUnlockMsg
 
-	return &lnrpc.UnlockWalletResponse{}, nil
+	if !in.StatelessInit {
+		return &lnrpc.UnlockWalletResponse{}, nil
+	}
+
+	// The macaroon files of a stateless node were never written to disk,
+	// so we hand the caller a freshly baked admin macaroon. This also
+	// allows recovering access if the one returned by InitWallet never
+	// reached the caller.
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.UnlockWalletResponse{
+		AdminMacaroon: adminMac,
+	}, nil
 }
 
 // ChangePassword changes the password of the wallet and sends the new password
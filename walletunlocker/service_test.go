@@ -2,6 +2,7 @@ package walletunlocker_test
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -271,6 +272,83 @@ func TestInitWallet(t *testing.T) {
 	}
 }
 
+// TestInitWalletStateless tests that the admin macaroon handed over by the
+// daemon is returned when initializing the wallet stateless.
+func TestInitWalletStateless(t *testing.T) {
+	t.Parallel()
+
+	// testDir is empty, meaning wallet was not created from before.
+	testDir, err := ioutil.TempDir("", "testcreate")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer func() {
+		os.RemoveAll(testDir)
+	}()
+
+	// Create new UnlockerService.
+	service := walletunlocker.New(testDir, testNetParams, nil)
+
+	cipherSeed, err := aezeed.New(
+		keychain.KeyDerivationVersion, &testEntropy, time.Now(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	mnemonic, err := cipherSeed.ToMnemonic(nil)
+	if err != nil {
+		t.Fatalf("unable to create mnemonic: %v", err)
+	}
+
+	// We'll act as the daemon, which hands over the admin macaroon once
+	// it received a stateless init message.
+	adminMac := []byte("admin macaroon")
+	errChan := make(chan error, 1)
+	go func() {
+		select {
+		case msg := <-service.InitMsgs:
+			if !msg.StatelessInit {
+				errChan <- fmt.Errorf("expected stateless " +
+					"init message")
+				return
+			}
+			service.MacResponseChan <- adminMac
+			errChan <- nil
+
+		case <-time.After(3 * time.Second):
+			errChan <- fmt.Errorf("init message not received")
+		}
+	}()
+
+	ctx := context.Background()
+	req := &lnrpc.InitWalletRequest{
+		WalletPassword:     testPassword,
+		CipherSeedMnemonic: []string(mnemonic[:]),
+		StatelessInit:      true,
+	}
+	resp, err := service.InitWallet(ctx, req)
+	if err != nil {
+		t.Fatalf("InitWallet call failed: %v", err)
+	}
+	if err := <-errChan; err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(resp.AdminMacaroon, adminMac) {
+		t.Fatalf("expected admin macaroon %x, got %x", adminMac,
+			resp.AdminMacaroon)
+	}
+
+	// If the request is cancelled before the daemon hands over the
+	// macaroon, then we should receive an error instead.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = service.InitWallet(cancelledCtx, req)
+	if err != walletunlocker.ErrMacaroonTimeout {
+		t.Fatalf("expected ErrMacaroonTimeout, got %v", err)
+	}
+}
+
 // TestInitWalletInvalidCipherSeed tests that if we attempt to create a wallet
 // with an invalid cipher seed, then we'll receive an error.
 func TestCreateWalletInvalidEntropy(t *testing.T) {
@@ -363,9 +441,49 @@ func TestUnlockWallet(t *testing.T) {
 				"got %d", testRecoveryWindow,
 				unlockMsg.RecoveryWindow)
 		}
+
+		// The unlocked wallet holds the lock on its database, which
+		// must be released before it can be unlocked again.
+		if err := unlockMsg.Wallet.Database().Close(); err != nil {
+			t.Fatalf("unable to close wallet: %v", err)
+		}
 	case <-time.After(3 * time.Second):
 		t.Fatalf("password not received")
 	}
+
+	// When unlocking stateless, we'll act as the daemon and hand over a
+	// new admin macaroon, which should be returned to the caller.
+	adminMac := []byte("admin macaroon")
+	errChan := make(chan error, 1)
+	go func() {
+		select {
+		case msg := <-service.UnlockMsgs:
+			if !msg.StatelessInit {
+				errChan <- fmt.Errorf("expected stateless " +
+					"unlock message")
+				return
+			}
+			service.MacResponseChan <- adminMac
+			errChan <- nil
+
+		case <-time.After(3 * time.Second):
+			errChan <- fmt.Errorf("unlock message not received")
+		}
+	}()
+
+	req.StatelessInit = true
+	resp, err := service.UnlockWallet(ctx, req)
+	if err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	if err := <-errChan; err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(resp.AdminMacaroon, adminMac) {
+		t.Fatalf("expected admin macaroon %x, got %x", adminMac,
+			resp.AdminMacaroon)
+	}
 }
 
 // TestChangeWalletPassword tests that we can successfully change the wallet's
@@ -0,0 +1,201 @@
+package walletunlocker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/wakiyamap/lnd/aezeed"
+)
+
+const (
+	// maxPasswordSize is the maximum number of bytes that are read from a
+	// password source, to guard against accidentally reading a huge file
+	// or a misbehaving agent.
+	maxPasswordSize = 1024
+
+	// DefaultSocketTimeout is the default time we'll wait for the agent
+	// behind a unix socket to hand out the password.
+	DefaultSocketTimeout = 10 * time.Second
+)
+
+// ErrEmptyPassword is returned if a password source didn't yield a password.
+var ErrEmptyPassword = errors.New("password source returned an empty " +
+	"password")
+
+// PasswordSource is a source of the wallet password that allows the daemon to
+// unlock its wallet on startup, without waiting for the password to be
+// provided over RPC.
+type PasswordSource interface {
+	// Password returns the wallet password.
+	Password() ([]byte, error)
+
+	// String returns a human readable description of the source.
+	String() string
+}
+
+// trimPassword removes a single trailing newline from the passed password, as
+// added by most editors and shell commands, and checks that the remaining
+// password isn't empty.
+func trimPassword(password []byte) ([]byte, error) {
+	password = bytes.TrimSuffix(password, []byte("\n"))
+	password = bytes.TrimSuffix(password, []byte("\r"))
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	return password, nil
+}
+
+// readPassword reads the password from the passed reader, failing if it
+// exceeds maxPasswordSize.
+func readPassword(r io.Reader) ([]byte, error) {
+	password, err := ioutil.ReadAll(io.LimitReader(r, maxPasswordSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(password) > maxPasswordSize {
+		return nil, fmt.Errorf("password exceeds %v bytes",
+			maxPasswordSize)
+	}
+
+	return trimPassword(password)
+}
+
+// FileSource is a PasswordSource that reads the password from a file.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource creates a new FileSource reading the password from the file
+// at the given path.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// Password returns the wallet password.
+//
+// NOTE: This is part of the PasswordSource interface.
+func (f *FileSource) Password() ([]byte, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return readPassword(file)
+}
+
+// String returns a human readable description of the source.
+//
+// NOTE: This is part of the PasswordSource interface.
+func (f *FileSource) String() string {
+	return fmt.Sprintf("file %v", f.path)
+}
+
+// EnvSource is a PasswordSource that reads the password from an environment
+// variable.
+type EnvSource struct {
+	name string
+}
+
+// NewEnvSource creates a new EnvSource reading the password from the
+// environment variable with the given name.
+func NewEnvSource(name string) *EnvSource {
+	return &EnvSource{name: name}
+}
+
+// Password returns the wallet password.
+//
+// NOTE: This is part of the PasswordSource interface.
+func (e *EnvSource) Password() ([]byte, error) {
+	password, ok := os.LookupEnv(e.name)
+	if !ok {
+		return nil, fmt.Errorf("environment variable %v not set",
+			e.name)
+	}
+
+	return trimPassword([]byte(password))
+}
+
+// String returns a human readable description of the source.
+//
+// NOTE: This is part of the PasswordSource interface.
+func (e *EnvSource) String() string {
+	return fmt.Sprintf("environment variable %v", e.name)
+}
+
+// SocketSource is a PasswordSource that obtains the password from a local
+// agent listening on a unix socket, such as a stand-in for a hardware
+// security module. The agent is expected to write the password to every
+// connection, and to close it afterwards.
+type SocketSource struct {
+	path    string
+	timeout time.Duration
+}
+
+// NewSocketSource creates a new SocketSource obtaining the password from the
+// agent listening on the unix socket at the given path. The timeout bounds the
+// time it may take to connect to the agent and to read the password.
+func NewSocketSource(path string, timeout time.Duration) *SocketSource {
+	return &SocketSource{
+		path:    path,
+		timeout: timeout,
+	}
+}
+
+// Password returns the wallet password.
+//
+// NOTE: This is part of the PasswordSource interface.
+func (s *SocketSource) Password() ([]byte, error) {
+	conn, err := net.DialTimeout("unix", s.path, s.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetReadDeadline(time.Now().Add(s.timeout)); err != nil {
+		return nil, err
+	}
+
+	return readPassword(conn)
+}
+
+// String returns a human readable description of the source.
+//
+// NOTE: This is part of the PasswordSource interface.
+func (s *SocketSource) String() string {
+	return fmt.Sprintf("unix socket %v", s.path)
+}
+
+// ReadMnemonicFile reads an aezeed mnemonic from the file at the given path.
+// The words of the mnemonic may be separated by any whitespace.
+func ReadMnemonicFile(path string) (aezeed.Mnemonic, error) {
+	var mnemonic aezeed.Mnemonic
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return mnemonic, err
+	}
+
+	words := strings.Fields(string(content))
+	if len(words) != aezeed.NummnemonicWords {
+		return mnemonic, fmt.Errorf("seed file must contain %v words, "+
+			"instead got %v", aezeed.NummnemonicWords, len(words))
+	}
+	copy(mnemonic[:], words)
+
+	return mnemonic, nil
+}
+
+// Compile-time constraints to ensure all sources implement the
+// PasswordSource interface.
+var _ PasswordSource = (*FileSource)(nil)
+var _ PasswordSource = (*EnvSource)(nil)
+var _ PasswordSource = (*SocketSource)(nil)
@@ -0,0 +1,161 @@
+package walletunlocker_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wakiyamap/lnd/walletunlocker"
+)
+
+// assertSourcePassword asserts that the passed source yields the test
+// password.
+func assertSourcePassword(t *testing.T, source walletunlocker.PasswordSource) {
+	t.Helper()
+
+	password, err := source.Password()
+	if err != nil {
+		t.Fatalf("unable to obtain password from %v: %v", source, err)
+	}
+	if !bytes.Equal(password, testPassword) {
+		t.Fatalf("expected password %s from %v, got %s", testPassword,
+			source, password)
+	}
+}
+
+// TestFileSource tests that the password is read from a file, ignoring a
+// trailing newline, and that an empty file is rejected.
+func TestFileSource(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testfilesource")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	pwFile := filepath.Join(testDir, "password")
+	content := append(append([]byte{}, testPassword...), '\n')
+	if err := ioutil.WriteFile(pwFile, content, 0600); err != nil {
+		t.Fatalf("unable to write password file: %v", err)
+	}
+	assertSourcePassword(t, walletunlocker.NewFileSource(pwFile))
+
+	if err := ioutil.WriteFile(pwFile, []byte("\n"), 0600); err != nil {
+		t.Fatalf("unable to write password file: %v", err)
+	}
+	_, err = walletunlocker.NewFileSource(pwFile).Password()
+	if err != walletunlocker.ErrEmptyPassword {
+		t.Fatalf("expected ErrEmptyPassword, got %v", err)
+	}
+}
+
+// TestEnvSource tests that the password is read from an environment variable,
+// and that an unset variable is rejected.
+func TestEnvSource(t *testing.T) {
+	const envName = "LND_TEST_WALLET_PASSWORD"
+
+	if err := os.Setenv(envName, string(testPassword)); err != nil {
+		t.Fatalf("unable to set environment variable: %v", err)
+	}
+	assertSourcePassword(t, walletunlocker.NewEnvSource(envName))
+
+	if err := os.Unsetenv(envName); err != nil {
+		t.Fatalf("unable to unset environment variable: %v", err)
+	}
+	_, err := walletunlocker.NewEnvSource(envName).Password()
+	if err == nil {
+		t.Fatalf("expected unset environment variable to fail")
+	}
+}
+
+// TestSocketSource tests that the password is obtained from an agent
+// listening on a unix socket.
+func TestSocketSource(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testsocketsource")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	socketPath := filepath.Join(testDir, "agent.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("unable to listen on unix socket: %v", err)
+	}
+	defer listener.Close()
+
+	// Serve the password to a single connection, like an agent would.
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.Write(testPassword)
+	}()
+
+	source := walletunlocker.NewSocketSource(socketPath, 5*time.Second)
+	assertSourcePassword(t, source)
+
+	// Once the agent is gone, obtaining the password must fail.
+	listener.Close()
+	if _, err := source.Password(); err == nil {
+		t.Fatalf("expected password from closed socket to fail")
+	}
+}
+
+// TestReadMnemonicFile tests that a mnemonic is read from a file regardless
+// of the whitespace separating its words, and that a mnemonic with the wrong
+// number of words is rejected.
+func TestReadMnemonicFile(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testmnemonicfile")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	words := make([]string, 24)
+	for i := range words {
+		words[i] = "abandon"
+	}
+	words[23] = "zoo"
+
+	seedFile := filepath.Join(testDir, "seed")
+	content := strings.Join(words[:12], " ") + "\n" +
+		strings.Join(words[12:], "\t") + "\n"
+	err = ioutil.WriteFile(seedFile, []byte(content), 0600)
+	if err != nil {
+		t.Fatalf("unable to write seed file: %v", err)
+	}
+
+	mnemonic, err := walletunlocker.ReadMnemonicFile(seedFile)
+	if err != nil {
+		t.Fatalf("unable to read seed file: %v", err)
+	}
+	for i, word := range words {
+		if mnemonic[i] != word {
+			t.Fatalf("word %v: expected %v, got %v", i, word,
+				mnemonic[i])
+		}
+	}
+
+	content = strings.Join(words[:23], " ")
+	err = ioutil.WriteFile(seedFile, []byte(content), 0600)
+	if err != nil {
+		t.Fatalf("unable to write seed file: %v", err)
+	}
+	if _, err := walletunlocker.ReadMnemonicFile(seedFile); err == nil {
+		t.Fatalf("expected seed file with 23 words to fail")
+	}
+}
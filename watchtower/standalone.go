@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/wakiyamap/lnd/brontide"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/watchtower/lookout"
 	"github.com/wakiyamap/lnd/watchtower/wtserver"
 )
@@ -67,7 +68,8 @@ func New(cfg *Config) (*Standalone, error) {
 	listeners := make([]net.Listener, 0, len(cfg.ListenAddrs))
 	for _, listenAddr := range cfg.ListenAddrs {
 		listener, err := brontide.NewListener(
-			cfg.NodePrivKey, listenAddr.String(),
+			&keychain.PrivKeyECDH{PrivKey: cfg.NodePrivKey},
+			listenAddr.String(),
 		)
 		if err != nil {
 			return nil, err
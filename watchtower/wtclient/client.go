@@ -6,9 +6,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwallet"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/watchtower/wtdb"
@@ -76,7 +76,7 @@ type Config struct {
 	// SecretKeyRing is used to derive the session keys used to communicate
 	// with the tower. The client only stores the KeyLocators internally so
 	// that we never store private keys on disk.
-	SecretKeyRing SecretKeyRing
+	SecretKeyRing ECDHKeyRing
 
 	// Dial connects to an addr using the specified net and returns the
 	// connection object.
@@ -232,7 +232,7 @@ func New(config *Config) (*TowerClient, error) {
 			return nil, err
 		}
 
-		sessionPriv, err := DeriveSessionKey(
+		sessionKey, err := DeriveSessionKey(
 			c.cfg.SecretKeyRing, s.KeyIndex,
 		)
 		if err != nil {
@@ -240,7 +240,7 @@ func New(config *Config) (*TowerClient, error) {
 		}
 
 		s.Tower = tower
-		s.SessionPrivKey = sessionPriv
+		s.SessionKeyECDH = sessionKey
 	}
 
 	// Finally, load the sweep pkscripts that have been generated for all
@@ -681,13 +681,13 @@ func (c *TowerClient) taskRejected(task *backupTask, curStatus reserveStatus) {
 	}
 }
 
-// dial connects the peer at addr using privKey as our secret key for the
+// dial connects the peer at addr using sessionKey as our secret key for the
 // connection. The connection will use the configured Net's resolver to resolve
 // the address for either Tor or clear net connections.
-func (c *TowerClient) dial(privKey *btcec.PrivateKey,
+func (c *TowerClient) dial(sessionKey keychain.SingleKeyECDH,
 	addr *lnwire.NetAddress) (wtserver.Peer, error) {
 
-	return c.cfg.AuthDial(privKey, addr, c.cfg.Dial)
+	return c.cfg.AuthDial(sessionKey, addr, c.cfg.Dial)
 }
 
 // readMessage receives and parses the next message from the given Peer. An
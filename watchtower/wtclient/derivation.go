@@ -1,24 +1,30 @@
 package wtclient
 
 import (
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/wakiyamap/lnd/keychain"
 )
 
 // DeriveSessionKey accepts an session key index for an existing session and
-// derives the HD private key to be used to authenticate the brontide transport
-// and authenticate requests sent to the tower. The key will use the
+// derives the HD key to be used to authenticate the brontide transport and
+// authenticate requests sent to the tower. The key will use the
 // keychain.KeyFamilyTowerSession and the provided index, giving a BIP43
 // derivation path of:
 //
 //  * m/1017'/coinType'/8/0/index
-func DeriveSessionKey(keyRing SecretKeyRing,
-	index uint32) (*btcec.PrivateKey, error) {
+//
+// Only the public key is derived, while the ECDH operations of the brontide
+// handshake are carried out by the key ring. This allows the session keys to
+// be held by a remote signer.
+func DeriveSessionKey(keyRing ECDHKeyRing,
+	index uint32) (keychain.SingleKeyECDH, error) {
 
-	return keyRing.DerivePrivKey(keychain.KeyDescriptor{
-		KeyLocator: keychain.KeyLocator{
-			Family: keychain.KeyFamilyTowerSession,
-			Index:  index,
-		},
+	keyDesc, err := keyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamilyTowerSession,
+		Index:  index,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keychain.NewPubKeyECDH(keyDesc, keyRing), nil
 }
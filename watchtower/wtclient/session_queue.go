@@ -7,9 +7,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/wakiyamap/lnd/input"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/watchtower/wtdb"
 	"github.com/wakiyamap/lnd/watchtower/wtserver"
@@ -46,7 +46,7 @@ type sessionQueueConfig struct {
 
 	// Dial allows the client to dial the tower using it's public key and
 	// net address.
-	Dial func(*btcec.PrivateKey,
+	Dial func(keychain.SingleKeyECDH,
 		*lnwire.NetAddress) (wtserver.Peer, error)
 
 	// SendMessage encodes, encrypts, and writes a message to the given peer.
@@ -322,7 +322,7 @@ func (q *sessionQueue) sessionManager() {
 // drainBackups attempts to send all pending updates in the queue to the tower.
 func (q *sessionQueue) drainBackups() {
 	// First, check that we are able to dial this session's tower.
-	conn, err := q.cfg.Dial(q.cfg.ClientSession.SessionPrivKey, q.towerAddr)
+	conn, err := q.cfg.Dial(q.cfg.ClientSession.SessionKeyECDH, q.towerAddr)
 	if err != nil {
 		log.Errorf("Unable to dial watchtower at %v: %v",
 			q.towerAddr, err)
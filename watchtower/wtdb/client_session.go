@@ -3,7 +3,7 @@ package wtdb
 import (
 	"errors"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/wakiyamap/lnd/keychain"
 	"github.com/wakiyamap/lnd/lnwire"
 	"github.com/wakiyamap/lnd/watchtower/wtpolicy"
 )
@@ -71,12 +71,12 @@ type ClientSession struct {
 	// use the keychain.KeyFamilyTowerSession key family.
 	KeyIndex uint32
 
-	// SessionPrivKey is the ephemeral secret key used to connect to the
-	// watchtower.
+	// SessionKeyECDH is the ECDH capable wrapper of the ephemeral secret
+	// key used to connect to the watchtower.
 	//
 	// NOTE: This value is not serialized. It is derived using the KeyIndex
 	// on startup to avoid storing private keys on disk.
-	SessionPrivKey *btcec.PrivateKey
+	SessionKeyECDH keychain.SingleKeyECDH
 
 	// Policy holds the negotiated session parameters.
 	Policy wtpolicy.Policy
@@ -8,17 +8,14 @@ import (
 	"path/filepath"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/coreos/bbolt"
 	"github.com/wakiyamap/lnd/chainntnfs"
 	"github.com/wakiyamap/lnd/channeldb"
+	"github.com/wakiyamap/lnd/channeldb/kvdb"
 )
 
 const (
 	// dbName is the filename of tower database.
 	dbName = "watchtower.db"
-
-	// dbFilePermission requests read+write access to the db file.
-	dbFilePermission = 0600
 )
 
 var (
@@ -74,7 +71,7 @@ var (
 // TowerDB is single database providing a persistent storage engine for the
 // wtserver and lookout subsystems.
 type TowerDB struct {
-	db     *bbolt.DB
+	db     kvdb.Backend
 	dbPath string
 }
 
@@ -99,7 +96,7 @@ func OpenTowerDB(dbPath string) (*TowerDB, error) {
 		}
 	}
 
-	bdb, err := bbolt.Open(path, dbFilePermission, nil)
+	bdb, err := kvdb.OpenBolt(path)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +108,7 @@ func OpenTowerDB(dbPath string) (*TowerDB, error) {
 	// set firstInit to true so that we can treat is initialize the bucket.
 	if !firstInit {
 		var metadataExists bool
-		err = bdb.View(func(tx *bbolt.Tx) error {
+		err = bdb.View(func(tx kvdb.Tx) error {
 			metadataExists = tx.Bucket(metadataBkt) != nil
 			return nil
 		})
@@ -132,7 +129,7 @@ func OpenTowerDB(dbPath string) (*TowerDB, error) {
 	if firstInit {
 		// If the database has not yet been created, we'll initialize
 		// the database version with the latest known version.
-		err = towerDB.db.Update(func(tx *bbolt.Tx) error {
+		err = towerDB.db.Update(func(tx kvdb.Tx) error {
 			return initDBVersion(tx, getLatestDBVersion(dbVersions))
 		})
 		if err != nil {
@@ -176,7 +173,7 @@ func fileExists(path string) bool {
 
 // initTowerDBBuckets creates all top-level buckets required to handle database
 // operations required by the latest version.
-func initTowerDBBuckets(tx *bbolt.Tx) error {
+func initTowerDBBuckets(tx kvdb.Tx) error {
 	buckets := [][]byte{
 		sessionsBkt,
 		updateIndexBkt,
@@ -220,7 +217,7 @@ func (t *TowerDB) syncVersions(versions []version) error {
 	// Otherwise, apply any migrations in order to bring the database
 	// version up to the highest known version.
 	updates := getMigrations(versions, curVersion)
-	return t.db.Update(func(tx *bbolt.Tx) error {
+	return t.db.Update(func(tx kvdb.Tx) error {
 		for _, update := range updates {
 			if update.migration == nil {
 				continue
@@ -243,7 +240,7 @@ func (t *TowerDB) syncVersions(versions []version) error {
 // Version returns the database's current version number.
 func (t *TowerDB) Version() (uint32, error) {
 	var version uint32
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err := t.db.View(func(tx kvdb.Tx) error {
 		var err error
 		version, err = getDBVersion(tx)
 		return err
@@ -264,7 +261,7 @@ func (t *TowerDB) Close() error {
 // returned if the session could not be found.
 func (t *TowerDB) GetSessionInfo(id *SessionID) (*SessionInfo, error) {
 	var session *SessionInfo
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err := t.db.View(func(tx kvdb.Tx) error {
 		sessions := tx.Bucket(sessionsBkt)
 		if sessions == nil {
 			return ErrUninitializedDB
@@ -284,7 +281,7 @@ func (t *TowerDB) GetSessionInfo(id *SessionID) (*SessionInfo, error) {
 // InsertSessionInfo records a negotiated session in the tower database. An
 // error is returned if the session already exists.
 func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
-	return t.db.Update(func(tx *bbolt.Tx) error {
+	return t.db.Update(func(tx kvdb.Tx) error {
 		sessions := tx.Bucket(sessionsBkt)
 		if sessions == nil {
 			return ErrUninitializedDB
@@ -327,7 +324,7 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 // properly and the last applied values echoed by the client are sane.
 func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error) {
 	var lastApplied uint16
-	err := t.db.Update(func(tx *bbolt.Tx) error {
+	err := t.db.Update(func(tx kvdb.Tx) error {
 		sessions := tx.Bucket(sessionsBkt)
 		if sessions == nil {
 			return ErrUninitializedDB
@@ -404,7 +401,7 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 // DeleteSession removes all data associated with a particular session id from
 // the tower's database.
 func (t *TowerDB) DeleteSession(target SessionID) error {
-	return t.db.Update(func(tx *bbolt.Tx) error {
+	return t.db.Update(func(tx kvdb.Tx) error {
 		sessions := tx.Bucket(sessionsBkt)
 		if sessions == nil {
 			return ErrUninitializedDB
@@ -490,7 +487,7 @@ func (t *TowerDB) DeleteSession(target SessionID) error {
 // they exist in the database.
 func (t *TowerDB) QueryMatches(breachHints []BreachHint) ([]Match, error) {
 	var matches []Match
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err := t.db.View(func(tx kvdb.Tx) error {
 		sessions := tx.Bucket(sessionsBkt)
 		if sessions == nil {
 			return ErrUninitializedDB
@@ -572,7 +569,7 @@ func (t *TowerDB) QueryMatches(breachHints []BreachHint) ([]Match, error) {
 // SetLookoutTip stores the provided epoch as the latest lookout tip epoch in
 // the tower database.
 func (t *TowerDB) SetLookoutTip(epoch *chainntnfs.BlockEpoch) error {
-	return t.db.Update(func(tx *bbolt.Tx) error {
+	return t.db.Update(func(tx kvdb.Tx) error {
 		lookoutTip := tx.Bucket(lookoutTipBkt)
 		if lookoutTip == nil {
 			return ErrUninitializedDB
@@ -586,7 +583,7 @@ func (t *TowerDB) SetLookoutTip(epoch *chainntnfs.BlockEpoch) error {
 // database.
 func (t *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
 	var epoch *chainntnfs.BlockEpoch
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err := t.db.View(func(tx kvdb.Tx) error {
 		lookoutTip := tx.Bucket(lookoutTipBkt)
 		if lookoutTip == nil {
 			return ErrUninitializedDB
@@ -606,7 +603,7 @@ func (t *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
 // getSession retrieves the session info from the sessions bucket identified by
 // its session id. An error is returned if the session is not found or a
 // deserialization error occurs.
-func getSession(sessions *bbolt.Bucket, id []byte) (*SessionInfo, error) {
+func getSession(sessions kvdb.Bucket, id []byte) (*SessionInfo, error) {
 	sessionBytes := sessions.Get(id)
 	if sessionBytes == nil {
 		return nil, ErrSessionNotFound
@@ -623,7 +620,7 @@ func getSession(sessions *bbolt.Bucket, id []byte) (*SessionInfo, error) {
 
 // putSession stores the session info in the sessions bucket identified by its
 // session id. An error is returned if a serialization error occurs.
-func putSession(sessions *bbolt.Bucket, session *SessionInfo) error {
+func putSession(sessions kvdb.Bucket, session *SessionInfo) error {
 	var b bytes.Buffer
 	err := session.Encode(&b)
 	if err != nil {
@@ -637,7 +634,7 @@ func putSession(sessions *bbolt.Bucket, session *SessionInfo) error {
 // session id. This ensures that future calls to getHintsForSession or
 // putHintForSession can rely on the bucket already being created, and fail if
 // index has not been initialized as this points to improper usage.
-func touchSessionHintBkt(updateIndex *bbolt.Bucket, id *SessionID) error {
+func touchSessionHintBkt(updateIndex kvdb.Bucket, id *SessionID) error {
 	_, err := updateIndex.CreateBucketIfNotExists(id[:])
 	return err
 }
@@ -645,14 +642,14 @@ func touchSessionHintBkt(updateIndex *bbolt.Bucket, id *SessionID) error {
 // removeSessionHintBkt prunes the session-hint bucket for the given session id
 // and all of the hints contained inside. This should be used to clean up the
 // index upon session deletion.
-func removeSessionHintBkt(updateIndex *bbolt.Bucket, id *SessionID) error {
+func removeSessionHintBkt(updateIndex kvdb.Bucket, id *SessionID) error {
 	return updateIndex.DeleteBucket(id[:])
 }
 
 // getHintsForSession returns all known hints belonging to the given session id.
 // If the index for the session has not been initialized, this method returns
 // ErrNoSessionHintIndex.
-func getHintsForSession(updateIndex *bbolt.Bucket,
+func getHintsForSession(updateIndex kvdb.Bucket,
 	id *SessionID) ([]BreachHint, error) {
 
 	sessionHints := updateIndex.Bucket(id[:])
@@ -683,7 +680,7 @@ func getHintsForSession(updateIndex *bbolt.Bucket,
 // session id, and used to perform efficient removal of updates. If the index
 // for the session has not been initialized, this method returns
 // ErrNoSessionHintIndex.
-func putHintForSession(updateIndex *bbolt.Bucket, id *SessionID,
+func putHintForSession(updateIndex kvdb.Bucket, id *SessionID,
 	hint BreachHint) error {
 
 	sessionHints := updateIndex.Bucket(id[:])
@@ -695,7 +692,7 @@ func putHintForSession(updateIndex *bbolt.Bucket, id *SessionID,
 }
 
 // putLookoutEpoch stores the given lookout tip block epoch in provided bucket.
-func putLookoutEpoch(bkt *bbolt.Bucket, epoch *chainntnfs.BlockEpoch) error {
+func putLookoutEpoch(bkt kvdb.Bucket, epoch *chainntnfs.BlockEpoch) error {
 	epochBytes := make([]byte, 36)
 	copy(epochBytes, epoch.Hash[:])
 	byteOrder.PutUint32(epochBytes[32:], uint32(epoch.Height))
@@ -705,7 +702,7 @@ func putLookoutEpoch(bkt *bbolt.Bucket, epoch *chainntnfs.BlockEpoch) error {
 
 // getLookoutEpoch retrieves the lookout tip block epoch from the given bucket.
 // A nil epoch is returned if no update exists.
-func getLookoutEpoch(bkt *bbolt.Bucket) *chainntnfs.BlockEpoch {
+func getLookoutEpoch(bkt kvdb.Bucket) *chainntnfs.BlockEpoch {
 	epochBytes := bkt.Get(lookoutTipKey)
 	if len(epochBytes) != 36 {
 		return nil
@@ -726,7 +723,7 @@ func getLookoutEpoch(bkt *bbolt.Bucket) *chainntnfs.BlockEpoch {
 var errBucketNotEmpty = errors.New("bucket not empty")
 
 // isBucketEmpty returns errBucketNotEmpty if the bucket is not empty.
-func isBucketEmpty(bkt *bbolt.Bucket) error {
+func isBucketEmpty(bkt kvdb.Bucket) error {
 	return bkt.ForEach(func(_, _ []byte) error {
 		return errBucketNotEmpty
 	})
@@ -1,11 +1,11 @@
 package wtdb
 
-import "github.com/coreos/bbolt"
+import "github.com/wakiyamap/lnd/channeldb/kvdb"
 
 // migration is a function which takes a prior outdated version of the database
 // instances and mutates the key/bucket structure to arrive at a more
 // up-to-date version of the database.
-type migration func(tx *bbolt.Tx) error
+type migration func(tx kvdb.Tx) error
 
 // version pairs a version number with the migration that would need to be
 // applied from the prior version to upgrade.
@@ -45,7 +45,7 @@ func getMigrations(versions []version, curVersion uint32) []version {
 
 // getDBVersion retrieves the current database version from the metadata bucket
 // using the dbVersionKey.
-func getDBVersion(tx *bbolt.Tx) (uint32, error) {
+func getDBVersion(tx kvdb.Tx) (uint32, error) {
 	metadata := tx.Bucket(metadataBkt)
 	if metadata == nil {
 		return 0, ErrUninitializedDB
@@ -61,7 +61,7 @@ func getDBVersion(tx *bbolt.Tx) (uint32, error) {
 
 // initDBVersion initializes the top-level metadata bucket and writes the passed
 // version number as the current version.
-func initDBVersion(tx *bbolt.Tx, version uint32) error {
+func initDBVersion(tx kvdb.Tx, version uint32) error {
 	_, err := tx.CreateBucketIfNotExists(metadataBkt)
 	if err != nil {
 		return err
@@ -72,7 +72,7 @@ func initDBVersion(tx *bbolt.Tx, version uint32) error {
 
 // putDBVersion stores the passed database version in the metadata bucket under
 // the dbVersionKey.
-func putDBVersion(tx *bbolt.Tx, version uint32) error {
+func putDBVersion(tx kvdb.Tx, version uint32) error {
 	metadata := tx.Bucket(metadataBkt)
 	if metadata == nil {
 		return ErrUninitializedDB
@@ -20,6 +20,25 @@ func NewSecretKeyRing() *SecretKeyRing {
 	}
 }
 
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator. If this method is called twice with the same argument, it will
+// return the same public key.
+func (m *SecretKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	privKey, err := m.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     privKey.PubKey(),
+	}, nil
+}
+
 // DerivePrivKey derives the private key for a given key descriptor. If
 // this method is called twice with the same argument, it will return the same
 // private key.
@@ -42,3 +61,22 @@ func (m *SecretKeyRing) DerivePrivKey(
 
 	return privKey, nil
 }
+
+// ScalarMult performs a scalar multiplication (ECDH-like operation) between
+// the private key of the target key descriptor and remote public key.
+func (m *SecretKeyRing) ScalarMult(desc keychain.KeyDescriptor,
+	pubKey *btcec.PublicKey) ([]byte, error) {
+
+	privKey, err := m.DerivePrivKey(desc)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdh := &keychain.PrivKeyECDH{PrivKey: privKey}
+	sharedSecret, err := ecdh.ECDH(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sharedSecret[:], nil
+}
@@ -79,7 +79,8 @@ var (
 	}
 
 	testMessageSigner = MessageSigner{
-		SignCompact: func(hash []byte) ([]byte, error) {
+		SignCompact: func(msg []byte) ([]byte, error) {
+			hash := chainhash.HashB(msg)
 			sig, err := btcec.SignCompact(btcec.S256(),
 				testPrivKey, hash, true)
 			if err != nil {